@@ -34,6 +34,15 @@ var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 // ErrGetGuardianData signals an error in getting the guardian data for given address
 var ErrGetGuardianData = errors.New("get guardian data for account error")
 
+// ErrGetAddressTransactions signals an error in getting the transactions of the given address
+var ErrGetAddressTransactions = errors.New("get transactions for account error")
+
+// ErrGetBalanceHistory signals an error in getting the balance history of the given address
+var ErrGetBalanceHistory = errors.New("get balance history for account error")
+
+// ErrQueryEvents signals an error in querying the indexed log events
+var ErrQueryEvents = errors.New("query events error")
+
 // ErrGetRolesForAccount signals an error in getting esdt tokens and roles for a given address
 var ErrGetRolesForAccount = errors.New("get roles for account error")
 
@@ -79,6 +88,12 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactionLifecycle signals an error happening when trying to fetch the lifecycle of a transaction
+var ErrGetTransactionLifecycle = errors.New("getting transaction lifecycle failed")
+
+// ErrGetTransactionProof signals an error happening when trying to build the proof of a transaction
+var ErrGetTransactionProof = errors.New("getting transaction proof failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -139,6 +154,9 @@ var ErrBadUrlParams = errors.New("bad url parameter(s)")
 // ErrGetGasConfigs signals that an error occurred while trying to fetch gas configs
 var ErrGetGasConfigs = errors.New("getting gas configs failed")
 
+// ErrGetGasConfigsDiff signals that an error occurred while trying to compute the gas configs differences
+var ErrGetGasConfigsDiff = errors.New("getting gas configs diff failed")
+
 // ErrEmptySenderToGetLatestNonce signals that an error happened when trying to fetch latest nonce
 var ErrEmptySenderToGetLatestNonce = errors.New("empty sender to get latest nonce")
 
@@ -174,3 +192,60 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
+
+// ErrNilAdminAuthenticator signals that a nil admin authenticator was provided
+var ErrNilAdminAuthenticator = errors.New("nil admin authenticator")
+
+// ErrAddManagedKey signals that an error occurred while adding a managed key
+var ErrAddManagedKey = errors.New("error adding the managed key")
+
+// ErrRemoveManagedKey signals that an error occurred while removing a managed key
+var ErrRemoveManagedKey = errors.New("error removing the managed key")
+
+// ErrReloadManagedKeys signals that an error occurred while reloading the managed keys
+var ErrReloadManagedKeys = errors.New("error reloading the managed keys")
+
+// ErrValidationEmptyPeerID signals that an empty peer ID was provided
+var ErrValidationEmptyPeerID = errors.New("peer ID is empty")
+
+// ErrValidationPeerIDOrIPRange signals that the request did not contain exactly one of the peer ID or the IP range
+var ErrValidationPeerIDOrIPRange = errors.New("exactly one of peerID or ipRange must be provided")
+
+// ErrGetPeerReputation signals that an error occurred while getting the peer reputation state
+var ErrGetPeerReputation = errors.New("error getting the peer reputation state")
+
+// ErrBanPeer signals that an error occurred while banning a peer or an IP range
+var ErrBanPeer = errors.New("error banning the peer")
+
+// ErrUnbanPeer signals that an error occurred while lifting the ban of a peer or an IP range
+var ErrUnbanPeer = errors.New("error unbanning the peer")
+
+// ErrPinPeer signals that an error occurred while pinning a peer
+var ErrPinPeer = errors.New("error pinning the peer")
+
+// ErrUnpinPeer signals that an error occurred while unpinning a peer
+var ErrUnpinPeer = errors.New("error unpinning the peer")
+
+// ErrGetESDTHolders signals that an error occurred while getting the holders of a token
+var ErrGetESDTHolders = errors.New("error getting the token holders")
+
+// ErrGetESDTVolumes signals that an error occurred while getting the volumes of a token
+var ErrGetESDTVolumes = errors.New("error getting the token volumes")
+
+// ErrGetGovernanceProposals signals that an error occurred while getting the governance proposals
+var ErrGetGovernanceProposals = errors.New("error getting the governance proposals")
+
+// ErrGetGovernanceProposal signals that an error occurred while getting a governance proposal
+var ErrGetGovernanceProposal = errors.New("error getting the governance proposal")
+
+// ErrGetGovernanceVoter signals that an error occurred while getting the governance voter info
+var ErrGetGovernanceVoter = errors.New("error getting the governance voter")
+
+// ErrInvalidGovernanceProposalStatus signals that an invalid governance proposal status was provided
+var ErrInvalidGovernanceProposalStatus = errors.New("invalid governance proposal status")
+
+// ErrGetRewardsBreakdown signals that an error occurred while getting the rewards breakdown
+var ErrGetRewardsBreakdown = errors.New("error getting the rewards breakdown")
+
+// ErrGetValidatorScorecard signals that an error occurred while getting the validator scorecard
+var ErrGetValidatorScorecard = errors.New("error getting the validator scorecard")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -168,6 +169,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["internal"] = internalBlockGroup
 
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
+	governanceGroup, err := groups.NewGovernanceGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["governance"] = governanceGroup
+
 	hardforkGroup, err := groups.NewHardforkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -210,11 +223,36 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["vm-values"] = vmValuesGroup
 
+	adminToken, err := ws.readAdminToken()
+	if err != nil {
+		return err
+	}
+	adminGroup, err := groups.NewAdminGroup(ws.facade, middleware.NewAdminAuthenticator(adminToken))
+	if err != nil {
+		return err
+	}
+	groupsMap["admin"] = adminGroup
+
 	ws.groups = groupsMap
 
 	return nil
 }
 
+func (ws *webServer) readAdminToken() (string, error) {
+	tokenFile := ws.apiConfig.Admin.TokenFile
+	if len(tokenFile) == 0 {
+		log.Debug("no admin token file configured, the admin API endpoints will reject all requests")
+		return "", nil
+	}
+
+	content, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("%w while reading the admin token file %s", err, tokenFile)
+	}
+
+	return string(content), nil
+}
+
 func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	for groupName, groupHandler := range ws.groups {
 		log.Debug("registering gin API group", "group name", groupName)
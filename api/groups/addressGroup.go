@@ -3,6 +3,7 @@ package groups
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
 	"sync"
@@ -14,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -32,6 +34,8 @@ const (
 	getRegisteredNFTsPath          = "/:address/registered-nfts"
 	getESDTNFTDataPath             = "/:address/nft/:tokenIdentifier/nonce/:nonce"
 	getGuardianData                = "/:address/guardian-data"
+	getTransactionsPath            = "/:address/transactions"
+	getBalanceHistoryPath          = "/:address/balance-history"
 	urlParamOnFinalBlock           = "onFinalBlock"
 	urlParamOnStartOfEpoch         = "onStartOfEpoch"
 	urlParamBlockNonce             = "blockNonce"
@@ -39,6 +43,12 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamFrom                   = "from"
+	urlParamSize                   = "size"
+	urlParamToken                  = "token"
+	urlParamDirection              = "direction"
+	urlParamStep                   = "step"
+	defaultAddressTransactionsSize = 20
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -57,6 +67,8 @@ type addressFacadeHandler interface {
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
 	IsInterfaceNil() bool
 }
 
@@ -172,6 +184,16 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.isDataTrieMigrated,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+		},
+		{
+			Path:    getBalanceHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ag.getBalanceHistory,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -481,6 +503,104 @@ func (ag *addressGroup) isDataTrieMigrated(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"isMigrated": isMigrated})
 }
 
+// getTransactions returns the indexed transactions, smart contract results and ESDT transfers of the given address
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractAddressTransactionsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	transactions, err := ag.getFacade().GetAddressTransactions(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"transactions": transactions})
+}
+
+func extractAddressTransactionsQueryOptions(c *gin.Context) (common.AddressTransactionsQueryOptions, error) {
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+
+	size, err := parseUint64UrlParam(c, urlParamSize)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+	if !size.HasValue {
+		size.Value = defaultAddressTransactionsSize
+	}
+
+	return common.AddressTransactionsQueryOptions{
+		From:      from.Value,
+		Size:      size.Value,
+		Token:     c.Request.URL.Query().Get(urlParamToken),
+		Direction: c.Request.URL.Query().Get(urlParamDirection),
+	}, nil
+}
+
+// getBalanceHistory returns the recorded changes of the EGLD balance or of the given token's balance of an address
+func (ag *addressGroup) getBalanceHistory(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetBalanceHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractBalanceHistoryQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetBalanceHistory, err)
+		return
+	}
+
+	history, err := ag.getFacade().GetBalanceHistory(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetBalanceHistory, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"balanceHistory": history})
+}
+
+func extractBalanceHistoryQueryOptions(c *gin.Context) (common.BalanceHistoryQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.BalanceHistoryQueryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.BalanceHistoryQueryOptions{}, err
+	}
+	if !toNonce.HasValue {
+		toNonce.Value = math.MaxUint64
+	}
+
+	step, err := parseUint64UrlParam(c, urlParamStep)
+	if err != nil {
+		return common.BalanceHistoryQueryOptions{}, err
+	}
+	if !step.HasValue {
+		step.Value = 1
+	}
+
+	return common.BalanceHistoryQueryOptions{
+		Token:     c.Request.URL.Query().Get(urlParamToken),
+		FromNonce: fromNonce.Value,
+		ToNonce:   toNonce.Value,
+		Step:      step.Value,
+	}, nil
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -19,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1151,6 +1153,8 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
 					{Name: "/:address/is-data-trie-migrated", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/balance-history", Open: true},
 				},
 			},
 		},
@@ -1240,3 +1244,193 @@ func TestIsDataTrieMigrated(t *testing.T) {
 		assert.False(t, respData["isMigrated"].(bool))
 	})
 }
+
+func TestAddressGroup_getTransactions(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		testAddressGroup(
+			t,
+			&mock.FacadeStub{},
+			fmt.Sprintf("/address/%s/transactions?size=abc", testAddress),
+			"GET",
+			nil,
+			http.StatusBadRequest,
+			apiErrors.ErrGetAddressTransactions.Error(),
+		)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAddressTransactionsCalled: func(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testAddressGroup(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/transactions", testAddress),
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, expectedErr),
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTransactions := []*common.AddressTransaction{
+			{
+				TxHash:    "aabb",
+				Epoch:     2,
+				Nonce:     37,
+				Type:      "esdtTransfer",
+				Direction: "in",
+				Token:     "TKN-abcdef",
+			},
+		}
+		var providedOptions common.AddressTransactionsQueryOptions
+		facade := &mock.FacadeStub{
+			GetAddressTransactionsCalled: func(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+				assert.Equal(t, testAddress, address)
+				providedOptions = options
+				return expectedTransactions, nil
+			},
+		}
+
+		response := &struct {
+			Data struct {
+				Transactions []*common.AddressTransaction `json:"transactions"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/transactions?from=5&size=10&token=TKN-abcdef&direction=in", testAddress),
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedTransactions, response.Data.Transactions)
+		assert.Equal(t, common.AddressTransactionsQueryOptions{From: 5, Size: 10, Token: "TKN-abcdef", Direction: "in"}, providedOptions)
+	})
+	t.Run("should use the default size", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.AddressTransactionsQueryOptions
+		facade := &mock.FacadeStub{
+			GetAddressTransactionsCalled: func(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+				providedOptions = options
+				return make([]*common.AddressTransaction, 0), nil
+			},
+		}
+
+		response := &shared.GenericAPIResponse{}
+		loadAddressGroupResponse(t, facade, fmt.Sprintf("/address/%s/transactions", testAddress), "GET", nil, response)
+		assert.Equal(t, uint64(20), providedOptions.Size)
+		assert.Equal(t, uint64(0), providedOptions.From)
+	})
+}
+
+func TestAddressGroup_getBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+
+	t.Run("invalid step should error", func(t *testing.T) {
+		t.Parallel()
+
+		testAddressGroup(
+			t,
+			&mock.FacadeStub{},
+			fmt.Sprintf("/address/%s/balance-history?step=abc", testAddress),
+			"GET",
+			nil,
+			http.StatusBadRequest,
+			apiErrors.ErrGetBalanceHistory.Error(),
+		)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceHistoryCalled: func(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testAddressGroup(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/balance-history", testAddress),
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetBalanceHistory, expectedErr),
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHistory := []*common.BalanceChange{
+			{
+				Nonce:    37,
+				Epoch:    2,
+				Balance:  "100",
+				Delta:    "-20",
+				TxHashes: []string{"aabb"},
+			},
+		}
+		var providedOptions common.BalanceHistoryQueryOptions
+		facade := &mock.FacadeStub{
+			GetBalanceHistoryCalled: func(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+				assert.Equal(t, testAddress, address)
+				providedOptions = options
+				return expectedHistory, nil
+			},
+		}
+
+		response := &struct {
+			Data struct {
+				BalanceHistory []*common.BalanceChange `json:"balanceHistory"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/balance-history?fromNonce=5&toNonce=100&step=10&token=TKN-abcdef", testAddress),
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedHistory, response.Data.BalanceHistory)
+		assert.Equal(t, common.BalanceHistoryQueryOptions{Token: "TKN-abcdef", FromNonce: 5, ToNonce: 100, Step: 10}, providedOptions)
+	})
+	t.Run("should use the default options", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.BalanceHistoryQueryOptions
+		facade := &mock.FacadeStub{
+			GetBalanceHistoryCalled: func(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+				providedOptions = options
+				return make([]*common.BalanceChange, 0), nil
+			},
+		}
+
+		response := &shared.GenericAPIResponse{}
+		loadAddressGroupResponse(t, facade, fmt.Sprintf("/address/%s/balance-history", testAddress), "GET", nil, response)
+		assert.Equal(t, common.BalanceHistoryQueryOptions{FromNonce: 0, ToNonce: math.MaxUint64, Step: 1}, providedOptions)
+	})
+}
@@ -0,0 +1,347 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	addManagedKeyPath     = "/managed-keys/add"
+	removeManagedKeyPath  = "/managed-keys/remove"
+	reloadManagedKeysPath = "/managed-keys/reload"
+	peerReputationPath    = "/peer-reputation"
+	banPeerPath           = "/peer-reputation/ban"
+	unbanPeerPath         = "/peer-reputation/unban"
+	pinPeerPath           = "/peer-reputation/pin"
+	unpinPeerPath         = "/peer-reputation/unpin"
+)
+
+// adminFacadeHandler defines the methods to be implemented by a facade for handling admin requests
+type adminFacadeHandler interface {
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKeyHex string) error
+	ReloadManagedKeys() (*common.ManagedKeysReloadResult, error)
+	GetPeerReputationState() (*common.PeerReputationState, error)
+	BanPeer(peerID string, duration time.Duration) error
+	UnbanPeer(peerID string) error
+	BanIPRange(ipRange string, duration time.Duration) error
+	UnbanIPRange(ipRange string) error
+	PinPeer(peerID string) error
+	UnpinPeer(peerID string) error
+	IsInterfaceNil() bool
+}
+
+type adminGroup struct {
+	*baseGroup
+	facade    adminFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewAdminGroup returns a new instance of adminGroup. All its endpoints are protected by the provided authenticator
+func NewAdminGroup(facade adminFacadeHandler, authenticator shared.MiddlewareProcessor) (*adminGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for admin group", errors.ErrNilFacadeHandler)
+	}
+	if check.IfNil(authenticator) {
+		return nil, errors.ErrNilAdminAuthenticator
+	}
+
+	ag := &adminGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	authMiddleware := []shared.AdditionalMiddleware{
+		{
+			Middleware: authenticator.MiddlewareHandlerFunc(),
+			Position:   shared.Before,
+		},
+	}
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:                  addManagedKeyPath,
+			Method:                http.MethodPost,
+			Handler:               ag.addManagedKey,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  removeManagedKeyPath,
+			Method:                http.MethodPost,
+			Handler:               ag.removeManagedKey,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  reloadManagedKeysPath,
+			Method:                http.MethodPost,
+			Handler:               ag.reloadManagedKeys,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  peerReputationPath,
+			Method:                http.MethodGet,
+			Handler:               ag.getPeerReputation,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  banPeerPath,
+			Method:                http.MethodPost,
+			Handler:               ag.banPeer,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  unbanPeerPath,
+			Method:                http.MethodPost,
+			Handler:               ag.unbanPeer,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  pinPeerPath,
+			Method:                http.MethodPost,
+			Handler:               ag.pinPeer,
+			AdditionalMiddlewares: authMiddleware,
+		},
+		{
+			Path:                  unpinPeerPath,
+			Method:                http.MethodPost,
+			Handler:               ag.unpinPeer,
+			AdditionalMiddlewares: authMiddleware,
+		},
+	}
+	ag.endpoints = endpoints
+
+	return ag, nil
+}
+
+// AddManagedKeyRequest represents the structure on which user input for adding a managed key will validate against
+type AddManagedKeyRequest struct {
+	PrivateKey string `json:"privateKey"`
+}
+
+// RemoveManagedKeyRequest represents the structure on which user input for removing a managed key will validate against
+type RemoveManagedKeyRequest struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// BanPeerRequest represents the structure on which user input for banning or unbanning a peer ID or an IP range will
+// validate against. A zero duration means a permanent ban
+type BanPeerRequest struct {
+	PeerID            string `json:"peerID"`
+	IPRange           string `json:"ipRange"`
+	DurationInSeconds uint64 `json:"durationInSeconds"`
+}
+
+// PinPeerRequest represents the structure on which user input for pinning or unpinning a peer ID will validate against
+type PinPeerRequest struct {
+	PeerID string `json:"peerID"`
+}
+
+// addManagedKey will add the provided private key to the set of keys managed by the node
+func (ag *adminGroup) addManagedKey(c *gin.Context) {
+	request := AddManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.PrivateKey) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyKey)
+		return
+	}
+
+	publicKey, err := ag.getFacade().AddManagedKey(request.PrivateKey)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrAddManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": publicKey})
+}
+
+// removeManagedKey will remove the provided public key from the set of keys managed by the node
+func (ag *adminGroup) removeManagedKey(c *gin.Context) {
+	request := RemoveManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.PublicKey) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyKey)
+		return
+	}
+
+	err = ag.getFacade().RemoveManagedKey(request.PublicKey)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrRemoveManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": request.PublicKey})
+}
+
+// reloadManagedKeys will apply the changes made in the all validators keys file
+func (ag *adminGroup) reloadManagedKeys(c *gin.Context) {
+	result, err := ag.getFacade().ReloadManagedKeys()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrReloadManagedKeys, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"result": result})
+}
+
+// getPeerReputation returns the banned peers, public keys and IP ranges, the pinned peers and the honesty scores
+func (ag *adminGroup) getPeerReputation(c *gin.Context) {
+	state, err := ag.getFacade().GetPeerReputationState()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetPeerReputation, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"state": state})
+}
+
+// banPeer will ban the provided peer ID or IP range
+func (ag *adminGroup) banPeer(c *gin.Context) {
+	request, ok := bindBanPeerRequest(c)
+	if !ok {
+		return
+	}
+
+	var err error
+	duration := time.Duration(request.DurationInSeconds) * time.Second
+	if len(request.PeerID) > 0 {
+		err = ag.getFacade().BanPeer(request.PeerID, duration)
+	} else {
+		err = ag.getFacade().BanIPRange(request.IPRange, duration)
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrBanPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"peerID": request.PeerID, "ipRange": request.IPRange})
+}
+
+// unbanPeer will lift the ban of the provided peer ID or IP range
+func (ag *adminGroup) unbanPeer(c *gin.Context) {
+	request, ok := bindBanPeerRequest(c)
+	if !ok {
+		return
+	}
+
+	var err error
+	if len(request.PeerID) > 0 {
+		err = ag.getFacade().UnbanPeer(request.PeerID)
+	} else {
+		err = ag.getFacade().UnbanIPRange(request.IPRange)
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrUnbanPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"peerID": request.PeerID, "ipRange": request.IPRange})
+}
+
+// pinPeer will mark the provided peer ID as trusted
+func (ag *adminGroup) pinPeer(c *gin.Context) {
+	request, ok := bindPinPeerRequest(c)
+	if !ok {
+		return
+	}
+
+	err := ag.getFacade().PinPeer(request.PeerID)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrPinPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"peerID": request.PeerID})
+}
+
+// unpinPeer will remove the provided peer ID from the trusted peers
+func (ag *adminGroup) unpinPeer(c *gin.Context) {
+	request, ok := bindPinPeerRequest(c)
+	if !ok {
+		return
+	}
+
+	err := ag.getFacade().UnpinPeer(request.PeerID)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrUnpinPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"peerID": request.PeerID})
+}
+
+func bindBanPeerRequest(c *gin.Context) (*BanPeerRequest, bool) {
+	request := &BanPeerRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return nil, false
+	}
+
+	hasPeerID := len(request.PeerID) > 0
+	hasIPRange := len(request.IPRange) > 0
+	if hasPeerID == hasIPRange {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationPeerIDOrIPRange)
+		return nil, false
+	}
+
+	return request, true
+}
+
+func bindPinPeerRequest(c *gin.Context) (*PinPeerRequest, bool) {
+	request := &PinPeerRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return nil, false
+	}
+	if len(request.PeerID) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyPeerID)
+		return nil, false
+	}
+
+	return request, true
+}
+
+func (ag *adminGroup) getFacade() adminFacadeHandler {
+	ag.mutFacade.RLock()
+	defer ag.mutFacade.RUnlock()
+
+	return ag.facade
+}
+
+// UpdateFacade will update the facade
+func (ag *adminGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(adminFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	ag.mutFacade.Lock()
+	ag.facade = castFacade
+	ag.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *adminGroup) IsInterfaceNil() bool {
+	return ag == nil
+}
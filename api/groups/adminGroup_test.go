@@ -0,0 +1,570 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminTestToken = "admin token"
+
+type addManagedKeyResponse struct {
+	Data struct {
+		PublicKey string `json:"publicKey"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type reloadManagedKeysResponse struct {
+	Data struct {
+		Result common.ManagedKeysReloadResult `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type peerReputationResponse struct {
+	Data struct {
+		State common.PeerReputationState `json:"state"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewAdminGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		ag, err := groups.NewAdminGroup(nil, middleware.NewAdminAuthenticator(adminTestToken))
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, ag)
+	})
+	t.Run("nil authenticator should error", func(t *testing.T) {
+		ag, err := groups.NewAdminGroup(&mock.FacadeStub{}, nil)
+		require.Equal(t, apiErrors.ErrNilAdminAuthenticator, err)
+		require.Nil(t, ag)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ag, err := groups.NewAdminGroup(&mock.FacadeStub{}, middleware.NewAdminAuthenticator(adminTestToken))
+		require.NoError(t, err)
+		require.NotNil(t, ag)
+	})
+}
+
+func TestAdminGroup_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing token should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				require.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/add", &groups.AddManagedKeyRequest{PrivateKey: "aa"}, "")
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("empty private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startAdminWebServer(t, &mock.FacadeStub{})
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/add", &groups.AddManagedKeyRequest{}, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidationEmptyKey.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				return "", expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/add", &groups.AddManagedKeyRequest{PrivateKey: "aa"}, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrAddManagedKey.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				assert.Equal(t, "aa", privateKeyHex)
+				return "bb", nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/add", &groups.AddManagedKeyRequest{PrivateKey: "aa"}, adminTestToken)
+		response := addManagedKeyResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "bb", response.Data.PublicKey)
+	})
+}
+
+func TestAdminGroup_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startAdminWebServer(t, &mock.FacadeStub{})
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/remove", &groups.RemoveManagedKeyRequest{}, adminTestToken)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RemoveManagedKeyCalled: func(publicKeyHex string) error {
+				return expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/remove", &groups.RemoveManagedKeyRequest{PublicKey: "bb"}, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrRemoveManagedKey.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			RemoveManagedKeyCalled: func(publicKeyHex string) error {
+				assert.Equal(t, "bb", publicKeyHex)
+				wasCalled = true
+				return nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/remove", &groups.RemoveManagedKeyRequest{PublicKey: "bb"}, adminTestToken)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAdminGroup_ReloadManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReloadManagedKeysCalled: func() (*common.ManagedKeysReloadResult, error) {
+				return nil, expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/reload", nil, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrReloadManagedKeys.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResult := common.ManagedKeysReloadResult{
+			Added:   []string{"aa"},
+			Removed: []string{"bb"},
+			Pending: []string{"cc"},
+		}
+		facade := &mock.FacadeStub{
+			ReloadManagedKeysCalled: func() (*common.ManagedKeysReloadResult, error) {
+				return &expectedResult, nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/reload", nil, adminTestToken)
+		response := reloadManagedKeysResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResult, response.Data.Result)
+	})
+}
+
+func TestAdminGroup_GetPeerReputation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing token should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startAdminWebServer(t, &mock.FacadeStub{})
+
+		req, _ := http.NewRequest(http.MethodGet, "/admin/peer-reputation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetPeerReputationStateCalled: func() (*common.PeerReputationState, error) {
+				return nil, expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupGetRequest(ws, "/admin/peer-reputation", adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetPeerReputation.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedState := common.PeerReputationState{
+			BannedPeers:      []common.PeerReputationBan{{Identifier: "pid", ExpiresAt: 100, IsManual: true}},
+			BannedPublicKeys: []common.PeerReputationBan{},
+			BannedIPRanges:   []common.PeerReputationBan{{Identifier: "10.0.0.0/8"}},
+			PinnedPeers:      []string{"pinned pid"},
+			HonestyScores:    map[string]map[string]float64{"aa": {"topic": -1.5}},
+		}
+		facade := &mock.FacadeStub{
+			GetPeerReputationStateCalled: func() (*common.PeerReputationState, error) {
+				return &expectedState, nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupGetRequest(ws, "/admin/peer-reputation", adminTestToken)
+		response := peerReputationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedState, response.Data.State)
+	})
+}
+
+func TestAdminGroup_BanPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing or both identifiers should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startAdminWebServer(t, &mock.FacadeStub{})
+
+		requests := []*groups.BanPeerRequest{
+			{},
+			{PeerID: "pid", IPRange: "10.0.0.0/8"},
+		}
+		for _, request := range requests {
+			resp := doAdminGroupRequest(ws, "/admin/peer-reputation/ban", request, adminTestToken)
+			response := shared.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.Contains(t, response.Error, apiErrors.ErrValidationPeerIDOrIPRange.Error())
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			BanPeerCalled: func(peerID string, duration time.Duration) error {
+				return expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/ban", &groups.BanPeerRequest{PeerID: "pid"}, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBanPeer.Error())
+	})
+	t.Run("should ban peer ID", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			BanPeerCalled: func(peerID string, duration time.Duration) error {
+				assert.Equal(t, "pid", peerID)
+				assert.Equal(t, time.Minute, duration)
+				wasCalled = true
+				return nil
+			},
+			BanIPRangeCalled: func(ipRange string, duration time.Duration) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/ban", &groups.BanPeerRequest{PeerID: "pid", DurationInSeconds: 60}, adminTestToken)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+	t.Run("should ban IP range", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			BanIPRangeCalled: func(ipRange string, duration time.Duration) error {
+				assert.Equal(t, "10.0.0.0/8", ipRange)
+				assert.Equal(t, time.Duration(0), duration)
+				wasCalled = true
+				return nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/ban", &groups.BanPeerRequest{IPRange: "10.0.0.0/8"}, adminTestToken)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAdminGroup_UnbanPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			UnbanIPRangeCalled: func(ipRange string) error {
+				return expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/unban", &groups.BanPeerRequest{IPRange: "10.0.0.0/8"}, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrUnbanPeer.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			UnbanPeerCalled: func(peerID string) error {
+				assert.Equal(t, "pid", peerID)
+				wasCalled = true
+				return nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/unban", &groups.BanPeerRequest{PeerID: "pid"}, adminTestToken)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestAdminGroup_PinAndUnpinPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty peer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startAdminWebServer(t, &mock.FacadeStub{})
+
+		for _, path := range []string{"/admin/peer-reputation/pin", "/admin/peer-reputation/unpin"} {
+			resp := doAdminGroupRequest(ws, path, &groups.PinPeerRequest{}, adminTestToken)
+			response := shared.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.Contains(t, response.Error, apiErrors.ErrValidationEmptyPeerID.Error())
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			PinPeerCalled: func(peerID string) error {
+				return expectedErr
+			},
+			UnpinPeerCalled: func(peerID string) error {
+				return expectedErr
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/pin", &groups.PinPeerRequest{PeerID: "pid"}, adminTestToken)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrPinPeer.Error())
+
+		resp = doAdminGroupRequest(ws, "/admin/peer-reputation/unpin", &groups.PinPeerRequest{PeerID: "pid"}, adminTestToken)
+		response = shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrUnpinPeer.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pinned := make(map[string]bool)
+		facade := &mock.FacadeStub{
+			PinPeerCalled: func(peerID string) error {
+				pinned[peerID] = true
+				return nil
+			},
+			UnpinPeerCalled: func(peerID string) error {
+				delete(pinned, peerID)
+				return nil
+			},
+		}
+		ws := startAdminWebServer(t, facade)
+
+		resp := doAdminGroupRequest(ws, "/admin/peer-reputation/pin", &groups.PinPeerRequest{PeerID: "pid"}, adminTestToken)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, pinned["pid"])
+
+		resp = doAdminGroupRequest(ws, "/admin/peer-reputation/unpin", &groups.PinPeerRequest{PeerID: "pid"}, adminTestToken)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.False(t, pinned["pid"])
+	})
+}
+
+func TestAdminGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{}, middleware.NewAdminAuthenticator(adminTestToken))
+		require.NoError(t, err)
+
+		err = adminGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{}, middleware.NewAdminAuthenticator(adminTestToken))
+		require.NoError(t, err)
+
+		err = adminGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{}, middleware.NewAdminAuthenticator(adminTestToken))
+		require.NoError(t, err)
+
+		newFacade := &mock.FacadeStub{
+			ReloadManagedKeysCalled: func() (*common.ManagedKeysReloadResult, error) {
+				return nil, expectedErr
+			},
+		}
+		err = adminGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(adminGroup, "admin", getAdminRoutesConfig())
+		resp := doAdminGroupRequest(ws, "/admin/managed-keys/reload", nil, adminTestToken)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestAdminGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	adminGroup, _ := groups.NewAdminGroup(nil, nil)
+	require.True(t, adminGroup.IsInterfaceNil())
+
+	adminGroup, _ = groups.NewAdminGroup(&mock.FacadeStub{}, middleware.NewAdminAuthenticator(adminTestToken))
+	require.False(t, adminGroup.IsInterfaceNil())
+}
+
+func startAdminWebServer(tb testing.TB, facade *mock.FacadeStub) http.Handler {
+	adminGroup, err := groups.NewAdminGroup(facade, middleware.NewAdminAuthenticator(adminTestToken))
+	require.NoError(tb, err)
+
+	return startWebServer(adminGroup, "admin", getAdminRoutesConfig())
+}
+
+func doAdminGroupRequest(ws http.Handler, path string, request interface{}, token string) *httptest.ResponseRecorder {
+	body := make([]byte, 0)
+	if request != nil {
+		body, _ = json.Marshal(request)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(body))
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func doAdminGroupGetRequest(ws http.Handler, path string, token string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func getAdminRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"admin": {
+				Routes: []config.RouteConfig{
+					{Name: "/managed-keys/add", Open: true},
+					{Name: "/managed-keys/remove", Open: true},
+					{Name: "/managed-keys/reload", Open: true},
+					{Name: "/peer-reputation", Open: true},
+					{Name: "/peer-reputation/ban", Open: true},
+					{Name: "/peer-reputation/unban", Open: true},
+					{Name: "/peer-reputation/pin", Open: true},
+					{Name: "/peer-reputation/unpin", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	governanceProposalsPath = "/proposals"
+	governanceProposalPath  = "/proposal/:nonce"
+	governanceVoterPath     = "/voter/:address"
+
+	urlParamGovernanceStatus = "status"
+)
+
+// governanceFacadeHandler defines the methods to be implemented by a facade for governance requests
+type governanceFacadeHandler interface {
+	GetGovernanceProposals(status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVoter(address string) (*common.GovernanceVoterAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+type governanceGroup struct {
+	*baseGroup
+	facade    governanceFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewGovernanceGroup returns a new instance of governanceGroup
+func NewGovernanceGroup(facade governanceFacadeHandler) (*governanceGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for governance group", errors.ErrNilFacadeHandler)
+	}
+
+	gg := &governanceGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    governanceProposalsPath,
+			Method:  http.MethodGet,
+			Handler: gg.getProposals,
+		},
+		{
+			Path:    governanceProposalPath,
+			Method:  http.MethodGet,
+			Handler: gg.getProposal,
+		},
+		{
+			Path:    governanceVoterPath,
+			Method:  http.MethodGet,
+			Handler: gg.getVoter,
+		},
+	}
+	gg.endpoints = endpoints
+
+	return gg, nil
+}
+
+// getProposals will return the governance proposals, optionally filtered by their status (active or closed)
+func (gg *governanceGroup) getProposals(c *gin.Context) {
+	status := c.Request.URL.Query().Get(urlParamGovernanceStatus)
+	if !isValidGovernanceProposalStatus(status) {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrInvalidGovernanceProposalStatus)
+		return
+	}
+
+	proposals, err := gg.getFacade().GetGovernanceProposals(status)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposals, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proposals": proposals})
+}
+
+// getProposal will return the governance proposal with the provided nonce, including the delegated votes breakdown
+func (gg *governanceGroup) getProposal(c *gin.Context) {
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrNonceInvalid)
+		return
+	}
+
+	proposal, err := gg.getFacade().GetGovernanceProposal(nonce)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposal, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proposal": proposal})
+}
+
+// getVoter will return the voting power and the votes history of the provided address
+func (gg *governanceGroup) getVoter(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	voter, err := gg.getFacade().GetGovernanceVoter(address)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceVoter, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"voter": voter})
+}
+
+func isValidGovernanceProposalStatus(status string) bool {
+	switch status {
+	case "", common.GovernanceProposalActive, common.GovernanceProposalClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (gg *governanceGroup) getFacade() governanceFacadeHandler {
+	gg.mutFacade.RLock()
+	defer gg.mutFacade.RUnlock()
+
+	return gg.facade
+}
+
+// UpdateFacade will update the facade
+func (gg *governanceGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(governanceFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	gg.mutFacade.Lock()
+	gg.facade = castFacade
+	gg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gg *governanceGroup) IsInterfaceNil() bool {
+	return gg == nil
+}
@@ -0,0 +1,300 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type governanceProposalsResponse struct {
+	Data struct {
+		Proposals []*common.GovernanceProposalAPIResponse `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalResponse struct {
+	Data struct {
+		Proposal *common.GovernanceProposalAPIResponse `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceVoterResponse struct {
+	Data struct {
+		Voter *common.GovernanceVoterAPIResponse `json:"voter"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewGovernanceGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		gg, err := groups.NewGovernanceGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, gg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		gg, err := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, gg)
+	})
+}
+
+func TestGovernanceGroup_getProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid status should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposals?status=pending", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidGovernanceProposalStatus.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func(status string) ([]*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposals.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proposals := []*common.GovernanceProposalAPIResponse{
+			{
+				Nonce:  1,
+				Status: common.GovernanceProposalActive,
+				Votes:  &common.GovernanceVotesAPIResponse{Yes: "10", No: "0", Veto: "0", Abstain: "0", Total: "10"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func(status string) ([]*common.GovernanceProposalAPIResponse, error) {
+				assert.Equal(t, common.GovernanceProposalActive, status)
+				return proposals, nil
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposals?status=active", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, proposals, response.Data.Proposals)
+	})
+}
+
+func TestGovernanceGroup_getProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrNonceInvalid.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposal.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proposal := &common.GovernanceProposalAPIResponse{
+			Nonce:  7,
+			Status: common.GovernanceProposalClosed,
+			Passed: true,
+			DelegatedVotes: []*common.GovernanceDelegatedVotesAPIResponse{
+				{DelegationContract: "erd1qqq", UsedStake: "5", UsedPower: "5", TotalStake: "10", TotalPower: "10"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+				assert.Equal(t, uint64(7), nonce)
+				return proposal, nil
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/7", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, proposal, response.Data.Proposal)
+	})
+}
+
+func TestGovernanceGroup_getVoter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetGovernanceVoterCalled: func(address string) (*common.GovernanceVoterAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/voter/erd1addr", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGovernanceVoter.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		voter := &common.GovernanceVoterAPIResponse{
+			Address:        "erd1addr",
+			VotingPower:    "1000",
+			DirectVotes:    []uint64{1, 3},
+			DelegatedVotes: []uint64{2},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceVoterCalled: func(address string) (*common.GovernanceVoterAPIResponse, error) {
+				assert.Equal(t, "erd1addr", address)
+				return voter, nil
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/voter/erd1addr", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceVoterResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, voter, response.Data.Voter)
+	})
+}
+
+func TestGovernanceGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		err := gg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		err := gg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		err := gg.UpdateFacade(&mock.FacadeStub{})
+		require.NoError(t, err)
+	})
+}
+
+func TestGovernanceGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGovernanceGroup(nil)
+	require.True(t, gg.IsInterfaceNil())
+
+	gg, _ = groups.NewGovernanceGroup(&mock.FacadeStub{})
+	require.False(t, gg.IsInterfaceNil())
+}
+
+func getGovernanceRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"governance": {
+				Routes: []config.RouteConfig{
+					{Name: "/proposals", Open: true},
+					{Name: "/proposal/:nonce", Open: true},
+					{Name: "/voter/:address", Open: true},
+				},
+			},
+		},
+	}
+}
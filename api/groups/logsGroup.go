@@ -0,0 +1,143 @@
+package groups
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	queryEventsPath      = "/query"
+	urlParamAddress      = "address"
+	urlParamIdentifier   = "identifier"
+	urlParamTopic        = "topic"
+	urlParamFromNonce    = "fromNonce"
+	urlParamToNonce      = "toNonce"
+	defaultQueryLogsSize = 20
+)
+
+// logsFacadeHandler defines the methods to be implemented by a facade for handling logs requests
+type logsFacadeHandler interface {
+	QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", errors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    queryEventsPath,
+			Method:  http.MethodGet,
+			Handler: lg.queryEvents,
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// queryEvents returns the indexed log events matching the provided emitter address, identifier and first topic
+func (lg *logsGroup) queryEvents(c *gin.Context) {
+	options, err := extractEventsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrQueryEvents, err)
+		return
+	}
+
+	events, err := lg.getFacade().QueryEvents(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrQueryEvents, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"events": events})
+}
+
+func extractEventsQueryOptions(c *gin.Context) (common.EventsQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !toNonce.HasValue {
+		toNonce.Value = math.MaxUint64
+	}
+
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+
+	size, err := parseUint64UrlParam(c, urlParamSize)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !size.HasValue {
+		size.Value = defaultQueryLogsSize
+	}
+
+	return common.EventsQueryOptions{
+		Address:    c.Query(urlParamAddress),
+		Identifier: c.Query(urlParamIdentifier),
+		Topic:      c.Query(urlParamTopic),
+		FromNonce:  fromNonce.Value,
+		ToNonce:    toNonce.Value,
+		From:       from.Value,
+		Size:       size.Value,
+	}, nil
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
@@ -0,0 +1,209 @@
+package groups_test
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryEventsResponse struct {
+	Data struct {
+		Events []*common.IndexedEventAPI `json:"events"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, lg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, lg)
+	})
+}
+
+func TestLogsGroup_queryEvents(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := queryEvents(t, &mock.FacadeStub{}, "/logs/query?identifier=swap&fromNonce=abc")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, apiErrors.ErrQueryEvents.Error())
+	})
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := queryEvents(t, &mock.FacadeStub{}, "/logs/query?identifier=swap&size=-1")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, apiErrors.ErrQueryEvents.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response, code := queryEvents(t, facade, "/logs/query?identifier=swap")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, formatExpectedErr(apiErrors.ErrQueryEvents, expectedErr), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvents := []*common.IndexedEventAPI{
+			{
+				TxHash:     "aabb",
+				BlockNonce: 37,
+				Address:    "erd1contract",
+				Identifier: "swap",
+				Topics:     [][]byte{[]byte("topic")},
+				Data:       []byte("data"),
+			},
+		}
+		var providedOptions common.EventsQueryOptions
+		facade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+				providedOptions = options
+				return expectedEvents, nil
+			},
+		}
+
+		response, code := queryEvents(t, facade, "/logs/query?address=erd1contract&identifier=swap&topic=aabb&fromNonce=5&toNonce=10&from=2&size=3")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, expectedEvents, response.Data.Events)
+		assert.Equal(t, common.EventsQueryOptions{
+			Address:    "erd1contract",
+			Identifier: "swap",
+			Topic:      "aabb",
+			FromNonce:  5,
+			ToNonce:    10,
+			From:       2,
+			Size:       3,
+		}, providedOptions)
+	})
+	t.Run("should use the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.EventsQueryOptions
+		facade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+				providedOptions = options
+				return make([]*common.IndexedEventAPI, 0), nil
+			},
+		}
+
+		_, code := queryEvents(t, facade, "/logs/query?identifier=swap")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, common.EventsQueryOptions{
+			Identifier: "swap",
+			ToNonce:    math.MaxUint64,
+			Size:       20,
+		}, providedOptions)
+	})
+}
+
+func TestLogsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = lg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = lg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		newFacade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+				return nil, expectedErr
+			},
+		}
+		err = lg.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+		req, _ := http.NewRequest("GET", "/logs/query?identifier=swap", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestLogsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	lg, _ := groups.NewLogsGroup(nil)
+	require.True(t, lg.IsInterfaceNil())
+
+	lg, _ = groups.NewLogsGroup(&mock.FacadeStub{})
+	require.False(t, lg.IsInterfaceNil())
+}
+
+func queryEvents(t *testing.T, facade *mock.FacadeStub, url string) (*queryEventsResponse, int) {
+	lg, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &queryEventsResponse{}
+	loadResponse(resp.Body, response)
+
+	return response, resp.Code
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
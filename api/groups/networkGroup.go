@@ -27,12 +27,21 @@ const (
 	getSFTsPath            = "/esdt/semi-fungible-tokens"
 	getNFTsPath            = "/esdt/non-fungible-tokens"
 	getESDTSupplyPath      = "/esdt/supply/:token"
+	getESDTHoldersPath     = "/esdt/holders/:token"
+	getESDTVolumesPath     = "/esdt/volumes/:token"
 	directStakedInfoPath   = "/direct-staked-info"
 	delegatedInfoPath      = "/delegated-info"
 	ratingsPath            = "/ratings"
 	genesisNodesConfigPath = "/genesis-nodes"
 	genesisBalances        = "/genesis-balances"
 	gasConfigPath          = "/gas-configs"
+	gasConfigDiffPath      = "/gas-configs/diff"
+
+	urlParamFromEpoch = "fromEpoch"
+	urlParamToEpoch   = "toEpoch"
+
+	// maxVolumesEpochs is the maximum number of epochs that can be fetched at once on the token volumes endpoint
+	maxVolumesEpochs = 100
 )
 
 // networkFacadeHandler defines the methods to be implemented by a facade for handling network requests
@@ -43,9 +52,12 @@ type networkFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error)
+	GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
+	GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -134,6 +146,16 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
 		},
+		{
+			Path:    getESDTHoldersPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTTokenHolders,
+		},
+		{
+			Path:    getESDTVolumesPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTTokenVolumes,
+		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
@@ -154,6 +176,11 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getGasConfig,
 		},
+		{
+			Path:    gasConfigDiffPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGasConfigDiff,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -352,6 +379,71 @@ func (ng *networkGroup) delegatedInfo(c *gin.Context) {
 	)
 }
 
+// getESDTTokenHolders returns the number of holders and the top holders of the provided token
+func (ng *networkGroup) getESDTTokenHolders(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTHolders, errors.ErrBadUrlParams)
+		return
+	}
+
+	holders, err := ng.getFacade().GetTokenHolders(token)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetESDTHolders, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, holders)
+}
+
+// getESDTTokenVolumes returns the minted, burned and transferred amounts of the provided token for each epoch of the
+// requested interval. The toEpoch parameter defaults to fromEpoch
+func (ng *networkGroup) getESDTTokenVolumes(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTVolumes, errors.ErrBadUrlParams)
+		return
+	}
+
+	fromEpoch, toEpoch, err := extractEpochsInterval(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTVolumes, err)
+		return
+	}
+
+	volumes, err := ng.getFacade().GetTokenVolumes(token, fromEpoch, toEpoch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetESDTVolumes, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"volumes": volumes})
+}
+
+func extractEpochsInterval(c *gin.Context) (uint32, uint32, error) {
+	fromEpoch, err := parseUint32UrlParam(c, urlParamFromEpoch)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !fromEpoch.HasValue {
+		return 0, 0, fmt.Errorf("%w: missing %s", errors.ErrBadUrlParams, urlParamFromEpoch)
+	}
+
+	toEpoch, err := parseUint32UrlParam(c, urlParamToEpoch)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !toEpoch.HasValue {
+		toEpoch = fromEpoch
+	}
+
+	if toEpoch.Value < fromEpoch.Value || toEpoch.Value-fromEpoch.Value >= maxVolumesEpochs {
+		return 0, 0, fmt.Errorf("%w: the epochs interval should hold at most %d epochs", errors.ErrBadUrlParams, maxVolumesEpochs)
+	}
+
+	return fromEpoch.Value, toEpoch.Value, nil
+}
+
 func (ng *networkGroup) getESDTTokenSupply(c *gin.Context) {
 	token := c.Param("token")
 	if token == "" {
@@ -477,6 +569,34 @@ func (ng *networkGroup) getGasConfig(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"gasConfigs": gc}, "", shared.ReturnCodeSuccess)
 }
 
+// getGasConfigDiff returns the gas schedule entries which differ between the fromEpoch and the toEpoch, according to
+// the gas schedule versions and the governance changes. Both epochs are mandatory
+func (ng *networkGroup) getGasConfigDiff(c *gin.Context) {
+	fromEpoch, err := parseUint32UrlParam(c, urlParamFromEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetGasConfigsDiff, err)
+		return
+	}
+	toEpoch, err := parseUint32UrlParam(c, urlParamToEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetGasConfigsDiff, err)
+		return
+	}
+	if !fromEpoch.HasValue || !toEpoch.HasValue {
+		shared.RespondWithValidationError(c, errors.ErrGetGasConfigsDiff,
+			fmt.Errorf("%w: both %s and %s are required", errors.ErrBadUrlParams, urlParamFromEpoch, urlParamToEpoch))
+		return
+	}
+
+	diff, err := ng.getFacade().GetGasConfigsDiff(fromEpoch.Value, toEpoch.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGasConfigsDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"gasConfigsDiff": diff})
+}
+
 func (ng *networkGroup) getFacade() networkFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
@@ -90,6 +90,14 @@ type gasConfigsData struct {
 	Configs groups.GasConfig `json:"gasConfigs"`
 }
 
+type gasConfigsDiffResponse struct {
+	Data struct {
+		Diff *common.GasScheduleDiffAPIResponse `json:"gasConfigsDiff"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 func TestNetworkConfigMetrics_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -718,6 +726,134 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	}}, respSupply)
 }
 
+func TestGetESDTTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTokenHoldersCalled: func(token string) (*common.ESDTHoldersAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/mytoken-aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		type holdersResponse struct {
+			Data *common.ESDTHoldersAPIResponse `json:"data"`
+		}
+
+		expectedHolders := &common.ESDTHoldersAPIResponse{
+			NumHolders: 7,
+			TopHolders: []*common.ESDTHolderAPIResponse{{Address: "erd1", Balance: "100"}},
+		}
+		facade := mock.FacadeStub{
+			GetTokenHoldersCalled: func(token string) (*common.ESDTHoldersAPIResponse, error) {
+				assert.Equal(t, "mytoken-aabb", token)
+				return expectedHolders, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/mytoken-aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &holdersResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedHolders, response.Data)
+	})
+}
+
+func TestGetESDTTokenVolumes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epochs interval should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTokenVolumesCalled: func(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		for _, query := range []string{"", "?fromEpoch=a", "?fromEpoch=5&toEpoch=4", "?fromEpoch=0&toEpoch=100"} {
+			req, _ := http.NewRequest("GET", "/network/esdt/volumes/mytoken-aabb"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := &shared.GenericAPIResponse{}
+			loadResponse(resp.Body, response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTVolumes.Error()))
+		}
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		type volumesResponse struct {
+			Data struct {
+				Volumes []*common.ESDTVolumeAPIResponse `json:"volumes"`
+			} `json:"data"`
+		}
+
+		expectedVolumes := []*common.ESDTVolumeAPIResponse{
+			{Epoch: 3, Minted: "10", Burned: "0", Transferred: "5"},
+			{Epoch: 4, Minted: "0", Burned: "1", Transferred: "0"},
+		}
+		facade := mock.FacadeStub{
+			GetTokenVolumesCalled: func(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+				assert.Equal(t, uint32(3), fromEpoch)
+				assert.Equal(t, uint32(4), toEpoch)
+				return expectedVolumes, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/volumes/mytoken-aabb?fromEpoch=3&toEpoch=4", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &volumesResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedVolumes, response.Data.Volumes)
+	})
+}
+
 func TestGetGenesisNodes(t *testing.T) {
 	t.Parallel()
 
@@ -903,6 +1039,96 @@ func TestGetGasConfigs(t *testing.T) {
 	})
 }
 
+func TestGetGasConfigsDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should fail", testNetworkGroupGasConfigsDiffErrorScenario("/network/gas-configs/diff?fromEpoch=a&toEpoch=2", http.StatusBadRequest))
+	t.Run("missing from epoch should fail", testNetworkGroupGasConfigsDiffErrorScenario("/network/gas-configs/diff?toEpoch=2", http.StatusBadRequest))
+	t.Run("missing to epoch should fail", testNetworkGroupGasConfigsDiffErrorScenario("/network/gas-configs/diff?fromEpoch=1", http.StatusBadRequest))
+	t.Run("facade error should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGasConfigsDiffCalled: func(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/gas-configs/diff?fromEpoch=1&toEpoch=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := gasConfigsDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGasConfigsDiff.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &common.GasScheduleDiffAPIResponse{
+			FromEpoch: 1,
+			ToEpoch:   2,
+			Changes: []*common.GasScheduleEntryChangeAPIResponse{
+				{Section: common.BuiltInCost, Name: "ESDTTransfer", OldValue: 200, NewValue: 300, Change: common.GasScheduleEntryModified},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGasConfigsDiffCalled: func(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+				require.Equal(t, uint32(1), fromEpoch)
+				require.Equal(t, uint32(2), toEpoch)
+				return expectedDiff, nil
+			},
+		}
+
+		response := &gasConfigsDiffResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/gas-configs/diff?fromEpoch=1&toEpoch=2",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedDiff, response.Data.Diff)
+	})
+}
+
+func testNetworkGroupGasConfigsDiffErrorScenario(url string, expectedCode int) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGasConfigsDiffCalled: func(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := gasConfigsDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, expectedCode, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGasConfigsDiff.Error()))
+	}
+}
+
 func TestNetworkGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1016,10 +1242,13 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
 					{Name: "/esdt/supply/:token", Open: true},
+					{Name: "/esdt/holders/:token", Open: true},
+					{Name: "/esdt/volumes/:token", Open: true},
 					{Name: "/genesis-nodes", Open: true},
 					{Name: "/genesis-balances", Open: true},
 					{Name: "/ratings", Open: true},
 					{Name: "/gas-configs", Open: true},
+					{Name: "/gas-configs/diff", Open: true},
 				},
 			},
 		},
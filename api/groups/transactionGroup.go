@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/node/external"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 )
 
 const (
@@ -26,11 +27,15 @@ const (
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	getTransactionLifecycleEndpoint  = "/transaction/:hash/lifecycle"
+	getTransactionProofEndpoint      = "/transaction/:hash/proof"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionLifecyclePath      = "/:txhash/lifecycle"
+	getTransactionProofPath          = "/:txhash/proof"
 	getTransactionsPool              = "/pool"
 
 	queryParamWithResults    = "withResults"
@@ -39,6 +44,7 @@ const (
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
+	queryParamGasBreakdown   = "withGasBreakdown"
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -53,7 +59,10 @@ type transactionFacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error)
+	GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -137,6 +146,28 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionLifecyclePath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionLifecycle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionLifecycleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getTransactionProofPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -483,10 +514,105 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	)
 }
 
-// computeTransactionGasLimit returns how many gas units a transaction wil consume
+// getTransactionLifecycle returns the processing stages of a transaction and of its smart contract results
+func (tg *transactionGroup) getTransactionLifecycle(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	lifecycle, err := tg.getFacade().GetTransactionLifecycle(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionLifecycle")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionLifecycle.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"lifecycle": lifecycle},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionProof returns the proof that a transaction and its smart contract results were included in signed and
+// notarized blocks
+func (tg *transactionGroup) getTransactionProof(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	proof, err := tg.getFacade().GetTransactionProof(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionProof")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// computeTransactionGasLimit returns how many gas units a transaction wil consume. If requested, the breakdown of the
+// consumed gas is returned as well
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
+	withGasBreakdown, err := getQueryParamGasBreakdown(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	var ftx transaction.FrontendTransaction
-	err := c.ShouldBindJSON(&ftx)
+	err = c.ShouldBindJSON(&ftx)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -532,7 +658,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	}
 
 	start = time.Now()
-	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx)
+	cost, err := tg.computeTransactionCost(tx, withGasBreakdown)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ComputeTransactionGasLimit")
 	if err != nil {
 		c.JSON(
@@ -556,6 +682,14 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+func (tg *transactionGroup) computeTransactionCost(tx *transaction.Transaction, withGasBreakdown bool) (interface{}, error) {
+	if withGasBreakdown {
+		return tg.getFacade().ComputeTransactionCostWithGasBreakdown(tx)
+	}
+
+	return tg.getFacade().ComputeTransactionGasLimit(tx)
+}
+
 // getTransactionsPool returns the transactions details in the pool
 func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	// extract and validate query parameters
@@ -777,6 +911,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamGasBreakdown(c *gin.Context) (bool, error) {
+	withGasBreakdownStr := c.Request.URL.Query().Get(queryParamGasBreakdown)
+	if withGasBreakdownStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withGasBreakdownStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -22,6 +22,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/node/external"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -80,7 +81,8 @@ type sendSingleTxResponse struct {
 }
 
 type transactionCostResponseData struct {
-	Cost uint64 `json:"txGasUnits"`
+	Cost         uint64                  `json:"txGasUnits"`
+	GasBreakdown *txSimData.GasBreakdown `json:"gasBreakdown"`
 }
 
 type transactionCostResponse struct {
@@ -129,6 +131,26 @@ type txPoolNonceGapsForSenderResponse struct {
 	Code  string                               `json:"code"`
 }
 
+type transactionLifecycleResponseData struct {
+	Lifecycle common.TransactionLifecycleAPIResponse `json:"lifecycle"`
+}
+
+type transactionLifecycleResponse struct {
+	Data  transactionLifecycleResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+type transactionProofResponseData struct {
+	Proof receiptProof.ReceiptProof `json:"proof"`
+}
+
+type transactionProofResponse struct {
+	Data  transactionProofResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
 var (
 	sender      = "sender"
 	receiver    = "receiver"
@@ -236,6 +258,115 @@ func TestTransactionsGroup_getTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionsGroup_getTransactionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/eeee/lifecycle", nil))
+	t.Run("facade returns error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionLifecycleCalled: func(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/lifecycle",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			apiErrors.ErrGetTransactionLifecycle,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedLifecycle := common.TransactionLifecycleAPIResponse{
+			Hash:      hexTxHash,
+			Completed: true,
+			Stages: []*common.TransactionLifecycleStageAPIResponse{
+				{Stage: "sourceShardInclusion", ShardID: 1, Completed: true, BlockNonce: 10, BlockHash: "aa"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionLifecycleCalled: func(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+				require.Equal(t, hexTxHash, txHash)
+				return &expectedLifecycle, nil
+			},
+		}
+
+		response := &transactionLifecycleResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/lifecycle",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedLifecycle, response.Data.Lifecycle)
+	})
+}
+
+func TestTransactionsGroup_getTransactionProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/eeee/proof", nil))
+	t.Run("facade returns error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionProofCalled: func(txHash string) (*receiptProof.ReceiptProof, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/proof",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			apiErrors.ErrGetTransactionProof,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProof := receiptProof.ReceiptProof{
+			Transaction: &receiptProof.TransactionProof{
+				TxHash:      []byte("hash"),
+				Transaction: []byte("tx"),
+				ShardID:     1,
+				Miniblock:   []byte("miniblock"),
+				Header:      []byte("header"),
+				MetaBlock:   []byte("metablock"),
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionProofCalled: func(txHash string) (*receiptProof.ReceiptProof, error) {
+				require.Equal(t, hexTxHash, txHash)
+				return &expectedProof, nil
+			},
+		}
+
+		response := &transactionProofResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/proof",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedProof, response.Data.Proof)
+	})
+}
+
 func TestTransactionGroup_sendTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -560,6 +691,91 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			response,
 		)
 		assert.Equal(t, expectedGasLimit, response.Data.Cost)
+		assert.Nil(t, response.Data.GasBreakdown)
+	})
+	t.Run("invalid gas breakdown param should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/cost?withGasBreakdown=not-a-bool",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			apiErrors.ErrValidation,
+		)
+	})
+	t.Run("ComputeTransactionCostWithGasBreakdown error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ComputeTransactionCostWithGasBreakdownCalled: func(tx *dataTx.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/cost?withGasBreakdown=true",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work with gas breakdown", func(t *testing.T) {
+		t.Parallel()
+
+		expectedGasBreakdown := &txSimData.GasBreakdown{
+			BaseCost:               50000,
+			BuiltInFunctions:       map[string]uint64{"ESDTTransfer": 200},
+			SmartContractExecution: 1000,
+			APIFunctions: map[string]*txSimData.APIFunctionGas{
+				"bigIntAdd": {GasUsed: 300, NumCalls: 3},
+			},
+			ExecutionNotInAPIFunctions: 700,
+			GasTraceAvailable:          true,
+			OpcodesBreakdownMessage:    "the wasm executor only meters the total gas of the opcodes",
+		}
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+			ComputeTransactionCostWithGasBreakdownCalled: func(tx *dataTx.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+				return &txSimData.CostResponseWithGasBreakdown{
+					CostResponse: &dataTx.CostResponse{GasUnits: 51200},
+					GasBreakdown: expectedGasBreakdown,
+				}, nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal(dataTx.FrontendTransaction{})
+
+		response := &transactionCostResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/cost?withGasBreakdown=true",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, uint64(51200), response.Data.Cost)
+		assert.Equal(t, expectedGasBreakdown, response.Data.GasBreakdown)
 	})
 }
 
@@ -1121,6 +1337,8 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/pool", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/:txhash/lifecycle", Open: true},
+					{Name: "/:txhash/proof", Open: true},
 					{Name: "/simulate", Open: true},
 				},
 			},
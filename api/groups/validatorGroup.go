@@ -14,14 +14,21 @@ import (
 )
 
 const (
-	statisticsPath = "/statistics"
-	auctionPath    = "/auction"
+	statisticsPath        = "/statistics"
+	auctionPath           = "/auction"
+	auctionSimulationPath = "/auction/simulate"
+	rewardsPath           = "/rewards/:epoch"
+	scorecardPath         = "/:bls/scorecard"
+	urlParamEpochs        = "epochs"
 )
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
+	GetRewardsBreakdown(epoch uint32, address string) (*common.RewardsBreakdown, error)
 	IsInterfaceNil() bool
 }
 
@@ -53,6 +60,21 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.auction,
 		},
+		{
+			Path:    auctionSimulationPath,
+			Method:  http.MethodPost,
+			Handler: ng.auctionSimulation,
+		},
+		{
+			Path:    rewardsPath,
+			Method:  http.MethodGet,
+			Handler: ng.rewards,
+		},
+		{
+			Path:    scorecardPath,
+			Method:  http.MethodGet,
+			Handler: ng.scorecard,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -109,6 +131,83 @@ func (vg *validatorGroup) auction(c *gin.Context) {
 	)
 }
 
+// auctionSimulation will return the outcome of the auction list selection, after applying the hypothetical top-up
+// and new nodes of an owner over the current staking data
+func (vg *validatorGroup) auctionSimulation(c *gin.Context) {
+	args := &common.AuctionSimulationArgs{}
+	err := c.ShouldBindJSON(args)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	simulation, err := vg.getFacade().AuctionSimulationApi(args)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"simulation": simulation},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// rewards will return how the rewards paid at the start of the provided epoch were computed. The address query
+// parameter selects a single reward address, also returning how the rewards of a delegation contract were split between
+// its owner and its delegators
+func (vg *validatorGroup) rewards(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetRewardsBreakdown, errors.ErrInvalidEpoch)
+		return
+	}
+
+	breakdown, err := vg.getFacade().GetRewardsBreakdown(epoch, c.Query(urlParamAddress))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetRewardsBreakdown, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rewards": breakdown})
+}
+
+// scorecard will return the performance history of a validator for the last ended epochs: proposed and missed blocks,
+// signed and missed signatures, rating evolution, jail events and shard changes
+func (vg *validatorGroup) scorecard(c *gin.Context) {
+	numEpochs, err := parseUint32UrlParam(c, urlParamEpochs)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetValidatorScorecard, errors.ErrBadUrlParams)
+		return
+	}
+
+	scorecard, err := vg.getFacade().ValidatorScorecardApi(c.Param("bls"), numEpochs.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetValidatorScorecard, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"scorecard": scorecard})
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -47,6 +48,27 @@ type auctionListResponse struct {
 	Error string
 }
 
+type auctionSimulationResponse struct {
+	Data struct {
+		Result *common.AuctionSimulationAPIResponse `json:"simulation"`
+	} `json:"data"`
+	Error string
+}
+
+type rewardsBreakdownResponse struct {
+	Data struct {
+		Result *common.RewardsBreakdown `json:"rewards"`
+	} `json:"data"`
+	Error string
+}
+
+type validatorScorecardResponse struct {
+	Data struct {
+		Result *common.ValidatorScorecard `json:"scorecard"`
+	} `json:"data"`
+	Error string
+}
+
 func TestValidatorStatistics_ErrorWhenFacadeFails(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +278,249 @@ func TestAuctionList_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Data.Result, auctionListToReturn)
 }
 
+func TestAuctionSimulation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request body should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, _ := groups.NewValidatorGroup(&mock.FacadeStub{})
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := auctionSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errStr := "error in facade"
+		facade := &mock.FacadeStub{
+			AuctionSimulationHandler: func(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+				return nil, errors.New(errStr)
+			},
+		}
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBufferString(`{"owner":"erd1owner"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := auctionSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, errStr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		simulationToReturn := &common.AuctionSimulationAPIResponse{
+			AuctionList: []*common.AuctionListValidatorAPIResponse{
+				{
+					Owner:          "erd1owner",
+					NumStakedNodes: 2,
+					TotalTopUp:     "1000",
+					TopUpPerNode:   "500",
+					QualifiedTopUp: "1000",
+					Nodes:          []*common.AuctionNode{{BlsKey: "bls", Qualified: true, Simulated: true}},
+				},
+			},
+			ThresholdTopUp: "1000",
+			MarginalNode: &common.AuctionMarginalNode{
+				Owner:          "erd1owner",
+				BlsKey:         "bls",
+				QualifiedTopUp: "1000",
+			},
+		}
+		facade := &mock.FacadeStub{
+			AuctionSimulationHandler: func(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+				assert.Equal(t, &common.AuctionSimulationArgs{
+					Owner:           "erd1owner",
+					AdditionalTopUp: "1000",
+					AdditionalNodes: 1,
+				}, args)
+				return simulationToReturn, nil
+			},
+		}
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		body := `{"owner":"erd1owner","topUp":"1000","numNodes":1}`
+		req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := auctionSimulationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, simulationToReturn, response.Data.Result)
+	})
+}
+
+func TestRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, _ := groups.NewValidatorGroup(&mock.FacadeStub{})
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errStr := "error in facade"
+		facade := &mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32, address string) (*common.RewardsBreakdown, error) {
+				return nil, errors.New(errStr)
+			},
+		}
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/7", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetRewardsBreakdown.Error())
+		assert.Contains(t, response.Error, errStr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		breakdownToReturn := &common.RewardsBreakdown{
+			Epoch:      7,
+			LeaderFees: "100",
+			Nodes: []*common.NodeRewardsBreakdown{
+				{BlsKey: "bls", RewardAddress: "erd1delegation", BaseReward: "1000", AccumulatedFees: "100", LeaderFeeShare: 1},
+			},
+			RewardAddresses: []*common.RewardAddressBreakdown{
+				{
+					Address:     "erd1delegation",
+					NumNodes:    1,
+					TotalReward: "1100",
+					Delegation: &common.DelegationRewardsBreakdown{
+						RewardsToDistribute:  "1100",
+						TotalActiveStake:     "10",
+						ServiceFee:           1000,
+						ServiceFeeReward:     "110",
+						RewardsForDelegators: "990",
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetRewardsBreakdownCalled: func(epoch uint32, address string) (*common.RewardsBreakdown, error) {
+				assert.Equal(t, uint32(7), epoch)
+				assert.Equal(t, "erd1delegation", address)
+				return breakdownToReturn, nil
+			},
+		}
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/rewards/7?address=erd1delegation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsBreakdownResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, breakdownToReturn, response.Data.Result)
+	})
+}
+
+func TestValidatorScorecard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, _ := groups.NewValidatorGroup(&mock.FacadeStub{})
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/abcd/scorecard?epochs=-1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorScorecardResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errStr := "error in facade"
+		facade := &mock.FacadeStub{
+			ValidatorScorecardHandler: func(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+				return nil, errors.New(errStr)
+			},
+		}
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/abcd/scorecard", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorScorecardResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetValidatorScorecard.Error())
+		assert.Contains(t, response.Error, errStr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		scorecardToReturn := &common.ValidatorScorecard{
+			BlsKey: "abcd",
+			Epochs: []*common.ValidatorEpochScorecard{
+				{Epoch: 6, ShardID: 1, List: "jailed", ProposedBlocks: 1, MissedBlocks: 5, SignedBlocks: 10, MissedSignatures: 50, StartRating: 40, EndRating: 10},
+				{Epoch: 5, ShardID: 0, List: "eligible", ProposedBlocks: 5, SignedBlocks: 60, StartRating: 35, EndRating: 40},
+			},
+			JailEvents:   []uint32{6},
+			ShardChanges: []*common.ValidatorShardChange{{Epoch: 6, FromShard: 0, ToShard: 1}},
+		}
+		facade := &mock.FacadeStub{
+			ValidatorScorecardHandler: func(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+				assert.Equal(t, "abcd", blsKey)
+				assert.Equal(t, uint32(2), numEpochs)
+				return scorecardToReturn, nil
+			},
+		}
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+		req, _ := http.NewRequest("GET", "/validator/abcd/scorecard?epochs=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorScorecardResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, scorecardToReturn, response.Data.Result)
+	})
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -263,6 +528,9 @@ func getValidatorRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
 					{Name: "/auction", Open: true},
+					{Name: "/auction/simulate", Open: true},
+					{Name: "/rewards/:epoch", Open: true},
+					{Name: "/:bls/scorecard", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const bearerPrefix = "Bearer "
+
+// adminAuthenticator is a middleware that only allows requests carrying the configured admin bearer token
+type adminAuthenticator struct {
+	token []byte
+}
+
+// NewAdminAuthenticator creates a new instance of adminAuthenticator. If the provided token is empty, all the
+// requests will be rejected
+func NewAdminAuthenticator(token string) *adminAuthenticator {
+	return &adminAuthenticator{
+		token: []byte(strings.TrimSpace(token)),
+	}
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (aa *adminAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(aa.token) == 0 {
+			c.AbortWithStatusJSON(
+				http.StatusForbidden,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: ErrAdminTokenNotConfigured.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		header := c.GetHeader("Authorization")
+		providedToken := []byte(strings.TrimPrefix(header, bearerPrefix))
+		isValid := strings.HasPrefix(header, bearerPrefix) && subtle.ConstantTimeCompare(providedToken, aa.token) == 1
+		if !isValid {
+			log.Debug("rejected admin API request", "path", c.Request.URL.Path, "remote", c.ClientIP())
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: ErrUnauthorized.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aa *adminAuthenticator) IsInterfaceNil() bool {
+	return aa == nil
+}
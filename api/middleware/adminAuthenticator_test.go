@@ -0,0 +1,78 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func startNodeServerAdminAuthenticator(token string) *gin.Engine {
+	ws := gin.New()
+	authenticator := middleware.NewAdminAuthenticator(token)
+
+	ginAdminRoutes := ws.Group("/admin")
+	ginAdminRoutes.Use(authenticator.MiddlewareHandlerFunc())
+	ginAdminRoutes.Handle(http.MethodPost, "/managed-keys/reload", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return ws
+}
+
+func doAdminRequest(ws *gin.Engine, authorizationHeader string) int {
+	req, _ := http.NewRequest(http.MethodPost, "/admin/managed-keys/reload", nil)
+	if len(authorizationHeader) > 0 {
+		req.Header.Set("Authorization", authorizationHeader)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewAdminAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	aa := middleware.NewAdminAuthenticator("token")
+	assert.False(t, check.IfNil(aa))
+}
+
+func TestAdminAuthenticator_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no token configured should reject with forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator(" \n")
+		assert.Equal(t, http.StatusForbidden, doAdminRequest(ws, "Bearer "))
+	})
+	t.Run("missing token should reject with unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator("secret")
+		assert.Equal(t, http.StatusUnauthorized, doAdminRequest(ws, ""))
+	})
+	t.Run("wrong token should reject with unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator("secret")
+		assert.Equal(t, http.StatusUnauthorized, doAdminRequest(ws, "Bearer secreT"))
+	})
+	t.Run("token without bearer scheme should reject with unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator("secret")
+		assert.Equal(t, http.StatusUnauthorized, doAdminRequest(ws, "secret"))
+	})
+	t.Run("valid token should pass", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator("secret\n")
+		assert.Equal(t, http.StatusOK, doAdminRequest(ws, "Bearer secret"))
+	})
+}
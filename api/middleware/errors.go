@@ -7,3 +7,9 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrAdminTokenNotConfigured signals that the admin endpoints were called but no admin token was configured
+var ErrAdminTokenNotConfigured = errors.New("admin endpoints are disabled: no admin token configured")
+
+// ErrUnauthorized signals that the request did not provide a valid admin token
+var ErrUnauthorized = errors.New("missing or invalid admin token")
@@ -3,6 +3,7 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -17,86 +18,112 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
 // FacadeStub is the mock implementation of a node router handler
 type FacadeStub struct {
-	ShouldErrorStart                            bool
-	ShouldErrorStop                             bool
-	GetHeartbeatsHandler                        func() ([]data.PubKeyHeartbeat, error)
-	GetBalanceCalled                            func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
-	GetAccountCalled                            func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
-	GetAccountsCalled                           func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
-	GenerateTransactionHandler                  func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
-	GetTransactionHandler                       func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler                    func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler                  func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
-	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
-	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	NodeConfigCalled                            func() map[string]interface{}
-	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
-	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetConnectedPeersRatingsOnMainNetworkCalled func() (string, error)
-	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
-	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
-	GetESDTsRolesCalled                         func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
-	GetNFTTokenIDsRegisteredByAddressCalled     func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
-	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetAlteredAccountsForBlockCalled            func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
-	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) ([]*state.ShardValidatorInfo, error)
-	GetInternalMiniBlockByHashCalled            func(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
-	GetTotalStakedValueHandler                  func() (*api.StakeValues, error)
-	GetAllIssuedESDTsCalled                     func(tokenType string) ([]string, error)
-	GetDirectStakedListHandler                  func() ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler                    func() ([]*api.Delegator, error)
-	GetProofCalled                              func(string, string) (*common.GetProofResponse, error)
-	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
-	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
-	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
-	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
-	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
-	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
-	RestApiInterfaceCalled                      func() string
-	RestAPIServerDebugModeCalled                func() bool
-	PprofEnabledCalled                          func() bool
-	DecodeAddressPubkeyCalled                   func(pk string) ([]byte, error)
-	IsDataTrieMigratedCalled                    func(address string, options api.AccountQueryOptions) (bool, error)
-	GetManagedKeysCountCalled                   func() int
-	GetManagedKeysCalled                        func() []string
-	GetLoadedKeysCalled                         func() []string
-	GetEligibleManagedKeysCalled                func() ([]string, error)
-	GetWaitingManagedKeysCalled                 func() ([]string, error)
-	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
-	P2PPrometheusMetricsEnabledCalled           func() bool
-	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
+	ShouldErrorStart                             bool
+	ShouldErrorStop                              bool
+	GetHeartbeatsHandler                         func() ([]data.PubKeyHeartbeat, error)
+	GetBalanceCalled                             func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	GetAccountCalled                             func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountsCalled                            func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
+	GenerateTransactionHandler                   func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
+	GetTransactionHandler                        func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	CreateTransactionHandler                     func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransactionHandler                   func(tx *transaction.Transaction) error
+	ValidateTransactionForSimulationHandler      func(tx *transaction.Transaction, bypassSignature bool) error
+	SendBulkTransactionsHandler                  func(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler                        func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	StatusMetricsHandler                         func() external.StatusMetricsHandler
+	ValidatorStatisticsHandler                   func() (map[string]*validator.ValidatorStatistics, error)
+	ComputeTransactionGasLimitHandler            func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdownCalled func(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
+	NodeConfigCalled                             func() map[string]interface{}
+	GetQueryHandlerCalled                        func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                         func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetGuardianDataCalled                        func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
+	GetPeerInfoCalled                            func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConnectedPeersRatingsOnMainNetworkCalled  func() (string, error)
+	GetEpochStartDataAPICalled                   func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetThrottlerForEndpointCalled                func(endpoint string) (core.Throttler, bool)
+	GetUsernameCalled                            func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetCodeHashCalled                            func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
+	GetKeyValuePairsCalled                       func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	SimulateTransactionExecutionHandler          func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	GetESDTDataCalled                            func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetAllESDTTokensCalled                       func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTsWithRoleCalled                       func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
+	GetESDTsRolesCalled                          func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
+	GetNFTTokenIDsRegisteredByAddressCalled      func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
+	GetBlockByHashCalled                         func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled                        func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetAlteredAccountsForBlockCalled             func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetBlockByRoundCalled                        func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetInternalShardBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled            func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled             func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled            func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled       func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) ([]*state.ShardValidatorInfo, error)
+	GetInternalMiniBlockByHashCalled             func(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
+	GetTotalStakedValueHandler                   func() (*api.StakeValues, error)
+	GetAllIssuedESDTsCalled                      func(tokenType string) ([]string, error)
+	GetDirectStakedListHandler                   func() ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                     func() ([]*api.Delegator, error)
+	GetGovernanceProposalsCalled                 func(status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled                  func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVoterCalled                     func(address string) (*common.GovernanceVoterAPIResponse, error)
+	GetRewardsBreakdownCalled                    func(epoch uint32, address string) (*common.RewardsBreakdown, error)
+	GetProofCalled                               func(string, string) (*common.GetProofResponse, error)
+	GetProofCurrentRootHashCalled                func(string) (*common.GetProofResponse, error)
+	GetProofDataTrieCalled                       func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyProofCalled                            func(string, string, [][]byte) (bool, error)
+	GetTokenSupplyCalled                         func(token string) (*api.ESDTSupply, error)
+	GetTokenHoldersCalled                        func(token string) (*common.ESDTHoldersAPIResponse, error)
+	GetTokenVolumesCalled                        func(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error)
+	GetGenesisNodesPubKeysCalled                 func() (map[uint32][]string, map[uint32][]string, error)
+	GetGenesisBalancesCalled                     func() ([]*common.InitialAccountAPI, error)
+	GetTransactionsPoolCalled                    func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSenderCalled           func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled              func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled  func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycleCalled                func(txHash string) (*common.TransactionLifecycleAPIResponse, error)
+	GetTransactionProofCalled                    func(txHash string) (*receiptProof.ReceiptProof, error)
+	GetGasConfigsCalled                          func() (map[string]map[string]uint64, error)
+	GetGasConfigsDiffCalled                      func(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error)
+	RestApiInterfaceCalled                       func() string
+	RestAPIServerDebugModeCalled                 func() bool
+	PprofEnabledCalled                           func() bool
+	DecodeAddressPubkeyCalled                    func(pk string) ([]byte, error)
+	IsDataTrieMigratedCalled                     func(address string, options api.AccountQueryOptions) (bool, error)
+	GetAddressTransactionsCalled                 func(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	GetBalanceHistoryCalled                      func(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
+	QueryEventsCalled                            func(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error)
+	GetManagedKeysCountCalled                    func() int
+	GetManagedKeysCalled                         func() []string
+	GetLoadedKeysCalled                          func() []string
+	GetEligibleManagedKeysCalled                 func() ([]string, error)
+	GetWaitingManagedKeysCalled                  func() ([]string, error)
+	GetWaitingEpochsLeftForPublicKeyCalled       func(publicKey string) (uint32, error)
+	AddManagedKeyCalled                          func(privateKeyHex string) (string, error)
+	RemoveManagedKeyCalled                       func(publicKeyHex string) error
+	ReloadManagedKeysCalled                      func() (*common.ManagedKeysReloadResult, error)
+	GetPeerReputationStateCalled                 func() (*common.PeerReputationState, error)
+	BanPeerCalled                                func(peerID string, duration time.Duration) error
+	UnbanPeerCalled                              func(peerID string) error
+	BanIPRangeCalled                             func(ipRange string, duration time.Duration) error
+	UnbanIPRangeCalled                           func(ipRange string) error
+	PinPeerCalled                                func(peerID string) error
+	UnpinPeerCalled                              func(peerID string) error
+	P2PPrometheusMetricsEnabledCalled            func() bool
+	AuctionListHandler                           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationHandler                     func(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorScorecardHandler                    func(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
 }
 
 // GetTokenSupply -
@@ -108,6 +135,24 @@ func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// GetTokenHolders -
+func (f *FacadeStub) GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error) {
+	if f.GetTokenHoldersCalled != nil {
+		return f.GetTokenHoldersCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetTokenVolumes -
+func (f *FacadeStub) GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+	if f.GetTokenVolumesCalled != nil {
+		return f.GetTokenVolumesCalled(token, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // GetProof -
 func (f *FacadeStub) GetProof(rootHash string, address string) (*common.GetProofResponse, error) {
 	if f.GetProofCalled != nil {
@@ -383,6 +428,24 @@ func (f *FacadeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse
 	return nil, nil
 }
 
+// AuctionSimulationApi is the mock implementation of a handler's AuctionSimulationApi method
+func (f *FacadeStub) AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	if f.AuctionSimulationHandler != nil {
+		return f.AuctionSimulationHandler(args)
+	}
+
+	return nil, nil
+}
+
+// ValidatorScorecardApi is the mock implementation of a handler's ValidatorScorecardApi method
+func (f *FacadeStub) ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+	if f.ValidatorScorecardHandler != nil {
+		return f.ValidatorScorecardHandler(blsKey, numEpochs)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
 	if f.ExecuteSCQueryHandler != nil {
@@ -428,6 +491,42 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals(status string) ([]*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled(status)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVoter -
+func (f *FacadeStub) GetGovernanceVoter(address string) (*common.GovernanceVoterAPIResponse, error) {
+	if f.GetGovernanceVoterCalled != nil {
+		return f.GetGovernanceVoterCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetRewardsBreakdown -
+func (f *FacadeStub) GetRewardsBreakdown(epoch uint32, address string) (*common.RewardsBreakdown, error) {
+	if f.GetRewardsBreakdownCalled != nil {
+		return f.GetRewardsBreakdownCalled(epoch, address)
+	}
+
+	return nil, nil
+}
+
 // ComputeTransactionGasLimit -
 func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	if f.ComputeTransactionGasLimitHandler != nil {
@@ -437,6 +536,15 @@ func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*t
 	return nil, nil
 }
 
+// ComputeTransactionCostWithGasBreakdown -
+func (f *FacadeStub) ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	if f.ComputeTransactionCostWithGasBreakdownCalled != nil {
+		return f.ComputeTransactionCostWithGasBreakdownCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // NodeConfig -
 func (f *FacadeStub) NodeConfig() map[string]interface{} {
 	if f.NodeConfigCalled != nil {
@@ -642,6 +750,24 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionLifecycle -
+func (f *FacadeStub) GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+	if f.GetTransactionLifecycleCalled != nil {
+		return f.GetTransactionLifecycleCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionProof -
+func (f *FacadeStub) GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error) {
+	if f.GetTransactionProofCalled != nil {
+		return f.GetTransactionProofCalled(txHash)
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -651,6 +777,15 @@ func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return nil, nil
 }
 
+// GetGasConfigsDiff -
+func (f *FacadeStub) GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+	if f.GetGasConfigsDiffCalled != nil {
+		return f.GetGasConfigsDiffCalled(fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // GetInternalStartOfEpochValidatorsInfo -
 func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) ([]*state.ShardValidatorInfo, error) {
 	if f.GetInternalStartOfEpochValidatorsInfoCalled != nil {
@@ -669,6 +804,33 @@ func (f *FacadeStub) IsDataTrieMigrated(address string, options api.AccountQuery
 	return false, nil
 }
 
+// GetAddressTransactions -
+func (f *FacadeStub) GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	if f.GetAddressTransactionsCalled != nil {
+		return f.GetAddressTransactionsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetBalanceHistory -
+func (f *FacadeStub) GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	if f.GetBalanceHistoryCalled != nil {
+		return f.GetBalanceHistoryCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// QueryEvents -
+func (f *FacadeStub) QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+	if f.QueryEventsCalled != nil {
+		return f.QueryEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // Trigger -
 func (f *FacadeStub) Trigger(_ uint32, _ bool) error {
 	return nil
@@ -679,6 +841,96 @@ func (f *FacadeStub) IsSelfTrigger() bool {
 	return false
 }
 
+// AddManagedKey -
+func (f *FacadeStub) AddManagedKey(privateKeyHex string) (string, error) {
+	if f.AddManagedKeyCalled != nil {
+		return f.AddManagedKeyCalled(privateKeyHex)
+	}
+
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (f *FacadeStub) RemoveManagedKey(publicKeyHex string) error {
+	if f.RemoveManagedKeyCalled != nil {
+		return f.RemoveManagedKeyCalled(publicKeyHex)
+	}
+
+	return nil
+}
+
+// ReloadManagedKeys -
+func (f *FacadeStub) ReloadManagedKeys() (*common.ManagedKeysReloadResult, error) {
+	if f.ReloadManagedKeysCalled != nil {
+		return f.ReloadManagedKeysCalled()
+	}
+
+	return &common.ManagedKeysReloadResult{}, nil
+}
+
+// GetPeerReputationState -
+func (f *FacadeStub) GetPeerReputationState() (*common.PeerReputationState, error) {
+	if f.GetPeerReputationStateCalled != nil {
+		return f.GetPeerReputationStateCalled()
+	}
+
+	return &common.PeerReputationState{}, nil
+}
+
+// BanPeer -
+func (f *FacadeStub) BanPeer(peerID string, duration time.Duration) error {
+	if f.BanPeerCalled != nil {
+		return f.BanPeerCalled(peerID, duration)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (f *FacadeStub) UnbanPeer(peerID string) error {
+	if f.UnbanPeerCalled != nil {
+		return f.UnbanPeerCalled(peerID)
+	}
+
+	return nil
+}
+
+// BanIPRange -
+func (f *FacadeStub) BanIPRange(ipRange string, duration time.Duration) error {
+	if f.BanIPRangeCalled != nil {
+		return f.BanIPRangeCalled(ipRange, duration)
+	}
+
+	return nil
+}
+
+// UnbanIPRange -
+func (f *FacadeStub) UnbanIPRange(ipRange string) error {
+	if f.UnbanIPRangeCalled != nil {
+		return f.UnbanIPRangeCalled(ipRange)
+	}
+
+	return nil
+}
+
+// PinPeer -
+func (f *FacadeStub) PinPeer(peerID string) error {
+	if f.PinPeerCalled != nil {
+		return f.PinPeerCalled(peerID)
+	}
+
+	return nil
+}
+
+// UnpinPeer -
+func (f *FacadeStub) UnpinPeer(peerID string) error {
+	if f.UnpinPeerCalled != nil {
+		return f.UnpinPeerCalled(peerID)
+	}
+
+	return nil
+}
+
 // GetManagedKeysCount -
 func (f *FacadeStub) GetManagedKeysCount() int {
 	if f.GetManagedKeysCountCalled != nil {
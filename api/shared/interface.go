@@ -2,6 +2,7 @@ package shared
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -18,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
@@ -93,8 +95,14 @@ type FacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGovernanceProposals(status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVoter(address string) (*common.GovernanceVoterAPIResponse, error)
+	GetRewardsBreakdown(epoch uint32, address string) (*common.RewardsBreakdown, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error)
+	GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
@@ -113,9 +121,12 @@ type FacadeHandler interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
@@ -124,11 +135,17 @@ type FacadeHandler interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
+	GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error)
+	GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
+	QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetLoadedKeys() []string
@@ -136,5 +153,15 @@ type FacadeHandler interface {
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
 	P2PPrometheusMetricsEnabled() bool
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKeyHex string) error
+	ReloadManagedKeys() (*common.ManagedKeysReloadResult, error)
+	GetPeerReputationState() (*common.PeerReputationState, error)
+	BanPeer(peerID string, duration time.Duration) error
+	UnbanPeer(peerID string) error
+	BanIPRange(ipRange string, duration time.Duration) error
+	UnbanIPRange(ipRange string) error
+	PinPeer(peerID string) error
+	UnpinPeer(peerID string) error
 	IsInterfaceNil() bool
 }
@@ -5,6 +5,7 @@ generate() {
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
+    generateForOutportReplay
     generateForSeedNode
     generateForTermUi
 }
@@ -65,6 +66,20 @@ $(code)
     echo "$HELP" > ./node/CLI.md
 }
 
+generateForOutportReplay() {
+    HELP="
+# MultiversX Outport Replay CLI
+
+The **MultiversX Outport Replay** exposes the following Command Line Interface:
+$(code)
+\$ outportreplay --help
+
+$(./outportreplay/outportreplay --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./outportreplay/CLI.md
+}
+
 generateForSeedNode() {
     HELP="
 # MultiversX SeedNode CLI
@@ -20,17 +20,24 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
 	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
 
 type cfg struct {
-	numKeys       int
-	keyType       string
-	consoleOut    bool
-	noSplit       bool
-	prefixPattern string
-	shardIDByte   int
+	numKeys        int
+	keyType        string
+	consoleOut     bool
+	noSplit        bool
+	prefixPattern  string
+	shardIDByte    int
+	useKeystore    bool
+	kdfType        string
+	passphraseFile string
+	passphraseEnv  string
+	pemFile        string
+	keystoreFile   string
 }
 
 const validatorType = "validator"
@@ -42,12 +49,16 @@ const nopattern = "nopattern"
 const desiredpattern = "[0-f]+"
 const noshard = -1
 const pubkeyHrp = "erd"
+const pemExtension = ".pem"
+const keystoreExtension = ".json"
 
 type key struct {
 	skBytes []byte
 	pkBytes []byte
 }
 
+type contentEncrypter func(content []byte) ([]byte, error)
+
 type pubKeyConverter interface {
 	Decode(humanReadable string) ([]byte, error)
 	Encode(pkBytes []byte) (string, error)
@@ -123,6 +134,46 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// useKeystore is the flag that, if active, will save the generated keys in the encrypted keystore format
+	useKeystore = cli.BoolFlag{
+		Name:        "keystore",
+		Usage:       "Boolean option that will save the generated keys in the encrypted keystore format instead of plain PEM files",
+		Destination: &argsConfig.useKeystore,
+	}
+	// kdfType defines a flag for setting the key derivation function used by the keystore
+	kdfType = cli.StringFlag{
+		Name: "kdf",
+		Usage: fmt.Sprintf(
+			"The key derivation function used to encrypt the keystore. Available options: %s, %s",
+			keystore.ScryptKDF,
+			keystore.Argon2idKDF),
+		Value:       keystore.ScryptKDF,
+		Destination: &argsConfig.kdfType,
+	}
+	// passphraseFile defines a flag for the path to the file holding the keystore passphrase
+	passphraseFile = cli.StringFlag{
+		Name:        "passphrase-file",
+		Usage:       "The `filepath` for the file holding the keystore passphrase",
+		Destination: &argsConfig.passphraseFile,
+	}
+	// passphraseEnv defines a flag for the environment variable holding the keystore passphrase
+	passphraseEnv = cli.StringFlag{
+		Name:        "passphrase-env",
+		Usage:       "The name of the environment `variable` holding the keystore passphrase. If neither this flag nor the passphrase file is set, the passphrase will be requested in the terminal",
+		Destination: &argsConfig.passphraseEnv,
+	}
+	// pemFile defines a flag for the path to the PEM file that will be converted
+	pemFile = cli.StringFlag{
+		Name:        "pem-file",
+		Usage:       "The `filepath` for the existing PEM file that will be converted in the keystore format",
+		Destination: &argsConfig.pemFile,
+	}
+	// keystoreFile defines a flag for the path to the keystore file that will be created
+	keystoreFile = cli.StringFlag{
+		Name:        "keystore-file",
+		Usage:       "The `filepath` for the keystore file that will be created. If not set, the PEM file name with the .json extension will be used",
+		Destination: &argsConfig.keystoreFile,
+	}
 	argsConfig = &cfg{}
 
 	walletKeyFilenameTemplate    = "walletKey%s.pem"
@@ -155,6 +206,26 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		useKeystore,
+		kdfType,
+		passphraseFile,
+		passphraseEnv,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "convert",
+			Usage: "Converts an existing PEM file (validator, all validators or wallet keys) in the encrypted keystore format",
+			Flags: []cli.Flag{
+				pemFile,
+				keystoreFile,
+				kdfType,
+				passphraseFile,
+				passphraseEnv,
+			},
+			Action: func(_ *cli.Context) error {
+				return convertToKeystore()
+			},
+		},
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -170,12 +241,81 @@ func main() {
 }
 
 func process() error {
+	if argsConfig.useKeystore && argsConfig.consoleOut {
+		return fmt.Errorf("the keystore option can not be used together with the console output option")
+	}
+
+	var encrypter contentEncrypter
+	if argsConfig.useKeystore {
+		var err error
+		encrypter, err = createKeystoreEncrypter()
+		if err != nil {
+			return err
+		}
+	}
+
 	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
 	if err != nil {
 		return err
 	}
 
-	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit, encrypter)
+}
+
+func createKeystoreEncrypter() (contentEncrypter, error) {
+	kdf, passphrase, err := getKeystoreSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(content []byte) ([]byte, error) {
+		return keystore.Encrypt(content, passphrase, kdf)
+	}, nil
+}
+
+func getKeystoreSettings() (keystore.KDFConfig, []byte, error) {
+	kdf, err := keystore.NewDefaultKDFConfig(argsConfig.kdfType)
+	if err != nil {
+		return keystore.KDFConfig{}, nil, err
+	}
+
+	passphraseProvider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile:        argsConfig.passphraseFile,
+		PassphraseEnvVariable: argsConfig.passphraseEnv,
+		PromptEnabled:         true,
+		ConfirmPrompt:         true,
+	})
+	passphrase, err := passphraseProvider.Passphrase()
+	if err != nil {
+		return keystore.KDFConfig{}, nil, err
+	}
+
+	return kdf, passphrase, nil
+}
+
+func convertToKeystore() error {
+	if len(argsConfig.pemFile) == 0 {
+		return fmt.Errorf("the PEM file to be converted was not provided")
+	}
+
+	output := argsConfig.keystoreFile
+	if len(output) == 0 {
+		output = strings.TrimSuffix(argsConfig.pemFile, pemExtension) + keystoreExtension
+	}
+
+	kdf, passphrase, err := getKeystoreSettings()
+	if err != nil {
+		return err
+	}
+
+	numKeys, err := keystore.ConvertPemFile(argsConfig.pemFile, output, passphrase, kdf)
+	if err != nil {
+		return err
+	}
+
+	log.Info("converted PEM file", "source", argsConfig.pemFile, "keystore", output, "num keys", numKeys)
+
+	return nil
 }
 
 func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]key, []key, []key, error) {
@@ -308,12 +448,13 @@ func outputKeys(
 	p2pKeys []key,
 	consoleOut bool,
 	noSplit bool,
+	encrypter contentEncrypter,
 ) error {
 	if consoleOut {
 		return printKeys(validatorKeys, walletKeys, p2pKeys)
 	}
 
-	return saveKeys(validatorKeys, walletKeys, p2pKeys, noSplit)
+	return saveKeys(validatorKeys, walletKeys, p2pKeys, noSplit, encrypter)
 }
 
 func printKeys(validatorKeys, walletKeys, p2pKeys []key) error {
@@ -379,26 +520,26 @@ func writeKeyToStream(writer io.Writer, key key, converter pubKeyConverter) erro
 	return pem.Encode(writer, &blk)
 }
 
-func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
+func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool, encrypter contentEncrypter) error {
 	if len(validatorKeys)+len(walletKeys)+len(p2pKeys) == 0 {
 		return fmt.Errorf("internal error: no keys to save")
 	}
 
 	var errFound error
 	if len(validatorKeys) > 0 {
-		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit)
+		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit, encrypter)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(walletKeys) > 0 {
-		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit)
+		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit, encrypter)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(p2pKeys) > 0 {
-		err := saveSliceKeys(p2pKeyFilenameTemplate, p2pKeys, pidPubKeyConverter, noSplit)
+		err := saveSliceKeys(p2pKeyFilenameTemplate, p2pKeys, pidPubKeyConverter, noSplit, encrypter)
 		if err != nil {
 			errFound = err
 		}
@@ -407,38 +548,57 @@ func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
 	return errFound
 }
 
-func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConverter, noSplit bool) error {
-	var file *os.File
-	var err error
+func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConverter, noSplit bool, encrypter contentEncrypter) error {
+	if encrypter != nil {
+		baseFilenameTemplate = strings.TrimSuffix(baseFilenameTemplate, pemExtension) + keystoreExtension
+	}
+
+	buff := bytes.NewBuffer(make([]byte, 0))
 	for i, k := range keys {
-		shouldCreateFile := !noSplit || i == 0
-		if shouldCreateFile {
-			file, err = generateFile(i, len(keys), noSplit, baseFilenameTemplate)
-			if err != nil {
-				return err
-			}
+		err := writeKeyToStream(buff, k, converter)
+		if err != nil {
+			return err
 		}
 
-		err = writeKeyToStream(file, k, converter)
+		shouldSaveFile := !noSplit || i == len(keys)-1
+		if !shouldSaveFile {
+			continue
+		}
+
+		err = saveContentToFile(i, len(keys), noSplit, baseFilenameTemplate, buff.Bytes(), encrypter)
 		if err != nil {
 			return err
 		}
-		if !noSplit {
-			err = file.Close()
-			if err != nil {
-				return err
-			}
+		buff.Reset()
+	}
+
+	return nil
+}
+
+func saveContentToFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string, content []byte, encrypter contentEncrypter) error {
+	var err error
+	if encrypter != nil {
+		content, err = encrypter(content)
+		if err != nil {
+			return err
 		}
 	}
 
-	if noSplit {
-		return file.Close()
+	file, err := generateFile(index, numKeys, noSplit, baseFilenameTemplate, encrypter != nil)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	_, err = file.Write(content)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
 }
 
-func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string) (*os.File, error) {
+func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string, isKeystore bool) (*os.File, error) {
 	folder, err := generateFolder(index, numKeys, noSplit)
 	if err != nil {
 		return nil, err
@@ -453,7 +613,12 @@ func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate str
 		return nil, err
 	}
 
-	return os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, core.FileModeReadWrite)
+	fileMode := os.FileMode(core.FileModeReadWrite)
+	if isKeystore {
+		fileMode = core.FileModeUserReadWrite
+	}
+
+	return os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, fileMode)
 }
 
 func generateFolder(index int, numKeys int, noSplit bool) (string, error) {
@@ -220,15 +220,16 @@ var (
 	validatorKeyPemFile = cli.StringFlag{
 		Name: "validator-key-pem-file",
 		Usage: "The `filepath` for the PEM file which contains the secret keys to be used by this node. If the file " +
-			"does not exists or can not be loaded, the node will autogenerate and use a random key. The key may or may not " +
-			"be registered to be a consensus validator.",
+			"does not exists, the node will autogenerate and use a random key. The key may or may not be registered to be " +
+			"a consensus validator. The file can also be an encrypted keystore, in which case a wrong or missing " +
+			"passphrase stops the node.",
 		Value: "./config/validatorKey.pem",
 	}
 	// allValidatorKeysPemFile defines a flag for the path to the file that hold all validator keys used in block signing
 	// managed by the current node
 	allValidatorKeysPemFile = cli.StringFlag{
 		Name:  "all-validator-keys-pem-file",
-		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node.",
+		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node. The file can also be an encrypted keystore.",
 		Value: "./config/allValidatorsKeys.pem",
 	}
 
@@ -408,6 +409,20 @@ var (
 		Name:  "p2p-prometheus-metrics",
 		Usage: "Boolean option for enabling the /debug/metrics/prometheus route for p2p prometheus metrics",
 	}
+
+	// keystorePassphraseFile defines a flag for the path to the file holding the passphrase of the encrypted key files
+	keystorePassphraseFile = cli.StringFlag{
+		Name: "keystore-passphrase-file",
+		Usage: "The `filepath` for the file holding the passphrase used to unlock the validator keys stored in the " +
+			"encrypted keystore format. Plain PEM files do not require a passphrase.",
+	}
+	// keystorePassphraseEnv defines a flag for the environment variable holding the passphrase of the encrypted key files
+	keystorePassphraseEnv = cli.StringFlag{
+		Name: "keystore-passphrase-env",
+		Usage: "The name of the environment `variable` holding the passphrase used to unlock the validator keys stored " +
+			"in the encrypted keystore format. If neither this flag nor the passphrase file is set, the passphrase " +
+			"will be requested in the terminal.",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -470,6 +485,8 @@ func getFlags() []cli.Flag {
 		operationMode,
 		repopulateTokensSupplies,
 		p2pPrometheusMetrics,
+		keystorePassphraseFile,
+		keystorePassphraseEnv,
 	}
 }
 
@@ -499,6 +516,8 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
 	flagsConfig.P2PPrometheusMetricsEnabled = ctx.GlobalBool(p2pPrometheusMetrics.Name)
+	flagsConfig.KeystorePassphraseFile = ctx.GlobalString(keystorePassphraseFile.Name)
+	flagsConfig.KeystorePassphraseEnv = ctx.GlobalString(keystorePassphraseEnv.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
@@ -0,0 +1,620 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/endProcess"
+	factoryMarshalizer "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
+	"github.com/multiversx/mx-chain-go/common"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	bootstrapDisabled "github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
+	mainFactory "github.com/multiversx/mx-chain-go/factory"
+	coreComp "github.com/multiversx/mx-chain-go/factory/core"
+	"github.com/multiversx/mx-chain-go/factory/disabled"
+	"github.com/multiversx/mx-chain-go/outport"
+	outportDriverFactory "github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/replay"
+	"github.com/multiversx/mx-chain-go/process/receipts"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
+	factoryState "github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/state/storagePruningManager"
+	"github.com/multiversx/mx-chain-go/state/storagePruningManager/evictionWaitingList"
+	"github.com/multiversx/mx-chain-go/statusHandler"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/latestData"
+	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommonBuiltInFunctions "github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/urfave/cli"
+)
+
+const filePathPlaceholder = "[path]"
+
+var (
+	outportReplayHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirectory defines a flag for the path of the node working directory, the one holding the db directory
+	workingDirectory = cli.StringFlag{
+		Name:  "working-directory",
+		Usage: "This flag specifies the `directory` of the node whose database will be replayed. The node must be stopped.",
+		Value: "",
+	}
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `" + filePathPlaceholder + "` for the main configuration file the node was started with. This " +
+			"TOML file contains the storage setups used to open the database.",
+		Value: "./config/config.toml",
+	}
+	// configurationEconomicsFile defines a flag for the path to the economics toml configuration file
+	configurationEconomicsFile = cli.StringFlag{
+		Name:  "config-economics",
+		Usage: "The `" + filePathPlaceholder + "` for the economics configuration file.",
+		Value: "./config/economics.toml",
+	}
+	// configurationRatingsFile defines a flag for the path to the ratings toml configuration file
+	configurationRatingsFile = cli.StringFlag{
+		Name:  "config-ratings",
+		Usage: "The ratings configuration file to load",
+		Value: "./config/ratings.toml",
+	}
+	// configurationPreferencesFile defines a flag for the path to the preferences toml configuration file
+	configurationPreferencesFile = cli.StringFlag{
+		Name:  "config-preferences",
+		Usage: "The `" + filePathPlaceholder + "` for the preferences configuration file.",
+		Value: "./config/prefs.toml",
+	}
+	// externalConfigFile defines a flag for the path to the external toml configuration file
+	externalConfigFile = cli.StringFlag{
+		Name: "config-external",
+		Usage: "The `" + filePathPlaceholder + "` for the external configuration file. The outport drivers enabled " +
+			"in this file will receive the replayed blocks.",
+		Value: "./config/external.toml",
+	}
+	// epochConfigurationFile defines a flag for the path to the toml file containing the epoch configuration
+	epochConfigurationFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `" + filePathPlaceholder + "` for the epoch configuration file.",
+		Value: "./config/enableEpochs.toml",
+	}
+	// roundConfigurationFile defines a flag for the path to the toml file containing the round configuration
+	roundConfigurationFile = cli.StringFlag{
+		Name:  "round-config",
+		Usage: "The `" + filePathPlaceholder + "` for the round configuration file.",
+		Value: "./config/enableRounds.toml",
+	}
+	// nodesFile defines a flag for the path of the initial nodes file
+	nodesFile = cli.StringFlag{
+		Name:  "nodes-setup-file",
+		Usage: "The `" + filePathPlaceholder + "` for the nodes setup.",
+		Value: "./config/nodesSetup.json",
+	}
+	// shardID defines a flag for the shard whose database will be replayed
+	shardID = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `shard` of the replayed database. Can be a shard number or `metachain`.",
+		Value: "0",
+	}
+	// fromNonce defines a flag for the first replayed nonce
+	fromNonce = cli.Uint64Flag{
+		Name:  "from-nonce",
+		Usage: "The first `nonce` to be replayed.",
+		Value: 1,
+	}
+	// toNonce defines a flag for the last replayed nonce
+	toNonce = cli.Uint64Flag{
+		Name:  "to-nonce",
+		Usage: "The last `nonce` to be replayed.",
+		Value: 1,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var (
+	log                = logger.GetOrCreate("main")
+	errNoOutportDriver = errors.New("no outport driver is enabled in the external configuration")
+)
+
+type replayConfigs struct {
+	generalConfig     *config.Config
+	economicsConfig   *config.EconomicsConfig
+	ratingsConfig     *config.RatingsConfig
+	preferencesConfig *config.Preferences
+	externalConfig    *config.ExternalConfig
+	epochConfig       *config.EpochConfig
+	roundConfig       *config.RoundConfig
+	configPaths       *config.ConfigurationPathsHolder
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = outportReplayHelpTemplate
+	app.Name = "Outport replay CLI App"
+	app.Usage = "This tool re-feeds the outport drivers with the blocks stored in an existing node database"
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		configurationEconomicsFile,
+		configurationRatingsFile,
+		configurationPreferencesFile,
+		externalConfigFile,
+		epochConfigurationFile,
+		roundConfigurationFile,
+		nodesFile,
+		shardID,
+		fromNonce,
+		toNonce,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return replayBlocks(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func replayBlocks(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	configs, err := readConfigs(ctx)
+	if err != nil {
+		return err
+	}
+
+	workingDir := ctx.GlobalString(workingDirectory.Name)
+	if len(workingDir) == 0 {
+		workingDir, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	selfShardID, err := core.ConvertShardIDToUint32(ctx.GlobalString(shardID.Name))
+	if err != nil {
+		return err
+	}
+
+	coreComponents, err := createCoreComponents(configs, ctx.GlobalString(nodesFile.Name), workingDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(coreComponents.Close())
+	}()
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(coreComponents.GenesisNodesSetup().NumberOfShards(), selfShardID)
+	if err != nil {
+		return err
+	}
+
+	store, err := createStorageService(configs, coreComponents, shardCoordinator, workingDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(store.CloseAll())
+	}()
+
+	accountsDB, err := createAccountsDB(configs.generalConfig, coreComponents, store)
+	if err != nil {
+		return err
+	}
+
+	outportHandler, err := createOutport(configs, coreComponents, selfShardID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(outportHandler.Close())
+	}()
+
+	replayer, err := createReplayer(coreComponents, shardCoordinator, store, accountsDB, outportHandler)
+	if err != nil {
+		return err
+	}
+
+	replayCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigs:
+			log.Info("terminating at user's signal...")
+			cancel()
+		case <-replayCtx.Done():
+		}
+	}()
+
+	return replayer.Replay(replayCtx, ctx.GlobalUint64(fromNonce.Name), ctx.GlobalUint64(toNonce.Name))
+}
+
+func readConfigs(ctx *cli.Context) (*replayConfigs, error) {
+	configPaths := &config.ConfigurationPathsHolder{
+		MainConfig:      ctx.GlobalString(configurationFile.Name),
+		Economics:       ctx.GlobalString(configurationEconomicsFile.Name),
+		Ratings:         ctx.GlobalString(configurationRatingsFile.Name),
+		Preferences:     ctx.GlobalString(configurationPreferencesFile.Name),
+		External:        ctx.GlobalString(externalConfigFile.Name),
+		Epoch:           ctx.GlobalString(epochConfigurationFile.Name),
+		RoundActivation: ctx.GlobalString(roundConfigurationFile.Name),
+		Nodes:           ctx.GlobalString(nodesFile.Name),
+	}
+
+	generalConfig, err := common.LoadMainConfig(configPaths.MainConfig)
+	if err != nil {
+		return nil, err
+	}
+	economicsConfig, err := common.LoadEconomicsConfig(configPaths.Economics)
+	if err != nil {
+		return nil, err
+	}
+	ratingsConfig, err := common.LoadRatingsConfig(configPaths.Ratings)
+	if err != nil {
+		return nil, err
+	}
+	preferencesConfig, err := common.LoadPreferencesConfig(configPaths.Preferences)
+	if err != nil {
+		return nil, err
+	}
+	externalConfig, err := common.LoadExternalConfig(configPaths.External)
+	if err != nil {
+		return nil, err
+	}
+	epochConfig, err := common.LoadEpochConfig(configPaths.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	roundConfig, err := common.LoadRoundConfig(configPaths.RoundActivation)
+	if err != nil {
+		return nil, err
+	}
+
+	// the replay only reads the database, so the old epochs data should never be removed
+	generalConfig.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfig.StoragePruning.ObserverCleanOldEpochsData = false
+
+	return &replayConfigs{
+		generalConfig:     generalConfig,
+		economicsConfig:   economicsConfig,
+		ratingsConfig:     ratingsConfig,
+		preferencesConfig: preferencesConfig,
+		externalConfig:    externalConfig,
+		epochConfig:       epochConfig,
+		roundConfig:       roundConfig,
+		configPaths:       configPaths,
+	}, nil
+}
+
+func createCoreComponents(configs *replayConfigs, nodesFilename string, workingDir string) (mainFactory.CoreComponentsHandler, error) {
+	coreArgs := coreComp.CoreComponentsFactoryArgs{
+		Config:              *configs.generalConfig,
+		ConfigPathsHolder:   *configs.configPaths,
+		EpochConfig:         *configs.epochConfig,
+		RoundConfig:         *configs.roundConfig,
+		RatingsConfig:       *configs.ratingsConfig,
+		EconomicsConfig:     *configs.economicsConfig,
+		NodesFilename:       nodesFilename,
+		WorkingDirectory:    workingDir,
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+	}
+
+	coreComponentsFactory, err := coreComp.NewCoreComponentsFactory(coreArgs)
+	if err != nil {
+		return nil, fmt.Errorf("NewCoreComponentsFactory failed: %w", err)
+	}
+
+	managedCoreComponents, err := coreComp.NewManagedCoreComponents(coreComponentsFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = managedCoreComponents.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	return managedCoreComponents, nil
+}
+
+func createStorageService(
+	configs *replayConfigs,
+	coreComponents mainFactory.CoreComponentsHolder,
+	shardCoordinator sharding.Coordinator,
+	workingDir string,
+) (dataRetriever.StorageService, error) {
+	lastEpoch, err := getLastStoredEpoch(configs.generalConfig, coreComponents, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	storageServiceFactory, err := storageFactory.NewStorageServiceFactory(
+		storageFactory.StorageServiceFactoryArgs{
+			Config:             *configs.generalConfig,
+			PrefsConfig:        configs.preferencesConfig.Preferences,
+			ShardCoordinator:   shardCoordinator,
+			PathManager:        coreComponents.PathHandler(),
+			EpochStartNotifier: coreComponents.EpochStartNotifierWithConfirm(),
+			NodeTypeProvider:   coreComponents.NodeTypeProvider(),
+			CurrentEpoch:       lastEpoch,
+			StorageType:        storageFactory.ProcessStorageService,
+			NodeProcessingMode: common.Normal,
+			ManagedPeersHolder: disabled.NewManagedPeersHolder(),
+			StateStatsHandler:  disabledStatistics.NewStateStatistics(),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return storageServiceFactory.CreateForMeta()
+	}
+
+	return storageServiceFactory.CreateForShard()
+}
+
+// getLastStoredEpoch returns the highest epoch found in the node database, so that the storers are opened the way
+// the node left them
+func getLastStoredEpoch(generalConfig *config.Config, coreComponents mainFactory.CoreComponentsHolder, workingDir string) (uint32, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(coreComponents.InternalMarshalizer())
+	if err != nil {
+		return 0, err
+	}
+
+	latestStorageDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         *generalConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryhandler.NewDirectoryReader(),
+		ParentDir:             filepath.Join(workingDir, common.DefaultDBPath, coreComponents.ChainID()),
+		DefaultEpochString:    storage.DefaultEpochString,
+		DefaultShardString:    storage.DefaultShardString,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_, lastEpoch, err := latestStorageDataProvider.GetParentDirAndLastEpoch()
+
+	return lastEpoch, err
+}
+
+func createAccountsDB(
+	generalConfig *config.Config,
+	coreComponents mainFactory.CoreComponentsHolder,
+	store dataRetriever.StorageService,
+) (state.AccountsAdapter, error) {
+	triesContainer, _, err := trieFactory.CreateTriesComponentsForShardId(
+		*generalConfig,
+		coreComponents,
+		store,
+		disabledStatistics.NewStateStatistics(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := factoryState.NewAccountCreator(factoryState.ArgsAccountCreator{
+		Hasher:              coreComponents.Hasher(),
+		Marshaller:          coreComponents.InternalMarshalizer(),
+		EnableEpochsHandler: coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trieEvictionWaitingList, err := evictionWaitingList.NewMemoryEvictionWaitingList(evictionWaitingList.MemoryEvictionWaitingListArgs{
+		RootHashesSize: generalConfig.EvictionWaitingList.RootHashesSize,
+		HashesSize:     generalConfig.EvictionWaitingList.HashesSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storagePruning, err := storagePruningManager.NewStoragePruningManager(
+		trieEvictionWaitingList,
+		generalConfig.TrieStorageManagerConfig.PruningBufferLen,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  triesContainer.Get([]byte(dataRetriever.UserAccountsUnit.String())),
+		Hasher:                coreComponents.Hasher(),
+		Marshaller:            coreComponents.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: storagePruning,
+		AddressConverter:      coreComponents.AddressPubKeyConverter(),
+		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+	})
+}
+
+func createOutport(configs *replayConfigs, coreComponents mainFactory.CoreComponentsHolder, selfShardID uint32) (outport.OutportHandler, error) {
+	externalConfig := configs.externalConfig
+
+	eventNotifierMarshaller, err := factoryMarshalizer.NewMarshalizer(externalConfig.EventNotifierConnector.MarshallerType)
+	if err != nil {
+		return nil, err
+	}
+
+	hostDriversArgs := make([]outportDriverFactory.ArgsHostDriverFactory, 0, len(externalConfig.HostDriversConfig))
+	for _, hostConfig := range externalConfig.HostDriversConfig {
+		if !hostConfig.Enabled {
+			continue
+		}
+
+		marshaller, errCreate := factoryMarshalizer.NewMarshalizer(hostConfig.MarshallerType)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		hostDriversArgs = append(hostDriversArgs, outportDriverFactory.ArgsHostDriverFactory{
+			Marshaller: marshaller,
+			HostConfig: hostConfig,
+		})
+	}
+
+	fileDriverArgs := outportDriverFactory.ArgsFileDriverFactory{
+		Config: externalConfig.FileDriverConfig,
+	}
+	if externalConfig.FileDriverConfig.Enabled {
+		fileDriverArgs.Marshaller, err = factoryMarshalizer.NewMarshalizer(externalConfig.FileDriverConfig.MarshallerType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	kafkaDriverArgs := outportDriverFactory.ArgsKafkaDriverFactory{
+		Config: externalConfig.KafkaDriverConfig,
+	}
+	if externalConfig.KafkaDriverConfig.Enabled {
+		kafkaDriverArgs.Marshaller, err = factoryMarshalizer.NewMarshalizer(externalConfig.KafkaDriverConfig.MarshallerType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	elasticSearchConfig := externalConfig.ElasticSearchConnector
+	outportHandler, err := outportDriverFactory.CreateOutport(&outportDriverFactory.OutportFactoryArgs{
+		ShardID:         selfShardID,
+		RetrialInterval: common.RetrialIntervalForOutportDriver,
+		ElasticIndexerFactoryArgs: indexerFactory.ArgsIndexerFactory{
+			Enabled:                  elasticSearchConfig.Enabled,
+			BulkRequestMaxSize:       elasticSearchConfig.BulkRequestMaxSizeInBytes,
+			Url:                      elasticSearchConfig.URL,
+			UserName:                 elasticSearchConfig.Username,
+			Password:                 elasticSearchConfig.Password,
+			Marshalizer:              coreComponents.InternalMarshalizer(),
+			Hasher:                   coreComponents.Hasher(),
+			AddressPubkeyConverter:   coreComponents.AddressPubKeyConverter(),
+			ValidatorPubkeyConverter: coreComponents.ValidatorPubKeyConverter(),
+			EnabledIndexes:           elasticSearchConfig.EnabledIndexes,
+			Denomination:             configs.economicsConfig.GlobalSettings.Denomination,
+			UseKibana:                elasticSearchConfig.UseKibana,
+			HeaderMarshaller:         coreComponents.InternalMarshalizer(),
+		},
+		ElasticBufferConfig: elasticSearchConfig.Buffer,
+		EventNotifierFactoryArgs: &outportDriverFactory.EventNotifierFactoryArgs{
+			Enabled:           externalConfig.EventNotifierConnector.Enabled,
+			UseAuthorization:  externalConfig.EventNotifierConnector.UseAuthorization,
+			ProxyUrl:          externalConfig.EventNotifierConnector.ProxyUrl,
+			Username:          externalConfig.EventNotifierConnector.Username,
+			Password:          externalConfig.EventNotifierConnector.Password,
+			RequestTimeoutSec: externalConfig.EventNotifierConnector.RequestTimeoutSec,
+			Marshaller:        eventNotifierMarshaller,
+			AddressConverter:  coreComponents.AddressPubKeyConverter(),
+			Subscriptions:     externalConfig.EventNotifierConnector.Subscriptions,
+			BufferConfig:      externalConfig.EventNotifierConnector.Buffer,
+		},
+		HostDriversArgs:  hostDriversArgs,
+		FileDriverArgs:   fileDriverArgs,
+		KafkaDriverArgs:  kafkaDriverArgs,
+		AppStatusHandler: statusHandler.NewNilStatusHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !outportHandler.HasDrivers() {
+		log.LogIfError(outportHandler.Close())
+		return nil, errNoOutportDriver
+	}
+
+	return outportHandler, nil
+}
+
+func createReplayer(
+	coreComponents mainFactory.CoreComponentsHolder,
+	shardCoordinator sharding.Coordinator,
+	store dataRetriever.StorageService,
+	accountsDB state.AccountsAdapter,
+	outportHandler outport.OutportHandler,
+) (replay.OutportReplayer, error) {
+	esdtDataStorage, err := vmcommonBuiltInFunctions.NewESDTDataStorage(vmcommonBuiltInFunctions.ArgsNewESDTDataStorage{
+		Accounts:              accountsDB,
+		GlobalSettingsHandler: disabled.NewDisabledGlobalSettingHandler(),
+		Marshalizer:           coreComponents.InternalMarshalizer(),
+		ShardCoordinator:      shardCoordinator,
+		EnableEpochsHandler:   coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsRepository, err := receipts.NewReceiptsRepository(receipts.ArgsNewReceiptsRepository{
+		Marshaller: coreComponents.InternalMarshalizer(),
+		Hasher:     coreComponents.Hasher(),
+		Store:      store,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return replay.NewOutportReplayer(replay.ArgsOutportReplayer{
+		Store:                  store,
+		Marshaller:             coreComponents.InternalMarshalizer(),
+		Hasher:                 coreComponents.Hasher(),
+		Uint64Converter:        coreComponents.Uint64ByteSliceConverter(),
+		AddressConverter:       coreComponents.AddressPubKeyConverter(),
+		ShardCoordinator:       shardCoordinator,
+		AccountsDB:             accountsDB,
+		EsdtDataStorageHandler: esdtDataStorage,
+		EconomicsData:          coreComponents.EconomicsData(),
+		EnableEpochsHandler:    coreComponents.EnableEpochsHandler(),
+		EpochNotifier:          coreComponents.EpochNotifier(),
+		NodesCoordinator:       bootstrapDisabled.NewNodesCoordinator(),
+		ReceiptsRepository:     receiptsRepository,
+		Outport:                outportHandler,
+	})
+}
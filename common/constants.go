@@ -51,6 +51,42 @@ const AuctionList PeerType = "auction"
 // their top up to be distributed on the WaitingList in the next epoch
 const SelectedFromAuctionList PeerType = "selectedFromAuction"
 
+// GovernanceProposalActive is the status of a governance proposal that was not closed yet
+const GovernanceProposalActive = "active"
+
+// GovernanceProposalClosed is the status of a governance proposal that was closed
+const GovernanceProposalClosed = "closed"
+
+// ValidatorStatusChangeReason represents the reason of a validator's list change
+type ValidatorStatusChangeReason string
+
+// ValidatorJailed is the reason of a validator moved to the jailed list because of its low rating
+const ValidatorJailed ValidatorStatusChangeReason = "jailed"
+
+// ValidatorUnJailed is the reason of a validator which left the jailed list
+const ValidatorUnJailed ValidatorStatusChangeReason = "unJailed"
+
+// ValidatorLeaving is the reason of a validator which is leaving, after being unstaked or left without enough stake
+const ValidatorLeaving ValidatorStatusChangeReason = "leaving"
+
+// ValidatorStaked is the reason of a newly registered validator
+const ValidatorStaked ValidatorStatusChangeReason = "staked"
+
+// ValidatorRemoved is the reason of a validator which is no longer registered
+const ValidatorRemoved ValidatorStatusChangeReason = "removed"
+
+// ValidatorAuction is the reason of a validator moved to, or kept out of the consensus in, the auction list
+const ValidatorAuction ValidatorStatusChangeReason = "auction"
+
+// ValidatorSelectedFromAuction is the reason of a validator selected from the auction list
+const ValidatorSelectedFromAuction ValidatorStatusChangeReason = "selectedFromAuction"
+
+// ValidatorShuffled is the reason of a validator moved by the nodes shuffler between the eligible and waiting lists
+const ValidatorShuffled ValidatorStatusChangeReason = "shuffled"
+
+// ValidatorListChanged is the reason of any other list change
+const ValidatorListChanged ValidatorStatusChangeReason = "listChanged"
+
 // CombinedPeerType - represents the combination of two peerTypes
 const CombinedPeerType = "%s (%s)"
 
@@ -416,6 +452,18 @@ const AsyncCallStepField = "AsyncCallStep"
 // before executing the destination async call, to be put aside for the async callback
 const AsyncCallbackGasLockField = "AsyncCallbackGasLock"
 
+// GasScheduleEntryChangeType defines how a gas schedule entry changed between two epochs
+type GasScheduleEntryChangeType string
+
+// GasScheduleEntryAdded signals a gas schedule entry which exists only in the newer gas schedule
+const GasScheduleEntryAdded GasScheduleEntryChangeType = "added"
+
+// GasScheduleEntryRemoved signals a gas schedule entry which exists only in the older gas schedule
+const GasScheduleEntryRemoved GasScheduleEntryChangeType = "removed"
+
+// GasScheduleEntryModified signals a gas schedule entry which has a different value in the newer gas schedule
+const GasScheduleEntryModified GasScheduleEntryChangeType = "modified"
+
 const (
 	// MetricScDeployEnableEpoch represents the epoch when the deployment of smart contracts is enabled
 	MetricScDeployEnableEpoch = "erd_smart_contract_deploy_enable_epoch"
@@ -645,6 +693,8 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// ParameterChangesOrder defines the order in which the governance parameter changes provider is notified of a start of epoch event
+	ParameterChangesOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -674,6 +724,33 @@ const MetricP2PIntraShardObservers = "erd_p2p_intra_shard_observers"
 // MetricP2PCrossShardObservers is the metric that outputs the cross-shard connected observers
 const MetricP2PCrossShardObservers = "erd_p2p_cross_shard_observers"
 
+// MetricInterceptorsLaneQueueDepthPrefix is the metric prefix for the number of intercepted messages waiting in a
+// priority lane. The topic class is appended to the prefix
+const MetricInterceptorsLaneQueueDepthPrefix = "erd_interceptors_lane_queue_depth_"
+
+// MetricInterceptorsLaneRunningPrefix is the metric prefix for the number of workers busy on a priority lane
+const MetricInterceptorsLaneRunningPrefix = "erd_interceptors_lane_running_"
+
+// MetricInterceptorsLaneDroppedPrefix is the metric prefix for the number of intercepted messages dropped by a
+// priority lane
+const MetricInterceptorsLaneDroppedPrefix = "erd_interceptors_lane_dropped_"
+
+// MetricInterceptorsLaneProcessedPrefix is the metric prefix for the number of intercepted messages processed by a
+// priority lane
+const MetricInterceptorsLaneProcessedPrefix = "erd_interceptors_lane_processed_"
+
+// MetricOutportDriverLagInBlocksPrefix is the metric prefix for the number of blocks queued by a non-blocking outport
+// driver and not yet delivered. The driver name is appended to the prefix
+const MetricOutportDriverLagInBlocksPrefix = "erd_outport_driver_lag_in_blocks_"
+
+// MetricOutportDriverLagInBytesPrefix is the metric prefix for the size of the items queued by a non-blocking outport
+// driver and not yet delivered
+const MetricOutportDriverLagInBytesPrefix = "erd_outport_driver_lag_in_bytes_"
+
+// MetricOutportDriverDroppedPrefix is the metric prefix for the number of items dropped by a non-blocking outport
+// driver because of its maximum lag policy
+const MetricOutportDriverDroppedPrefix = "erd_outport_driver_dropped_"
+
 // MetricP2PUnknownPeers is the metric that outputs the unknown-shard connected peers
 const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 
@@ -696,6 +773,15 @@ const TriggerRegistryInitialKeyPrefix = "initial_value_epoch_"
 // NodesCoordinatorRegistryKeyPrefix is the key prefix to save epoch start registry to storage
 const NodesCoordinatorRegistryKeyPrefix = "indexHashed_"
 
+// RewardsBreakdownKeyPrefix is the key prefix to save the rewards breakdown of an epoch to storage
+const RewardsBreakdownKeyPrefix = "rewardsBreakdown_"
+
+// ValidatorPerformanceKeyPrefix is the key prefix to save the activity of a validator during an epoch to storage
+const ValidatorPerformanceKeyPrefix = "validatorPerformance_"
+
+// TopicSaveValidatorsStatusChanges is the outport topic used to push the validators' status changes of an epoch start
+const TopicSaveValidatorsStatusChanges = "SaveValidatorsStatusChanges"
+
 // ShuffledOut signals that a restart is pending because the node was shuffled out
 const ShuffledOut = "shuffledOut"
 
@@ -1014,5 +1100,9 @@ const (
 	CleanupAuctionOnLowWaitingListFlag                 core.EnableEpochFlag = "CleanupAuctionOnLowWaitingListFlag"
 	StakingV4StartedFlag                               core.EnableEpochFlag = "StakingV4StartedFlag"
 	AlwaysMergeContextsInEEIFlag                       core.EnableEpochFlag = "AlwaysMergeContextsInEEIFlag"
+	GovernanceParameterChangesFlag                     core.EnableEpochFlag = "GovernanceParameterChangesFlag"
+	DelegationReceiptTokensFlag                        core.EnableEpochFlag = "DelegationReceiptTokensFlag"
+	ESDTComplianceFlag                                 core.EnableEpochFlag = "ESDTComplianceFlag"
+	ScheduledCallsFlag                                 core.EnableEpochFlag = "ScheduledCallsFlag"
 	// all new flags must be added to createAllFlagsMap method, as part of enableEpochsHandler allFlagsDefined
 )
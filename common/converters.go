@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -51,3 +52,13 @@ func AssignShardForPubKeyWhenNotSpecified(pubKey []byte, numShards uint32) uint3
 func SuffixedMetric(metric string, suffix string) string {
 	return fmt.Sprintf("%s%s", metric, suffix)
 }
+
+// RewardsBreakdownKey returns the storage key of the rewards breakdown computed for the provided epoch
+func RewardsBreakdownKey(epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%d", RewardsBreakdownKeyPrefix, epoch))
+}
+
+// ValidatorPerformanceKey returns the storage key of the activity of the provided validator during the provided epoch
+func ValidatorPerformanceKey(blsKey []byte, epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s_%d", ValidatorPerformanceKeyPrefix, hex.EncodeToString(blsKey), epoch))
+}
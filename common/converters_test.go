@@ -375,3 +375,17 @@ func TestSuffixedMetric(t *testing.T) {
 	expectedMetric = providedMetric + providedSuffix
 	require.Equal(t, expectedMetric, common.SuffixedMetric(providedMetric, providedSuffix))
 }
+
+func TestRewardsBreakdownKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte(common.RewardsBreakdownKeyPrefix+"0"), common.RewardsBreakdownKey(0))
+	require.Equal(t, []byte(common.RewardsBreakdownKeyPrefix+"1234"), common.RewardsBreakdownKey(1234))
+}
+
+func TestValidatorPerformanceKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte(common.ValidatorPerformanceKeyPrefix+"626c73_0"), common.ValidatorPerformanceKey([]byte("bls"), 0))
+	require.Equal(t, []byte(common.ValidatorPerformanceKeyPrefix+"626c73_1234"), common.ValidatorPerformanceKey([]byte("bls"), 1234))
+}
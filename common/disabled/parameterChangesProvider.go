@@ -0,0 +1,26 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-go/common/governance"
+
+// parameterChangesProvider is the disabled implementation for the governance parameter changes provider
+type parameterChangesProvider struct {
+}
+
+// NewParameterChangesProvider creates a new instance of type parameterChangesProvider
+func NewParameterChangesProvider() *parameterChangesProvider {
+	return &parameterChangesProvider{}
+}
+
+// ParameterChanges returns an empty slice
+func (provider *parameterChangesProvider) ParameterChanges() ([]*governance.ParameterChange, error) {
+	return make([]*governance.ParameterChange, 0), nil
+}
+
+// RegisterHandler does nothing
+func (provider *parameterChangesProvider) RegisterHandler(_ governance.ParameterChangesHandler) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *parameterChangesProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,21 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameterChangesProvider_ParameterChanges(t *testing.T) {
+	t.Parallel()
+
+	provider := NewParameterChangesProvider()
+	assert.False(t, check.IfNil(provider))
+
+	provider.RegisterHandler(nil)
+
+	changes, err := provider.ParameterChanges()
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+}
@@ -1,3 +1,5 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. rewardsBreakdown.proto validatorPerformance.proto validatorsStatusChanges.proto
+
 package common
 
 import (
@@ -80,6 +82,7 @@ type AlteredAccountsForBlockAPIResponse struct {
 type AuctionNode struct {
 	BlsKey    string `json:"blsKey"`
 	Qualified bool   `json:"qualified"`
+	Simulated bool   `json:"simulated,omitempty"`
 }
 
 // AuctionListValidatorAPIResponse holds the data needed for an auction node validator for responding to API calls
@@ -91,3 +94,286 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// AuctionSimulationArgs holds the hypothetical changes of an owner to be applied over the current staking data when
+// simulating the auction list selection
+type AuctionSimulationArgs struct {
+	Owner           string `json:"owner"`
+	AdditionalTopUp string `json:"topUp"`
+	AdditionalNodes uint32 `json:"numNodes"`
+}
+
+// AuctionSimulationAPIResponse holds the outcome of an auction list selection simulation for responding to API calls
+type AuctionSimulationAPIResponse struct {
+	AuctionList    []*AuctionListValidatorAPIResponse `json:"auctionList"`
+	Owner          *AuctionListValidatorAPIResponse   `json:"owner"`
+	ThresholdTopUp string                             `json:"thresholdTopUp"`
+	MarginalNode   *AuctionMarginalNode               `json:"marginalNode"`
+}
+
+// AuctionMarginalNode holds the data of the last node selected from the auction list, the one with the lowest qualified top-up
+type AuctionMarginalNode struct {
+	Owner          string `json:"owner"`
+	BlsKey         string `json:"blsKey"`
+	QualifiedTopUp string `json:"qualifiedTopUp"`
+}
+
+// ValidatorScorecard holds the per epoch history of a validator for responding to API calls, most recent epoch first
+type ValidatorScorecard struct {
+	BlsKey       string                     `json:"blsKey"`
+	Epochs       []*ValidatorEpochScorecard `json:"epochs"`
+	JailEvents   []uint32                   `json:"jailEvents"`
+	ShardChanges []*ValidatorShardChange    `json:"shardChanges"`
+}
+
+// ValidatorEpochScorecard holds the activity of a validator during an epoch. The ratings are percentages of the
+// maximum rating, as in the validator statistics
+type ValidatorEpochScorecard struct {
+	Epoch             uint32  `json:"epoch"`
+	ShardID           uint32  `json:"shardID"`
+	List              string  `json:"list"`
+	ProposedBlocks    uint32  `json:"proposedBlocks"`
+	MissedBlocks      uint32  `json:"missedBlocks"`
+	SignedBlocks      uint32  `json:"signedBlocks"`
+	MissedSignatures  uint32  `json:"missedSignatures"`
+	IgnoredSignatures uint32  `json:"ignoredSignatures"`
+	StartRating       float32 `json:"startRating"`
+	EndRating         float32 `json:"endRating"`
+}
+
+// ValidatorShardChange holds a shuffling of a validator between shards. The epoch is the first one spent in the new shard
+type ValidatorShardChange struct {
+	Epoch     uint32 `json:"epoch"`
+	FromShard uint32 `json:"fromShard"`
+	ToShard   uint32 `json:"toShard"`
+}
+
+// ManagedKeysReloadResult holds the outcome of a managed keys reload operation. The public keys are hex encoded
+type ManagedKeysReloadResult struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Pending []string `json:"pending"`
+}
+
+// PeerReputationState holds the peers' reputation as exposed on the admin API. Peer IDs are base58 encoded,
+// public keys are hex encoded
+type PeerReputationState struct {
+	BannedPeers      []PeerReputationBan           `json:"bannedPeers"`
+	BannedPublicKeys []PeerReputationBan           `json:"bannedPublicKeys"`
+	BannedIPRanges   []PeerReputationBan           `json:"bannedIPRanges"`
+	PinnedPeers      []string                      `json:"pinnedPeers"`
+	HonestyScores    map[string]map[string]float64 `json:"honestyScores"`
+}
+
+// PeerReputationBan holds a banned identifier. A zero ExpiresAt value (unix seconds) marks a permanent ban
+type PeerReputationBan struct {
+	Identifier string `json:"identifier"`
+	ExpiresAt  int64  `json:"expiresAt"`
+	IsManual   bool   `json:"isManual"`
+}
+
+// AddressTransactionsQueryOptions holds the pagination and filtering options used when querying an address' transactions
+type AddressTransactionsQueryOptions struct {
+	From      uint64
+	Size      uint64
+	Token     string
+	Direction string
+}
+
+// AddressTransaction holds an entry of the address to transactions index. The transaction hash is hex encoded
+type AddressTransaction struct {
+	TxHash    string `json:"txHash"`
+	Epoch     uint32 `json:"epoch"`
+	Nonce     uint64 `json:"blockNonce"`
+	Type      string `json:"type"`
+	Direction string `json:"direction"`
+	Token     string `json:"token,omitempty"`
+}
+
+// EventsQueryOptions holds the filters and the pagination options of an events query, as received on the API. The
+// address is bech32 encoded and the topic is hex encoded
+type EventsQueryOptions struct {
+	Address    string
+	Identifier string
+	Topic      string
+	FromNonce  uint64
+	ToNonce    uint64
+	From       uint64
+	Size       uint64
+}
+
+// EventsQuery holds the decoded filters and the pagination options of an events query
+type EventsQuery struct {
+	Address    []byte
+	Identifier []byte
+	Topic      []byte
+	FromNonce  uint64
+	ToNonce    uint64
+	From       uint64
+	Size       uint64
+}
+
+// IndexedEvent holds an event returned by the events index
+type IndexedEvent struct {
+	TxHash     []byte
+	BlockNonce uint64
+	Address    []byte
+	Identifier []byte
+	Topics     [][]byte
+	Data       []byte
+}
+
+// IndexedEventAPI holds an event returned by the events index, as exposed on the API
+type IndexedEventAPI struct {
+	TxHash     string   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// BalanceHistoryQueryOptions holds the filters used when querying the balance history of an address. An empty token
+// selects the EGLD balance, while a step greater than one merges the changes from each interval of step blocks
+type BalanceHistoryQueryOptions struct {
+	Token     string
+	FromNonce uint64
+	ToNonce   uint64
+	Step      uint64
+}
+
+// BalanceChange holds a change of an address' balance. The balance and the delta are in base 10, while the hashes of
+// the transactions that caused the change are hex encoded
+type BalanceChange struct {
+	Nonce    uint64   `json:"blockNonce"`
+	Epoch    uint32   `json:"epoch"`
+	Balance  string   `json:"balance"`
+	Delta    string   `json:"delta"`
+	TxHashes []string `json:"txHashes"`
+}
+
+// ESDTHoldersAPIResponse holds the number of holders of a token from the current shard, together with its top holders
+type ESDTHoldersAPIResponse struct {
+	NumHolders uint64                   `json:"numHolders"`
+	TopHolders []*ESDTHolderAPIResponse `json:"topHolders"`
+}
+
+// ESDTHolderAPIResponse holds the bech32 address and the balance of a token holder
+type ESDTHolderAPIResponse struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// ESDTVolumeAPIResponse holds the minted, burned and transferred amounts of a token during an epoch
+type ESDTVolumeAPIResponse struct {
+	Epoch       uint32 `json:"epoch"`
+	Minted      string `json:"minted"`
+	Burned      string `json:"burned"`
+	Transferred string `json:"transferred"`
+}
+
+// TransactionLifecycleAPIResponse holds the processing stages of a transaction, as seen from the current shard, together
+// with the stages of the smart contract results generated by it
+type TransactionLifecycleAPIResponse struct {
+	Hash        string                                   `json:"hash"`
+	Completed   bool                                     `json:"completed"`
+	Stages      []*TransactionLifecycleStageAPIResponse  `json:"stages"`
+	ReceiptHash string                                   `json:"receiptHash,omitempty"`
+	Results     []*TransactionLifecycleResultAPIResponse `json:"results,omitempty"`
+}
+
+// TransactionLifecycleResultAPIResponse holds the processing stages of a smart contract result
+type TransactionLifecycleResultAPIResponse struct {
+	Hash     string                                  `json:"hash"`
+	IsRefund bool                                    `json:"isRefund"`
+	Stages   []*TransactionLifecycleStageAPIResponse `json:"stages"`
+}
+
+// TransactionLifecycleStageAPIResponse holds a processing stage of a transaction. The block fields of the other shards
+// are taken from the metachain block notarizing them, so they are empty until that block is known by the current shard
+type TransactionLifecycleStageAPIResponse struct {
+	Stage      string `json:"stage"`
+	ShardID    uint32 `json:"shardID"`
+	Completed  bool   `json:"completed"`
+	BlockNonce uint64 `json:"blockNonce,omitempty"`
+	BlockHash  string `json:"blockHash,omitempty"`
+	Timestamp  int64  `json:"timestamp,omitempty"`
+}
+
+// GovernanceProposalAPIResponse holds a proposal of the governance system smart contract, together with its vote tallies
+// and quorum progress. The amounts and the voting powers are in base 10. The delegated votes are filled only when a
+// single proposal is requested
+type GovernanceProposalAPIResponse struct {
+	Nonce            uint64                                  `json:"nonce"`
+	CommitHash       string                                  `json:"commitHash"`
+	Issuer           string                                  `json:"issuer"`
+	Cost             string                                  `json:"cost"`
+	StartVoteEpoch   uint64                                  `json:"startVoteEpoch"`
+	EndVoteEpoch     uint64                                  `json:"endVoteEpoch"`
+	Status           string                                  `json:"status"`
+	Passed           bool                                    `json:"passed"`
+	Votes            *GovernanceVotesAPIResponse             `json:"votes"`
+	Quorum           *GovernanceQuorumAPIResponse            `json:"quorum"`
+	ParameterChanges []*GovernanceParameterChangeAPIResponse `json:"parameterChanges,omitempty"`
+	ActivationEpoch  uint64                                  `json:"activationEpoch,omitempty"`
+	DelegatedVotes   []*GovernanceDelegatedVotesAPIResponse  `json:"delegatedVotes,omitempty"`
+}
+
+// GovernanceVotesAPIResponse holds the voting power cast for each option of a governance proposal
+type GovernanceVotesAPIResponse struct {
+	Yes     string `json:"yes"`
+	No      string `json:"no"`
+	Veto    string `json:"veto"`
+	Abstain string `json:"abstain"`
+	Total   string `json:"total"`
+}
+
+// GovernanceQuorumAPIResponse holds the quorum progress of a governance proposal. The required quorum is computed out of
+// the current total stake, while the progress is the percentage of the required quorum reached by the cast votes
+type GovernanceQuorumAPIResponse struct {
+	Stake    string  `json:"stake"`
+	Required string  `json:"required"`
+	Progress float64 `json:"progress"`
+	Reached  bool    `json:"reached"`
+}
+
+// GovernanceParameterChangeAPIResponse holds a protocol parameter change carried by a governance proposal
+type GovernanceParameterChangeAPIResponse struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GovernanceDelegatedVotesAPIResponse holds the stake and the voting power of a delegation contract used by its
+// delegators to vote on a governance proposal
+type GovernanceDelegatedVotesAPIResponse struct {
+	DelegationContract string `json:"delegationContract"`
+	UsedStake          string `json:"usedStake"`
+	UsedPower          string `json:"usedPower"`
+	TotalStake         string `json:"totalStake"`
+	TotalPower         string `json:"totalPower"`
+}
+
+// GovernanceVoterAPIResponse holds the voting power of an address, together with the nonces of the governance proposals
+// it voted on, either directly or through delegation contracts
+type GovernanceVoterAPIResponse struct {
+	Address        string   `json:"address"`
+	VotingPower    string   `json:"votingPower"`
+	DirectVotes    []uint64 `json:"directVotes"`
+	DelegatedVotes []uint64 `json:"delegatedVotes"`
+}
+
+// GasScheduleEntryChangeAPIResponse holds a gas schedule entry which differs between two epochs
+type GasScheduleEntryChangeAPIResponse struct {
+	Section  string                     `json:"section"`
+	Name     string                     `json:"name"`
+	OldValue uint64                     `json:"oldValue"`
+	NewValue uint64                     `json:"newValue"`
+	Change   GasScheduleEntryChangeType `json:"change"`
+}
+
+// GasScheduleDiffAPIResponse holds the gas schedule entries which differ between two epochs
+type GasScheduleDiffAPIResponse struct {
+	FromEpoch uint32                               `json:"fromEpoch"`
+	ToEpoch   uint32                               `json:"toEpoch"`
+	Changes   []*GasScheduleEntryChangeAPIResponse `json:"changes"`
+}
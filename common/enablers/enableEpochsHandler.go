@@ -732,6 +732,30 @@ func (handler *enableEpochsHandler) createAllFlagsMap() {
 			},
 			activationEpoch: handler.enableEpochsConfig.AlwaysMergeContextsInEEIEnableEpoch,
 		},
+		common.GovernanceParameterChangesFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.GovernanceParameterChangesEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.GovernanceParameterChangesEnableEpoch,
+		},
+		common.DelegationReceiptTokensFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.DelegationReceiptTokensEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.DelegationReceiptTokensEnableEpoch,
+		},
+		common.ESDTComplianceFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.ESDTComplianceEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.ESDTComplianceEnableEpoch,
+		},
+		common.ScheduledCallsFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.ScheduledCallsEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.ScheduledCallsEnableEpoch,
+		},
 	}
 }
 
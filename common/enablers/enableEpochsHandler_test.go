@@ -116,6 +116,10 @@ func createEnableEpochsConfig() config.EnableEpochs {
 		StakingV4Step3EnableEpoch:                                98,
 		CleanupAuctionOnLowWaitingListEnableEpoch:                96,
 		AlwaysMergeContextsInEEIEnableEpoch:                      99,
+		GovernanceParameterChangesEnableEpoch:                    100,
+		DelegationReceiptTokensEnableEpoch:                       101,
+		ESDTComplianceEnableEpoch:                                102,
+		ScheduledCallsEnableEpoch:                                103,
 	}
 }
 
@@ -315,6 +319,10 @@ func TestEnableEpochsHandler_IsFlagEnabled(t *testing.T) {
 	require.True(t, handler.IsFlagEnabled(common.StakingV4Step3Flag))
 	require.True(t, handler.IsFlagEnabled(common.StakingV4StartedFlag))
 	require.True(t, handler.IsFlagEnabled(common.AlwaysMergeContextsInEEIFlag))
+	require.True(t, handler.IsFlagEnabled(common.GovernanceParameterChangesFlag))
+	require.True(t, handler.IsFlagEnabled(common.DelegationReceiptTokensFlag))
+	require.True(t, handler.IsFlagEnabled(common.ESDTComplianceFlag))
+	require.True(t, handler.IsFlagEnabled(common.ScheduledCallsFlag))
 }
 
 func TestEnableEpochsHandler_GetActivationEpoch(t *testing.T) {
@@ -431,6 +439,10 @@ func TestEnableEpochsHandler_GetActivationEpoch(t *testing.T) {
 	require.Equal(t, cfg.CleanupAuctionOnLowWaitingListEnableEpoch, handler.GetActivationEpoch(common.CleanupAuctionOnLowWaitingListFlag))
 	require.Equal(t, cfg.StakingV4Step1EnableEpoch, handler.GetActivationEpoch(common.StakingV4StartedFlag))
 	require.Equal(t, cfg.AlwaysMergeContextsInEEIEnableEpoch, handler.GetActivationEpoch(common.AlwaysMergeContextsInEEIFlag))
+	require.Equal(t, cfg.GovernanceParameterChangesEnableEpoch, handler.GetActivationEpoch(common.GovernanceParameterChangesFlag))
+	require.Equal(t, cfg.DelegationReceiptTokensEnableEpoch, handler.GetActivationEpoch(common.DelegationReceiptTokensFlag))
+	require.Equal(t, cfg.ESDTComplianceEnableEpoch, handler.GetActivationEpoch(common.ESDTComplianceFlag))
+	require.Equal(t, cfg.ScheduledCallsEnableEpoch, handler.GetActivationEpoch(common.ScheduledCallsFlag))
 }
 
 func TestEnableEpochsHandler_IsInterfaceNil(t *testing.T) {
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	// BuiltInFunctionESDTSetComplianceConfig is the built-in function used by the ESDT system SC to propagate the
+	// transfer restrictions of a token towards the system accounts of all shards
+	BuiltInFunctionESDTSetComplianceConfig = "ESDTSetComplianceConfig"
+	// BuiltInFunctionESDTSetComplianceEntries is the built-in function used by the ESDT system SC to propagate the
+	// allow list, deny list and per account transfer limit changes of a token towards the system accounts of all shards
+	BuiltInFunctionESDTSetComplianceEntries = "ESDTSetComplianceEntries"
+
+	// ESDTComplianceAllowList is the entry type of the addresses allowed to send and receive a token
+	ESDTComplianceAllowList = "allowList"
+	// ESDTComplianceDenyList is the entry type of the addresses forbidden to send or receive a token
+	ESDTComplianceDenyList = "denyList"
+	// ESDTComplianceTransferLimit is the entry type of the per account, per epoch, transfer limits of a token
+	ESDTComplianceTransferLimit = "transferLimit"
+
+	// ESDTComplianceKeyPrefix is the protected key prefix under which the compliance data of tokens is saved
+	ESDTComplianceKeyPrefix = core.ProtectedKeyPrefix + "compliance"
+	// ESDTComplianceConfigKeyPrefix is the key prefix of the token compliance configuration
+	ESDTComplianceConfigKeyPrefix = ESDTComplianceKeyPrefix + "config"
+	// ESDTComplianceUsageKeyPrefix is the key prefix, in the sender account, of the amount transferred in the current epoch
+	ESDTComplianceUsageKeyPrefix = ESDTComplianceKeyPrefix + "usage"
+)
+
+const (
+	complianceAllowListBit = byte(1) << iota
+	complianceDenyListBit
+	complianceTransferLimitBit
+)
+
+// ESDTComplianceConfig holds the transfer restrictions a token was issued with
+type ESDTComplianceConfig struct {
+	AllowList            bool
+	DenyList             bool
+	TransferLimit        bool
+	DefaultTransferLimit *big.Int
+}
+
+// NewESDTComplianceConfigFromBytes decodes a compliance configuration. An empty buffer means no restrictions
+func NewESDTComplianceConfigFromBytes(buff []byte) *ESDTComplianceConfig {
+	config := &ESDTComplianceConfig{
+		DefaultTransferLimit: big.NewInt(0),
+	}
+	if len(buff) == 0 {
+		return config
+	}
+
+	config.AllowList = buff[0]&complianceAllowListBit != 0
+	config.DenyList = buff[0]&complianceDenyListBit != 0
+	config.TransferLimit = buff[0]&complianceTransferLimitBit != 0
+	config.DefaultTransferLimit.SetBytes(buff[1:])
+
+	return config
+}
+
+// ToBytes encodes the compliance configuration as a flags byte followed by the default transfer limit
+func (config *ESDTComplianceConfig) ToBytes() []byte {
+	flags := byte(0)
+	if config.AllowList {
+		flags |= complianceAllowListBit
+	}
+	if config.DenyList {
+		flags |= complianceDenyListBit
+	}
+	if config.TransferLimit {
+		flags |= complianceTransferLimitBit
+	}
+
+	buff := []byte{flags}
+	if config.DefaultTransferLimit != nil {
+		buff = append(buff, config.DefaultTransferLimit.Bytes()...)
+	}
+
+	return buff
+}
+
+// HasRestrictions returns true if any of the transfer restrictions is enabled
+func (config *ESDTComplianceConfig) HasRestrictions() bool {
+	return config.AllowList || config.DenyList || config.TransferLimit
+}
+
+// ESDTComplianceConfigKey returns the key of the compliance configuration of the provided token
+func ESDTComplianceConfigKey(tokenID []byte) []byte {
+	return append([]byte(ESDTComplianceConfigKeyPrefix), tokenID...)
+}
+
+// ESDTComplianceEntryKey returns the key of an allow list, deny list or transfer limit entry of the provided token
+func ESDTComplianceEntryKey(entryType string, tokenID []byte, address []byte) []byte {
+	key := append([]byte(ESDTComplianceKeyPrefix+entryType), tokenID...)
+	return append(key, address...)
+}
+
+// ESDTComplianceUsageKey returns the key of the amount of the provided token transferred by an account in the current epoch
+func ESDTComplianceUsageKey(tokenID []byte) []byte {
+	return append([]byte(ESDTComplianceUsageKeyPrefix), tokenID...)
+}
+
+// IsValidESDTComplianceEntryType returns true if the provided entry type is a known compliance entry type
+func IsValidESDTComplianceEntryType(entryType string) bool {
+	switch entryType {
+	case ESDTComplianceAllowList, ESDTComplianceDenyList, ESDTComplianceTransferLimit:
+		return true
+	default:
+		return false
+	}
+}
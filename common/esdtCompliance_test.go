@@ -0,0 +1,35 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestESDTComplianceConfig_ToBytesAndBack(t *testing.T) {
+	t.Parallel()
+
+	config := NewESDTComplianceConfigFromBytes(nil)
+	require.False(t, config.HasRestrictions())
+	require.Equal(t, big.NewInt(0), config.DefaultTransferLimit)
+
+	config = &ESDTComplianceConfig{
+		AllowList:            true,
+		TransferLimit:        true,
+		DefaultTransferLimit: big.NewInt(1000),
+	}
+	decoded := NewESDTComplianceConfigFromBytes(config.ToBytes())
+	require.Equal(t, config, decoded)
+	require.True(t, decoded.HasRestrictions())
+}
+
+func TestESDTComplianceKeys(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte("ELRONDcomplianceconfigTKN-abcdef"), ESDTComplianceConfigKey([]byte("TKN-abcdef")))
+	require.Equal(t, []byte("ELRONDcomplianceallowListTKN-abcdefaddr"), ESDTComplianceEntryKey(ESDTComplianceAllowList, []byte("TKN-abcdef"), []byte("addr")))
+	require.Equal(t, []byte("ELRONDcomplianceusageTKN-abcdef"), ESDTComplianceUsageKey([]byte("TKN-abcdef")))
+	require.True(t, IsValidESDTComplianceEntryType(ESDTComplianceTransferLimit))
+	require.False(t, IsValidESDTComplianceEntryType("unknown"))
+}
@@ -2,6 +2,7 @@ package forking
 
 import (
 	"path/filepath"
+	"reflect"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 )
@@ -17,21 +19,24 @@ import (
 type GasScheduleMap = map[string]map[string]uint64
 
 type gasScheduleNotifier struct {
-	mutNotifier        sync.RWMutex
-	configDir          string
-	gasScheduleConfig  config.GasScheduleConfig
-	currentEpoch       uint32
-	lastGasSchedule    GasScheduleMap
-	handlers           []core.GasScheduleSubscribeHandler
-	wasmVMChangeLocker common.Locker
+	mutNotifier              sync.RWMutex
+	configDir                string
+	gasScheduleConfig        config.GasScheduleConfig
+	currentEpoch             uint32
+	lastGasSchedule          GasScheduleMap
+	lastOverrides            map[string]uint64
+	handlers                 []core.GasScheduleSubscribeHandler
+	wasmVMChangeLocker       common.Locker
+	parameterChangesProvider governance.ParameterChangesProvider
 }
 
 // ArgsNewGasScheduleNotifier defines the gas schedule notifier arguments
 type ArgsNewGasScheduleNotifier struct {
-	GasScheduleConfig  config.GasScheduleConfig
-	ConfigDir          string
-	EpochNotifier      process.EpochNotifier
-	WasmVMChangeLocker common.Locker
+	GasScheduleConfig        config.GasScheduleConfig
+	ConfigDir                string
+	EpochNotifier            process.EpochNotifier
+	WasmVMChangeLocker       common.Locker
+	ParameterChangesProvider governance.ParameterChangesProvider
 }
 
 // NewGasScheduleNotifier creates a new instance of a gasScheduleNotifier component
@@ -45,12 +50,17 @@ func NewGasScheduleNotifier(args ArgsNewGasScheduleNotifier) (*gasScheduleNotifi
 	if check.IfNilReflect(args.WasmVMChangeLocker) {
 		return nil, common.ErrNilWasmChangeLocker
 	}
+	if check.IfNil(args.ParameterChangesProvider) {
+		return nil, governance.ErrNilParameterChangesProvider
+	}
 
 	g := &gasScheduleNotifier{
-		gasScheduleConfig:  args.GasScheduleConfig,
-		handlers:           make([]core.GasScheduleSubscribeHandler, 0),
-		configDir:          args.ConfigDir,
-		wasmVMChangeLocker: args.WasmVMChangeLocker,
+		gasScheduleConfig:        args.GasScheduleConfig,
+		handlers:                 make([]core.GasScheduleSubscribeHandler, 0),
+		configDir:                args.ConfigDir,
+		wasmVMChangeLocker:       args.WasmVMChangeLocker,
+		parameterChangesProvider: args.ParameterChangesProvider,
+		lastOverrides:            make(map[string]uint64),
 	}
 	log.Debug("gasSchedule: enable epoch for gas schedule directories paths epoch", "epoch", g.gasScheduleConfig.GasScheduleByEpochs)
 
@@ -71,6 +81,7 @@ func NewGasScheduleNotifier(args ArgsNewGasScheduleNotifier) (*gasScheduleNotifi
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(g)
+	args.ParameterChangesProvider.RegisterHandler(g)
 
 	return g, nil
 }
@@ -114,7 +125,29 @@ func (g *gasScheduleNotifier) EpochConfirmed(epoch uint32, _ uint64) {
 		return
 	}
 
-	newGasSchedule := g.changeLatestGasSchedule(epoch, old)
+	newGasSchedule, err := g.changeLatestGasSchedule(epoch, old)
+	if err != nil {
+		log.Error("gasScheduleNotifier.EpochConfirmed: could not change the gas schedule", "epoch", epoch, "error", err)
+		return
+	}
+
+	g.notifyHandlers(newGasSchedule)
+}
+
+// ParameterChangesUpdated applies the gas schedule changes received by the parameter changes provider
+func (g *gasScheduleNotifier) ParameterChangesUpdated() error {
+	epoch := atomic.LoadUint32(&g.currentEpoch)
+	newGasSchedule, err := g.changeLatestGasSchedule(epoch, epoch)
+	if err != nil {
+		return err
+	}
+
+	g.notifyHandlers(newGasSchedule)
+
+	return nil
+}
+
+func (g *gasScheduleNotifier) notifyHandlers(newGasSchedule map[string]map[string]uint64) {
 	if newGasSchedule == nil {
 		return
 	}
@@ -128,31 +161,101 @@ func (g *gasScheduleNotifier) EpochConfirmed(epoch uint32, _ uint64) {
 	g.wasmVMChangeLocker.Unlock()
 }
 
-func (g *gasScheduleNotifier) changeLatestGasSchedule(epoch uint32, oldEpoch uint32) map[string]map[string]uint64 {
+// changeLatestGasSchedule returns the new gas schedule or nil if it did not change
+func (g *gasScheduleNotifier) changeLatestGasSchedule(epoch uint32, oldEpoch uint32) (map[string]map[string]uint64, error) {
 	g.mutNotifier.Lock()
 	defer g.mutNotifier.Unlock()
 	newVersion := g.getMatchingVersion(epoch)
 	oldVersion := g.getMatchingVersion(oldEpoch)
+	overrides, err := g.getGovernanceOverrides(epoch)
+	if err != nil {
+		return nil, err
+	}
 
-	if newVersion.StartEpoch == oldVersion.StartEpoch {
+	if newVersion.StartEpoch == oldVersion.StartEpoch && reflect.DeepEqual(overrides, g.lastOverrides) {
 		// gasSchedule is still the same
-		return nil
+		return nil, nil
 	}
 
 	newGasSchedule, err := common.LoadGasScheduleConfig(filepath.Join(g.configDir, newVersion.FileName))
 	if err != nil {
-		log.Error("could not load the new gas schedule")
-		return nil
+		return nil, err
 	}
 
+	applyGovernanceOverrides(newGasSchedule, overrides)
+
 	log.Debug("gasScheduleNotifier.EpochConfirmed new gas schedule",
 		"new epoch", epoch,
+		"num governance overrides", len(overrides),
 		"num handlers", len(g.handlers),
 	)
 
 	g.lastGasSchedule = newGasSchedule
+	g.lastOverrides = overrides
+
+	return newGasSchedule, nil
+}
+
+func applyGovernanceOverrides(gasSchedule map[string]map[string]uint64, overrides map[string]uint64) {
+	for name, value := range overrides {
+		section, entry, _ := governance.SplitGasScheduleParameter(name)
+		_, exists := gasSchedule[section][entry]
+		if !exists {
+			log.Warn("gasScheduleNotifier: governance change for an unknown gas schedule entry", "name", name)
+			continue
+		}
+
+		gasSchedule[section][entry] = value
+	}
+}
+
+// getGovernanceOverrides returns the gas schedule entries changed through governance that are active in the provided
+// epoch. The latest activated change of an entry wins
+func (g *gasScheduleNotifier) getGovernanceOverrides(epoch uint32) (map[string]uint64, error) {
+	overrides := make(map[string]uint64)
+	changes, err := g.parameterChangesProvider.ParameterChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range governance.ActiveChanges(changes, governance.GasSchedulePrefix, epoch) {
+		value, errParse := governance.ParseUint64Value(change.Name, change.Value)
+		if errParse != nil {
+			log.Warn("gasScheduleNotifier: invalid governance gas schedule change", "error", errParse)
+			continue
+		}
+		_, _, errSplit := governance.SplitGasScheduleParameter(change.Name)
+		if errSplit != nil {
+			log.Warn("gasScheduleNotifier: invalid governance gas schedule change", "error", errSplit)
+			continue
+		}
+
+		overrides[change.Name] = value
+	}
+
+	return overrides, nil
+}
+
+// GasScheduleForEpoch returns the gas schedule active in the provided epoch, as defined by the gas schedule versions
+// and the governance changes
+func (g *gasScheduleNotifier) GasScheduleForEpoch(epoch uint32) (map[string]map[string]uint64, error) {
+	g.mutNotifier.RLock()
+	defer g.mutNotifier.RUnlock()
+
+	version := g.getMatchingVersion(epoch)
+	gasSchedule, err := common.LoadGasScheduleConfig(filepath.Join(g.configDir, version.FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := g.getGovernanceOverrides(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	applyGovernanceOverrides(gasSchedule, overrides)
 
-	return newGasSchedule
+	return gasSchedule, nil
 }
 
 // LatestGasSchedule returns the latest gas schedule
@@ -1,6 +1,7 @@
 package forking
 
 import (
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -8,8 +9,10 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -27,9 +30,10 @@ func createGasScheduleNotifierArgs() ArgsNewGasScheduleNotifier {
 					FileName:   "gasScheduleV2.toml",
 				},
 			}},
-		ConfigDir:          "../../cmd/node/config/gasSchedules",
-		EpochNotifier:      NewGenericEpochNotifier(),
-		WasmVMChangeLocker: &sync.RWMutex{},
+		ConfigDir:                "../../cmd/node/config/gasSchedules",
+		EpochNotifier:            NewGenericEpochNotifier(),
+		WasmVMChangeLocker:       &sync.RWMutex{},
+		ParameterChangesProvider: &testscommon.ParameterChangesProviderStub{},
 	}
 }
 
@@ -59,6 +63,12 @@ func TestNewGasScheduleNotifierConstructorErrors(t *testing.T) {
 	g, err = NewGasScheduleNotifier(args)
 	assert.Equal(t, err, common.ErrNilWasmChangeLocker)
 	assert.Nil(t, g)
+
+	args = createGasScheduleNotifierArgs()
+	args.ParameterChangesProvider = nil
+	g, err = NewGasScheduleNotifier(args)
+	assert.Equal(t, err, governance.ErrNilParameterChangesProvider)
+	assert.Nil(t, g)
 }
 
 func TestNewGasScheduleNotifier(t *testing.T) {
@@ -164,6 +174,174 @@ func TestGasScheduleNotifier_CheckEpochShouldCall(t *testing.T) {
 	assert.Equal(t, uint64(300), g.LatestGasScheduleCopy()["BaseOperationCost"]["AoTPreparePerByte"])
 }
 
+func TestGasScheduleNotifier_EpochConfirmedShouldApplyGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := []*governance.ParameterChange{
+		{ProposalNonce: 1, Name: "GasSchedule.BaseOperationCost.StorePerByte", Value: "60000", ActivationEpoch: 5},
+		{ProposalNonce: 2, Name: "GasSchedule.BaseOperationCost.StorePerByte", Value: "70000", ActivationEpoch: 7},
+		{ProposalNonce: 2, Name: "GasSchedule.BaseOperationCost.UnknownEntry", Value: "1", ActivationEpoch: 7},
+		{ProposalNonce: 2, Name: governance.MaxGasLimitPerTx, Value: "1", ActivationEpoch: 7},
+	}
+	args := createGasScheduleNotifierArgs()
+	args.ParameterChangesProvider = &testscommon.ParameterChangesProviderStub{
+		ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+			return changes, nil
+		},
+	}
+	g, err := NewGasScheduleNotifier(args)
+	require.Nil(t, err)
+
+	numCalled := uint32(0)
+	g.RegisterNotifyHandler(&mock.GasScheduleSubscribeHandlerStub{
+		GasScheduleChangeCalled: func(gasMap map[string]map[string]uint64) {
+			atomic.AddUint32(&numCalled, 1)
+		},
+	})
+
+	g.EpochConfirmed(3, 0)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(50000), g.LatestGasSchedule()["BaseOperationCost"]["StorePerByte"])
+
+	g.EpochConfirmed(4, 0)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalled))
+
+	g.EpochConfirmed(5, 0)
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(60000), g.LatestGasSchedule()["BaseOperationCost"]["StorePerByte"])
+
+	g.EpochConfirmed(7, 0)
+	assert.Equal(t, uint32(4), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(70000), g.LatestGasSchedule()["BaseOperationCost"]["StorePerByte"])
+	_, exists := g.LatestGasSchedule()["BaseOperationCost"]["UnknownEntry"]
+	assert.False(t, exists)
+
+	g.EpochConfirmed(8, 0)
+	assert.Equal(t, uint32(4), atomic.LoadUint32(&numCalled))
+}
+
+func TestGasScheduleNotifier_GasScheduleForEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing gas schedule file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createGasScheduleNotifierArgs()
+		g, _ := NewGasScheduleNotifier(args)
+		g.configDir = "missing directory"
+
+		gasSchedule, err := g.GasScheduleForEpoch(1)
+		assert.Nil(t, gasSchedule)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the gas schedule of the requested epoch", func(t *testing.T) {
+		t.Parallel()
+
+		changes := []*governance.ParameterChange{
+			{ProposalNonce: 1, Name: "GasSchedule.BaseOperationCost.StorePerByte", Value: "60000", ActivationEpoch: 5},
+		}
+		args := createGasScheduleNotifierArgs()
+		args.ParameterChangesProvider = &testscommon.ParameterChangesProviderStub{
+			ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+				return changes, nil
+			},
+		}
+		g, _ := NewGasScheduleNotifier(args)
+		g.EpochConfirmed(7, 0)
+
+		gasSchedule, err := g.GasScheduleForEpoch(1)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(50), gasSchedule["BaseOperationCost"]["AoTPreparePerByte"])
+		assert.Equal(t, uint64(50000), gasSchedule["BaseOperationCost"]["StorePerByte"])
+
+		gasSchedule, err = g.GasScheduleForEpoch(3)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(300), gasSchedule["BaseOperationCost"]["AoTPreparePerByte"])
+		assert.Equal(t, uint64(50000), gasSchedule["BaseOperationCost"]["StorePerByte"])
+
+		gasSchedule, err = g.GasScheduleForEpoch(5)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(60000), gasSchedule["BaseOperationCost"]["StorePerByte"])
+
+		// the latest gas schedule should not be altered
+		assert.Equal(t, uint64(60000), g.LatestGasSchedule()["BaseOperationCost"]["StorePerByte"])
+	})
+}
+
+func TestGasScheduleNotifier_ParameterChangesUpdatedShouldApplyGovernanceChangesInCurrentEpoch(t *testing.T) {
+	t.Parallel()
+
+	changes := make([]*governance.ParameterChange, 0)
+	var registeredHandler governance.ParameterChangesHandler
+	args := createGasScheduleNotifierArgs()
+	args.ParameterChangesProvider = &testscommon.ParameterChangesProviderStub{
+		ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+			return changes, nil
+		},
+		RegisterHandlerCalled: func(handler governance.ParameterChangesHandler) {
+			registeredHandler = handler
+		},
+	}
+	g, err := NewGasScheduleNotifier(args)
+	require.Nil(t, err)
+	require.Equal(t, g, registeredHandler)
+
+	numCalled := uint32(0)
+	g.RegisterNotifyHandler(&mock.GasScheduleSubscribeHandlerStub{
+		GasScheduleChangeCalled: func(gasMap map[string]map[string]uint64) {
+			atomic.AddUint32(&numCalled, 1)
+		},
+	})
+	g.EpochConfirmed(5, 0)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalled))
+
+	err = registeredHandler.ParameterChangesUpdated()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalled))
+
+	changes = []*governance.ParameterChange{
+		{ProposalNonce: 1, Name: "GasSchedule.BaseOperationCost.StorePerByte", Value: "60000", ActivationEpoch: 5},
+		{ProposalNonce: 2, Name: "GasSchedule.BaseOperationCost.StorePerByte", Value: "70000", ActivationEpoch: 6},
+	}
+	err = registeredHandler.ParameterChangesUpdated()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(60000), g.LatestGasSchedule()["BaseOperationCost"]["StorePerByte"])
+}
+
+func TestGasScheduleNotifier_ParameterChangesErrorShouldKeepTheGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createGasScheduleNotifierArgs()
+	args.ParameterChangesProvider = &testscommon.ParameterChangesProviderStub{
+		ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+			return nil, expectedErr
+		},
+	}
+	g, err := NewGasScheduleNotifier(args)
+	require.Nil(t, err)
+
+	numCalled := uint32(0)
+	g.RegisterNotifyHandler(&mock.GasScheduleSubscribeHandlerStub{
+		GasScheduleChangeCalled: func(gasMap map[string]map[string]uint64) {
+			atomic.AddUint32(&numCalled, 1)
+		},
+	})
+
+	g.EpochConfirmed(3, 0)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(50000), g.LatestGasSchedule()["BaseOperationCost"]["StorePerByte"])
+
+	err = g.ParameterChangesUpdated()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalled))
+
+	gasSchedule, err := g.GasScheduleForEpoch(3)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, gasSchedule)
+}
+
 func TestGasScheduleNotifier_CheckEpochInSyncShouldWork(t *testing.T) {
 	t.Parallel()
 
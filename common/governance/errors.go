@@ -0,0 +1,15 @@
+package governance
+
+import "errors"
+
+// ErrUnknownParameter signals that the parameter can not be changed through a governance proposal
+var ErrUnknownParameter = errors.New("unknown parameter")
+
+// ErrInvalidParameterValue signals that an invalid value was provided for a parameter
+var ErrInvalidParameterValue = errors.New("invalid parameter value")
+
+// ErrNilParameterChangesProvider signals that a nil parameter changes provider was provided
+var ErrNilParameterChangesProvider = errors.New("nil parameter changes provider")
+
+// ErrInconsistentGasLimits signals that a maximum gas limit would become lower than the minimum gas limit
+var ErrInconsistentGasLimits = errors.New("inconsistent gas limits")
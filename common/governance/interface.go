@@ -0,0 +1,15 @@
+package governance
+
+// ParameterChangesProvider defines the component able to return the protocol parameter changes voted through governance
+type ParameterChangesProvider interface {
+	ParameterChanges() ([]*ParameterChange, error)
+	RegisterHandler(handler ParameterChangesHandler)
+	IsInterfaceNil() bool
+}
+
+// ParameterChangesHandler defines the component that applies the protocol parameter changes voted through governance
+// whenever the provider receives new ones
+type ParameterChangesHandler interface {
+	ParameterChangesUpdated() error
+	IsInterfaceNil() bool
+}
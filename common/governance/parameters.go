@@ -0,0 +1,194 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. parameterChanges.proto
+package governance
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the prefixes of the parameters that can be changed through a governance proposal. The enable epochs can not be
+// changed this way, as they are read while bootstrapping, before the start of epoch metablock carrying the changes is
+// available, and every node has to agree on them to process the blocks of any past epoch
+const (
+	GovernancePrefix  = "Governance."
+	EconomicsPrefix   = "Economics."
+	GasSchedulePrefix = "GasSchedule."
+)
+
+// the governance system smart contract parameters. The thresholds are expressed as 0-10000 percentages, the fees as
+// decimal big integers
+const (
+	MinQuorum        = GovernancePrefix + "MinQuorum"
+	MinPassThreshold = GovernancePrefix + "MinPassThreshold"
+	MinVetoThreshold = GovernancePrefix + "MinVetoThreshold"
+	ProposalFee      = GovernancePrefix + "ProposalFee"
+	LostProposalFee  = GovernancePrefix + "LostProposalFee"
+)
+
+// the economics gas limit parameters, expressed as decimal unsigned integers
+const (
+	MinGasLimit                 = EconomicsPrefix + "MinGasLimit"
+	ExtraGasLimitGuardedTx      = EconomicsPrefix + "ExtraGasLimitGuardedTx"
+	MaxGasLimitPerBlock         = EconomicsPrefix + "MaxGasLimitPerBlock"
+	MaxGasLimitPerMiniBlock     = EconomicsPrefix + "MaxGasLimitPerMiniBlock"
+	MaxGasLimitPerMetaBlock     = EconomicsPrefix + "MaxGasLimitPerMetaBlock"
+	MaxGasLimitPerMetaMiniBlock = EconomicsPrefix + "MaxGasLimitPerMetaMiniBlock"
+	MaxGasLimitPerTx            = EconomicsPrefix + "MaxGasLimitPerTx"
+)
+
+const maxPercentage = 10000
+const minPercentage = 10
+
+// ParameterChange holds a protocol parameter change voted through a passed governance proposal
+type ParameterChange = GovernanceParameterChange
+
+// CheckParameterChange returns an error if the provided parameter can not be changed through governance or if the
+// provided value is not valid for it. The gas schedule entries are named GasSchedule.<section>.<entry>
+func CheckParameterChange(name string, value string) error {
+	switch name {
+	case MinQuorum, MinPassThreshold, MinVetoThreshold:
+		percentage, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || percentage < minPercentage || percentage > maxPercentage {
+			return fmt.Errorf("%w for %s: %s, expected a value between %d and %d", ErrInvalidParameterValue, name, value, minPercentage, maxPercentage)
+		}
+		return nil
+	case ProposalFee, LostProposalFee:
+		fee, ok := big.NewInt(0).SetString(value, 10)
+		if !ok || fee.Sign() < 0 || (name == ProposalFee && fee.Sign() == 0) {
+			return fmt.Errorf("%w for %s: %s", ErrInvalidParameterValue, name, value)
+		}
+		return nil
+	case MinGasLimit, ExtraGasLimitGuardedTx, MaxGasLimitPerBlock, MaxGasLimitPerMiniBlock, MaxGasLimitPerMetaBlock,
+		MaxGasLimitPerMetaMiniBlock, MaxGasLimitPerTx:
+		_, err := ParseUint64Value(name, value)
+		return err
+	}
+
+	if strings.HasPrefix(name, GasSchedulePrefix) {
+		_, _, err := SplitGasScheduleParameter(name)
+		if err != nil {
+			return err
+		}
+
+		_, err = ParseUint64Value(name, value)
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownParameter, name)
+}
+
+// GasLimits holds the economics gas limits that have to stay consistent with each other, as none of the maximum gas
+// limits can be lower than the minimum gas limit
+type GasLimits struct {
+	MinGasLimit                 uint64
+	MaxGasLimitPerBlock         uint64
+	MaxGasLimitPerMiniBlock     uint64
+	MaxGasLimitPerMetaBlock     uint64
+	MaxGasLimitPerMetaMiniBlock uint64
+	MaxGasLimitPerTx            uint64
+}
+
+// ApplyGasLimitsChanges returns the gas limits resulting from applying, in order, the provided changes on the provided
+// gas limits. The changes of the other parameters are ignored
+func ApplyGasLimitsChanges(limits GasLimits, changes []*ParameterChange) (GasLimits, error) {
+	for _, change := range changes {
+		var field *uint64
+		switch change.Name {
+		case MinGasLimit:
+			field = &limits.MinGasLimit
+		case MaxGasLimitPerBlock:
+			field = &limits.MaxGasLimitPerBlock
+		case MaxGasLimitPerMiniBlock:
+			field = &limits.MaxGasLimitPerMiniBlock
+		case MaxGasLimitPerMetaBlock:
+			field = &limits.MaxGasLimitPerMetaBlock
+		case MaxGasLimitPerMetaMiniBlock:
+			field = &limits.MaxGasLimitPerMetaMiniBlock
+		case MaxGasLimitPerTx:
+			field = &limits.MaxGasLimitPerTx
+		default:
+			continue
+		}
+
+		value, err := ParseUint64Value(change.Name, change.Value)
+		if err != nil {
+			return limits, err
+		}
+		*field = value
+	}
+
+	return limits, nil
+}
+
+// CheckGasLimitsChanges returns an error if the gas limits resulting from applying, in order, the provided changes on
+// the gas limits that will be in effect are not consistent
+func CheckGasLimitsChanges(inEffect GasLimits, changes []*ParameterChange) error {
+	limits, err := ApplyGasLimitsChanges(inEffect, changes)
+	if err != nil {
+		return err
+	}
+
+	maxGasLimits := []struct {
+		name  string
+		value uint64
+	}{
+		{name: MaxGasLimitPerBlock, value: limits.MaxGasLimitPerBlock},
+		{name: MaxGasLimitPerMiniBlock, value: limits.MaxGasLimitPerMiniBlock},
+		{name: MaxGasLimitPerMetaBlock, value: limits.MaxGasLimitPerMetaBlock},
+		{name: MaxGasLimitPerMetaMiniBlock, value: limits.MaxGasLimitPerMetaMiniBlock},
+		{name: MaxGasLimitPerTx, value: limits.MaxGasLimitPerTx},
+	}
+	for _, maxGasLimit := range maxGasLimits {
+		if maxGasLimit.value < limits.MinGasLimit {
+			return fmt.Errorf("%w: %s = %d is lower than %s = %d",
+				ErrInconsistentGasLimits, maxGasLimit.name, maxGasLimit.value, MinGasLimit, limits.MinGasLimit)
+		}
+	}
+
+	return nil
+}
+
+// ParseUint64Value parses the value of a parameter expressed as decimal unsigned integer
+func ParseUint64Value(name string, value string) (uint64, error) {
+	result, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w for %s: %s", ErrInvalidParameterValue, name, value)
+	}
+
+	return result, nil
+}
+
+// SplitGasScheduleParameter returns the gas schedule section and entry of a GasSchedule.<section>.<entry> parameter
+func SplitGasScheduleParameter(name string) (string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(name, GasSchedulePrefix), ".")
+	if !strings.HasPrefix(name, GasSchedulePrefix) || len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("%w: %s, expected %s<section>.<entry>", ErrUnknownParameter, name, GasSchedulePrefix)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ActiveChanges returns, in activation order, the changes of the parameters with the provided prefix that are active
+// in the provided epoch. The changes activated in the same epoch are applied in the order of their proposals
+func ActiveChanges(changes []*ParameterChange, prefix string, epoch uint32) []*ParameterChange {
+	active := make([]*ParameterChange, 0)
+	for _, change := range changes {
+		if change == nil || change.ActivationEpoch > epoch || !strings.HasPrefix(change.Name, prefix) {
+			continue
+		}
+
+		active = append(active, change)
+	}
+
+	sort.SliceStable(active, func(i, j int) bool {
+		if active[i].ActivationEpoch != active[j].ActivationEpoch {
+			return active[i].ActivationEpoch < active[j].ActivationEpoch
+		}
+		return active[i].ProposalNonce < active[j].ProposalNonce
+	})
+
+	return active
+}
@@ -0,0 +1,78 @@
+package governance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckGasLimitsChanges(t *testing.T) {
+	t.Parallel()
+
+	inEffect := GasLimits{
+		MinGasLimit:                 50000,
+		MaxGasLimitPerBlock:         1500000000,
+		MaxGasLimitPerMiniBlock:     1500000000,
+		MaxGasLimitPerMetaBlock:     15000000000,
+		MaxGasLimitPerMetaMiniBlock: 15000000000,
+		MaxGasLimitPerTx:            1500000000,
+	}
+
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckGasLimitsChanges(inEffect, []*ParameterChange{{Name: MinGasLimit, Value: "abc"}})
+		require.True(t, errors.Is(err, ErrInvalidParameterValue))
+	})
+	t.Run("max gas limit lower than the min gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckGasLimitsChanges(inEffect, []*ParameterChange{{Name: MaxGasLimitPerTx, Value: "40000"}})
+		require.True(t, errors.Is(err, ErrInconsistentGasLimits))
+
+		err = CheckGasLimitsChanges(inEffect, []*ParameterChange{{Name: MinGasLimit, Value: "2000000000"}})
+		require.True(t, errors.Is(err, ErrInconsistentGasLimits))
+	})
+	t.Run("consistent changes should work", func(t *testing.T) {
+		t.Parallel()
+
+		changes := []*ParameterChange{
+			{Name: MaxGasLimitPerTx, Value: "40000"},
+			{Name: MinGasLimit, Value: "30000"},
+			{Name: ExtraGasLimitGuardedTx, Value: "1"},
+			{Name: MinQuorum, Value: "2000"},
+		}
+		require.Nil(t, CheckGasLimitsChanges(inEffect, changes))
+
+		limits, err := ApplyGasLimitsChanges(inEffect, changes)
+		require.Nil(t, err)
+		require.Equal(t, uint64(40000), limits.MaxGasLimitPerTx)
+		require.Equal(t, uint64(30000), limits.MinGasLimit)
+	})
+}
+
+func TestCheckParameterChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enable epochs can not be changed", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckParameterChange("EnableEpochs.StakingV4Step1EnableEpoch", "100")
+		require.True(t, errors.Is(err, ErrUnknownParameter))
+	})
+	t.Run("unknown gas schedule entry format should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckParameterChange(GasSchedulePrefix+"BaseOperationCost", "100")
+		require.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, CheckParameterChange(MinQuorum, "2000"))
+		require.Nil(t, CheckParameterChange(ProposalFee, "1000"))
+		require.Nil(t, CheckParameterChange(MaxGasLimitPerTx, "1500000000"))
+		require.Nil(t, CheckParameterChange(GasSchedulePrefix+"BaseOperationCost.StorePerByte", "10000"))
+	})
+}
@@ -278,6 +278,7 @@ type RootHashHolder interface {
 type GasScheduleNotifierAPI interface {
 	core.GasScheduleNotifier
 	LatestGasScheduleCopy() map[string]map[string]uint64
+	GasScheduleForEpoch(epoch uint32) (map[string]map[string]uint64, error)
 }
 
 // PidQueueHandler defines the behavior of a queue of pids
@@ -304,9 +305,19 @@ type EnableEpochsHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ManagedKeysReloader defines the operations of an entity able to change the managed keys while the node is running
+type ManagedKeysReloader interface {
+	AddManagedKey(privateKeyBytes []byte) (string, error)
+	RemoveManagedKey(pkBytes []byte) error
+	ReloadFromFile() (*ManagedKeysReloadResult, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ManagedPeersHolder defines the operations of an entity that holds managed identities for a node
 type ManagedPeersHolder interface {
 	AddManagedPeer(privateKeyBytes []byte) error
+	RemoveManagedPeer(pkBytes []byte) error
 	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentity(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineID(pkBytes []byte) (string, error)
@@ -14,6 +14,7 @@ func ProcessHistoricalBalancesMode(log logger.Logger, configs *config.Configs) {
 	configs.GeneralConfig.StoragePruning.AccountsTrieCleanOldEpochsData = false
 	configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled = false
 	configs.GeneralConfig.DbLookupExtensions.Enabled = true
+	configs.GeneralConfig.DbLookupExtensions.BalanceHistoryIndexEnabled = true
 	configs.PreferencesConfig.Preferences.FullArchive = true
 
 	log.Warn("the node is in historical balances mode! Will auto-set some config values",
@@ -24,6 +25,7 @@ func ProcessHistoricalBalancesMode(log logger.Logger, configs *config.Configs) {
 		"GeneralSettings.StartInEpochEnabled", configs.GeneralConfig.GeneralSettings.StartInEpochEnabled,
 		"StateTriesConfig.AccountsStatePruningEnabled", configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled,
 		"DbLookupExtensions.Enabled", configs.GeneralConfig.DbLookupExtensions.Enabled,
+		"DbLookupExtensions.BalanceHistoryIndexEnabled", configs.GeneralConfig.DbLookupExtensions.BalanceHistoryIndexEnabled,
 		"Preferences.FullArchive", configs.PreferencesConfig.Preferences.FullArchive,
 	)
 }
@@ -24,6 +24,7 @@ func TestProcessHistoricalBalancesMode(t *testing.T) {
 	assert.False(t, cfg.GeneralConfig.StoragePruning.AccountsTrieCleanOldEpochsData)
 	assert.False(t, cfg.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled)
 	assert.True(t, cfg.GeneralConfig.DbLookupExtensions.Enabled)
+	assert.True(t, cfg.GeneralConfig.DbLookupExtensions.BalanceHistoryIndexEnabled)
 	assert.True(t, cfg.PreferencesConfig.Preferences.FullArchive)
 }
 
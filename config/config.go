@@ -194,17 +194,19 @@ type Config struct {
 	PeerIdShardId         CacheConfig
 	PublicKeyPIDSignature CacheConfig
 	PeerHonesty           CacheConfig
-
-	Antiflood            AntifloodConfig
-	WebServerAntiflood   WebServerAntifloodConfig
-	ResourceStats        ResourceStatsConfig
-	HeartbeatV2          HeartbeatV2Config
-	ValidatorStatistics  ValidatorStatisticsConfig
-	GeneralSettings      GeneralSettingsConfig
-	Consensus            ConsensusConfig
-	StoragePruning       StoragePruningConfig
-	LogsAndEvents        LogsAndEventsConfig
-	HardwareRequirements HardwareRequirementsConfig
+	PeerReputation        PeerReputationConfig
+
+	Antiflood                 AntifloodConfig
+	InterceptorsPriorityLanes InterceptorsPriorityLanesConfig
+	WebServerAntiflood        WebServerAntifloodConfig
+	ResourceStats             ResourceStatsConfig
+	HeartbeatV2               HeartbeatV2Config
+	ValidatorStatistics       ValidatorStatisticsConfig
+	GeneralSettings           GeneralSettingsConfig
+	Consensus                 ConsensusConfig
+	StoragePruning            StoragePruningConfig
+	LogsAndEvents             LogsAndEventsConfig
+	HardwareRequirements      HardwareRequirementsConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -228,6 +230,7 @@ type Config struct {
 	PeersRatingConfig   PeersRatingConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	ManagedKeysReload   ManagedKeysReloadConfig
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -377,6 +380,43 @@ type AntifloodConfig struct {
 	Cache                               CacheConfig
 	Topic                               TopicAntifloodConfig
 	TxAccumulator                       TxAccumulatorConfig
+	Adaptive                            AdaptiveAntifloodConfig
+}
+
+// AdaptiveAntifloodConfig will hold the parameters used by the adaptive topic quotas mechanism
+type AdaptiveAntifloodConfig struct {
+	Enabled                               bool
+	UpdateIntervalInSeconds               uint32
+	TargetProcessingLatencyInMilliseconds uint32
+	MaxQueueDepth                         uint32
+	MinQuotaPercent                       uint32
+	DecreaseStepPercent                   uint32
+	RecoveryStepPercent                   uint32
+	LowHonestyScoreThreshold              float64
+}
+
+// InterceptorsPriorityLanesConfig will hold the settings of the priority lanes used when processing intercepted data
+type InterceptorsPriorityLanesConfig struct {
+	Enabled                        bool
+	NumWorkers                     uint32
+	MetricsUpdateIntervalInSeconds uint32
+	Lanes                          []InterceptorsPriorityLaneConfig
+}
+
+// InterceptorsPriorityLaneConfig will hold the settings of the priority lane of a topic class
+type InterceptorsPriorityLaneConfig struct {
+	Class      string
+	Weight     uint32
+	QueueSize  uint32
+	MaxWorkers uint32
+}
+
+// PeerReputationConfig will hold the settings of the store that keeps the peers' bans, pins and honesty scores
+// across node restarts
+type PeerReputationConfig struct {
+	Enabled                  bool
+	PersistIntervalInSeconds uint32
+	Storage                  StorageConfig
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
@@ -476,7 +516,15 @@ type DbLookupExtensionsConfig struct {
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
+	ESDTHoldersTrackingEnabled         bool
+	ESDTTopHoldersCount                uint32
 	RoundHashStorageConfig             StorageConfig
+	AddressTransactionsIndexEnabled    bool
+	AddressTransactionsStorageConfig   StorageConfig
+	EventsIndexEnabled                 bool
+	EventsIndexStorageConfig           StorageConfig
+	BalanceHistoryIndexEnabled         bool
+	BalanceHistoryStorageConfig        StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -541,9 +589,15 @@ type ProcessDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	Admin       ApiAdminConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// ApiAdminConfig holds the configuration related to the admin API endpoints
+type ApiAdminConfig struct {
+	TokenFile string
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -639,3 +693,9 @@ type PoolsCleanersConfig struct {
 type RedundancyConfig struct {
 	MaxRoundsOfInactivityAccepted int
 }
+
+// ManagedKeysReloadConfig represents the config options used when changing the managed keys while the node is running
+type ManagedKeysReloadConfig struct {
+	FileWatcherEnabled           bool
+	FileWatcherIntervalInSeconds int
+}
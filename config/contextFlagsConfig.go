@@ -28,6 +28,8 @@ type ContextFlagsConfig struct {
 	OperationMode                string
 	RepopulateTokensSupplies     bool
 	P2PPrometheusMetricsEnabled  bool
+	KeystorePassphraseFile       string
+	KeystorePassphraseEnv        string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -114,6 +114,10 @@ type EnableEpochs struct {
 	StakingV4Step3EnableEpoch                                uint32
 	CleanupAuctionOnLowWaitingListEnableEpoch                uint32
 	AlwaysMergeContextsInEEIEnableEpoch                      uint32
+	GovernanceParameterChangesEnableEpoch                    uint32
+	DelegationReceiptTokensEnableEpoch                       uint32
+	ESDTComplianceEnableEpoch                                uint32
+	ScheduledCallsEnableEpoch                                uint32
 	BLSMultiSignerEnableEpoch                                []MultiSignerConfig
 }
 
@@ -5,6 +5,8 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	FileDriverConfig       FileDriverConfig
+	KafkaDriverConfig      KafkaDriverConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -17,6 +19,7 @@ type ElasticSearchConfig struct {
 	Username                  string
 	Password                  string
 	EnabledIndexes            []string
+	Buffer                    OutportBufferConfig
 }
 
 // EventNotifierConfig will hold the configuration for the events notifier driver
@@ -28,6 +31,18 @@ type EventNotifierConfig struct {
 	Password          string
 	RequestTimeoutSec int
 	MarshallerType    string
+	Subscriptions     EventNotifierSubscriptionsConfig
+	Buffer            OutportBufferConfig
+}
+
+// EventNotifierSubscriptionsConfig will hold the filters applied by the events notifier driver. An empty list
+// matches everything, so an event is pushed if its address, identifier and one of its topics match the lists
+type EventNotifierSubscriptionsConfig struct {
+	Enabled                bool
+	Addresses              []string
+	Identifiers            []string
+	Topics                 []string
+	IncludeAlteredAccounts bool
 }
 
 // CovalentConfig will hold the configurations for covalent indexer
@@ -50,4 +65,39 @@ type HostDriversConfig struct {
 	RetryDurationInSec         int
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
+	Buffer                     OutportBufferConfig
+}
+
+// FileDriverConfig will hold the configuration for the append-only file driver
+type FileDriverConfig struct {
+	Enabled            bool
+	Directory          string
+	MarshallerType     string
+	MaxSegmentSizeInMB uint64
+	Buffer             OutportBufferConfig
+}
+
+// KafkaDriverConfig will hold the configuration for the Kafka driver
+type KafkaDriverConfig struct {
+	Enabled             bool
+	BrokerAddress       string
+	Topic               string
+	Partition           int32
+	ClientID            string
+	RequiredAcks        int16
+	RequestTimeoutInSec int
+	MarshallerType      string
+	Buffer              OutportBufferConfig
+}
+
+// OutportBufferConfig will hold the configuration of the non-blocking mode of an outport driver. When enabled, the
+// driver calls are queued in memory, spilled on disk once the in-memory queue is full, and delivered on a
+// separate goroutine so that block processing does not wait for the driver
+type OutportBufferConfig struct {
+	Enabled          bool
+	MaxItemsInMemory int
+	SpillDirectory   string
+	MaxLagInBlocks   uint64
+	MaxLagInMB       uint64
+	MaxLagPolicy     string
 }
@@ -847,6 +847,18 @@ func TestEnableEpochConfig(t *testing.T) {
     # CleanupAuctionOnLowWaitingListEnableEpoch represents the epoch when the cleanup auction on low waiting list is enabled
     CleanupAuctionOnLowWaitingListEnableEpoch = 95
 
+    # GovernanceParameterChangesEnableEpoch represents the epoch when the governance proposals carrying protocol parameter changes are enabled
+    GovernanceParameterChangesEnableEpoch = 96
+
+    # DelegationReceiptTokensEnableEpoch represents the epoch when the delegation contracts can mint liquid staking receipt tokens
+    DelegationReceiptTokensEnableEpoch = 97
+
+    # ESDTComplianceEnableEpoch represents the epoch when the ESDT transfer restrictions (allow list, deny list and per epoch transfer limits) are enabled
+    ESDTComplianceEnableEpoch = 98
+
+    # ScheduledCallsEnableEpoch represents the epoch when the scheduled calls system smart contract is enabled
+    ScheduledCallsEnableEpoch = 99
+
     # MaxNodesChangeEnableEpoch holds configuration for changing the maximum number of nodes and the enabling epoch
     MaxNodesChangeEnableEpoch = [
         { EpochEnable = 44, MaxNumNodes = 2169, NodesToShufflePerShard = 80 },
@@ -960,6 +972,10 @@ func TestEnableEpochConfig(t *testing.T) {
 			CurrentRandomnessOnSortingEnableEpoch:                    93,
 			AlwaysMergeContextsInEEIEnableEpoch:                      94,
 			CleanupAuctionOnLowWaitingListEnableEpoch:                95,
+			GovernanceParameterChangesEnableEpoch:                    96,
+			DelegationReceiptTokensEnableEpoch:                       97,
+			ESDTComplianceEnableEpoch:                                98,
+			ScheduledCallsEnableEpoch:                                99,
 			MaxNodesChangeEnableEpoch: []MaxNodesChangeConfig{
 				{
 					EpochEnable:            44,
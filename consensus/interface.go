@@ -107,6 +107,7 @@ type HeadersPoolSubscriber interface {
 // participating in consensus
 type PeerHonestyHandler interface {
 	ChangeScore(pk string, topic string, units int)
+	GetScore(pk string) float64
 	IsInterfaceNil() bool
 	Close() error
 }
@@ -191,5 +192,6 @@ type KeysHandler interface {
 	IsOriginalPublicKeyOfTheNode(pkBytes []byte) bool
 	ResetRoundsWithoutReceivedMessages(pkBytes []byte, pid core.PeerID)
 	GetRedundancyStepInReason() string
+	SetConsensusGroup(consensusGroup []string)
 	IsInterfaceNil() bool
 }
@@ -223,6 +223,9 @@ var ErrNilMessageSigningHandler = errors.New("nil message signing handler")
 // ErrNilPeerBlacklistHandler signals that the provided peer blacklist handler is nil
 var ErrNilPeerBlacklistHandler = errors.New("nil peer blacklist handler")
 
+// ErrNilPriorityLanes signals that the provided priority lanes handler is nil
+var ErrNilPriorityLanes = errors.New("nil priority lanes handler")
+
 // ErrNilPeerBlacklistCacher signals that a nil peer blacklist cacher has been provided
 var ErrNilPeerBlacklistCacher = errors.New("nil peer blacklist cacher")
 
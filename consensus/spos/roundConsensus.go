@@ -70,6 +70,7 @@ func (rcns *roundConsensus) ConsensusGroup() []string {
 // SetConsensusGroup sets the consensus group ID's
 func (rcns *roundConsensus) SetConsensusGroup(consensusGroup []string) {
 	rcns.consensusGroup = consensusGroup
+	rcns.keysHandler.SetConsensusGroup(consensusGroup)
 
 	rcns.mut.Lock()
 
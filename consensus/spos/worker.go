@@ -74,6 +74,7 @@ type Worker struct {
 
 	antifloodHandler consensus.P2PAntifloodHandler
 	poolAdder        PoolAdder
+	scheduler        process.InterceptedDataScheduler
 
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
@@ -109,6 +110,7 @@ type WorkerArgs struct {
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
 	PeerBlacklistHandler     consensus.PeerBlacklistHandler
+	PriorityLanes            process.InterceptorPriorityLanesHandler
 }
 
 // NewWorker creates a new Worker object
@@ -160,6 +162,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
+	wrk.scheduler = args.PriorityLanes.Scheduler(GetConsensusTopicID(args.ShardCoordinator))
 	wrk.executeMessageChannel = make(chan *consensus.Message)
 	wrk.receivedMessagesCalls = make(map[consensus.MessageType]func(context.Context, *consensus.Message) bool)
 	wrk.receivedHeadersHandlers = make([]func(data.HeaderHandler), 0)
@@ -257,6 +260,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PeerBlacklistHandler) {
 		return ErrNilPeerBlacklistHandler
 	}
+	if check.IfNil(args.PriorityLanes) {
+		return ErrNilPriorityLanes
+	}
 
 	return nil
 }
@@ -433,9 +439,10 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 		return nil
 	}
 
-	go wrk.executeReceivedMessages(cnsMsg)
-
-	return nil
+	// the message is executed on the consensus lane, which the other intercepted data can not starve
+	return wrk.scheduler.Schedule(func() {
+		wrk.executeReceivedMessages(cnsMsg)
+	})
 }
 
 func (wrk *Worker) shouldBlacklistPeer(err error) bool {
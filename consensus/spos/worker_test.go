@@ -116,6 +116,7 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
 		PeerBlacklistHandler:     &mock.PeerBlacklistHandlerStub{},
+		PriorityLanes:            &testscommon.PriorityLanesStub{},
 	}
 
 	return workerArgs
@@ -370,6 +371,17 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerNilPriorityLanesShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.PriorityLanes = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilPriorityLanes, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1381,6 +1393,105 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.True(t, wasUpdatePeerIDInfoCalled)
 }
 
+func TestWorker_ProcessReceivedMessageShouldExecuteOnTheConsensusLane(t *testing.T) {
+	t.Parallel()
+
+	createMessage := func(wrk *spos.Worker) p2p.MessageP2P {
+		hdr := &block.Header{ChainID: chainID}
+		hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, &hashingMocks.HasherMock{}, hdr)
+		hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+		cnsMsg := consensus.NewConsensusMessage(
+			hdrHash,
+			nil,
+			nil,
+			hdrStr,
+			[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+			signature,
+			int(bls.MtBlockHeader),
+			0,
+			chainID,
+			nil,
+			nil,
+			nil,
+			currentPid,
+			nil,
+		)
+		buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+		return &p2pmocks.P2PMessageMock{
+			DataField:      buff,
+			PeerField:      currentPid,
+			SignatureField: []byte("signature"),
+		}
+	}
+	createWorker := func(scheduler process.InterceptedDataScheduler, antifloodHandler *mock.P2PAntifloodHandlerStub) *spos.Worker {
+		workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+		workerArgs.AntifloodHandler = antifloodHandler
+		workerArgs.PriorityLanes = &testscommon.PriorityLanesStub{
+			SchedulerCalled: func(topic string) process.InterceptedDataScheduler {
+				assert.Equal(t, spos.GetConsensusTopicID(workerArgs.ShardCoordinator), topic)
+				return scheduler
+			},
+		}
+		wrk, _ := spos.NewWorker(workerArgs)
+		wrk.SetBlockProcessor(
+			&testscommon.BlockProcessorStub{
+				DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+					return &testscommon.HeaderHandlerStub{
+						CheckChainIDCalled: func(reference []byte) error {
+							return nil
+						},
+						GetPrevHashCalled: func() []byte {
+							return make([]byte, 0)
+						},
+					}
+				},
+				RevertCurrentBlockCalled: func() {
+				},
+				DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+					return nil
+				},
+			},
+		)
+
+		return wrk
+	}
+
+	t.Run("full consensus lane should drop the message without blacklisting", func(t *testing.T) {
+		t.Parallel()
+
+		antifloodHandler := createMockP2PAntifloodHandler()
+		antifloodHandler.BlacklistPeerCalled = func(peer core.PeerID, reason string, duration time.Duration) {
+			assert.Fail(t, "should have not blacklisted the peer")
+		}
+		scheduler := &mock.InterceptedDataSchedulerStub{
+			ScheduleCalled: func(handler func()) error {
+				return process.ErrSystemBusy
+			},
+		}
+		wrk := createWorker(scheduler, antifloodHandler)
+
+		err := wrk.ProcessReceivedMessage(createMessage(wrk), fromConnectedPeerId, &p2pmocks.MessengerStub{})
+		assert.Equal(t, process.ErrSystemBusy, err)
+		assert.Equal(t, 0, len(wrk.ReceivedMessages()[bls.MtBlockHeader]))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		scheduler := &mock.InterceptedDataSchedulerStub{
+			ScheduleCalled: func(handler func()) error {
+				handler()
+				return nil
+			},
+		}
+		wrk := createWorker(scheduler, createMockP2PAntifloodHandler())
+
+		err := wrk.ProcessReceivedMessage(createMessage(wrk), fromConnectedPeerId, &p2pmocks.MessengerStub{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(wrk.ReceivedMessages()[bls.MtBlockHeader]))
+	})
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -49,6 +49,12 @@ const (
 	PeerAccountsUnit UnitType = 21
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 22
+	// AddressTransactionsUnit is the address to transactions index storage unit identifier
+	AddressTransactionsUnit UnitType = 23
+	// EventsIndexUnit is the log events index storage unit identifier
+	EventsIndexUnit UnitType = 24
+	// BalanceHistoryUnit is the balance history index storage unit identifier
+	BalanceHistoryUnit UnitType = 25
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -110,6 +116,12 @@ func (ut UnitType) String() string {
 		return "PeerAccountsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AddressTransactionsUnit:
+		return "AddressTransactionsUnit"
+	case EventsIndexUnit:
+		return "EventsIndexUnit"
+	case BalanceHistoryUnit:
+		return "BalanceHistoryUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
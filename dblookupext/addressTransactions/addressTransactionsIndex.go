@@ -0,0 +1,309 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. addressEntry.proto
+
+package addressTransactions
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/recordedBlocks"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	// MaxPageSize is the maximum number of entries that can be fetched at once
+	MaxPageSize = 1000
+
+	// TypeTransaction marks an entry created from a transaction
+	TypeTransaction = "transaction"
+	// TypeSmartContractResult marks an entry created from a smart contract result
+	TypeSmartContractResult = "scr"
+	// TypeESDTTransfer marks an entry created from an ESDT transfer event
+	TypeESDTTransfer = "esdtTransfer"
+
+	// DirectionIn marks an entry in which the address is the receiver
+	DirectionIn = "in"
+	// DirectionOut marks an entry in which the address is the sender
+	DirectionOut = "out"
+	// DirectionSelf marks an entry in which the address is both the sender and the receiver
+	DirectionSelf = "self"
+)
+
+// ArgsAddressTransactionsIndex holds the arguments needed to create a new address transactions index
+type ArgsAddressTransactionsIndex struct {
+	Marshalizer      marshal.Marshalizer
+	Storer           storage.Storer
+	ShardCoordinator sharding.Coordinator
+}
+
+type addressTransactionsIndex struct {
+	storer           *recordedBlocks.Storer
+	shardCoordinator sharding.Coordinator
+	mutex            sync.RWMutex
+}
+
+// NewAddressTransactionsIndex creates a new index that keeps, for each address of the current shard, the
+// list of its transactions, smart contract results and ESDT transfers
+func NewAddressTransactionsIndex(args ArgsAddressTransactionsIndex) (*addressTransactionsIndex, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	storer, err := recordedBlocks.NewStorer(args.Storer, args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &addressTransactionsIndex{
+		storer:           storer,
+		shardCoordinator: args.ShardCoordinator,
+	}, nil
+}
+
+// RecordBlock adds the entries of the provided block
+func (ati *addressTransactionsIndex) RecordBlock(
+	blockHeader data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+	scrs map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	shouldRecord, err := ati.storer.ShouldRecordBlock(blockHeader.GetNonce())
+	if err != nil || !shouldRecord {
+		return err
+	}
+
+	entries := make(map[string][]interface{})
+	ati.addTransactionsEntries(entries, blockHeader, txs, TypeTransaction)
+	ati.addTransactionsEntries(entries, blockHeader, scrs, TypeSmartContractResult)
+	ati.addLogsEntries(entries, blockHeader, logs)
+
+	record := &recordedBlocks.BlockRecord{}
+	for _, address := range recordedBlocks.SortedKeys(entries) {
+		err = ati.storer.AppendToList(record, []byte(address), entries[address]...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.storer.SaveBlockRecord(blockHeader.GetNonce(), record)
+}
+
+func (ati *addressTransactionsIndex) addTransactionsEntries(
+	entries map[string][]interface{},
+	blockHeader data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+	entryType string,
+) {
+	for _, txHash := range recordedBlocks.SortedKeys(txs) {
+		tx := txs[txHash]
+		if check.IfNil(tx) {
+			continue
+		}
+
+		ati.addEntries(entries, blockHeader, []byte(txHash), tx.GetSndAddr(), tx.GetRcvAddr(), entryType, "")
+	}
+}
+
+func (ati *addressTransactionsIndex) addLogsEntries(entries map[string][]interface{}, blockHeader data.HeaderHandler, logs []*data.LogData) {
+	sortedLogs := make([]*data.LogData, 0, len(logs))
+	for _, logData := range logs {
+		if logData != nil && !check.IfNil(logData.LogHandler) {
+			sortedLogs = append(sortedLogs, logData)
+		}
+	}
+	sort.SliceStable(sortedLogs, func(i, j int) bool {
+		return sortedLogs[i].TxHash < sortedLogs[j].TxHash
+	})
+
+	for _, logData := range sortedLogs {
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			event, ok := eventHandler.(*transaction.Event)
+			if !ok || !isESDTTransferEvent(event) {
+				continue
+			}
+
+			// the topics hold groups of (token, nonce, value), followed by the receiver
+			receiver := event.Topics[len(event.Topics)-1]
+			for i := 0; i+3 < len(event.Topics); i += 3 {
+				token := string(event.Topics[i])
+				ati.addEntries(entries, blockHeader, []byte(logData.TxHash), event.Address, receiver, TypeESDTTransfer, token)
+			}
+		}
+	}
+}
+
+func isESDTTransferEvent(event *transaction.Event) bool {
+	switch string(event.Identifier) {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		return len(event.Topics) >= 4 && len(event.Topics)%3 == 1
+	default:
+		return false
+	}
+}
+
+func (ati *addressTransactionsIndex) addEntries(
+	entries map[string][]interface{},
+	blockHeader data.HeaderHandler,
+	txHash []byte,
+	sender []byte,
+	receiver []byte,
+	entryType string,
+	token string,
+) {
+	createEntry := func(direction string) *AddressEntry {
+		return &AddressEntry{
+			TxHash:    txHash,
+			Epoch:     blockHeader.GetEpoch(),
+			Nonce:     blockHeader.GetNonce(),
+			Type:      entryType,
+			Direction: direction,
+			Token:     token,
+		}
+	}
+
+	if string(sender) == string(receiver) {
+		if ati.isSelfShardAddress(sender) {
+			addEntry(entries, sender, createEntry(DirectionSelf))
+		}
+		return
+	}
+
+	if ati.isSelfShardAddress(sender) {
+		addEntry(entries, sender, createEntry(DirectionOut))
+	}
+	if ati.isSelfShardAddress(receiver) {
+		addEntry(entries, receiver, createEntry(DirectionIn))
+	}
+}
+
+// addEntry adds the entry to the list of the address and to the lists filtered by direction and token, so a query
+// reads only the entries it returns. The entries with both directions are added to the lists of both directions
+func addEntry(entries map[string][]interface{}, address []byte, entry *AddressEntry) {
+	directions := []string{"", entry.Direction}
+	if entry.Direction == DirectionSelf {
+		directions = []string{"", DirectionIn, DirectionOut}
+	}
+	tokens := []string{""}
+	if len(entry.Token) > 0 {
+		tokens = append(tokens, entry.Token)
+	}
+
+	for _, token := range tokens {
+		for _, direction := range directions {
+			listKey := string(createListKey(address, token, direction))
+			entries[listKey] = append(entries[listKey], entry)
+		}
+	}
+}
+
+func (ati *addressTransactionsIndex) isSelfShardAddress(address []byte) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	return ati.shardCoordinator.ComputeId(address) == ati.shardCoordinator.SelfId()
+}
+
+// RevertBlock removes the entries added by the provided block
+func (ati *addressTransactionsIndex) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	return ati.storer.RevertBlock(blockHeader.GetNonce())
+}
+
+// GetTransactions returns the entries of the provided address, newest first, filtered and paginated by the provided options
+func (ati *addressTransactionsIndex) GetTransactions(address []byte, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	err := checkQueryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	ati.mutex.RLock()
+	defer ati.mutex.RUnlock()
+
+	listKey := createListKey(address, options.Token, options.Direction)
+	numEntries, err := ati.storer.GetListLength(listKey)
+	if err != nil {
+		return nil, err
+	}
+	if options.From >= numEntries {
+		return make([]*common.AddressTransaction, 0), nil
+	}
+
+	result := make([]*common.AddressTransaction, 0)
+	for i := numEntries - options.From; i > 0 && uint64(len(result)) < options.Size; i-- {
+		entry := &AddressEntry{}
+		found, errGet := ati.storer.GetListValue(listKey, i-1, entry)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if !found {
+			continue
+		}
+
+		result = append(result, &common.AddressTransaction{
+			TxHash:    hex.EncodeToString(entry.TxHash),
+			Epoch:     entry.Epoch,
+			Nonce:     entry.Nonce,
+			Type:      entry.Type,
+			Direction: entry.Direction,
+			Token:     entry.Token,
+		})
+	}
+
+	return result, nil
+}
+
+func checkQueryOptions(options common.AddressTransactionsQueryOptions) error {
+	switch options.Direction {
+	case "", DirectionIn, DirectionOut:
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidDirection, options.Direction)
+	}
+
+	if options.Size == 0 || options.Size > MaxPageSize {
+		return fmt.Errorf("%w: %d, should be between 1 and %d", ErrInvalidPageSize, options.Size, MaxPageSize)
+	}
+
+	return nil
+}
+
+// createListKey builds the key as length prefixed address | length prefixed direction | token. The token is upper
+// cased, as the token filter is case insensitive
+func createListKey(address []byte, token string, direction string) []byte {
+	key := binary.AppendUvarint(nil, uint64(len(address)))
+	key = append(key, address...)
+	key = binary.AppendUvarint(key, uint64(len(direction)))
+	key = append(key, direction...)
+
+	return append(key, strings.ToUpper(token)...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ati *addressTransactionsIndex) IsInterfaceNil() bool {
+	return ati == nil
+}
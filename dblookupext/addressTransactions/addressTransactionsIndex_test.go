@@ -0,0 +1,301 @@
+package addressTransactions_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = []byte("alice")
+	bob   = []byte("bob")
+	carol = []byte("carol")
+)
+
+func createMockArgsAddressTransactionsIndex() addressTransactions.ArgsAddressTransactionsIndex {
+	return addressTransactions.ArgsAddressTransactionsIndex{
+		Marshalizer:      &marshal.GogoProtoMarshalizer{},
+		Storer:           genericMocks.NewStorerMock(),
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+	}
+}
+
+func createTx(sender []byte, receiver []byte) data.TransactionHandler {
+	return &transaction.Transaction{SndAddr: sender, RcvAddr: receiver}
+}
+
+func createESDTTransferLog(txHash string, sender []byte, receiver []byte, tokens ...string) *data.LogData {
+	topics := make([][]byte, 0)
+	for _, token := range tokens {
+		topics = append(topics, []byte(token), nil, []byte{1})
+	}
+	topics = append(topics, receiver)
+
+	identifier := core.BuiltInFunctionESDTTransfer
+	if len(tokens) > 1 {
+		identifier = core.BuiltInFunctionMultiESDTNFTTransfer
+	}
+
+	return &data.LogData{
+		TxHash: txHash,
+		LogHandler: &transaction.Log{
+			Events: []*transaction.Event{
+				{
+					Address:    sender,
+					Identifier: []byte(identifier),
+					Topics:     topics,
+				},
+			},
+		},
+	}
+}
+
+func getTxHashes(t *testing.T, ati dblookupext.AddressTransactionsHandler, address []byte, options common.AddressTransactionsQueryOptions) []string {
+	result, err := ati.GetTransactions(address, options)
+	require.Nil(t, err)
+
+	hashes := make([]string, 0, len(result))
+	for _, entry := range result {
+		txHash, _ := hex.DecodeString(entry.TxHash)
+		hashes = append(hashes, string(txHash))
+	}
+
+	return hashes
+}
+
+func TestNewAddressTransactionsIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsIndex()
+		args.Storer = nil
+		ati, err := addressTransactions.NewAddressTransactionsIndex(args)
+		assert.Equal(t, core.ErrNilStore, err)
+		assert.True(t, check.IfNil(ati))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsIndex()
+		args.Marshalizer = nil
+		ati, err := addressTransactions.NewAddressTransactionsIndex(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(ati))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsIndex()
+		args.ShardCoordinator = nil
+		ati, err := addressTransactions.NewAddressTransactionsIndex(args)
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(ati))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ati, err := addressTransactions.NewAddressTransactionsIndex(createMockArgsAddressTransactionsIndex())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ati))
+	})
+}
+
+func TestAddressTransactionsIndex_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	ati, _ := addressTransactions.NewAddressTransactionsIndex(createMockArgsAddressTransactionsIndex())
+
+	txs := map[string]data.TransactionHandler{
+		"tx1": createTx(alice, bob),
+		"tx2": createTx(carol, carol),
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr1": &smartContractResult.SmartContractResult{SndAddr: bob, RcvAddr: alice},
+	}
+	logs := []*data.LogData{
+		createESDTTransferLog("tx3", alice, carol, "TKN-abcdef", "NFT-abcdef"),
+	}
+	err := ati.RecordBlock(&block.Header{Epoch: 2, Nonce: 7}, txs, scrs, logs)
+	require.Nil(t, err)
+
+	result, err := ati.GetTransactions(alice, common.AddressTransactionsQueryOptions{Size: 10})
+	require.Nil(t, err)
+	expected := []*common.AddressTransaction{
+		{
+			TxHash:    hex.EncodeToString([]byte("tx3")),
+			Epoch:     2,
+			Nonce:     7,
+			Type:      addressTransactions.TypeESDTTransfer,
+			Direction: addressTransactions.DirectionOut,
+			Token:     "NFT-abcdef",
+		},
+		{
+			TxHash:    hex.EncodeToString([]byte("tx3")),
+			Epoch:     2,
+			Nonce:     7,
+			Type:      addressTransactions.TypeESDTTransfer,
+			Direction: addressTransactions.DirectionOut,
+			Token:     "TKN-abcdef",
+		},
+		{
+			TxHash:    hex.EncodeToString([]byte("scr1")),
+			Epoch:     2,
+			Nonce:     7,
+			Type:      addressTransactions.TypeSmartContractResult,
+			Direction: addressTransactions.DirectionIn,
+		},
+		{
+			TxHash:    hex.EncodeToString([]byte("tx1")),
+			Epoch:     2,
+			Nonce:     7,
+			Type:      addressTransactions.TypeTransaction,
+			Direction: addressTransactions.DirectionOut,
+		},
+	}
+	assert.Equal(t, expected, result)
+
+	assert.Equal(t, []string{"tx3", "tx3", "tx2"}, getTxHashes(t, ati, carol, common.AddressTransactionsQueryOptions{Size: 10}))
+
+	// a block with a lower or equal nonce is ignored
+	err = ati.RecordBlock(&block.Header{Nonce: 7}, map[string]data.TransactionHandler{"tx4": createTx(alice, bob)}, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"scr1", "tx1"}, getTxHashes(t, ati, bob, common.AddressTransactionsQueryOptions{Size: 10}))
+}
+
+func TestAddressTransactionsIndex_RecordBlockShouldIgnoreOtherShardsAddresses(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressTransactionsIndex()
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(bob) {
+			return 1
+		}
+		return 0
+	}
+	args.ShardCoordinator = shardCoordinator
+	ati, _ := addressTransactions.NewAddressTransactionsIndex(args)
+
+	err := ati.RecordBlock(&block.Header{Nonce: 1}, map[string]data.TransactionHandler{"tx1": createTx(alice, bob)}, nil, nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"tx1"}, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{Size: 10}))
+	assert.Empty(t, getTxHashes(t, ati, bob, common.AddressTransactionsQueryOptions{Size: 10}))
+}
+
+func TestAddressTransactionsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	ati, _ := addressTransactions.NewAddressTransactionsIndex(createMockArgsAddressTransactionsIndex())
+
+	header1 := &block.Header{Nonce: 1}
+	header2 := &block.Header{Nonce: 2}
+	_ = ati.RecordBlock(header1, map[string]data.TransactionHandler{"tx1": createTx(alice, bob)}, nil, nil)
+	_ = ati.RecordBlock(header2, map[string]data.TransactionHandler{"tx2": createTx(alice, carol)}, nil, nil)
+
+	// only the last recorded block can be reverted
+	err := ati.RevertBlock(header1)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx2", "tx1"}, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{Size: 10}))
+
+	err = ati.RevertBlock(header2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{Size: 10}))
+	assert.Empty(t, getTxHashes(t, ati, carol, common.AddressTransactionsQueryOptions{Size: 10}))
+
+	// the block on the canonical chain can be recorded after the revert
+	err = ati.RecordBlock(header2, map[string]data.TransactionHandler{"tx3": createTx(bob, alice)}, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx3", "tx1"}, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{Size: 10}))
+	assert.Equal(t, []string{"tx3", "tx1"}, getTxHashes(t, ati, bob, common.AddressTransactionsQueryOptions{Size: 10}))
+}
+
+func TestAddressTransactionsIndex_GetTransactions(t *testing.T) {
+	t.Parallel()
+
+	ati, _ := addressTransactions.NewAddressTransactionsIndex(createMockArgsAddressTransactionsIndex())
+	_ = ati.RecordBlock(&block.Header{Nonce: 1}, map[string]data.TransactionHandler{"tx1": createTx(alice, bob)}, nil, nil)
+	_ = ati.RecordBlock(&block.Header{Nonce: 2}, map[string]data.TransactionHandler{"tx2": createTx(bob, alice)}, nil, nil)
+	_ = ati.RecordBlock(&block.Header{Nonce: 3}, map[string]data.TransactionHandler{"tx3": createTx(alice, alice)}, nil, nil)
+	_ = ati.RecordBlock(&block.Header{Nonce: 4}, nil, nil, []*data.LogData{createESDTTransferLog("tx4", alice, bob, "TKN-abcdef")})
+
+	t.Run("invalid options should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ati.GetTransactions(alice, common.AddressTransactionsQueryOptions{Size: 10, Direction: "sideways"})
+		assert.True(t, errors.Is(err, addressTransactions.ErrInvalidDirection))
+
+		_, err = ati.GetTransactions(alice, common.AddressTransactionsQueryOptions{Size: 0})
+		assert.True(t, errors.Is(err, addressTransactions.ErrInvalidPageSize))
+
+		_, err = ati.GetTransactions(alice, common.AddressTransactionsQueryOptions{Size: addressTransactions.MaxPageSize + 1})
+		assert.True(t, errors.Is(err, addressTransactions.ErrInvalidPageSize))
+	})
+	t.Run("pagination", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"tx4", "tx3"}, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{Size: 2}))
+		assert.Equal(t, []string{"tx2", "tx1"}, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{From: 2, Size: 2}))
+		assert.Empty(t, getTxHashes(t, ati, alice, common.AddressTransactionsQueryOptions{From: 4, Size: 2}))
+	})
+	t.Run("direction filter", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.AddressTransactionsQueryOptions{Size: 10, Direction: addressTransactions.DirectionIn}
+		assert.Equal(t, []string{"tx3", "tx2"}, getTxHashes(t, ati, alice, options))
+
+		options.Direction = addressTransactions.DirectionOut
+		assert.Equal(t, []string{"tx4", "tx3", "tx1"}, getTxHashes(t, ati, alice, options))
+	})
+	t.Run("token filter", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.AddressTransactionsQueryOptions{Size: 10, Token: "TKN-abcdef"}
+		assert.Equal(t, []string{"tx4"}, getTxHashes(t, ati, bob, options))
+
+		options.Token = "OTHER-abcdef"
+		assert.Empty(t, getTxHashes(t, ati, bob, options))
+	})
+	t.Run("token and direction filters", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.AddressTransactionsQueryOptions{Size: 10, Token: "tkn-ABCDEF", Direction: addressTransactions.DirectionOut}
+		assert.Equal(t, []string{"tx4"}, getTxHashes(t, ati, alice, options))
+
+		options.Direction = addressTransactions.DirectionIn
+		assert.Empty(t, getTxHashes(t, ati, alice, options))
+		assert.Equal(t, []string{"tx4"}, getTxHashes(t, ati, bob, options))
+	})
+	t.Run("filtered pagination", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.AddressTransactionsQueryOptions{From: 1, Size: 1, Direction: addressTransactions.DirectionOut}
+		assert.Equal(t, []string{"tx3"}, getTxHashes(t, ati, alice, options))
+
+		options.From = 3
+		assert.Empty(t, getTxHashes(t, ati, alice, options))
+	})
+	t.Run("unknown address", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, getTxHashes(t, ati, []byte("unknown"), common.AddressTransactionsQueryOptions{Size: 10}))
+	})
+}
@@ -0,0 +1,9 @@
+package addressTransactions
+
+import "errors"
+
+// ErrInvalidDirection signals that an invalid direction filter was provided
+var ErrInvalidDirection = errors.New("invalid direction")
+
+// ErrInvalidPageSize signals that an invalid page size was provided
+var ErrInvalidPageSize = errors.New("invalid page size")
@@ -0,0 +1,38 @@
+package balanceHistory
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// accountsAtBlockRepository reads the accounts from the state of the queried block and returns an empty account for
+// the addresses missing from it, as the receivers of failed calls, so that their zero balances can still be recorded
+type accountsAtBlockRepository struct {
+	state.AccountsRepository
+	accountFactory state.AccountFactory
+}
+
+// GetAccountWithBlockInfo returns the account from the state of the queried block or an empty account if missing
+func (repository *accountsAtBlockRepository) GetAccountWithBlockInfo(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+	account, blockInfo, err := repository.AccountsRepository.GetAccountWithBlockInfo(address, options)
+	errAccountNotFound := &state.ErrAccountNotFoundAtBlock{}
+	if !errors.As(err, &errAccountNotFound) {
+		return account, blockInfo, err
+	}
+
+	account, err = repository.accountFactory.CreateAccount(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, errAccountNotFound.BlockInfo, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (repository *accountsAtBlockRepository) IsInterfaceNil() bool {
+	return repository == nil
+}
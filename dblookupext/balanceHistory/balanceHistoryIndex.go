@@ -0,0 +1,362 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. balanceEntry.proto
+
+package balanceHistory
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/recordedBlocks"
+	outportProcess "github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	assetSeparator = "@"
+
+	// MaxResults is the maximum number of balance changes that can be fetched at once
+	MaxResults = 1000
+)
+
+// ArgsBalanceHistoryIndex holds the arguments needed to create a new balance history index
+type ArgsBalanceHistoryIndex struct {
+	Marshalizer             marshal.Marshalizer
+	Storer                  storage.Storer
+	AddressConverter        core.PubkeyConverter
+	AlteredAccountsProvider outportProcess.AlteredAccountsProviderHandler
+	AccountsRepository      state.AccountsRepository
+	AccountFactory          state.AccountFactory
+}
+
+type balanceHistoryIndex struct {
+	storer                  *recordedBlocks.Storer
+	addressConverter        core.PubkeyConverter
+	alteredAccountsProvider outportProcess.AlteredAccountsProviderHandler
+	accountsRepository      state.AccountsRepository
+	mutex                   sync.RWMutex
+}
+
+// NewBalanceHistoryIndex creates a new index that keeps, for each address of the current shard, the blocks in which
+// its EGLD or ESDT balances changed. The changed balances are the ones of the accounts altered by the block, read
+// from the state of that block
+func NewBalanceHistoryIndex(args ArgsBalanceHistoryIndex) (*balanceHistoryIndex, error) {
+	if check.IfNil(args.AddressConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.AlteredAccountsProvider) {
+		return nil, ErrNilAlteredAccountsProvider
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+	if check.IfNil(args.AccountFactory) {
+		return nil, state.ErrNilAccountFactory
+	}
+
+	storer, err := recordedBlocks.NewStorer(args.Storer, args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balanceHistoryIndex{
+		storer:                  storer,
+		addressConverter:        args.AddressConverter,
+		alteredAccountsProvider: args.AlteredAccountsProvider,
+		accountsRepository: &accountsAtBlockRepository{
+			AccountsRepository: args.AccountsRepository,
+			accountFactory:     args.AccountFactory,
+		},
+	}, nil
+}
+
+// RecordBlock adds an entry for each EGLD or ESDT balance changed by the provided block
+func (bhi *balanceHistoryIndex) RecordBlock(
+	blockHeader data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+	scrs map[string]data.TransactionHandler,
+	rewards map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	bhi.mutex.Lock()
+	defer bhi.mutex.Unlock()
+
+	shouldRecord, err := bhi.storer.ShouldRecordBlock(blockHeader.GetNonce())
+	if err != nil || !shouldRecord {
+		return err
+	}
+
+	alteredAccounts, err := bhi.extractAlteredAccounts(blockHeader, txs, scrs, rewards, logs)
+	if err != nil {
+		return err
+	}
+
+	hashes := newTxHashesHolder()
+	hashes.addTransactions(txs)
+	hashes.addTransactions(scrs)
+	hashes.addTransactions(rewards)
+	hashes.addLogs(logs)
+
+	record := &recordedBlocks.BlockRecord{}
+	for _, encodedAddress := range recordedBlocks.SortedKeys(alteredAccounts) {
+		err = bhi.recordAlteredAccount(record, alteredAccounts[encodedAddress], hashes, blockHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	return bhi.storer.SaveBlockRecord(blockHeader.GetNonce(), record)
+}
+
+func (bhi *balanceHistoryIndex) extractAlteredAccounts(
+	blockHeader data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+	scrs map[string]data.TransactionHandler,
+	rewards map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) (map[string]*alteredAccount.AlteredAccount, error) {
+	pool, err := createTransactionPool(txs, scrs, rewards, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bhi.alteredAccountsProvider.ExtractAlteredAccountsFromPool(pool, shared.AlteredAccountsOptions{
+		WithCustomAccountsRepository: true,
+		AccountsRepository:           bhi.accountsRepository,
+		AccountQueryOptions: api.AccountQueryOptions{
+			BlockNonce:    core.OptionalUint64{HasValue: true, Value: blockHeader.GetNonce()},
+			BlockRootHash: blockHeader.GetRootHash(),
+			HintEpoch:     core.OptionalUint32{HasValue: true, Value: blockHeader.GetEpoch()},
+		},
+	})
+}
+
+func (bhi *balanceHistoryIndex) recordAlteredAccount(
+	record *recordedBlocks.BlockRecord,
+	account *alteredAccount.AlteredAccount,
+	hashes *txHashesHolder,
+	blockHeader data.HeaderHandler,
+) error {
+	address, err := bhi.addressConverter.Decode(account.Address)
+	if err != nil {
+		return err
+	}
+
+	key := assetKey(address, "")
+	err = bhi.recordAsset(record, key, account.Balance, hashes.get(address, key), blockHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range account.Tokens {
+		if token == nil {
+			continue
+		}
+
+		key = assetKey(address, tokenIdentifier(token.Identifier, token.Nonce))
+		err = bhi.recordAsset(record, key, token.Balance, hashes.get(address, key), blockHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bhi *balanceHistoryIndex) recordAsset(
+	record *recordedBlocks.BlockRecord,
+	key []byte,
+	balanceString string,
+	txHashes [][]byte,
+	blockHeader data.HeaderHandler,
+) error {
+	balance, ok := big.NewInt(0).SetString(balanceString, 10)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidBalance, balanceString)
+	}
+
+	numEntries, err := bhi.storer.GetListLength(key)
+	if err != nil {
+		return err
+	}
+
+	previousBalance := big.NewInt(0)
+	if numEntries > 0 {
+		lastEntry := &BalanceEntry{}
+		_, err = bhi.storer.GetListValue(key, numEntries-1, lastEntry)
+		if err != nil {
+			return err
+		}
+
+		previousBalance, _ = big.NewInt(0).SetString(lastEntry.Balance, 10)
+		if previousBalance == nil {
+			previousBalance = big.NewInt(0)
+		}
+	}
+	if balance.Cmp(previousBalance) == 0 {
+		return nil
+	}
+
+	return bhi.storer.AppendToList(record, key, &BalanceEntry{
+		Nonce:    blockHeader.GetNonce(),
+		Epoch:    blockHeader.GetEpoch(),
+		Balance:  balance.String(),
+		Delta:    big.NewInt(0).Sub(balance, previousBalance).String(),
+		TxHashes: txHashes,
+	})
+}
+
+// RevertBlock removes the entries added by the provided block
+func (bhi *balanceHistoryIndex) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	bhi.mutex.Lock()
+	defer bhi.mutex.Unlock()
+
+	return bhi.storer.RevertBlock(blockHeader.GetNonce())
+}
+
+// GetBalanceHistory returns, oldest first, the changes of the EGLD balance or of the provided token's balance that
+// happened in the requested block nonce range. When the step is greater than one, the changes from the same interval
+// of step blocks are merged into a single change, reported at the nonce of the last block that changed the balance
+func (bhi *balanceHistoryIndex) GetBalanceHistory(address []byte, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	err := checkQueryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	bhi.mutex.RLock()
+	defer bhi.mutex.RUnlock()
+
+	key := assetKey(address, options.Token)
+	numEntries, err := bhi.storer.GetListLength(key)
+	if err != nil {
+		return nil, err
+	}
+
+	firstIndex, err := bhi.searchFirstEntry(key, numEntries, options.FromNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*common.BalanceChange, 0)
+	lastInterval := uint64(0)
+	for i := firstIndex; i < numEntries; i++ {
+		entry := &BalanceEntry{}
+		found, errGet := bhi.storer.GetListValue(key, i, entry)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if !found {
+			continue
+		}
+		if entry.Nonce > options.ToNonce {
+			break
+		}
+
+		txHashes := make([]string, 0, len(entry.TxHashes))
+		for _, txHash := range entry.TxHashes {
+			txHashes = append(txHashes, hex.EncodeToString(txHash))
+		}
+
+		interval := (entry.Nonce - options.FromNonce) / options.Step
+		if len(result) > 0 && interval == lastInterval {
+			mergeChange(result[len(result)-1], entry, txHashes)
+			continue
+		}
+		if len(result) == MaxResults {
+			break
+		}
+
+		lastInterval = interval
+		result = append(result, &common.BalanceChange{
+			Nonce:    entry.Nonce,
+			Epoch:    entry.Epoch,
+			Balance:  entry.Balance,
+			Delta:    entry.Delta,
+			TxHashes: txHashes,
+		})
+	}
+
+	return result, nil
+}
+
+func checkQueryOptions(options common.BalanceHistoryQueryOptions) error {
+	if options.FromNonce > options.ToNonce {
+		return fmt.Errorf("%w: from %d, to %d", ErrInvalidNonceRange, options.FromNonce, options.ToNonce)
+	}
+	if options.Step == 0 {
+		return fmt.Errorf("%w: %d, should be at least 1", ErrInvalidStep, options.Step)
+	}
+
+	return nil
+}
+
+// searchFirstEntry returns the index of the first entry recorded at a nonce greater or equal to the provided one. The
+// entries of an asset are appended in increasing order of their block nonces
+func (bhi *balanceHistoryIndex) searchFirstEntry(key []byte, numEntries uint64, fromNonce uint64) (uint64, error) {
+	var errSearch error
+	index := sort.Search(int(numEntries), func(i int) bool {
+		if errSearch != nil {
+			return true
+		}
+
+		entry := &BalanceEntry{}
+		_, errSearch = bhi.storer.GetListValue(key, uint64(i), entry)
+
+		return entry.Nonce >= fromNonce
+	})
+
+	return uint64(index), errSearch
+}
+
+func mergeChange(change *common.BalanceChange, entry *BalanceEntry, txHashes []string) {
+	delta, _ := big.NewInt(0).SetString(change.Delta, 10)
+	entryDelta, _ := big.NewInt(0).SetString(entry.Delta, 10)
+	if delta != nil && entryDelta != nil {
+		change.Delta = delta.Add(delta, entryDelta).String()
+	}
+
+	change.Nonce = entry.Nonce
+	change.Epoch = entry.Epoch
+	change.Balance = entry.Balance
+	change.TxHashes = append(change.TxHashes, txHashes...)
+}
+
+// tokenIdentifier returns the token identifier as used on the API, with the hex encoded nonce appended for the
+// non-fungible tokens
+func tokenIdentifier(token string, tokenNonce uint64) string {
+	if tokenNonce == 0 {
+		return token
+	}
+
+	return fmt.Sprintf("%s-%s", token, core.ConvertToEvenHexBigInt(big.NewInt(0).SetUint64(tokenNonce)))
+}
+
+// assetKey identifies the EGLD balance of an address, when the token is empty, or the balance of one of its tokens
+func assetKey(address []byte, token string) []byte {
+	return []byte(string(address) + assetSeparator + token)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bhi *balanceHistoryIndex) IsInterfaceNil() bool {
+	return bhi == nil
+}
@@ -0,0 +1,544 @@
+package balanceHistory_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/balanceHistory"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = []byte("alice")
+	bob   = []byte("bob")
+)
+
+// blockState holds the accounts altered by the recorded block and their balances at the block's root hash
+type blockState struct {
+	altered  [][]byte
+	balances map[string]int64
+	tokens   map[string][]*alteredAccount.AccountTokenData
+}
+
+func newBlockState() *blockState {
+	return &blockState{
+		balances: make(map[string]int64),
+		tokens:   make(map[string][]*alteredAccount.AccountTokenData),
+	}
+}
+
+func (bs *blockState) alter(addresses ...[]byte) {
+	bs.altered = addresses
+}
+
+func createMockArgs(bs *blockState) balanceHistory.ArgsBalanceHistoryIndex {
+	return balanceHistory.ArgsBalanceHistoryIndex{
+		Marshalizer:      &marshal.GogoProtoMarshalizer{},
+		Storer:           genericMocks.NewStorerMock(),
+		AddressConverter: testscommon.NewPubkeyConverterMock(32),
+		AlteredAccountsProvider: &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(_ *outport.TransactionPool, options shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				return extractAlteredAccounts(bs, options)
+			},
+		},
+		AccountsRepository: &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				blockInfo := holders.NewBlockInfo(nil, options.BlockNonce.Value, options.BlockRootHash)
+				balance, found := bs.balances[string(address)]
+				if !found {
+					return nil, nil, state.NewErrAccountNotFoundAtBlock(blockInfo)
+				}
+
+				return &stateMock.UserAccountStub{Balance: big.NewInt(balance)}, blockInfo, nil
+			},
+		},
+		AccountFactory: &stateMock.AccountsFactoryStub{
+			CreateAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(0)}, nil
+			},
+		},
+	}
+}
+
+// extractAlteredAccounts mimics the altered accounts provider, which loads the altered accounts through the
+// repository from the options
+func extractAlteredAccounts(bs *blockState, options shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+	alteredAccounts := make(map[string]*alteredAccount.AlteredAccount)
+	for _, address := range bs.altered {
+		account, _, err := options.AccountsRepository.GetAccountWithBlockInfo(address, options.AccountQueryOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedAddress := hex.EncodeToString(address)
+		alteredAccounts[encodedAddress] = &alteredAccount.AlteredAccount{
+			Address: encodedAddress,
+			Balance: account.(*stateMock.UserAccountStub).GetBalance().String(),
+			Tokens:  bs.tokens[string(address)],
+		}
+	}
+
+	return alteredAccounts, nil
+}
+
+func createReward(receiver []byte) map[string]data.TransactionHandler {
+	return map[string]data.TransactionHandler{
+		"reward-" + string(receiver): &rewardTx.RewardTx{RcvAddr: receiver},
+	}
+}
+
+func getHistory(t *testing.T, index dblookupext.BalanceHistoryHandler, address []byte, options common.BalanceHistoryQueryOptions) []*common.BalanceChange {
+	history, err := index.GetBalanceHistory(address, options)
+	require.Nil(t, err)
+
+	return history
+}
+
+func allBlocks(token string) common.BalanceHistoryQueryOptions {
+	return common.BalanceHistoryQueryOptions{
+		Token:     token,
+		FromNonce: 0,
+		ToNonce:   math.MaxUint64,
+		Step:      1,
+	}
+}
+
+func TestNewBalanceHistoryIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.Storer = nil
+		index, err := balanceHistory.NewBalanceHistoryIndex(args)
+		assert.True(t, check.IfNil(index))
+		assert.Equal(t, core.ErrNilStore, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.Marshalizer = nil
+		index, err := balanceHistory.NewBalanceHistoryIndex(args)
+		assert.True(t, check.IfNil(index))
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.AddressConverter = nil
+		index, err := balanceHistory.NewBalanceHistoryIndex(args)
+		assert.True(t, check.IfNil(index))
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+	})
+	t.Run("nil altered accounts provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.AlteredAccountsProvider = nil
+		index, err := balanceHistory.NewBalanceHistoryIndex(args)
+		assert.True(t, check.IfNil(index))
+		assert.Equal(t, balanceHistory.ErrNilAlteredAccountsProvider, err)
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.AccountsRepository = nil
+		index, err := balanceHistory.NewBalanceHistoryIndex(args)
+		assert.True(t, check.IfNil(index))
+		assert.Equal(t, balanceHistory.ErrNilAccountsRepository, err)
+	})
+	t.Run("nil account factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.AccountFactory = nil
+		index, err := balanceHistory.NewBalanceHistoryIndex(args)
+		assert.True(t, check.IfNil(index))
+		assert.Equal(t, state.ErrNilAccountFactory, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		index, err := balanceHistory.NewBalanceHistoryIndex(createMockArgs(newBlockState()))
+		assert.False(t, check.IfNil(index))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldRecordEGLDChanges(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	bs.alter(alice, bob)
+	bs.balances[string(alice)] = 100
+	bs.balances[string(bob)] = 50
+	txs := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{SndAddr: alice, RcvAddr: bob},
+	}
+	err := index.RecordBlock(&block.Header{Nonce: 1, Epoch: 2}, txs, nil, nil, nil)
+	require.Nil(t, err)
+
+	bs.alter(bob)
+	bs.balances[string(bob)] = 70
+	err = index.RecordBlock(&block.Header{Nonce: 2, Epoch: 2}, nil, nil, createReward(bob), nil)
+	require.Nil(t, err)
+
+	aliceHistory := getHistory(t, index, alice, allBlocks(""))
+	require.Len(t, aliceHistory, 1)
+	assert.Equal(t, &common.BalanceChange{
+		Nonce:    1,
+		Epoch:    2,
+		Balance:  "100",
+		Delta:    "100",
+		TxHashes: []string{"747831"},
+	}, aliceHistory[0])
+
+	bobHistory := getHistory(t, index, bob, allBlocks(""))
+	require.Len(t, bobHistory, 2)
+	assert.Equal(t, "50", bobHistory[0].Balance)
+	assert.Equal(t, "70", bobHistory[1].Balance)
+	assert.Equal(t, "20", bobHistory[1].Delta)
+	assert.Equal(t, uint64(2), bobHistory[1].Nonce)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldReadTheBalancesAtTheBlockRootHash(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	bs.alter(bob)
+	bs.balances[string(bob)] = 10
+
+	var providedOptions shared.AlteredAccountsOptions
+	args := createMockArgs(bs)
+	args.AlteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+		ExtractAlteredAccountsFromPoolCalled: func(txPool *outport.TransactionPool, options shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+			providedOptions = options
+			require.Len(t, txPool.Rewards, 1)
+
+			return extractAlteredAccounts(bs, options)
+		},
+	}
+	index, _ := balanceHistory.NewBalanceHistoryIndex(args)
+
+	header := &block.Header{Nonce: 7, Epoch: 3, RootHash: []byte("root hash")}
+	err := index.RecordBlock(header, nil, nil, createReward(bob), nil)
+	require.Nil(t, err)
+
+	assert.True(t, providedOptions.WithCustomAccountsRepository)
+	assert.Equal(t, header.RootHash, providedOptions.AccountQueryOptions.BlockRootHash)
+	assert.Equal(t, core.OptionalUint64{HasValue: true, Value: 7}, providedOptions.AccountQueryOptions.BlockNonce)
+	assert.Equal(t, core.OptionalUint32{HasValue: true, Value: 3}, providedOptions.AccountQueryOptions.HintEpoch)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldRecordAlteredAccountsWithoutTransactions(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	// the relayer paid the fee of a transaction between alice and bob, so it is only found in the altered accounts
+	relayer := []byte("relayer")
+	bs.alter(alice, bob, relayer)
+	bs.balances[string(alice)] = 10
+	bs.balances[string(bob)] = 10
+	bs.balances[string(relayer)] = 90
+	txs := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{SndAddr: alice, RcvAddr: bob},
+	}
+	err := index.RecordBlock(&block.Header{Nonce: 1}, txs, nil, nil, nil)
+	require.Nil(t, err)
+
+	history := getHistory(t, index, relayer, allBlocks(""))
+	require.Len(t, history, 1)
+	assert.Equal(t, "90", history[0].Balance)
+	assert.Empty(t, history[0].TxHashes)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldRecordZeroBalancesOfMissingAccounts(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	bs.alter(bob)
+	bs.balances[string(bob)] = 10
+	_ = index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+
+	delete(bs.balances, string(bob))
+	err := index.RecordBlock(&block.Header{Nonce: 2}, nil, nil, createReward(bob), nil)
+	require.Nil(t, err)
+
+	history := getHistory(t, index, bob, allBlocks(""))
+	require.Len(t, history, 2)
+	assert.Equal(t, "0", history[1].Balance)
+	assert.Equal(t, "-10", history[1].Delta)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldNotRecordUnchangedBalances(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	bs.alter(bob)
+	bs.balances[string(bob)] = 10
+	_ = index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+	_ = index.RecordBlock(&block.Header{Nonce: 2}, nil, nil, createReward(bob), nil)
+
+	assert.Len(t, getHistory(t, index, bob, allBlocks("")), 1)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldIgnoreAlreadyRecordedBlocks(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	bs.alter(bob)
+	bs.balances[string(bob)] = 10
+	_ = index.RecordBlock(&block.Header{Nonce: 2}, nil, nil, createReward(bob), nil)
+	bs.balances[string(bob)] = 20
+	_ = index.RecordBlock(&block.Header{Nonce: 2}, nil, nil, createReward(bob), nil)
+	_ = index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+
+	history := getHistory(t, index, bob, allBlocks(""))
+	require.Len(t, history, 1)
+	assert.Equal(t, "10", history[0].Balance)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldRecordESDTChanges(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	bs.alter(alice, bob)
+	bs.balances[string(alice)] = 0
+	bs.balances[string(bob)] = 0
+	bs.tokens[string(alice)] = []*alteredAccount.AccountTokenData{
+		{Identifier: "TKN-abcdef", Balance: "60"},
+		{Identifier: "NFT-abcdef", Nonce: 1, Balance: "0"},
+	}
+	bs.tokens[string(bob)] = []*alteredAccount.AccountTokenData{
+		{Identifier: "TKN-abcdef", Balance: "40"},
+		{Identifier: "NFT-abcdef", Nonce: 1, Balance: "1"},
+	}
+
+	logs := []*data.LogData{
+		{
+			TxHash: "tx1",
+			LogHandler: &transaction.Log{Events: []*transaction.Event{
+				{
+					Address:    alice,
+					Identifier: []byte(core.BuiltInFunctionMultiESDTNFTTransfer),
+					Topics: [][]byte{
+						[]byte("TKN-abcdef"), nil, big.NewInt(40).Bytes(),
+						[]byte("NFT-abcdef"), {1}, big.NewInt(1).Bytes(),
+						bob,
+					},
+				},
+			}},
+		},
+	}
+	err := index.RecordBlock(&block.Header{Nonce: 3}, nil, nil, nil, logs)
+	require.Nil(t, err)
+
+	aliceHistory := getHistory(t, index, alice, allBlocks("TKN-abcdef"))
+	require.Len(t, aliceHistory, 1)
+	assert.Equal(t, "60", aliceHistory[0].Balance)
+	assert.Equal(t, []string{"747831"}, aliceHistory[0].TxHashes)
+
+	bobHistory := getHistory(t, index, bob, allBlocks("TKN-abcdef"))
+	require.Len(t, bobHistory, 1)
+	assert.Equal(t, "40", bobHistory[0].Balance)
+
+	bobNFTHistory := getHistory(t, index, bob, allBlocks("NFT-abcdef-01"))
+	require.Len(t, bobNFTHistory, 1)
+	assert.Equal(t, "1", bobNFTHistory[0].Balance)
+
+	// the EGLD balances and the empty NFT balance did not change
+	assert.Len(t, getHistory(t, index, alice, allBlocks("")), 0)
+	assert.Len(t, getHistory(t, index, alice, allBlocks("NFT-abcdef-01")), 0)
+}
+
+func TestBalanceHistoryIndex_RecordBlockShouldPropagateErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("altered accounts provider error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs(newBlockState())
+		args.AlteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(_ *outport.TransactionPool, _ shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				return nil, expectedErr
+			},
+		}
+		index, _ := balanceHistory.NewBalanceHistoryIndex(args)
+
+		err := index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("accounts repository error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		bs := newBlockState()
+		bs.alter(bob)
+		args := createMockArgs(bs)
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, _ api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		index, _ := balanceHistory.NewBalanceHistoryIndex(args)
+
+		err := index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("wrong transaction type", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(newBlockState()))
+
+		txs := map[string]data.TransactionHandler{
+			"tx1": &rewardTx.RewardTx{RcvAddr: bob},
+		}
+		err := index.RecordBlock(&block.Header{Nonce: 1}, txs, nil, nil, nil)
+		assert.True(t, errors.Is(err, process.ErrWrongTypeAssertion))
+	})
+	t.Run("invalid balance", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newBlockState())
+		args.AlteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(_ *outport.TransactionPool, _ shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				return map[string]*alteredAccount.AlteredAccount{
+					hex.EncodeToString(bob): {Address: hex.EncodeToString(bob), Balance: "not a number"},
+				}, nil
+			},
+		}
+		index, _ := balanceHistory.NewBalanceHistoryIndex(args)
+
+		err := index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+		assert.True(t, errors.Is(err, balanceHistory.ErrInvalidBalance))
+	})
+}
+
+func TestBalanceHistoryIndex_RevertBlockShouldRemoveTheBlockEntries(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+
+	bs.alter(bob)
+	bs.balances[string(bob)] = 10
+	_ = index.RecordBlock(&block.Header{Nonce: 1}, nil, nil, createReward(bob), nil)
+	bs.balances[string(bob)] = 20
+	_ = index.RecordBlock(&block.Header{Nonce: 2}, nil, nil, createReward(bob), nil)
+
+	err := index.RevertBlock(&block.Header{Nonce: 2})
+	require.Nil(t, err)
+
+	history := getHistory(t, index, bob, allBlocks(""))
+	require.Len(t, history, 1)
+	assert.Equal(t, "10", history[0].Balance)
+
+	// the reverted block can be recorded again
+	bs.balances[string(bob)] = 30
+	_ = index.RecordBlock(&block.Header{Nonce: 2}, nil, nil, createReward(bob), nil)
+
+	history = getHistory(t, index, bob, allBlocks(""))
+	require.Len(t, history, 2)
+	assert.Equal(t, "30", history[1].Balance)
+	assert.Equal(t, "20", history[1].Delta)
+}
+
+func TestBalanceHistoryIndex_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	bs := newBlockState()
+	bs.alter(bob)
+	index, _ := balanceHistory.NewBalanceHistoryIndex(createMockArgs(bs))
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		bs.balances[string(bob)] = int64(nonce) * 10
+		_ = index.RecordBlock(&block.Header{Nonce: nonce}, nil, nil, createReward(bob), nil)
+	}
+
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		history, err := index.GetBalanceHistory(bob, common.BalanceHistoryQueryOptions{FromNonce: 5, ToNonce: 4, Step: 1})
+		assert.Nil(t, history)
+		assert.True(t, errors.Is(err, balanceHistory.ErrInvalidNonceRange))
+	})
+	t.Run("invalid step should error", func(t *testing.T) {
+		t.Parallel()
+
+		history, err := index.GetBalanceHistory(bob, common.BalanceHistoryQueryOptions{FromNonce: 1, ToNonce: 4})
+		assert.Nil(t, history)
+		assert.True(t, errors.Is(err, balanceHistory.ErrInvalidStep))
+	})
+	t.Run("unknown address should return empty history", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Len(t, getHistory(t, index, alice, allBlocks("")), 0)
+	})
+	t.Run("nonce range should filter the changes", func(t *testing.T) {
+		t.Parallel()
+
+		history := getHistory(t, index, bob, common.BalanceHistoryQueryOptions{FromNonce: 4, ToNonce: 6, Step: 1})
+		require.Len(t, history, 3)
+		assert.Equal(t, uint64(4), history[0].Nonce)
+		assert.Equal(t, uint64(6), history[2].Nonce)
+	})
+	t.Run("step should merge the changes of the same interval", func(t *testing.T) {
+		t.Parallel()
+
+		history := getHistory(t, index, bob, common.BalanceHistoryQueryOptions{FromNonce: 3, ToNonce: 10, Step: 3})
+		require.Len(t, history, 3)
+
+		assert.Equal(t, uint64(5), history[0].Nonce)
+		assert.Equal(t, "50", history[0].Balance)
+		assert.Equal(t, "30", history[0].Delta)
+		assert.Len(t, history[0].TxHashes, 3)
+
+		assert.Equal(t, uint64(8), history[1].Nonce)
+		assert.Equal(t, "80", history[1].Balance)
+		assert.Equal(t, "30", history[1].Delta)
+
+		assert.Equal(t, uint64(10), history[2].Nonce)
+		assert.Equal(t, "100", history[2].Balance)
+		assert.Equal(t, "20", history[2].Delta)
+	})
+}
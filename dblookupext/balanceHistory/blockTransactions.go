@@ -0,0 +1,199 @@
+package balanceHistory
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/dblookupext/recordedBlocks"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// createTransactionPool gathers the transactions, smart contract results, rewards and logs of a block in the pool
+// the altered accounts are extracted from
+func createTransactionPool(
+	txs map[string]data.TransactionHandler,
+	scrs map[string]data.TransactionHandler,
+	rewards map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) (*outportcore.TransactionPool, error) {
+	pool := &outportcore.TransactionPool{
+		Transactions:         make(map[string]*outportcore.TxInfo, len(txs)),
+		SmartContractResults: make(map[string]*outportcore.SCRInfo, len(scrs)),
+		Rewards:              make(map[string]*outportcore.RewardInfo, len(rewards)),
+		Logs:                 make([]*outportcore.LogData, 0, len(logs)),
+	}
+
+	for txHash, txHandler := range txs {
+		tx, ok := txHandler.(*transaction.Transaction)
+		if !ok {
+			return nil, fmt.Errorf("%w for transaction %x, type %T", process.ErrWrongTypeAssertion, txHash, txHandler)
+		}
+
+		pool.Transactions[hex.EncodeToString([]byte(txHash))] = &outportcore.TxInfo{Transaction: tx}
+	}
+
+	for scrHash, txHandler := range scrs {
+		scr, ok := txHandler.(*smartContractResult.SmartContractResult)
+		if !ok {
+			return nil, fmt.Errorf("%w for smart contract result %x, type %T", process.ErrWrongTypeAssertion, scrHash, txHandler)
+		}
+
+		pool.SmartContractResults[hex.EncodeToString([]byte(scrHash))] = &outportcore.SCRInfo{SmartContractResult: scr}
+	}
+
+	for rewardHash, txHandler := range rewards {
+		reward, ok := txHandler.(*rewardTx.RewardTx)
+		if !ok {
+			return nil, fmt.Errorf("%w for reward %x, type %T", process.ErrWrongTypeAssertion, rewardHash, txHandler)
+		}
+
+		pool.Rewards[hex.EncodeToString([]byte(rewardHash))] = &outportcore.RewardInfo{Reward: reward}
+	}
+
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		txLog, ok := logData.LogHandler.(*transaction.Log)
+		if !ok {
+			return nil, fmt.Errorf("%w for log %x, type %T", process.ErrWrongTypeAssertion, logData.TxHash, logData.LogHandler)
+		}
+
+		pool.Logs = append(pool.Logs, &outportcore.LogData{
+			TxHash: hex.EncodeToString([]byte(logData.TxHash)),
+			Log:    txLog,
+		})
+	}
+
+	return pool, nil
+}
+
+// txHashesHolder keeps the hashes of the transactions of a block by the assets they moved and by the addresses they
+// involved. It only provides the hashes reported with the balance changes, the changes themselves being driven by the
+// altered accounts of the block
+type txHashesHolder struct {
+	byAsset   map[string][][]byte
+	byAddress map[string][][]byte
+}
+
+func newTxHashesHolder() *txHashesHolder {
+	return &txHashesHolder{
+		byAsset:   make(map[string][][]byte),
+		byAddress: make(map[string][][]byte),
+	}
+}
+
+// get returns the hashes of the transactions that moved the provided asset or, if none is known, the hashes of all
+// the transactions that involved the address. The latter covers the changes without a dedicated transaction or event,
+// like the fees paid by a relayer
+func (holder *txHashesHolder) get(address []byte, assetKey []byte) [][]byte {
+	txHashes, found := holder.byAsset[string(assetKey)]
+	if found {
+		return txHashes
+	}
+
+	return holder.byAddress[string(address)]
+}
+
+func (holder *txHashesHolder) addTransactions(txs map[string]data.TransactionHandler) {
+	for _, txHash := range recordedBlocks.SortedKeys(txs) {
+		tx := txs[txHash]
+		if check.IfNil(tx) {
+			continue
+		}
+
+		holder.add(tx.GetSndAddr(), "", 0, []byte(txHash))
+		holder.add(tx.GetRcvAddr(), "", 0, []byte(txHash))
+	}
+}
+
+func (holder *txHashesHolder) addLogs(logs []*data.LogData) {
+	sortedLogs := make([]*data.LogData, 0, len(logs))
+	for _, logData := range logs {
+		if logData != nil && !check.IfNil(logData.LogHandler) {
+			sortedLogs = append(sortedLogs, logData)
+		}
+	}
+	sort.SliceStable(sortedLogs, func(i, j int) bool {
+		return sortedLogs[i].TxHash < sortedLogs[j].TxHash
+	})
+
+	for _, logData := range sortedLogs {
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			event, ok := eventHandler.(*transaction.Event)
+			if !ok {
+				continue
+			}
+
+			holder.addEvent(event, []byte(logData.TxHash))
+		}
+	}
+}
+
+func (holder *txHashesHolder) addEvent(event *transaction.Event, txHash []byte) {
+	holder.add(event.Address, "", 0, txHash)
+
+	switch string(event.Identifier) {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		if len(event.Topics) < 4 || len(event.Topics)%3 != 1 {
+			return
+		}
+
+		// the topics hold groups of (token, nonce, value), followed by the receiver
+		receiver := event.Topics[len(event.Topics)-1]
+		for i := 0; i+3 < len(event.Topics); i += 3 {
+			token := string(event.Topics[i])
+			tokenNonce := big.NewInt(0).SetBytes(event.Topics[i+1]).Uint64()
+			holder.add(event.Address, token, tokenNonce, txHash)
+			holder.add(receiver, token, tokenNonce, txHash)
+		}
+	case core.BuiltInFunctionESDTLocalMint, core.BuiltInFunctionESDTLocalBurn, core.BuiltInFunctionESDTNFTCreate,
+		core.BuiltInFunctionESDTNFTAddQuantity, core.BuiltInFunctionESDTNFTBurn:
+		// the holder is the address of the event, the topics start with the token and its nonce
+		if len(event.Topics) < 2 {
+			return
+		}
+
+		tokenNonce := big.NewInt(0).SetBytes(event.Topics[1]).Uint64()
+		holder.add(event.Address, string(event.Topics[0]), tokenNonce, txHash)
+	case core.BuiltInFunctionESDTWipe:
+		// the topics hold the token, its nonce, the wiped value and the wiped address
+		if len(event.Topics) < 4 {
+			return
+		}
+
+		tokenNonce := big.NewInt(0).SetBytes(event.Topics[1]).Uint64()
+		holder.add(event.Topics[3], string(event.Topics[0]), tokenNonce, txHash)
+	}
+}
+
+func (holder *txHashesHolder) add(address []byte, token string, tokenNonce uint64, txHash []byte) {
+	if len(address) == 0 {
+		return
+	}
+
+	holder.byAddress[string(address)] = appendHash(holder.byAddress[string(address)], txHash)
+
+	key := string(assetKey(address, tokenIdentifier(token, tokenNonce)))
+	holder.byAsset[key] = appendHash(holder.byAsset[key], txHash)
+}
+
+func appendHash(txHashes [][]byte, txHash []byte) [][]byte {
+	for _, hash := range txHashes {
+		if string(hash) == string(txHash) {
+			return txHashes
+		}
+	}
+
+	return append(txHashes, txHash)
+}
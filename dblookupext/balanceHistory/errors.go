@@ -0,0 +1,18 @@
+package balanceHistory
+
+import "errors"
+
+// ErrInvalidNonceRange signals that an invalid block nonce range was provided
+var ErrInvalidNonceRange = errors.New("invalid block nonce range")
+
+// ErrInvalidStep signals that an invalid step was provided
+var ErrInvalidStep = errors.New("invalid step")
+
+// ErrNilAlteredAccountsProvider signals that a nil altered accounts provider was provided
+var ErrNilAlteredAccountsProvider = errors.New("nil altered accounts provider")
+
+// ErrNilAccountsRepository signals that a nil accounts repository was provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrInvalidBalance signals that an altered account holds a balance that is not a valid number
+var ErrInvalidBalance = errors.New("invalid balance")
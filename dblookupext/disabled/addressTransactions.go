@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errAddressTransactionsIndexDisabled = errors.New("address transactions index is disabled")
+
+type addressTransactions struct {
+}
+
+// NewAddressTransactions returns a disabled address transactions index
+func NewAddressTransactions() *addressTransactions {
+	return &addressTransactions{}
+}
+
+// RecordBlock does nothing
+func (at *addressTransactions) RecordBlock(_ data.HeaderHandler, _ map[string]data.TransactionHandler, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (at *addressTransactions) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetTransactions returns an address transactions index disabled error
+func (at *addressTransactions) GetTransactions(_ []byte, _ common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	return nil, errAddressTransactionsIndexDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (at *addressTransactions) IsInterfaceNil() bool {
+	return at == nil
+}
@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errBalanceHistoryIndexDisabled = errors.New("balance history index is disabled")
+
+type balanceHistory struct {
+}
+
+// NewBalanceHistory returns a disabled balance history index
+func NewBalanceHistory() *balanceHistory {
+	return &balanceHistory{}
+}
+
+// RecordBlock does nothing
+func (bh *balanceHistory) RecordBlock(_ data.HeaderHandler, _, _, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (bh *balanceHistory) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetBalanceHistory returns a balance history index disabled error
+func (bh *balanceHistory) GetBalanceHistory(_ []byte, _ common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	return nil, errBalanceHistoryIndexDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bh *balanceHistory) IsInterfaceNil() bool {
+	return bh == nil
+}
@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errEventsIndexDisabled = errors.New("events index is disabled")
+
+type eventsIndex struct {
+}
+
+// NewEventsIndex returns a disabled events index
+func NewEventsIndex() *eventsIndex {
+	return &eventsIndex{}
+}
+
+// RecordBlock does nothing
+func (ei *eventsIndex) RecordBlock(_ data.HeaderHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (ei *eventsIndex) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetEvents returns an events index disabled error
+func (ei *eventsIndex) GetEvents(_ common.EventsQuery) ([]*common.IndexedEvent, error) {
+	return nil, errEventsIndexDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *eventsIndex) IsInterfaceNil() bool {
+	return ei == nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
@@ -20,7 +21,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
 	return nil
 }
 
@@ -53,6 +54,31 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetESDTHolders returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetESDTHolders(_ string) (*esdtSupply.HoldersESDT, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetESDTVolume returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetESDTVolume(_ string, _ uint32) (*esdtSupply.VolumeESDT, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetAddressTransactions returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetAddressTransactions(_ []byte, _ common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// QueryEvents returns a disabled history repository error
+func (nhr *nilHistoryRepository) QueryEvents(_ common.EventsQuery) ([]*common.IndexedEvent, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetBalanceHistory returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetBalanceHistory(_ []byte, _ common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
@@ -13,6 +13,12 @@ var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
 
+var errNilAddressTransactionsHandler = errors.New("nil address transactions handler")
+
+var errNilEventsIndexHandler = errors.New("nil events index handler")
+
+var errNilBalanceHistoryHandler = errors.New("nil balance history handler")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -0,0 +1,31 @@
+package esdtSupply
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+type disabledHoldersProcessor struct {
+}
+
+// NewDisabledHoldersProcessor creates a holders processor that does not track anything
+func NewDisabledHoldersProcessor() *disabledHoldersProcessor {
+	return &disabledHoldersProcessor{}
+}
+
+// ProcessEvents does nothing
+func (dhp *disabledHoldersProcessor) ProcessEvents(_ []*transaction.Event, _ uint32, _ bool) error {
+	return nil
+}
+
+// GetHolders returns the holders tracking disabled error
+func (dhp *disabledHoldersProcessor) GetHolders(_ string) (*HoldersESDT, error) {
+	return nil, ErrHoldersTrackingDisabled
+}
+
+// GetVolume returns the holders tracking disabled error
+func (dhp *disabledHoldersProcessor) GetVolume(_ string, _ uint32) (*VolumeESDT, error) {
+	return nil, ErrHoldersTrackingDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dhp *disabledHoldersProcessor) IsInterfaceNil() bool {
+	return dhp == nil
+}
@@ -3,3 +3,15 @@ package esdtSupply
 import "errors"
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidTopHoldersCount signals that an invalid number of top holders has been provided
+var ErrInvalidTopHoldersCount = errors.New("invalid top holders count")
+
+// ErrNilHoldersHandler signals that a nil holders handler has been provided
+var ErrNilHoldersHandler = errors.New("nil holders handler")
+
+// ErrHoldersTrackingDisabled signals that the ESDT holders tracking is disabled
+var ErrHoldersTrackingDisabled = errors.New("ESDT holders tracking is disabled")
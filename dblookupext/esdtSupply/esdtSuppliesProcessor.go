@@ -26,6 +26,7 @@ func NewSuppliesProcessor(
 	marshalizer marshal.Marshalizer,
 	suppliesStorer storage.Storer,
 	logsStorer storage.Storer,
+	holdersProc HoldersHandler,
 ) (*suppliesProcessor, error) {
 	if check.IfNil(marshalizer) {
 		return nil, core.ErrNilMarshalizer
@@ -36,9 +37,12 @@ func NewSuppliesProcessor(
 	if check.IfNil(logsStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(holdersProc) {
+		return nil, ErrNilHoldersHandler
+	}
 
 	logsGet := newLogsGetter(marshalizer, logsStorer)
-	logsProc := newLogsProcessor(marshalizer, suppliesStorer)
+	logsProc := newLogsProcessor(marshalizer, suppliesStorer, holdersProc)
 
 	return &suppliesProcessor{
 		logsProc: logsProc,
@@ -46,8 +50,8 @@ func NewSuppliesProcessor(
 	}, nil
 }
 
-// ProcessLogs will process the provided logs
-func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, logs []*data.LogData) error {
+// ProcessLogs will process the provided logs of a block from the given epoch
+func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, epoch uint32, logs []*data.LogData) error {
 	sp.mutex.Lock()
 	defer sp.mutex.Unlock()
 
@@ -58,7 +62,7 @@ func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, logs []*data.LogData
 		}
 	}
 
-	return sp.logsProc.processLogs(blockNonce, logsMap, false)
+	return sp.logsProc.processLogs(blockNonce, epoch, logsMap, false)
 }
 
 // RevertChanges will revert supplies changes based on the provided block body
@@ -75,7 +79,7 @@ func (sp *suppliesProcessor) RevertChanges(header data.HeaderHandler, body data.
 		return err
 	}
 
-	return sp.logsProc.processLogs(header.GetNonce(), logsFromDB, true)
+	return sp.logsProc.processLogs(header.GetNonce(), header.GetEpoch(), logsFromDB, true)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -83,6 +87,16 @@ func (sp *suppliesProcessor) GetESDTSupply(token string) (*SupplyESDT, error) {
 	return sp.logsProc.getESDTSupply([]byte(token))
 }
 
+// GetESDTHolders will return the number of holders and the top holders of the given token
+func (sp *suppliesProcessor) GetESDTHolders(token string) (*HoldersESDT, error) {
+	return sp.logsProc.holdersProc.GetHolders(token)
+}
+
+// GetESDTVolume will return the minted, burned and transferred amounts of the given token during the given epoch
+func (sp *suppliesProcessor) GetESDTVolume(token string, epoch uint32) (*VolumeESDT, error) {
+	return sp.logsProc.holdersProc.GetVolume(token, epoch)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sp *suppliesProcessor) IsInterfaceNil() bool {
 	return sp == nil
@@ -31,16 +31,19 @@ const (
 func TestNewSuppliesProcessor(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewSuppliesProcessor(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	_, err := NewSuppliesProcessor(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{}, NewDisabledHoldersProcessor())
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
-	_, err = NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, nil, &storageStubs.StorerStub{})
+	_, err = NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, nil, &storageStubs.StorerStub{}, NewDisabledHoldersProcessor())
 	require.Equal(t, core.ErrNilStore, err)
 
-	_, err = NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, nil)
+	_, err = NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, nil, NewDisabledHoldersProcessor())
 	require.Equal(t, core.ErrNilStore, err)
 
-	proc, err := NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	_, err = NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{}, nil)
+	require.Equal(t, ErrNilHoldersHandler, err)
+
+	proc, err := NewSuppliesProcessor(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{}, NewDisabledHoldersProcessor())
 	require.Nil(t, err)
 	require.NotNil(t, proc)
 	require.False(t, proc.IsInterfaceNil())
@@ -134,10 +137,10 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, NewDisabledHoldersProcessor())
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logs)
+	err = suppliesProc.ProcessLogs(6, 0, logs)
 	require.Nil(t, err)
 
 	require.Equal(t, 3, putCalledNum)
@@ -296,16 +299,16 @@ func TestProcessLogsSaveSupplyShouldUpdateSupplyMintedAndBurned(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, NewDisabledHoldersProcessor())
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logsCreate)
+	err = suppliesProc.ProcessLogs(6, 0, logsCreate)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(7, logsAddQuantity)
+	err = suppliesProc.ProcessLogs(7, 0, logsAddQuantity)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(8, logsBurn)
+	err = suppliesProc.ProcessLogs(8, 0, logsBurn)
 	require.Nil(t, err)
 
 	require.Equal(t, 9, numTimesCalled)
@@ -372,14 +375,14 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingMinting(t *testing.T) {
 
 	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound(0)
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer, NewDisabledHoldersProcessor())
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
+	err = suppliesProc.ProcessLogs(6, 0, logsMintNoRevert)
 	require.Nil(t, err)
 	checkStoredValues(t, suppliesStorer, token, marshalizer, testFungibleTokenMint*2, testFungibleTokenMint*2, 0)
 
-	err = suppliesProc.ProcessLogs(7, logsMintRevert)
+	err = suppliesProc.ProcessLogs(7, 0, logsMintRevert)
 	require.Nil(t, err)
 	checkStoredValues(t, suppliesStorer, token, marshalizer,
 		testFungibleTokenMint*2+testFungibleTokenMint2,
@@ -463,14 +466,14 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingBurning(t *testing.T) {
 
 	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound(0)
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer, NewDisabledHoldersProcessor())
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
+	err = suppliesProc.ProcessLogs(6, 0, logsMintNoRevert)
 	require.Nil(t, err)
 	checkStoredValues(t, suppliesStorer, token, marshalizer, testFungibleTokenMint*2, testFungibleTokenMint*2, 0)
 
-	err = suppliesProc.ProcessLogs(7, logsMintRevert)
+	err = suppliesProc.ProcessLogs(7, 0, logsMintRevert)
 	require.Nil(t, err)
 	checkStoredValues(t,
 		suppliesStorer,
@@ -535,7 +538,7 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 			}
 			return nil, errors.New("local err")
 		},
-	}, &storageStubs.StorerStub{})
+	}, &storageStubs.StorerStub{}, NewDisabledHoldersProcessor())
 
 	res, err := proc.GetESDTSupply("my-token")
 	require.Nil(t, err)
@@ -0,0 +1,481 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. holdersESDT.proto
+
+package esdtSupply
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	holderBalanceKeyPrefix = "holder-balance@"
+	topHoldersKeyPrefix    = "top-holders@"
+	volumeKeyPrefix        = "volume@"
+	keySeparator           = "@"
+
+	// the top holders record keeps more holders than the returned ones, so the holders that drop out of the top, or
+	// are evicted by a block that is later reverted, can be replaced without scanning all the balances
+	topHoldersReserveFactor = 2
+
+	// transfer events hold groups of (token, nonce, value) topics followed by the receiver
+	numTopicsPerTransferredToken = 3
+)
+
+// HoldersESDT holds the number of holders of a token from the current shard, together with its top holders
+type HoldersESDT struct {
+	NumHolders uint64        `json:"numHolders"`
+	TopHolders []*HolderESDT `json:"topHolders"`
+}
+
+// ArgsHoldersProcessor holds the arguments needed to create a holders processor
+type ArgsHoldersProcessor struct {
+	Marshalizer      marshal.Marshalizer
+	Storer           storage.Storer
+	ShardCoordinator sharding.Coordinator
+	TopHoldersCount  uint32
+}
+
+// holdersChanges holds the changes of a block: the balance deltas for each token and holder and the volume deltas
+// for each token
+type holdersChanges struct {
+	balances map[string]map[string]*big.Int
+	volumes  map[string]*VolumeESDT
+}
+
+type holdersProcessor struct {
+	storer             storage.Storer
+	marshaller         marshal.Marshalizer
+	shardCoordinator   sharding.Coordinator
+	topHoldersCount    int
+	maxTrackedHolders  int
+	mintOperations     map[string]struct{}
+	burnOperations     map[string]struct{}
+	transferOperations map[string]struct{}
+}
+
+// NewHoldersProcessor creates a component that tracks, from the ESDT log events, the number of holders of each token,
+// the top holders and the per-epoch volumes. The holders are tracked for the addresses of the current shard, so the
+// chain should be processed from genesis in order to have exact values, same as for the supplies
+func NewHoldersProcessor(args ArgsHoldersProcessor) (*holdersProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Storer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.TopHoldersCount == 0 {
+		return nil, ErrInvalidTopHoldersCount
+	}
+
+	return &holdersProcessor{
+		storer:            args.Storer,
+		marshaller:        args.Marshalizer,
+		shardCoordinator:  args.ShardCoordinator,
+		topHoldersCount:   int(args.TopHoldersCount),
+		maxTrackedHolders: int(args.TopHoldersCount) * topHoldersReserveFactor,
+		mintOperations: map[string]struct{}{
+			core.BuiltInFunctionESDTLocalMint:      {},
+			core.BuiltInFunctionESDTNFTCreate:      {},
+			core.BuiltInFunctionESDTNFTAddQuantity: {},
+		},
+		burnOperations: map[string]struct{}{
+			core.BuiltInFunctionESDTLocalBurn: {},
+			core.BuiltInFunctionESDTNFTBurn:   {},
+			core.BuiltInFunctionESDTWipe:      {},
+		},
+		transferOperations: map[string]struct{}{
+			core.BuiltInFunctionESDTTransfer:         {},
+			core.BuiltInFunctionESDTNFTTransfer:      {},
+			core.BuiltInFunctionMultiESDTNFTTransfer: {},
+		},
+	}, nil
+}
+
+// ProcessEvents updates the holders and the volumes of the given epoch based on the events of a block. On revert,
+// the changes of the events are subtracted
+func (hp *holdersProcessor) ProcessEvents(events []*transaction.Event, epoch uint32, isRevert bool) error {
+	changes := &holdersChanges{
+		balances: make(map[string]map[string]*big.Int),
+		volumes:  make(map[string]*VolumeESDT),
+	}
+
+	for _, event := range events {
+		hp.processEvent(event, changes)
+	}
+
+	if isRevert {
+		changes.negate()
+	}
+
+	err := hp.saveBalances(changes.balances)
+	if err != nil {
+		return err
+	}
+
+	return hp.saveVolumes(changes.volumes, epoch)
+}
+
+func (hp *holdersProcessor) processEvent(event *transaction.Event, changes *holdersChanges) {
+	identifier := string(event.Identifier)
+	_, isMint := hp.mintOperations[identifier]
+	_, isBurn := hp.burnOperations[identifier]
+	_, isTransfer := hp.transferOperations[identifier]
+
+	switch {
+	case isMint && len(event.Topics) >= numTopicsPerTransferredToken:
+		value := big.NewInt(0).SetBytes(event.Topics[2])
+		for _, token := range getTokenIdentifiers(event.Topics[0], event.Topics[1]) {
+			hp.addBalanceChange(changes, token, event.Address, value)
+			volume := changes.getVolume(token)
+			volume.Minted.Add(volume.Minted, value)
+		}
+	case isBurn && len(event.Topics) >= numTopicsPerTransferredToken:
+		holder := event.Address
+		if identifier == core.BuiltInFunctionESDTWipe && len(event.Topics) > numTopicsPerTransferredToken {
+			holder = event.Topics[3]
+		}
+
+		value := big.NewInt(0).SetBytes(event.Topics[2])
+		for _, token := range getTokenIdentifiers(event.Topics[0], event.Topics[1]) {
+			hp.addBalanceChange(changes, token, holder, big.NewInt(0).Neg(value))
+			volume := changes.getVolume(token)
+			volume.Burned.Add(volume.Burned, value)
+		}
+	case isTransfer:
+		hp.processTransferEvent(event, changes)
+	}
+}
+
+// processTransferEvent handles the transfer events, which are generated both on the sender shard and on the receiver
+// shard. Each side only updates the balances of its own addresses, while the volume is accounted on the sender shard
+func (hp *holdersProcessor) processTransferEvent(event *transaction.Event, changes *holdersChanges) {
+	numTopics := len(event.Topics)
+	if numTopics <= numTopicsPerTransferredToken || (numTopics-1)%numTopicsPerTransferredToken != 0 {
+		return
+	}
+
+	receiver := event.Topics[numTopics-1]
+	isSenderInSelfShard := hp.isInSelfShard(event.Address)
+	for i := 0; i < numTopics-1; i += numTopicsPerTransferredToken {
+		value := big.NewInt(0).SetBytes(event.Topics[i+2])
+		for _, token := range getTokenIdentifiers(event.Topics[i], event.Topics[i+1]) {
+			hp.addBalanceChange(changes, token, event.Address, big.NewInt(0).Neg(value))
+			hp.addBalanceChange(changes, token, receiver, value)
+			if isSenderInSelfShard {
+				volume := changes.getVolume(token)
+				volume.Transferred.Add(volume.Transferred, value)
+			}
+		}
+	}
+}
+
+func (hp *holdersProcessor) addBalanceChange(changes *holdersChanges, token string, address []byte, value *big.Int) {
+	if len(address) == 0 || !hp.isInSelfShard(address) {
+		return
+	}
+
+	tokenBalances, found := changes.balances[token]
+	if !found {
+		tokenBalances = make(map[string]*big.Int)
+		changes.balances[token] = tokenBalances
+	}
+
+	balance, found := tokenBalances[string(address)]
+	if !found {
+		balance = big.NewInt(0)
+		tokenBalances[string(address)] = balance
+	}
+
+	balance.Add(balance, value)
+}
+
+func (hp *holdersProcessor) isInSelfShard(address []byte) bool {
+	return hp.shardCoordinator.ComputeId(address) == hp.shardCoordinator.SelfId()
+}
+
+// saveBalances applies the balance deltas on the holder keys and updates, for each token, the holders counter and the
+// bounded list of top holders, so the cost of a block only depends on the number of changed balances
+func (hp *holdersProcessor) saveBalances(balances map[string]map[string]*big.Int) error {
+	for token, tokenBalances := range balances {
+		topHolders, err := hp.getTopHolders(token)
+		if err != nil {
+			return err
+		}
+
+		for address, delta := range tokenBalances {
+			if delta.Sign() == 0 {
+				continue
+			}
+
+			oldBalance, newBalance, errUpdate := hp.updateBalance(token, []byte(address), delta)
+			if errUpdate != nil {
+				return errUpdate
+			}
+
+			topHolders.updateNumHolders(oldBalance, newBalance)
+			topHolders.remove([]byte(address), oldBalance)
+			topHolders.insert([]byte(address), newBalance, hp.maxTrackedHolders)
+		}
+
+		err = hp.saveTopHolders(token, topHolders)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateBalance returns the balances before and after applying the delta
+func (hp *holdersProcessor) updateBalance(token string, address []byte, delta *big.Int) (*big.Int, *big.Int, error) {
+	key := createHolderBalanceKey(token, address)
+	holder := &HolderESDT{}
+	err := hp.get(key, holder)
+	if err != nil {
+		return nil, nil, err
+	}
+	if holder.Balance == nil {
+		holder.Balance = big.NewInt(0)
+	}
+
+	// balances received before the tracking started are not known, so a holder might reach negative values
+	oldBalance := big.NewInt(0).Set(holder.Balance)
+	holder.Balance.Add(holder.Balance, delta)
+	if holder.Balance.Sign() == 0 {
+		return oldBalance, holder.Balance, hp.storer.Remove(key)
+	}
+
+	return oldBalance, holder.Balance, hp.put(key, holder)
+}
+
+func (hp *holdersProcessor) getTopHolders(token string) (*TopHoldersESDT, error) {
+	topHolders := &TopHoldersESDT{}
+	err := hp.get([]byte(topHoldersKeyPrefix+token), topHolders)
+	if err != nil {
+		return nil, err
+	}
+
+	return topHolders, nil
+}
+
+func (hp *holdersProcessor) saveTopHolders(token string, topHolders *TopHoldersESDT) error {
+	key := []byte(topHoldersKeyPrefix + token)
+	if topHolders.NumHolders == 0 && len(topHolders.Holders) == 0 {
+		return hp.storer.Remove(key)
+	}
+
+	return hp.put(key, topHolders)
+}
+
+func (hp *holdersProcessor) saveVolumes(volumes map[string]*VolumeESDT, epoch uint32) error {
+	for token, delta := range volumes {
+		volume, err := hp.GetVolume(token, epoch)
+		if err != nil {
+			return err
+		}
+
+		volume.Minted.Add(volume.Minted, delta.Minted)
+		volume.Burned.Add(volume.Burned, delta.Burned)
+		volume.Transferred.Add(volume.Transferred, delta.Transferred)
+
+		err = hp.put(createVolumeKey(token, epoch), volume)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetHolders returns the number of holders and the top holders of the given token
+func (hp *holdersProcessor) GetHolders(token string) (*HoldersESDT, error) {
+	topHolders, err := hp.getTopHolders(token)
+	if err != nil {
+		return nil, err
+	}
+
+	numTopHolders := len(topHolders.Holders)
+	if numTopHolders > hp.topHoldersCount {
+		numTopHolders = hp.topHoldersCount
+	}
+
+	holders := make([]*HolderESDT, 0, numTopHolders)
+	holders = append(holders, topHolders.Holders[:numTopHolders]...)
+
+	return &HoldersESDT{
+		NumHolders: topHolders.NumHolders,
+		TopHolders: holders,
+	}, nil
+}
+
+// GetVolume returns the minted, burned and transferred amounts of the given token during the given epoch
+func (hp *holdersProcessor) GetVolume(token string, epoch uint32) (*VolumeESDT, error) {
+	volume := &VolumeESDT{}
+	err := hp.get(createVolumeKey(token, epoch), volume)
+	if err != nil {
+		return nil, err
+	}
+
+	makeVolumePropertiesNotNil(volume)
+	return volume, nil
+}
+
+func (hp *holdersProcessor) get(key []byte, value interface{}) error {
+	valueBytes, err := hp.storer.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return hp.marshaller.Unmarshal(value, valueBytes)
+}
+
+func (hp *holdersProcessor) put(key []byte, value interface{}) error {
+	valueBytes, err := hp.marshaller.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return hp.storer.Put(key, valueBytes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *holdersProcessor) IsInterfaceNil() bool {
+	return hp == nil
+}
+
+// updateNumHolders counts the holders that reached or left a positive balance
+func (topHolders *TopHoldersESDT) updateNumHolders(oldBalance *big.Int, newBalance *big.Int) {
+	wasHolder := oldBalance.Sign() > 0
+	isHolder := newBalance.Sign() > 0
+	switch {
+	case !wasHolder && isHolder:
+		topHolders.NumHolders++
+	case wasHolder && !isHolder && topHolders.NumHolders > 0:
+		topHolders.NumHolders--
+	}
+}
+
+// remove deletes the holder with the given balance, if it is part of the top holders
+func (topHolders *TopHoldersESDT) remove(address []byte, balance *big.Int) {
+	if balance.Sign() <= 0 {
+		return
+	}
+
+	position := topHolders.search(address, balance)
+	if position == len(topHolders.Holders) || !bytes.Equal(topHolders.Holders[position].Address, address) {
+		return
+	}
+
+	topHolders.Holders = append(topHolders.Holders[:position], topHolders.Holders[position+1:]...)
+}
+
+// insert adds the holder at its position, if the balance is positive, and keeps at most maxHolders holders. A holder
+// placed after the last tracked one is only added if there are no untracked holders, which might have larger balances
+func (topHolders *TopHoldersESDT) insert(address []byte, balance *big.Int, maxHolders int) {
+	if balance.Sign() <= 0 {
+		return
+	}
+
+	position := topHolders.search(address, balance)
+	numTracked := len(topHolders.Holders)
+	hasUntrackedHolders := topHolders.NumHolders > uint64(numTracked+1)
+	if position >= maxHolders || (position == numTracked && hasUntrackedHolders) {
+		return
+	}
+
+	topHolders.Holders = append(topHolders.Holders, nil)
+	copy(topHolders.Holders[position+1:], topHolders.Holders[position:])
+	topHolders.Holders[position] = &HolderESDT{
+		Address: address,
+		Balance: big.NewInt(0).Set(balance),
+	}
+	if len(topHolders.Holders) > maxHolders {
+		topHolders.Holders = topHolders.Holders[:maxHolders]
+	}
+}
+
+// search returns the position of the first holder that is not placed before the given one: the holders are ordered
+// by descending balance, then by address
+func (topHolders *TopHoldersESDT) search(address []byte, balance *big.Int) int {
+	return sort.Search(len(topHolders.Holders), func(i int) bool {
+		cmp := topHolders.Holders[i].Balance.Cmp(balance)
+		if cmp != 0 {
+			return cmp < 0
+		}
+
+		return bytes.Compare(topHolders.Holders[i].Address, address) >= 0
+	})
+}
+
+func (changes *holdersChanges) getVolume(token string) *VolumeESDT {
+	volume, found := changes.volumes[token]
+	if !found {
+		volume = &VolumeESDT{}
+		makeVolumePropertiesNotNil(volume)
+		changes.volumes[token] = volume
+	}
+
+	return volume
+}
+
+func (changes *holdersChanges) negate() {
+	for _, tokenBalances := range changes.balances {
+		for _, balance := range tokenBalances {
+			balance.Neg(balance)
+		}
+	}
+	for _, volume := range changes.volumes {
+		volume.Minted.Neg(volume.Minted)
+		volume.Burned.Neg(volume.Burned)
+		volume.Transferred.Neg(volume.Transferred)
+	}
+}
+
+// getTokenIdentifiers returns the identifiers updated by an event: the token itself for fungible tokens, the token
+// with the nonce and its collection otherwise
+func getTokenIdentifiers(tokenID []byte, nonce []byte) []string {
+	if len(nonce) == 0 {
+		return []string{string(tokenID)}
+	}
+
+	return []string{
+		fmt.Sprintf("%s-%s", tokenID, hex.EncodeToString(nonce)),
+		string(tokenID),
+	}
+}
+
+func createHolderBalanceKey(token string, address []byte) []byte {
+	return append([]byte(holderBalanceKeyPrefix+token+keySeparator), address...)
+}
+
+func createVolumeKey(token string, epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%d", volumeKeyPrefix, token, keySeparator, epoch))
+}
+
+func makeVolumePropertiesNotNil(volume *VolumeESDT) {
+	if volume.Minted == nil {
+		volume.Minted = big.NewInt(0)
+	}
+	if volume.Burned == nil {
+		volume.Burned = big.NewInt(0)
+	}
+	if volume.Transferred == nil {
+		volume.Transferred = big.NewInt(0)
+	}
+}
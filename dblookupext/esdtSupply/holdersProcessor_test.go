@@ -0,0 +1,317 @@
+package esdtSupply
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	holderA       = []byte("holder-a")
+	holderB       = []byte("holder-b")
+	holderC       = []byte("holder-c")
+	holderD       = []byte("holder-d")
+	holderE       = []byte("holder-e")
+	otherShardAcc = []byte("other-shard-account")
+	testToken     = []byte("TKN-abcdef")
+)
+
+func createMockArgsHoldersProcessor() ArgsHoldersProcessor {
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(otherShardAcc) {
+			return 1
+		}
+		return 0
+	}
+
+	return ArgsHoldersProcessor{
+		Marshalizer:      &marshal.GogoProtoMarshalizer{},
+		Storer:           testscommon.CreateMemUnit(),
+		ShardCoordinator: shardCoordinator,
+		TopHoldersCount:  2,
+	}
+}
+
+func createMintEvent(holder []byte, nonce uint64, value int64) *transaction.Event {
+	return &transaction.Event{
+		Address:    holder,
+		Identifier: []byte(core.BuiltInFunctionESDTLocalMint),
+		Topics:     [][]byte{testToken, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(value).Bytes()},
+	}
+}
+
+func createTransferEvent(sender []byte, receiver []byte, value int64) *transaction.Event {
+	return &transaction.Event{
+		Address:    sender,
+		Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+		Topics:     [][]byte{testToken, nil, big.NewInt(value).Bytes(), receiver},
+	}
+}
+
+func TestNewHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHoldersProcessor()
+		args.Marshalizer = nil
+
+		hp, err := NewHoldersProcessor(args)
+		require.Nil(t, hp)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHoldersProcessor()
+		args.Storer = nil
+
+		hp, err := NewHoldersProcessor(args)
+		require.Nil(t, hp)
+		require.Equal(t, core.ErrNilStore, err)
+	})
+
+	t.Run("nil shard coordinator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHoldersProcessor()
+		args.ShardCoordinator = nil
+
+		hp, err := NewHoldersProcessor(args)
+		require.Nil(t, hp)
+		require.Equal(t, ErrNilShardCoordinator, err)
+	})
+
+	t.Run("invalid top holders count", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHoldersProcessor()
+		args.TopHoldersCount = 0
+
+		hp, err := NewHoldersProcessor(args)
+		require.Nil(t, hp)
+		require.Equal(t, ErrInvalidTopHoldersCount, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hp, err := NewHoldersProcessor(createMockArgsHoldersProcessor())
+		require.Nil(t, err)
+		require.False(t, hp.IsInterfaceNil())
+	})
+}
+
+func TestHoldersProcessor_ProcessEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should track the holders and the volumes", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+		err := hp.ProcessEvents([]*transaction.Event{
+			createMintEvent(holderA, 0, 100),
+			createTransferEvent(holderA, holderB, 30),
+			createTransferEvent(holderA, holderC, 20),
+			{
+				Address:    holderC,
+				Identifier: []byte(core.BuiltInFunctionESDTLocalBurn),
+				Topics:     [][]byte{testToken, nil, big.NewInt(20).Bytes()},
+			},
+			{Identifier: []byte("something else")},
+		}, 5, false)
+		require.Nil(t, err)
+
+		holders, err := hp.GetHolders(string(testToken))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), holders.NumHolders)
+		assert.Equal(t, []*HolderESDT{
+			{Address: holderA, Balance: big.NewInt(50)},
+			{Address: holderB, Balance: big.NewInt(30)},
+		}, holders.TopHolders)
+
+		volume, err := hp.GetVolume(string(testToken), 5)
+		require.Nil(t, err)
+		assert.Equal(t, &VolumeESDT{
+			Minted:      big.NewInt(100),
+			Burned:      big.NewInt(20),
+			Transferred: big.NewInt(50),
+		}, volume)
+
+		volume, _ = hp.GetVolume(string(testToken), 6)
+		assert.Equal(t, big.NewInt(0), volume.Transferred)
+	})
+
+	t.Run("cross shard transfers should only update the addresses of the current shard", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+		err := hp.ProcessEvents([]*transaction.Event{
+			createMintEvent(holderA, 0, 100),
+			createTransferEvent(holderA, otherShardAcc, 100),
+			createTransferEvent(otherShardAcc, holderB, 40),
+		}, 1, false)
+		require.Nil(t, err)
+
+		holders, _ := hp.GetHolders(string(testToken))
+		assert.Equal(t, uint64(1), holders.NumHolders)
+		assert.Equal(t, []*HolderESDT{{Address: holderB, Balance: big.NewInt(40)}}, holders.TopHolders)
+
+		volume, _ := hp.GetVolume(string(testToken), 1)
+		assert.Equal(t, big.NewInt(100), volume.Transferred)
+	})
+
+	t.Run("should track the collection of a non fungible token", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+		err := hp.ProcessEvents([]*transaction.Event{
+			createMintEvent(holderA, 1, 1),
+			createMintEvent(holderA, 2, 1),
+			{
+				Address:    holderA,
+				Identifier: []byte(core.BuiltInFunctionMultiESDTNFTTransfer),
+				Topics: [][]byte{
+					testToken, big.NewInt(1).Bytes(), big.NewInt(1).Bytes(),
+					testToken, big.NewInt(2).Bytes(), big.NewInt(1).Bytes(),
+					holderB,
+				},
+			},
+		}, 1, false)
+		require.Nil(t, err)
+
+		holders, _ := hp.GetHolders(string(testToken))
+		assert.Equal(t, []*HolderESDT{{Address: holderB, Balance: big.NewInt(2)}}, holders.TopHolders)
+
+		holders, _ = hp.GetHolders(string(testToken) + "-01")
+		assert.Equal(t, uint64(1), holders.NumHolders)
+	})
+
+	t.Run("revert should undo the changes", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+		_ = hp.ProcessEvents([]*transaction.Event{createMintEvent(holderA, 0, 100)}, 1, false)
+
+		events := []*transaction.Event{
+			createTransferEvent(holderA, holderB, 100),
+			createMintEvent(holderC, 0, 10),
+		}
+		_ = hp.ProcessEvents(events, 1, false)
+		holders, _ := hp.GetHolders(string(testToken))
+		assert.Equal(t, uint64(2), holders.NumHolders)
+
+		err := hp.ProcessEvents(events, 1, true)
+		require.Nil(t, err)
+
+		holders, _ = hp.GetHolders(string(testToken))
+		assert.Equal(t, uint64(1), holders.NumHolders)
+		assert.Equal(t, []*HolderESDT{{Address: holderA, Balance: big.NewInt(100)}}, holders.TopHolders)
+
+		volume, _ := hp.GetVolume(string(testToken), 1)
+		assert.Equal(t, &VolumeESDT{
+			Minted:      big.NewInt(100),
+			Burned:      big.NewInt(0),
+			Transferred: big.NewInt(0),
+		}, volume)
+	})
+
+	t.Run("should keep the order of all the holders", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+		_ = hp.ProcessEvents([]*transaction.Event{
+			createMintEvent(holderA, 0, 100),
+			createMintEvent(holderB, 0, 50),
+			createMintEvent(holderC, 0, 10),
+		}, 1, false)
+
+		// holderA drops below holderC, so holderC has to take its place in the top holders
+		err := hp.ProcessEvents([]*transaction.Event{createTransferEvent(holderA, otherShardAcc, 95)}, 1, false)
+		require.Nil(t, err)
+
+		holders, _ := hp.GetHolders(string(testToken))
+		assert.Equal(t, uint64(3), holders.NumHolders)
+		assert.Equal(t, []*HolderESDT{
+			{Address: holderB, Balance: big.NewInt(50)},
+			{Address: holderC, Balance: big.NewInt(10)},
+		}, holders.TopHolders)
+	})
+
+	t.Run("revert should restore an evicted top holder", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+		_ = hp.ProcessEvents([]*transaction.Event{
+			createMintEvent(holderA, 0, 100),
+			createMintEvent(holderB, 0, 50),
+		}, 1, false)
+
+		// holderC evicts holderB from the top holders, without any change of holderB's balance
+		events := []*transaction.Event{createMintEvent(holderC, 0, 70)}
+		_ = hp.ProcessEvents(events, 1, false)
+		holders, _ := hp.GetHolders(string(testToken))
+		assert.Equal(t, []*HolderESDT{
+			{Address: holderA, Balance: big.NewInt(100)},
+			{Address: holderC, Balance: big.NewInt(70)},
+		}, holders.TopHolders)
+
+		err := hp.ProcessEvents(events, 1, true)
+		require.Nil(t, err)
+
+		holders, _ = hp.GetHolders(string(testToken))
+		assert.Equal(t, uint64(2), holders.NumHolders)
+		assert.Equal(t, []*HolderESDT{
+			{Address: holderA, Balance: big.NewInt(100)},
+			{Address: holderB, Balance: big.NewInt(50)},
+		}, holders.TopHolders)
+	})
+	t.Run("should keep a bounded number of top holders", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+		_ = hp.ProcessEvents([]*transaction.Event{
+			createMintEvent(holderA, 0, 50),
+			createMintEvent(holderB, 0, 40),
+			createMintEvent(holderC, 0, 30),
+			createMintEvent(holderD, 0, 20),
+			createMintEvent(holderE, 0, 10),
+		}, 1, false)
+
+		topHolders, err := hp.getTopHolders(string(testToken))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(5), topHolders.NumHolders)
+		assert.Equal(t, 4, len(topHolders.Holders))
+
+		// holderA drops below the untracked holderE, so it can no longer be placed among the tracked holders
+		err = hp.ProcessEvents([]*transaction.Event{createTransferEvent(holderA, otherShardAcc, 45)}, 1, false)
+		require.Nil(t, err)
+
+		topHolders, _ = hp.getTopHolders(string(testToken))
+		assert.Equal(t, uint64(5), topHolders.NumHolders)
+		assert.Equal(t, []*HolderESDT{
+			{Address: holderB, Balance: big.NewInt(40)},
+			{Address: holderC, Balance: big.NewInt(30)},
+			{Address: holderD, Balance: big.NewInt(20)},
+		}, topHolders.Holders)
+
+		holders, _ := hp.GetHolders(string(testToken))
+		assert.Equal(t, uint64(5), holders.NumHolders)
+		assert.Equal(t, []*HolderESDT{
+			{Address: holderB, Balance: big.NewInt(40)},
+			{Address: holderC, Balance: big.NewInt(30)},
+		}, holders.TopHolders)
+	})
+}
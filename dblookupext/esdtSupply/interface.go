@@ -0,0 +1,11 @@
+package esdtSupply
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// HoldersHandler defines the actions of a component tracking the token holders and the per-epoch token volumes
+type HoldersHandler interface {
+	ProcessEvents(events []*transaction.Event, epoch uint32, isRevert bool) error
+	GetHolders(token string) (*HoldersESDT, error)
+	GetVolume(token string, epoch uint32) (*VolumeESDT, error)
+	IsInterfaceNil() bool
+}
@@ -19,17 +19,20 @@ type logsProcessor struct {
 	marshalizer        marshal.Marshalizer
 	suppliesStorer     storage.Storer
 	nonceProc          *nonceProcessor
+	holdersProc        HoldersHandler
 	fungibleOperations map[string]struct{}
 }
 
 func newLogsProcessor(
 	marshalizer marshal.Marshalizer,
 	suppliesStorer storage.Storer,
+	holdersProc HoldersHandler,
 ) *logsProcessor {
 	nonceProc := newNonceProcessor(marshalizer, suppliesStorer)
 
 	return &logsProcessor{
 		nonceProc:      nonceProc,
+		holdersProc:    holdersProc,
 		marshalizer:    marshalizer,
 		suppliesStorer: suppliesStorer,
 		fungibleOperations: map[string]struct{}{
@@ -43,7 +46,7 @@ func newLogsProcessor(
 	}
 }
 
-func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.LogData, isRevert bool) error {
+func (lp *logsProcessor) processLogs(blockNonce uint64, epoch uint32, logs map[string]*data.LogData, isRevert bool) error {
 	shouldProcess, err := lp.nonceProc.shouldProcessLog(blockNonce, isRevert)
 	if err != nil {
 		return err
@@ -53,15 +56,18 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 	}
 
 	supplies := make(map[string]*SupplyESDT)
+	events := make([]*transaction.Event, 0)
 	for _, logHandler := range logs {
 		if logHandler == nil || check.IfNil(logHandler.LogHandler) {
 			continue
 		}
 
-		errProc := lp.processLog(logHandler.LogHandler, supplies, isRevert)
+		logEvents, errProc := lp.processLog(logHandler.LogHandler, supplies, isRevert)
 		if errProc != nil {
 			return errProc
 		}
+
+		events = append(events, logEvents...)
 	}
 
 	err = lp.saveSupplies(supplies)
@@ -69,10 +75,17 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 		return err
 	}
 
+	err = lp.holdersProc.ProcessEvents(events, epoch, isRevert)
+	if err != nil {
+		return err
+	}
+
 	return lp.nonceProc.saveNonceInStorage(blockNonce)
 }
 
-func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
+// processLog updates the supplies based on the log events and returns the events, needed for the holders tracking
+func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) ([]*transaction.Event, error) {
+	events := make([]*transaction.Event, 0, len(txLog.GetLogEvents()))
 	for _, entryHandler := range txLog.GetLogEvents() {
 		if check.IfNil(entryHandler) {
 			continue
@@ -83,17 +96,18 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 			continue
 		}
 
+		events = append(events, event)
 		if lp.shouldIgnoreEvent(event) {
 			continue
 		}
 
 		err := lp.processEvent(event, supplies, isRevert)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return events, nil
 }
 
 func (lp *logsProcessor) saveSupplies(supplies map[string]*SupplyESDT) error {
@@ -94,9 +94,9 @@ func TestProcessLogsSaveSupplyNothingInStorage(t *testing.T) {
 		},
 	}
 
-	logsProc := newLogsProcessor(marshalizer, storer)
+	logsProc := newLogsProcessor(marshalizer, storer, NewDisabledHoldersProcessor())
 
-	err := logsProc.processLogs(1, logs, false)
+	err := logsProc.processLogs(1, 0, logs, false)
 	require.Nil(t, err)
 	require.Equal(t, 3, putCalledNum)
 }
@@ -152,9 +152,9 @@ func TestTestProcessLogsSaveSupplyExistsInStorage(t *testing.T) {
 		},
 	}
 
-	logsProc := newLogsProcessor(marshalizer, storer)
+	logsProc := newLogsProcessor(marshalizer, storer, NewDisabledHoldersProcessor())
 
-	err := logsProc.processLogs(1, logs, false)
+	err := logsProc.processLogs(1, 0, logs, false)
 	require.Nil(t, err)
 }
 
@@ -0,0 +1,12 @@
+package eventsIndex
+
+import "errors"
+
+// ErrMissingFilter signals that no address, identifier or topic filter was provided
+var ErrMissingFilter = errors.New("at least one of the address, identifier or topic filters should be provided")
+
+// ErrInvalidNonceRange signals that an invalid block nonce range was provided
+var ErrInvalidNonceRange = errors.New("invalid block nonce range")
+
+// ErrInvalidPageSize signals that an invalid page size was provided
+var ErrInvalidPageSize = errors.New("invalid page size")
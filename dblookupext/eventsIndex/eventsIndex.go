@@ -0,0 +1,282 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. eventEntries.proto
+
+package eventsIndex
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/recordedBlocks"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/eventsIndex")
+
+const (
+	filterKeyPrefix = "f"
+	eventKeyPrefix  = "e"
+
+	addressFilter    = byte(1)
+	identifierFilter = byte(2)
+	topicFilter      = byte(4)
+	allFilters       = addressFilter | identifierFilter | topicFilter
+
+	// MaxPageSize is the maximum number of events that can be fetched at once
+	MaxPageSize = 1000
+)
+
+type eventsIndex struct {
+	storer *recordedBlocks.Storer
+	mutex  sync.RWMutex
+}
+
+// NewEventsIndex creates a new index of the log events, queryable by any combination of emitter address,
+// event identifier and first topic
+func NewEventsIndex(storer storage.Storer, marshaller marshal.Marshalizer) (*eventsIndex, error) {
+	recordedBlocksStorer, err := recordedBlocks.NewStorer(storer, marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventsIndex{
+		storer: recordedBlocksStorer,
+	}, nil
+}
+
+// RecordBlock indexes the events of the provided logs
+func (ei *eventsIndex) RecordBlock(blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	shouldRecord, err := ei.storer.ShouldRecordBlock(blockHeader.GetNonce())
+	if err != nil || !shouldRecord {
+		return err
+	}
+
+	sortedLogs := make([]*data.LogData, 0, len(logs))
+	for _, logData := range logs {
+		if logData != nil && !check.IfNil(logData.LogHandler) {
+			sortedLogs = append(sortedLogs, logData)
+		}
+	}
+	sort.SliceStable(sortedLogs, func(i, j int) bool {
+		return sortedLogs[i].TxHash < sortedLogs[j].TxHash
+	})
+
+	record := &recordedBlocks.BlockRecord{}
+	entries := make(map[string][]interface{})
+	for _, logData := range sortedLogs {
+		for index, eventHandler := range logData.LogHandler.GetLogEvents() {
+			event, ok := eventHandler.(*transaction.Event)
+			if !ok {
+				continue
+			}
+
+			eventKey := createEventKey([]byte(logData.TxHash), uint32(index))
+			err = ei.storer.PutInBlock(record, eventKey, &StoredEvent{
+				TxHash:     []byte(logData.TxHash),
+				Address:    event.Address,
+				Identifier: event.Identifier,
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, filterKey := range createFilterKeysForEvent(event) {
+				entries[string(filterKey)] = append(entries[string(filterKey)], &FilterEntry{
+					Nonce:    blockHeader.GetNonce(),
+					EventKey: eventKey,
+				})
+			}
+		}
+	}
+
+	for _, filterKey := range recordedBlocks.SortedKeys(entries) {
+		err = ei.storer.AppendToList(record, []byte(filterKey), entries[filterKey]...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ei.storer.SaveBlockRecord(blockHeader.GetNonce(), record)
+}
+
+// RevertBlock removes the events indexed for the provided block
+func (ei *eventsIndex) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	return ei.storer.RevertBlock(blockHeader.GetNonce())
+}
+
+// GetEvents returns the events matching the provided query, in the order they were recorded
+func (ei *eventsIndex) GetEvents(query common.EventsQuery) ([]*common.IndexedEvent, error) {
+	err := checkQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	ei.mutex.RLock()
+	defer ei.mutex.RUnlock()
+
+	filterKey := createFilterKey(query.Address, query.Identifier, query.Topic)
+	numEntries, err := ei.storer.GetListLength(filterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	firstIndex, err := ei.searchFirstEntry(filterKey, numEntries, query.FromNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*common.IndexedEvent, 0)
+	for i := firstIndex + query.From; i < numEntries && uint64(len(result)) < query.Size; i++ {
+		entry := &FilterEntry{}
+		_, err = ei.storer.GetListValue(filterKey, i, entry)
+		if err != nil {
+			return nil, err
+		}
+		if entry.Nonce > query.ToNonce {
+			break
+		}
+
+		event := &StoredEvent{}
+		found, errGet := ei.storer.Get(entry.EventKey, event)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if !found {
+			log.Debug("eventsIndex.GetEvents: missing event", "key", entry.EventKey)
+			continue
+		}
+
+		result = append(result, &common.IndexedEvent{
+			TxHash:     event.TxHash,
+			BlockNonce: entry.Nonce,
+			Address:    event.Address,
+			Identifier: event.Identifier,
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return result, nil
+}
+
+// searchFirstEntry returns the index of the first entry recorded at a nonce higher or equal to the provided one
+func (ei *eventsIndex) searchFirstEntry(filterKey []byte, numEntries uint64, fromNonce uint64) (uint64, error) {
+	var searchErr error
+	index := sort.Search(int(numEntries), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+
+		entry := &FilterEntry{}
+		_, searchErr = ei.storer.GetListValue(filterKey, uint64(i), entry)
+
+		return entry.Nonce >= fromNonce
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+
+	return uint64(index), nil
+}
+
+func checkQuery(query common.EventsQuery) error {
+	if len(query.Address) == 0 && len(query.Identifier) == 0 && len(query.Topic) == 0 {
+		return ErrMissingFilter
+	}
+	if query.FromNonce > query.ToNonce {
+		return fmt.Errorf("%w: from %d, to %d", ErrInvalidNonceRange, query.FromNonce, query.ToNonce)
+	}
+	if query.Size == 0 || query.Size > MaxPageSize {
+		return fmt.Errorf("%w: %d, should be between 1 and %d", ErrInvalidPageSize, query.Size, MaxPageSize)
+	}
+
+	return nil
+}
+
+// createFilterKeysForEvent returns the keys of all the filters matching the provided event
+func createFilterKeysForEvent(event *transaction.Event) [][]byte {
+	var firstTopic []byte
+	if len(event.Topics) > 0 {
+		firstTopic = event.Topics[0]
+	}
+
+	keys := make([][]byte, 0, allFilters)
+	for mask := byte(1); mask <= allFilters; mask++ {
+		address := selectField(mask, addressFilter, event.Address)
+		identifier := selectField(mask, identifierFilter, event.Identifier)
+		topic := selectField(mask, topicFilter, firstTopic)
+		if (mask&addressFilter != 0 && len(address) == 0) ||
+			(mask&identifierFilter != 0 && len(identifier) == 0) ||
+			(mask&topicFilter != 0 && len(topic) == 0) {
+			continue
+		}
+
+		keys = append(keys, createFilterKey(address, identifier, topic))
+	}
+
+	return keys
+}
+
+func selectField(mask byte, filter byte, value []byte) []byte {
+	if mask&filter == 0 {
+		return nil
+	}
+
+	return value
+}
+
+// createFilterKey builds the key as prefix | mask | length prefixed fields, so that different combinations can not collide
+func createFilterKey(address []byte, identifier []byte, topic []byte) []byte {
+	mask := byte(0)
+	fields := [][]byte{address, identifier, topic}
+	filters := []byte{addressFilter, identifierFilter, topicFilter}
+
+	key := []byte(filterKeyPrefix)
+	key = append(key, 0)
+	for i, field := range fields {
+		if len(field) == 0 {
+			continue
+		}
+
+		mask |= filters[i]
+		key = binary.AppendUvarint(key, uint64(len(field)))
+		key = append(key, field...)
+	}
+	key[len(filterKeyPrefix)] = mask
+
+	return key
+}
+
+func createEventKey(txHash []byte, index uint32) []byte {
+	key := append([]byte(eventKeyPrefix), txHash...)
+
+	return binary.BigEndian.AppendUint32(key, index)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *eventsIndex) IsInterfaceNil() bool {
+	return ei == nil
+}
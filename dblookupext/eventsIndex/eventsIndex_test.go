@@ -0,0 +1,241 @@
+package eventsIndex_test
+
+import (
+	"errors"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"math"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contractA = []byte("contractA")
+	contractB = []byte("contractB")
+)
+
+func createLog(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash:     txHash,
+		LogHandler: &transaction.Log{Events: events},
+	}
+}
+
+func createEvent(address []byte, identifier string, topics ...string) *transaction.Event {
+	eventTopics := make([][]byte, 0, len(topics))
+	for _, topic := range topics {
+		eventTopics = append(eventTopics, []byte(topic))
+	}
+
+	return &transaction.Event{
+		Address:    address,
+		Identifier: []byte(identifier),
+		Topics:     eventTopics,
+	}
+}
+
+func getTxHashes(t *testing.T, index dblookupext.EventsIndexHandler, query common.EventsQuery) []string {
+	result, err := index.GetEvents(query)
+	require.Nil(t, err)
+
+	hashes := make([]string, 0, len(result))
+	for _, event := range result {
+		hashes = append(hashes, string(event.TxHash))
+	}
+
+	return hashes
+}
+
+func createPopulatedIndex(t *testing.T) (dblookupext.EventsIndexHandler, []*block.Header) {
+	index, err := eventsIndex.NewEventsIndex(genericMocks.NewStorerMock(), &marshal.GogoProtoMarshalizer{})
+	require.Nil(t, err)
+
+	headers := []*block.Header{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}}
+	err = index.RecordBlock(headers[0], []*data.LogData{
+		createLog("tx1", createEvent(contractA, "swap", "pairA")),
+		createLog("tx2", createEvent(contractB, "swap", "pairB"), createEvent(contractB, "transfer")),
+	})
+	require.Nil(t, err)
+	err = index.RecordBlock(headers[1], []*data.LogData{
+		createLog("tx3", createEvent(contractA, "deposit", "pairA")),
+	})
+	require.Nil(t, err)
+	err = index.RecordBlock(headers[2], []*data.LogData{
+		createLog("tx4", createEvent(contractA, "swap", "pairA")),
+	})
+	require.Nil(t, err)
+
+	return index, headers
+}
+
+func TestNewEventsIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		index, err := eventsIndex.NewEventsIndex(nil, &marshal.GogoProtoMarshalizer{})
+		assert.Equal(t, core.ErrNilStore, err)
+		assert.True(t, check.IfNil(index))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		index, err := eventsIndex.NewEventsIndex(genericMocks.NewStorerMock(), nil)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(index))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		index, err := eventsIndex.NewEventsIndex(genericMocks.NewStorerMock(), &marshal.GogoProtoMarshalizer{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(index))
+	})
+}
+
+func TestEventsIndex_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := createPopulatedIndex(t)
+
+		_, err := index.GetEvents(common.EventsQuery{ToNonce: math.MaxUint64, Size: 10})
+		assert.Equal(t, eventsIndex.ErrMissingFilter, err)
+
+		_, err = index.GetEvents(common.EventsQuery{Address: contractA, FromNonce: 3, ToNonce: 2, Size: 10})
+		assert.True(t, errors.Is(err, eventsIndex.ErrInvalidNonceRange))
+
+		_, err = index.GetEvents(common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64})
+		assert.True(t, errors.Is(err, eventsIndex.ErrInvalidPageSize))
+
+		_, err = index.GetEvents(common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64, Size: eventsIndex.MaxPageSize + 1})
+		assert.True(t, errors.Is(err, eventsIndex.ErrInvalidPageSize))
+	})
+	t.Run("should filter by any combination", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := createPopulatedIndex(t)
+
+		query := common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx3", "tx4"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Identifier: []byte("swap"), ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx2", "tx4"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Topic: []byte("pairA"), ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx3", "tx4"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Address: contractA, Identifier: []byte("swap"), ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx4"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Address: contractB, Identifier: []byte("swap"), Topic: []byte("pairB"), ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx2"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Identifier: []byte("unknown"), ToNonce: math.MaxUint64, Size: 10}
+		assert.Empty(t, getTxHashes(t, index, query))
+	})
+	t.Run("should return the event fields", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := createPopulatedIndex(t)
+
+		result, err := index.GetEvents(common.EventsQuery{Identifier: []byte("transfer"), ToNonce: math.MaxUint64, Size: 10})
+		require.Nil(t, err)
+		require.Equal(t, []*common.IndexedEvent{
+			{
+				TxHash:     []byte("tx2"),
+				BlockNonce: 1,
+				Address:    contractB,
+				Identifier: []byte("transfer"),
+			},
+		}, result)
+	})
+	t.Run("should apply the nonce range and pagination", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := createPopulatedIndex(t)
+
+		query := common.EventsQuery{Address: contractA, FromNonce: 2, ToNonce: 2, Size: 10}
+		assert.Equal(t, []string{"tx3"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Address: contractA, FromNonce: 2, ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx3", "tx4"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64, From: 1, Size: 1}
+		assert.Equal(t, []string{"tx3"}, getTxHashes(t, index, query))
+
+		query = common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64, From: 5, Size: 1}
+		assert.Empty(t, getTxHashes(t, index, query))
+	})
+}
+
+func TestEventsIndex_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("already recorded block should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		index, headers := createPopulatedIndex(t)
+
+		err := index.RecordBlock(headers[1], []*data.LogData{
+			createLog("tx5", createEvent(contractA, "swap", "pairA")),
+		})
+		require.Nil(t, err)
+
+		query := common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx3", "tx4"}, getTxHashes(t, index, query))
+	})
+	t.Run("nil header and nil logs should not error", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := eventsIndex.NewEventsIndex(genericMocks.NewStorerMock(), &marshal.GogoProtoMarshalizer{})
+		assert.Nil(t, index.RecordBlock(nil, nil))
+		assert.Nil(t, index.RecordBlock(&block.Header{Nonce: 1}, []*data.LogData{nil, {TxHash: "tx"}}))
+	})
+}
+
+func TestEventsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should revert the last recorded block", func(t *testing.T) {
+		t.Parallel()
+
+		index, headers := createPopulatedIndex(t)
+
+		err := index.RevertBlock(headers[2])
+		require.Nil(t, err)
+
+		query := common.EventsQuery{Address: contractA, ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx3"}, getTxHashes(t, index, query))
+
+		err = index.RecordBlock(&block.Header{Nonce: 3}, []*data.LogData{
+			createLog("tx5", createEvent(contractA, "swap", "pairA")),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"tx1", "tx3", "tx5"}, getTxHashes(t, index, query))
+	})
+	t.Run("should ignore a block which is not the last recorded one", func(t *testing.T) {
+		t.Parallel()
+
+		index, headers := createPopulatedIndex(t)
+
+		err := index.RevertBlock(headers[0])
+		require.Nil(t, err)
+
+		query := common.EventsQuery{Identifier: []byte("swap"), ToNonce: math.MaxUint64, Size: 10}
+		assert.Equal(t, []string{"tx1", "tx2", "tx4"}, getTxHashes(t, index, query))
+	})
+}
@@ -6,12 +6,23 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+	"github.com/multiversx/mx-chain-go/dblookupext/balanceHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+	nodeDisabled "github.com/multiversx/mx-chain-go/node/disabled"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	stateFactory "github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/storage"
+	vmcommonBuiltInFunctions "github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -23,6 +34,11 @@ type ArgsHistoryRepositoryFactory struct {
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
+	Accounts                 state.AccountsAdapter
+	AccountsRepository       state.AccountsRepository
+	AddressConverter         core.PubkeyConverter
+	EnableEpochsHandler      common.EnableEpochsHandler
 }
 
 type historyRepositoryFactory struct {
@@ -32,6 +48,11 @@ type historyRepositoryFactory struct {
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	uInt64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
+	accounts                 state.AccountsAdapter
+	accountsRepository       state.AccountsRepository
+	addressConverter         core.PubkeyConverter
+	enableEpochsHandler      common.EnableEpochsHandler
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -48,6 +69,21 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return nil, balanceHistory.ErrNilAccountsRepository
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, process.ErrNilEnableEpochsHandler
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -56,6 +92,11 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
 		uInt64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		shardCoordinator:         args.ShardCoordinator,
+		accounts:                 args.Accounts,
+		accountsRepository:       args.AccountsRepository,
+		addressConverter:         args.AddressConverter,
+		enableEpochsHandler:      args.EnableEpochsHandler,
 	}, nil
 }
 
@@ -75,10 +116,16 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	holdersHandler, err := hpf.createESDTHoldersHandler(esdtSuppliesStorer)
+	if err != nil {
+		return nil, err
+	}
+
 	esdtSuppliesHandler, err := esdtSupply.NewSuppliesProcessor(
 		hpf.marshalizer,
 		esdtSuppliesStorer,
 		txLogsStorer,
+		holdersHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -109,6 +156,21 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	addressTransactionsHandler, err := hpf.createAddressTransactionsHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	eventsIndexHandler, err := hpf.createEventsIndexHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	balanceHistoryHandler, err := hpf.createBalanceHistoryHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -120,10 +182,106 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AddressTransactionsHandler:  addressTransactionsHandler,
+		EventsIndexHandler:          eventsIndexHandler,
+		BalanceHistoryHandler:       balanceHistoryHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createESDTHoldersHandler(esdtSuppliesStorer storage.Storer) (esdtSupply.HoldersHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.ESDTHoldersTrackingEnabled {
+		return esdtSupply.NewDisabledHoldersProcessor(), nil
+	}
+
+	return esdtSupply.NewHoldersProcessor(esdtSupply.ArgsHoldersProcessor{
+		Marshalizer:      hpf.marshalizer,
+		Storer:           esdtSuppliesStorer,
+		ShardCoordinator: hpf.shardCoordinator,
+		TopHoldersCount:  hpf.dbLookupExtensionsConfig.ESDTTopHoldersCount,
+	})
+}
+
+func (hpf *historyRepositoryFactory) createAddressTransactionsHandler() (dblookupext.AddressTransactionsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.AddressTransactionsIndexEnabled {
+		return disabled.NewAddressTransactions(), nil
+	}
+
+	addressTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return addressTransactions.NewAddressTransactionsIndex(addressTransactions.ArgsAddressTransactionsIndex{
+		Marshalizer:      hpf.marshalizer,
+		Storer:           addressTransactionsStorer,
+		ShardCoordinator: hpf.shardCoordinator,
+	})
+}
+
+func (hpf *historyRepositoryFactory) createEventsIndexHandler() (dblookupext.EventsIndexHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.EventsIndexEnabled {
+		return disabled.NewEventsIndex(), nil
+	}
+
+	eventsIndexStorer, err := hpf.store.GetStorer(dataRetriever.EventsIndexUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsIndex.NewEventsIndex(eventsIndexStorer, hpf.marshalizer)
+}
+
+func (hpf *historyRepositoryFactory) createBalanceHistoryHandler() (dblookupext.BalanceHistoryHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.BalanceHistoryIndexEnabled {
+		return disabled.NewBalanceHistory(), nil
+	}
+
+	balanceHistoryStorer, err := hpf.store.GetStorer(dataRetriever.BalanceHistoryUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtDataStorage, err := vmcommonBuiltInFunctions.NewESDTDataStorage(vmcommonBuiltInFunctions.ArgsNewESDTDataStorage{
+		Accounts:              hpf.accounts,
+		GlobalSettingsHandler: nodeDisabled.NewDisabledGlobalSettingHandler(),
+		Marshalizer:           hpf.marshalizer,
+		ShardCoordinator:      hpf.shardCoordinator,
+		EnableEpochsHandler:   hpf.enableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	alteredAccountsProvider, err := alteredaccounts.NewAlteredAccountsProvider(alteredaccounts.ArgsAlteredAccountsProvider{
+		ShardCoordinator:       hpf.shardCoordinator,
+		AddressConverter:       hpf.addressConverter,
+		AccountsDB:             hpf.accounts,
+		EsdtDataStorageHandler: esdtDataStorage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := stateFactory.NewAccountCreator(stateFactory.ArgsAccountCreator{
+		Hasher:              hpf.hasher,
+		Marshaller:          hpf.marshalizer,
+		EnableEpochsHandler: hpf.enableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return balanceHistory.NewBalanceHistoryIndex(balanceHistory.ArgsBalanceHistoryIndex{
+		Marshalizer:             hpf.marshalizer,
+		Storer:                  balanceHistoryStorer,
+		AddressConverter:        hpf.addressConverter,
+		AlteredAccountsProvider: alteredAccountsProvider,
+		AccountsRepository:      hpf.accountsRepository,
+		AccountFactory:          accountFactory,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
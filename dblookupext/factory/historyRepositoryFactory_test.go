@@ -10,11 +10,16 @@ import (
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext/balanceHistory"
+	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/dblookupext/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	processMock "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +51,36 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, process.ErrNilUint64Converter, err)
 	require.Nil(t, hrf)
 
+	argsNilShardCoordinator := getArgs()
+	argsNilShardCoordinator.ShardCoordinator = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilShardCoordinator)
+	require.Equal(t, process.ErrNilShardCoordinator, err)
+	require.Nil(t, hrf)
+
+	argsNilAccounts := getArgs()
+	argsNilAccounts.Accounts = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilAccounts)
+	require.Equal(t, process.ErrNilAccountsAdapter, err)
+	require.Nil(t, hrf)
+
+	argsNilAccountsRepository := getArgs()
+	argsNilAccountsRepository.AccountsRepository = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilAccountsRepository)
+	require.Equal(t, balanceHistory.ErrNilAccountsRepository, err)
+	require.Nil(t, hrf)
+
+	argsNilAddressConverter := getArgs()
+	argsNilAddressConverter.AddressConverter = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilAddressConverter)
+	require.Equal(t, process.ErrNilPubkeyConverter, err)
+	require.Nil(t, hrf)
+
+	argsNilEnableEpochsHandler := getArgs()
+	argsNilEnableEpochsHandler.EnableEpochsHandler = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilEnableEpochsHandler)
+	require.Equal(t, process.ErrNilEnableEpochsHandler, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -77,6 +112,40 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithBalanceHistoryIndexShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.BalanceHistoryIndexEnabled = true
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+}
+
+func TestHistoryRepositoryFactory_CreateWithInvalidTopHoldersCountShouldErr(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.ESDTHoldersTrackingEnabled = true
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.Equal(t, esdtSupply.ErrInvalidTopHoldersCount, err)
+	require.True(t, check.IfNil(repository))
+}
+
 func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +156,9 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 	t.Run("missing EpochByHashUnit", testWithMissingStorer(dataRetriever.EpochByHashUnit))
 	t.Run("missing MiniblockHashByTxHashUnit", testWithMissingStorer(dataRetriever.MiniblockHashByTxHashUnit))
 	t.Run("missing ResultsHashesByTxHashUnit", testWithMissingStorer(dataRetriever.ResultsHashesByTxHashUnit))
+	t.Run("missing AddressTransactionsUnit", testWithMissingStorer(dataRetriever.AddressTransactionsUnit))
+	t.Run("missing EventsIndexUnit", testWithMissingStorer(dataRetriever.EventsIndexUnit))
+	t.Run("missing BalanceHistoryUnit", testWithMissingStorer(dataRetriever.BalanceHistoryUnit))
 }
 
 func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
@@ -95,6 +167,11 @@ func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T
 
 		args := getArgs()
 		args.Config.Enabled = true
+		args.Config.AddressTransactionsIndexEnabled = true
+		args.Config.EventsIndexEnabled = true
+		args.Config.BalanceHistoryIndexEnabled = true
+		args.Config.ESDTHoldersTrackingEnabled = true
+		args.Config.ESDTTopHoldersCount = 10
 		args.Store = &storageStubs.ChainStorerStub{
 			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
 				if unitType == missingUnit {
@@ -120,5 +197,10 @@ func getArgs() *factory.ArgsHistoryRepositoryFactory {
 		Marshalizer:              &mock.MarshalizerMock{},
 		Hasher:                   &hashingMocks.HasherMock{},
 		Uint64ByteSliceConverter: &processMock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         testscommon.NewMultiShardsCoordinatorMock(1),
+		Accounts:                 &stateMock.AccountsStub{},
+		AccountsRepository:       &stateMock.AccountsRepositoryStub{},
+		AddressConverter:         testscommon.NewPubkeyConverterMock(32),
+		EnableEpochsHandler:      &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/logging"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
@@ -38,6 +39,9 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AddressTransactionsHandler  AddressTransactionsHandler
+	EventsIndexHandler          EventsIndexHandler
+	BalanceHistoryHandler       BalanceHistoryHandler
 }
 
 type historyRepository struct {
@@ -51,6 +55,9 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	addressTransactionsHandler AddressTransactionsHandler
+	eventsIndexHandler         EventsIndexHandler
+	balanceHistoryHandler      BalanceHistoryHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -94,6 +101,15 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.AddressTransactionsHandler) {
+		return nil, errNilAddressTransactionsHandler
+	}
+	if check.IfNil(arguments.EventsIndexHandler) {
+		return nil, errNilEventsIndexHandler
+	}
+	if check.IfNil(arguments.BalanceHistoryHandler) {
+		return nil, errNilBalanceHistoryHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -118,6 +134,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		addressTransactionsHandler:                   arguments.AddressTransactionsHandler,
+		eventsIndexHandler:                           arguments.EventsIndexHandler,
+		balanceHistoryHandler:                        arguments.BalanceHistoryHandler,
 	}, nil
 }
 
@@ -126,7 +145,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
+	rewardsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	createdIntraShardMiniBlocks []*block.MiniBlock,
 	logs []*data.LogData) error {
@@ -173,7 +194,7 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
-	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
+	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), blockHeader.GetEpoch(), logs)
 	if err != nil {
 		return err
 	}
@@ -183,6 +204,24 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.addressTransactionsHandler.RecordBlock(blockHeader, txsFromPool, scrResultsFromPool, logs)
+	if err != nil {
+		logging.LogErrAsErrorExceptAsDebugIfClosingError(log, err, "cannot record the address transactions",
+			"nonce", blockHeader.GetNonce(), "error", err)
+	}
+
+	err = hr.eventsIndexHandler.RecordBlock(blockHeader, logs)
+	if err != nil {
+		logging.LogErrAsErrorExceptAsDebugIfClosingError(log, err, "cannot record the events",
+			"nonce", blockHeader.GetNonce(), "error", err)
+	}
+
+	err = hr.balanceHistoryHandler.RecordBlock(blockHeader, txsFromPool, scrResultsFromPool, rewardsFromPool, logs)
+	if err != nil {
+		logging.LogErrAsErrorExceptAsDebugIfClosingError(log, err, "cannot record the balance history",
+			"nonce", blockHeader.GetNonce(), "error", err)
+	}
+
 	return nil
 }
 
@@ -480,7 +519,30 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	err = hr.addressTransactionsHandler.RevertBlock(blockHeader)
+	if err != nil {
+		logging.LogErrAsErrorExceptAsDebugIfClosingError(log, err, "cannot revert the address transactions",
+			"nonce", blockHeader.GetNonce(), "error", err)
+	}
+
+	err = hr.eventsIndexHandler.RevertBlock(blockHeader)
+	if err != nil {
+		logging.LogErrAsErrorExceptAsDebugIfClosingError(log, err, "cannot revert the events",
+			"nonce", blockHeader.GetNonce(), "error", err)
+	}
+
+	err = hr.balanceHistoryHandler.RevertBlock(blockHeader)
+	if err != nil {
+		logging.LogErrAsErrorExceptAsDebugIfClosingError(log, err, "cannot revert the balance history",
+			"nonce", blockHeader.GetNonce(), "error", err)
+	}
+
+	return nil
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +550,31 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetESDTHolders will return the number of holders and the top holders of the given token
+func (hr *historyRepository) GetESDTHolders(token string) (*esdtSupply.HoldersESDT, error) {
+	return hr.esdtSuppliesHandler.GetESDTHolders(token)
+}
+
+// GetESDTVolume will return the minted, burned and transferred amounts of the given token during the given epoch
+func (hr *historyRepository) GetESDTVolume(token string, epoch uint32) (*esdtSupply.VolumeESDT, error) {
+	return hr.esdtSuppliesHandler.GetESDTVolume(token, epoch)
+}
+
+// GetAddressTransactions will return the indexed transactions of the provided address
+func (hr *historyRepository) GetAddressTransactions(address []byte, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	return hr.addressTransactionsHandler.GetTransactions(address, options)
+}
+
+// QueryEvents will return the indexed log events matching the provided query
+func (hr *historyRepository) QueryEvents(query common.EventsQuery) ([]*common.IndexedEvent, error) {
+	return hr.eventsIndexHandler.GetEvents(query)
+}
+
+// GetBalanceHistory will return the changes of the EGLD or ESDT balance of the provided address
+func (hr *historyRepository) GetBalanceHistory(address []byte, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	return hr.balanceHistoryHandler.GetBalanceHistory(address, options)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
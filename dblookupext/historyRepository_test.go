@@ -1,20 +1,34 @@
 package dblookupext
 
 import (
+	"encoding/hex"
 	"errors"
+	"math"
+	"math/big"
 	"sync"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+	"github.com/multiversx/mx-chain-go/dblookupext/balanceHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,7 +39,37 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		GetCalled: func(key []byte) ([]byte, error) {
 			return nil, storage.ErrKeyNotFound
 		},
-	}, &storageStubs.StorerStub{})
+	}, &storageStubs.StorerStub{}, esdtSupply.NewDisabledHoldersProcessor())
+
+	addressTransactionsIndex, _ := addressTransactions.NewAddressTransactionsIndex(addressTransactions.ArgsAddressTransactionsIndex{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Storer:           genericMocks.NewStorerMockWithEpoch(epoch),
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+	})
+
+	eventsIdx, _ := eventsIndex.NewEventsIndex(genericMocks.NewStorerMockWithEpoch(epoch), &mock.MarshalizerMock{})
+
+	balanceHistoryIndex, _ := balanceHistory.NewBalanceHistoryIndex(balanceHistory.ArgsBalanceHistoryIndex{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Storer:           genericMocks.NewStorerMockWithEpoch(epoch),
+		AddressConverter: testscommon.NewPubkeyConverterMock(32),
+		AlteredAccountsProvider: &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(txPool *outport.TransactionPool, _ shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				alteredAccounts := make(map[string]*alteredAccount.AlteredAccount)
+				for _, reward := range txPool.Rewards {
+					address := hex.EncodeToString(reward.Reward.RcvAddr)
+					alteredAccounts[address] = &alteredAccount.AlteredAccount{
+						Address: address,
+						Balance: big.NewInt(int64(len(reward.Reward.RcvAddr))).String(),
+					}
+				}
+
+				return alteredAccounts, nil
+			},
+		},
+		AccountsRepository: &stateMock.AccountsRepositoryStub{},
+		AccountFactory:     &stateMock.AccountsFactoryStub{},
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -37,6 +81,9 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		AddressTransactionsHandler:  addressTransactionsIndex,
+		EventsIndexHandler:          eventsIdx,
+		BalanceHistoryHandler:       balanceHistoryIndex,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +135,24 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilAddressTransactionsHandler, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.EventsIndexHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilEventsIndexHandler, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.BalanceHistoryHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilBalanceHistoryHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -108,10 +173,37 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
+func TestHistoryRepository_RecordBlockShouldNotStopOnAddressTransactionsError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.AddressTransactionsHandler, _ = addressTransactions.NewAddressTransactionsIndex(addressTransactions.ArgsAddressTransactionsIndex{
+		Marshalizer: &mock.MarshalizerMock{},
+		Storer: &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, storage.ErrKeyNotFound
+			},
+			PutCalled: func(key, data []byte) error {
+				return errors.New("error put")
+			},
+		},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+	})
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Round: 5}, &block.Body{}, txs, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
+}
+
 func TestHistoryRepository_RecordBlock(t *testing.T) {
 	t.Parallel()
 
@@ -140,7 +232,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -176,7 +268,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +306,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +364,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +564,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +572,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +619,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +676,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +689,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +803,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil, nil,
+				}, nil, nil, nil, nil, nil, nil,
 			)
 		}
 
@@ -741,3 +833,112 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 	require.Equal(t, 4001, int(metadata.NotarizedAtDestinationInMetaNonce))
 	require.Equal(t, []byte("metablockFoo"), metadata.NotarizedAtDestinationInMetaHash)
 }
+
+func TestHistoryRepository_RecordAndRevertBlockShouldUpdateAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	repo, err := NewHistoryRepository(createMockHistoryRepoArgs(42))
+	require.Nil(t, err)
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	header := &block.Header{Epoch: 42, Nonce: 10}
+	err = repo.RecordBlock([]byte("fooBlock"), header, &block.Body{}, txs, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	options := common.AddressTransactionsQueryOptions{Size: 10}
+	result, err := repo.GetAddressTransactions([]byte("bob"), options)
+	require.Nil(t, err)
+	require.Equal(t, []*common.AddressTransaction{
+		{
+			TxHash:    hex.EncodeToString([]byte("txA")),
+			Epoch:     42,
+			Nonce:     10,
+			Type:      addressTransactions.TypeTransaction,
+			Direction: addressTransactions.DirectionIn,
+		},
+	}, result)
+
+	err = repo.RevertBlock(header, &block.Body{})
+	require.Nil(t, err)
+
+	result, err = repo.GetAddressTransactions([]byte("bob"), options)
+	require.Nil(t, err)
+	require.Empty(t, result)
+}
+
+func TestHistoryRepository_RecordAndRevertBlockShouldUpdateEventsIndex(t *testing.T) {
+	t.Parallel()
+
+	repo, err := NewHistoryRepository(createMockHistoryRepoArgs(42))
+	require.Nil(t, err)
+
+	logs := []*data.LogData{
+		{
+			TxHash: "txA",
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{Address: []byte("contract"), Identifier: []byte("swap"), Topics: [][]byte{[]byte("topic")}},
+				},
+			},
+		},
+	}
+	header := &block.Header{Epoch: 42, Nonce: 10}
+	err = repo.RecordBlock([]byte("fooBlock"), header, &block.Body{}, nil, nil, nil, nil, nil, logs)
+	require.Nil(t, err)
+
+	query := common.EventsQuery{Identifier: []byte("swap"), ToNonce: math.MaxUint64, Size: 10}
+	result, err := repo.QueryEvents(query)
+	require.Nil(t, err)
+	require.Equal(t, []*common.IndexedEvent{
+		{
+			TxHash:     []byte("txA"),
+			BlockNonce: 10,
+			Address:    []byte("contract"),
+			Identifier: []byte("swap"),
+			Topics:     [][]byte{[]byte("topic")},
+		},
+	}, result)
+
+	err = repo.RevertBlock(header, &block.Body{})
+	require.Nil(t, err)
+
+	result, err = repo.QueryEvents(query)
+	require.Nil(t, err)
+	require.Empty(t, result)
+}
+
+func TestHistoryRepository_RecordAndRevertBlockShouldUpdateBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	repo, err := NewHistoryRepository(createMockHistoryRepoArgs(42))
+	require.Nil(t, err)
+
+	rewards := map[string]data.TransactionHandler{
+		"rewardA": &rewardTx.RewardTx{RcvAddr: []byte("bob"), Value: big.NewInt(3)},
+	}
+	header := &block.Header{Epoch: 42, Nonce: 10}
+	err = repo.RecordBlock([]byte("fooBlock"), header, &block.Body{}, nil, nil, rewards, nil, nil, nil)
+	require.Nil(t, err)
+
+	options := common.BalanceHistoryQueryOptions{ToNonce: math.MaxUint64, Step: 1}
+	result, err := repo.GetBalanceHistory([]byte("bob"), options)
+	require.Nil(t, err)
+	require.Equal(t, []*common.BalanceChange{
+		{
+			Nonce:    10,
+			Epoch:    42,
+			Balance:  "3",
+			Delta:    "3",
+			TxHashes: []string{hex.EncodeToString([]byte("rewardA"))},
+		},
+	}, result)
+
+	err = repo.RevertBlock(header, &block.Body{})
+	require.Nil(t, err)
+
+	result, err = repo.GetBalanceHistory([]byte("bob"), options)
+	require.Nil(t, err)
+	require.Empty(t, result)
+}
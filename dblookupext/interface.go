@@ -3,6 +3,7 @@ package dblookupext
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -17,7 +18,9 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
+		rewardsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 		createdIntraShardMiniBlocks []*block.MiniBlock,
 		logs []*data.LogData) error
@@ -27,6 +30,11 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTHolders(token string) (*esdtSupply.HoldersESDT, error)
+	GetESDTVolume(token string, epoch uint32) (*esdtSupply.VolumeESDT, error)
+	GetAddressTransactions(address []byte, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	QueryEvents(query common.EventsQuery) ([]*common.IndexedEvent, error)
+	GetBalanceHistory(address []byte, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -42,8 +50,40 @@ type BlockTracker interface {
 
 // SuppliesHandler defines the interface of a supplies processor
 type SuppliesHandler interface {
-	ProcessLogs(blockNonce uint64, logs []*data.LogData) error
+	ProcessLogs(blockNonce uint64, epoch uint32, logs []*data.LogData) error
 	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTHolders(token string) (*esdtSupply.HoldersESDT, error)
+	GetESDTVolume(token string, epoch uint32) (*esdtSupply.VolumeESDT, error)
+	IsInterfaceNil() bool
+}
+
+// AddressTransactionsHandler defines the interface of the address to transactions index
+type AddressTransactionsHandler interface {
+	RecordBlock(blockHeader data.HeaderHandler, txs map[string]data.TransactionHandler, scrs map[string]data.TransactionHandler, logs []*data.LogData) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetTransactions(address []byte, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	IsInterfaceNil() bool
+}
+
+// EventsIndexHandler defines the interface of the log events index
+type EventsIndexHandler interface {
+	RecordBlock(blockHeader data.HeaderHandler, logs []*data.LogData) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetEvents(query common.EventsQuery) ([]*common.IndexedEvent, error)
+	IsInterfaceNil() bool
+}
+
+// BalanceHistoryHandler defines the interface of the balance history index
+type BalanceHistoryHandler interface {
+	RecordBlock(
+		blockHeader data.HeaderHandler,
+		txs map[string]data.TransactionHandler,
+		scrs map[string]data.TransactionHandler,
+		rewards map[string]data.TransactionHandler,
+		logs []*data.LogData,
+	) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetBalanceHistory(address []byte, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
 	IsInterfaceNil() bool
 }
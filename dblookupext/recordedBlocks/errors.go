@@ -0,0 +1,6 @@
+package recordedBlocks
+
+import "errors"
+
+// ErrInvalidValue signals that a stored value could not be decoded
+var ErrInvalidValue = errors.New("invalid stored value")
@@ -0,0 +1,273 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. blockRecord.proto
+
+package recordedBlocks
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/recordedBlocks")
+
+const (
+	lastRecordedBlockKey = "last-recorded-block"
+	recordedBlockPrefix  = "recorded-block-"
+	listLengthPrefix     = "list-length@"
+	listValuePrefix      = "list-value@"
+)
+
+// Storer wraps the storer of a db lookup extension index that is fed block by block, in increasing order of the
+// nonces. An index records a block by calling ShouldRecordBlock, which rejects the blocks with a nonce lower or equal
+// to the last recorded one, then AppendToList and PutInBlock with a new BlockRecord, and finally SaveBlockRecord.
+// Everything added this way is removed by RevertBlock, which only reverts the last recorded block, so the blocks of a
+// fork have to be reverted starting with the highest nonce. The indexes built on top of it follow the same contract
+// for their RecordBlock and RevertBlock methods
+type Storer struct {
+	storer     storage.Storer
+	marshaller marshal.Marshalizer
+}
+
+// NewStorer creates a new recorded blocks storer on top of the provided storer
+func NewStorer(storer storage.Storer, marshaller marshal.Marshalizer) (*Storer, error) {
+	if check.IfNil(storer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	return &Storer{
+		storer:     storer,
+		marshaller: marshaller,
+	}, nil
+}
+
+// ShouldRecordBlock returns true if the provided nonce is higher than the nonce of the last recorded block
+func (s *Storer) ShouldRecordBlock(nonce uint64) (bool, error) {
+	lastRecordedNonce, found, err := s.getUint64([]byte(lastRecordedBlockKey))
+	if err != nil {
+		return false, err
+	}
+
+	return !found || nonce > lastRecordedNonce, nil
+}
+
+// AppendToList appends the provided values to the list stored under the provided key and adds them to the record
+func (s *Storer) AppendToList(record *BlockRecord, listKey []byte, values ...interface{}) error {
+	length, err := s.GetListLength(listKey)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		err = s.put(listValueKey(listKey, length), value)
+		if err != nil {
+			return err
+		}
+		length++
+	}
+
+	record.Lists = append(record.Lists, &ListAppend{
+		Key:       listKey,
+		NumValues: uint64(len(values)),
+	})
+
+	return s.storer.Put(listLengthKey(listKey), uint64ToBytes(length))
+}
+
+// PutInBlock saves the provided value under the provided key and adds the key to the record
+func (s *Storer) PutInBlock(record *BlockRecord, key []byte, value interface{}) error {
+	err := s.put(key, value)
+	if err != nil {
+		return err
+	}
+
+	record.Keys = append(record.Keys, key)
+
+	return nil
+}
+
+// SaveBlockRecord saves the record of the block with the provided nonce and marks the block as the last recorded one
+func (s *Storer) SaveBlockRecord(nonce uint64, record *BlockRecord) error {
+	err := s.put(recordedBlockKey(nonce), record)
+	if err != nil {
+		return err
+	}
+
+	return s.storer.Put([]byte(lastRecordedBlockKey), uint64ToBytes(nonce))
+}
+
+// RevertBlock removes what the block with the provided nonce added, if it is the last recorded one, and marks the
+// previous block as the last recorded one
+func (s *Storer) RevertBlock(nonce uint64) error {
+	lastRecordedNonce, found, err := s.getUint64([]byte(lastRecordedBlockKey))
+	if err != nil {
+		return err
+	}
+	if !found || lastRecordedNonce != nonce {
+		return nil
+	}
+
+	record := &BlockRecord{}
+	found, err = s.Get(recordedBlockKey(nonce), record)
+	if err != nil {
+		return err
+	}
+	if found {
+		err = s.removeRecord(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.storer.Remove(recordedBlockKey(nonce))
+	if err != nil {
+		return err
+	}
+
+	if nonce == 0 {
+		return s.storer.Remove([]byte(lastRecordedBlockKey))
+	}
+
+	return s.storer.Put([]byte(lastRecordedBlockKey), uint64ToBytes(nonce-1))
+}
+
+func (s *Storer) removeRecord(record *BlockRecord) error {
+	for i := len(record.Lists) - 1; i >= 0; i-- {
+		err := s.removeFromList(record.Lists[i].Key, record.Lists[i].NumValues)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, key := range record.Keys {
+		err := s.storer.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Storer) removeFromList(listKey []byte, numValues uint64) error {
+	length, err := s.GetListLength(listKey)
+	if err != nil {
+		return err
+	}
+	if numValues > length {
+		numValues = length
+	}
+
+	for i := length - numValues; i < length; i++ {
+		err = s.storer.Remove(listValueKey(listKey, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	length -= numValues
+	if length == 0 {
+		return s.storer.Remove(listLengthKey(listKey))
+	}
+
+	return s.storer.Put(listLengthKey(listKey), uint64ToBytes(length))
+}
+
+// GetListLength returns the number of values of the list stored under the provided key
+func (s *Storer) GetListLength(listKey []byte) (uint64, error) {
+	length, _, err := s.getUint64(listLengthKey(listKey))
+
+	return length, err
+}
+
+// GetListValue loads the value with the provided index of the list stored under the provided key. It returns false if
+// the value is missing
+func (s *Storer) GetListValue(listKey []byte, index uint64, obj interface{}) (bool, error) {
+	return s.Get(listValueKey(listKey, index), obj)
+}
+
+// Get loads the value stored under the provided key in the provided object. It returns false if the key is missing
+func (s *Storer) Get(key []byte, obj interface{}) (bool, error) {
+	if s.storer.Has(key) != nil {
+		return false, nil
+	}
+
+	buff, err := s.storer.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	return true, s.marshaller.Unmarshal(obj, buff)
+}
+
+func (s *Storer) getUint64(key []byte) (uint64, bool, error) {
+	if s.storer.Has(key) != nil {
+		return 0, false, nil
+	}
+
+	buff, err := s.storer.Get(key)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(buff) != 8 {
+		return 0, false, fmt.Errorf("%w under key %s, size %d", ErrInvalidValue, key, len(buff))
+	}
+
+	return binary.BigEndian.Uint64(buff), true, nil
+}
+
+func (s *Storer) put(key []byte, obj interface{}) error {
+	buff, err := s.marshaller.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	err = s.storer.Put(key, buff)
+	if err != nil {
+		log.Debug("recordedBlocks.Storer.put", "key", key, "error", err)
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *Storer) IsInterfaceNil() bool {
+	return s == nil
+}
+
+// SortedKeys returns the keys of the provided map in ascending order, so the maps are processed deterministically
+func SortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func recordedBlockKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", recordedBlockPrefix, nonce))
+}
+
+func listLengthKey(listKey []byte) []byte {
+	return append([]byte(listLengthPrefix), listKey...)
+}
+
+func listValueKey(listKey []byte, index uint64) []byte {
+	key := append([]byte(listValuePrefix), listKey...)
+
+	return binary.BigEndian.AppendUint64(key, index)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, value)
+}
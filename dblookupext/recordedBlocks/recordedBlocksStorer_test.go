@@ -0,0 +1,203 @@
+package recordedBlocks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/dblookupext/recordedBlocks"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testValue struct {
+	Keys []string `json:"keys"`
+}
+
+func TestNewStorer(t *testing.T) {
+	t.Parallel()
+
+	storer, err := recordedBlocks.NewStorer(nil, &marshallerMock.MarshalizerMock{})
+	assert.Equal(t, core.ErrNilStore, err)
+	assert.True(t, check.IfNil(storer))
+
+	storer, err = recordedBlocks.NewStorer(genericMocks.NewStorerMock(), nil)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(storer))
+
+	storer, err = recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(storer))
+}
+
+func TestStorer_PutInBlockAndGet(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+
+	value := &testValue{}
+	found, err := storer.Get([]byte("key"), value)
+	assert.Nil(t, err)
+	assert.False(t, found)
+
+	record := &recordedBlocks.BlockRecord{}
+	err = storer.PutInBlock(record, []byte("key"), &testValue{Keys: []string{"a"}})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("key")}, record.Keys)
+
+	found, err = storer.Get([]byte("key"), value)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"a"}, value.Keys)
+}
+
+func TestStorer_AppendToList(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+
+	length, err := storer.GetListLength([]byte("list"))
+	assert.Nil(t, err)
+	assert.Zero(t, length)
+
+	record := &recordedBlocks.BlockRecord{}
+	err = storer.AppendToList(record, []byte("list"), &testValue{Keys: []string{"a"}}, &testValue{Keys: []string{"b"}})
+	require.Nil(t, err)
+	err = storer.AppendToList(record, []byte("list"), &testValue{Keys: []string{"c"}})
+	require.Nil(t, err)
+	assert.Equal(t, []*recordedBlocks.ListAppend{
+		{Key: []byte("list"), NumValues: 2},
+		{Key: []byte("list"), NumValues: 1},
+	}, record.Lists)
+
+	length, _ = storer.GetListLength([]byte("list"))
+	assert.Equal(t, uint64(3), length)
+
+	value := &testValue{}
+	found, err := storer.GetListValue([]byte("list"), 1, value)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"b"}, value.Keys)
+
+	found, _ = storer.GetListValue([]byte("list"), 3, value)
+	assert.False(t, found)
+
+	length, _ = storer.GetListLength([]byte("lis"))
+	assert.Zero(t, length)
+}
+
+func TestStorer_ShouldRecordBlock(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+
+	shouldRecord, err := storer.ShouldRecordBlock(0)
+	assert.Nil(t, err)
+	assert.True(t, shouldRecord)
+
+	err = storer.SaveBlockRecord(5, &recordedBlocks.BlockRecord{})
+	require.Nil(t, err)
+
+	shouldRecord, _ = storer.ShouldRecordBlock(5)
+	assert.False(t, shouldRecord)
+	shouldRecord, _ = storer.ShouldRecordBlock(4)
+	assert.False(t, shouldRecord)
+	shouldRecord, _ = storer.ShouldRecordBlock(6)
+	assert.True(t, shouldRecord)
+}
+
+func TestStorer_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	recordBlock := func(storer *recordedBlocks.Storer, nonce uint64, key string) {
+		record := &recordedBlocks.BlockRecord{}
+		_ = storer.AppendToList(record, []byte("list"), &testValue{Keys: []string{key}})
+		_ = storer.PutInBlock(record, []byte(key), &testValue{})
+		_ = storer.SaveBlockRecord(nonce, record)
+	}
+
+	t.Run("not the last recorded block should not revert", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+		recordBlock(storer, 1, "a")
+		recordBlock(storer, 2, "b")
+
+		err := storer.RevertBlock(1)
+		assert.Nil(t, err)
+
+		length, _ := storer.GetListLength([]byte("list"))
+		assert.Equal(t, uint64(2), length)
+		shouldRecord, _ := storer.ShouldRecordBlock(2)
+		assert.False(t, shouldRecord)
+	})
+	t.Run("storer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		mock := genericMocks.NewStorerMock()
+		storer, _ := recordedBlocks.NewStorer(mock, &marshallerMock.MarshalizerMock{})
+		recordBlock(storer, 1, "a")
+
+		expectedErr := errors.New("expected error")
+		stub := &storageStubs.StorerStub{
+			HasCalled: mock.Has,
+			GetCalled: mock.Get,
+			RemoveCalled: func(key []byte) error {
+				return expectedErr
+			},
+		}
+		storer, _ = recordedBlocks.NewStorer(stub, &marshallerMock.MarshalizerMock{})
+
+		err := storer.RevertBlock(1)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should remove what the block added and move back the last recorded block", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+		recordBlock(storer, 1, "a")
+		recordBlock(storer, 2, "b")
+
+		err := storer.RevertBlock(2)
+		assert.Nil(t, err)
+
+		length, _ := storer.GetListLength([]byte("list"))
+		assert.Equal(t, uint64(1), length)
+		found, _ := storer.GetListValue([]byte("list"), 1, &testValue{})
+		assert.False(t, found)
+		found, _ = storer.Get([]byte("b"), &testValue{})
+		assert.False(t, found)
+		found, _ = storer.Get([]byte("a"), &testValue{})
+		assert.True(t, found)
+
+		shouldRecord, _ := storer.ShouldRecordBlock(2)
+		assert.True(t, shouldRecord)
+		shouldRecord, _ = storer.ShouldRecordBlock(1)
+		assert.False(t, shouldRecord)
+	})
+	t.Run("reverting the block with nonce 0 should clear the last recorded block", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := recordedBlocks.NewStorer(genericMocks.NewStorerMock(), &marshallerMock.MarshalizerMock{})
+		recordBlock(storer, 0, "a")
+
+		err := storer.RevertBlock(0)
+		assert.Nil(t, err)
+
+		length, _ := storer.GetListLength([]byte("list"))
+		assert.Zero(t, length)
+		shouldRecord, _ := storer.ShouldRecordBlock(0)
+		assert.True(t, shouldRecord)
+	})
+}
+
+func TestSortedKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"a", "b", "c"}, recordedBlocks.SortedKeys(map[string]int{"c": 1, "a": 2, "b": 3}))
+	assert.Empty(t, recordedBlocks.SortedKeys(map[string]int{}))
+}
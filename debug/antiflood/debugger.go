@@ -24,6 +24,7 @@ const newLineChar = "\n"
 const minIntervalInSeconds = 1
 const maxSequencesToPrint = 5
 const moreSequencesPresent = "..."
+const quotaDecisionPrefix = "quota decision: "
 
 var log = logger.GetOrCreate("debug/antiflood")
 
@@ -59,6 +60,24 @@ func (ev *event) String() string {
 		ev.pid.Pretty(), ev.topic, ev.numRejected, ev.sizeRejected, strings.Join(sequences, ", "), ev.isBlackListed)
 }
 
+type quotaDecision struct {
+	topic           string
+	oldQuotaPercent uint32
+	newQuotaPercent uint32
+	reason          string
+	numChanges      uint32
+}
+
+// Size returns the size of a quota decision instance
+func (qd *quotaDecision) Size() int {
+	return len(qd.topic) + len(qd.reason) + 3*sizeUint32
+}
+
+func (qd *quotaDecision) String() string {
+	return fmt.Sprintf("adaptive quota on topic: %s; old quota: %d%%; new quota: %d%%; num changes: %d; reason: %s",
+		qd.topic, qd.oldQuotaPercent, qd.newQuotaPercent, qd.numChanges, qd.reason)
+}
+
 type debugger struct {
 	mut               sync.RWMutex
 	cache             storage.Cacher
@@ -141,6 +160,33 @@ func (d *debugger) AddData(
 	d.cache.Put(identifier, ev, ev.Size())
 }
 
+// AddQuotaDecision records a change of the adaptive quota applied on a topic. Consecutive changes on the same topic
+// between two prints are aggregated, keeping the first old value and the last new value
+func (d *debugger) AddQuotaDecision(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string) {
+	identifier := []byte(quotaDecisionPrefix + topic)
+
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	decision := &quotaDecision{
+		topic:           topic,
+		oldQuotaPercent: oldQuotaPercent,
+	}
+	obj, ok := d.cache.Get(identifier)
+	if ok {
+		existing, isDecision := obj.(*quotaDecision)
+		if isDecision {
+			decision = existing
+		}
+	}
+
+	decision.newQuotaPercent = newQuotaPercent
+	decision.reason = reason
+	decision.numChanges++
+
+	d.cache.Put(identifier, decision, decision.Size())
+}
+
 func (d *debugger) computeIdentifier(pid core.PeerID, topic string) []byte {
 	return []byte(string(pid) + topic)
 }
@@ -179,12 +225,12 @@ func (d *debugger) getStringEvents() []string {
 			continue
 		}
 
-		ev, ok := element.(*event)
+		stringer, ok := element.(fmt.Stringer)
 		if !ok {
 			continue
 		}
 
-		strs = append(strs, ev.String())
+		strs = append(strs, stringer.String())
 	}
 
 	return strs
@@ -104,6 +104,52 @@ func TestAntifloodDebugger_AddDataExistingShouldChange(t *testing.T) {
 	assert.False(t, ev.isBlackListed)
 }
 
+//------- AddQuotaDecision
+
+func TestAntifloodDebugger_AddQuotaDecisionShouldAggregate(t *testing.T) {
+	t.Parallel()
+
+	d, _ := NewAntifloodDebugger(config.AntifloodDebugConfig{
+		CacheSize:                  100,
+		IntervalAutoPrintInSeconds: 10,
+	})
+
+	topic := "topic"
+	d.AddQuotaDecision(topic, 100, 80, "reason 1")
+	d.AddQuotaDecision(topic, 80, 60, "reason 2")
+
+	assert.Equal(t, 1, d.cache.Len())
+	decision := d.GetQuotaDecision(topic)
+	require.NotNil(t, decision)
+	assert.Equal(t, topic, decision.topic)
+	assert.Equal(t, uint32(100), decision.oldQuotaPercent)
+	assert.Equal(t, uint32(60), decision.newQuotaPercent)
+	assert.Equal(t, uint32(2), decision.numChanges)
+	assert.Equal(t, "reason 2", decision.reason)
+}
+
+func TestAntifloodDebugger_PrintQuotaDecisionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	d, _ := NewAntifloodDebugger(config.AntifloodDebugConfig{
+		CacheSize:                  100,
+		IntervalAutoPrintInSeconds: 1,
+	})
+
+	numPrinted := int32(0)
+	d.printEventFunc = func(data string) {
+		if strings.Contains(data, "adaptive quota on topic: topic") {
+			atomic.AddInt32(&numPrinted, 1)
+		}
+	}
+
+	d.AddQuotaDecision("topic", 100, 80, "reason")
+
+	time.Sleep(time.Millisecond * 1500)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numPrinted))
+}
+
 func TestAntifloodDebugger_PrintShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -8,3 +8,12 @@ func (d *debugger) GetData(key []byte) *event {
 
 	return obj.(*event)
 }
+
+func (d *debugger) GetQuotaDecision(topic string) *quotaDecision {
+	obj, ok := d.cache.Get([]byte(quotaDecisionPrefix + topic))
+	if !ok {
+		return nil
+	}
+
+	return obj.(*quotaDecision)
+}
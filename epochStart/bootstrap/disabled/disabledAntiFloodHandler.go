@@ -47,6 +47,18 @@ func (a *antiFloodHandler) IsOriginatorEligibleForTopic(_ core.PeerID, _ string)
 	return nil
 }
 
+// StartProcessingOnTopic does nothing
+func (a *antiFloodHandler) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic does nothing
+func (a *antiFloodHandler) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic does nothing
+func (a *antiFloodHandler) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
 // Close returns nil
 func (a *antiFloodHandler) Close() error {
 	return nil
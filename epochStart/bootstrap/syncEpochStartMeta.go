@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	interceptorsFactory "github.com/multiversx/mx-chain-go/process/interceptors/factory"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
 
@@ -95,6 +96,7 @@ func NewEpochStartMetaSyncer(args ArgsNewEpochStartMetaSyncer) (*epochStartMetaS
 			DataFactory:          interceptedMetaHdrDataFactory,
 			Processor:            args.MetaBlockProcessor,
 			Throttler:            disabled.NewThrottler(),
+			Scheduler:            lanes.NewGoRoutineScheduler(),
 			AntifloodHandler:     disabled.NewAntiFloodHandler(),
 			WhiteListRequest:     args.WhitelistHandler,
 			CurrentPeerId:        args.Messenger.ID(),
@@ -352,3 +352,6 @@ var ErrUint32SubtractionOverflow = errors.New("uint32 subtraction overflowed")
 
 // ErrReceivedAuctionValidatorsBeforeStakingV4 signals that an auction node has been provided before enabling staking v4
 var ErrReceivedAuctionValidatorsBeforeStakingV4 = errors.New("auction node has been provided before enabling staking v4")
+
+// ErrInvalidAdditionalTopUp signals that an invalid additional top up value has been provided
+var ErrInvalidAdditionalTopUp = errors.New("invalid additional top up value")
@@ -62,6 +62,7 @@ type baseRewardsCreator struct {
 	enableEpochsHandler                common.EnableEpochsHandler
 	mutRewardsData                     sync.RWMutex
 	executionOrderHandler              common.TxExecutionOrderHandler
+	rewardsBreakdown                   *common.RewardsBreakdown
 }
 
 // NewBaseRewardsCreator will create a new base rewards creator instance
@@ -186,11 +187,13 @@ func (brc *baseRewardsCreator) GetRewardsTxs(body *block.Body) map[string]data.T
 }
 
 // SaveBlockDataToStorage saves block data to storage
-func (brc *baseRewardsCreator) SaveBlockDataToStorage(_ data.MetaHeaderHandler, body *block.Body) {
+func (brc *baseRewardsCreator) SaveBlockDataToStorage(metaBlock data.MetaHeaderHandler, body *block.Body) {
 	if check.IfNil(body) {
 		return
 	}
 
+	brc.saveRewardsBreakdownToStorage(metaBlock)
+
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.RewardsBlock {
 			continue
@@ -236,6 +239,10 @@ func (brc *baseRewardsCreator) DeleteBlockDataFromStorage(metaBlock data.MetaHea
 		}
 	}
 
+	if metaBlock.IsStartOfEpochBlock() {
+		_ = brc.rewardsStorage.Remove(common.RewardsBreakdownKey(metaBlock.GetEpoch()))
+	}
+
 	for _, mbHeader := range metaBlock.GetMiniBlockHeaderHandlers() {
 		if mbHeader.GetTypeInt32() == int32(block.RewardsBlock) {
 			_ = brc.miniBlockStorage.Remove(mbHeader.GetHash())
@@ -243,6 +250,31 @@ func (brc *baseRewardsCreator) DeleteBlockDataFromStorage(metaBlock data.MetaHea
 	}
 }
 
+func (brc *baseRewardsCreator) saveRewardsBreakdownToStorage(metaBlock data.MetaHeaderHandler) {
+	if check.IfNil(metaBlock) || !metaBlock.IsStartOfEpochBlock() {
+		return
+	}
+
+	brc.mutRewardsData.RLock()
+	breakdown := brc.rewardsBreakdown
+	brc.mutRewardsData.RUnlock()
+
+	if breakdown == nil || breakdown.Epoch != metaBlock.GetEpoch() {
+		return
+	}
+
+	marshalledBreakdown, err := brc.marshalizer.Marshal(breakdown)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsBreakdownToStorage", "epoch", breakdown.Epoch, "error", err)
+		return
+	}
+
+	err = brc.rewardsStorage.Put(common.RewardsBreakdownKey(breakdown.Epoch), marshalledBreakdown)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsBreakdownToStorage", "epoch", breakdown.Epoch, "error", err)
+	}
+}
+
 // RemoveBlockDataFromPools removes block data from pools
 func (brc *baseRewardsCreator) RemoveBlockDataFromPools(metaBlock data.MetaHeaderHandler, body *block.Body) {
 	if check.IfNil(metaBlock) || check.IfNil(body) {
@@ -332,6 +364,7 @@ func (brc *baseRewardsCreator) clean() {
 	brc.currTxs.Clean()
 	brc.accumulatedRewards = big.NewInt(0)
 	brc.protocolSustainabilityValue = big.NewInt(0)
+	brc.rewardsBreakdown = nil
 }
 
 func (brc *baseRewardsCreator) isSystemDelegationSC(address []byte) bool {
@@ -9,3 +9,5 @@ var errCannotComputeDenominator = errors.New("cannot compute denominator value")
 var errNilAuctionListDisplayHandler = errors.New("nil auction list display handler provided")
 
 var errNilTableDisplayHandler = errors.New("nil table display handler provided")
+
+var errInvalidParameterChangesData = errors.New("invalid parameter changes data")
@@ -109,6 +109,7 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 
 	nodesRewardInfo, dustFromRewardsPerNode := rc.computeRewardsPerNode(validatorsInfo)
 	log.Debug("arithmetic difference from dust rewards per node", "value", dustFromRewardsPerNode)
+	rewardsBreakdown := rc.createRewardsBreakdown(metaBlock, nodesRewardInfo)
 
 	dust, err := rc.addValidatorRewardsToMiniBlocks(metaBlock, miniBlocks, nodesRewardInfo)
 	if err != nil {
@@ -124,6 +125,9 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rewardsBreakdown.ProtocolSustainabilityRewards = rc.protocolSustainabilityValue.String()
+	rc.rewardsBreakdown = rewardsBreakdown
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
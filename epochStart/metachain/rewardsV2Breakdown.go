@@ -0,0 +1,134 @@
+package metachain
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type rewardAddressBreakdownData struct {
+	numNodes        uint32
+	baseReward      *big.Int
+	topUpReward     *big.Int
+	accumulatedFees *big.Int
+}
+
+// createRewardsBreakdown keeps the per node and per reward address details of the computed rewards, so they can be
+// saved together with the rewards transactions
+func (rc *rewardsCreatorV2) createRewardsBreakdown(
+	metaBlock data.MetaHeaderHandler,
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+) *common.RewardsBreakdown {
+	totalLeaderFees := rc.economicsDataProvider.LeaderFees()
+	totalBaseRewards := big.NewInt(0)
+	totalTopUpRewards := big.NewInt(0)
+	rwdAddrData := make(map[string]*rewardAddressBreakdownData)
+
+	nodes := make([]*common.NodeRewardsBreakdown, 0)
+	for shardID, nodeInfoList := range nodesRewardInfo {
+		for _, nodeInfo := range nodeInfoList {
+			valInfo := nodeInfo.valInfo
+			forfeited := valInfo.GetLeaderSuccess() == 0 && valInfo.GetValidatorSuccess() == 0
+			nodes = append(nodes, &common.NodeRewardsBreakdown{
+				BlsKey:                     hex.EncodeToString(valInfo.GetPublicKey()),
+				ShardID:                    shardID,
+				RewardAddress:              rc.pubkeyConverter.SilentEncode(valInfo.GetRewardAddress(), log),
+				Rating:                     valInfo.GetRating(),
+				NumSelectedInSuccessBlocks: valInfo.GetNumSelectedInSuccessBlocks(),
+				BaseReward:                 nodeInfo.baseReward.String(),
+				TopUpStake:                 nodeInfo.topUpStake.String(),
+				TopUpReward:                nodeInfo.topUpReward.String(),
+				AccumulatedFees:            valInfo.GetAccumulatedFees().String(),
+				LeaderFeeShare:             computeLeaderFeeShare(valInfo.GetAccumulatedFees(), totalLeaderFees),
+				Forfeited:                  forfeited,
+			})
+
+			totalBaseRewards.Add(totalBaseRewards, nodeInfo.baseReward)
+			totalTopUpRewards.Add(totalTopUpRewards, nodeInfo.topUpReward)
+			if forfeited {
+				continue
+			}
+
+			addressData, ok := rwdAddrData[string(valInfo.GetRewardAddress())]
+			if !ok {
+				addressData = &rewardAddressBreakdownData{
+					baseReward:      big.NewInt(0),
+					topUpReward:     big.NewInt(0),
+					accumulatedFees: big.NewInt(0),
+				}
+				rwdAddrData[string(valInfo.GetRewardAddress())] = addressData
+			}
+
+			addressData.numNodes++
+			addressData.baseReward.Add(addressData.baseReward, nodeInfo.baseReward)
+			addressData.topUpReward.Add(addressData.topUpReward, nodeInfo.topUpReward)
+			addressData.accumulatedFees.Add(addressData.accumulatedFees, valInfo.GetAccumulatedFees())
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].ShardID != nodes[j].ShardID {
+			return nodes[i].ShardID < nodes[j].ShardID
+		}
+		return nodes[i].BlsKey < nodes[j].BlsKey
+	})
+
+	return &common.RewardsBreakdown{
+		Epoch:                         metaBlock.GetEpoch(),
+		BaseRewards:                   totalBaseRewards.String(),
+		TopUpRewards:                  totalTopUpRewards.String(),
+		LeaderFees:                    totalLeaderFees.String(),
+		DeveloperFees:                 metaBlock.GetDevFeesInEpoch().String(),
+		ProtocolSustainabilityRewards: "0",
+		Nodes:                         nodes,
+		RewardAddresses:               rc.createRewardAddressesBreakdown(rwdAddrData, totalLeaderFees),
+	}
+}
+
+func (rc *rewardsCreatorV2) createRewardAddressesBreakdown(
+	rwdAddrData map[string]*rewardAddressBreakdownData,
+	totalLeaderFees *big.Int,
+) []*common.RewardAddressBreakdown {
+	addresses := make([]string, 0, len(rwdAddrData))
+	for address := range rwdAddrData {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i] < addresses[j]
+	})
+
+	rewardAddresses := make([]*common.RewardAddressBreakdown, 0, len(addresses))
+	for _, address := range addresses {
+		addressData := rwdAddrData[address]
+		totalReward := big.NewInt(0).Add(addressData.baseReward, addressData.topUpReward)
+		totalReward.Add(totalReward, addressData.accumulatedFees)
+
+		rewardAddresses = append(rewardAddresses, &common.RewardAddressBreakdown{
+			Address:         rc.pubkeyConverter.SilentEncode([]byte(address), log),
+			NumNodes:        addressData.numNodes,
+			BaseReward:      addressData.baseReward.String(),
+			TopUpReward:     addressData.topUpReward.String(),
+			AccumulatedFees: addressData.accumulatedFees.String(),
+			LeaderFeeShare:  computeLeaderFeeShare(addressData.accumulatedFees, totalLeaderFees),
+			TotalReward:     totalReward.String(),
+		})
+	}
+
+	return rewardAddresses
+}
+
+func computeLeaderFeeShare(accumulatedFees *big.Int, totalLeaderFees *big.Int) float64 {
+	if accumulatedFees == nil || totalLeaderFees == nil || totalLeaderFees.Cmp(zero) <= 0 {
+		return 0
+	}
+
+	share, _ := big.NewFloat(0).Quo(
+		big.NewFloat(0).SetInt(accumulatedFees),
+		big.NewFloat(0).SetInt(totalLeaderFees),
+	).Float64()
+
+	return share
+}
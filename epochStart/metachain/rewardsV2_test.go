@@ -1667,6 +1667,96 @@ func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNewRewardsCreatorV2_CreateRewardsMiniBlocksSavesRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	nbEligiblePerShard := uint32(2)
+	vInfo := createDefaultValidatorInfo(nbEligiblePerShard, args.ShardCoordinator, args.NodesConfigProvider, 100, defaultBlocksPerShard)
+	offlineNode := vInfo.GetShardValidatorsInfoMap()[0][1]
+	offlineNode.SetLeaderSuccess(0)
+	offlineNode.SetValidatorSuccess(0)
+
+	args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+		GetTotalTopUpStakeEligibleNodesCalled: func() *big.Int {
+			return big.NewInt(4000)
+		},
+		GetNodeStakedTopUpCalled: func(_ []byte) (*big.Int, error) {
+			return big.NewInt(1000), nil
+		},
+	}
+	blocksPerShard := make(map[uint32]uint64)
+	for shardID := range createShardsMap(args.ShardCoordinator) {
+		blocksPerShard[shardID] = uint64(defaultBlocksPerShard)
+	}
+	args.EconomicsDataProvider.SetNumberOfBlocksPerShard(blocksPerShard)
+	args.EconomicsDataProvider.SetNumberOfBlocks(uint64(defaultBlocksPerShard) * uint64(len(blocksPerShard)))
+	args.EconomicsDataProvider.SetLeadersFees(big.NewInt(600))
+	rewardsForBlocks, _ := big.NewInt(0).SetString("5000000000000000000000", 10)
+	args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(rewardsForBlocks)
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.Nil(t, err)
+
+	metaBlock := &block.MetaBlock{
+		Epoch:          3,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(7),
+	}
+	metaBlock.EpochStart.LastFinalizedHeaders = []block.EpochStartShardData{{}}
+
+	miniBlocks, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	breakdown := rwd.rewardsBreakdown
+	require.NotNil(t, breakdown)
+	require.Equal(t, uint32(3), breakdown.Epoch)
+	require.Equal(t, "600", breakdown.LeaderFees)
+	require.Equal(t, "7", breakdown.DeveloperFees)
+	require.Equal(t, rwd.protocolSustainabilityValue.String(), breakdown.ProtocolSustainabilityRewards)
+	require.Len(t, breakdown.Nodes, len(vInfo.GetAllValidatorsInfo()))
+	require.Len(t, breakdown.RewardAddresses, len(vInfo.GetAllValidatorsInfo())-1)
+
+	offlineBlsKey := hex.EncodeToString(offlineNode.GetPublicKey())
+	for _, node := range breakdown.Nodes {
+		require.Equal(t, node.BlsKey == offlineBlsKey, node.Forfeited)
+		require.Equal(t, "100", node.AccumulatedFees)
+		require.Equal(t, float64(100)/600, node.LeaderFeeShare)
+	}
+
+	totalRewardPerAddress := make(map[string]string)
+	for _, rewardAddress := range breakdown.RewardAddresses {
+		require.Equal(t, uint32(1), rewardAddress.NumNodes)
+		totalRewardPerAddress[rewardAddress.Address] = rewardAddress.TotalReward
+	}
+	for _, mb := range miniBlocks {
+		for _, txHash := range mb.TxHashes {
+			rwdTx, errGet := rwd.currTxs.GetTx(txHash)
+			require.Nil(t, errGet)
+			if bytes.Equal(rwdTx.GetRcvAddr(), rwd.protocolSustainabilityAddress) {
+				continue
+			}
+
+			encodedAddress := args.PubkeyConverter.SilentEncode(rwdTx.GetRcvAddr(), log)
+			require.Equal(t, rwdTx.GetValue().String(), totalRewardPerAddress[encodedAddress])
+		}
+	}
+
+	body := &block.Body{MiniBlocks: miniBlocks}
+	rwd.SaveBlockDataToStorage(metaBlock, body)
+	marshalledBreakdown, err := args.RewardsStorage.Get(common.RewardsBreakdownKey(3))
+	require.Nil(t, err)
+
+	recoveredBreakdown := &common.RewardsBreakdown{}
+	err = args.Marshalizer.Unmarshal(recoveredBreakdown, marshalledBreakdown)
+	require.Nil(t, err)
+	require.Equal(t, breakdown, recoveredBreakdown)
+
+	rwd.DeleteBlockDataFromStorage(metaBlock, body)
+	_, err = args.RewardsStorage.Get(common.RewardsBreakdownKey(3))
+	require.NotNil(t, err)
+}
+
 func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks2169Nodes(t *testing.T) {
 	t.Parallel()
 
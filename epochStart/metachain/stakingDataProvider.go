@@ -256,6 +256,55 @@ func (sdp *stakingDataProvider) GetOwnersData() map[string]*epochStart.OwnerData
 	return ret
 }
 
+// SimulateOwnerAuctionChanges applies hypothetical changes over the cached staking data of the provided owner. The
+// additional top-up is added to the owner's total top-up, while the additional nodes are added in the owner's auction
+// list, having their stake covered by the minimum node price. It should be called after all validators were filled and
+// before computing the unqualified nodes
+func (sdp *stakingDataProvider) SimulateOwnerAuctionChanges(
+	owner []byte,
+	additionalTopUp *big.Int,
+	additionalNodes []state.ValidatorInfoHandler,
+) error {
+	if additionalTopUp == nil || additionalTopUp.Sign() < 0 {
+		return epochStart.ErrInvalidAdditionalTopUp
+	}
+
+	sdp.mutStakingData.Lock()
+	defer sdp.mutStakingData.Unlock()
+
+	ownerData, exists := sdp.cache[string(owner)]
+	if !exists {
+		ownerData = &ownerStats{
+			totalTopUp:           big.NewInt(0),
+			topUpPerNode:         big.NewInt(0),
+			totalStaked:          big.NewInt(0),
+			eligibleBaseStake:    big.NewInt(0).Set(sdp.minNodePrice),
+			eligibleTopUpStake:   big.NewInt(0),
+			eligibleTopUpPerNode: big.NewInt(0),
+			qualified:            true,
+		}
+		sdp.cache[string(owner)] = ownerData
+	}
+
+	numAdditionalNodes := int64(len(additionalNodes))
+	stakeForAdditionalNodes := big.NewInt(0).Mul(sdp.minNodePrice, big.NewInt(numAdditionalNodes))
+
+	ownerData.totalTopUp = big.NewInt(0).Add(ownerData.totalTopUp, additionalTopUp)
+	ownerData.totalStaked = big.NewInt(0).Add(ownerData.totalStaked, additionalTopUp)
+	ownerData.totalStaked.Add(ownerData.totalStaked, stakeForAdditionalNodes)
+	ownerData.numStakedNodes += numAdditionalNodes
+	for _, node := range additionalNodes {
+		ownerData.auctionList = append(ownerData.auctionList, node.ShallowClone())
+		ownerData.blsKeys = append(ownerData.blsKeys, node.GetPublicKey())
+	}
+
+	if ownerData.numStakedNodes > 0 {
+		ownerData.topUpPerNode = big.NewInt(0).Div(ownerData.totalTopUp, big.NewInt(ownerData.numStakedNodes))
+	}
+
+	return nil
+}
+
 // GetBlsKeyOwner returns the owner's public key of the provided bls key
 func (sdp *stakingDataProvider) GetBlsKeyOwner(blsKey []byte) (string, error) {
 	vmInput := &vmcommon.ContractCallInput{
@@ -654,6 +654,67 @@ func TestSelectKeysToUnStake(t *testing.T) {
 	})
 }
 
+func TestStakingDataProvider_SimulateOwnerAuctionChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid additional top up should error", func(t *testing.T) {
+		t.Parallel()
+
+		sdp, _ := NewStakingDataProvider(createStakingDataProviderArgs())
+		err := sdp.SimulateOwnerAuctionChanges([]byte("owner"), nil, nil)
+		require.Equal(t, epochStart.ErrInvalidAdditionalTopUp, err)
+
+		err = sdp.SimulateOwnerAuctionChanges([]byte("owner"), big.NewInt(-1), nil)
+		require.Equal(t, epochStart.ErrInvalidAdditionalTopUp, err)
+	})
+	t.Run("existing owner should add top up and nodes", func(t *testing.T) {
+		t.Parallel()
+
+		sdp, _ := NewStakingDataProvider(createStakingDataProviderArgs())
+		existingNode := &state.ValidatorInfo{PublicKey: []byte("pk0"), List: string(common.AuctionList)}
+		sdp.cache["owner"] = &ownerStats{
+			numStakedNodes: 2,
+			numActiveNodes: 1,
+			totalTopUp:     big.NewInt(1000),
+			topUpPerNode:   big.NewInt(500),
+			totalStaked:    big.NewInt(6000),
+			blsKeys:        [][]byte{[]byte("pk0"), []byte("pk1")},
+			auctionList:    []state.ValidatorInfoHandler{existingNode},
+			qualified:      true,
+		}
+
+		simulatedNode := &state.ValidatorInfo{PublicKey: []byte("simulated0"), List: string(common.AuctionList)}
+		err := sdp.SimulateOwnerAuctionChanges([]byte("owner"), big.NewInt(2000), []state.ValidatorInfoHandler{simulatedNode})
+		require.Nil(t, err)
+
+		ownerData := sdp.GetOwnersData()["owner"]
+		require.Equal(t, int64(3), ownerData.NumStakedNodes)
+		require.Equal(t, int64(1), ownerData.NumActiveNodes)
+		require.Equal(t, big.NewInt(3000), ownerData.TotalTopUp)
+		require.Equal(t, big.NewInt(1000), ownerData.TopUpPerNode)
+		require.Equal(t, []state.ValidatorInfoHandler{existingNode, simulatedNode}, ownerData.AuctionList)
+		require.True(t, ownerData.Qualified)
+		require.Equal(t, big.NewInt(10500), sdp.cache["owner"].totalStaked)
+		require.Equal(t, [][]byte{[]byte("pk0"), []byte("pk1"), []byte("simulated0")}, sdp.cache["owner"].blsKeys)
+	})
+	t.Run("new owner should be created", func(t *testing.T) {
+		t.Parallel()
+
+		sdp, _ := NewStakingDataProvider(createStakingDataProviderArgs())
+		simulatedNode := &state.ValidatorInfo{PublicKey: []byte("simulated0"), List: string(common.AuctionList)}
+		err := sdp.SimulateOwnerAuctionChanges([]byte("new owner"), big.NewInt(100), []state.ValidatorInfoHandler{simulatedNode})
+		require.Nil(t, err)
+
+		ownerData := sdp.GetOwnersData()["new owner"]
+		require.Equal(t, int64(1), ownerData.NumStakedNodes)
+		require.Equal(t, int64(0), ownerData.NumActiveNodes)
+		require.Equal(t, big.NewInt(100), ownerData.TotalTopUp)
+		require.Equal(t, big.NewInt(100), ownerData.TopUpPerNode)
+		require.True(t, ownerData.Qualified)
+		require.Equal(t, big.NewInt(2600), sdp.cache["new owner"].totalStaked)
+	})
+}
+
 func createStakingDataProviderWithMockArgs(
 	t *testing.T,
 	owner []byte,
@@ -2,6 +2,7 @@ package metachain
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
@@ -81,6 +83,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		common.StakingV4StartedFlag,
 		common.DelegationSmartContractFlagInSpecificEpochOnly,
 		common.GovernanceFlagInSpecificEpochOnly,
+		common.GovernanceParameterChangesFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -136,6 +139,13 @@ func (s *systemSCProcessor) processWithNewFlags(
 		}
 	}
 
+	if s.isGovernanceParameterChangesEnabled() {
+		err := s.processGovernanceParameterChanges(header.GetEpoch())
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.enableEpochsHandler.IsFlagEnabled(common.StakingV4Step1Flag) {
 		err := s.unStakeAllNodesFromQueue()
 		if err != nil {
@@ -250,6 +260,89 @@ func (s *systemSCProcessor) updateToGovernanceV2() error {
 	return nil
 }
 
+// the governance system smart contract rejects any call before it is enabled, so the parameter changes are processed
+// only when both flags are set
+func (s *systemSCProcessor) isGovernanceParameterChangesEnabled() bool {
+	return s.enableEpochsHandler.IsFlagEnabled(common.GovernanceFlag) &&
+		s.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag)
+}
+
+func (s *systemSCProcessor) processGovernanceParameterChanges(epoch uint32) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.GovernanceSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{big.NewInt(0).SetUint64(uint64(epoch)).Bytes()},
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "processParameterChanges",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return fmt.Errorf("%w when processing the governance parameter changes", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("got return code %s when processing the governance parameter changes, message: %s",
+			vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return s.processSCOutputAccounts(vmOutput)
+}
+
+// ParameterChanges returns the governance parameter changes in effect and the ones of the pending passed proposals,
+// as recorded by the governance system smart contract. They are carried by the start of epoch metablocks to all the
+// shards
+func (s *systemSCProcessor) ParameterChanges() ([]*governance.ParameterChange, error) {
+	if !s.isGovernanceParameterChangesEnabled() {
+		return make([]*governance.ParameterChange, 0), nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.GovernanceSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{},
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "viewAllParameterChanges",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return nil, fmt.Errorf("%w when reading the governance parameter changes", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("got return code %s when reading the governance parameter changes, message: %s",
+			vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return parseParameterChanges(vmOutput.ReturnData)
+}
+
+// parseParameterChanges parses the groups of proposal nonce, activation epoch, parameter name and value returned by
+// the governance system smart contract
+func parseParameterChanges(returnData [][]byte) ([]*governance.ParameterChange, error) {
+	if len(returnData)%4 != 0 {
+		return nil, fmt.Errorf("%w, number of returned values %d is not a multiple of 4", errInvalidParameterChangesData, len(returnData))
+	}
+
+	changes := make([]*governance.ParameterChange, 0, len(returnData)/4)
+	for i := 0; i < len(returnData); i += 4 {
+		activationEpoch := big.NewInt(0).SetBytes(returnData[i+1])
+		if !activationEpoch.IsUint64() || activationEpoch.Uint64() > math.MaxUint32 {
+			return nil, fmt.Errorf("%w, invalid activation epoch %s", errInvalidParameterChangesData, activationEpoch.String())
+		}
+
+		changes = append(changes, &governance.ParameterChange{
+			ProposalNonce:   big.NewInt(0).SetBytes(returnData[i]).Uint64(),
+			Name:            string(returnData[i+2]),
+			Value:           string(returnData[i+3]),
+			ActivationEpoch: uint32(activationEpoch.Uint64()),
+		})
+	}
+
+	return changes, nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (s *systemSCProcessor) IsInterfaceNil() bool {
 	return s == nil
@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/enablers"
 	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dataRetriever/blockchain"
@@ -1085,7 +1086,8 @@ func TestSystemSCProcessor_ProcessSystemSmartContractInitGovernance(t *testing.T
 					flag == common.CorrectLastUnJailedFlag ||
 					flag == common.SwitchJailWaitingFlag ||
 					flag == common.StakingV2Flag ||
-					flag == common.ESDTFlagInSpecificEpochOnly {
+					flag == common.ESDTFlagInSpecificEpochOnly ||
+					flag == common.GovernanceParameterChangesFlag {
 
 					return false
 				}
@@ -2382,6 +2384,169 @@ func TestSystemSCProcessor_ProcessSystemSmartContractNilInputValues(t *testing.T
 	})
 }
 
+func TestSystemSCProcessor_ParameterChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should not call the governance system smart contract", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				assert.Fail(t, "should have not called")
+
+				return nil, fmt.Errorf("should have not called")
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		changes, err := processor.ParameterChanges()
+		require.Nil(t, err)
+		require.Empty(t, changes)
+	})
+	t.Run("contract call errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		changes, err := processor.ParameterChanges()
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, changes)
+	})
+	t.Run("contract call fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		changes, err := processor.ParameterChanges()
+		require.NotNil(t, err)
+		require.Nil(t, changes)
+	})
+	t.Run("invalid returned data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnData: [][]byte{{1}, {2}, []byte("name")}}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		changes, err := processor.ParameterChanges()
+		require.ErrorIs(t, err, errInvalidParameterChangesData)
+		require.Nil(t, changes)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Equal(t, vm.GovernanceSCAddress, input.CallerAddr)
+				require.Equal(t, vm.GovernanceSCAddress, input.RecipientAddr)
+				require.Equal(t, "viewAllParameterChanges", input.Function)
+
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{
+						{1}, {60}, []byte(governance.MaxGasLimitPerTx), []byte("1000"),
+						{2}, {61}, []byte(governance.MinGasLimit), []byte("10"),
+					},
+				}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		changes, err := processor.ParameterChanges()
+		require.Nil(t, err)
+		expectedChanges := []*governance.ParameterChange{
+			{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "1000", ActivationEpoch: 60},
+			{ProposalNonce: 2, Name: governance.MinGasLimit, Value: "10", ActivationEpoch: 61},
+		}
+		require.Equal(t, expectedChanges, changes)
+	})
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractGovernanceParameterChanges(t *testing.T) {
+	t.Parallel()
+
+	header := &block.MetaBlock{Nonce: 10, Epoch: 60}
+
+	t.Run("governance not enabled should not call the governance system smart contract", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				assert.Fail(t, "should have not called")
+
+				return nil, fmt.Errorf("should have not called")
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		err := processor.ProcessSystemSmartContract(state.NewShardValidatorsInfoMap(), header)
+		require.Nil(t, err)
+	})
+	t.Run("contract call fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		err := processor.ProcessSystemSmartContract(state.NewShardValidatorsInfoMap(), header)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "when processing the governance parameter changes")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		wasCalled := false
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Equal(t, vm.GovernanceSCAddress, input.CallerAddr)
+				require.Equal(t, vm.GovernanceSCAddress, input.RecipientAddr)
+				require.Equal(t, "processParameterChanges", input.Function)
+				require.Equal(t, [][]byte{big.NewInt(60).Bytes()}, input.Arguments)
+				wasCalled = true
+
+				return &vmcommon.VMOutput{}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		err := processor.ProcessSystemSmartContract(state.NewShardValidatorsInfoMap(), header)
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+}
+
 func TestLegacySystemSCProcessor_addNewlyStakedNodesToValidatorTrie(t *testing.T) {
 	t.Parallel()
 
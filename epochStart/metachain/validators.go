@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -36,13 +37,15 @@ type ArgsNewValidatorInfoCreator struct {
 }
 
 type validatorInfoCreator struct {
-	shardCoordinator     sharding.Coordinator
-	validatorInfoStorage storage.Storer
-	miniBlockStorage     storage.Storer
-	hasher               hashing.Hasher
-	marshalizer          marshal.Marshalizer
-	dataPool             dataRetriever.PoolsHolder
-	enableEpochsHandler  common.EnableEpochsHandler
+	shardCoordinator         sharding.Coordinator
+	validatorInfoStorage     storage.Storer
+	miniBlockStorage         storage.Storer
+	hasher                   hashing.Hasher
+	marshalizer              marshal.Marshalizer
+	dataPool                 dataRetriever.PoolsHolder
+	enableEpochsHandler      common.EnableEpochsHandler
+	validatorsPerformance    map[string]*common.ValidatorEpochPerformance
+	mutValidatorsPerformance sync.Mutex
 }
 
 // NewValidatorInfoCreator creates a new validatorInfo creator object
@@ -99,6 +102,7 @@ func (vic *validatorInfoCreator) CreateValidatorInfoMiniBlocks(validatorsInfo st
 	}
 
 	vic.clean()
+	vic.setValidatorsPerformance(validatorsInfo)
 
 	miniBlocks := make([]*block.MiniBlock, 0)
 
@@ -440,11 +444,13 @@ func (vic *validatorInfoCreator) getShardValidatorInfo(txHash []byte) (*state.Sh
 }
 
 // SaveBlockDataToStorage saves block data to storage
-func (vic *validatorInfoCreator) SaveBlockDataToStorage(_ data.HeaderHandler, body *block.Body) {
+func (vic *validatorInfoCreator) SaveBlockDataToStorage(header data.HeaderHandler, body *block.Body) {
 	if check.IfNil(body) {
 		return
 	}
 
+	vic.saveValidatorsPerformanceToStorage(header)
+
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.PeerBlock {
 			continue
@@ -497,6 +503,8 @@ func (vic *validatorInfoCreator) DeleteBlockDataFromStorage(metaBlock data.Heade
 		return
 	}
 
+	vic.removeValidatorsPerformanceFromStorage(metaBlock, body)
+
 	if vic.enableEpochsHandler.IsFlagEnabled(common.RefactorPeersMiniBlocksFlag) {
 		vic.removeValidatorInfo(body)
 	}
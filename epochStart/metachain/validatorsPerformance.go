@@ -0,0 +1,104 @@
+package metachain
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// setValidatorsPerformance keeps the activity of all validators during the ending epoch, before the counters are reset,
+// so it can be saved together with the validator info of the epoch start block
+func (vic *validatorInfoCreator) setValidatorsPerformance(validatorsInfo state.ShardValidatorsInfoMapHandler) {
+	allValidatorsInfo := validatorsInfo.GetAllValidatorsInfo()
+	validatorsPerformance := make(map[string]*common.ValidatorEpochPerformance, len(allValidatorsInfo))
+	for _, validator := range allValidatorsInfo {
+		validatorsPerformance[string(validator.GetPublicKey())] = &common.ValidatorEpochPerformance{
+			ShardID:                    validator.GetShardId(),
+			List:                       validator.GetList(),
+			LeaderSuccess:              validator.GetLeaderSuccess(),
+			LeaderFailure:              validator.GetLeaderFailure(),
+			ValidatorSuccess:           validator.GetValidatorSuccess(),
+			ValidatorFailure:           validator.GetValidatorFailure(),
+			ValidatorIgnoredSignatures: validator.GetValidatorIgnoredSignatures(),
+			StartRating:                validator.GetRating(),
+			EndRating:                  validator.GetTempRating(),
+		}
+	}
+
+	vic.mutValidatorsPerformance.Lock()
+	vic.validatorsPerformance = validatorsPerformance
+	vic.mutValidatorsPerformance.Unlock()
+}
+
+func (vic *validatorInfoCreator) saveValidatorsPerformanceToStorage(header data.HeaderHandler) {
+	if check.IfNil(header) || !header.IsStartOfEpochBlock() || header.GetEpoch() == 0 {
+		return
+	}
+
+	vic.mutValidatorsPerformance.Lock()
+	validatorsPerformance := vic.validatorsPerformance
+	vic.validatorsPerformance = nil
+	vic.mutValidatorsPerformance.Unlock()
+
+	epoch := header.GetEpoch() - 1
+	for blsKey, performance := range validatorsPerformance {
+		performance.Epoch = epoch
+		marshalledPerformance, err := vic.marshalizer.Marshal(performance)
+		if err != nil {
+			log.Warn("validatorInfoCreator.saveValidatorsPerformanceToStorage", "epoch", epoch, "error", err)
+			continue
+		}
+
+		err = vic.validatorInfoStorage.Put(common.ValidatorPerformanceKey([]byte(blsKey), epoch), marshalledPerformance)
+		if err != nil {
+			log.Debug("validatorInfoCreator.saveValidatorsPerformanceToStorage.Put", "epoch", epoch, "error", err)
+		}
+	}
+}
+
+// removeValidatorsPerformanceFromStorage needs the validator info of the block, so it has to be called before the
+// validator info is removed from storage
+func (vic *validatorInfoCreator) removeValidatorsPerformanceFromStorage(header data.HeaderHandler, body *block.Body) {
+	if !header.IsStartOfEpochBlock() || header.GetEpoch() == 0 {
+		return
+	}
+
+	epoch := header.GetEpoch() - 1
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock.Type != block.PeerBlock {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			shardValidatorInfo, err := vic.getShardValidatorInfoFromPoolOrStorage(txHash)
+			if err != nil {
+				log.Debug("validatorInfoCreator.removeValidatorsPerformanceFromStorage", "hash", txHash, "error", err)
+				continue
+			}
+
+			_ = vic.validatorInfoStorage.Remove(common.ValidatorPerformanceKey(shardValidatorInfo.PublicKey, epoch))
+		}
+	}
+}
+
+func (vic *validatorInfoCreator) getShardValidatorInfoFromPoolOrStorage(txHash []byte) (*state.ShardValidatorInfo, error) {
+	shardValidatorInfo, err := vic.getShardValidatorInfo(txHash)
+	if err == nil && shardValidatorInfo != nil {
+		return shardValidatorInfo, nil
+	}
+
+	marshalledData, err := vic.validatorInfoStorage.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	shardValidatorInfo = &state.ShardValidatorInfo{}
+	err = vic.marshalizer.Unmarshal(shardValidatorInfo, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return shardValidatorInfo, nil
+}
@@ -0,0 +1,205 @@
+package metachain
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	errorsCommon "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+type validatorStatus struct {
+	list    string
+	shardID uint32
+}
+
+// ArgsValidatorsStatusChangesCollector holds the arguments needed to create a validators status changes collector
+type ArgsValidatorsStatusChangesCollector struct {
+	StakingDataProvider    epochStart.StakingDataProvider
+	AddressPubKeyConverter core.PubkeyConverter
+}
+
+// validatorsStatusChangesCollector detects the validators' list changes by comparing the validators' lists of two
+// consecutive epoch starts. Before the first epoch start seen by the node, the lists loaded at the epoch start, before
+// processing it, are used instead
+type validatorsStatusChangesCollector struct {
+	stakingDataProvider    epochStart.StakingDataProvider
+	addressPubKeyConverter core.PubkeyConverter
+
+	mutStatus      sync.RWMutex
+	statusInEpoch  map[uint32]map[string]*validatorStatus
+	changesInEpoch map[uint32][]*common.ValidatorStatusChange
+}
+
+// NewValidatorsStatusChangesCollector creates a new validators status changes collector
+func NewValidatorsStatusChangesCollector(args ArgsValidatorsStatusChangesCollector) (*validatorsStatusChangesCollector, error) {
+	if check.IfNil(args.StakingDataProvider) {
+		return nil, epochStart.ErrNilStakingDataProvider
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, errorsCommon.ErrNilAddressPublicKeyConverter
+	}
+
+	return &validatorsStatusChangesCollector{
+		stakingDataProvider:    args.StakingDataProvider,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		statusInEpoch:          make(map[uint32]map[string]*validatorStatus),
+		changesInEpoch:         make(map[uint32][]*common.ValidatorStatusChange),
+	}, nil
+}
+
+// SetPreviousStatus records the validators' lists of the ending epoch, unless the lists computed at the previous epoch
+// start are already known. It has to be called before processing the epoch start
+func (vsc *validatorsStatusChangesCollector) SetPreviousStatus(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32) {
+	if validatorsInfo == nil || epoch == 0 {
+		return
+	}
+
+	vsc.mutStatus.Lock()
+	defer vsc.mutStatus.Unlock()
+
+	_, exists := vsc.statusInEpoch[epoch-1]
+	if !exists {
+		vsc.statusInEpoch[epoch-1] = createValidatorsStatus(validatorsInfo)
+	}
+}
+
+// ComputeStatusChanges computes the validators' list changes of the provided epoch start. It has to be called after the
+// system smart contracts were processed, so the jailed, unjailed, leaving and auction nodes are already updated
+func (vsc *validatorsStatusChangesCollector) ComputeStatusChanges(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32) {
+	if validatorsInfo == nil || epoch == 0 {
+		return
+	}
+
+	vsc.mutStatus.Lock()
+	defer vsc.mutStatus.Unlock()
+
+	currentStatus := createValidatorsStatus(validatorsInfo)
+	vsc.statusInEpoch[epoch] = currentStatus
+	previousStatus, exists := vsc.statusInEpoch[epoch-1]
+	if !exists {
+		vsc.changesInEpoch = make(map[uint32][]*common.ValidatorStatusChange)
+		return
+	}
+
+	changes := make([]*common.ValidatorStatusChange, 0)
+	for blsKey, current := range currentStatus {
+		previous, found := previousStatus[blsKey]
+		if !found {
+			changes = append(changes, vsc.createStatusChange(blsKey, "", current.list, current.shardID, epoch))
+			continue
+		}
+		if previous.list != current.list {
+			changes = append(changes, vsc.createStatusChange(blsKey, previous.list, current.list, current.shardID, epoch))
+		}
+	}
+	for blsKey, previous := range previousStatus {
+		_, found := currentStatus[blsKey]
+		if !found {
+			changes = append(changes, vsc.createStatusChange(blsKey, previous.list, "", previous.shardID, epoch))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].BlsKey < changes[j].BlsKey
+	})
+
+	for storedEpoch := range vsc.statusInEpoch {
+		if storedEpoch+1 < epoch {
+			delete(vsc.statusInEpoch, storedEpoch)
+		}
+	}
+	vsc.changesInEpoch = map[uint32][]*common.ValidatorStatusChange{epoch: changes}
+
+	log.Debug("validatorsStatusChangesCollector.ComputeStatusChanges", "epoch", epoch, "num changes", len(changes))
+}
+
+// GetStatusChanges returns the validators' list changes computed for the provided epoch start
+func (vsc *validatorsStatusChangesCollector) GetStatusChanges(epoch uint32) []*common.ValidatorStatusChange {
+	vsc.mutStatus.RLock()
+	defer vsc.mutStatus.RUnlock()
+
+	changes := vsc.changesInEpoch[epoch]
+	result := make([]*common.ValidatorStatusChange, len(changes))
+	copy(result, changes)
+
+	return result
+}
+
+func createValidatorsStatus(validatorsInfo state.ShardValidatorsInfoMapHandler) map[string]*validatorStatus {
+	allValidatorsInfo := validatorsInfo.GetAllValidatorsInfo()
+	status := make(map[string]*validatorStatus, len(allValidatorsInfo))
+	for _, validator := range allValidatorsInfo {
+		status[string(validator.GetPublicKey())] = &validatorStatus{
+			list:    validator.GetList(),
+			shardID: validator.GetShardId(),
+		}
+	}
+
+	return status
+}
+
+func (vsc *validatorsStatusChangesCollector) createStatusChange(
+	blsKey string,
+	oldList string,
+	newList string,
+	shardID uint32,
+	epoch uint32,
+) *common.ValidatorStatusChange {
+	return &common.ValidatorStatusChange{
+		BlsKey:  hex.EncodeToString([]byte(blsKey)),
+		Owner:   vsc.getOwner([]byte(blsKey)),
+		ShardID: shardID,
+		OldList: oldList,
+		NewList: newList,
+		Reason:  getStatusChangeReason(oldList, newList),
+		Epoch:   epoch,
+	}
+}
+
+func (vsc *validatorsStatusChangesCollector) getOwner(blsKey []byte) string {
+	owner, err := vsc.stakingDataProvider.GetBlsKeyOwner(blsKey)
+	if err != nil || len(owner) == 0 {
+		log.Debug("validatorsStatusChangesCollector.getOwner", "bls key", blsKey, "error", err)
+		return ""
+	}
+
+	return vsc.addressPubKeyConverter.SilentEncode([]byte(owner), log)
+}
+
+func getStatusChangeReason(oldList string, newList string) common.ValidatorStatusChangeReason {
+	switch {
+	case len(oldList) == 0:
+		return common.ValidatorStaked
+	case len(newList) == 0:
+		return common.ValidatorRemoved
+	case newList == string(common.JailedList):
+		return common.ValidatorJailed
+	case oldList == string(common.JailedList):
+		return common.ValidatorUnJailed
+	case newList == string(common.LeavingList):
+		return common.ValidatorLeaving
+	case newList == string(common.SelectedFromAuctionList):
+		return common.ValidatorSelectedFromAuction
+	case newList == string(common.AuctionList):
+		return common.ValidatorAuction
+	case isEligibleOrWaiting(oldList) && isEligibleOrWaiting(newList):
+		return common.ValidatorShuffled
+	default:
+		return common.ValidatorListChanged
+	}
+}
+
+func isEligibleOrWaiting(list string) bool {
+	return list == string(common.EligibleList) || list == string(common.WaitingList)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vsc *validatorsStatusChangesCollector) IsInterfaceNil() bool {
+	return vsc == nil
+}
@@ -0,0 +1,149 @@
+package metachain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	errorsCommon "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/stakingcommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsValidatorsStatusChangesCollector() ArgsValidatorsStatusChangesCollector {
+	return ArgsValidatorsStatusChangesCollector{
+		StakingDataProvider: &stakingcommon.StakingDataProviderStub{
+			GetBlsKeyOwnerCalled: func(blsKey []byte) (string, error) {
+				return "owner", nil
+			},
+		},
+		AddressPubKeyConverter: &testscommon.PubkeyConverterMock{},
+	}
+}
+
+func createValidatorsInfoWithLists(lists map[string]common.PeerType) state.ShardValidatorsInfoMapHandler {
+	validatorsInfo := state.NewShardValidatorsInfoMap()
+	for pubKey, list := range lists {
+		_ = validatorsInfo.Add(createValidatorInfo([]byte(pubKey), list, "", 0, []byte("owner")))
+	}
+
+	return validatorsInfo
+}
+
+func TestNewValidatorsStatusChangesCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil staking data provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsValidatorsStatusChangesCollector()
+		args.StakingDataProvider = nil
+		vsc, err := NewValidatorsStatusChangesCollector(args)
+		require.Nil(t, vsc)
+		require.Equal(t, epochStart.ErrNilStakingDataProvider, err)
+	})
+	t.Run("nil address pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsValidatorsStatusChangesCollector()
+		args.AddressPubKeyConverter = nil
+		vsc, err := NewValidatorsStatusChangesCollector(args)
+		require.Nil(t, vsc)
+		require.Equal(t, errorsCommon.ErrNilAddressPublicKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		vsc, err := NewValidatorsStatusChangesCollector(createMockArgsValidatorsStatusChangesCollector())
+		require.Nil(t, err)
+		require.False(t, vsc.IsInterfaceNil())
+	})
+}
+
+func TestValidatorsStatusChangesCollector_ComputeStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	previousLists := map[string]common.PeerType{
+		"pk0": common.EligibleList,
+		"pk1": common.EligibleList,
+		"pk2": common.JailedList,
+		"pk3": common.WaitingList,
+		"pk4": common.AuctionList,
+		"pk5": common.WaitingList,
+		"pk6": common.EligibleList,
+	}
+	currentLists := map[string]common.PeerType{
+		"pk0": common.EligibleList,
+		"pk1": common.JailedList,
+		"pk2": common.InactiveList,
+		"pk3": common.EligibleList,
+		"pk4": common.SelectedFromAuctionList,
+		"pk5": common.LeavingList,
+		"pk7": common.AuctionList,
+	}
+	expectedChange := func(pubKey string, oldList string, newList string, reason common.ValidatorStatusChangeReason) *common.ValidatorStatusChange {
+		return &common.ValidatorStatusChange{
+			BlsKey:  hex.EncodeToString([]byte(pubKey)),
+			Owner:   hex.EncodeToString([]byte("owner")),
+			OldList: oldList,
+			NewList: newList,
+			Reason:  reason,
+			Epoch:   3,
+		}
+	}
+	expectedChanges := []*common.ValidatorStatusChange{
+		expectedChange("pk1", string(common.EligibleList), string(common.JailedList), common.ValidatorJailed),
+		expectedChange("pk2", string(common.JailedList), string(common.InactiveList), common.ValidatorUnJailed),
+		expectedChange("pk3", string(common.WaitingList), string(common.EligibleList), common.ValidatorShuffled),
+		expectedChange("pk4", string(common.AuctionList), string(common.SelectedFromAuctionList), common.ValidatorSelectedFromAuction),
+		expectedChange("pk5", string(common.WaitingList), string(common.LeavingList), common.ValidatorLeaving),
+		expectedChange("pk6", string(common.EligibleList), "", common.ValidatorRemoved),
+		expectedChange("pk7", "", string(common.AuctionList), common.ValidatorStaked),
+	}
+
+	t.Run("epoch 0 should not compute changes", func(t *testing.T) {
+		t.Parallel()
+
+		vsc, _ := NewValidatorsStatusChangesCollector(createMockArgsValidatorsStatusChangesCollector())
+		vsc.SetPreviousStatus(createValidatorsInfoWithLists(previousLists), 0)
+		vsc.ComputeStatusChanges(createValidatorsInfoWithLists(currentLists), 0)
+		require.Empty(t, vsc.GetStatusChanges(0))
+	})
+	t.Run("unknown previous status should not compute changes", func(t *testing.T) {
+		t.Parallel()
+
+		vsc, _ := NewValidatorsStatusChangesCollector(createMockArgsValidatorsStatusChangesCollector())
+		vsc.ComputeStatusChanges(createValidatorsInfoWithLists(currentLists), 3)
+		require.Empty(t, vsc.GetStatusChanges(3))
+	})
+	t.Run("should compute the changes against the previous status", func(t *testing.T) {
+		t.Parallel()
+
+		vsc, _ := NewValidatorsStatusChangesCollector(createMockArgsValidatorsStatusChangesCollector())
+		vsc.SetPreviousStatus(createValidatorsInfoWithLists(previousLists), 3)
+		vsc.ComputeStatusChanges(createValidatorsInfoWithLists(currentLists), 3)
+		require.Equal(t, expectedChanges, vsc.GetStatusChanges(3))
+		require.Empty(t, vsc.GetStatusChanges(2))
+
+		// processing the same epoch start again should yield the same changes
+		vsc.SetPreviousStatus(createValidatorsInfoWithLists(currentLists), 3)
+		vsc.ComputeStatusChanges(createValidatorsInfoWithLists(currentLists), 3)
+		require.Equal(t, expectedChanges, vsc.GetStatusChanges(3))
+	})
+	t.Run("should prefer the status computed at the previous epoch start", func(t *testing.T) {
+		t.Parallel()
+
+		vsc, _ := NewValidatorsStatusChangesCollector(createMockArgsValidatorsStatusChangesCollector())
+		vsc.SetPreviousStatus(createValidatorsInfoWithLists(map[string]common.PeerType{}), 2)
+		vsc.ComputeStatusChanges(createValidatorsInfoWithLists(previousLists), 2)
+		require.Len(t, vsc.GetStatusChanges(2), len(previousLists))
+
+		vsc.SetPreviousStatus(createValidatorsInfoWithLists(currentLists), 3)
+		vsc.ComputeStatusChanges(createValidatorsInfoWithLists(currentLists), 3)
+		require.Equal(t, expectedChanges, vsc.GetStatusChanges(3))
+		require.Empty(t, vsc.GetStatusChanges(2))
+	})
+}
@@ -1326,3 +1326,86 @@ func createTestValidatorInfo() *state.ValidatorInfo {
 		TotalValidatorIgnoredSignatures: 17,
 	}
 }
+
+func TestEpochValidatorInfoCreator_ValidatorsPerformance(t *testing.T) {
+	t.Parallel()
+
+	createValidatorInfoCreator := func() (*validatorInfoCreator, ArgsNewValidatorInfoCreator) {
+		arguments := createMockEpochValidatorInfoCreatorsArguments()
+		validatorsInfoPool := make(map[string]*state.ShardValidatorInfo)
+		arguments.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			MiniBlocksCalled: func() storage.Cacher {
+				return &testscommon.CacherStub{}
+			},
+			CurrEpochValidatorInfoCalled: func() dataRetriever.ValidatorInfoCacher {
+				return &vics.ValidatorInfoCacherStub{
+					AddValidatorInfoCalled: func(validatorInfoHash []byte, validatorInfo *state.ShardValidatorInfo) {
+						validatorsInfoPool[string(validatorInfoHash)] = validatorInfo
+					},
+					GetValidatorInfoCalled: func(validatorInfoHash []byte) (*state.ShardValidatorInfo, error) {
+						validatorInfo, ok := validatorsInfoPool[string(validatorInfoHash)]
+						if !ok {
+							return nil, errors.New("not found")
+						}
+						return validatorInfo, nil
+					},
+				}
+			},
+		}
+		vic, _ := NewValidatorInfoCreator(arguments)
+
+		return vic, arguments
+	}
+	getPerformance := func(arguments ArgsNewValidatorInfoCreator, blsKey string, epoch uint32) *common.ValidatorEpochPerformance {
+		buff, err := arguments.ValidatorInfoStorage.Get(common.ValidatorPerformanceKey([]byte(blsKey), epoch))
+		if err != nil {
+			return nil
+		}
+
+		performance := &common.ValidatorEpochPerformance{}
+		err = arguments.Marshalizer.Unmarshal(performance, buff)
+		require.Nil(t, err)
+
+		return performance
+	}
+
+	t.Run("not a start of epoch block should not save the performance", func(t *testing.T) {
+		t.Parallel()
+
+		vic, arguments := createValidatorInfoCreator()
+		miniBlocks, _ := vic.CreateValidatorInfoMiniBlocks(createMockValidatorInfo())
+		vic.SaveBlockDataToStorage(&block.MetaBlock{Epoch: 5}, &block.Body{MiniBlocks: miniBlocks})
+
+		require.Nil(t, getPerformance(arguments, "a1", 4))
+	})
+	t.Run("start of epoch block should save the performance of the ended epoch", func(t *testing.T) {
+		t.Parallel()
+
+		vic, arguments := createValidatorInfoCreator()
+		miniBlocks, _ := vic.CreateValidatorInfoMiniBlocks(createMockValidatorInfo())
+		metaBlock := &block.MetaBlock{
+			Epoch:      5,
+			EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		}
+		vic.SaveBlockDataToStorage(metaBlock, &block.Body{MiniBlocks: miniBlocks})
+
+		expectedPerformance := &common.ValidatorEpochPerformance{
+			Epoch:            4,
+			ShardID:          0,
+			List:             "waiting",
+			LeaderSuccess:    6,
+			LeaderFailure:    7,
+			ValidatorSuccess: 8,
+			ValidatorFailure: 9,
+			StartRating:      1001,
+			EndRating:        101,
+		}
+		require.Equal(t, expectedPerformance, getPerformance(arguments, "a2", 4))
+		require.Equal(t, core.MetachainShardId, getPerformance(arguments, "m1", 4).ShardID)
+
+		vic.DeleteBlockDataFromStorage(metaBlock, &block.Body{MiniBlocks: miniBlocks})
+		for _, blsKey := range []string{"a1", "a2", "m0", "m1"} {
+			require.Nil(t, getPerformance(arguments, blsKey, 4))
+		}
+	})
+}
@@ -598,3 +598,9 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrNilEpochSystemSCProcessor defines the error for setting a nil EpochSystemSCProcessor
 var ErrNilEpochSystemSCProcessor = errors.New("nil epoch system SC processor")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrCannotLoadValidatorKey signals that the validator key keystore exists but could not be decrypted
+var ErrCannotLoadValidatorKey = errors.New("cannot load the validator key")
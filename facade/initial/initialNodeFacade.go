@@ -3,6 +3,7 @@ package initial
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -20,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/ntp"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
@@ -161,6 +163,16 @@ func (inf *initialNodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAP
 	return nil, errNodeStarting
 }
 
+// AuctionSimulationApi returns nil and error
+func (inf *initialNodeFacade) AuctionSimulationApi(_ *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// ValidatorScorecardApi returns nil and error
+func (inf *initialNodeFacade) ValidatorScorecardApi(_ string, _ uint32) (*common.ValidatorScorecard, error) {
+	return nil, errNodeStarting
+}
+
 // SendBulkTransactions returns 0 and error
 func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction) (uint64, error) {
 	return uint64(0), errNodeStarting
@@ -181,6 +193,11 @@ func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transact
 	return nil, errNodeStarting
 }
 
+// ComputeTransactionCostWithGasBreakdown returns nil and error
+func (inf *initialNodeFacade) ComputeTransactionCostWithGasBreakdown(_ *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	return nil, errNodeStarting
+}
+
 // GetAccount returns nil and error
 func (inf *initialNodeFacade) GetAccount(_ string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	return api.AccountResponse{}, api.BlockInfo{}, errNodeStarting
@@ -236,6 +253,56 @@ func (inf *initialNodeFacade) IsSelfTrigger() bool {
 	return false
 }
 
+// AddManagedKey returns empty string and error
+func (inf *initialNodeFacade) AddManagedKey(_ string) (string, error) {
+	return "", errNodeStarting
+}
+
+// RemoveManagedKey returns error
+func (inf *initialNodeFacade) RemoveManagedKey(_ string) error {
+	return errNodeStarting
+}
+
+// ReloadManagedKeys returns nil and error
+func (inf *initialNodeFacade) ReloadManagedKeys() (*common.ManagedKeysReloadResult, error) {
+	return nil, errNodeStarting
+}
+
+// GetPeerReputationState returns nil and error
+func (inf *initialNodeFacade) GetPeerReputationState() (*common.PeerReputationState, error) {
+	return nil, errNodeStarting
+}
+
+// BanPeer returns error
+func (inf *initialNodeFacade) BanPeer(_ string, _ time.Duration) error {
+	return errNodeStarting
+}
+
+// UnbanPeer returns error
+func (inf *initialNodeFacade) UnbanPeer(_ string) error {
+	return errNodeStarting
+}
+
+// BanIPRange returns error
+func (inf *initialNodeFacade) BanIPRange(_ string, _ time.Duration) error {
+	return errNodeStarting
+}
+
+// UnbanIPRange returns error
+func (inf *initialNodeFacade) UnbanIPRange(_ string) error {
+	return errNodeStarting
+}
+
+// PinPeer returns error
+func (inf *initialNodeFacade) PinPeer(_ string) error {
+	return errNodeStarting
+}
+
+// UnpinPeer returns error
+func (inf *initialNodeFacade) UnpinPeer(_ string) error {
+	return errNodeStarting
+}
+
 // EncodeAddressPubkey returns empty string and error
 func (inf *initialNodeFacade) EncodeAddressPubkey(_ []byte) (string, error) {
 	return emptyString, errNodeStarting
@@ -361,6 +428,26 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, errNodeStarting
 }
 
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals(_ string) ([]*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVoter returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVoter(_ string) (*common.GovernanceVoterAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetRewardsBreakdown returns nil and error
+func (inf *initialNodeFacade) GetRewardsBreakdown(_ uint32, _ string) (*common.RewardsBreakdown, error) {
+	return nil, errNodeStarting
+}
+
 // GetESDTData returns nil and error
 func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64, _ api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
 	return nil, api.BlockInfo{}, errNodeStarting
@@ -381,6 +468,16 @@ func (inf *initialNodeFacade) GetTokenSupply(_ string) (*api.ESDTSupply, error)
 	return nil, errNodeStarting
 }
 
+// GetTokenHolders returns nil and error
+func (inf *initialNodeFacade) GetTokenHolders(_ string) (*common.ESDTHoldersAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTokenVolumes returns nil and error
+func (inf *initialNodeFacade) GetTokenVolumes(_ string, _ uint32, _ uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetGenesisNodesPubKeys returns nil and error
 func (inf *initialNodeFacade) GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error) {
 	return nil, nil, errNodeStarting
@@ -406,6 +503,16 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionLifecycle returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionLifecycle(_ string) (*common.TransactionLifecycleAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionProof returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionProof(_ string) (*receiptProof.ReceiptProof, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -416,11 +523,31 @@ func (inf *initialNodeFacade) GetGasConfigs() (map[string]map[string]uint64, err
 	return nil, errNodeStarting
 }
 
+// GetGasConfigsDiff returns nil and error
+func (inf *initialNodeFacade) GetGasConfigsDiff(_ uint32, _ uint32) (*common.GasScheduleDiffAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // IsDataTrieMigrated returns false and error
 func (inf *initialNodeFacade) IsDataTrieMigrated(_ string, _ api.AccountQueryOptions) (bool, error) {
 	return false, errNodeStarting
 }
 
+// GetAddressTransactions returns nil and error
+func (inf *initialNodeFacade) GetAddressTransactions(_ string, _ common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	return nil, errNodeStarting
+}
+
+// GetBalanceHistory returns nil and error
+func (inf *initialNodeFacade) GetBalanceHistory(_ string, _ common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	return nil, errNodeStarting
+}
+
+// QueryEvents returns nil and error
+func (inf *initialNodeFacade) QueryEvents(_ common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+	return nil, errNodeStarting
+}
+
 // GetManagedKeysCount returns 0
 func (inf *initialNodeFacade) GetManagedKeysCount() int {
 	return 0
@@ -99,6 +99,14 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, v2)
 	assert.Equal(t, errNodeStarting, err)
 
+	simulation, err := inf.AuctionSimulationApi(nil)
+	assert.Nil(t, simulation)
+	assert.Equal(t, errNodeStarting, err)
+
+	scorecard, err := inf.ValidatorScorecardApi("", 0)
+	assert.Nil(t, scorecard)
+	assert.Equal(t, errNodeStarting, err)
+
 	u1, err := inf.SendBulkTransactions(nil)
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
@@ -212,6 +220,22 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, ds)
 	assert.Equal(t, errNodeStarting, err)
 
+	gps, err := inf.GetGovernanceProposals("")
+	assert.Nil(t, gps)
+	assert.Equal(t, errNodeStarting, err)
+
+	gp, err := inf.GetGovernanceProposal(0)
+	assert.Nil(t, gp)
+	assert.Equal(t, errNodeStarting, err)
+
+	gv, err := inf.GetGovernanceVoter("")
+	assert.Nil(t, gv)
+	assert.Equal(t, errNodeStarting, err)
+
+	rb, err := inf.GetRewardsBreakdown(0, "")
+	assert.Nil(t, rb)
+	assert.Equal(t, errNodeStarting, err)
+
 	mssa, _, err := inf.GetESDTsRoles("", api.AccountQueryOptions{})
 	assert.Nil(t, mssa)
 	assert.Equal(t, errNodeStarting, err)
@@ -333,6 +357,14 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, txPoolGaps)
 	assert.Equal(t, errNodeStarting, err)
 
+	txLifecycle, err := inf.GetTransactionLifecycle("")
+	assert.Nil(t, txLifecycle)
+	assert.Equal(t, errNodeStarting, err)
+
+	txProof, err := inf.GetTransactionProof("")
+	assert.Nil(t, txProof)
+	assert.Equal(t, errNodeStarting, err)
+
 	count := inf.GetManagedKeysCount()
 	assert.Zero(t, count)
 
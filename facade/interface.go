@@ -3,6 +3,7 @@ package facade
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
@@ -17,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -62,6 +64,12 @@ type NodeHandler interface {
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 
+	// GetTokenHolders returns the number of holders and the top holders of the provided token from current shard
+	GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error)
+
+	// GetTokenVolumes returns the per-epoch volumes of the provided token from current shard
+	GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error)
+
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 
@@ -93,9 +101,22 @@ type NodeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
 	DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKeyHex string) error
+	ReloadManagedKeys() (*common.ManagedKeysReloadResult, error)
+	GetPeerReputationState() (*common.PeerReputationState, error)
+	BanPeer(peerID string, duration time.Duration) error
+	UnbanPeer(peerID string) error
+	BanIPRange(ipRange string, duration time.Duration) error
+	UnbanIPRange(ipRange string) error
+	PinPeer(peerID string) error
+	UnpinPeer(peerID string) error
+
 	EncodeAddressPubkey(pk []byte) (string, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 
@@ -109,6 +130,9 @@ type NodeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
+	QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -121,16 +145,23 @@ type TransactionSimulatorProcessor interface {
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
+	GetGovernanceProposals(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposal(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVoter(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error)
+	GetRewardsBreakdown(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error)
+	GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -147,6 +178,7 @@ type ApiResolver interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() map[string]map[string]uint64
+	GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetLoadedKeys() []string
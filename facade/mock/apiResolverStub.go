@@ -10,46 +10,55 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
-	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
-	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
-	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetAlteredAccountsForBlockCalled            func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
-	GetTransactionHandler                       func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMiniBlockCalled                  func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) ([]*state.ShardValidatorInfo, error)
-	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	GetGasConfigsCalled                         func() map[string]map[string]uint64
-	GetManagedKeysCountCalled                   func() int
-	GetManagedKeysCalled                        func() []string
-	GetLoadedKeysCalled                         func() []string
-	GetEligibleManagedKeysCalled                func() ([]string, error)
-	GetWaitingManagedKeysCalled                 func() ([]string, error)
-	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	ExecuteSCQueryHandler                        func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	StatusMetricsHandler                         func() external.StatusMetricsHandler
+	ComputeTransactionGasLimitHandler            func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdownCalled func(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
+	SimulateTransactionExecutionHandler          func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	GetTotalStakedValueHandler                   func(ctx context.Context) (*api.StakeValues, error)
+	GetDirectStakedListHandler                   func(ctx context.Context) ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                     func(ctx context.Context) ([]*api.Delegator, error)
+	GetGovernanceProposalsCalled                 func(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceProposalCalled                  func(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVoterCalled                     func(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error)
+	GetRewardsBreakdownCalled                    func(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error)
+	GetBlockByHashCalled                         func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled                        func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByRoundCalled                        func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetAlteredAccountsForBlockCalled             func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetTransactionHandler                        func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetInternalShardBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled            func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled             func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled            func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMiniBlockCalled                   func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled       func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) ([]*state.ShardValidatorInfo, error)
+	GetGenesisNodesPubKeysCalled                 func() (map[uint32][]string, map[uint32][]string)
+	GetTransactionsPoolCalled                    func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetGenesisBalancesCalled                     func() ([]*common.InitialAccountAPI, error)
+	GetTransactionsPoolForSenderCalled           func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled              func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled  func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycleCalled                func(txHash string) (*common.TransactionLifecycleAPIResponse, error)
+	GetTransactionProofCalled                    func(txHash string) (*receiptProof.ReceiptProof, error)
+	GetGasConfigsCalled                          func() map[string]map[string]uint64
+	GetGasConfigsDiffCalled                      func(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error)
+	GetManagedKeysCountCalled                    func() int
+	GetManagedKeysCalled                         func() []string
+	GetLoadedKeysCalled                          func() []string
+	GetEligibleManagedKeysCalled                 func() ([]string, error)
+	GetWaitingManagedKeysCalled                  func() ([]string, error)
+	GetWaitingEpochsLeftForPublicKeyCalled       func(publicKey string) (uint32, error)
 }
 
 // GetTransaction -
@@ -124,6 +133,15 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nil, nil
 }
 
+// ComputeTransactionCostWithGasBreakdown -
+func (ars *ApiResolverStub) ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	if ars.ComputeTransactionCostWithGasBreakdownCalled != nil {
+		return ars.ComputeTransactionCostWithGasBreakdownCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // SimulateTransactionExecution -
 func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if ars.SimulateTransactionExecutionHandler != nil {
@@ -159,6 +177,42 @@ func (ars *ApiResolverStub) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalsCalled != nil {
+		return ars.GetGovernanceProposalsCalled(ctx, status)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalCalled != nil {
+		return ars.GetGovernanceProposalCalled(ctx, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVoter -
+func (ars *ApiResolverStub) GetGovernanceVoter(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error) {
+	if ars.GetGovernanceVoterCalled != nil {
+		return ars.GetGovernanceVoterCalled(ctx, address)
+	}
+
+	return nil, nil
+}
+
+// GetRewardsBreakdown -
+func (ars *ApiResolverStub) GetRewardsBreakdown(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error) {
+	if ars.GetRewardsBreakdownCalled != nil {
+		return ars.GetRewardsBreakdownCalled(ctx, epoch, address)
+	}
+
+	return nil, nil
+}
+
 // GetInternalShardBlockByNonce -
 func (ars *ApiResolverStub) GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if ars.GetInternalShardBlockByNonceCalled != nil {
@@ -227,6 +281,24 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nil, nil
 }
 
+// GetTransactionLifecycle -
+func (ars *ApiResolverStub) GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+	if ars.GetTransactionLifecycleCalled != nil {
+		return ars.GetTransactionLifecycleCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionProof -
+func (ars *ApiResolverStub) GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error) {
+	if ars.GetTransactionProofCalled != nil {
+		return ars.GetTransactionProofCalled(txHash)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
@@ -285,6 +357,15 @@ func (ars *ApiResolverStub) GetGasConfigs() map[string]map[string]uint64 {
 	return nil
 }
 
+// GetGasConfigsDiff -
+func (ars *ApiResolverStub) GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+	if ars.GetGasConfigsDiffCalled != nil {
+		return ars.GetGasConfigsDiffCalled(fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // GetInternalStartOfEpochValidatorsInfo -
 func (ars *ApiResolverStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) ([]*state.ShardValidatorInfo, error) {
 	if ars.GetInternalStartOfEpochValidatorsInfoCalled != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -35,6 +36,16 @@ type NodeStub struct {
 	ValidatorStatisticsApiCalled                   func() (map[string]*validator.ValidatorStatistics, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
+	AddManagedKeyCalled                            func(privateKeyHex string) (string, error)
+	RemoveManagedKeyCalled                         func(publicKeyHex string) error
+	ReloadManagedKeysCalled                        func() (*common.ManagedKeysReloadResult, error)
+	GetPeerReputationStateCalled                   func() (*common.PeerReputationState, error)
+	BanPeerCalled                                  func(peerID string, duration time.Duration) error
+	UnbanPeerCalled                                func(peerID string) error
+	BanIPRangeCalled                               func(ipRange string, duration time.Duration) error
+	UnbanIPRangeCalled                             func(ipRange string) error
+	PinPeerCalled                                  func(peerID string) error
+	UnpinPeerCalled                                func(peerID string) error
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
@@ -54,8 +65,15 @@ type NodeStub struct {
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
+	GetTokenHoldersCalled                          func(token string) (*common.ESDTHoldersAPIResponse, error)
+	GetTokenVolumesCalled                          func(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
+	GetAddressTransactionsCalled                   func(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	GetBalanceHistoryCalled                        func(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
+	QueryEventsCalled                              func(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error)
 	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	AuctionSimulationApiCalled                     func(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	ValidatorScorecardApiCalled                    func(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
 }
 
 // GetProof -
@@ -235,6 +253,24 @@ func (ns *NodeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse,
 	return nil, nil
 }
 
+// AuctionSimulationApi -
+func (ns *NodeStub) AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	if ns.AuctionSimulationApiCalled != nil {
+		return ns.AuctionSimulationApiCalled(args)
+	}
+
+	return nil, nil
+}
+
+// ValidatorScorecardApi -
+func (ns *NodeStub) ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+	if ns.ValidatorScorecardApiCalled != nil {
+		return ns.ValidatorScorecardApiCalled(blsKey, numEpochs)
+	}
+
+	return nil, nil
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	if ns.DirectTriggerCalled != nil {
@@ -253,6 +289,96 @@ func (ns *NodeStub) IsSelfTrigger() bool {
 	return false
 }
 
+// AddManagedKey -
+func (ns *NodeStub) AddManagedKey(privateKeyHex string) (string, error) {
+	if ns.AddManagedKeyCalled != nil {
+		return ns.AddManagedKeyCalled(privateKeyHex)
+	}
+
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (ns *NodeStub) RemoveManagedKey(publicKeyHex string) error {
+	if ns.RemoveManagedKeyCalled != nil {
+		return ns.RemoveManagedKeyCalled(publicKeyHex)
+	}
+
+	return nil
+}
+
+// ReloadManagedKeys -
+func (ns *NodeStub) ReloadManagedKeys() (*common.ManagedKeysReloadResult, error) {
+	if ns.ReloadManagedKeysCalled != nil {
+		return ns.ReloadManagedKeysCalled()
+	}
+
+	return &common.ManagedKeysReloadResult{}, nil
+}
+
+// GetPeerReputationState -
+func (ns *NodeStub) GetPeerReputationState() (*common.PeerReputationState, error) {
+	if ns.GetPeerReputationStateCalled != nil {
+		return ns.GetPeerReputationStateCalled()
+	}
+
+	return &common.PeerReputationState{}, nil
+}
+
+// BanPeer -
+func (ns *NodeStub) BanPeer(peerID string, duration time.Duration) error {
+	if ns.BanPeerCalled != nil {
+		return ns.BanPeerCalled(peerID, duration)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (ns *NodeStub) UnbanPeer(peerID string) error {
+	if ns.UnbanPeerCalled != nil {
+		return ns.UnbanPeerCalled(peerID)
+	}
+
+	return nil
+}
+
+// BanIPRange -
+func (ns *NodeStub) BanIPRange(ipRange string, duration time.Duration) error {
+	if ns.BanIPRangeCalled != nil {
+		return ns.BanIPRangeCalled(ipRange, duration)
+	}
+
+	return nil
+}
+
+// UnbanIPRange -
+func (ns *NodeStub) UnbanIPRange(ipRange string) error {
+	if ns.UnbanIPRangeCalled != nil {
+		return ns.UnbanIPRangeCalled(ipRange)
+	}
+
+	return nil
+}
+
+// PinPeer -
+func (ns *NodeStub) PinPeer(peerID string) error {
+	if ns.PinPeerCalled != nil {
+		return ns.PinPeerCalled(peerID)
+	}
+
+	return nil
+}
+
+// UnpinPeer -
+func (ns *NodeStub) UnpinPeer(peerID string) error {
+	if ns.UnpinPeerCalled != nil {
+		return ns.UnpinPeerCalled(peerID)
+	}
+
+	return nil
+}
+
 // GetQueryHandler -
 func (ns *NodeStub) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	if ns.GetQueryHandlerCalled != nil {
@@ -333,6 +459,22 @@ func (ns *NodeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// GetTokenHolders -
+func (ns *NodeStub) GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error) {
+	if ns.GetTokenHoldersCalled != nil {
+		return ns.GetTokenHoldersCalled(token)
+	}
+	return nil, nil
+}
+
+// GetTokenVolumes -
+func (ns *NodeStub) GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+	if ns.GetTokenVolumesCalled != nil {
+		return ns.GetTokenVolumesCalled(token, fromEpoch, toEpoch)
+	}
+	return nil, nil
+}
+
 // GetAllIssuedESDTs -
 func (ns *NodeStub) GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error) {
 	if ns.GetAllIssuedESDTsCalled != nil {
@@ -349,6 +491,30 @@ func (ns *NodeStub) IsDataTrieMigrated(address string, options api.AccountQueryO
 	return false, nil
 }
 
+// GetAddressTransactions -
+func (ns *NodeStub) GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	if ns.GetAddressTransactionsCalled != nil {
+		return ns.GetAddressTransactionsCalled(address, options)
+	}
+	return nil, nil
+}
+
+// GetBalanceHistory -
+func (ns *NodeStub) GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	if ns.GetBalanceHistoryCalled != nil {
+		return ns.GetBalanceHistoryCalled(address, options)
+	}
+	return nil, nil
+}
+
+// QueryEvents -
+func (ns *NodeStub) QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+	if ns.QueryEventsCalled != nil {
+		return ns.QueryEventsCalled(options)
+	}
+	return nil, nil
+}
+
 // GetNFTTokenIDsRegisteredByAddress -
 func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error) {
 	if ns.GetNFTTokenIDsRegisteredByAddressCalled != nil {
@@ -27,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/ntp"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/state"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -247,6 +248,16 @@ func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error)
 	return nf.node.GetTokenSupply(token)
 }
 
+// GetTokenHolders returns the number of holders and the top holders of the provided token
+func (nf *nodeFacade) GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error) {
+	return nf.node.GetTokenHolders(token)
+}
+
+// GetTokenVolumes returns the minted, burned and transferred amounts of the provided token for each epoch of the interval
+func (nf *nodeFacade) GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+	return nf.node.GetTokenVolumes(token, fromEpoch, toEpoch)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -289,6 +300,16 @@ func (nf *nodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAPIRespons
 	return nf.node.AuctionListApi()
 }
 
+// AuctionSimulationApi will return the outcome of the auction list selection with the provided hypothetical changes
+func (nf *nodeFacade) AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	return nf.node.AuctionSimulationApi(args)
+}
+
+// ValidatorScorecardApi will return the performance history of the provided validator for the last ended epochs
+func (nf *nodeFacade) ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+	return nf.node.ValidatorScorecardApi(blsKey, numEpochs)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -299,6 +320,11 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.apiResolver.SimulateTransactionExecution(tx)
 }
 
+// ComputeTransactionCostWithGasBreakdown will estimate how many gas a transaction will consume and where the gas goes
+func (nf *nodeFacade) ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	return nf.apiResolver.ComputeTransactionCostWithGasBreakdown(tx)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.apiResolver.GetTransaction(hash, withResults)
@@ -329,6 +355,17 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender, accountResponse.Nonce)
 }
 
+// GetTransactionLifecycle will return the processing stages of the given transaction and of its results
+func (nf *nodeFacade) GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+	return nf.apiResolver.GetTransactionLifecycle(txHash)
+}
+
+// GetTransactionProof will return the proofs that the given transaction and its results were included in signed and
+// notarized blocks
+func (nf *nodeFacade) GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error) {
+	return nf.apiResolver.GetTransactionProof(txHash)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -434,6 +471,39 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList(ctx)
 }
 
+// GetGovernanceProposals will output the governance proposals having the provided status
+func (nf *nodeFacade) GetGovernanceProposals(status string) ([]*common.GovernanceProposalAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceProposals(ctx, status)
+}
+
+// GetGovernanceProposal will output the governance proposal with the provided nonce
+func (nf *nodeFacade) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceProposal(ctx, nonce)
+}
+
+// GetGovernanceVoter will output the governance voting power and votes of the provided address
+func (nf *nodeFacade) GetGovernanceVoter(address string) (*common.GovernanceVoterAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceVoter(ctx, address)
+}
+
+// GetRewardsBreakdown will output how the rewards paid at the start of the provided epoch were computed, optionally
+// filtered for a reward address
+func (nf *nodeFacade) GetRewardsBreakdown(epoch uint32, address string) (*common.RewardsBreakdown, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetRewardsBreakdown(ctx, epoch, address)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error) {
 	vmOutput, blockInfo, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -459,6 +529,56 @@ func (nf *nodeFacade) IsSelfTrigger() bool {
 	return nf.node.IsSelfTrigger()
 }
 
+// AddManagedKey will add the provided hex encoded private key to the set of keys managed by the node
+func (nf *nodeFacade) AddManagedKey(privateKeyHex string) (string, error) {
+	return nf.node.AddManagedKey(privateKeyHex)
+}
+
+// RemoveManagedKey will remove the provided hex encoded public key from the set of keys managed by the node
+func (nf *nodeFacade) RemoveManagedKey(publicKeyHex string) error {
+	return nf.node.RemoveManagedKey(publicKeyHex)
+}
+
+// ReloadManagedKeys will apply the changes made in the all validators keys file
+func (nf *nodeFacade) ReloadManagedKeys() (*common.ManagedKeysReloadResult, error) {
+	return nf.node.ReloadManagedKeys()
+}
+
+// GetPeerReputationState returns the banned peers, public keys and IP ranges, the pinned peers and the honesty scores
+func (nf *nodeFacade) GetPeerReputationState() (*common.PeerReputationState, error) {
+	return nf.node.GetPeerReputationState()
+}
+
+// BanPeer will ban the provided peer ID for the given duration. A zero duration means a permanent ban
+func (nf *nodeFacade) BanPeer(peerID string, duration time.Duration) error {
+	return nf.node.BanPeer(peerID, duration)
+}
+
+// UnbanPeer will lift the ban of the provided peer ID
+func (nf *nodeFacade) UnbanPeer(peerID string) error {
+	return nf.node.UnbanPeer(peerID)
+}
+
+// BanIPRange will ban the provided IP range for the given duration. A zero duration means a permanent ban
+func (nf *nodeFacade) BanIPRange(ipRange string, duration time.Duration) error {
+	return nf.node.BanIPRange(ipRange, duration)
+}
+
+// UnbanIPRange will lift the ban of the provided IP range
+func (nf *nodeFacade) UnbanIPRange(ipRange string) error {
+	return nf.node.UnbanIPRange(ipRange)
+}
+
+// PinPeer will mark the provided peer ID as trusted, so it will never be denied
+func (nf *nodeFacade) PinPeer(peerID string) error {
+	return nf.node.PinPeer(peerID)
+}
+
+// UnpinPeer will remove the provided peer ID from the trusted peers
+func (nf *nodeFacade) UnpinPeer(peerID string) error {
+	return nf.node.UnpinPeer(peerID)
+}
+
 // EncodeAddressPubkey will encode the provided address public key bytes to string
 func (nf *nodeFacade) EncodeAddressPubkey(pk []byte) (string, error) {
 	return nf.node.EncodeAddressPubkey(pk)
@@ -608,6 +728,21 @@ func (nf *nodeFacade) IsDataTrieMigrated(address string, options apiData.Account
 	return nf.node.IsDataTrieMigrated(address, options)
 }
 
+// GetAddressTransactions returns the indexed transactions of the given address
+func (nf *nodeFacade) GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	return nf.node.GetAddressTransactions(address, options)
+}
+
+// GetBalanceHistory returns the recorded balance changes of the given address
+func (nf *nodeFacade) GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	return nf.node.GetBalanceHistory(address, options)
+}
+
+// QueryEvents returns the indexed log events matching the provided options
+func (nf *nodeFacade) QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+	return nf.node.QueryEvents(options)
+}
+
 // GetManagedKeysCount returns the number of managed keys when node is running in multikey mode
 func (nf *nodeFacade) GetManagedKeysCount() int {
 	return nf.apiResolver.GetManagedKeysCount()
@@ -767,6 +902,11 @@ func (nf *nodeFacade) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return gasConfigs, nil
 }
 
+// GetGasConfigsDiff will return the gas schedule entries which differ between the provided epochs
+func (nf *nodeFacade) GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+	return nf.apiResolver.GetGasConfigsDiff(fromEpoch, toEpoch)
+}
+
 // P2PPrometheusMetricsEnabled returns if p2p prometheus metrics should be enabled or not on the application
 func (nf *nodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return nf.config.P2PPrometheusMetricsEnabled
@@ -34,6 +34,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
+	"github.com/multiversx/mx-chain-go/receiptProof/builder"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/blockInfoProviders"
@@ -211,6 +212,32 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	governanceHandler, err := trieIteratorsFactory.CreateGovernanceHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsStorer, err := args.DataComponents.StorageService().GetStorer(dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	// the system SCs config is only needed by the metachain rewards breakdown processor
+	maxServiceFee := uint64(0)
+	if args.Configs.SystemSCConfig != nil {
+		maxServiceFee = args.Configs.SystemSCConfig.DelegationSystemSCConfig.MaxServiceFee
+	}
+	argsRewardsBreakdown := trieIterators.ArgRewardsBreakdownProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		RewardsStorer:            rewardsStorer,
+		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
+		MaxServiceFee:            maxServiceFee,
+	}
+	rewardsBreakdownHandler, err := trieIteratorsFactory.CreateRewardsBreakdownHandler(argsRewardsBreakdown)
+	if err != nil {
+		return nil, err
+	}
+
 	feeComputer, err := fee.NewFeeComputer(args.CoreComponents.EconomicsData())
 	if err != nil {
 		return nil, err
@@ -260,6 +287,17 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsProofBuilder := builder.ArgsProofBuilder{
+		Marshaller:        args.CoreComponents.InternalMarshalizer(),
+		HistoryRepository: args.ProcessComponents.HistoryRepository(),
+		StorageService:    args.DataComponents.StorageService(),
+		ShardCoordinator:  args.ProcessComponents.ShardCoordinator(),
+	}
+	receiptProofBuilder, err := builder.NewProofBuilder(argsProofBuilder)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
@@ -267,6 +305,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		GovernanceHandler:        governanceHandler,
+		RewardsBreakdownHandler:  rewardsBreakdownHandler,
 		APITransactionHandler:    apiTransactionProcessor,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -278,6 +318,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		PublicKey:                args.CryptoComponents.PublicKeyString(),
 		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
 		StorageManagers:          storageManagers,
+		ReceiptProofBuilder:      receiptProofBuilder,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -131,6 +131,7 @@ func (bcf *bootstrapComponentsFactory) Create() (*bootstrapComponents, error) {
 	headerIntegrityVerifier, err := headerCheck.NewHeaderIntegrityVerifier(
 		[]byte(bcf.coreComponents.ChainID()),
 		headerVersionHandler,
+		bcf.coreComponents.EnableEpochsHandler(),
 	)
 	if err != nil {
 		return nil, err
@@ -204,6 +204,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		AppStatusHandler:         ccf.statusCoreComponents.AppStatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
 		PeerBlacklistHandler:     cc.peerBlacklistHandler,
+		PriorityLanes:            ccf.processComponents.InterceptorsPriorityLanes(),
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -3,7 +3,9 @@ package crypto
 import (
 	"bytes"
 	"encoding/hex"
+	errorsGo "errors"
 	"fmt"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,6 +28,7 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/peerSignatureHandler"
 	"github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -99,6 +102,7 @@ type cryptoComponents struct {
 	consensusSigningHandler consensus.SigningHandler
 	managedPeersHolder      common.ManagedPeersHolder
 	keysHandler             consensus.KeysHandler
+	managedKeysReloader     common.ManagedKeysReloader
 	cryptoParams
 	p2pCryptoParams
 }
@@ -239,6 +243,21 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	argsManagedKeysReloader := keysManagement.ArgsManagedKeysReloader{
+		ManagedPeersHolder:    managedPeersHolder,
+		ConsensusKeysChecker:  keysHandler,
+		KeyLoader:             ccf.keyLoader,
+		KeyGenerator:          blockSignKeyGen,
+		PubKeyConverter:       ccf.validatorPubKeyConverter,
+		AllValidatorsKeysFile: ccf.allValidatorKeysPemFileName,
+		FileWatcherEnabled:    ccf.config.ManagedKeysReload.FileWatcherEnabled,
+		FileWatchInterval:     time.Duration(ccf.config.ManagedKeysReload.FileWatcherIntervalInSeconds) * time.Second,
+	}
+	managedKeysReloader, err := keysManagement.NewManagedKeysReloader(argsManagedKeysReloader)
+	if err != nil {
+		return nil, err
+	}
+
 	signingHandlerArgs := ArgsSigningHandler{
 		PubKeys:              []string{cp.publicKeyString},
 		MultiSignerContainer: multiSigner,
@@ -248,6 +267,7 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 	}
 	consensusSigningHandler, err := NewSigningHandler(signingHandlerArgs)
 	if err != nil {
+		_ = managedKeysReloader.Close()
 		return nil, err
 	}
 
@@ -263,6 +283,7 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		consensusSigningHandler: consensusSigningHandler,
 		managedPeersHolder:      managedPeersHolder,
 		keysHandler:             keysHandler,
+		managedKeysReloader:     managedKeysReloader,
 		cryptoParams:            *cp,
 		p2pCryptoParams:         *p2pCryptoParamsInstance,
 		p2pSingleSigner:         p2pSingleSigner,
@@ -342,6 +363,10 @@ func (ccf *cryptoComponentsFactory) createCryptoParams(
 		return cp, nil
 	}
 
+	if errorsGo.Is(err, errors.ErrCannotLoadValidatorKey) {
+		return nil, err
+	}
+
 	log.Debug("failure while reading the BLS key, will autogenerate one", "error", err)
 
 	return ccf.generateCryptoParams(keygen, handledKeysInfo, handledPrivateKeys)
@@ -405,6 +430,12 @@ func (ccf *cryptoComponentsFactory) generateCryptoParams(
 
 func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
 	encodedSk, pkString, err := ccf.keyLoader.LoadKey(ccf.validatorKeyPemFileName, ccf.skIndex)
+	var keystoreErr *keystore.KeystoreError
+	if errorsGo.As(err, &keystoreErr) {
+		// a keystore that can not be decrypted must not be silently replaced by an autogenerated key as the node
+		// would stop validating
+		return nil, nil, fmt.Errorf("%w from %s: %w", errors.ErrCannotLoadValidatorKey, ccf.validatorKeyPemFileName, err)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -468,6 +499,10 @@ func CreateP2pKeyPair(
 
 func (ccf *cryptoComponentsFactory) processAllHandledKeys(keygen crypto.KeyGenerator) ([][]byte, error) {
 	privateKeys, publicKeys, err := ccf.keyLoader.LoadAllKeys(ccf.allValidatorKeysPemFileName)
+	var keystoreErr *keystore.KeystoreError
+	if errorsGo.As(err, &keystoreErr) {
+		return nil, err
+	}
 	if err != nil {
 		log.Debug("allValidatorsKeys could not be loaded", "reason", err)
 		return make([][]byte, 0), nil
@@ -527,5 +562,9 @@ func (ccf *cryptoComponentsFactory) processPrivatePublicKey(keygen crypto.KeyGen
 
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
+	if !check.IfNil(cc.managedKeysReloader) {
+		return cc.managedKeysReloader.Close()
+	}
+
 	return nil
 }
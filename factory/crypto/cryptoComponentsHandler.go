@@ -371,6 +371,18 @@ func (mcc *managedCryptoComponents) KeysHandler() consensus.KeysHandler {
 	return mcc.cryptoComponents.keysHandler
 }
 
+// ManagedKeysReloader returns the component able to change the managed keys while the node is running
+func (mcc *managedCryptoComponents) ManagedKeysReloader() common.ManagedKeysReloader {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.managedKeysReloader
+}
+
 // Clone creates a shallow clone of a managedCryptoComponents
 func (mcc *managedCryptoComponents) Clone() interface{} {
 	cryptoComp := (*cryptoComponents)(nil)
@@ -388,6 +400,7 @@ func (mcc *managedCryptoComponents) Clone() interface{} {
 			consensusSigningHandler: mcc.ConsensusSigningHandler(),
 			managedPeersHolder:      mcc.ManagedPeersHolder(),
 			keysHandler:             mcc.KeysHandler(),
+			managedKeysReloader:     mcc.ManagedKeysReloader(),
 			cryptoParams:            mcc.cryptoParams,
 			p2pCryptoParams:         mcc.p2pCryptoParams,
 		}
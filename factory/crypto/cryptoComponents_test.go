@@ -3,6 +3,8 @@ package crypto_test
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/multiversx/mx-chain-crypto-go/signing"
@@ -11,6 +13,7 @@ import (
 	cryptoComp "github.com/multiversx/mx-chain-go/factory/crypto"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	integrationTestsMock "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -301,6 +304,72 @@ func TestCryptoComponentsFactory_CreateCryptoParamsLoadKeysFailShouldNotError(t
 	require.Nil(t, err)
 }
 
+func TestCryptoComponentsFactory_CreateCryptoParamsKeystoreErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := componentsMock.GetCoreComponents()
+	args := componentsMock.GetCryptoArgs(coreComponents)
+	args.KeyLoader = &mock.KeyLoaderStub{
+		LoadKeyCalled: componentsMock.DummyLoadSkPkFromPemFile([]byte{}, "", &keystore.KeystoreError{
+			Path: "validatorKey.pem",
+			Err:  keystore.ErrDecryptionFailed,
+		}),
+	}
+	ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+	suite, _ := ccf.GetSuite()
+	blockSignKeyGen := signing.NewKeyGenerator(suite)
+
+	cryptoParams, err := ccf.CreateCryptoParams(blockSignKeyGen)
+	require.Nil(t, cryptoParams)
+	require.True(t, errors.Is(err, keystore.ErrDecryptionFailed))
+	require.True(t, errors.Is(err, errErd.ErrCannotLoadValidatorKey))
+
+	var keystoreErr *keystore.KeystoreError
+	require.True(t, errors.As(err, &keystoreErr))
+}
+
+func TestCryptoComponentsFactory_CreateCryptoParamsMissingKeyFileShouldNotError(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := componentsMock.GetCoreComponents()
+	args := componentsMock.GetCryptoArgs(coreComponents)
+	args.KeyLoader = &mock.KeyLoaderStub{
+		LoadKeyCalled: componentsMock.DummyLoadSkPkFromPemFile([]byte{}, "", fmt.Errorf("%w while opening the file", os.ErrNotExist)),
+	}
+	ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+	suite, _ := ccf.GetSuite()
+	blockSignKeyGen := signing.NewKeyGenerator(suite)
+
+	cryptoParams, err := ccf.CreateCryptoParams(blockSignKeyGen)
+	require.NotNil(t, cryptoParams)
+	require.Nil(t, err)
+}
+
+func TestCryptoComponentsFactory_CreateCryptoParamsAllKeysKeystoreErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := componentsMock.GetCoreComponents()
+	args := componentsMock.GetCryptoArgs(coreComponents)
+	args.KeyLoader = &mock.KeyLoaderStub{
+		LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+			return nil, nil, &keystore.KeystoreError{
+				Path: path,
+				Err:  keystore.ErrNoPassphraseSource,
+			}
+		},
+	}
+	ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+	suite, _ := ccf.GetSuite()
+	blockSignKeyGen := signing.NewKeyGenerator(suite)
+
+	cryptoParams, err := ccf.CreateCryptoParams(blockSignKeyGen)
+	require.Nil(t, cryptoParams)
+	require.True(t, errors.Is(err, keystore.ErrNoPassphraseSource))
+}
+
 func TestCryptoComponentsFactory_CreateCryptoParamsOK(t *testing.T) {
 	t.Parallel()
 
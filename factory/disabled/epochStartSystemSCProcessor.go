@@ -3,6 +3,7 @@ package disabled
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -36,6 +37,11 @@ func (e *epochStartSystemSCProcessor) ProcessDelegationRewards(
 	return nil
 }
 
+// ParameterChanges returns an empty slice
+func (e *epochStartSystemSCProcessor) ParameterChanges() ([]*governance.ParameterChange, error) {
+	return make([]*governance.ParameterChange, 0), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (e *epochStartSystemSCProcessor) IsInterfaceNil() bool {
 	return e == nil
@@ -0,0 +1,19 @@
+package disabled
+
+type managedPeersHolder struct {
+}
+
+// NewManagedPeersHolder returns a new instance of managedPeersHolder
+func NewManagedPeersHolder() *managedPeersHolder {
+	return &managedPeersHolder{}
+}
+
+// IsMultiKeyMode returns false as this is a disabled component
+func (holder *managedPeersHolder) IsMultiKeyMode() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
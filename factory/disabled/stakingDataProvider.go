@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"math/big"
+
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -28,6 +30,11 @@ func (s *stakingDataProvider) GetOwnersData() map[string]*epochStart.OwnerData {
 	return nil
 }
 
+// SimulateOwnerAuctionChanges returns a nil error
+func (s *stakingDataProvider) SimulateOwnerAuctionChanges(_ []byte, _ *big.Int, _ []state.ValidatorInfoHandler) error {
+	return nil
+}
+
 // Clean does nothing
 func (s *stakingDataProvider) Clean() {
 }
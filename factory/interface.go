@@ -65,10 +65,14 @@ type P2PAntifloodHandler interface {
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
 	SetDebugger(debugger process.AntifloodDebugger) error
 	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
+	SetPeerHonestyScorer(scorer process.PeerHonestyScorer) error
 	SetTopicsForAll(topics ...string)
 	ApplyConsensusSize(size int)
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error
+	StartProcessingOnTopic(topic string)
+	ThrottledOnTopic(topic string)
+	EndProcessingOnTopic(topic string, processingTime time.Duration)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -188,6 +192,7 @@ type CryptoComponentsHolder interface {
 	ConsensusSigningHandler() consensus.SigningHandler
 	ManagedPeersHolder() common.ManagedPeersHolder
 	KeysHandler() consensus.KeysHandler
+	ManagedKeysReloader() common.ManagedKeysReloader
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
@@ -234,6 +239,7 @@ type DataComponentsHandler interface {
 // participating in consensus
 type PeerHonestyHandler interface {
 	ChangeScore(pk string, topic string, units int)
+	GetScore(pk string) float64
 	IsInterfaceNil() bool
 	Close() error
 }
@@ -246,6 +252,7 @@ type NetworkComponentsHolder interface {
 	PubKeyCacher() process.TimeCacher
 	PeerBlackListHandler() process.PeerBlackListCacher
 	PeerHonestyHandler() PeerHonestyHandler
+	PeerReputationHandler() process.PeerReputationHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	PeersRatingHandler() p2p.PeersRatingHandler
 	PeersRatingMonitor() p2p.PeersRatingMonitor
@@ -264,6 +271,7 @@ type NetworkComponentsHandler interface {
 type TransactionEvaluator interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
 	IsInterfaceNil() bool
 }
 
@@ -311,6 +319,7 @@ type ProcessComponentsHolder interface {
 	ReceiptsRepository() ReceiptsRepository
 	SentSignaturesTracker() process.SentSignaturesTracker
 	EpochSystemSCProcessor() process.EpochStartSystemSCProcessor
+	InterceptorsPriorityLanes() process.InterceptorPriorityLanesHandler
 	IsInterfaceNil() bool
 }
 
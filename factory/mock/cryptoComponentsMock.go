@@ -13,25 +13,26 @@ import (
 
 // CryptoComponentsMock -
 type CryptoComponentsMock struct {
-	PubKey                  crypto.PublicKey
-	PrivKey                 crypto.PrivateKey
-	P2pPubKey               crypto.PublicKey
-	P2pPrivKey              crypto.PrivateKey
-	P2pSig                  crypto.SingleSigner
-	PubKeyString            string
-	PubKeyBytes             []byte
-	BlockSig                crypto.SingleSigner
-	TxSig                   crypto.SingleSigner
-	MultiSigContainer       cryptoCommon.MultiSignerContainer
-	PeerSignHandler         crypto.PeerSignatureHandler
-	BlKeyGen                crypto.KeyGenerator
-	TxKeyGen                crypto.KeyGenerator
-	P2PKeyGen               crypto.KeyGenerator
-	MsgSigVerifier          vm.MessageSignVerifier
-	SigHandler              consensus.SigningHandler
-	ManagedPeersHolderField common.ManagedPeersHolder
-	KeysHandlerField        consensus.KeysHandler
-	mutMultiSig             sync.RWMutex
+	PubKey                   crypto.PublicKey
+	PrivKey                  crypto.PrivateKey
+	P2pPubKey                crypto.PublicKey
+	P2pPrivKey               crypto.PrivateKey
+	P2pSig                   crypto.SingleSigner
+	PubKeyString             string
+	PubKeyBytes              []byte
+	BlockSig                 crypto.SingleSigner
+	TxSig                    crypto.SingleSigner
+	MultiSigContainer        cryptoCommon.MultiSignerContainer
+	PeerSignHandler          crypto.PeerSignatureHandler
+	BlKeyGen                 crypto.KeyGenerator
+	TxKeyGen                 crypto.KeyGenerator
+	P2PKeyGen                crypto.KeyGenerator
+	MsgSigVerifier           vm.MessageSignVerifier
+	SigHandler               consensus.SigningHandler
+	ManagedPeersHolderField  common.ManagedPeersHolder
+	KeysHandlerField         consensus.KeysHandler
+	ManagedKeysReloaderField common.ManagedKeysReloader
+	mutMultiSig              sync.RWMutex
 }
 
 // PublicKey -
@@ -151,24 +152,30 @@ func (ccm *CryptoComponentsMock) KeysHandler() consensus.KeysHandler {
 	return ccm.KeysHandlerField
 }
 
+// ManagedKeysReloader -
+func (ccm *CryptoComponentsMock) ManagedKeysReloader() common.ManagedKeysReloader {
+	return ccm.ManagedKeysReloaderField
+}
+
 // Clone -
 func (ccm *CryptoComponentsMock) Clone() interface{} {
 	return &CryptoComponentsMock{
-		PubKey:                  ccm.PubKey,
-		PrivKey:                 ccm.PrivKey,
-		PubKeyString:            ccm.PubKeyString,
-		PubKeyBytes:             ccm.PubKeyBytes,
-		BlockSig:                ccm.BlockSig,
-		TxSig:                   ccm.TxSig,
-		MultiSigContainer:       ccm.MultiSigContainer,
-		PeerSignHandler:         ccm.PeerSignHandler,
-		BlKeyGen:                ccm.BlKeyGen,
-		TxKeyGen:                ccm.TxKeyGen,
-		P2PKeyGen:               ccm.P2PKeyGen,
-		MsgSigVerifier:          ccm.MsgSigVerifier,
-		ManagedPeersHolderField: ccm.ManagedPeersHolderField,
-		KeysHandlerField:        ccm.KeysHandlerField,
-		mutMultiSig:             sync.RWMutex{},
+		PubKey:                   ccm.PubKey,
+		PrivKey:                  ccm.PrivKey,
+		PubKeyString:             ccm.PubKeyString,
+		PubKeyBytes:              ccm.PubKeyBytes,
+		BlockSig:                 ccm.BlockSig,
+		TxSig:                    ccm.TxSig,
+		MultiSigContainer:        ccm.MultiSigContainer,
+		PeerSignHandler:          ccm.PeerSignHandler,
+		BlKeyGen:                 ccm.BlKeyGen,
+		TxKeyGen:                 ccm.TxKeyGen,
+		P2PKeyGen:                ccm.P2PKeyGen,
+		MsgSigVerifier:           ccm.MsgSigVerifier,
+		ManagedPeersHolderField:  ccm.ManagedPeersHolderField,
+		KeysHandlerField:         ccm.KeysHandlerField,
+		ManagedKeysReloaderField: ccm.ManagedKeysReloaderField,
+		mutMultiSig:              sync.RWMutex{},
 	}
 }
 
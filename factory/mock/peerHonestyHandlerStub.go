@@ -3,6 +3,7 @@ package mock
 // PeerHonestyHandlerStub -
 type PeerHonestyHandlerStub struct {
 	ChangeScoreCalled func(pk string, topic string, units int)
+	GetScoreCalled    func(pk string) float64
 }
 
 // ChangeScore -
@@ -12,6 +13,15 @@ func (phhs *PeerHonestyHandlerStub) ChangeScore(pk string, topic string, units i
 	}
 }
 
+// GetScore -
+func (phhs *PeerHonestyHandlerStub) GetScore(pk string) float64 {
+	if phhs.GetScoreCalled != nil {
+		return phhs.GetScoreCalled(pk)
+	}
+
+	return 0
+}
+
 // Close -
 func (phhs *PeerHonestyHandlerStub) Close() error {
 	return nil
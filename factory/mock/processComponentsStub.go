@@ -58,6 +58,7 @@ type ProcessComponentsMock struct {
 	ReceiptsRepositoryInternal           factory.ReceiptsRepository
 	SentSignaturesTrackerInternal        process.SentSignaturesTracker
 	EpochSystemSCProcessorInternal       process.EpochStartSystemSCProcessor
+	InterceptorsPriorityLanesInternal    process.InterceptorPriorityLanesHandler
 }
 
 // Create -
@@ -290,6 +291,11 @@ func (pcm *ProcessComponentsMock) EpochSystemSCProcessor() process.EpochStartSys
 	return pcm.EpochSystemSCProcessorInternal
 }
 
+// InterceptorsPriorityLanes -
+func (pcm *ProcessComponentsMock) InterceptorsPriorityLanes() process.InterceptorPriorityLanesHandler {
+	return pcm.InterceptorsPriorityLanesInternal
+}
+
 // IsInterfaceNil -
 func (pcm *ProcessComponentsMock) IsInterfaceNil() bool {
 	return pcm == nil
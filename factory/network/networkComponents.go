@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -21,7 +22,10 @@ import (
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/rating/peerHonesty"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	peerReputationDisabled "github.com/multiversx/mx-chain-go/process/rating/peerReputation/disabled"
 	antifloodFactory "github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -42,6 +46,7 @@ type NetworkComponentsFactoryArgs struct {
 	NodeOperationMode     common.NodeOperation
 	ConnectionWatcherType string
 	CryptoComponents      factory.CryptoComponentsHolder
+	PathManager           storage.PathManagerHandler
 }
 
 type networkComponentsFactory struct {
@@ -57,6 +62,7 @@ type networkComponentsFactory struct {
 	nodeOperationMode     common.NodeOperation
 	connectionWatcherType string
 	cryptoComponents      factory.CryptoComponentsHolder
+	pathManager           storage.PathManagerHandler
 }
 
 type networkComponentsHolder struct {
@@ -78,6 +84,7 @@ type networkComponents struct {
 	peerBlackListHandler     process.PeerBlackListCacher
 	antifloodConfig          config.AntifloodConfig
 	peerHonestyHandler       consensus.PeerHonestyHandler
+	peerReputationHandler    process.PeerReputationHandler
 	closeFunc                context.CancelFunc
 }
 
@@ -102,6 +109,9 @@ func NewNetworkComponentsFactory(
 	if args.NodeOperationMode != common.NormalOperation && args.NodeOperationMode != common.FullArchiveMode {
 		return nil, errors.ErrInvalidNodeOperationMode
 	}
+	if args.MainConfig.PeerReputation.Enabled && check.IfNil(args.PathManager) {
+		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", errors.ErrNilPathHandler)
+	}
 
 	return &networkComponentsFactory{
 		mainP2PConfig:         args.MainP2pConfig,
@@ -116,6 +126,7 @@ func NewNetworkComponentsFactory(
 		nodeOperationMode:     args.NodeOperationMode,
 		connectionWatcherType: args.ConnectionWatcherType,
 		cryptoComponents:      args.CryptoComponents,
+		pathManager:           args.PathManager,
 	}, nil
 }
 
@@ -143,7 +154,23 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		}
 	}()
 
-	antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, err := ncf.createAntifloodComponents(ctx, mainNetworkComp.netMessenger.ID())
+	peerReputationHandler, peerBlackList, publicKeysCache, err := ncf.createPeerReputationHandler()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			log.LogIfError(peerReputationHandler.Close())
+		}
+	}()
+
+	antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, err := ncf.createAntifloodComponents(
+		ctx,
+		mainNetworkComp.netMessenger.ID(),
+		peerReputationHandler,
+		peerBlackList,
+		publicKeysCache,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -173,16 +200,73 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerBlackListHandler:     antiFloodComponents.BlacklistHandler,
 		antifloodConfig:          ncf.mainConfig.Antiflood,
 		peerHonestyHandler:       peerHonestyHandler,
+		peerReputationHandler:    peerReputationHandler,
 		closeFunc:                cancelFunc,
 	}, nil
 }
 
+// createPeerReputationHandler returns the peer reputation handler along with the peer IDs and public keys blacklists
+func (ncf *networkComponentsFactory) createPeerReputationHandler() (process.PeerReputationHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
+	reputationConfig := ncf.mainConfig.PeerReputation
+	if !reputationConfig.Enabled {
+		peerBlackList, err := cache.NewPeerTimeCache(cache.NewTimeCache(antifloodFactory.DefaultSpan))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return peerReputationDisabled.NewPeerReputationHandler(), peerBlackList, cache.NewTimeCache(antifloodFactory.DefaultSpan), nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(reputationConfig.Storage.DB)
+	dbConfig.FilePath = filepath.Join(ncf.pathManager.DatabasePath(), reputationConfig.Storage.DB.FilePath)
+	dbConfigHandler := storageFactory.NewDBConfigHandler(reputationConfig.Storage.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(reputationConfig.Storage.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w while creating the peer reputation storer", err)
+	}
+
+	args := peerReputation.ArgsPeerReputationStore{
+		Storer:            storer,
+		Marshaller:        ncf.marshalizer,
+		PeerHonestyConfig: ncf.ratingsConfig.PeerHonesty,
+		DefaultSpan:       antifloodFactory.DefaultSpan,
+		PersistInterval:   time.Duration(reputationConfig.PersistIntervalInSeconds) * time.Second,
+	}
+
+	store, err := peerReputation.NewPeerReputationStore(args)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, nil, nil, err
+	}
+
+	return store, store.PeerBlackListCacher(), store.PublicKeysCacher(), nil
+}
+
 func (ncf *networkComponentsFactory) createAntifloodComponents(
 	ctx context.Context,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
+	peerBlackList process.PeerBlackListCacher,
+	publicKeysCache process.TimeCacher,
 ) (*antifloodFactory.AntiFloodComponents, factory.P2PAntifloodHandler, factory.P2PAntifloodHandler, consensus.PeerHonestyHandler, error) {
 	var antiFloodComponents *antifloodFactory.AntiFloodComponents
-	antiFloodComponents, err := antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, currentPid)
+	antiFloodComponents, err := antifloodFactory.NewP2PAntiFloodComponentsWithCachers(
+		ctx,
+		ncf.mainConfig,
+		ncf.statusHandler,
+		currentPid,
+		peerBlackList,
+		publicKeysCache,
+	)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -210,11 +294,17 @@ func (ncf *networkComponentsFactory) createAntifloodComponents(
 		&ncf.mainConfig,
 		ncf.ratingsConfig,
 		antiFloodComponents.PubKeysCacher,
+		peerReputationHandler,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
+	err = inputAntifloodHandler.SetPeerHonestyScorer(peerHonestyHandler)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	return antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, nil
 }
 
@@ -222,6 +312,7 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
 	pkTimeCache process.TimeCacher,
+	peerReputationHandler process.PeerReputationHandler,
 ) (consensus.PeerHonestyHandler, error) {
 
 	suCache, err := storageunit.NewCache(storageFactory.GetCacherFromConfig(config.PeerHonesty))
@@ -229,7 +320,17 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 		return nil, err
 	}
 
-	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache)
+	peerHonestyHandler, err := peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache)
+	if err != nil {
+		return nil, err
+	}
+
+	err = peerReputationHandler.SetPeerHonestyHandler(peerHonestyHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return peerHonestyHandler, nil
 }
 
 func (ncf *networkComponentsFactory) createNetworkHolder(
@@ -338,6 +439,9 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peerReputationHandler) {
+		log.LogIfError(nc.peerReputationHandler.Close())
+	}
 
 	mainNetMessenger := nc.mainNetworkHolder.netMessenger
 	if !check.IfNil(mainNetMessenger) {
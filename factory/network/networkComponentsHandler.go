@@ -105,6 +105,9 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.peerReputationHandler) {
+		return errors.ErrNilPeerReputationHandler
+	}
 
 	return nil
 }
@@ -157,6 +160,18 @@ func (mnc *managedNetworkComponents) PubKeyCacher() process.TimeCacher {
 	return mnc.pubKeyTimeCacher
 }
 
+// PeerReputationHandler returns the peer reputation handler
+func (mnc *managedNetworkComponents) PeerReputationHandler() process.PeerReputationHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.peerReputationHandler
+}
+
 // PeerBlackListHandler returns the blacklist handler
 func (mnc *managedNetworkComponents) PeerBlackListHandler() process.PeerBlackListCacher {
 	mnc.mutNetworkComponents.RLock()
@@ -30,6 +30,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory/shard"
 	"github.com/multiversx/mx-chain-go/process/rewardTransaction"
 	"github.com/multiversx/mx-chain-go/process/scToProtocol"
+	"github.com/multiversx/mx-chain-go/process/scheduledCalls"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
@@ -159,6 +160,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		pcf.config.SmartContractsStorage,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -761,6 +763,21 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	argsScheduledCalls := scheduledCalls.ArgsScheduledCallsProcessor{
+		SystemVM:            systemVM,
+		Accounts:            pcf.state.AccountsAdapter(),
+		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
+		TxCoordinator:       txCoordinator,
+		FeeHandler:          txFeeHandler,
+		Hasher:              pcf.coreData.Hasher(),
+		Marshalizer:         pcf.coreData.InternalMarshalizer(),
+		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
+	}
+	scheduledCallsProcessor, err := scheduledCalls.NewScheduledCallsProcessor(argsScheduledCalls)
+	if err != nil {
+		return nil, err
+	}
+
 	argsStakingDataProvider := metachainEpochStart.StakingDataProviderArgs{
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		SystemVM:            systemVM,
@@ -959,16 +976,27 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	argsStatusChangesCollector := metachainEpochStart.ArgsValidatorsStatusChangesCollector{
+		StakingDataProvider:    stakingDataProvider,
+		AddressPubKeyConverter: pcf.coreData.AddressPubKeyConverter(),
+	}
+	statusChangesCollector, err := metachainEpochStart.NewValidatorsStatusChangesCollector(argsStatusChangesCollector)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := block.ArgMetaProcessor{
-		ArgBaseProcessor:             argumentsBaseProcessor,
-		SCToProtocol:                 smartContractToProtocol,
-		PendingMiniBlocksHandler:     pendingMiniBlocksHandler,
-		EpochStartDataCreator:        epochStartDataCreator,
-		EpochEconomics:               epochEconomics,
-		EpochRewardsCreator:          epochRewards,
-		EpochValidatorInfoCreator:    validatorInfoCreator,
-		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
-		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+		ArgBaseProcessor:                 argumentsBaseProcessor,
+		SCToProtocol:                     smartContractToProtocol,
+		PendingMiniBlocksHandler:         pendingMiniBlocksHandler,
+		EpochStartDataCreator:            epochStartDataCreator,
+		EpochEconomics:                   epochEconomics,
+		EpochRewardsCreator:              epochRewards,
+		EpochValidatorInfoCreator:        validatorInfoCreator,
+		ValidatorStatisticsProcessor:     validatorStatisticsProcessor,
+		EpochSystemSCProcessor:           epochStartSystemSCProcessor,
+		ScheduledCallsProcessor:          scheduledCallsProcessor,
+		ValidatorsStatusChangesCollector: statusChangesCollector,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -1044,6 +1072,7 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 	configSCStorage config.StorageConfig,
 	nftStorageHandler vmcommon.SimpleESDTNFTStorageHandler,
 	globalSettingsHandler vmcommon.ESDTGlobalSettingsHandler,
+	vmHooksGasTracer process.VMHooksGasTracer,
 ) (process.VirtualMachinesContainerFactory, error) {
 	counter, err := counters.NewUsageCounter(esdtTransferParser)
 	if err != nil {
@@ -1089,6 +1118,7 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 		WasmVMChangeLocker:  wasmVMChangeLocker,
 		ESDTTransferParser:  esdtTransferParser,
 		Hasher:              pcf.coreData.Hasher(),
+		VMHooksGasTracer:    vmHooksGasTracer,
 	}
 
 	return shard.NewVMContainerFactory(argsNewVMFactory)
@@ -54,6 +54,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory/interceptorscontainer"
 	"github.com/multiversx/mx-chain-go/process/headerCheck"
 	"github.com/multiversx/mx-chain-go/process/heartbeat/validator"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
 	"github.com/multiversx/mx-chain-go/process/peer"
 	"github.com/multiversx/mx-chain-go/process/receipts"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
@@ -132,6 +133,7 @@ type processComponents struct {
 	receiptsRepository               mainFactory.ReceiptsRepository
 	sentSignaturesTracker            process.SentSignaturesTracker
 	epochSystemSCProcessor           process.EpochStartSystemSCProcessor
+	interceptorsPriorityLanes        process.InterceptorPriorityLanesHandler
 }
 
 // ProcessComponentsFactoryArgs holds the arguments needed to create a process components factory
@@ -514,7 +516,13 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	interceptorsPriorityLanes, err := pcf.createInterceptorsPriorityLanes()
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, blackListHandler, err := pcf.newInterceptorContainerFactory(
+		interceptorsPriorityLanes,
 		headerSigVerifier,
 		pcf.bootstrapComponents.HeaderIntegrityVerifier(),
 		blockTracker,
@@ -632,6 +640,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		}
 	}
 
+	validatorPerformanceStorer, err := pcf.data.StorageService().GetStorer(dataRetriever.UnsignedTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
 	cacheRefreshDuration := time.Duration(pcf.config.ValidatorStatistics.CacheRefreshIntervalInSec) * time.Second
 	argVSP := peer.ArgValidatorsProvider{
 		NodesCoordinator:                  pcf.nodesCoordinator,
@@ -644,6 +657,8 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		AddressPubKeyConverter:            pcf.coreData.AddressPubKeyConverter(),
 		AuctionListSelector:               pcf.auctionListSelectorAPI,
 		StakingDataProvider:               pcf.stakingDataProviderAPI,
+		ValidatorPerformanceStorer:        validatorPerformanceStorer,
+		Marshalizer:                       pcf.coreData.InternalMarshalizer(),
 	}
 
 	validatorsProvider, err := peer.NewValidatorsProvider(argVSP)
@@ -761,6 +776,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		accountsParser:                   pcf.accountsParser,
 		receiptsRepository:               receiptsRepository,
 		sentSignaturesTracker:            sentSignaturesTracker,
+		interceptorsPriorityLanes:        interceptorsPriorityLanes,
 	}, nil
 }
 
@@ -1199,7 +1215,9 @@ func (pcf *processComponentsFactory) indexGenesisBlocks(
 			genesisBlockHash,
 			originalGenesisBlockHeader,
 			genesisBody,
+			wrapTxsInfo(txsPoolPerShard[currentShardID].Transactions),
 			wrapSCRsInfo(txsPoolPerShard[currentShardID].SmartContractResults),
+			wrapRewardsInfo(txsPoolPerShard[currentShardID].Rewards),
 			wrapReceipts(txsPoolPerShard[currentShardID].Receipts),
 			intraShardMiniBlocks,
 			wrapLogs(txsPoolPerShard[currentShardID].Logs))
@@ -1267,7 +1285,9 @@ func (pcf *processComponentsFactory) saveAlteredGenesisHeaderToStorage(
 			genesisBlockHash,
 			genesisBlockHeader,
 			genesisBody,
+			wrapTxsInfo(txsPoolPerShard[currentShardId].Transactions),
 			wrapSCRsInfo(txsPoolPerShard[currentShardId].SmartContractResults),
+			wrapRewardsInfo(txsPoolPerShard[currentShardId].Rewards),
 			wrapReceipts(txsPoolPerShard[currentShardId].Receipts),
 			intraShardMiniBlocks,
 			wrapLogs(txsPoolPerShard[currentShardId].Logs))
@@ -1483,7 +1503,21 @@ func (pcf *processComponentsFactory) newRequestersContainerFactory(
 	return nil, errors.New("could not create requester container factory")
 }
 
+func (pcf *processComponentsFactory) createInterceptorsPriorityLanes() (process.InterceptorPriorityLanesHandler, error) {
+	if !pcf.config.InterceptorsPriorityLanes.Enabled {
+		return lanes.NewNoPriorityLanes()
+	}
+
+	argsPriorityLanes := lanes.ArgsPriorityLanes{
+		Config:           pcf.config.InterceptorsPriorityLanes,
+		AppStatusHandler: pcf.statusCoreComponents.AppStatusHandler(),
+	}
+
+	return lanes.NewPriorityLanes(argsPriorityLanes)
+}
+
 func (pcf *processComponentsFactory) newInterceptorContainerFactory(
+	priorityLanes process.InterceptorPriorityLanesHandler,
 	headerSigVerifier process.InterceptedHeaderSigVerifier,
 	headerIntegrityVerifier nodeFactory.HeaderIntegrityVerifierHandler,
 	validityAttester process.ValidityAttester,
@@ -1501,6 +1535,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 	shardCoordinator := pcf.bootstrapComponents.ShardCoordinator()
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return pcf.newShardInterceptorContainerFactory(
+			priorityLanes,
 			headerSigVerifier,
 			headerIntegrityVerifier,
 			validityAttester,
@@ -1514,6 +1549,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
 		return pcf.newMetaInterceptorContainerFactory(
+			priorityLanes,
 			headerSigVerifier,
 			headerIntegrityVerifier,
 			validityAttester,
@@ -1653,6 +1689,7 @@ func (pcf *processComponentsFactory) createStorageRequestersForShard(
 }
 
 func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
+	priorityLanes process.InterceptorPriorityLanesHandler,
 	headerSigVerifier process.InterceptedHeaderSigVerifier,
 	headerIntegrityVerifier nodeFactory.HeaderIntegrityVerifierHandler,
 	validityAttester process.ValidityAttester,
@@ -1695,6 +1732,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 		FullArchivePeerShardMapper:   fullArchivePeerShardMapper,
 		HardforkTrigger:              hardforkTrigger,
 		NodeOperationMode:            nodeOperationMode,
+		PriorityLanes:                priorityLanes,
 	}
 
 	interceptorContainerFactory, err := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterceptorsContainerFactoryArgs)
@@ -1706,6 +1744,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 }
 
 func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
+	priorityLanes process.InterceptorPriorityLanesHandler,
 	headerSigVerifier process.InterceptedHeaderSigVerifier,
 	headerIntegrityVerifier nodeFactory.HeaderIntegrityVerifierHandler,
 	validityAttester process.ValidityAttester,
@@ -1748,6 +1787,7 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 		FullArchivePeerShardMapper:   fullArchivePeerShardMapper,
 		HardforkTrigger:              hardforkTrigger,
 		NodeOperationMode:            nodeOperationMode,
+		PriorityLanes:                priorityLanes,
 	}
 
 	interceptorContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorsContainerFactoryArgs)
@@ -2044,6 +2084,9 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.txsSender) {
 		log.LogIfError(pc.txsSender.Close())
 	}
+	if !check.IfNil(pc.interceptorsPriorityLanes) {
+		log.LogIfError(pc.interceptorsPriorityLanes.Close())
+	}
 
 	return nil
 }
@@ -2066,6 +2109,15 @@ func wrapSCRsInfo(scrs map[string]*outport.SCRInfo) map[string]data.TransactionH
 	return ret
 }
 
+func wrapRewardsInfo(rewards map[string]*outport.RewardInfo) map[string]data.TransactionHandler {
+	ret := make(map[string]data.TransactionHandler, len(rewards))
+	for hash, reward := range rewards {
+		ret[hash] = reward.Reward
+	}
+
+	return ret
+}
+
 func wrapReceipts(receipts map[string]*receipt.Receipt) map[string]data.TransactionHandler {
 	ret := make(map[string]data.TransactionHandler, len(receipts))
 	for hash, r := range receipts {
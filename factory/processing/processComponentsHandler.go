@@ -180,6 +180,9 @@ func (m *managedProcessComponents) CheckSubcomponents() error {
 	if check.IfNil(m.processComponents.epochSystemSCProcessor) {
 		return errors.ErrNilEpochSystemSCProcessor
 	}
+	if check.IfNil(m.processComponents.interceptorsPriorityLanes) {
+		return process.ErrNilInterceptorPriorityLanes
+	}
 
 	return nil
 }
@@ -688,6 +691,18 @@ func (m *managedProcessComponents) EpochSystemSCProcessor() process.EpochStartSy
 	return m.processComponents.epochSystemSCProcessor
 }
 
+// InterceptorsPriorityLanes returns the priority lanes used when processing the intercepted data
+func (m *managedProcessComponents) InterceptorsPriorityLanes() process.InterceptorPriorityLanesHandler {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.interceptorsPriorityLanes
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (m *managedProcessComponents) IsInterfaceNil() bool {
 	return m == nil
@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator/gasTracer"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/syncer"
@@ -80,6 +81,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		BlockChain:          pcf.data.Blockchain(),
+		GasScheduleNotifier: pcf.gasSchedule,
 	})
 
 	return apiTransactionEvaluator, vmContainerFactory, err
@@ -143,6 +145,8 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	}
 
 	args.BlockChainHook = vmContainerFactory.BlockChainHookImpl()
+	// the metachain does not execute wasm smart contracts, so there are no VM hooks to trace
+	args.VMHooksGasTracer = gasTracer.NewVMHooksGasTracer()
 
 	vmContainer, err := vmContainerFactory.Create()
 	if err != nil {
@@ -290,6 +294,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		return args, nil, nil, err
 	}
 
+	vmHooksGasTracer := gasTracer.NewVMHooksGasTracer()
 	vmContainerFactory, err := pcf.createVMFactoryShard(
 		accountsAdapter,
 		syncer.NewMissingTrieNodesNotifier(),
@@ -299,12 +304,14 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		smartContractStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		vmHooksGasTracer,
 	)
 	if err != nil {
 		return args, nil, nil, err
 	}
 
 	args.BlockChainHook = vmContainerFactory.BlockChainHookImpl()
+	args.VMHooksGasTracer = vmHooksGasTracer
 
 	err = builtInFuncFactory.SetPayableHandler(vmContainerFactory.BlockChainHookImpl())
 	if err != nil {
@@ -221,13 +221,27 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		return nil, err
 	}
 
+	fileDriverArgs, err := scf.makeFileDriverArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	kafkaDriverArgs, err := scf.makeKafkaDriverArgs()
+	if err != nil {
+		return nil, err
+	}
+
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		ShardID:                   scf.shardCoordinator.SelfId(),
 		RetrialInterval:           common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs: scf.makeElasticIndexerArgs(),
+		ElasticBufferConfig:       scf.externalConfig.ElasticSearchConnector.Buffer,
 		EventNotifierFactoryArgs:  eventNotifierArgs,
 		HostDriversArgs:           hostDriversArgs,
+		FileDriverArgs:            fileDriverArgs,
+		KafkaDriverArgs:           kafkaDriverArgs,
 		IsImportDB:                scf.isInImportMode,
+		AppStatusHandler:          scf.statusCoreComponents.AppStatusHandler(),
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
@@ -269,6 +283,9 @@ func (scf *statusComponentsFactory) makeEventNotifierArgs() (*outportDriverFacto
 		Password:          eventNotifierConfig.Password,
 		RequestTimeoutSec: eventNotifierConfig.RequestTimeoutSec,
 		Marshaller:        marshaller,
+		AddressConverter:  scf.coreComponents.AddressPubKeyConverter(),
+		Subscriptions:     eventNotifierConfig.Subscriptions,
+		BufferConfig:      eventNotifierConfig.Buffer,
 	}, nil
 }
 
@@ -293,3 +310,37 @@ func (scf *statusComponentsFactory) makeHostDriversArgs() ([]outportDriverFactor
 
 	return argsHostDriverFactorySlice, nil
 }
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() (outportDriverFactory.ArgsFileDriverFactory, error) {
+	fileDriverConfig := scf.externalConfig.FileDriverConfig
+	if !fileDriverConfig.Enabled {
+		return outportDriverFactory.ArgsFileDriverFactory{}, nil
+	}
+
+	marshaller, err := factoryMarshalizer.NewMarshalizer(fileDriverConfig.MarshallerType)
+	if err != nil {
+		return outportDriverFactory.ArgsFileDriverFactory{}, err
+	}
+
+	return outportDriverFactory.ArgsFileDriverFactory{
+		Config:     fileDriverConfig,
+		Marshaller: marshaller,
+	}, nil
+}
+
+func (scf *statusComponentsFactory) makeKafkaDriverArgs() (outportDriverFactory.ArgsKafkaDriverFactory, error) {
+	kafkaDriverConfig := scf.externalConfig.KafkaDriverConfig
+	if !kafkaDriverConfig.Enabled {
+		return outportDriverFactory.ArgsKafkaDriverFactory{}, nil
+	}
+
+	marshaller, err := factoryMarshalizer.NewMarshalizer(kafkaDriverConfig.MarshallerType)
+	if err != nil {
+		return outportDriverFactory.ArgsKafkaDriverFactory{}, err
+	}
+
+	return outportDriverFactory.ArgsKafkaDriverFactory{
+		Config:     kafkaDriverConfig,
+		Marshaller: marshaller,
+	}, nil
+}
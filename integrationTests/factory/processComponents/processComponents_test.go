@@ -11,6 +11,7 @@ import (
 	bootstrapComp "github.com/multiversx/mx-chain-go/factory/bootstrap"
 	"github.com/multiversx/mx-chain-go/integrationTests/factory"
 	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/goroutines"
 	"github.com/stretchr/testify/require"
 )
@@ -83,10 +84,11 @@ func TestProcessComponents_Close_ShouldWork(t *testing.T) {
 	)
 	require.Nil(t, err)
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
-		GasScheduleConfig:  configs.EpochConfig.GasSchedule,
-		ConfigDir:          configs.ConfigurationPathsHolder.GasScheduleDirectoryName,
-		EpochNotifier:      managedCoreComponents.EpochNotifier(),
-		WasmVMChangeLocker: managedCoreComponents.WasmVMChangeLocker(),
+		GasScheduleConfig:        configs.EpochConfig.GasSchedule,
+		ConfigDir:                configs.ConfigurationPathsHolder.GasScheduleDirectoryName,
+		EpochNotifier:            managedCoreComponents.EpochNotifier(),
+		WasmVMChangeLocker:       managedCoreComponents.WasmVMChangeLocker(),
+		ParameterChangesProvider: &testscommon.ParameterChangesProviderStub{},
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	require.Nil(t, err)
@@ -13,27 +13,28 @@ import (
 
 // CryptoComponentsStub -
 type CryptoComponentsStub struct {
-	PubKey                  crypto.PublicKey
-	PublicKeyCalled         func() crypto.PublicKey
-	PrivKey                 crypto.PrivateKey
-	P2pPubKey               crypto.PublicKey
-	P2pPrivKey              crypto.PrivateKey
-	PubKeyBytes             []byte
-	PubKeyString            string
-	BlockSig                crypto.SingleSigner
-	TxSig                   crypto.SingleSigner
-	P2pSig                  crypto.SingleSigner
-	MultiSigContainer       cryptoCommon.MultiSignerContainer
-	PeerSignHandler         crypto.PeerSignatureHandler
-	BlKeyGen                crypto.KeyGenerator
-	TxKeyGen                crypto.KeyGenerator
-	P2PKeyGen               crypto.KeyGenerator
-	MsgSigVerifier          vm.MessageSignVerifier
-	ManagedPeersHolderField common.ManagedPeersHolder
-	KeysHandlerField        consensus.KeysHandler
-	KeysHandlerCalled       func() consensus.KeysHandler
-	SigHandler              consensus.SigningHandler
-	mutMultiSig             sync.RWMutex
+	PubKey                   crypto.PublicKey
+	PublicKeyCalled          func() crypto.PublicKey
+	PrivKey                  crypto.PrivateKey
+	P2pPubKey                crypto.PublicKey
+	P2pPrivKey               crypto.PrivateKey
+	PubKeyBytes              []byte
+	PubKeyString             string
+	BlockSig                 crypto.SingleSigner
+	TxSig                    crypto.SingleSigner
+	P2pSig                   crypto.SingleSigner
+	MultiSigContainer        cryptoCommon.MultiSignerContainer
+	PeerSignHandler          crypto.PeerSignatureHandler
+	BlKeyGen                 crypto.KeyGenerator
+	TxKeyGen                 crypto.KeyGenerator
+	P2PKeyGen                crypto.KeyGenerator
+	MsgSigVerifier           vm.MessageSignVerifier
+	ManagedPeersHolderField  common.ManagedPeersHolder
+	KeysHandlerField         consensus.KeysHandler
+	ManagedKeysReloaderField common.ManagedKeysReloader
+	KeysHandlerCalled        func() consensus.KeysHandler
+	SigHandler               consensus.SigningHandler
+	mutMultiSig              sync.RWMutex
 }
 
 // Create -
@@ -174,26 +175,32 @@ func (ccs *CryptoComponentsStub) KeysHandler() consensus.KeysHandler {
 	return ccs.KeysHandlerField
 }
 
+// ManagedKeysReloader -
+func (ccs *CryptoComponentsStub) ManagedKeysReloader() common.ManagedKeysReloader {
+	return ccs.ManagedKeysReloaderField
+}
+
 // Clone -
 func (ccs *CryptoComponentsStub) Clone() interface{} {
 	return &CryptoComponentsStub{
-		PubKey:                  ccs.PubKey,
-		P2pPubKey:               ccs.P2pPubKey,
-		PrivKey:                 ccs.PrivKey,
-		P2pPrivKey:              ccs.P2pPrivKey,
-		PubKeyString:            ccs.PubKeyString,
-		PubKeyBytes:             ccs.PubKeyBytes,
-		BlockSig:                ccs.BlockSig,
-		TxSig:                   ccs.TxSig,
-		MultiSigContainer:       ccs.MultiSigContainer,
-		PeerSignHandler:         ccs.PeerSignHandler,
-		BlKeyGen:                ccs.BlKeyGen,
-		TxKeyGen:                ccs.TxKeyGen,
-		P2PKeyGen:               ccs.P2PKeyGen,
-		MsgSigVerifier:          ccs.MsgSigVerifier,
-		ManagedPeersHolderField: ccs.ManagedPeersHolderField,
-		KeysHandlerField:        ccs.KeysHandlerField,
-		mutMultiSig:             sync.RWMutex{},
+		PubKey:                   ccs.PubKey,
+		P2pPubKey:                ccs.P2pPubKey,
+		PrivKey:                  ccs.PrivKey,
+		P2pPrivKey:               ccs.P2pPrivKey,
+		PubKeyString:             ccs.PubKeyString,
+		PubKeyBytes:              ccs.PubKeyBytes,
+		BlockSig:                 ccs.BlockSig,
+		TxSig:                    ccs.TxSig,
+		MultiSigContainer:        ccs.MultiSigContainer,
+		PeerSignHandler:          ccs.PeerSignHandler,
+		BlKeyGen:                 ccs.BlKeyGen,
+		TxKeyGen:                 ccs.TxKeyGen,
+		P2PKeyGen:                ccs.P2PKeyGen,
+		MsgSigVerifier:           ccs.MsgSigVerifier,
+		ManagedPeersHolderField:  ccs.ManagedPeersHolderField,
+		KeysHandlerField:         ccs.KeysHandlerField,
+		ManagedKeysReloaderField: ccs.ManagedKeysReloaderField,
+		mutMultiSig:              sync.RWMutex{},
 	}
 }
 
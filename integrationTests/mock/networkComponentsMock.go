@@ -13,6 +13,7 @@ type NetworkComponentsStub struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	PeerReputation                   process.PeerReputationHandler
 	PeerHonesty                      factory.PeerHonestyHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
@@ -64,6 +65,11 @@ func (ncs *NetworkComponentsStub) OutputAntiFloodHandler() factory.P2PAntifloodH
 	return ncs.OutputAntiFlood
 }
 
+// PeerReputationHandler -
+func (ncs *NetworkComponentsStub) PeerReputationHandler() process.PeerReputationHandler {
+	return ncs.PeerReputation
+}
+
 // PeerBlackListHandler -
 func (ncs *NetworkComponentsStub) PeerBlackListHandler() process.PeerBlackListCacher {
 	return ncs.PeerBlackList
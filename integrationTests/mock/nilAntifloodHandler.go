@@ -58,6 +58,23 @@ func (nah *NilAntifloodHandler) IsOriginatorEligibleForTopic(_ core.PeerID, _ st
 	return nil
 }
 
+// StartProcessingOnTopic does nothing
+func (nah *NilAntifloodHandler) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic does nothing
+func (nah *NilAntifloodHandler) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic does nothing
+func (nah *NilAntifloodHandler) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
+// SetPeerHonestyScorer does nothing and returns nil
+func (nah *NilAntifloodHandler) SetPeerHonestyScorer(_ process.PeerHonestyScorer) error {
+	return nil
+}
+
 // Close does nothing
 func (nah *NilAntifloodHandler) Close() error {
 	return nil
@@ -89,6 +89,23 @@ func (stub *P2PAntifloodHandlerStub) SetTopicsForAll(_ ...string) {
 
 }
 
+// StartProcessingOnTopic -
+func (stub *P2PAntifloodHandlerStub) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic -
+func (stub *P2PAntifloodHandlerStub) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic -
+func (stub *P2PAntifloodHandlerStub) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
+// SetPeerHonestyScorer -
+func (stub *P2PAntifloodHandlerStub) SetPeerHonestyScorer(_ process.PeerHonestyScorer) error {
+	return nil
+}
+
 // Close -
 func (stub *P2PAntifloodHandlerStub) Close() error {
 	return nil
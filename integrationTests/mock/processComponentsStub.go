@@ -61,6 +61,7 @@ type ProcessComponentsStub struct {
 	ESDTDataStorageHandlerForAPIInternal vmcommon.ESDTNFTStorageHandler
 	SentSignaturesTrackerInternal        process.SentSignaturesTracker
 	EpochSystemSCProcessorInternal       process.EpochStartSystemSCProcessor
+	InterceptorsPriorityLanesInternal    process.InterceptorPriorityLanesHandler
 }
 
 // Create -
@@ -302,6 +303,11 @@ func (pcs *ProcessComponentsStub) EpochSystemSCProcessor() process.EpochStartSys
 	return pcs.EpochSystemSCProcessorInternal
 }
 
+// InterceptorsPriorityLanes -
+func (pcs *ProcessComponentsStub) InterceptorsPriorityLanes() process.InterceptorPriorityLanesHandler {
+	return pcs.InterceptorsPriorityLanesInternal
+}
+
 // IsInterfaceNil -
 func (pcs *ProcessComponentsStub) IsInterfaceNil() bool {
 	return pcs == nil
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-core-go/core"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
@@ -28,6 +32,18 @@ func (t *TopicAntiFloodStub) ResetForNotRegisteredTopics() {
 func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// StartProcessingOnTopic -
+func (t *TopicAntiFloodStub) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic -
+func (t *TopicAntiFloodStub) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic -
+func (t *TopicAntiFloodStub) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
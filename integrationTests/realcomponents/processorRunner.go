@@ -33,6 +33,7 @@ import (
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/integrationTests/vm"
 	"github.com/multiversx/mx-chain-go/integrationTests/vm/wasm"
+	"github.com/multiversx/mx-chain-go/process/governanceParameters"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	nodesCoord "github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
@@ -178,6 +179,7 @@ func (pr *ProcessorRunner) createNetworkComponents(tb testing.TB) {
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: "",
 		CryptoComponents:      pr.CryptoComponents,
+		PathManager:           pr.CoreComponents.PathHandler(),
 	}
 
 	networkFactory, err := factoryNetwork.NewNetworkComponentsFactory(argsNetwork)
@@ -373,11 +375,23 @@ func (pr *ProcessorRunner) createProcessComponents(tb testing.TB) {
 	)
 	require.Nil(tb, err)
 
+	metaBlockStorer, err := pr.DataComponents.StorageService().GetStorer(dataRetriever.MetaBlockUnit)
+	require.Nil(tb, err)
+
+	parameterChangesProvider, err := governanceParameters.NewParameterChangesProvider(governanceParameters.ArgsParameterChangesProvider{
+		Marshaller:         pr.CoreComponents.InternalMarshalizer(),
+		MetaBlockStorer:    metaBlockStorer,
+		EpochStartNotifier: pr.CoreComponents.EpochStartNotifierWithConfirm(),
+		CurrentEpoch:       pr.BootstrapComponents.EpochBootstrapParams().Epoch(),
+	})
+	require.Nil(tb, err)
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
-		GasScheduleConfig:  pr.Config.EpochConfig.GasSchedule,
-		ConfigDir:          pr.Config.ConfigurationPathsHolder.GasScheduleDirectoryName,
-		EpochNotifier:      pr.CoreComponents.EpochNotifier(),
-		WasmVMChangeLocker: pr.CoreComponents.WasmVMChangeLocker(),
+		GasScheduleConfig:        pr.Config.EpochConfig.GasSchedule,
+		ConfigDir:                pr.Config.ConfigurationPathsHolder.GasScheduleDirectoryName,
+		EpochNotifier:            pr.CoreComponents.EpochNotifier(),
+		WasmVMChangeLocker:       pr.CoreComponents.WasmVMChangeLocker(),
+		ParameterChangesProvider: parameterChangesProvider,
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	require.Nil(tb, err)
@@ -389,6 +403,11 @@ func (pr *ProcessorRunner) createProcessComponents(tb testing.TB) {
 		Marshalizer:              pr.CoreComponents.InternalMarshalizer(),
 		Store:                    pr.DataComponents.StorageService(),
 		Uint64ByteSliceConverter: pr.CoreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         pr.BootstrapComponents.ShardCoordinator(),
+		Accounts:                 pr.StateComponents.AccountsAdapter(),
+		AccountsRepository:       pr.StateComponents.AccountsRepository(),
+		AddressConverter:         pr.CoreComponents.AddressPubKeyConverter(),
+		EnableEpochsHandler:      pr.CoreComponents.EnableEpochsHandler(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	require.Nil(tb, err)
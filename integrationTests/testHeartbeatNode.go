@@ -40,6 +40,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/heartbeat/validator"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	interceptorFactory "github.com/multiversx/mx-chain-go/process/interceptors/factory"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
 	interceptorsProcessor "github.com/multiversx/mx-chain-go/process/interceptors/processor"
 	processMock "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/sharding"
@@ -697,6 +698,7 @@ func (thn *TestHeartbeatNode) initMultiDataInterceptor(topic string, dataFactory
 			DataFactory:      dataFactory,
 			Processor:        processor,
 			Throttler:        TestThrottler,
+			Scheduler:        lanes.NewGoRoutineScheduler(),
 			AntifloodHandler: &mock.NilAntifloodHandler{},
 			WhiteListRequest: &testscommon.WhiteListHandlerStub{
 				IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
@@ -720,6 +722,7 @@ func (thn *TestHeartbeatNode) initSingleDataInterceptor(topic string, dataFactor
 			DataFactory:      dataFactory,
 			Processor:        processor,
 			Throttler:        TestThrottler,
+			Scheduler:        lanes.NewGoRoutineScheduler(),
 			AntifloodHandler: &mock.NilAntifloodHandler{},
 			WhiteListRequest: &testscommon.WhiteListHandlerStub{
 				IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
@@ -1308,6 +1308,11 @@ func CreateHeaderIntegrityVerifier() process.HeaderIntegrityVerifier {
 	headerVersioning, _ := headerCheck.NewHeaderIntegrityVerifier(
 		ChainID,
 		hvh,
+		&enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+				return flag == common.GovernanceParameterChangesFlag
+			},
+		},
 	)
 
 	return headerVersioning
@@ -78,6 +78,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory/shard"
 	"github.com/multiversx/mx-chain-go/process/heartbeat/validator"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
 	processMock "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/peer"
 	"github.com/multiversx/mx-chain-go/process/rating"
@@ -1307,6 +1308,7 @@ func (tpn *TestProcessorNode) initInterceptors(heartbeatPk string) {
 		providedHardforkPk := tpn.createHardforkTrigger(heartbeatPk)
 		coreComponents.HardforkTriggerPubKeyField = providedHardforkPk
 
+		priorityLanes, _ := lanes.NewNoPriorityLanes()
 		metaInterceptorContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
 			CoreComponents:               coreComponents,
 			CryptoComponents:             cryptoComponents,
@@ -1338,6 +1340,7 @@ func (tpn *TestProcessorNode) initInterceptors(heartbeatPk string) {
 			FullArchivePeerShardMapper:   tpn.FullArchivePeerShardMapper,
 			HardforkTrigger:              tpn.HardforkTrigger,
 			NodeOperationMode:            tpn.NodeOperationMode,
+			PriorityLanes:                priorityLanes,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorContainerFactoryArgs)
 
@@ -1375,6 +1378,7 @@ func (tpn *TestProcessorNode) initInterceptors(heartbeatPk string) {
 		providedHardforkPk := tpn.createHardforkTrigger(heartbeatPk)
 		coreComponents.HardforkTriggerPubKeyField = providedHardforkPk
 
+		priorityLanes, _ := lanes.NewNoPriorityLanes()
 		shardIntereptorContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
 			CoreComponents:               coreComponents,
 			CryptoComponents:             cryptoComponents,
@@ -1406,6 +1410,7 @@ func (tpn *TestProcessorNode) initInterceptors(heartbeatPk string) {
 			FullArchivePeerShardMapper:   tpn.FullArchivePeerShardMapper,
 			HardforkTrigger:              tpn.HardforkTrigger,
 			NodeOperationMode:            tpn.NodeOperationMode,
+			PriorityLanes:                priorityLanes,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardIntereptorContainerFactoryArgs)
 
@@ -2381,15 +2386,17 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 		tpn.EpochStartSystemSCProcessor = epochStartSystemSCProcessor
 
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor:             argumentsBase,
-			SCToProtocol:                 scToProtocolInstance,
-			PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
-			EpochEconomics:               epochEconomics,
-			EpochStartDataCreator:        epochStartDataCreator,
-			EpochRewardsCreator:          epochStartRewards,
-			EpochValidatorInfoCreator:    epochStartValidatorInfo,
-			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
-			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			ArgBaseProcessor:                 argumentsBase,
+			SCToProtocol:                     scToProtocolInstance,
+			ScheduledCallsProcessor:          &testscommon.ScheduledCallsProcessorStub{},
+			ValidatorsStatusChangesCollector: &testscommon.ValidatorsStatusChangesCollectorStub{},
+			PendingMiniBlocksHandler:         &mock.PendingMiniBlocksHandlerStub{},
+			EpochEconomics:                   epochEconomics,
+			EpochStartDataCreator:            epochStartDataCreator,
+			EpochRewardsCreator:              epochStartRewards,
+			EpochValidatorInfoCreator:        epochStartValidatorInfo,
+			ValidatorStatisticsProcessor:     tpn.ValidatorStatisticsProcessor,
+			EpochSystemSCProcessor:           epochStartSystemSCProcessor,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -3333,9 +3340,10 @@ func GetDefaultProcessComponents() *mock.ProcessComponentsStub {
 				return &mock.PrivateKeyMock{}
 			},
 		},
-		CurrentEpochProviderInternal: &testscommon.CurrentEpochProviderStub{},
-		HistoryRepositoryInternal:    &dblookupextMock.HistoryRepositoryStub{},
-		HardforkTriggerField:         &testscommon.HardforkTriggerStub{},
+		CurrentEpochProviderInternal:      &testscommon.CurrentEpochProviderStub{},
+		HistoryRepositoryInternal:         &dblookupextMock.HistoryRepositoryStub{},
+		HardforkTriggerField:              &testscommon.HardforkTriggerStub{},
+		InterceptorsPriorityLanesInternal: &testscommon.PriorityLanesStub{},
 	}
 }
 
@@ -3391,6 +3399,7 @@ func GetDefaultNetworkComponents() *mock.NetworkComponentsStub {
 		InputAntiFlood:                   &mock.P2PAntifloodHandlerStub{},
 		OutputAntiFlood:                  &mock.P2PAntifloodHandlerStub{},
 		PeerBlackList:                    &mock.PeerBlackListCacherStub{},
+		PeerReputation:                   &testscommon.PeerReputationHandlerStub{},
 		PeersRatingHandlerField:          &p2pmocks.PeersRatingHandlerStub{},
 		PeersRatingMonitorField:          &p2pmocks.PeersRatingMonitorStub{},
 		FullArchiveNetworkMessengerField: &p2pmocks.MessengerStub{},
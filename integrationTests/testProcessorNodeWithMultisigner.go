@@ -17,6 +17,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	mclmultisig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
 	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/factory/peerSignatureHandler"
@@ -377,6 +378,11 @@ func createHeaderIntegrityVerifier() process.HeaderIntegrityVerifier {
 	headerVersioning, _ := headerCheck.NewHeaderIntegrityVerifier(
 		ChainID,
 		hvh,
+		&enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+				return flag == common.GovernanceParameterChangesFlag
+			},
+		},
 	)
 
 	return headerVersioning
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	nodeFacade "github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -20,7 +21,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator/gasTracer"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
+	"github.com/multiversx/mx-chain-go/receiptProof/builder"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -180,6 +183,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		VMOutputCacher:            &testscommon.CacherMock{},
 		DataFieldParser:           dataFieldParser,
 		BlockChainHook:            tpn.BlockchainHook,
+		VMHooksGasTracer:          gasTracer.NewVMHooksGasTracer(),
 	}
 
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(argSimulator)
@@ -196,6 +200,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		ShardCoordinator:    tpn.ShardCoordinator,
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		BlockChain:          tpn.BlockChain,
+		GasScheduleNotifier: gasScheduleNotifier,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	log.LogIfError(err)
@@ -220,6 +225,21 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	governanceHandler, err := factory.CreateGovernanceHandler(args)
+	log.LogIfError(err)
+
+	rewardsStorer, err := tpn.Storage.GetStorer(dataRetriever.RewardTransactionUnit)
+	log.LogIfError(err)
+
+	argsRewardsBreakdown := trieIterators.ArgRewardsBreakdownProcessor{
+		ArgTrieIteratorProcessor: args,
+		RewardsStorer:            rewardsStorer,
+		Marshalizer:              TestMarshalizer,
+		MaxServiceFee:            100000,
+	}
+	rewardsBreakdownHandler, err := factory.CreateRewardsBreakdownHandler(argsRewardsBreakdown)
+	log.LogIfError(err)
+
 	logsFacade := &testscommon.LogsFacadeStub{}
 	receiptsRepository := &testscommon.ReceiptsRepositoryStub{}
 
@@ -265,6 +285,15 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	apiInternalBlockProcessor, err := blockAPI.CreateAPIInternalBlockProcessor(argsBlockAPI)
 	log.LogIfError(err)
 
+	argsProofBuilder := builder.ArgsProofBuilder{
+		Marshaller:        TestMarshalizer,
+		HistoryRepository: tpn.HistoryRepository,
+		StorageService:    tpn.Storage,
+		ShardCoordinator:  tpn.ShardCoordinator,
+	}
+	receiptProofBuilder, err := builder.NewProofBuilder(argsProofBuilder)
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           tpn.SCQueryService,
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
@@ -272,6 +301,8 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		GovernanceHandler:        governanceHandler,
+		RewardsBreakdownHandler:  rewardsBreakdownHandler,
 		APITransactionHandler:    apiTransactionHandler,
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -281,6 +312,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
 		NodesCoordinator:         tpn.NodesCoordinator,
+		ReceiptProofBuilder:      receiptProofBuilder,
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -319,6 +351,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["hardfork"] = hardforkGroup
 	}
 
+	logsGroup, err := groups.NewLogsGroup(facade)
+	if err == nil {
+		groupsMap["logs"] = logsGroup
+	}
+
 	networkGroup, err := groups.NewNetworkGroup(facade)
 	if err == nil {
 		groupsMap["network"] = networkGroup
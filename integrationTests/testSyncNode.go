@@ -112,13 +112,15 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		argumentsBase.ForkDetector = tpn.ForkDetector
 		argumentsBase.TxCoordinator = &mock.TransactionCoordinatorMock{}
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor:          argumentsBase,
-			SCToProtocol:              &mock.SCToProtocolStub{},
-			PendingMiniBlocksHandler:  &mock.PendingMiniBlocksHandlerStub{},
-			EpochStartDataCreator:     &mock.EpochStartDataCreatorStub{},
-			EpochEconomics:            &mock.EpochEconomicsStub{},
-			EpochRewardsCreator:       &testscommon.RewardsCreatorStub{},
-			EpochValidatorInfoCreator: &testscommon.EpochValidatorInfoCreatorStub{},
+			ArgBaseProcessor:                 argumentsBase,
+			SCToProtocol:                     &mock.SCToProtocolStub{},
+			ScheduledCallsProcessor:          &testscommon.ScheduledCallsProcessorStub{},
+			ValidatorsStatusChangesCollector: &testscommon.ValidatorsStatusChangesCollectorStub{},
+			PendingMiniBlocksHandler:         &mock.PendingMiniBlocksHandlerStub{},
+			EpochStartDataCreator:            &mock.EpochStartDataCreatorStub{},
+			EpochEconomics:                   &mock.EpochEconomicsStub{},
+			EpochRewardsCreator:              &testscommon.RewardsCreatorStub{},
+			EpochValidatorInfoCreator:        &testscommon.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &testscommon.ValidatorStatisticsProcessorStub{
 				UpdatePeerStateCalled: func(header data.MetaHeaderHandler) ([]byte, error) {
 					return []byte("validator stats root hash"), nil
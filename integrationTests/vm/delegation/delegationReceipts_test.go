@@ -0,0 +1,169 @@
+package delegation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/integrationTests"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegationSystemDelegateUnDelegateWithReceiptTokens(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	tpn := integrationTests.NewTestProcessorNode(integrationTests.ArgTestProcessorNode{
+		MaxShards:            1,
+		NodeShardId:          core.MetachainShardId,
+		TxSignPrivKeyShardId: 0,
+	})
+	tpn.InitDelegationManager()
+	maxDelegationCap := big.NewInt(5000)
+	serviceFee := big.NewInt(1000)
+	delegationVal := big.NewInt(1000)
+	tpn.EpochNotifier.CheckEpoch(&testscommon.HeaderHandlerStub{
+		EpochField: integrationTests.UnreachableEpoch + 1,
+	})
+	tpn.BlockchainHook.SetCurrentHeader(&block.MetaBlock{Nonce: 1})
+
+	// create new delegation contract
+	delegationScAddress := deployNewSc(t, tpn, maxDelegationCap, serviceFee, big.NewInt(1000), tpn.OwnAccount.Address)
+
+	// enable the receipt tokens, the issue cost of the test node ESDT system SC is 1000
+	txData := "enableReceiptTokens" +
+		"@" + hex.EncodeToString([]byte("LIQUID")) +
+		"@" + hex.EncodeToString([]byte("LQD")) +
+		"@" + hex.EncodeToString([]byte{18})
+	returnedCode, err := processTransaction(tpn, tpn.OwnAccount.Address, delegationScAddress, txData, big.NewInt(1000))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, returnedCode)
+
+	receiptTokenData := viewFuncMultipleResults(t, tpn, delegationScAddress, "getReceiptTokenData", nil)
+	require.Equal(t, 3, len(receiptTokenData))
+	tokenIdentifier := receiptTokenData[0]
+	assert.Equal(t, big.NewInt(0), big.NewInt(0).SetBytes(receiptTokenData[1]))
+
+	// the roles of the delegation contract are set on the metachain in the same call, no role change is left in flight
+	for _, scr := range getCurrentSmartContractResults(tpn) {
+		assert.False(t, bytes.Equal(scr.GetRcvAddr(), delegationScAddress))
+	}
+
+	delegators := getAddresses(2)
+
+	// without the local mint role the receipts cannot be minted and the delegation is reverted
+	setReceiptTokenRole(t, tpn, delegationScAddress, tokenIdentifier, core.BuiltInFunctionUnSetESDTRole)
+	returnedCode, err = processTransaction(tpn, delegators[0], delegationScAddress, "delegate", delegationVal)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, returnedCode)
+	assert.Equal(t, big.NewInt(1000), big.NewInt(0).SetBytes(viewFuncSingleResult(t, tpn, delegationScAddress, "getTotalActiveStake", nil)))
+	receiptTokenData = viewFuncMultipleResults(t, tpn, delegationScAddress, "getReceiptTokenData", nil)
+	assert.Equal(t, big.NewInt(0), big.NewInt(0).SetBytes(receiptTokenData[1]))
+
+	setReceiptTokenRole(t, tpn, delegationScAddress, tokenIdentifier, core.BuiltInFunctionSetESDTRole)
+
+	// receipts are minted 1:1 while the pool is empty and transferred to the shard of the delegators
+	processMultipleTransactions(t, tpn, delegators, delegationScAddress, "delegate", delegationVal)
+	expectedData := receiptTokenTransferTxData(tokenIdentifier, delegationVal, "")
+	for _, delegator := range delegators {
+		assert.Equal(t, 1, countSmartContractResults(tpn, delegationScAddress, delegator, expectedData))
+	}
+	assert.Equal(t, big.NewInt(0), getESDTBalance(tpn, delegationScAddress, tokenIdentifier))
+	assert.Equal(t, big.NewInt(3000), big.NewInt(0).SetBytes(viewFuncSingleResult(t, tpn, delegationScAddress, "getTotalActiveStake", nil)))
+
+	receiptTokenData = viewFuncMultipleResults(t, tpn, delegationScAddress, "getReceiptTokenData", nil)
+	assert.Equal(t, tokenIdentifier, receiptTokenData[0])
+	assert.Equal(t, big.NewInt(2000), big.NewInt(0).SetBytes(receiptTokenData[1]))
+	assert.Equal(t, big.NewInt(2000), big.NewInt(0).SetBytes(receiptTokenData[2]))
+
+	// sending back more receipts than the supply fails and returns them to the sender along with the error
+	txData = receiptTokenTransferTxData(tokenIdentifier, big.NewInt(3000), "unDelegate")
+	returnedCode, err = processTransaction(tpn, delegators[1], delegationScAddress, txData, big.NewInt(0))
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, returnedCode)
+	expectedData = receiptTokenTransferTxData(tokenIdentifier, big.NewInt(3000), "") + "@" + hex.EncodeToString([]byte("user error"))
+	assert.Equal(t, 1, countSmartContractResults(tpn, delegationScAddress, delegators[1], expectedData))
+
+	// unDelegate by sending the receipt tokens back to the delegation contract
+	txData = receiptTokenTransferTxData(tokenIdentifier, delegationVal, "unDelegate")
+	returnedCode, err = processTransaction(tpn, delegators[0], delegationScAddress, txData, big.NewInt(0))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, returnedCode)
+	assert.Equal(t, big.NewInt(0), getESDTBalance(tpn, delegationScAddress, tokenIdentifier))
+	verifyDelegatorsStake(t, tpn, "getUserUnStakedValue", delegators[:1], delegationScAddress, delegationVal)
+	assert.Equal(t, big.NewInt(2000), big.NewInt(0).SetBytes(viewFuncSingleResult(t, tpn, delegationScAddress, "getTotalActiveStake", nil)))
+
+	receiptTokenData = viewFuncMultipleResults(t, tpn, delegationScAddress, "getReceiptTokenData", nil)
+	assert.Equal(t, big.NewInt(1000), big.NewInt(0).SetBytes(receiptTokenData[1]))
+	assert.Equal(t, big.NewInt(1000), big.NewInt(0).SetBytes(receiptTokenData[2]))
+}
+
+func setReceiptTokenRole(
+	t *testing.T,
+	tpn *integrationTests.TestProcessorNode,
+	scAddress []byte,
+	tokenIdentifier []byte,
+	function string,
+) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vm.ESDTSCAddress,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{tokenIdentifier, []byte(core.ESDTRoleLocalMint)},
+			GasProvided: integrationTests.MinTxGasLimit,
+		},
+		RecipientAddr: scAddress,
+		Function:      function,
+	}
+	vmOutput, err := tpn.BlockchainHook.ProcessBuiltInFunction(vmInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+}
+
+func receiptTokenTransferTxData(tokenIdentifier []byte, value *big.Int, function string) string {
+	txData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString(value.Bytes())
+	if len(function) > 0 {
+		txData += "@" + hex.EncodeToString([]byte(function))
+	}
+
+	return txData
+}
+
+func getCurrentSmartContractResults(tpn *integrationTests.TestProcessorNode) map[string]data.TransactionHandler {
+	scrsHandler, _ := tpn.InterimProcContainer.Get(block.SmartContractResultBlock)
+	return scrsHandler.GetAllCurrentFinishedTxs()
+}
+
+func countSmartContractResults(
+	tpn *integrationTests.TestProcessorNode,
+	sender []byte,
+	receiver []byte,
+	txData string,
+) int {
+	numFound := 0
+	for _, scr := range getCurrentSmartContractResults(tpn) {
+		if bytes.Equal(scr.GetSndAddr(), sender) && bytes.Equal(scr.GetRcvAddr(), receiver) && string(scr.GetData()) == txData {
+			numFound++
+		}
+	}
+
+	return numFound
+}
+
+func getESDTBalance(tpn *integrationTests.TestProcessorNode, address []byte, tokenIdentifier []byte) *big.Int {
+	esdtData, err := tpn.BlockchainHook.GetESDTToken(address, tokenIdentifier, 0)
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	return esdtData.Value
+}
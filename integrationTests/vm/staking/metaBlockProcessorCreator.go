@@ -105,10 +105,12 @@ func createMetaBlockProcessor(
 			BlockProcessingCutoffHandler:   &testscommon.BlockProcessingCutoffStub{},
 			SentSignaturesTracker:          &testscommon.SentSignatureTrackerStub{},
 		},
-		SCToProtocol:             stakingToPeer,
-		PendingMiniBlocksHandler: &mock.PendingMiniBlocksHandlerStub{},
-		EpochStartDataCreator:    epochStartDataCreator,
-		EpochEconomics:           &mock.EpochEconomicsStub{},
+		SCToProtocol:                     stakingToPeer,
+		ScheduledCallsProcessor:          &testscommon.ScheduledCallsProcessorStub{},
+		ValidatorsStatusChangesCollector: &testscommon.ValidatorsStatusChangesCollectorStub{},
+		PendingMiniBlocksHandler:         &mock.PendingMiniBlocksHandlerStub{},
+		EpochStartDataCreator:            epochStartDataCreator,
+		EpochEconomics:                   &mock.EpochEconomicsStub{},
 		EpochRewardsCreator: &testscommon.RewardsCreatorStub{
 			GetLocalTxCacheCalled: func() epochStart.TransactionCacher {
 				return dataComponents.Datapool().CurrentBlockTxs()
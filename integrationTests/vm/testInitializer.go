@@ -47,6 +47,7 @@ import (
 	syncDisabled "github.com/multiversx/mx-chain-go/process/sync/disabled"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator/gasTracer"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -987,6 +988,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		Hasher:                 integrationtests.TestHasher,
 		DataFieldParser:        dataFieldParser,
 		BlockChainHook:         blockChainHook,
+		VMHooksGasTracer:       gasTracer.NewVMHooksGasTracer(),
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
@@ -1014,6 +1016,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		ShardCoordinator:    shardCoordinator,
 		EnableEpochsHandler: argsNewSCProcessor.EnableEpochsHandler,
 		BlockChain:          chainHandler,
+		GasScheduleNotifier: mock.NewGasScheduleNotifierMock(gasSchedule),
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	if err != nil {
@@ -85,9 +85,10 @@ func prepareTestContextForGuardedAccounts(tb testing.TB) *vm.VMTestContext {
 		GasScheduleConfig: config.GasScheduleConfig{
 			GasScheduleByEpochs: []config.GasScheduleByEpochs{cfg},
 		},
-		ConfigDir:          gasScheduleDir,
-		EpochNotifier:      forking.NewGenericEpochNotifier(),
-		WasmVMChangeLocker: &sync.RWMutex{},
+		ConfigDir:                gasScheduleDir,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		WasmVMChangeLocker:       &sync.RWMutex{},
+		ParameterChangesProvider: &testscommon.ParameterChangesProviderStub{},
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	require.Nil(tb, err)
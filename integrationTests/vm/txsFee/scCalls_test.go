@@ -47,9 +47,10 @@ func prepareTestContextForEpoch836(tb testing.TB) (*vm.VMTestContext, []byte) {
 		GasScheduleConfig: config.GasScheduleConfig{
 			GasScheduleByEpochs: []config.GasScheduleByEpochs{cfg},
 		},
-		ConfigDir:          gasScheduleDir,
-		EpochNotifier:      forking.NewGenericEpochNotifier(),
-		WasmVMChangeLocker: &sync.RWMutex{},
+		ConfigDir:                gasScheduleDir,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		WasmVMChangeLocker:       &sync.RWMutex{},
+		ParameterChangesProvider: &testscommon.ParameterChangesProviderStub{},
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	require.Nil(tb, err)
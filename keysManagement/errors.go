@@ -37,3 +37,21 @@ var ErrNilShardProvider = errors.New("nil shard provider")
 
 // ErrNilEpochProvider signals that a nil epoch provider has been provided
 var ErrNilEpochProvider = errors.New("nil epoch provider")
+
+// ErrNilConsensusKeysChecker signals that a nil consensus keys checker has been provided
+var ErrNilConsensusKeysChecker = errors.New("nil consensus keys checker")
+
+// ErrNilKeyLoader signals that a nil key loader has been provided
+var ErrNilKeyLoader = errors.New("nil key loader")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNotInMultiKeyMode signals that the operation requires the node to be started in multi key mode
+var ErrNotInMultiKeyMode = errors.New("the node was not started in multi key mode")
+
+// ErrKeyInCurrentConsensus signals that the key can not be removed because it is part of the current consensus group
+var ErrKeyInCurrentConsensus = errors.New("key is part of the current consensus group")
+
+// ErrCannotRemoveLastManagedKey signals that the last managed key can not be removed
+var ErrCannotRemoveLastManagedKey = errors.New("can not remove the last managed key")
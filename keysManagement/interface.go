@@ -18,3 +18,15 @@ type CurrentEpochProvider interface {
 	CurrentEpoch() uint32
 	IsInterfaceNil() bool
 }
+
+// ConsensusKeysChecker defines a component able to tell if a key is part of the current consensus group
+type ConsensusKeysChecker interface {
+	IsKeyInCurrentConsensus(pkBytes []byte) bool
+	IsInterfaceNil() bool
+}
+
+// KeyLoader defines a component able to load all the keys from a file
+type KeyLoader interface {
+	LoadAllKeys(path string) ([][]byte, []string, error)
+	IsInterfaceNil() bool
+}
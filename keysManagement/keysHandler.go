@@ -2,6 +2,7 @@ package keysManagement
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -23,6 +24,9 @@ type keysHandler struct {
 	publicKey          crypto.PublicKey
 	publicKeyBytes     []byte
 	pid                core.PeerID
+
+	mutConsensusGroup sync.RWMutex
+	consensusGroup    map[string]struct{}
 }
 
 // NewKeysHandler will create a new instance of type keysHandler
@@ -44,6 +48,7 @@ func NewKeysHandler(args ArgsKeysHandler) (*keysHandler, error) {
 		publicKey:          pk,
 		publicKeyBytes:     pkBytes,
 		pid:                args.Pid,
+		consensusGroup:     make(map[string]struct{}),
 	}, nil
 }
 
@@ -125,6 +130,28 @@ func (handler *keysHandler) GetRedundancyStepInReason() string {
 	return handler.managedPeersHolder.GetRedundancyStepInReason()
 }
 
+// SetConsensusGroup records the public keys of the consensus group for the current round
+func (handler *keysHandler) SetConsensusGroup(consensusGroup []string) {
+	group := make(map[string]struct{}, len(consensusGroup))
+	for _, pk := range consensusGroup {
+		group[pk] = struct{}{}
+	}
+
+	handler.mutConsensusGroup.Lock()
+	handler.consensusGroup = group
+	handler.mutConsensusGroup.Unlock()
+}
+
+// IsKeyInCurrentConsensus returns true if the provided key is part of the consensus group for the current round
+func (handler *keysHandler) IsKeyInCurrentConsensus(pkBytes []byte) bool {
+	handler.mutConsensusGroup.RLock()
+	defer handler.mutConsensusGroup.RUnlock()
+
+	_, found := handler.consensusGroup[string(pkBytes)]
+
+	return found
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (handler *keysHandler) IsInterfaceNil() bool {
 	return handler == nil
@@ -0,0 +1,51 @@
+package keystore
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// ConvertPemFile encrypts the keys from an existing PEM file into a new keystore file. The PEM content is
+// validated before encryption and an existing destination file is never overwritten. It returns the number
+// of converted keys
+func ConvertPemFile(pemFile string, keystoreFile string, passphrase []byte, kdf KDFConfig) (int, error) {
+	buff, err := readFile(pemFile)
+	if err != nil {
+		return 0, err
+	}
+	if IsKeystore(buff) {
+		return 0, fmt.Errorf("%w: %s", ErrAlreadyAKeystore, pemFile)
+	}
+
+	privateKeys, _, err := LoadAllKeysFromPemBytes(buff, pemFile)
+	if err != nil {
+		return 0, err
+	}
+	if len(privateKeys) == 0 {
+		return 0, fmt.Errorf("%w while reading %s file", core.ErrEmptyFile, pemFile)
+	}
+
+	encrypted, err := Encrypt(buff, passphrase, kdf)
+	if err != nil {
+		return 0, err
+	}
+
+	file, err := os.OpenFile(keystoreFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		if os.IsExist(err) {
+			return 0, fmt.Errorf("%w: %s", ErrKeystoreAlreadyExists, keystoreFile)
+		}
+
+		return 0, err
+	}
+
+	_, err = file.Write(encrypted)
+	if err != nil {
+		_ = file.Close()
+		return 0, err
+	}
+
+	return len(privateKeys), file.Close()
+}
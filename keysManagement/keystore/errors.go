@@ -0,0 +1,61 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrNoPassphraseSource signals that no passphrase source is available
+var ErrNoPassphraseSource = errors.New("no passphrase source available: provide a passphrase file, an environment variable or run in an interactive terminal")
+
+// ErrUnknownKDF signals that an unknown key derivation function was provided
+var ErrUnknownKDF = errors.New("unknown key derivation function")
+
+// ErrUnknownCipher signals that an unknown cipher was provided
+var ErrUnknownCipher = errors.New("unknown cipher")
+
+// ErrUnsupportedVersion signals that the keystore version is not supported
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrInvalidKDFParams signals that invalid key derivation function parameters were provided
+var ErrInvalidKDFParams = errors.New("invalid key derivation function parameters")
+
+// ErrDecryptionFailed signals that the keystore could not be decrypted, either because of a wrong passphrase
+// or because the content was altered
+var ErrDecryptionFailed = errors.New("keystore decryption failed: wrong passphrase or corrupted file")
+
+// ErrNotAKeystore signals that the provided data is not in the keystore format
+var ErrNotAKeystore = errors.New("data is not in the keystore format")
+
+// ErrNilPassphraseProvider signals that a nil passphrase provider was provided
+var ErrNilPassphraseProvider = errors.New("nil passphrase provider")
+
+// ErrKeystoreAlreadyExists signals that the destination keystore file already exists
+var ErrKeystoreAlreadyExists = errors.New("destination keystore file already exists")
+
+// ErrAlreadyAKeystore signals that the provided file is already in the keystore format
+var ErrAlreadyAKeystore = errors.New("file is already in the keystore format")
+
+// ErrPassphraseMismatch signals that the passphrase and its confirmation do not match
+var ErrPassphraseMismatch = errors.New("the passphrase and its confirmation do not match")
+
+// KeystoreError signals that a file in the keystore format exists but could not be unlocked, either because the
+// passphrase is missing or wrong or because the keystore content is corrupted. Callers must not fall back to
+// generating a fresh key when this error is returned
+type KeystoreError struct {
+	Path string
+	Err  error
+}
+
+// Error returns the error message
+func (e *KeystoreError) Error() string {
+	return fmt.Sprintf("%v while unlocking the %s keystore", e.Err, e.Path)
+}
+
+// Unwrap returns the wrapped error
+func (e *KeystoreError) Unwrap() error {
+	return e.Err
+}
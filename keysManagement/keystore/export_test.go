@@ -0,0 +1,6 @@
+package keystore
+
+// SetPromptHandler -
+func (provider *passphraseProvider) SetPromptHandler(handler func(message string) ([]byte, error)) {
+	provider.promptHandler = handler
+}
@@ -0,0 +1,7 @@
+package keystore
+
+// PassphraseProvider defines the component able to provide the passphrase used to unlock keystore files
+type PassphraseProvider interface {
+	Passphrase() ([]byte, error)
+	IsInterfaceNil() bool
+}
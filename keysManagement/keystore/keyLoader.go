@@ -0,0 +1,156 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const pemPkHeader = "PRIVATE KEY for "
+
+// keyLoader loads keys from either plain PEM files or from keystore files. The format is detected from the
+// file content so the existing PEM files continue to work unchanged
+type keyLoader struct {
+	passphraseProvider PassphraseProvider
+}
+
+// NewKeyLoader creates a new instance of a keystore aware key loader
+func NewKeyLoader(passphraseProvider PassphraseProvider) (*keyLoader, error) {
+	if check.IfNil(passphraseProvider) {
+		return nil, ErrNilPassphraseProvider
+	}
+
+	return &keyLoader{
+		passphraseProvider: passphraseProvider,
+	}, nil
+}
+
+// LoadKey loads the key with the given index found in the file from the given path
+func (kl *keyLoader) LoadKey(path string, skIndex int) ([]byte, string, error) {
+	if skIndex < 0 {
+		return nil, "", core.ErrInvalidIndex
+	}
+
+	buff, err := kl.readPemContent(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var blkRecovered *pem.Block
+	for i := 0; i <= skIndex; i++ {
+		if len(buff) == 0 {
+			return nil, "", fmt.Errorf("%w while reading %s file, invalid index %d", core.ErrInvalidIndex, path, i)
+		}
+
+		blkRecovered, buff = pem.Decode(buff)
+		if blkRecovered == nil {
+			return nil, "", fmt.Errorf("%w while reading %s file, error decoding", core.ErrPemFileIsInvalid, path)
+		}
+	}
+
+	return extractKey(blkRecovered)
+}
+
+// LoadAllKeys loads all keys found in the file for the given path
+func (kl *keyLoader) LoadAllKeys(path string) ([][]byte, []string, error) {
+	buff, err := kl.readPemContent(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return LoadAllKeysFromPemBytes(buff, path)
+}
+
+// readPemContent returns the PEM encoded content of the file, decrypting it if the file is a keystore
+func (kl *keyLoader) readPemContent(path string) ([]byte, error) {
+	buff, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !IsKeystore(buff) {
+		return buff, nil
+	}
+
+	passphrase, err := kl.passphraseProvider.Passphrase()
+	if err != nil {
+		return nil, &KeystoreError{Path: path, Err: err}
+	}
+
+	plaintext, err := Decrypt(buff, passphrase)
+	if err != nil {
+		return nil, &KeystoreError{Path: path, Err: err}
+	}
+	if len(plaintext) == 0 {
+		return nil, &KeystoreError{Path: path, Err: core.ErrEmptyFile}
+	}
+
+	return plaintext, nil
+}
+
+// LoadAllKeysFromPemBytes loads all the secret keys and existing public keys from the PEM encoded content
+func LoadAllKeysFromPemBytes(buff []byte, source string) ([][]byte, []string, error) {
+	privateKeys := make([][]byte, 0)
+	publicKeys := make([]string, 0)
+
+	buff = bytes.TrimSpace(buff)
+	for len(buff) > 0 {
+		var blkRecovered *pem.Block
+		blkRecovered, buff = pem.Decode(buff)
+		if blkRecovered == nil {
+			return nil, nil, fmt.Errorf("%w while reading %s file, error decoding", core.ErrPemFileIsInvalid, source)
+		}
+		buff = bytes.TrimSpace(buff)
+
+		sk, pk, err := extractKey(blkRecovered)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		privateKeys = append(privateKeys, sk)
+		publicKeys = append(publicKeys, pk)
+	}
+
+	return privateKeys, publicKeys, nil
+}
+
+func extractKey(blk *pem.Block) ([]byte, string, error) {
+	if blk == nil {
+		return nil, "", core.ErrNilPemBLock
+	}
+	if strings.Index(blk.Type, pemPkHeader) != 0 {
+		return nil, "", fmt.Errorf("%w missing '%s' in block type", core.ErrPemFileIsInvalid, pemPkHeader)
+	}
+
+	return blk.Bytes, blk.Type[len(pemPkHeader):], nil
+}
+
+func readFile(path string) ([]byte, error) {
+	file, err := core.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	buff, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading %s file", err, path)
+	}
+	if len(buff) == 0 {
+		return nil, fmt.Errorf("%w while reading %s file", core.ErrEmptyFile, path)
+	}
+
+	return buff, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kl *keyLoader) IsInterfaceNil() bool {
+	return kl == nil
+}
@@ -0,0 +1,208 @@
+package keystore_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type passphraseProviderStub struct {
+	PassphraseCalled func() ([]byte, error)
+}
+
+func (stub *passphraseProviderStub) Passphrase() ([]byte, error) {
+	if stub.PassphraseCalled != nil {
+		return stub.PassphraseCalled()
+	}
+
+	return passphrase, nil
+}
+
+func (stub *passphraseProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func writeTestFile(t *testing.T, content []byte) string {
+	file := filepath.Join(t.TempDir(), "keys")
+	require.Nil(t, os.WriteFile(file, content, 0600))
+
+	return file
+}
+
+func writeTestKeystore(t *testing.T) string {
+	encrypted, err := keystore.Encrypt(pemContent, passphrase, createFastKDFConfig(keystore.ScryptKDF))
+	require.Nil(t, err)
+
+	return writeTestFile(t, encrypted)
+}
+
+func TestNewKeyLoader(t *testing.T) {
+	t.Parallel()
+
+	loader, err := keystore.NewKeyLoader(nil)
+	assert.True(t, check.IfNil(loader))
+	assert.Equal(t, keystore.ErrNilPassphraseProvider, err)
+
+	loader, err = keystore.NewKeyLoader(&passphraseProviderStub{})
+	assert.False(t, check.IfNil(loader))
+	assert.Nil(t, err)
+}
+
+func TestKeyLoader_LoadKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid index should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		_, _, err := loader.LoadKey(writeTestFile(t, pemContent), -1)
+		assert.Equal(t, core.ErrInvalidIndex, err)
+
+		_, _, err = loader.LoadKey(writeTestFile(t, pemContent), 2)
+		assert.True(t, errors.Is(err, core.ErrInvalidIndex))
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		_, _, err := loader.LoadKey(filepath.Join(t.TempDir(), "missing"), 0)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+
+		var keystoreErr *keystore.KeystoreError
+		assert.False(t, errors.As(err, &keystoreErr))
+	})
+	t.Run("plain pem file should not request the passphrase", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+		sk, pk, err := loader.LoadKey(writeTestFile(t, pemContent), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sk11"), sk)
+		assert.Equal(t, "pk1", pk)
+	})
+	t.Run("keystore file should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		sk, pk, err := loader.LoadKey(writeTestKeystore(t), 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sk00"), sk)
+		assert.Equal(t, "pk0", pk)
+	})
+	t.Run("passphrase provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				return nil, expectedErr
+			},
+		})
+		_, _, err := loader.LoadKey(writeTestKeystore(t), 0)
+		assert.True(t, errors.Is(err, expectedErr))
+
+		var keystoreErr *keystore.KeystoreError
+		assert.True(t, errors.As(err, &keystoreErr))
+	})
+	t.Run("wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{
+			PassphraseCalled: func() ([]byte, error) {
+				return []byte("wrong"), nil
+			},
+		})
+		_, _, err := loader.LoadKey(writeTestKeystore(t), 0)
+		assert.True(t, errors.Is(err, keystore.ErrDecryptionFailed))
+
+		var keystoreErr *keystore.KeystoreError
+		assert.True(t, errors.As(err, &keystoreErr))
+	})
+}
+
+func TestKeyLoader_LoadAllKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid pem should error", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		_, _, err := loader.LoadAllKeys(writeTestFile(t, []byte("not a pem")))
+		assert.True(t, errors.Is(err, core.ErrPemFileIsInvalid))
+	})
+	t.Run("plain pem file should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		sks, pks, err := loader.LoadAllKeys(writeTestFile(t, pemContent))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("sk00"), []byte("sk11")}, sks)
+		assert.Equal(t, []string{"pk0", "pk1"}, pks)
+	})
+	t.Run("keystore file should work", func(t *testing.T) {
+		t.Parallel()
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		sks, pks, err := loader.LoadAllKeys(writeTestKeystore(t))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("sk00"), []byte("sk11")}, sks)
+		assert.Equal(t, []string{"pk0", "pk1"}, pks)
+	})
+}
+
+func TestConvertPemFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid pem should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := filepath.Join(t.TempDir(), "out.json")
+		_, err := keystore.ConvertPemFile(writeTestFile(t, []byte("not a pem")), output, passphrase, createFastKDFConfig(keystore.ScryptKDF))
+		assert.True(t, errors.Is(err, core.ErrPemFileIsInvalid))
+		_, errStat := os.Stat(output)
+		assert.True(t, os.IsNotExist(errStat))
+	})
+	t.Run("keystore input should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := filepath.Join(t.TempDir(), "out.json")
+		_, err := keystore.ConvertPemFile(writeTestKeystore(t), output, passphrase, createFastKDFConfig(keystore.ScryptKDF))
+		assert.True(t, errors.Is(err, keystore.ErrAlreadyAKeystore))
+	})
+	t.Run("existing output should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := writeTestFile(t, []byte("existing"))
+		_, err := keystore.ConvertPemFile(writeTestFile(t, pemContent), output, passphrase, createFastKDFConfig(keystore.ScryptKDF))
+		assert.True(t, errors.Is(err, keystore.ErrKeystoreAlreadyExists))
+
+		content, _ := os.ReadFile(output)
+		assert.Equal(t, []byte("existing"), content)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		output := filepath.Join(t.TempDir(), "out.json")
+		numKeys, err := keystore.ConvertPemFile(writeTestFile(t, pemContent), output, passphrase, createFastKDFConfig(keystore.Argon2idKDF))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numKeys)
+
+		loader, _ := keystore.NewKeyLoader(&passphraseProviderStub{})
+		sks, pks, err := loader.LoadAllKeys(output)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("sk00"), []byte("sk11")}, sks)
+		assert.Equal(t, []string{"pk0", "pk1"}, pks)
+	})
+}
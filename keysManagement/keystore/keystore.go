@@ -0,0 +1,248 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// ScryptKDF is the identifier of the scrypt key derivation function
+	ScryptKDF = "scrypt"
+	// Argon2idKDF is the identifier of the argon2id key derivation function
+	Argon2idKDF = "argon2id"
+
+	currentVersion  = 1
+	aesGCMCipher    = "aes-256-gcm"
+	derivedKeyLen   = 32
+	saltLen         = 32
+	keystoreMarker  = `"kdfparams"`
+	defaultScryptN  = 1 << 18
+	defaultScryptR  = 8
+	defaultScryptP  = 1
+	defaultArgon2T  = 3
+	defaultArgon2M  = 64 * 1024
+	defaultArgon2Th = 4
+)
+
+// KDFConfig defines the key derivation function used to obtain the encryption key from the passphrase,
+// together with its cost parameters
+type KDFConfig struct {
+	Type          string
+	ScryptN       int
+	ScryptR       int
+	ScryptP       int
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+type kdfParams struct {
+	Salt          string `json:"salt"`
+	DerivedKeyLen int    `json:"dklen"`
+	N             int    `json:"n,omitempty"`
+	R             int    `json:"r,omitempty"`
+	P             int    `json:"p,omitempty"`
+	Time          uint32 `json:"time,omitempty"`
+	Memory        uint32 `json:"memory,omitempty"`
+	Threads       uint8  `json:"threads,omitempty"`
+}
+
+type keystoreFile struct {
+	Version    int       `json:"version"`
+	KDF        string    `json:"kdf"`
+	KDFParams  kdfParams `json:"kdfparams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	CipherText string    `json:"ciphertext"`
+}
+
+// NewDefaultKDFConfig returns the recommended cost parameters for the provided key derivation function
+func NewDefaultKDFConfig(kdfType string) (KDFConfig, error) {
+	switch kdfType {
+	case ScryptKDF:
+		return KDFConfig{
+			Type:    ScryptKDF,
+			ScryptN: defaultScryptN,
+			ScryptR: defaultScryptR,
+			ScryptP: defaultScryptP,
+		}, nil
+	case Argon2idKDF:
+		return KDFConfig{
+			Type:          Argon2idKDF,
+			Argon2Time:    defaultArgon2T,
+			Argon2Memory:  defaultArgon2M,
+			Argon2Threads: defaultArgon2Th,
+		}, nil
+	default:
+		return KDFConfig{}, fmt.Errorf("%w: %s", ErrUnknownKDF, kdfType)
+	}
+}
+
+// IsKeystore returns true if the provided data looks like a keystore file. Plain PEM files are not keystores
+func IsKeystore(data []byte) bool {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return false
+	}
+
+	return bytes.Contains(trimmed, []byte(keystoreMarker))
+}
+
+// Encrypt encrypts the plaintext (usually the content of a PEM file) using AES-GCM with a key derived from
+// the passphrase and returns the JSON encoded keystore
+func Encrypt(plaintext []byte, passphrase []byte, kdf KDFConfig) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	salt := make([]byte, saltLen)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	params := kdfParams{
+		Salt:          hex.EncodeToString(salt),
+		DerivedKeyLen: derivedKeyLen,
+		N:             kdf.ScryptN,
+		R:             kdf.ScryptR,
+		P:             kdf.ScryptP,
+		Time:          kdf.Argon2Time,
+		Memory:        kdf.Argon2Memory,
+		Threads:       kdf.Argon2Threads,
+	}
+	if kdf.Type == Argon2idKDF {
+		params.N, params.R, params.P = 0, 0, 0
+	} else {
+		params.Time, params.Memory, params.Threads = 0, 0, 0
+	}
+
+	key, err := deriveKey(kdf.Type, passphrase, params)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &keystoreFile{
+		Version:   currentVersion,
+		KDF:       kdf.Type,
+		KDFParams: params,
+		Cipher:    aesGCMCipher,
+		Nonce:     hex.EncodeToString(nonce),
+	}
+	ks.CipherText = hex.EncodeToString(aead.Seal(nil, nonce, plaintext, ks.additionalData()))
+
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// Decrypt decodes the JSON encoded keystore and returns the decrypted plaintext
+func Decrypt(data []byte, passphrase []byte) ([]byte, error) {
+	if !IsKeystore(data) {
+		return nil, ErrNotAKeystore
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	ks := &keystoreFile{}
+	err := json.Unmarshal(data, ks)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the keystore", err)
+	}
+	if ks.Version != currentVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ks.Version)
+	}
+	if ks.Cipher != aesGCMCipher {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCipher, ks.Cipher)
+	}
+
+	nonce, err := hex.DecodeString(ks.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the keystore nonce", err)
+	}
+	cipherText, err := hex.DecodeString(ks.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the keystore cipher text", err)
+	}
+
+	key, err := deriveKey(ks.KDF, passphrase, ks.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce length %d", ErrDecryptionFailed, len(nonce))
+	}
+
+	plaintext, err := aead.Open(nil, nonce, cipherText, ks.additionalData())
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return plaintext, nil
+}
+
+func deriveKey(kdfType string, passphrase []byte, params kdfParams) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the keystore salt", err)
+	}
+	if len(salt) == 0 || params.DerivedKeyLen != derivedKeyLen {
+		return nil, fmt.Errorf("%w: salt length %d, derived key length %d", ErrInvalidKDFParams, len(salt), params.DerivedKeyLen)
+	}
+
+	switch kdfType {
+	case ScryptKDF:
+		key, errDerive := scrypt.Key(passphrase, salt, params.N, params.R, params.P, params.DerivedKeyLen)
+		if errDerive != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidKDFParams, errDerive.Error())
+		}
+
+		return key, nil
+	case Argon2idKDF:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, fmt.Errorf("%w: time %d, memory %d, threads %d", ErrInvalidKDFParams, params.Time, params.Memory, params.Threads)
+		}
+
+		return argon2.IDKey(passphrase, salt, params.Time, params.Memory, params.Threads, uint32(params.DerivedKeyLen)), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKDF, kdfType)
+	}
+}
+
+func createAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the format identifiers to the cipher text so they can not be swapped without
+// failing the authentication. The KDF parameters are already bound through the derived key.
+func (ks *keystoreFile) additionalData() []byte {
+	return []byte(fmt.Sprintf("%d|%s|%s", ks.Version, ks.KDF, ks.Cipher))
+}
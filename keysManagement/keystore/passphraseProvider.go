@@ -0,0 +1,140 @@
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+const (
+	promptMessage        = "Enter the keystore passphrase: "
+	confirmPromptMessage = "Repeat the keystore passphrase: "
+)
+
+// ArgsPassphraseProvider represents the arguments for the passphrase provider. The sources are tried in the
+// following order: file, environment variable and, if enabled, the interactive prompt
+type ArgsPassphraseProvider struct {
+	PassphraseFile        string
+	PassphraseEnvVariable string
+	PromptEnabled         bool
+	ConfirmPrompt         bool
+}
+
+type passphraseProvider struct {
+	passphraseFile        string
+	passphraseEnvVariable string
+	promptEnabled         bool
+	confirmPrompt         bool
+	promptHandler         func(message string) ([]byte, error)
+
+	mut        sync.Mutex
+	passphrase []byte
+}
+
+// NewPassphraseProvider creates a new passphrase provider. The passphrase is resolved lazily, on the first
+// request, and then cached so the operator is prompted at most once
+func NewPassphraseProvider(args ArgsPassphraseProvider) *passphraseProvider {
+	return &passphraseProvider{
+		passphraseFile:        args.PassphraseFile,
+		passphraseEnvVariable: args.PassphraseEnvVariable,
+		promptEnabled:         args.PromptEnabled,
+		confirmPrompt:         args.ConfirmPrompt,
+		promptHandler:         promptFromTerminal,
+	}
+}
+
+// Passphrase returns the passphrase read from the first available source
+func (provider *passphraseProvider) Passphrase() ([]byte, error) {
+	provider.mut.Lock()
+	defer provider.mut.Unlock()
+
+	if len(provider.passphrase) > 0 {
+		return provider.passphrase, nil
+	}
+
+	passphrase, err := provider.readPassphrase()
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	provider.passphrase = passphrase
+
+	return passphrase, nil
+}
+
+func (provider *passphraseProvider) readPassphrase() ([]byte, error) {
+	if len(provider.passphraseFile) > 0 {
+		content, err := os.ReadFile(provider.passphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the passphrase file %s", err, provider.passphraseFile)
+		}
+
+		return trimLineEnding(content), nil
+	}
+
+	if len(provider.passphraseEnvVariable) > 0 {
+		value, found := os.LookupEnv(provider.passphraseEnvVariable)
+		if found {
+			return []byte(value), nil
+		}
+
+		return nil, fmt.Errorf("%w: environment variable %s is not set", ErrNoPassphraseSource, provider.passphraseEnvVariable)
+	}
+
+	if provider.promptEnabled {
+		return provider.prompt()
+	}
+
+	return nil, ErrNoPassphraseSource
+}
+
+func (provider *passphraseProvider) prompt() ([]byte, error) {
+	passphrase, err := provider.promptHandler(promptMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !provider.confirmPrompt {
+		return passphrase, nil
+	}
+
+	confirmation, err := provider.promptHandler(confirmPromptMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(passphrase, confirmation) {
+		return nil, ErrPassphraseMismatch
+	}
+
+	return passphrase, nil
+}
+
+func trimLineEnding(content []byte) []byte {
+	return bytes.TrimRight(content, "\r\n")
+}
+
+func promptFromTerminal(message string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, ErrNoPassphraseSource
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, message)
+	passphrase, err := term.ReadPassword(fd)
+	_, _ = fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the passphrase", err)
+	}
+
+	return passphrase, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *passphraseProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
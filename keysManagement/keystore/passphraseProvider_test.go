@@ -0,0 +1,148 @@
+package keystore_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPassphraseProvider(t *testing.T) {
+	t.Parallel()
+
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{})
+	assert.False(t, check.IfNil(provider))
+}
+
+func TestPassphraseProvider_Passphrase(t *testing.T) {
+	t.Run("no source should error", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, result)
+		assert.Equal(t, keystore.ErrNoPassphraseSource, err)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PassphraseFile: filepath.Join(t.TempDir(), "missing"),
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+	})
+	t.Run("from file should trim the line ending", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "passphrase")
+		require.Nil(t, os.WriteFile(file, []byte("secret \r\n"), 0600))
+
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PassphraseFile:        file,
+			PassphraseEnvVariable: "KEYSTORE_TEST_UNUSED",
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("secret "), result)
+	})
+	t.Run("empty file should error", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "passphrase")
+		require.Nil(t, os.WriteFile(file, []byte("\n"), 0600))
+
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PassphraseFile: file,
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, result)
+		assert.Equal(t, keystore.ErrEmptyPassphrase, err)
+	})
+	t.Run("from environment variable should work", func(t *testing.T) {
+		t.Setenv("KEYSTORE_TEST_PASSPHRASE", "secret")
+
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PassphraseEnvVariable: "KEYSTORE_TEST_PASSPHRASE",
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("secret"), result)
+	})
+	t.Run("unset environment variable should error", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PassphraseEnvVariable: "KEYSTORE_TEST_NOT_SET",
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, keystore.ErrNoPassphraseSource))
+	})
+	t.Run("prompt should be called once", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PromptEnabled: true,
+		})
+		numCalls := 0
+		provider.SetPromptHandler(func(message string) ([]byte, error) {
+			numCalls++
+			return []byte("secret"), nil
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("secret"), result)
+
+		result, err = provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("secret"), result)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("prompt with confirmation should work", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PromptEnabled: true,
+			ConfirmPrompt: true,
+		})
+		numCalls := 0
+		provider.SetPromptHandler(func(message string) ([]byte, error) {
+			numCalls++
+			return []byte("secret"), nil
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("secret"), result)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("prompt with different confirmation should error", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PromptEnabled: true,
+			ConfirmPrompt: true,
+		})
+		numCalls := 0
+		provider.SetPromptHandler(func(message string) ([]byte, error) {
+			numCalls++
+			return []byte(fmt.Sprintf("secret%d", numCalls)), nil
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, result)
+		assert.Equal(t, keystore.ErrPassphraseMismatch, err)
+	})
+	t.Run("prompt error should be returned", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			PromptEnabled: true,
+		})
+		expectedErr := errors.New("expected error")
+		provider.SetPromptHandler(func(message string) ([]byte, error) {
+			return nil, expectedErr
+		})
+
+		result, err := provider.Passphrase()
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
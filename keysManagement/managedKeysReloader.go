@@ -0,0 +1,456 @@
+package keysManagement
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const minFileWatchInterval = time.Second
+
+// ArgsManagedKeysReloader represents the arguments for the managed keys reloader
+type ArgsManagedKeysReloader struct {
+	ManagedPeersHolder    common.ManagedPeersHolder
+	ConsensusKeysChecker  ConsensusKeysChecker
+	KeyLoader             KeyLoader
+	KeyGenerator          crypto.KeyGenerator
+	PubKeyConverter       core.PubkeyConverter
+	AllValidatorsKeysFile string
+	FileWatcherEnabled    bool
+	FileWatchInterval     time.Duration
+}
+
+type managedKeysReloader struct {
+	managedPeersHolder    common.ManagedPeersHolder
+	consensusKeysChecker  ConsensusKeysChecker
+	keyLoader             KeyLoader
+	keyGenerator          crypto.KeyGenerator
+	pubKeyConverter       core.PubkeyConverter
+	allValidatorsKeysFile string
+	fileWatchInterval     time.Duration
+
+	mutOperation     sync.Mutex
+	lastFileChecksum []byte
+	hasPending       bool
+	runtimeKeys      map[string]struct{}
+	cancelFunc       func()
+}
+
+type managedKey struct {
+	pkBytes []byte
+	skBytes []byte
+}
+
+type managedKeysDiff struct {
+	toAdd    []managedKey
+	toRemove [][]byte
+	pending  [][]byte
+}
+
+// NewManagedKeysReloader creates a component able to add and remove managed keys while the node is running. The keys
+// can be changed either by direct calls (admin API) or by editing the all validators keys file, if the file watcher is enabled.
+// A key can only be removed while it is not part of the consensus group of the current round. Keys added by direct calls
+// are not written in the file, so they are never removed by a file reload, only by a direct removal call.
+func NewManagedKeysReloader(args ArgsManagedKeysReloader) (*managedKeysReloader, error) {
+	err := checkManagedKeysReloaderArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader := &managedKeysReloader{
+		managedPeersHolder:    args.ManagedPeersHolder,
+		consensusKeysChecker:  args.ConsensusKeysChecker,
+		keyLoader:             args.KeyLoader,
+		keyGenerator:          args.KeyGenerator,
+		pubKeyConverter:       args.PubKeyConverter,
+		allValidatorsKeysFile: args.AllValidatorsKeysFile,
+		fileWatchInterval:     args.FileWatchInterval,
+		runtimeKeys:           make(map[string]struct{}),
+		cancelFunc:            func() {},
+	}
+	reloader.lastFileChecksum, _ = reloader.computeFileChecksum()
+
+	if args.FileWatcherEnabled && args.ManagedPeersHolder.IsMultiKeyMode() {
+		var ctx context.Context
+		ctx, reloader.cancelFunc = context.WithCancel(context.Background())
+		go reloader.watchFile(ctx)
+	}
+
+	return reloader, nil
+}
+
+func checkManagedKeysReloaderArgs(args ArgsManagedKeysReloader) error {
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.ConsensusKeysChecker) {
+		return ErrNilConsensusKeysChecker
+	}
+	if check.IfNil(args.KeyLoader) {
+		return ErrNilKeyLoader
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if args.FileWatcherEnabled && args.FileWatchInterval < minFileWatchInterval {
+		return fmt.Errorf("%w for FileWatchInterval, minimum %v, provided %v", ErrInvalidValue, minFileWatchInterval, args.FileWatchInterval)
+	}
+
+	return nil
+}
+
+// AddManagedKey adds the provided private key to the set of keys managed by the node and returns the
+// associated hex encoded public key
+func (reloader *managedKeysReloader) AddManagedKey(privateKeyBytes []byte) (string, error) {
+	reloader.mutOperation.Lock()
+	defer reloader.mutOperation.Unlock()
+
+	if !reloader.managedPeersHolder.IsMultiKeyMode() {
+		return "", ErrNotInMultiKeyMode
+	}
+
+	pkBytes, err := reloader.computePublicKey(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	err = reloader.managedPeersHolder.AddManagedPeer(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	reloader.runtimeKeys[string(pkBytes)] = struct{}{}
+
+	pkHex := hex.EncodeToString(pkBytes)
+	log.Info("managed key added at runtime", "public key", pkHex)
+
+	return pkHex, nil
+}
+
+// RemoveManagedKey removes the provided public key from the set of keys managed by the node
+func (reloader *managedKeysReloader) RemoveManagedKey(pkBytes []byte) error {
+	reloader.mutOperation.Lock()
+	defer reloader.mutOperation.Unlock()
+
+	if !reloader.managedPeersHolder.IsKeyRegistered(pkBytes) {
+		return fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+	err := reloader.checkKeyCanBeRemoved(pkBytes)
+	if err != nil {
+		return err
+	}
+	if len(reloader.managedPeersHolder.GetLoadedKeysByCurrentNode()) == 1 {
+		return ErrCannotRemoveLastManagedKey
+	}
+
+	err = reloader.managedPeersHolder.RemoveManagedPeer(pkBytes)
+	if err != nil {
+		return err
+	}
+	delete(reloader.runtimeKeys, string(pkBytes))
+
+	log.Info("managed key removed at runtime", "public key", hex.EncodeToString(pkBytes))
+
+	return nil
+}
+
+func (reloader *managedKeysReloader) checkKeyCanBeRemoved(pkBytes []byte) error {
+	if reloader.consensusKeysChecker.IsKeyInCurrentConsensus(pkBytes) {
+		return fmt.Errorf("%w for public key %s", ErrKeyInCurrentConsensus, hex.EncodeToString(pkBytes))
+	}
+
+	return nil
+}
+
+// ReloadFromFile reads the all validators keys file and applies the differences between the file content and the
+// currently managed keys. Keys that can not be removed yet because they are part of the current consensus group are
+// reported as pending and will be retried by the file watcher, if enabled
+func (reloader *managedKeysReloader) ReloadFromFile() (*common.ManagedKeysReloadResult, error) {
+	reloader.mutOperation.Lock()
+	defer reloader.mutOperation.Unlock()
+
+	return reloader.reloadFromFile()
+}
+
+func (reloader *managedKeysReloader) reloadFromFile() (*common.ManagedKeysReloadResult, error) {
+	if !reloader.managedPeersHolder.IsMultiKeyMode() {
+		return nil, ErrNotInMultiKeyMode
+	}
+
+	fileKeys, err := reloader.loadKeysFromFile()
+	if err != nil {
+		return nil, err
+	}
+	if len(fileKeys) == 0 {
+		return nil, ErrCannotRemoveLastManagedKey
+	}
+
+	diff := reloader.computeDiff(fileKeys)
+	err = reloader.applyDiff(diff)
+	if err != nil {
+		return nil, err
+	}
+
+	// a key added at runtime that is now written in the file becomes managed by the file
+	for pk := range fileKeys {
+		delete(reloader.runtimeKeys, pk)
+	}
+
+	result := &common.ManagedKeysReloadResult{
+		Added:   make([]string, 0, len(diff.toAdd)),
+		Removed: make([]string, 0, len(diff.toRemove)),
+		Pending: make([]string, 0, len(diff.pending)),
+	}
+	for _, key := range diff.toAdd {
+		result.Added = append(result.Added, hex.EncodeToString(key.pkBytes))
+	}
+	for _, pkBytes := range diff.toRemove {
+		result.Removed = append(result.Removed, hex.EncodeToString(pkBytes))
+	}
+	for _, pkBytes := range diff.pending {
+		result.Pending = append(result.Pending, hex.EncodeToString(pkBytes))
+	}
+
+	sort.Strings(result.Added)
+	reloader.hasPending = len(result.Pending) > 0
+
+	return result, nil
+}
+
+// computeDiff returns the keys to be added, the keys to be removed and the keys whose removal is deferred, without
+// changing the managed keys. The keys added at runtime through direct calls are not part of the file, so they are kept
+func (reloader *managedKeysReloader) computeDiff(fileKeys map[string][]byte) *managedKeysDiff {
+	diff := &managedKeysDiff{
+		toAdd:    make([]managedKey, 0),
+		toRemove: make([][]byte, 0),
+		pending:  make([][]byte, 0),
+	}
+
+	for pk, skBytes := range fileKeys {
+		if reloader.managedPeersHolder.IsKeyRegistered([]byte(pk)) {
+			continue
+		}
+
+		diff.toAdd = append(diff.toAdd, managedKey{
+			pkBytes: []byte(pk),
+			skBytes: skBytes,
+		})
+	}
+
+	loadedKeys := reloader.managedPeersHolder.GetLoadedKeysByCurrentNode()
+	for _, pkBytes := range loadedKeys {
+		_, foundInFile := fileKeys[string(pkBytes)]
+		_, isRuntimeKey := reloader.runtimeKeys[string(pkBytes)]
+		if foundInFile || isRuntimeKey {
+			continue
+		}
+
+		err := reloader.checkKeyCanBeRemoved(pkBytes)
+		if err != nil {
+			log.Debug("managed key could not be removed yet", "public key", hex.EncodeToString(pkBytes), "reason", err)
+			diff.pending = append(diff.pending, pkBytes)
+			continue
+		}
+
+		diff.toRemove = append(diff.toRemove, pkBytes)
+	}
+
+	return diff
+}
+
+// applyDiff applies the whole diff or, in case of an error, restores the managed keys as they were before the call.
+// The new keys are added first so the removal of all the previous keys is possible
+func (reloader *managedKeysReloader) applyDiff(diff *managedKeysDiff) error {
+	addedKeys := make([]managedKey, 0, len(diff.toAdd))
+	removedKeys := make([]managedKey, 0, len(diff.toRemove))
+
+	var err error
+	for _, key := range diff.toAdd {
+		err = reloader.managedPeersHolder.AddManagedPeer(key.skBytes)
+		if err != nil {
+			reloader.rollback(addedKeys, removedKeys)
+			return err
+		}
+
+		addedKeys = append(addedKeys, key)
+	}
+
+	for _, pkBytes := range diff.toRemove {
+		skBytes, errGet := reloader.getPrivateKeyBytes(pkBytes)
+		if errGet != nil {
+			reloader.rollback(addedKeys, removedKeys)
+			return errGet
+		}
+
+		err = reloader.managedPeersHolder.RemoveManagedPeer(pkBytes)
+		if err != nil {
+			reloader.rollback(addedKeys, removedKeys)
+			return err
+		}
+
+		removedKeys = append(removedKeys, managedKey{
+			pkBytes: pkBytes,
+			skBytes: skBytes,
+		})
+	}
+
+	for _, key := range addedKeys {
+		log.Info("managed key added at runtime", "public key", hex.EncodeToString(key.pkBytes))
+	}
+	for _, pkBytes := range diff.toRemove {
+		log.Info("managed key removed at runtime", "public key", hex.EncodeToString(pkBytes))
+	}
+
+	return nil
+}
+
+func (reloader *managedKeysReloader) rollback(addedKeys []managedKey, removedKeys []managedKey) {
+	for _, key := range removedKeys {
+		err := reloader.managedPeersHolder.AddManagedPeer(key.skBytes)
+		if err != nil {
+			log.Error("managedKeysReloader: could not restore a removed key",
+				"public key", hex.EncodeToString(key.pkBytes), "error", err)
+		}
+	}
+
+	for _, key := range addedKeys {
+		err := reloader.managedPeersHolder.RemoveManagedPeer(key.pkBytes)
+		if err != nil {
+			log.Error("managedKeysReloader: could not revert an added key",
+				"public key", hex.EncodeToString(key.pkBytes), "error", err)
+		}
+	}
+}
+
+func (reloader *managedKeysReloader) getPrivateKeyBytes(pkBytes []byte) ([]byte, error) {
+	privateKey, err := reloader.managedPeersHolder.GetPrivateKey(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey.ToByteArray()
+}
+
+func (reloader *managedKeysReloader) loadKeysFromFile() (map[string][]byte, error) {
+	privateKeys, publicKeys, err := reloader.keyLoader.LoadAllKeys(reloader.allValidatorsKeysFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(privateKeys) != len(publicKeys) {
+		return nil, fmt.Errorf("%w: mismatch number of private and public keys", ErrInvalidKey)
+	}
+
+	fileKeys := make(map[string][]byte, len(privateKeys))
+	for i, encodedSk := range privateKeys {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key, key index %d", errDecode, i)
+		}
+
+		pkBytes, errCompute := reloader.computePublicKey(skBytes)
+		if errCompute != nil {
+			return nil, fmt.Errorf("%w, key index %d", errCompute, i)
+		}
+
+		readPkBytes, errDecode := reloader.pubKeyConverter.Decode(publicKeys[i])
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded public key %s, key index %d", errDecode, publicKeys[i], i)
+		}
+		if !bytes.Equal(pkBytes, readPkBytes) {
+			return nil, fmt.Errorf("%w: public keys mismatch, read %s, key index %d", ErrInvalidKey, publicKeys[i], i)
+		}
+
+		fileKeys[string(pkBytes)] = skBytes
+	}
+
+	return fileKeys, nil
+}
+
+func (reloader *managedKeysReloader) computePublicKey(privateKeyBytes []byte) ([]byte, error) {
+	privateKey, err := reloader.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKey, err.Error())
+	}
+
+	return privateKey.GeneratePublic().ToByteArray()
+}
+
+func (reloader *managedKeysReloader) computeFileChecksum() ([]byte, error) {
+	content, err := os.ReadFile(reloader.allValidatorsKeysFile)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(content)
+
+	return checksum[:], nil
+}
+
+func (reloader *managedKeysReloader) watchFile(ctx context.Context) {
+	timer := time.NewTimer(reloader.fileWatchInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing managedKeysReloader.watchFile go routine")
+			return
+		case <-timer.C:
+			reloader.checkFile()
+			timer.Reset(reloader.fileWatchInterval)
+		}
+	}
+}
+
+func (reloader *managedKeysReloader) checkFile() {
+	reloader.mutOperation.Lock()
+	defer reloader.mutOperation.Unlock()
+
+	checksum, err := reloader.computeFileChecksum()
+	if err != nil {
+		log.Debug("managedKeysReloader: can not read the all validators keys file", "error", err)
+		return
+	}
+
+	isFileChanged := !bytes.Equal(checksum, reloader.lastFileChecksum)
+	if !isFileChanged && !reloader.hasPending {
+		return
+	}
+
+	result, err := reloader.reloadFromFile()
+	if err != nil {
+		log.Warn("managedKeysReloader: could not reload the managed keys", "error", err)
+		return
+	}
+
+	reloader.lastFileChecksum = checksum
+	log.Info("managed keys reloaded from file",
+		"added", len(result.Added),
+		"removed", len(result.Removed),
+		"pending removal", len(result.Pending))
+}
+
+// Close stops the file watcher, if started
+func (reloader *managedKeysReloader) Close() error {
+	reloader.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reloader *managedKeysReloader) IsInterfaceNil() bool {
+	return reloader == nil
+}
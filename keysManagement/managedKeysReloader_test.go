@@ -0,0 +1,450 @@
+package keysManagement_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	skBytes2    = []byte("private key 2")
+	pkBytes2    = []byte("public key 2")
+	expectedErr = errors.New("expected error")
+)
+
+type keyLoaderStub struct {
+	loadAllKeysCalled func(path string) ([][]byte, []string, error)
+}
+
+func (stub *keyLoaderStub) LoadAllKeys(path string) ([][]byte, []string, error) {
+	return stub.loadAllKeysCalled(path)
+}
+
+func (stub *keyLoaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createKeyLoaderForKeys(secretKeys ...[]byte) *keyLoaderStub {
+	return &keyLoaderStub{
+		loadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+			privateKeys := make([][]byte, 0, len(secretKeys))
+			publicKeys := make([]string, 0, len(secretKeys))
+			for _, sk := range secretKeys {
+				privateKeys = append(privateKeys, []byte(hex.EncodeToString(sk)))
+				pk := strings.Replace(string(sk), "private", "public", -1)
+				publicKeys = append(publicKeys, hex.EncodeToString([]byte(pk)))
+			}
+
+			return privateKeys, publicKeys, nil
+		},
+	}
+}
+
+func createMockArgsManagedKeysReloader(tb testing.TB) keysManagement.ArgsManagedKeysReloader {
+	holder, err := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+	require.Nil(tb, err)
+
+	return keysManagement.ArgsManagedKeysReloader{
+		ManagedPeersHolder:    holder,
+		ConsensusKeysChecker:  &testscommon.KeysHandlerStub{},
+		KeyLoader:             createKeyLoaderForKeys(skBytes0, skBytes1),
+		KeyGenerator:          createMockKeyGenerator(),
+		PubKeyConverter:       &testscommon.PubkeyConverterStub{DecodeCalled: hex.DecodeString},
+		AllValidatorsKeysFile: "allValidatorsKeys.pem",
+		FileWatcherEnabled:    false,
+		FileWatchInterval:     time.Second,
+	}
+}
+
+func TestNewManagedKeysReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.ManagedPeersHolder = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil consensus keys checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.ConsensusKeysChecker = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.Equal(t, keysManagement.ErrNilConsensusKeysChecker, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil key loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.KeyLoader = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.Equal(t, keysManagement.ErrNilKeyLoader, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.KeyGenerator = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.PubKeyConverter = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.Equal(t, keysManagement.ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("invalid file watch interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.FileWatcherEnabled = true
+		args.FileWatchInterval = time.Millisecond
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "FileWatchInterval"))
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(reloader))
+		assert.Nil(t, reloader.Close())
+	})
+}
+
+func TestManagedKeysReloader_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not in multi key mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		pkHex, err := reloader.AddManagedKey(skBytes0)
+		assert.Equal(t, keysManagement.ErrNotInMultiKeyMode, err)
+		assert.Empty(t, pkHex)
+	})
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		args.KeyGenerator = &cryptoMocks.KeyGenStub{
+			PrivateKeyFromByteArrayStub: func(b []byte) (crypto.PrivateKey, error) {
+				return nil, expectedErr
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		pkHex, err := reloader.AddManagedKey(skBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidKey))
+		assert.Empty(t, pkHex)
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		pkHex, err := reloader.AddManagedKey(skBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrDuplicatedKey))
+		assert.Empty(t, pkHex)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		pkHex, err := reloader.AddManagedKey(skBytes1)
+		assert.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(pkBytes1), pkHex)
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+	})
+}
+
+func TestManagedKeysReloader_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		err := reloader.RemoveManagedKey(pkBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+	})
+	t.Run("key in current consensus should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes1)
+		args.ConsensusKeysChecker = &testscommon.KeysHandlerStub{
+			IsKeyInCurrentConsensusCalled: func(pkBytes []byte) bool {
+				return string(pkBytes) == string(pkBytes1)
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		err := reloader.RemoveManagedKey(pkBytes1)
+		assert.True(t, errors.Is(err, keysManagement.ErrKeyInCurrentConsensus))
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+	})
+	t.Run("last key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		err := reloader.RemoveManagedKey(pkBytes0)
+		assert.Equal(t, keysManagement.ErrCannotRemoveLastManagedKey, err)
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes0))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes1)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		err := reloader.RemoveManagedKey(pkBytes1)
+		assert.Nil(t, err)
+		assert.False(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+		assert.False(t, args.ManagedPeersHolder.IsKeyManagedByCurrentNode(pkBytes1))
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes0))
+	})
+}
+
+func TestManagedKeysReloader_ReloadFromFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not in multi key mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		result, err := reloader.ReloadFromFile()
+		assert.Equal(t, keysManagement.ErrNotInMultiKeyMode, err)
+		assert.Nil(t, result)
+	})
+	t.Run("key loader errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		args.KeyLoader = &keyLoaderStub{
+			loadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		result, err := reloader.ReloadFromFile()
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, result)
+	})
+	t.Run("public key mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		args.KeyLoader = &keyLoaderStub{
+			loadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return [][]byte{[]byte(hex.EncodeToString(skBytes1))}, []string{hex.EncodeToString(pkBytes0)}, nil
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		result, err := reloader.ReloadFromFile()
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidKey))
+		assert.Nil(t, result)
+		assert.False(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+	})
+	t.Run("empty file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		args.KeyLoader = createKeyLoaderForKeys()
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		result, err := reloader.ReloadFromFile()
+		assert.Equal(t, keysManagement.ErrCannotRemoveLastManagedKey, err)
+		assert.Nil(t, result)
+	})
+	t.Run("should add, remove and defer the removal of keys in consensus", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes1)
+		args.KeyLoader = createKeyLoaderForKeys(skBytes2)
+		args.ConsensusKeysChecker = &testscommon.KeysHandlerStub{
+			IsKeyInCurrentConsensusCalled: func(pkBytes []byte) bool {
+				return string(pkBytes) == string(pkBytes1)
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		result, err := reloader.ReloadFromFile()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{hex.EncodeToString(pkBytes2)}, result.Added)
+		assert.Equal(t, []string{hex.EncodeToString(pkBytes0)}, result.Removed)
+		assert.Equal(t, []string{hex.EncodeToString(pkBytes1)}, result.Pending)
+		assert.False(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes0))
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes2))
+	})
+	t.Run("keys added at runtime should not be removed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		args.KeyLoader = createKeyLoaderForKeys(skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		_, err := reloader.AddManagedKey(skBytes1)
+		require.Nil(t, err)
+
+		result, err := reloader.ReloadFromFile()
+		assert.Nil(t, err)
+		assert.Empty(t, result.Removed)
+		assert.Empty(t, result.Pending)
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes0))
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+	})
+	t.Run("runtime key written in the file should be removed with the file", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+		fileKeys := [][]byte{skBytes0, skBytes1}
+		args.KeyLoader = &keyLoaderStub{
+			loadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return createKeyLoaderForKeys(fileKeys...).LoadAllKeys(path)
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		_, _ = reloader.AddManagedKey(skBytes1)
+		_, err := reloader.ReloadFromFile()
+		require.Nil(t, err)
+
+		fileKeys = [][]byte{skBytes0}
+		result, err := reloader.ReloadFromFile()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{hex.EncodeToString(pkBytes1)}, result.Removed)
+		assert.False(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+	})
+	t.Run("failure while applying should restore the previous keys", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+
+		args := createMockArgsManagedKeysReloader(t)
+		args.KeyLoader = createKeyLoaderForKeys(skBytes2)
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled:             holder.IsMultiKeyMode,
+			IsKeyRegisteredCalled:            holder.IsKeyRegistered,
+			GetLoadedKeysByCurrentNodeCalled: holder.GetLoadedKeysByCurrentNode,
+			GetPrivateKeyCalled:              holder.GetPrivateKey,
+			AddManagedPeerCalled:             holder.AddManagedPeer,
+			RemoveManagedPeerCalled: func(pkBytes []byte) error {
+				if string(pkBytes) == string(pkBytes1) {
+					return expectedErr
+				}
+
+				return holder.RemoveManagedPeer(pkBytes)
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+
+		result, err := reloader.ReloadFromFile()
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, result)
+		assert.True(t, holder.IsKeyRegistered(pkBytes0))
+		assert.True(t, holder.IsKeyRegistered(pkBytes1))
+		assert.False(t, holder.IsKeyRegistered(pkBytes2))
+	})
+}
+
+func TestManagedKeysReloader_FileWatcher(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+	require.Nil(t, os.WriteFile(filename, []byte("initial"), 0600))
+
+	fileKeys := atomic.Value{}
+	fileKeys.Store([][]byte{skBytes0})
+
+	inConsensus := atomic.Bool{}
+	inConsensus.Store(true)
+
+	args := createMockArgsManagedKeysReloader(t)
+	_ = args.ManagedPeersHolder.AddManagedPeer(skBytes0)
+	_ = args.ManagedPeersHolder.AddManagedPeer(skBytes1)
+	args.AllValidatorsKeysFile = filename
+	args.FileWatcherEnabled = true
+	args.FileWatchInterval = time.Second
+	args.KeyLoader = &keyLoaderStub{
+		loadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+			return createKeyLoaderForKeys(fileKeys.Load().([][]byte)...).LoadAllKeys(path)
+		},
+	}
+	args.ConsensusKeysChecker = &testscommon.KeysHandlerStub{
+		IsKeyInCurrentConsensusCalled: func(pkBytes []byte) bool {
+			return inConsensus.Load()
+		},
+	}
+	reloader, _ := keysManagement.NewManagedKeysReloader(args)
+	defer func() {
+		_ = reloader.Close()
+	}()
+
+	require.Nil(t, os.WriteFile(filename, []byte("changed"), 0600))
+	time.Sleep(time.Second + time.Millisecond*500)
+	assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1), "key in consensus should not be removed")
+
+	inConsensus.Store(false)
+	time.Sleep(time.Second)
+	assert.False(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1), "pending removal should have been retried")
+	assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes0))
+}
@@ -43,6 +43,7 @@ type cryptoComponentsHolder struct {
 	consensusSigningHandler       consensus.SigningHandler
 	managedPeersHolder            common.ManagedPeersHolder
 	keysHandler                   consensus.KeysHandler
+	managedKeysReloader           common.ManagedKeysReloader
 	publicKeyBytes                []byte
 	publicKeyString               string
 	managedCryptoComponentsCloser io.Closer
@@ -106,6 +107,7 @@ func CreateCryptoComponents(args ArgsCryptoComponentsHolder) (*cryptoComponentsH
 	instance.consensusSigningHandler = managedCryptoComponents.ConsensusSigningHandler()
 	instance.managedPeersHolder = managedCryptoComponents.ManagedPeersHolder()
 	instance.keysHandler = managedCryptoComponents.KeysHandler()
+	instance.managedKeysReloader = managedCryptoComponents.ManagedKeysReloader()
 	instance.managedCryptoComponentsCloser = managedCryptoComponents
 
 	if args.BypassTxSignatureCheck {
@@ -219,6 +221,11 @@ func (c *cryptoComponentsHolder) KeysHandler() consensus.KeysHandler {
 	return c.keysHandler
 }
 
+// ManagedKeysReloader will return the component able to change the managed keys at runtime
+func (c *cryptoComponentsHolder) ManagedKeysReloader() common.ManagedKeysReloader {
+	return c.managedKeysReloader
+}
+
 // Clone will clone the cryptoComponentsHolder
 func (c *cryptoComponentsHolder) Clone() interface{} {
 	return &cryptoComponentsHolder{
@@ -238,6 +245,7 @@ func (c *cryptoComponentsHolder) Clone() interface{} {
 		consensusSigningHandler:       c.ConsensusSigningHandler(),
 		managedPeersHolder:            c.ManagedPeersHolder(),
 		keysHandler:                   c.KeysHandler(),
+		managedKeysReloader:           c.ManagedKeysReloader(),
 		publicKeyBytes:                c.PublicKeyBytes(),
 		publicKeyString:               c.PublicKeyString(),
 		managedCryptoComponentsCloser: c.managedCryptoComponentsCloser,
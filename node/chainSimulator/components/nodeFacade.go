@@ -11,10 +11,12 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/forking"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/facade"
 	apiComp "github.com/multiversx/mx-chain-go/factory/api"
 	nodePack "github.com/multiversx/mx-chain-go/node"
 	"github.com/multiversx/mx-chain-go/node/metrics"
+	"github.com/multiversx/mx-chain-go/process/governanceParameters"
 	"github.com/multiversx/mx-chain-go/process/mock"
 )
 
@@ -26,11 +28,27 @@ func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInte
 		return err
 	}
 
+	metaBlockStorer, err := node.DataComponentsHolder.StorageService().GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return err
+	}
+
+	parameterChangesProvider, err := governanceParameters.NewParameterChangesProvider(governanceParameters.ArgsParameterChangesProvider{
+		Marshaller:         node.CoreComponentsHolder.InternalMarshalizer(),
+		MetaBlockStorer:    metaBlockStorer,
+		EpochStartNotifier: node.CoreComponentsHolder.EpochStartNotifierWithConfirm(),
+		CurrentEpoch:       node.BootstrapComponentsHolder.EpochBootstrapParams().Epoch(),
+	})
+	if err != nil {
+		return err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
-		GasScheduleConfig:  configs.EpochConfig.GasSchedule,
-		ConfigDir:          configs.ConfigurationPathsHolder.GasScheduleDirectoryName,
-		EpochNotifier:      node.CoreComponentsHolder.EpochNotifier(),
-		WasmVMChangeLocker: node.CoreComponentsHolder.WasmVMChangeLocker(),
+		GasScheduleConfig:        configs.EpochConfig.GasSchedule,
+		ConfigDir:                configs.ConfigurationPathsHolder.GasScheduleDirectoryName,
+		EpochNotifier:            node.CoreComponentsHolder.EpochNotifier(),
+		WasmVMChangeLocker:       node.CoreComponentsHolder.WasmVMChangeLocker(),
+		ParameterChangesProvider: parameterChangesProvider,
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/genesis"
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/governanceParameters"
 	"github.com/multiversx/mx-chain-go/process/interceptors/disabled"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -99,6 +100,7 @@ type processComponentsHolder struct {
 	accountsParser                   genesis.AccountsParser
 	sentSignatureTracker             process.SentSignaturesTracker
 	epochStartSystemSCProcessor      process.EpochStartSystemSCProcessor
+	interceptorsPriorityLanes        process.InterceptorPriorityLanesHandler
 	managedProcessComponentsCloser   io.Closer
 }
 
@@ -146,6 +148,11 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
 		Store:                    args.DataComponents.StorageService(),
 		Uint64ByteSliceConverter: args.CoreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         args.BootstrapComponents.ShardCoordinator(),
+		Accounts:                 args.StateComponents.AccountsAdapter(),
+		AccountsRepository:       args.StateComponents.AccountsRepository(),
+		AddressConverter:         args.CoreComponents.AddressPubKeyConverter(),
+		EnableEpochsHandler:      args.CoreComponents.EnableEpochsHandler(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -172,11 +179,32 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 
 	txExecutionOrderHandler := ordering.NewOrderedCollection()
 
+	metaBlockStorer, err := args.DataComponents.StorageService().GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	parameterChangesProvider, err := governanceParameters.NewParameterChangesProvider(governanceParameters.ArgsParameterChangesProvider{
+		Marshaller:         args.CoreComponents.InternalMarshalizer(),
+		MetaBlockStorer:    metaBlockStorer,
+		EpochStartNotifier: args.CoreComponents.EpochStartNotifierWithConfirm(),
+		CurrentEpoch:       args.BootstrapComponents.EpochBootstrapParams().Epoch(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.CoreComponents.EconomicsData().SetParameterChangesProvider(parameterChangesProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
-		GasScheduleConfig:  args.EpochConfig.GasSchedule,
-		ConfigDir:          args.ConfigurationPathsHolder.GasScheduleDirectoryName,
-		EpochNotifier:      args.CoreComponents.EpochNotifier(),
-		WasmVMChangeLocker: args.CoreComponents.WasmVMChangeLocker(),
+		GasScheduleConfig:        args.EpochConfig.GasSchedule,
+		ConfigDir:                args.ConfigurationPathsHolder.GasScheduleDirectoryName,
+		EpochNotifier:            args.CoreComponents.EpochNotifier(),
+		WasmVMChangeLocker:       args.CoreComponents.WasmVMChangeLocker(),
+		ParameterChangesProvider: parameterChangesProvider,
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	if err != nil {
@@ -272,6 +300,7 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 		accountsParser:                   managedProcessComponents.AccountsParser(),
 		sentSignatureTracker:             managedProcessComponents.SentSignaturesTracker(),
 		epochStartSystemSCProcessor:      managedProcessComponents.EpochSystemSCProcessor(),
+		interceptorsPriorityLanes:        managedProcessComponents.InterceptorsPriorityLanes(),
 		managedProcessComponentsCloser:   managedProcessComponents,
 	}
 
@@ -488,6 +517,11 @@ func (p *processComponentsHolder) EpochSystemSCProcessor() process.EpochStartSys
 	return p.epochStartSystemSCProcessor
 }
 
+// InterceptorsPriorityLanes returns the priority lanes used when processing the intercepted data
+func (p *processComponentsHolder) InterceptorsPriorityLanes() process.InterceptorPriorityLanesHandler {
+	return p.interceptorsPriorityLanes
+}
+
 // Close will call the Close methods on all inner components
 func (p *processComponentsHolder) Close() error {
 	return p.managedProcessComponentsCloser.Close()
@@ -58,6 +58,8 @@ var (
 
 func createArgsProcessComponentsHolder() ArgsProcessComponentsHolder {
 	nodesSetup, _ := sharding.NewNodesSetup("../../../integrationTests/factory/testdata/nodesSetup.json", addrPubKeyConv, valPubKeyConv, 3)
+	store := genericMocks.NewChainStorerMock(0)
+	store.Metablocks = genericMocks.NewStorerMockWithErrKeyNotFound(0)
 
 	args := ArgsProcessComponentsHolder{
 		Config: testscommon.GetGeneralConfig(),
@@ -136,7 +138,7 @@ func createArgsProcessComponentsHolder() ArgsProcessComponentsHolder {
 				},
 			},
 			MbProvider: &mock.MiniBlocksProviderStub{},
-			Store:      genericMocks.NewChainStorerMock(0),
+			Store:      store,
 		},
 		CoreComponents: &mockFactory.CoreComponentsMock{
 			IntMarsh:            &marshal.GogoProtoMarshalizer{},
@@ -56,6 +56,7 @@ func CreateStatusComponents(shardID uint32, appStatusHandler core.AppStatusHandl
 		RetrialInterval:          time.Second,
 		HostDriversArgs:          hostDriverArgs,
 		EventNotifierFactoryArgs: &factory.EventNotifierFactoryArgs{},
+		AppStatusHandler:         appStatusHandler,
 	})
 	if err != nil {
 		return nil, err
@@ -28,6 +28,9 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.MiniblockHashByTxHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.EpochByHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ResultsHashesByTxHashUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.AddressTransactionsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.EventsIndexUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.BalanceHistoryUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
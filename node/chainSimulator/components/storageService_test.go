@@ -35,6 +35,9 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.MiniblockHashByTxHashUnit,
 		dataRetriever.EpochByHashUnit,
 		dataRetriever.ResultsHashesByTxHashUnit,
+		dataRetriever.AddressTransactionsUnit,
+		dataRetriever.EventsIndexUnit,
+		dataRetriever.BalanceHistoryUnit,
 		dataRetriever.TrieEpochRootHashUnit,
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.UnitType(101), // shard 2
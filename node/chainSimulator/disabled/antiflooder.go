@@ -61,6 +61,23 @@ func (a *antiFlooder) SetPeerValidatorMapper(_ process.PeerValidatorMapper) erro
 func (a *antiFlooder) SetTopicsForAll(_ ...string) {
 }
 
+// StartProcessingOnTopic does nothing
+func (a *antiFlooder) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic does nothing
+func (a *antiFlooder) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic does nothing
+func (a *antiFlooder) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
+// SetPeerHonestyScorer does nothing and returns nil
+func (a *antiFlooder) SetPeerHonestyScorer(_ process.PeerHonestyScorer) error {
+	return nil
+}
+
 // Close does nothing and returns nil
 func (a *antiFlooder) Close() error {
 	return nil
@@ -12,6 +12,11 @@ func NewPeerHonesty() *peerHonesty {
 func (p *peerHonesty) ChangeScore(_ string, _ string, _ int) {
 }
 
+// GetScore returns 0
+func (p *peerHonesty) GetScore(_ string) float64 {
+	return 0
+}
+
 // Close does nothing and returns nil
 func (p *peerHonesty) Close() error {
 	return nil
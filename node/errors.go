@@ -138,3 +138,6 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrInvalidEpochsInterval signals that an invalid epochs interval was provided
+var ErrInvalidEpochsInterval = errors.New("invalid epochs interval")
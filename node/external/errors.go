@@ -20,6 +20,12 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilRewardsBreakdownHandler signals that a nil rewards breakdown handler has been provided
+var ErrNilRewardsBreakdownHandler = errors.New("nil rewards breakdown handler")
+
 // ErrNilAPITransactionHandler signals that a nil api transaction handler has been provided
 var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
 
@@ -46,3 +52,6 @@ var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilReceiptProofBuilder signals that a nil receipt proof builder has been provided
+var ErrNilReceiptProofBuilder = errors.New("nil receipt proof builder")
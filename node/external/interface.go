@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -37,6 +38,7 @@ type StatusMetricsHandler interface {
 type TransactionEvaluator interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +60,20 @@ type DelegatedListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// GovernanceHandler defines the behavior of a component able to return the governance proposals and votes
+type GovernanceHandler interface {
+	GetProposals(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetProposal(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetVoter(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// RewardsBreakdownHandler defines the behavior of a component able to return how the rewards of an epoch were computed
+type RewardsBreakdownHandler interface {
+	GetRewardsBreakdown(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error)
+	IsInterfaceNil() bool
+}
+
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
@@ -65,8 +81,15 @@ type APITransactionHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
 }
+
+// ReceiptProofBuilder defines what a receipt proof builder should be able to do
+type ReceiptProofBuilder interface {
+	BuildReceiptProof(txHash []byte) (*receiptProof.ReceiptProof, error)
+	IsInterfaceNil() bool
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"sort"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -15,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
@@ -32,6 +34,8 @@ type ArgNodeApiResolver struct {
 	TotalStakedValueHandler  TotalStakedValueHandler
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
+	GovernanceHandler        GovernanceHandler
+	RewardsBreakdownHandler  RewardsBreakdownHandler
 	APITransactionHandler    APITransactionHandler
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -43,6 +47,7 @@ type ArgNodeApiResolver struct {
 	PublicKey                string
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	StorageManagers          []common.StorageManager
+	ReceiptProofBuilder      ReceiptProofBuilder
 }
 
 // nodeApiResolver can resolve API requests
@@ -53,6 +58,8 @@ type nodeApiResolver struct {
 	totalStakedValueHandler  TotalStakedValueHandler
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
+	governanceHandler        GovernanceHandler
+	rewardsBreakdownHandler  RewardsBreakdownHandler
 	apiTransactionHandler    APITransactionHandler
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -64,6 +71,7 @@ type nodeApiResolver struct {
 	publicKey                string
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	storageManagers          []common.StorageManager
+	receiptProofBuilder      ReceiptProofBuilder
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -86,6 +94,12 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.RewardsBreakdownHandler) {
+		return nil, ErrNilRewardsBreakdownHandler
+	}
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
@@ -113,6 +127,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
+	if check.IfNil(arg.ReceiptProofBuilder) {
+		return nil, ErrNilReceiptProofBuilder
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
@@ -121,6 +138,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		totalStakedValueHandler:  arg.TotalStakedValueHandler,
 		directStakedListHandler:  arg.DirectStakedListHandler,
 		delegatedListHandler:     arg.DelegatedListHandler,
+		governanceHandler:        arg.GovernanceHandler,
+		rewardsBreakdownHandler:  arg.RewardsBreakdownHandler,
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
@@ -132,6 +151,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		publicKey:                arg.PublicKey,
 		nodesCoordinator:         arg.NodesCoordinator,
 		storageManagers:          arg.StorageManagers,
+		receiptProofBuilder:      arg.ReceiptProofBuilder,
 	}, nil
 }
 
@@ -150,6 +170,11 @@ func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.apiTransactionEvaluator.ComputeTransactionGasLimit(tx)
 }
 
+// ComputeTransactionCostWithGasBreakdown will calculate how many gas a transaction will consume and where the gas goes
+func (nar *nodeApiResolver) ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	return nar.apiTransactionEvaluator.ComputeTransactionCostWithGasBreakdown(tx)
+}
+
 // SimulateTransactionExecution will simulate the provided transaction and return the simulation results
 func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
 	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
@@ -184,6 +209,26 @@ func (nar *nodeApiResolver) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nar.delegatedListHandler.GetDelegatorsList(ctx)
 }
 
+// GetGovernanceProposals will return the governance proposals having the provided status
+func (nar *nodeApiResolver) GetGovernanceProposals(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetProposals(ctx, status)
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided nonce
+func (nar *nodeApiResolver) GetGovernanceProposal(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetProposal(ctx, nonce)
+}
+
+// GetGovernanceVoter will return the governance voting power and votes of the provided address
+func (nar *nodeApiResolver) GetGovernanceVoter(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error) {
+	return nar.governanceHandler.GetVoter(ctx, address)
+}
+
+// GetRewardsBreakdown will return how the rewards paid at the start of the provided epoch were computed
+func (nar *nodeApiResolver) GetRewardsBreakdown(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error) {
+	return nar.rewardsBreakdownHandler.GetRewardsBreakdown(ctx, epoch, address)
+}
+
 // GetTransaction will return the transaction with the given hash and optionally with results
 func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
@@ -209,6 +254,21 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender, senderAccountNonce)
 }
 
+// GetTransactionLifecycle will return the processing stages of the given transaction and of its results
+func (nar *nodeApiResolver) GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionLifecycle(txHash)
+}
+
+// GetTransactionProof will return the proof of the given transaction and of its smart contract results
+func (nar *nodeApiResolver) GetTransactionProof(txHash string) (*receiptProof.ReceiptProof, error) {
+	decodedHash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return nar.receiptProofBuilder.BuildReceiptProof(decodedHash)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -355,6 +415,72 @@ func (nar *nodeApiResolver) GetGasConfigs() map[string]map[string]uint64 {
 	return nar.gasScheduleNotifier.LatestGasScheduleCopy()
 }
 
+// GetGasConfigsDiff returns the gas schedule entries which differ between the provided epochs
+func (nar *nodeApiResolver) GetGasConfigsDiff(fromEpoch uint32, toEpoch uint32) (*common.GasScheduleDiffAPIResponse, error) {
+	fromGasSchedule, err := nar.gasScheduleNotifier.GasScheduleForEpoch(fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	toGasSchedule, err := nar.gasScheduleNotifier.GasScheduleForEpoch(toEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GasScheduleDiffAPIResponse{
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+		Changes:   computeGasScheduleChanges(fromGasSchedule, toGasSchedule),
+	}, nil
+}
+
+func computeGasScheduleChanges(fromGasSchedule map[string]map[string]uint64, toGasSchedule map[string]map[string]uint64) []*common.GasScheduleEntryChangeAPIResponse {
+	changes := make([]*common.GasScheduleEntryChangeAPIResponse, 0)
+	for section, fromEntries := range fromGasSchedule {
+		for name, oldValue := range fromEntries {
+			newValue, exists := toGasSchedule[section][name]
+			switch {
+			case !exists:
+				changes = append(changes, createGasScheduleEntryChange(section, name, oldValue, 0, common.GasScheduleEntryRemoved))
+			case newValue != oldValue:
+				changes = append(changes, createGasScheduleEntryChange(section, name, oldValue, newValue, common.GasScheduleEntryModified))
+			}
+		}
+	}
+	for section, toEntries := range toGasSchedule {
+		for name, newValue := range toEntries {
+			_, exists := fromGasSchedule[section][name]
+			if !exists {
+				changes = append(changes, createGasScheduleEntryChange(section, name, 0, newValue, common.GasScheduleEntryAdded))
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Section != changes[j].Section {
+			return changes[i].Section < changes[j].Section
+		}
+		return changes[i].Name < changes[j].Name
+	})
+
+	return changes
+}
+
+func createGasScheduleEntryChange(
+	section string,
+	name string,
+	oldValue uint64,
+	newValue uint64,
+	change common.GasScheduleEntryChangeType,
+) *common.GasScheduleEntryChangeAPIResponse {
+	return &common.GasScheduleEntryChangeAPIResponse{
+		Section:  section,
+		Name:     name,
+		OldValue: oldValue,
+		NewValue: newValue,
+		Change:   change,
+	}
+}
+
 // GetManagedKeysCount returns the number of managed keys when node is running in multikey mode
 func (nar *nodeApiResolver) GetManagedKeysCount() int {
 	return nar.managedPeersMonitor.GetManagedKeysCount()
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/receiptProof"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -36,6 +37,8 @@ func createMockArgs() external.ArgNodeApiResolver {
 		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
+		GovernanceHandler:        &mock.GovernanceProcessorStub{},
+		RewardsBreakdownHandler:  &mock.RewardsBreakdownProcessorStub{},
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
@@ -45,6 +48,7 @@ func createMockArgs() external.ArgNodeApiResolver {
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
 		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		ReceiptProofBuilder:      &mock.ReceiptProofBuilderStub{},
 	}
 }
 
@@ -114,6 +118,28 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilRewardsBreakdownHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.RewardsBreakdownHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilRewardsBreakdownHandler, err)
+}
+
 func TestNewNodeApiResolver_NilGasSchedules(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +162,17 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilReceiptProofBuilder(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ReceiptProofBuilder = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilReceiptProofBuilder, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -277,6 +314,60 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GovernanceMethods(t *testing.T) {
+	t.Parallel()
+
+	proposals := []*common.GovernanceProposalAPIResponse{{Nonce: 1}}
+	voter := &common.GovernanceVoterAPIResponse{Address: "erd1voter"}
+	arg := createMockArgs()
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetProposalsCalled: func(_ context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error) {
+			assert.Equal(t, common.GovernanceProposalActive, status)
+			return proposals, nil
+		},
+		GetProposalCalled: func(_ context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+			assert.Equal(t, uint64(1), nonce)
+			return proposals[0], nil
+		},
+		GetVoterCalled: func(_ context.Context, address string) (*common.GovernanceVoterAPIResponse, error) {
+			assert.Equal(t, "erd1voter", address)
+			return voter, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredProposals, err := nar.GetGovernanceProposals(context.Background(), common.GovernanceProposalActive)
+	assert.Nil(t, err)
+	assert.Equal(t, proposals, recoveredProposals)
+
+	recoveredProposal, err := nar.GetGovernanceProposal(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, proposals[0], recoveredProposal)
+
+	recoveredVoter, err := nar.GetGovernanceVoter(context.Background(), "erd1voter")
+	assert.Nil(t, err)
+	assert.Equal(t, voter, recoveredVoter)
+}
+
+func TestNodeApiResolver_GetRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	breakdown := &common.RewardsBreakdown{Epoch: 7}
+	arg := createMockArgs()
+	arg.RewardsBreakdownHandler = &mock.RewardsBreakdownProcessorStub{
+		GetRewardsBreakdownCalled: func(_ context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error) {
+			assert.Equal(t, uint32(7), epoch)
+			assert.Equal(t, "erd1address", address)
+			return breakdown, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredBreakdown, err := nar.GetRewardsBreakdown(context.Background(), 7, "erd1address")
+	assert.Nil(t, err)
+	assert.Equal(t, breakdown, recoveredBreakdown)
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
@@ -578,6 +669,60 @@ func TestNodeApiResolver_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	expectedLifecycle := &common.TransactionLifecycleAPIResponse{
+		Hash:      "aabb",
+		Completed: true,
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionLifecycleCalled: func(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+			require.Equal(t, "aabb", txHash)
+			return expectedLifecycle, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionLifecycle("aabb")
+	require.NoError(t, err)
+	require.Equal(t, expectedLifecycle, res)
+}
+
+func TestNodeApiResolver_GetTransactionProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, _ := external.NewNodeApiResolver(createMockArgs())
+		res, err := nar.GetTransactionProof("not hex")
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProof := &receiptProof.ReceiptProof{
+			Transaction: &receiptProof.TransactionProof{TxHash: []byte{0xaa, 0xbb}},
+		}
+		arg := createMockArgs()
+		arg.ReceiptProofBuilder = &mock.ReceiptProofBuilderStub{
+			BuildReceiptProofCalled: func(txHash []byte) (*receiptProof.ReceiptProof, error) {
+				require.Equal(t, []byte{0xaa, 0xbb}, txHash)
+				return expectedProof, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		res, err := nar.GetTransactionProof("aabb")
+		require.NoError(t, err)
+		require.Equal(t, expectedProof, res)
+	})
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -693,6 +838,84 @@ func TestNodeApiResolver_GetGasConfigs(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetGasConfigsDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("gas schedule for the from epoch errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.GasScheduleNotifier = &testscommon.GasScheduleNotifierMock{
+			GasScheduleForEpochCalled: func(epoch uint32) (map[string]map[string]uint64, error) {
+				if epoch == 1 {
+					return nil, expectedErr
+				}
+				return make(map[string]map[string]uint64), nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(args)
+
+		diff, err := nar.GetGasConfigsDiff(1, 2)
+		require.Nil(t, diff)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("gas schedule for the to epoch errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.GasScheduleNotifier = &testscommon.GasScheduleNotifierMock{
+			GasScheduleForEpochCalled: func(epoch uint32) (map[string]map[string]uint64, error) {
+				if epoch == 2 {
+					return nil, expectedErr
+				}
+				return make(map[string]map[string]uint64), nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(args)
+
+		diff, err := nar.GetGasConfigsDiff(1, 2)
+		require.Nil(t, diff)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gasSchedules := map[uint32]map[string]map[string]uint64{
+			1: {
+				"BaseOperationCost":      {"StorePerByte": 50, "ReleasePerByte": 10, "DataCopyPerByte": 20},
+				"BuiltInCost":            {"ClaimDeveloperRewards": 100},
+				"MetaChainSystemSCsCost": {"Stake": 5000},
+			},
+			2: {
+				"BaseOperationCost": {"StorePerByte": 60, "DataCopyPerByte": 20, "AoTPreparePerByte": 30},
+				"BuiltInCost":       {"ClaimDeveloperRewards": 100},
+				"EthAPICost":        {"UseGas": 1},
+			},
+		}
+		args := createMockArgs()
+		args.GasScheduleNotifier = &testscommon.GasScheduleNotifierMock{
+			GasScheduleForEpochCalled: func(epoch uint32) (map[string]map[string]uint64, error) {
+				return gasSchedules[epoch], nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(args)
+
+		diff, err := nar.GetGasConfigsDiff(1, 2)
+		require.Nil(t, err)
+		require.Equal(t, &common.GasScheduleDiffAPIResponse{
+			FromEpoch: 1,
+			ToEpoch:   2,
+			Changes: []*common.GasScheduleEntryChangeAPIResponse{
+				{Section: "BaseOperationCost", Name: "AoTPreparePerByte", NewValue: 30, Change: common.GasScheduleEntryAdded},
+				{Section: "BaseOperationCost", Name: "ReleasePerByte", OldValue: 10, Change: common.GasScheduleEntryRemoved},
+				{Section: "BaseOperationCost", Name: "StorePerByte", OldValue: 50, NewValue: 60, Change: common.GasScheduleEntryModified},
+				{Section: "EthAPICost", Name: "UseGas", NewValue: 1, Change: common.GasScheduleEntryAdded},
+				{Section: "MetaChainSystemSCsCost", Name: "Stake", OldValue: 5000, Change: common.GasScheduleEntryRemoved},
+			},
+		}, diff)
+	})
+}
+
 func TestNodeApiResolver_GetManagedKeysCount(t *testing.T) {
 	t.Parallel()
 
@@ -4,3 +4,14 @@ const (
 	okReturnCodeMarker                    = "@6f6b"
 	okReturnCodeMarkerBackwardsCompatible = "@ok"
 )
+
+const (
+	// StageSourceShardInclusion is the lifecycle stage in which a transaction is included in a block of its source shard
+	StageSourceShardInclusion = "sourceShardInclusion"
+	// StageMetachainSourceNotarization is the lifecycle stage in which the source shard block is notarized by the metachain
+	StageMetachainSourceNotarization = "metachainSourceNotarization"
+	// StageDestinationShardExecution is the lifecycle stage in which a transaction is executed in its destination shard
+	StageDestinationShardExecution = "destinationShardExecution"
+	// StageMetachainDestinationNotarization is the lifecycle stage in which the destination shard block is notarized by the metachain
+	StageMetachainDestinationNotarization = "metachainDestinationNotarization"
+)
@@ -31,3 +31,7 @@ var ErrCannotRetrieveTransactions = errors.New("transactions cannot be retrieved
 
 // ErrInvalidAddress signals that the address is invalid
 var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrTransactionLifecycleNotAvailable signals that the lifecycle of a transaction cannot be computed because the
+// database lookup extensions are disabled
+var ErrTransactionLifecycleNotAvailable = errors.New("transaction lifecycle is available only when the database lookup extensions are enabled")
@@ -0,0 +1,249 @@
+package transactionAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+)
+
+// GetTransactionLifecycle returns the processing stages of the given transaction and of the smart contract results it
+// generated, based on the miniblocks metadata recorded by the history repository
+func (atp *apiTransactionProcessor) GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+	if !atp.historyRepository.IsEnabled() {
+		return nil, ErrTransactionLifecycleNotAvailable
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+
+	lifecycle := &common.TransactionLifecycleAPIResponse{
+		Hash:   txHash,
+		Stages: atp.computeLifecycleStages(miniblockMetadata),
+	}
+
+	err = atp.putResultsInLifecycle(hash, miniblockMetadata.Epoch, lifecycle)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle.Completed = isLifecycleCompleted(lifecycle)
+
+	return lifecycle, nil
+}
+
+func (atp *apiTransactionProcessor) putResultsInLifecycle(hash []byte, epoch uint32, lifecycle *common.TransactionLifecycleAPIResponse) error {
+	resultsHashes, err := atp.historyRepository.GetResultsHashesByTxHash(hash, epoch)
+	if err != nil {
+		// It's perfectly normal to have transactions without SCRs.
+		if errors.Is(err, dblookupext.ErrNotFoundInStorage) {
+			return nil
+		}
+		return err
+	}
+	if resultsHashes == nil {
+		return nil
+	}
+
+	if len(resultsHashes.ReceiptsHash) > 0 {
+		lifecycle.ReceiptHash = hex.EncodeToString(resultsHashes.ReceiptsHash)
+	}
+
+	for _, scrHashesEpoch := range resultsHashes.ScResultsHashesAndEpoch {
+		for _, scrHash := range scrHashesEpoch.ScResultsHashes {
+			result, errResult := atp.computeResultLifecycle(scrHash, scrHashesEpoch.Epoch)
+			if errResult != nil {
+				return fmt.Errorf("%w: %v, hash = %s", errCannotLoadContractResults, errResult, hex.EncodeToString(scrHash))
+			}
+
+			lifecycle.Results = append(lifecycle.Results, result)
+		}
+	}
+
+	return nil
+}
+
+func (atp *apiTransactionProcessor) computeResultLifecycle(scrHash []byte, epoch uint32) (*common.TransactionLifecycleResultAPIResponse, error) {
+	scr, err := atp.transactionResultsProcessor.getScrFromStorage(scrHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(scrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	isRefund := atp.refundDetector.IsRefund(RefundDetectorInput{
+		Value:         scr.Value.String(),
+		Data:          scr.Data,
+		ReturnMessage: string(scr.ReturnMessage),
+		GasLimit:      scr.GasLimit,
+	})
+
+	return &common.TransactionLifecycleResultAPIResponse{
+		Hash:     hex.EncodeToString(scrHash),
+		IsRefund: isRefund,
+		Stages:   atp.computeLifecycleStages(miniblockMetadata),
+	}, nil
+}
+
+func (atp *apiTransactionProcessor) computeLifecycleStages(metadata *dblookupext.MiniblockMetadata) []*common.TransactionLifecycleStageAPIResponse {
+	sourceMetaBlock := atp.getMetaBlock(metadata.NotarizedAtSourceInMetaHash)
+	destinationMetaBlock := atp.getMetaBlock(metadata.NotarizedAtDestinationInMetaHash)
+
+	return []*common.TransactionLifecycleStageAPIResponse{
+		atp.computeShardStage(StageSourceShardInclusion, metadata.SourceShardID, metadata, metadata.NotarizedAtSourceInMetaNonce, sourceMetaBlock),
+		atp.computeMetachainStage(StageMetachainSourceNotarization, metadata.NotarizedAtSourceInMetaNonce, metadata.NotarizedAtSourceInMetaHash, sourceMetaBlock),
+		atp.computeShardStage(StageDestinationShardExecution, metadata.DestinationShardID, metadata, metadata.NotarizedAtDestinationInMetaNonce, destinationMetaBlock),
+		atp.computeMetachainStage(StageMetachainDestinationNotarization, metadata.NotarizedAtDestinationInMetaNonce, metadata.NotarizedAtDestinationInMetaHash, destinationMetaBlock),
+	}
+}
+
+// computeShardStage fills the block fields of the current shard from the miniblock metadata. The blocks of the other
+// shards are resolved from the shard info of the metachain block notarizing them, so their stages are considered
+// completed as soon as the metachain notarized them
+func (atp *apiTransactionProcessor) computeShardStage(
+	stage string,
+	shardID uint32,
+	metadata *dblookupext.MiniblockMetadata,
+	notarizedInMetaNonce uint64,
+	notarizedInMetaBlock *block.MetaBlock,
+) *common.TransactionLifecycleStageAPIResponse {
+	if shardID != atp.shardCoordinator.SelfId() {
+		stageResponse := &common.TransactionLifecycleStageAPIResponse{
+			Stage:     stage,
+			ShardID:   shardID,
+			Completed: notarizedInMetaNonce > 0,
+		}
+
+		shardData, found := findShardDataWithMiniblock(notarizedInMetaBlock, shardID, metadata.MiniblockHash)
+		if found {
+			stageResponse.BlockNonce = shardData.Nonce
+			stageResponse.BlockHash = hex.EncodeToString(shardData.HeaderHash)
+			stageResponse.Timestamp = atp.computeTimestampForRound(shardData.Round)
+		}
+
+		return stageResponse
+	}
+
+	return &common.TransactionLifecycleStageAPIResponse{
+		Stage:      stage,
+		ShardID:    shardID,
+		Completed:  true,
+		BlockNonce: metadata.HeaderNonce,
+		BlockHash:  hex.EncodeToString(metadata.HeaderHash),
+		Timestamp:  atp.computeTimestampForRound(metadata.Round),
+	}
+}
+
+func findShardDataWithMiniblock(metaBlock *block.MetaBlock, shardID uint32, miniblockHash []byte) (*block.ShardData, bool) {
+	if metaBlock == nil {
+		return nil, false
+	}
+
+	for i := range metaBlock.ShardInfo {
+		shardData := &metaBlock.ShardInfo[i]
+		if shardData.ShardID != shardID {
+			continue
+		}
+
+		for _, miniBlockHeader := range shardData.ShardMiniBlockHeaders {
+			if bytes.Equal(miniBlockHeader.Hash, miniblockHash) {
+				return shardData, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (atp *apiTransactionProcessor) computeMetachainStage(
+	stage string,
+	metaNonce uint64,
+	metaHash []byte,
+	metaBlock *block.MetaBlock,
+) *common.TransactionLifecycleStageAPIResponse {
+	if metaNonce == 0 {
+		return &common.TransactionLifecycleStageAPIResponse{
+			Stage:   stage,
+			ShardID: core.MetachainShardId,
+		}
+	}
+
+	stageResponse := &common.TransactionLifecycleStageAPIResponse{
+		Stage:      stage,
+		ShardID:    core.MetachainShardId,
+		Completed:  true,
+		BlockNonce: metaNonce,
+		BlockHash:  hex.EncodeToString(metaHash),
+	}
+	if metaBlock != nil {
+		stageResponse.Timestamp = int64(metaBlock.GetTimeStamp())
+	}
+
+	return stageResponse
+}
+
+func (atp *apiTransactionProcessor) getMetaBlock(metaHash []byte) *block.MetaBlock {
+	if len(metaHash) == 0 {
+		return nil
+	}
+
+	metaBlocksStorer, err := atp.storageService.GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil
+	}
+
+	metaBlockBytes, err := metaBlocksStorer.SearchFirst(metaHash)
+	if err != nil {
+		log.Trace("getMetaBlock()", "hash", metaHash, "err", err)
+		return nil
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = atp.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		log.Trace("getMetaBlock()", "hash", metaHash, "err", err)
+		return nil
+	}
+
+	return metaBlock
+}
+
+func isLifecycleCompleted(lifecycle *common.TransactionLifecycleAPIResponse) bool {
+	if !areStagesCompleted(lifecycle.Stages) {
+		return false
+	}
+
+	for _, result := range lifecycle.Results {
+		if !areStagesCompleted(result.Stages) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func areStagesCompleted(stages []*common.TransactionLifecycleStageAPIResponse) bool {
+	for _, stage := range stages {
+		if !stage.Completed {
+			return false
+		}
+	}
+
+	return true
+}
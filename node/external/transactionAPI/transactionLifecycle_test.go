@@ -0,0 +1,254 @@
+package transactionAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiTransactionProcessor_GetTransactionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	scrHash := []byte("scrHash")
+	metaHash := []byte("metaHash")
+
+	t.Run("history repository disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+
+		lifecycle, err := atp.GetTransactionLifecycle(hex.EncodeToString(txHash))
+		require.Nil(t, lifecycle)
+		require.Equal(t, ErrTransactionLifecycleNotAvailable, err)
+	})
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, true)
+
+		lifecycle, err := atp.GetTransactionLifecycle("not hex")
+		require.Nil(t, lifecycle)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, historyRepo := createAPITransactionProc(t, 0, true)
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return nil, dblookupext.ErrNotFoundInStorage
+		}
+
+		lifecycle, err := atp.GetTransactionLifecycle(hex.EncodeToString(txHash))
+		require.Nil(t, lifecycle)
+		require.True(t, errors.Is(err, dblookupext.ErrNotFoundInStorage))
+	})
+
+	t.Run("cross shard transaction waiting for the destination shard", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 0, true)
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return &dblookupext.MiniblockMetadata{
+				SourceShardID:                1,
+				DestinationShardID:           2,
+				HeaderNonce:                  10,
+				HeaderHash:                   []byte("shardHeader"),
+				NotarizedAtSourceInMetaNonce: 12,
+				NotarizedAtSourceInMetaHash:  metaHash,
+			}, nil
+		}
+		historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			return nil, dblookupext.ErrNotFoundInStorage
+		}
+		_ = chainStorer.Metablocks.PutWithMarshalizer(metaHash, &block.MetaBlock{TimeStamp: 1234}, atp.marshalizer)
+
+		lifecycle, err := atp.GetTransactionLifecycle(hex.EncodeToString(txHash))
+		require.Nil(t, err)
+		assert.False(t, lifecycle.Completed)
+		assert.Empty(t, lifecycle.Results)
+		assert.Equal(t, []*common.TransactionLifecycleStageAPIResponse{
+			{
+				Stage:      StageSourceShardInclusion,
+				ShardID:    1,
+				Completed:  true,
+				BlockNonce: 10,
+				BlockHash:  hex.EncodeToString([]byte("shardHeader")),
+			},
+			{
+				Stage:      StageMetachainSourceNotarization,
+				ShardID:    core.MetachainShardId,
+				Completed:  true,
+				BlockNonce: 12,
+				BlockHash:  hex.EncodeToString(metaHash),
+				Timestamp:  1234,
+			},
+			{
+				Stage:   StageDestinationShardExecution,
+				ShardID: 2,
+			},
+			{
+				Stage:   StageMetachainDestinationNotarization,
+				ShardID: core.MetachainShardId,
+			},
+		}, lifecycle.Stages)
+	})
+
+	t.Run("cross shard transaction should resolve the blocks of the other shards from the metachain", func(t *testing.T) {
+		t.Parallel()
+
+		miniblockHash := []byte("miniblockHash")
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 0, true)
+		atp.genesisTime = time.Unix(1000, 0)
+		atp.roundDuration = 6000
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return &dblookupext.MiniblockMetadata{
+				SourceShardID:                     0,
+				DestinationShardID:                1,
+				Round:                             6,
+				HeaderNonce:                       20,
+				HeaderHash:                        []byte("destinationHeader"),
+				MiniblockHash:                     miniblockHash,
+				NotarizedAtSourceInMetaNonce:      12,
+				NotarizedAtSourceInMetaHash:       metaHash,
+				NotarizedAtDestinationInMetaNonce: 13,
+				NotarizedAtDestinationInMetaHash:  []byte("missingMetaHash"),
+			}, nil
+		}
+		historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			return nil, dblookupext.ErrNotFoundInStorage
+		}
+		metaBlock := &block.MetaBlock{
+			TimeStamp: 1234,
+			ShardInfo: []block.ShardData{
+				{
+					ShardID:               0,
+					HeaderHash:            []byte("otherHeader"),
+					Nonce:                 6,
+					Round:                 4,
+					ShardMiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("otherMiniblockHash")}},
+				},
+				{
+					ShardID:               0,
+					HeaderHash:            []byte("sourceHeader"),
+					Nonce:                 7,
+					Round:                 5,
+					ShardMiniBlockHeaders: []block.MiniBlockHeader{{Hash: miniblockHash}},
+				},
+			},
+		}
+		_ = chainStorer.Metablocks.PutWithMarshalizer(metaHash, metaBlock, atp.marshalizer)
+
+		lifecycle, err := atp.GetTransactionLifecycle(hex.EncodeToString(txHash))
+		require.Nil(t, err)
+		assert.True(t, lifecycle.Completed)
+		assert.Equal(t, []*common.TransactionLifecycleStageAPIResponse{
+			{
+				Stage:      StageSourceShardInclusion,
+				ShardID:    0,
+				Completed:  true,
+				BlockNonce: 7,
+				BlockHash:  hex.EncodeToString([]byte("sourceHeader")),
+				Timestamp:  1030,
+			},
+			{
+				Stage:      StageMetachainSourceNotarization,
+				ShardID:    core.MetachainShardId,
+				Completed:  true,
+				BlockNonce: 12,
+				BlockHash:  hex.EncodeToString(metaHash),
+				Timestamp:  1234,
+			},
+			{
+				Stage:      StageDestinationShardExecution,
+				ShardID:    1,
+				Completed:  true,
+				BlockNonce: 20,
+				BlockHash:  hex.EncodeToString([]byte("destinationHeader")),
+				Timestamp:  1036,
+			},
+			{
+				Stage:      StageMetachainDestinationNotarization,
+				ShardID:    core.MetachainShardId,
+				Completed:  true,
+				BlockNonce: 13,
+				BlockHash:  hex.EncodeToString([]byte("missingMetaHash")),
+			},
+		}, lifecycle.Stages)
+	})
+
+	t.Run("completed transaction with a refund", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 0, true)
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			metadata := &dblookupext.MiniblockMetadata{
+				SourceShardID:                     1,
+				DestinationShardID:                2,
+				NotarizedAtSourceInMetaNonce:      12,
+				NotarizedAtSourceInMetaHash:       metaHash,
+				NotarizedAtDestinationInMetaNonce: 13,
+				NotarizedAtDestinationInMetaHash:  metaHash,
+			}
+			if string(hash) == string(scrHash) {
+				metadata.SourceShardID = 2
+				metadata.DestinationShardID = 1
+			}
+
+			return metadata, nil
+		}
+		historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{ScResultsHashes: [][]byte{scrHash}},
+				},
+			}, nil
+		}
+		refund := &smartContractResult.SmartContractResult{
+			Value: big.NewInt(100),
+			Data:  []byte("@6f6b"),
+		}
+		_ = chainStorer.Unsigned.PutWithMarshalizer(scrHash, refund, atp.marshalizer)
+
+		lifecycle, err := atp.GetTransactionLifecycle(hex.EncodeToString(txHash))
+		require.Nil(t, err)
+		assert.True(t, lifecycle.Completed)
+		require.Len(t, lifecycle.Results, 1)
+		assert.Equal(t, hex.EncodeToString(scrHash), lifecycle.Results[0].Hash)
+		assert.True(t, lifecycle.Results[0].IsRefund)
+		assert.Equal(t, uint32(2), lifecycle.Results[0].Stages[0].ShardID)
+		assert.Equal(t, uint64(13), lifecycle.Results[0].Stages[3].BlockNonce)
+	})
+
+	t.Run("missing contract result should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, historyRepo := createAPITransactionProc(t, 0, true)
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return &dblookupext.MiniblockMetadata{}, nil
+		}
+		historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{ScResultsHashes: [][]byte{scrHash}},
+				},
+			}, nil
+		}
+
+		lifecycle, err := atp.GetTransactionLifecycle(hex.EncodeToString(txHash))
+		require.Nil(t, lifecycle)
+		require.True(t, errors.Is(err, errCannotLoadContractResults))
+	})
+}
@@ -12,6 +12,7 @@ type TransactionAPIHandlerStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionLifecycleCalled               func(txHash string) (*common.TransactionLifecycleAPIResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -53,6 +54,15 @@ func (tas *TransactionAPIHandlerStub) GetLastPoolNonceForSender(sender string) (
 	return 0, nil
 }
 
+// GetTransactionLifecycle -
+func (tas *TransactionAPIHandlerStub) GetTransactionLifecycle(txHash string) (*common.TransactionLifecycleAPIResponse, error) {
+	if tas.GetTransactionLifecycleCalled != nil {
+		return tas.GetTransactionLifecycleCalled(txHash)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionsPoolNonceGapsForSender -
 func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error) {
 	if tas.GetTransactionsPoolNonceGapsForSenderCalled != nil {
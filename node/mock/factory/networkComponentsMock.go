@@ -12,6 +12,7 @@ type NetworkComponentsMock struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	PeerReputation                   process.PeerReputationHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
 	PeersRatingMonitorField          p2p.PeersRatingMonitor
@@ -59,6 +60,11 @@ func (ncm *NetworkComponentsMock) OutputAntiFloodHandler() factory.P2PAntifloodH
 	return ncm.OutputAntiFlood
 }
 
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return ncm.PeerReputation
+}
+
 // PeerBlackListHandler -
 func (ncm *NetworkComponentsMock) PeerBlackListHandler() process.PeerBlackListCacher {
 	return ncm.PeerBlackList
@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GovernanceProcessorStub -
+type GovernanceProcessorStub struct {
+	GetProposalsCalled func(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error)
+	GetProposalCalled  func(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetVoterCalled     func(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error)
+}
+
+// GetProposals -
+func (gps *GovernanceProcessorStub) GetProposals(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error) {
+	if gps.GetProposalsCalled != nil {
+		return gps.GetProposalsCalled(ctx, status)
+	}
+
+	return nil, nil
+}
+
+// GetProposal -
+func (gps *GovernanceProcessorStub) GetProposal(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if gps.GetProposalCalled != nil {
+		return gps.GetProposalCalled(ctx, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetVoter -
+func (gps *GovernanceProcessorStub) GetVoter(ctx context.Context, address string) (*common.GovernanceVoterAPIResponse, error) {
+	if gps.GetVoterCalled != nil {
+		return gps.GetVoterCalled(ctx, address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gps *GovernanceProcessorStub) IsInterfaceNil() bool {
+	return gps == nil
+}
@@ -85,6 +85,23 @@ func (p2pahs *P2PAntifloodHandlerStub) SetTopicsForAll(_ ...string) {
 
 }
 
+// StartProcessingOnTopic -
+func (p2pahs *P2PAntifloodHandlerStub) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic -
+func (p2pahs *P2PAntifloodHandlerStub) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic -
+func (p2pahs *P2PAntifloodHandlerStub) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
+// SetPeerHonestyScorer -
+func (p2pahs *P2PAntifloodHandlerStub) SetPeerHonestyScorer(_ process.PeerHonestyScorer) error {
+	return nil
+}
+
 // Close -
 func (p2pahs *P2PAntifloodHandlerStub) Close() error {
 	return nil
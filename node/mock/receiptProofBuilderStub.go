@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/receiptProof"
+
+// ReceiptProofBuilderStub -
+type ReceiptProofBuilderStub struct {
+	BuildReceiptProofCalled func(txHash []byte) (*receiptProof.ReceiptProof, error)
+}
+
+// BuildReceiptProof -
+func (stub *ReceiptProofBuilderStub) BuildReceiptProof(txHash []byte) (*receiptProof.ReceiptProof, error) {
+	if stub.BuildReceiptProofCalled != nil {
+		return stub.BuildReceiptProofCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *ReceiptProofBuilderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// RewardsBreakdownProcessorStub -
+type RewardsBreakdownProcessorStub struct {
+	GetRewardsBreakdownCalled func(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error)
+}
+
+// GetRewardsBreakdown -
+func (rbps *RewardsBreakdownProcessorStub) GetRewardsBreakdown(ctx context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error) {
+	if rbps.GetRewardsBreakdownCalled != nil {
+		return rbps.GetRewardsBreakdownCalled(ctx, epoch, address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (rbps *RewardsBreakdownProcessorStub) IsInterfaceNil() bool {
+	return rbps == nil
+}
@@ -7,8 +7,9 @@ import (
 
 // TransactionCostEstimatorMock  -
 type TransactionCostEstimatorMock struct {
-	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimitCalled             func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionCostWithGasBreakdownCalled func(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error)
+	SimulateTransactionExecutionCalled           func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 }
 
 // ComputeTransactionGasLimit -
@@ -19,6 +20,14 @@ func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transac
 	return &transaction.CostResponse{}, nil
 }
 
+// ComputeTransactionCostWithGasBreakdown -
+func (tcem *TransactionCostEstimatorMock) ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	if tcem.ComputeTransactionCostWithGasBreakdownCalled != nil {
+		return tcem.ComputeTransactionCostWithGasBreakdownCalled(tx)
+	}
+	return &txSimData.CostResponseWithGasBreakdown{}, nil
+}
+
 // SimulateTransactionExecution -
 func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if tcem.SimulateTransactionExecutionCalled != nil {
@@ -571,6 +571,59 @@ func (n *Node) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	}, nil
 }
 
+// GetTokenHolders returns the number of holders and the top holders of the provided token from current shard
+func (n *Node) GetTokenHolders(token string) (*common.ESDTHoldersAPIResponse, error) {
+	holders, err := n.processComponents.HistoryRepository().GetESDTHolders(token)
+	if err != nil {
+		return nil, err
+	}
+
+	topHolders := make([]*common.ESDTHolderAPIResponse, 0, len(holders.TopHolders))
+	for _, holder := range holders.TopHolders {
+		address, errEncode := n.coreComponents.AddressPubKeyConverter().Encode(holder.Address)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+
+		topHolders = append(topHolders, &common.ESDTHolderAPIResponse{
+			Address: address,
+			Balance: bigToString(holder.Balance),
+		})
+	}
+
+	return &common.ESDTHoldersAPIResponse{
+		NumHolders: holders.NumHolders,
+		TopHolders: topHolders,
+	}, nil
+}
+
+// GetTokenVolumes returns the minted, burned and transferred amounts of the provided token from current shard, for
+// each epoch of the provided interval
+func (n *Node) GetTokenVolumes(token string, fromEpoch uint32, toEpoch uint32) ([]*common.ESDTVolumeAPIResponse, error) {
+	if fromEpoch > toEpoch {
+		return nil, ErrInvalidEpochsInterval
+	}
+
+	numEpochs := uint64(toEpoch-fromEpoch) + 1
+	volumes := make([]*common.ESDTVolumeAPIResponse, 0, numEpochs)
+	for i := uint64(0); i < numEpochs; i++ {
+		epoch := fromEpoch + uint32(i)
+		volume, err := n.processComponents.HistoryRepository().GetESDTVolume(token, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes = append(volumes, &common.ESDTVolumeAPIResponse{
+			Epoch:       epoch,
+			Minted:      bigToString(volume.Minted),
+			Burned:      bigToString(volume.Burned),
+			Transferred: bigToString(volume.Transferred),
+		})
+	}
+
+	return volumes, nil
+}
+
 func bigToString(bigValue *big.Int) string {
 	if bigValue == nil {
 		return "0"
@@ -1071,6 +1124,16 @@ func (n *Node) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, erro
 	return n.processComponents.ValidatorsProvider().GetAuctionList()
 }
 
+// AuctionSimulationApi will re-run the auction list selection after applying the provided hypothetical changes
+func (n *Node) AuctionSimulationApi(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	return n.processComponents.ValidatorsProvider().SimulateAuction(args)
+}
+
+// ValidatorScorecardApi will return the performance history of the provided validator for the last ended epochs
+func (n *Node) ValidatorScorecardApi(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+	return n.processComponents.ValidatorsProvider().GetValidatorScorecard(blsKey, numEpochs)
+}
+
 // DirectTrigger will start the hardfork trigger
 func (n *Node) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return n.processComponents.HardforkTrigger().Trigger(epoch, withEarlyEndOfEpoch)
@@ -1081,6 +1144,89 @@ func (n *Node) IsSelfTrigger() bool {
 	return n.processComponents.HardforkTrigger().IsSelfTrigger()
 }
 
+// AddManagedKey will add the provided hex encoded private key to the set of keys managed by the node
+func (n *Node) AddManagedKey(privateKeyHex string) (string, error) {
+	privateKeyBytes, err := hex.DecodeString(privateKeyHex)
+	if err != nil {
+		return "", fmt.Errorf("%w for the private key", err)
+	}
+
+	return n.cryptoComponents.ManagedKeysReloader().AddManagedKey(privateKeyBytes)
+}
+
+// RemoveManagedKey will remove the provided hex encoded public key from the set of keys managed by the node.
+// The key can not be removed while it is part of the consensus group of the current round
+func (n *Node) RemoveManagedKey(publicKeyHex string) error {
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return fmt.Errorf("%w for the public key", err)
+	}
+
+	return n.cryptoComponents.ManagedKeysReloader().RemoveManagedKey(publicKeyBytes)
+}
+
+// ReloadManagedKeys will apply the changes made in the all validators keys file
+func (n *Node) ReloadManagedKeys() (*common.ManagedKeysReloadResult, error) {
+	return n.cryptoComponents.ManagedKeysReloader().ReloadFromFile()
+}
+
+// GetPeerReputationState returns the banned peers, public keys and IP ranges, the pinned peers and the honesty scores
+func (n *Node) GetPeerReputationState() (*common.PeerReputationState, error) {
+	state := n.networkComponents.PeerReputationHandler().GetState()
+
+	return &state, nil
+}
+
+// BanPeer will ban the provided base58 encoded peer ID for the given duration. A zero duration means a permanent ban
+func (n *Node) BanPeer(peerID string, duration time.Duration) error {
+	pid, err := core.NewPeerID(peerID)
+	if err != nil {
+		return fmt.Errorf("%w for the peer ID", err)
+	}
+
+	return n.networkComponents.PeerReputationHandler().BanPeer(pid, duration)
+}
+
+// UnbanPeer will lift the ban of the provided base58 encoded peer ID
+func (n *Node) UnbanPeer(peerID string) error {
+	pid, err := core.NewPeerID(peerID)
+	if err != nil {
+		return fmt.Errorf("%w for the peer ID", err)
+	}
+
+	return n.networkComponents.PeerReputationHandler().UnbanPeer(pid)
+}
+
+// BanIPRange will ban the provided IP range for the given duration. A zero duration means a permanent ban
+func (n *Node) BanIPRange(ipRange string, duration time.Duration) error {
+	return n.networkComponents.PeerReputationHandler().BanIPRange(ipRange, duration)
+}
+
+// UnbanIPRange will lift the ban of the provided IP range
+func (n *Node) UnbanIPRange(ipRange string) error {
+	return n.networkComponents.PeerReputationHandler().UnbanIPRange(ipRange)
+}
+
+// PinPeer will mark the provided base58 encoded peer ID as trusted, so it will never be denied
+func (n *Node) PinPeer(peerID string) error {
+	pid, err := core.NewPeerID(peerID)
+	if err != nil {
+		return fmt.Errorf("%w for the peer ID", err)
+	}
+
+	return n.networkComponents.PeerReputationHandler().PinPeer(pid)
+}
+
+// UnpinPeer will remove the provided base58 encoded peer ID from the trusted peers
+func (n *Node) UnpinPeer(peerID string) error {
+	pid, err := core.NewPeerID(peerID)
+	if err != nil {
+		return fmt.Errorf("%w for the peer ID", err)
+	}
+
+	return n.networkComponents.PeerReputationHandler().UnpinPeer(pid)
+}
+
 // EncodeAddressPubkey will encode the provided address public key bytes to string
 func (n *Node) EncodeAddressPubkey(pk []byte) (string, error) {
 	if n.coreComponents.AddressPubKeyConverter() == nil {
@@ -1457,6 +1603,71 @@ func (n *Node) IsDataTrieMigrated(address string, options api.AccountQueryOption
 	return acc.IsDataTrieMigrated()
 }
 
+// GetAddressTransactions returns the transactions, smart contract results and ESDT transfers indexed for the given address
+func (n *Node) GetAddressTransactions(address string, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.processComponents.HistoryRepository().GetAddressTransactions(addressBytes, options)
+}
+
+// GetBalanceHistory returns the recorded changes of the EGLD balance or of the given token's balance of an address
+func (n *Node) GetBalanceHistory(address string, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.processComponents.HistoryRepository().GetBalanceHistory(addressBytes, options)
+}
+
+// QueryEvents returns the indexed log events matching the provided emitter address, identifier and first topic
+func (n *Node) QueryEvents(options common.EventsQueryOptions) ([]*common.IndexedEventAPI, error) {
+	query := common.EventsQuery{
+		Identifier: []byte(options.Identifier),
+		FromNonce:  options.FromNonce,
+		ToNonce:    options.ToNonce,
+		From:       options.From,
+		Size:       options.Size,
+	}
+
+	var err error
+	if len(options.Address) > 0 {
+		query.Address, err = n.coreComponents.AddressPubKeyConverter().Decode(options.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(options.Topic) > 0 {
+		query.Topic, err = hex.DecodeString(options.Topic)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := n.processComponents.HistoryRepository().QueryEvents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter := n.coreComponents.AddressPubKeyConverter()
+	result := make([]*common.IndexedEventAPI, 0, len(events))
+	for _, event := range events {
+		result = append(result, &common.IndexedEventAPI{
+			TxHash:     hex.EncodeToString(event.TxHash),
+			BlockNonce: event.BlockNonce,
+			Address:    addressConverter.SilentEncode(event.Address, log),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return result, nil
+}
+
 func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]byte, []byte, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/nodeDebugFactory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	procFactory "github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
@@ -127,7 +128,7 @@ func createAndAttachPeerDenialEvaluators(
 	networkComponents factory.NetworkComponentsHandler,
 	processComponents factory.ProcessComponentsHandler,
 ) (p2p.PeerDenialEvaluator, error) {
-	mainPeerDenialEvaluator, err := blackList.NewPeerDenialEvaluator(
+	mainBlackListEvaluator, err := blackList.NewPeerDenialEvaluator(
 		networkComponents.PeerBlackListHandler(),
 		networkComponents.PubKeyCacher(),
 		processComponents.PeerShardMapper(),
@@ -136,12 +137,21 @@ func createAndAttachPeerDenialEvaluators(
 		return nil, err
 	}
 
+	mainPeerDenialEvaluator, err := peerReputation.NewPeerDenialEvaluator(peerReputation.ArgsPeerDenialEvaluator{
+		DenialEvaluator:   mainBlackListEvaluator,
+		ReputationHandler: networkComponents.PeerReputationHandler(),
+		AddressesProvider: networkComponents.NetworkMessenger(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = networkComponents.NetworkMessenger().SetPeerDenialEvaluator(mainPeerDenialEvaluator)
 	if err != nil {
 		return nil, err
 	}
 
-	fullArchivePeerDenialEvaluator, err := blackList.NewPeerDenialEvaluator(
+	fullArchiveBlackListEvaluator, err := blackList.NewPeerDenialEvaluator(
 		networkComponents.PeerBlackListHandler(),
 		networkComponents.PubKeyCacher(),
 		processComponents.FullArchivePeerShardMapper(),
@@ -150,6 +160,15 @@ func createAndAttachPeerDenialEvaluators(
 		return nil, err
 	}
 
+	fullArchivePeerDenialEvaluator, err := peerReputation.NewPeerDenialEvaluator(peerReputation.ArgsPeerDenialEvaluator{
+		DenialEvaluator:   fullArchiveBlackListEvaluator,
+		ReputationHandler: networkComponents.PeerReputationHandler(),
+		AddressesProvider: networkComponents.FullArchiveNetworkMessenger(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = networkComponents.FullArchiveNetworkMessenger().SetPeerDenialEvaluator(fullArchivePeerDenialEvaluator)
 	if err != nil {
 		return nil, err
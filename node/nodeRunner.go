@@ -50,9 +50,11 @@ import (
 	"github.com/multiversx/mx-chain-go/genesis"
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/health"
+	"github.com/multiversx/mx-chain-go/keysManagement/keystore"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/governanceParameters"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state/syncer"
@@ -408,11 +410,32 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	metaBlockStorer, err := managedDataComponents.StorageService().GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return true, err
+	}
+
+	parameterChangesProvider, err := governanceParameters.NewParameterChangesProvider(governanceParameters.ArgsParameterChangesProvider{
+		Marshaller:         managedCoreComponents.InternalMarshalizer(),
+		MetaBlockStorer:    metaBlockStorer,
+		EpochStartNotifier: managedCoreComponents.EpochStartNotifierWithConfirm(),
+		CurrentEpoch:       managedBootstrapComponents.EpochBootstrapParams().Epoch(),
+	})
+	if err != nil {
+		return true, err
+	}
+
+	err = managedCoreComponents.EconomicsData().SetParameterChangesProvider(parameterChangesProvider)
+	if err != nil {
+		return true, err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
-		GasScheduleConfig:  configs.EpochConfig.GasSchedule,
-		ConfigDir:          configurationPaths.GasScheduleDirectoryName,
-		EpochNotifier:      managedCoreComponents.EpochNotifier(),
-		WasmVMChangeLocker: managedCoreComponents.WasmVMChangeLocker(),
+		GasScheduleConfig:        configs.EpochConfig.GasSchedule,
+		ConfigDir:                configurationPaths.GasScheduleDirectoryName,
+		EpochNotifier:            managedCoreComponents.EpochNotifier(),
+		WasmVMChangeLocker:       managedCoreComponents.WasmVMChangeLocker(),
+		ParameterChangesProvider: parameterChangesProvider,
 	}
 	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
 	if err != nil {
@@ -1203,6 +1226,11 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		Marshalizer:              coreComponents.InternalMarshalizer(),
 		Store:                    dataComponents.StorageService(),
 		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
+		Accounts:                 stateComponents.AccountsAdapter(),
+		AccountsRepository:       stateComponents.AccountsRepository(),
+		AddressConverter:         coreComponents.AddressPubKeyConverter(),
+		EnableEpochsHandler:      coreComponents.EnableEpochsHandler(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1427,6 +1455,7 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: nr.configs.PreferencesConfig.Preferences.ConnectionWatcherType,
 		CryptoComponents:      cryptoComponents,
+		PathManager:           coreComponents.PathHandler(),
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitTime = 0
@@ -1523,6 +1552,16 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 	configs := nr.configs
 	validatorKeyPemFileName := configs.ConfigurationPathsHolder.ValidatorKey
 	allValidatorKeysPemFileName := configs.ConfigurationPathsHolder.AllValidatorKeys
+	passphraseProvider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile:        configs.FlagsConfig.KeystorePassphraseFile,
+		PassphraseEnvVariable: configs.FlagsConfig.KeystorePassphraseEnv,
+		PromptEnabled:         true,
+	})
+	keyLoader, err := keystore.NewKeyLoader(passphraseProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	cryptoComponentsHandlerArgs := cryptoComp.CryptoComponentsFactoryArgs{
 		ValidatorKeyPemFileName:              validatorKeyPemFileName,
 		AllValidatorKeysPemFileName:          allValidatorKeysPemFileName,
@@ -1531,7 +1570,7 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 		PrefsConfig:                          *configs.PreferencesConfig,
 		CoreComponentsHolder:                 coreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            core.NewKeyLoader(),
+		KeyLoader:                            keyLoader,
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
 		EnableEpochs:                         configs.EpochConfig.EnableEpochs,
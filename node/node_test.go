@@ -4488,6 +4488,64 @@ func TestGetESDTSupply(t *testing.T) {
 	}, supply)
 }
 
+func TestGetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	holderAddress := bytes.Repeat([]byte{1}, 32)
+	historyProc := &dblookupext.HistoryRepositoryStub{
+		GetESDTHoldersCalled: func(token string) (*esdtSupply.HoldersESDT, error) {
+			return &esdtSupply.HoldersESDT{
+				NumHolders: 3,
+				TopHolders: []*esdtSupply.HolderESDT{{Address: holderAddress, Balance: big.NewInt(50)}},
+			}, nil
+		},
+	}
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = historyProc
+	coreComponents := getDefaultCoreComponents()
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	holders, err := n.GetTokenHolders("my-token")
+	require.Nil(t, err)
+
+	expectedAddress, _ := coreComponents.AddressPubKeyConverter().Encode(holderAddress)
+	require.Equal(t, &common.ESDTHoldersAPIResponse{
+		NumHolders: 3,
+		TopHolders: []*common.ESDTHolderAPIResponse{{Address: expectedAddress, Balance: "50"}},
+	}, holders)
+}
+
+func TestGetESDTVolumes(t *testing.T) {
+	t.Parallel()
+
+	historyProc := &dblookupext.HistoryRepositoryStub{
+		GetESDTVolumeCalled: func(token string, epoch uint32) (*esdtSupply.VolumeESDT, error) {
+			return &esdtSupply.VolumeESDT{Minted: big.NewInt(int64(epoch))}, nil
+		},
+	}
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = historyProc
+
+	n, _ := node.NewNode(
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	volumes, err := n.GetTokenVolumes("my-token", 2, 1)
+	require.Nil(t, volumes)
+	require.Equal(t, node.ErrInvalidEpochsInterval, err)
+
+	volumes, err = n.GetTokenVolumes("my-token", 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, []*common.ESDTVolumeAPIResponse{
+		{Epoch: 1, Minted: "1", Burned: "0", Transferred: "0"},
+		{Epoch: 2, Minted: "2", Burned: "0", Transferred: "0"},
+	}, volumes)
+}
+
 func TestNode_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
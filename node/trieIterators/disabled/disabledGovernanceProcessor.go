@@ -0,0 +1,37 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errCannotReturnGovernanceDataFromShardNode = errors.New("governance data cannot be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetProposals returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetProposals(_ context.Context, _ string) ([]*common.GovernanceProposalAPIResponse, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetProposal returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetProposal(_ context.Context, _ uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetVoter returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetVoter(_ context.Context, _ string) (*common.GovernanceVoterAPIResponse, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errCannotReturnRewardsBreakdownFromShardNode = errors.New("rewards breakdown cannot be returned by a shard node")
+
+type rewardsBreakdownProcessor struct{}
+
+// NewDisabledRewardsBreakdownProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledRewardsBreakdownProcessor() *rewardsBreakdownProcessor {
+	return &rewardsBreakdownProcessor{}
+}
+
+// GetRewardsBreakdown returns the errCannotReturnRewardsBreakdownFromShardNode error
+func (rbp *rewardsBreakdownProcessor) GetRewardsBreakdown(_ context.Context, _ uint32, _ string) (*common.RewardsBreakdown, error) {
+	return nil, errCannotReturnRewardsBreakdownFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbp *rewardsBreakdownProcessor) IsInterfaceNil() bool {
+	return rbp == nil
+}
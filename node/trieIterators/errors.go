@@ -19,3 +19,15 @@ var ErrNilMutex = errors.New("nil mutex")
 
 // ErrTrieOperationsTimeout signals a timeout during trie operations
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrNilRewardsStorer signals that a nil rewards storer has been provided
+var ErrNilRewardsStorer = errors.New("nil rewards storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidMaxServiceFee signals that an invalid maximum service fee has been provided
+var ErrInvalidMaxServiceFee = errors.New("invalid maximum service fee")
+
+// ErrRewardsBreakdownNotFound signals that the rewards breakdown was not found
+var ErrRewardsBreakdownNotFound = errors.New("rewards breakdown not found")
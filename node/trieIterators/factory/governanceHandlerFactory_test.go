@@ -0,0 +1,45 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGovernanceHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+	}
+
+	governanceHandler, err := CreateGovernanceHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.governanceProcessor", fmt.Sprintf("%T", governanceHandler))
+}
+
+func TestCreateGovernanceHandler_GovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &stateMock.AccountsStub{},
+		},
+		PublicKeyConverter: &testscommon.PubkeyConverterMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+	}
+
+	governanceHandler, err := CreateGovernanceHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.governanceProcessor", fmt.Sprintf("%T", governanceHandler))
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/node/trieIterators/disabled"
+)
+
+// CreateRewardsBreakdownHandler will create a new instance of RewardsBreakdownHandler
+func CreateRewardsBreakdownHandler(args trieIterators.ArgRewardsBreakdownProcessor) (external.RewardsBreakdownHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledRewardsBreakdownProcessor(), nil
+	}
+
+	return trieIterators.NewRewardsBreakdownProcessor(args)
+}
@@ -0,0 +1,54 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRewardsBreakdownHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgRewardsBreakdownProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	rewardsBreakdownHandler, err := CreateRewardsBreakdownHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.rewardsBreakdownProcessor", fmt.Sprintf("%T", rewardsBreakdownHandler))
+}
+
+func TestCreateRewardsBreakdownHandler_RewardsBreakdownProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgRewardsBreakdownProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &testscommon.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		RewardsStorer: genericMocks.NewStorerMock(),
+		Marshalizer:   &marshallerMock.MarshalizerMock{},
+		MaxServiceFee: 10000,
+	}
+
+	rewardsBreakdownHandler, err := CreateRewardsBreakdownHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.rewardsBreakdownProcessor", fmt.Sprintf("%T", rewardsBreakdownHandler))
+}
@@ -0,0 +1,444 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	viewConfigFunction            = "viewConfig"
+	viewProposalFunction          = "viewProposal"
+	viewParameterChangesFunction  = "viewParameterChanges"
+	viewDelegatedVoteInfoFunction = "viewDelegatedVoteInfo"
+	viewUserVoteHistoryFunction   = "viewUserVoteHistory"
+	viewVotingPowerFunction       = "viewVotingPower"
+
+	numViewConfigValues            = 5
+	numViewProposalValues          = 13
+	numViewDelegatedVoteInfoValues = 4
+)
+
+type governanceConfig struct {
+	minQuorum         float64
+	lastProposalNonce uint64
+}
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor
+func NewGovernanceProcessor(arg ArgTrieIteratorProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+	}, nil
+}
+
+// GetProposals will return the governance proposals having the provided status. An empty status selects all proposals
+func (gp *governanceProcessor) GetProposals(ctx context.Context, status string) ([]*common.GovernanceProposalAPIResponse, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	config, err := gp.getGovernanceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	totalStake, err := gp.getTotalStakeInSystem()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*common.GovernanceProposalAPIResponse, 0)
+	for nonce := uint64(1); nonce <= config.lastProposalNonce; nonce++ {
+		if common.IsContextDone(ctx) {
+			return nil, ErrTrieOperationsTimeout
+		}
+
+		proposal, errGet := gp.getProposal(nonce, config, totalStake)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if len(status) > 0 && proposal.Status != status {
+			continue
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
+// GetProposal will return the governance proposal with the provided nonce, together with the breakdown of the votes
+// cast through each delegation contract
+func (gp *governanceProcessor) GetProposal(ctx context.Context, nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	config, err := gp.getGovernanceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	totalStake, err := gp.getTotalStakeInSystem()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := gp.getProposal(nonce, config, totalStake)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.DelegatedVotes, err = gp.getDelegatedVotes(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// GetVoter will return the voting power of the provided address, together with the proposals it voted on
+func (gp *governanceProcessor) GetVoter(_ context.Context, address string) (*common.GovernanceVoterAPIResponse, error) {
+	decodedAddress, err := gp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	votingPower, err := gp.getVotingPower(decodedAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := gp.executeGovernanceQuery(viewUserVoteHistoryFunction, decodedAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedVotes, remaining, err := parseNoncesList(returnData)
+	if err != nil {
+		return nil, err
+	}
+	directVotes, _, err := parseNoncesList(remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GovernanceVoterAPIResponse{
+		Address:        address,
+		VotingPower:    votingPower.String(),
+		DirectVotes:    directVotes,
+		DelegatedVotes: delegatedVotes,
+	}, nil
+}
+
+func (gp *governanceProcessor) getGovernanceConfig() (*governanceConfig, error) {
+	returnData, err := gp.executeGovernanceQuery(viewConfigFunction)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numViewConfigValues {
+		return nil, fmt.Errorf("%w, %s function should have returned %d values", epochStart.ErrExecutingSystemScCode, viewConfigFunction, numViewConfigValues)
+	}
+
+	minQuorum, err := strconv.ParseFloat(string(returnData[1]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the min quorum", err)
+	}
+	lastProposalNonce, err := strconv.ParseUint(string(returnData[4]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the last proposal nonce", err)
+	}
+
+	return &governanceConfig{
+		minQuorum:         minQuorum,
+		lastProposalNonce: lastProposalNonce,
+	}, nil
+}
+
+func (gp *governanceProcessor) getTotalStakeInSystem() (*big.Int, error) {
+	validatorAccount, err := gp.getAccount(vm.ValidatorSCAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w when loading validator account", err)
+	}
+
+	return validatorAccount.GetBalance(), nil
+}
+
+func (gp *governanceProcessor) getProposal(nonce uint64, config *governanceConfig, totalStake *big.Int) (*common.GovernanceProposalAPIResponse, error) {
+	nonceBytes := big.NewInt(0).SetUint64(nonce).Bytes()
+	returnData, err := gp.executeGovernanceQuery(viewProposalFunction, nonceBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numViewProposalValues {
+		return nil, fmt.Errorf("%w, %s function should have returned %d values", epochStart.ErrExecutingSystemScCode, viewProposalFunction, numViewProposalValues)
+	}
+
+	issuer, err := gp.publicKeyConverter.Encode(returnData[3])
+	if err != nil {
+		return nil, fmt.Errorf("%w encoding the issuer address %s", err, hex.EncodeToString(returnData[3]))
+	}
+
+	yes := big.NewInt(0).SetBytes(returnData[7])
+	no := big.NewInt(0).SetBytes(returnData[8])
+	veto := big.NewInt(0).SetBytes(returnData[9])
+	abstain := big.NewInt(0).SetBytes(returnData[10])
+	totalVotes := big.NewInt(0).Add(yes, no)
+	totalVotes.Add(totalVotes, veto)
+	totalVotes.Add(totalVotes, abstain)
+
+	status := common.GovernanceProposalActive
+	if string(returnData[11]) == strconv.FormatBool(true) {
+		status = common.GovernanceProposalClosed
+	}
+
+	proposal := &common.GovernanceProposalAPIResponse{
+		Nonce:          nonce,
+		CommitHash:     string(returnData[1]),
+		Issuer:         issuer,
+		Cost:           big.NewInt(0).SetBytes(returnData[0]).String(),
+		StartVoteEpoch: big.NewInt(0).SetBytes(returnData[4]).Uint64(),
+		EndVoteEpoch:   big.NewInt(0).SetBytes(returnData[5]).Uint64(),
+		Status:         status,
+		Passed:         string(returnData[12]) == strconv.FormatBool(true),
+		Votes: &common.GovernanceVotesAPIResponse{
+			Yes:     yes.String(),
+			No:      no.String(),
+			Veto:    veto.String(),
+			Abstain: abstain.String(),
+			Total:   totalVotes.String(),
+		},
+		Quorum: computeQuorum(big.NewInt(0).SetBytes(returnData[6]), totalVotes, totalStake, config.minQuorum),
+	}
+
+	err = gp.putParameterChanges(nonceBytes, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// computeQuorum computes the quorum progress the same way the governance system smart contract does when closing a
+// proposal: the cast votes are compared against the min quorum percentage of the total stake
+func computeQuorum(quorumStake *big.Int, totalVotes *big.Int, totalStake *big.Int, minQuorum float64) *common.GovernanceQuorumAPIResponse {
+	requiredQuorum := core.GetIntTrimmedPercentageOfValue(totalStake, minQuorum)
+	progress := float64(100)
+	if requiredQuorum.Sign() > 0 {
+		progress, _ = big.NewFloat(0).Quo(
+			big.NewFloat(0).SetInt(big.NewInt(0).Mul(totalVotes, big.NewInt(100))),
+			big.NewFloat(0).SetInt(requiredQuorum),
+		).Float64()
+	}
+
+	return &common.GovernanceQuorumAPIResponse{
+		Stake:    quorumStake.String(),
+		Required: requiredQuorum.String(),
+		Progress: progress,
+		Reached:  totalVotes.Cmp(requiredQuorum) >= 0,
+	}
+}
+
+func (gp *governanceProcessor) putParameterChanges(nonceBytes []byte, proposal *common.GovernanceProposalAPIResponse) error {
+	returnData, err := gp.executeGovernanceQuery(viewParameterChangesFunction, nonceBytes)
+	if err != nil {
+		return err
+	}
+	if len(returnData) == 0 {
+		return nil
+	}
+	if len(returnData)%2 != 1 {
+		return fmt.Errorf("%w, %s function should have returned an odd number of values", epochStart.ErrExecutingSystemScCode, viewParameterChangesFunction)
+	}
+
+	proposal.ActivationEpoch = big.NewInt(0).SetBytes(returnData[0]).Uint64()
+	proposal.ParameterChanges = make([]*common.GovernanceParameterChangeAPIResponse, 0, len(returnData)/2)
+	for i := 1; i < len(returnData); i += 2 {
+		proposal.ParameterChanges = append(proposal.ParameterChanges, &common.GovernanceParameterChangeAPIResponse{
+			Name:  string(returnData[i]),
+			Value: string(returnData[i+1]),
+		})
+	}
+
+	return nil
+}
+
+// getDelegatedVotes iterates the governance system smart contract trie in search of the vote information saved for
+// each delegation contract, under the delegation contract address followed by the proposal nonce
+func (gp *governanceProcessor) getDelegatedVotes(ctx context.Context, nonce uint64) ([]*common.GovernanceDelegatedVotesAPIResponse, error) {
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w when loading governance account", err)
+	}
+
+	chLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = governanceAccount.GetAllLeaves(chLeaves, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addressLen := gp.publicKeyConverter.Len()
+	nonceBytes := big.NewInt(0).SetUint64(nonce).Bytes()
+	delegationContracts := make([][]byte, 0)
+	for leaf := range chLeaves.LeavesChan {
+		leafKey := leaf.Key()
+		if len(leafKey) != addressLen+len(nonceBytes) {
+			continue
+		}
+		if !core.IsSmartContractAddress(leafKey[:addressLen]) || !bytes.Equal(leafKey[addressLen:], nonceBytes) {
+			continue
+		}
+
+		delegationContracts = append(delegationContracts, leafKey[:addressLen])
+	}
+
+	err = chLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	sort.Slice(delegationContracts, func(i, j int) bool {
+		return bytes.Compare(delegationContracts[i], delegationContracts[j]) < 0
+	})
+
+	delegatedVotes := make([]*common.GovernanceDelegatedVotesAPIResponse, 0, len(delegationContracts))
+	for _, delegationContract := range delegationContracts {
+		votes, errGet := gp.getDelegatedVoteInfo(delegationContract, nonceBytes)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		delegatedVotes = append(delegatedVotes, votes)
+	}
+
+	return delegatedVotes, nil
+}
+
+func (gp *governanceProcessor) getDelegatedVoteInfo(delegationContract []byte, nonceBytes []byte) (*common.GovernanceDelegatedVotesAPIResponse, error) {
+	returnData, err := gp.executeGovernanceQuery(viewDelegatedVoteInfoFunction, delegationContract, nonceBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numViewDelegatedVoteInfoValues {
+		return nil, fmt.Errorf("%w, %s function should have returned %d values", epochStart.ErrExecutingSystemScCode, viewDelegatedVoteInfoFunction, numViewDelegatedVoteInfoValues)
+	}
+
+	encodedDelegationContract, err := gp.publicKeyConverter.Encode(delegationContract)
+	if err != nil {
+		return nil, fmt.Errorf("%w encoding delegation SC address %s", err, hex.EncodeToString(delegationContract))
+	}
+
+	return &common.GovernanceDelegatedVotesAPIResponse{
+		DelegationContract: encodedDelegationContract,
+		UsedStake:          big.NewInt(0).SetBytes(returnData[0]).String(),
+		UsedPower:          big.NewInt(0).SetBytes(returnData[1]).String(),
+		TotalStake:         big.NewInt(0).SetBytes(returnData[2]).String(),
+		TotalPower:         big.NewInt(0).SetBytes(returnData[3]).String(),
+	}, nil
+}
+
+func (gp *governanceProcessor) getVotingPower(address []byte) (*big.Int, error) {
+	vmOutput, err := gp.executeQuery(viewVotingPowerFunction, address)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok && strings.Contains(vmOutput.ReturnMessage, vm.ErrNotEnoughStakeToVote.Error()) {
+		return big.NewInt(0), nil
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData) != 1 {
+		return nil, fmt.Errorf("%w, %s function should have returned one value", epochStart.ErrExecutingSystemScCode, viewVotingPowerFunction)
+	}
+
+	return big.NewInt(0).SetBytes(vmOutput.ReturnData[0]), nil
+}
+
+func (gp *governanceProcessor) executeGovernanceQuery(function string, arguments ...[]byte) ([][]byte, error) {
+	vmOutput, err := gp.executeQuery(function, arguments...)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func (gp *governanceProcessor) executeQuery(function string, arguments ...[]byte) (*vmcommon.VMOutput, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.GovernanceSCAddress,
+		FuncName:   function,
+		CallerAddr: vm.GovernanceSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, _, err := gp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+// parseNoncesList parses a list of nonces encoded as its length followed by the nonces and returns the remaining values
+func parseNoncesList(returnData [][]byte) ([]uint64, [][]byte, error) {
+	if len(returnData) == 0 {
+		return nil, nil, fmt.Errorf("%w, %s function returned an invalid nonces list", epochStart.ErrExecutingSystemScCode, viewUserVoteHistoryFunction)
+	}
+
+	numNonces := big.NewInt(0).SetBytes(returnData[0]).Uint64()
+	if uint64(len(returnData)-1) < numNonces {
+		return nil, nil, fmt.Errorf("%w, %s function returned an invalid nonces list", epochStart.ErrExecutingSystemScCode, viewUserVoteHistoryFunction)
+	}
+
+	nonces := make([]uint64, 0, numNonces)
+	for i := uint64(1); i <= numNonces; i++ {
+		nonces = append(nonces, big.NewInt(0).SetBytes(returnData[i]).Uint64())
+	}
+
+	return nonces, returnData[numNonces+1:], nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
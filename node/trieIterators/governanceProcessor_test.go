@@ -0,0 +1,379 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const governanceTestAddressLen = 32
+
+func createGovernanceScAddress(lastByte byte) []byte {
+	address := make([]byte, governanceTestAddressLen)
+	address[governanceTestAddressLen-1] = lastByte
+
+	return address
+}
+
+func createViewProposalReturnData(closed bool, passed bool) [][]byte {
+	return [][]byte{
+		big.NewInt(500).Bytes(),
+		[]byte("commit hash"),
+		big.NewInt(1).Bytes(),
+		bytes.Repeat([]byte("i"), governanceTestAddressLen),
+		big.NewInt(10).Bytes(),
+		big.NewInt(20).Bytes(),
+		big.NewInt(150).Bytes(),
+		big.NewInt(100).Bytes(),
+		big.NewInt(30).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(20).Bytes(),
+		[]byte(fmt.Sprintf("%v", closed)),
+		[]byte(fmt.Sprintf("%v", passed)),
+	}
+}
+
+func createGovernanceQueryService(delegatedVotes map[string][][]byte) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			switch query.FuncName {
+			case viewConfigFunction:
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{[]byte("1000"), []byte("0.2"), []byte("0.5"), []byte("0.33"), []byte("2")},
+				}, nil, nil
+			case viewProposalFunction:
+				nonce := big.NewInt(0).SetBytes(query.Arguments[0]).Uint64()
+				return &vmcommon.VMOutput{
+					ReturnData: createViewProposalReturnData(nonce == 1, nonce == 1),
+				}, nil, nil
+			case viewParameterChangesFunction:
+				nonce := big.NewInt(0).SetBytes(query.Arguments[0]).Uint64()
+				if nonce == 1 {
+					return &vmcommon.VMOutput{}, nil, nil
+				}
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{big.NewInt(25).Bytes(), []byte("MaxGasLimitPerBlock"), []byte("2000000000")},
+				}, nil, nil
+			case viewDelegatedVoteInfoFunction:
+				returnData, ok := delegatedVotes[string(query.Arguments[0])]
+				if ok {
+					return &vmcommon.VMOutput{ReturnData: returnData}, nil, nil
+				}
+			}
+
+			return nil, nil, fmt.Errorf("not an expected call")
+		},
+	}
+}
+
+func createGovernanceMockArgs(governanceLeaves [][]byte) ArgTrieIteratorProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(governanceTestAddressLen)
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			if bytes.Equal(addressContainer, vm.GovernanceSCAddress) {
+				return createScAccount(addressContainer, governanceLeaves, addressContainer, 0), nil
+			}
+
+			acc := createScAccount(addressContainer, nil, addressContainer, 0)
+			_ = acc.AddToBalance(big.NewInt(1000))
+
+			return acc, nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+
+	return arg
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.Accounts = nil
+
+		gp, err := NewGovernanceProcessor(arg)
+		require.True(t, errors.Is(err, ErrNilAccountsAdapter))
+		require.Nil(t, gp)
+	})
+	t.Run("nil query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.QueryService = nil
+
+		gp, err := NewGovernanceProcessor(arg)
+		require.True(t, errors.Is(err, ErrNilQueryService))
+		require.Nil(t, gp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gp, err := NewGovernanceProcessor(createMockArgs())
+		require.Nil(t, err)
+		require.NotNil(t, gp)
+	})
+}
+
+func TestGovernanceProcessor_GetProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		gp, _ := NewGovernanceProcessor(arg)
+
+		proposals, err := gp.GetProposals(context.Background(), "")
+		assert.Nil(t, proposals)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("query user error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil, nil
+			},
+		}
+		gp, _ := NewGovernanceProcessor(arg)
+
+		proposals, err := gp.GetProposals(context.Background(), "")
+		assert.Nil(t, proposals)
+		assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = createGovernanceQueryService(nil)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		proposals, err := gp.GetProposals(ctx, "")
+		assert.Nil(t, proposals)
+		assert.Equal(t, ErrTrieOperationsTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = createGovernanceQueryService(nil)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		proposals, err := gp.GetProposals(context.Background(), "")
+		require.Nil(t, err)
+		require.Equal(t, 2, len(proposals))
+
+		closedProposal := proposals[0]
+		assert.Equal(t, uint64(1), closedProposal.Nonce)
+		assert.Equal(t, common.GovernanceProposalClosed, closedProposal.Status)
+		assert.True(t, closedProposal.Passed)
+		assert.Equal(t, "commit hash", closedProposal.CommitHash)
+		assert.Equal(t, "500", closedProposal.Cost)
+		assert.Equal(t, uint64(10), closedProposal.StartVoteEpoch)
+		assert.Equal(t, uint64(20), closedProposal.EndVoteEpoch)
+		assert.Equal(t, &common.GovernanceVotesAPIResponse{
+			Yes:     "100",
+			No:      "30",
+			Veto:    "0",
+			Abstain: "20",
+			Total:   "150",
+		}, closedProposal.Votes)
+		assert.Equal(t, &common.GovernanceQuorumAPIResponse{
+			Stake:    "150",
+			Required: "200",
+			Progress: 75,
+			Reached:  false,
+		}, closedProposal.Quorum)
+		assert.Nil(t, closedProposal.ParameterChanges)
+
+		activeProposal := proposals[1]
+		assert.Equal(t, common.GovernanceProposalActive, activeProposal.Status)
+		assert.False(t, activeProposal.Passed)
+		assert.Equal(t, uint64(25), activeProposal.ActivationEpoch)
+		assert.Equal(t, []*common.GovernanceParameterChangeAPIResponse{
+			{Name: "MaxGasLimitPerBlock", Value: "2000000000"},
+		}, activeProposal.ParameterChanges)
+
+		proposals, err = gp.GetProposals(context.Background(), common.GovernanceProposalActive)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(proposals))
+		assert.Equal(t, uint64(2), proposals[0].Nonce)
+	})
+}
+
+func TestGovernanceProcessor_GetProposal(t *testing.T) {
+	t.Parallel()
+
+	delegationSc1 := createGovernanceScAddress(1)
+	delegationSc2 := createGovernanceScAddress(2)
+	userAddress := bytes.Repeat([]byte("u"), governanceTestAddressLen)
+	nonceBytes := big.NewInt(1).Bytes()
+	leaves := [][]byte{
+		append(append([]byte{}, delegationSc2...), nonceBytes...),
+		append(append([]byte{}, delegationSc1...), nonceBytes...),
+		append(append([]byte{}, delegationSc1...), big.NewInt(2).Bytes()...),
+		append(append([]byte{}, userAddress...), nonceBytes...),
+		[]byte("proposal"),
+	}
+	delegatedVotes := map[string][][]byte{
+		string(delegationSc1): {big.NewInt(10).Bytes(), big.NewInt(10).Bytes(), big.NewInt(100).Bytes(), big.NewInt(100).Bytes()},
+		string(delegationSc2): {big.NewInt(5).Bytes(), big.NewInt(5).Bytes(), big.NewInt(50).Bytes(), big.NewInt(50).Bytes()},
+	}
+
+	arg := createGovernanceMockArgs(leaves)
+	arg.QueryService = createGovernanceQueryService(delegatedVotes)
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposal, err := gp.GetProposal(context.Background(), 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), proposal.Nonce)
+
+	encodedDelegationSc1, _ := arg.PublicKeyConverter.Encode(delegationSc1)
+	encodedDelegationSc2, _ := arg.PublicKeyConverter.Encode(delegationSc2)
+	expectedDelegatedVotes := []*common.GovernanceDelegatedVotesAPIResponse{
+		{
+			DelegationContract: encodedDelegationSc1,
+			UsedStake:          "10",
+			UsedPower:          "10",
+			TotalStake:         "100",
+			TotalPower:         "100",
+		},
+		{
+			DelegationContract: encodedDelegationSc2,
+			UsedStake:          "5",
+			UsedPower:          "5",
+			TotalStake:         "50",
+			TotalPower:         "50",
+		},
+	}
+	assert.Equal(t, expectedDelegatedVotes, proposal.DelegatedVotes)
+}
+
+func TestGovernanceProcessor_GetVoter(t *testing.T) {
+	t.Parallel()
+
+	voterAddress := bytes.Repeat([]byte("v"), governanceTestAddressLen)
+	createVoterQueryService := func(votingPowerOutput *vmcommon.VMOutput) *mock.SCQueryServiceStub {
+		return &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				assert.Equal(t, voterAddress, query.Arguments[0])
+
+				switch query.FuncName {
+				case viewVotingPowerFunction:
+					return votingPowerOutput, nil, nil
+				case viewUserVoteHistoryFunction:
+					return &vmcommon.VMOutput{
+						ReturnData: [][]byte{
+							big.NewInt(1).Bytes(), big.NewInt(2).Bytes(),
+							big.NewInt(2).Bytes(), big.NewInt(1).Bytes(), big.NewInt(3).Bytes(),
+						},
+					}, nil, nil
+				}
+
+				return nil, nil, fmt.Errorf("not an expected call")
+			},
+		}
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		voter, err := gp.GetVoter(context.Background(), "not hex")
+		assert.Nil(t, voter)
+		assert.NotNil(t, err)
+	})
+	t.Run("voting power user error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = createVoterQueryService(&vmcommon.VMOutput{
+			ReturnCode:    vmcommon.UserError,
+			ReturnMessage: "invalid caller",
+		})
+		gp, _ := NewGovernanceProcessor(arg)
+
+		encodedVoter, _ := arg.PublicKeyConverter.Encode(voterAddress)
+		voter, err := gp.GetVoter(context.Background(), encodedVoter)
+		assert.Nil(t, voter)
+		assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("not enough stake should return zero voting power", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = createVoterQueryService(&vmcommon.VMOutput{
+			ReturnCode:    vmcommon.UserError,
+			ReturnMessage: vm.ErrNotEnoughStakeToVote.Error(),
+		})
+		gp, _ := NewGovernanceProcessor(arg)
+
+		encodedVoter, _ := arg.PublicKeyConverter.Encode(voterAddress)
+		voter, err := gp.GetVoter(context.Background(), encodedVoter)
+		require.Nil(t, err)
+		assert.Equal(t, "0", voter.VotingPower)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceMockArgs(nil)
+		arg.QueryService = createVoterQueryService(&vmcommon.VMOutput{
+			ReturnData: [][]byte{big.NewInt(1234).Bytes()},
+		})
+		gp, _ := NewGovernanceProcessor(arg)
+
+		encodedVoter, _ := arg.PublicKeyConverter.Encode(voterAddress)
+		voter, err := gp.GetVoter(context.Background(), encodedVoter)
+		require.Nil(t, err)
+
+		expectedVoter := &common.GovernanceVoterAPIResponse{
+			Address:        encodedVoter,
+			VotingPower:    "1234",
+			DirectVotes:    []uint64{1, 3},
+			DelegatedVotes: []uint64{2},
+		}
+		assert.Equal(t, expectedVoter, voter)
+	})
+}
+
+func TestGovernanceProcessor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var gp *governanceProcessor
+	require.True(t, gp.IsInterfaceNil())
+
+	gp, _ = NewGovernanceProcessor(createMockArgs())
+	require.False(t, gp.IsInterfaceNil())
+}
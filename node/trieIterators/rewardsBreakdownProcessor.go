@@ -0,0 +1,211 @@
+package trieIterators
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	getRewardDataFunction  = "getRewardData"
+	numGetRewardDataValues = 3
+)
+
+// ArgRewardsBreakdownProcessor holds the arguments needed to create a rewards breakdown processor
+type ArgRewardsBreakdownProcessor struct {
+	ArgTrieIteratorProcessor
+	RewardsStorer storage.Storer
+	Marshalizer   marshal.Marshalizer
+	MaxServiceFee uint64
+}
+
+type delegationRewardData struct {
+	rewardsToDistribute *big.Int
+	totalActive         *big.Int
+	serviceFee          uint64
+}
+
+type rewardsBreakdownProcessor struct {
+	*delegatedListProcessor
+	rewardsStorer storage.Storer
+	marshaller    marshal.Marshalizer
+	maxServiceFee uint64
+}
+
+// NewRewardsBreakdownProcessor will create a new instance of rewardsBreakdownProcessor
+func NewRewardsBreakdownProcessor(arg ArgRewardsBreakdownProcessor) (*rewardsBreakdownProcessor, error) {
+	delegatedList, err := NewDelegatedListProcessor(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.RewardsStorer) {
+		return nil, ErrNilRewardsStorer
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if arg.MaxServiceFee == 0 {
+		return nil, ErrInvalidMaxServiceFee
+	}
+
+	return &rewardsBreakdownProcessor{
+		delegatedListProcessor: delegatedList,
+		rewardsStorer:          arg.RewardsStorer,
+		marshaller:             arg.Marshalizer,
+		maxServiceFee:          arg.MaxServiceFee,
+	}, nil
+}
+
+// GetRewardsBreakdown will return how the rewards paid at the start of the provided epoch were computed. When an address
+// is provided, only its nodes are returned and, if the address is a delegation contract, the split of its rewards between
+// the owner and the delegators, as recorded by the contract for that epoch, is also returned
+func (rbp *rewardsBreakdownProcessor) GetRewardsBreakdown(_ context.Context, epoch uint32, address string) (*common.RewardsBreakdown, error) {
+	breakdown, err := rbp.getStoredBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(address) == 0 {
+		return breakdown, nil
+	}
+
+	decodedAddress, err := rbp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	filterRewardsBreakdown(breakdown, address)
+	if len(breakdown.RewardAddresses) == 0 {
+		return breakdown, nil
+	}
+
+	rbp.accounts.Lock()
+	defer rbp.accounts.Unlock()
+
+	if !rbp.isDelegationContract(decodedAddress) {
+		return breakdown, nil
+	}
+
+	breakdown.RewardAddresses[0].Delegation, err = rbp.getDelegationRewardsBreakdown(decodedAddress, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return breakdown, nil
+}
+
+func (rbp *rewardsBreakdownProcessor) getStoredBreakdown(epoch uint32) (*common.RewardsBreakdown, error) {
+	marshalledBreakdown, err := rbp.rewardsStorer.GetFromEpoch(common.RewardsBreakdownKey(epoch), epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d", ErrRewardsBreakdownNotFound, epoch)
+	}
+
+	breakdown := &common.RewardsBreakdown{}
+	err = rbp.marshaller.Unmarshal(breakdown, marshalledBreakdown)
+	if err != nil {
+		return nil, err
+	}
+
+	return breakdown, nil
+}
+
+func filterRewardsBreakdown(breakdown *common.RewardsBreakdown, address string) {
+	nodes := make([]*common.NodeRewardsBreakdown, 0)
+	for _, node := range breakdown.Nodes {
+		if node.RewardAddress == address {
+			nodes = append(nodes, node)
+		}
+	}
+
+	rewardAddresses := make([]*common.RewardAddressBreakdown, 0, 1)
+	for _, rewardAddress := range breakdown.RewardAddresses {
+		if rewardAddress.Address == address {
+			rewardAddresses = append(rewardAddresses, rewardAddress)
+		}
+	}
+
+	breakdown.Nodes = nodes
+	breakdown.RewardAddresses = rewardAddresses
+}
+
+func (rbp *rewardsBreakdownProcessor) isDelegationContract(address []byte) bool {
+	if !core.IsSmartContractOnMetachain(metachainIdentifier, address) {
+		return false
+	}
+
+	account, err := rbp.getAccount(address)
+	if err != nil {
+		return false
+	}
+
+	value, _, err := account.RetrieveValue([]byte(core.DelegationSystemSCKey))
+	if err != nil {
+		return false
+	}
+
+	return len(value) > 0
+}
+
+// getDelegationRewardsBreakdown only uses the reward data stored by the contract for the epoch. The rewards are not
+// split between the delegators, as their active stake at that epoch is no longer available
+func (rbp *rewardsBreakdownProcessor) getDelegationRewardsBreakdown(delegationSC []byte, epoch uint32) (*common.DelegationRewardsBreakdown, error) {
+	rewardData, err := rbp.getDelegationRewardData(delegationSC, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceFeeReward := big.NewInt(0).Set(rewardData.rewardsToDistribute)
+	if rewardData.totalActive.Cmp(big.NewInt(0)) > 0 {
+		percentage := float64(rewardData.serviceFee) / float64(rbp.maxServiceFee)
+		serviceFeeReward = core.GetIntTrimmedPercentageOfValue(rewardData.rewardsToDistribute, percentage)
+	}
+	rewardsForDelegators := big.NewInt(0).Sub(rewardData.rewardsToDistribute, serviceFeeReward)
+
+	return &common.DelegationRewardsBreakdown{
+		RewardsToDistribute:  rewardData.rewardsToDistribute.String(),
+		TotalActiveStake:     rewardData.totalActive.String(),
+		ServiceFee:           rewardData.serviceFee,
+		ServiceFeeReward:     serviceFeeReward.String(),
+		RewardsForDelegators: rewardsForDelegators.String(),
+	}, nil
+}
+
+func (rbp *rewardsBreakdownProcessor) getDelegationRewardData(delegationSC []byte, epoch uint32) (*delegationRewardData, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  delegationSC,
+		FuncName:   getRewardDataFunction,
+		CallerAddr: delegationSC,
+		CallValue:  big.NewInt(0),
+		Arguments:  [][]byte{big.NewInt(int64(epoch)).Bytes()},
+	}
+
+	vmOutput, _, err := rbp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData) != numGetRewardDataValues {
+		return nil, fmt.Errorf("%w, %s function should have returned %d values", epochStart.ErrExecutingSystemScCode, getRewardDataFunction, numGetRewardDataValues)
+	}
+
+	return &delegationRewardData{
+		rewardsToDistribute: big.NewInt(0).SetBytes(vmOutput.ReturnData[0]),
+		totalActive:         big.NewInt(0).SetBytes(vmOutput.ReturnData[1]),
+		serviceFee:          big.NewInt(0).SetBytes(vmOutput.ReturnData[2]).Uint64(),
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbp *rewardsBreakdownProcessor) IsInterfaceNil() bool {
+	return rbp == nil
+}
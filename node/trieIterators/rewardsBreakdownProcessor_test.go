@@ -0,0 +1,248 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+const rewardsBreakdownTestEpoch = uint32(7)
+
+var rewardsTestUserAddress = bytes.Repeat([]byte("u"), 32)
+
+func createTestRewardsBreakdown() *common.RewardsBreakdown {
+	userAddress := hex.EncodeToString(rewardsTestUserAddress)
+	delegationAddress := hex.EncodeToString(vm.FirstDelegationSCAddress)
+
+	return &common.RewardsBreakdown{
+		Epoch:        rewardsBreakdownTestEpoch,
+		BaseRewards:  "1500",
+		TopUpRewards: "300",
+		LeaderFees:   "200",
+		Nodes: []*common.NodeRewardsBreakdown{
+			{BlsKey: "aa", RewardAddress: userAddress, BaseReward: "500", TopUpReward: "100", AccumulatedFees: "100"},
+			{BlsKey: "bb", RewardAddress: delegationAddress, BaseReward: "1000", TopUpReward: "200", AccumulatedFees: "100"},
+		},
+		RewardAddresses: []*common.RewardAddressBreakdown{
+			{Address: userAddress, NumNodes: 1, TotalReward: "700"},
+			{Address: delegationAddress, NumNodes: 1, TotalReward: "1300"},
+		},
+	}
+}
+
+func createRewardsBreakdownMockArgs() ArgRewardsBreakdownProcessor {
+	storer := genericMocks.NewStorerMockWithEpoch(rewardsBreakdownTestEpoch)
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	marshalledBreakdown, _ := marshaller.Marshal(createTestRewardsBreakdown())
+	_ = storer.Put(common.RewardsBreakdownKey(rewardsBreakdownTestEpoch), marshalledBreakdown)
+
+	arg := createMockArgs()
+	arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(32)
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			switch query.FuncName {
+			case getRewardDataFunction:
+				if big.NewInt(0).SetBytes(query.Arguments[0]).Uint64() != uint64(rewardsBreakdownTestEpoch) {
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "reward not found"}, nil, nil
+				}
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{big.NewInt(1000).Bytes(), big.NewInt(400).Bytes(), big.NewInt(1000).Bytes()},
+				}, nil, nil
+			}
+
+			return nil, nil, fmt.Errorf("not an expected call")
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			if bytes.Equal(addressContainer, vm.FirstDelegationSCAddress) {
+				return createDelegationScAccount(addressContainer, nil), nil
+			}
+
+			return createScAccount(addressContainer, nil, addressContainer, 0), nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+
+	return ArgRewardsBreakdownProcessor{
+		ArgTrieIteratorProcessor: arg,
+		RewardsStorer:            storer,
+		Marshalizer:              marshaller,
+		MaxServiceFee:            10000,
+	}
+}
+
+func createDelegationScAccount(address []byte, leaves [][]byte) vmcommon.AccountHandler {
+	scAccount := createScAccount(address, leaves, address, 0)
+	dtt := &trieMock.DataTrieTrackerStub{
+		DataTrieCalled: func() common.Trie {
+			return scAccount.DataTrie().(common.Trie)
+		},
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			if bytes.Equal(key, []byte(core.DelegationSystemSCKey)) {
+				return []byte{1}, 0, nil
+			}
+			return nil, 0, nil
+		},
+	}
+
+	acc, _ := accounts.NewUserAccount(address, dtt, &trieMock.TrieLeafParserStub{})
+
+	return acc
+}
+
+func TestNewRewardsBreakdownProcessor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgRewardsBreakdownProcessor
+		exError  error
+	}{
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgRewardsBreakdownProcessor {
+				arg := createRewardsBreakdownMockArgs()
+				arg.Accounts = nil
+
+				return arg
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilRewardsStorer",
+			argsFunc: func() ArgRewardsBreakdownProcessor {
+				arg := createRewardsBreakdownMockArgs()
+				arg.RewardsStorer = nil
+
+				return arg
+			},
+			exError: ErrNilRewardsStorer,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgRewardsBreakdownProcessor {
+				arg := createRewardsBreakdownMockArgs()
+				arg.Marshalizer = nil
+
+				return arg
+			},
+			exError: ErrNilMarshalizer,
+		},
+		{
+			name: "InvalidMaxServiceFee",
+			argsFunc: func() ArgRewardsBreakdownProcessor {
+				arg := createRewardsBreakdownMockArgs()
+				arg.MaxServiceFee = 0
+
+				return arg
+			},
+			exError: ErrInvalidMaxServiceFee,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rbp, err := NewRewardsBreakdownProcessor(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+			require.Nil(t, rbp)
+		})
+	}
+
+	rbp, err := NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+	require.NotNil(t, rbp)
+	require.Nil(t, err)
+}
+
+func TestRewardsBreakdownProcessor_GetRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		rbp, _ := NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+		breakdown, err := rbp.GetRewardsBreakdown(context.Background(), rewardsBreakdownTestEpoch+1, "")
+		require.Nil(t, breakdown)
+		require.True(t, errors.Is(err, ErrRewardsBreakdownNotFound))
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rbp, _ := NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+		breakdown, err := rbp.GetRewardsBreakdown(context.Background(), rewardsBreakdownTestEpoch, "invalid")
+		require.Nil(t, breakdown)
+		require.NotNil(t, err)
+	})
+	t.Run("no address should return the whole breakdown", func(t *testing.T) {
+		t.Parallel()
+
+		rbp, _ := NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+		breakdown, err := rbp.GetRewardsBreakdown(context.Background(), rewardsBreakdownTestEpoch, "")
+		require.Nil(t, err)
+		require.Equal(t, createTestRewardsBreakdown(), breakdown)
+	})
+	t.Run("reward address should return only its data", func(t *testing.T) {
+		t.Parallel()
+
+		rbp, _ := NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+		address := hex.EncodeToString(rewardsTestUserAddress)
+		breakdown, err := rbp.GetRewardsBreakdown(context.Background(), rewardsBreakdownTestEpoch, address)
+		require.Nil(t, err)
+		require.Len(t, breakdown.Nodes, 1)
+		require.Equal(t, "aa", breakdown.Nodes[0].BlsKey)
+		require.Len(t, breakdown.RewardAddresses, 1)
+		require.Equal(t, "700", breakdown.RewardAddresses[0].TotalReward)
+		require.Nil(t, breakdown.RewardAddresses[0].Delegation)
+	})
+	t.Run("delegation contract should return the rewards split of the epoch", func(t *testing.T) {
+		t.Parallel()
+
+		rbp, _ := NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+		address := hex.EncodeToString(vm.FirstDelegationSCAddress)
+		breakdown, err := rbp.GetRewardsBreakdown(context.Background(), rewardsBreakdownTestEpoch, address)
+		require.Nil(t, err)
+		require.Len(t, breakdown.Nodes, 1)
+		require.Equal(t, "bb", breakdown.Nodes[0].BlsKey)
+		require.Len(t, breakdown.RewardAddresses, 1)
+
+		// 10% service fee out of 1000, while the remaining 900 goes to the delegators
+		expectedDelegation := &common.DelegationRewardsBreakdown{
+			RewardsToDistribute:  "1000",
+			TotalActiveStake:     "400",
+			ServiceFee:           1000,
+			ServiceFeeReward:     "100",
+			RewardsForDelegators: "900",
+		}
+		require.Equal(t, expectedDelegation, breakdown.RewardAddresses[0].Delegation)
+	})
+}
+
+func TestRewardsBreakdownProcessor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var rbp *rewardsBreakdownProcessor
+	require.True(t, rbp.IsInterfaceNil())
+
+	rbp, _ = NewRewardsBreakdownProcessor(createRewardsBreakdownMockArgs())
+	require.False(t, rbp.IsInterfaceNil())
+}
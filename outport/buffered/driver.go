@@ -0,0 +1,476 @@
+package buffered
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/buffered")
+
+const bytesInMegabyte = 1024 * 1024
+
+const (
+	// StallPolicy makes the block processing wait while the driver lag is over the limits
+	StallPolicy = "stall"
+	// DropPolicy drops the new items while the driver lag is over the limits, except the block reverts and the
+	// finalized blocks
+	DropPolicy = "drop"
+	// DisconnectPolicy closes the driver and drops all its items once the driver lag goes over the limits
+	DisconnectPolicy = "disconnect"
+)
+
+// ArgsBufferedDriver holds the arguments needed for creating a new bufferedDriver
+type ArgsBufferedDriver struct {
+	Driver           outport.Driver
+	Name             string
+	Config           config.OutportBufferConfig
+	RetrialInterval  time.Duration
+	AppStatusHandler core.AppStatusHandler
+}
+
+// bufferedDriver decouples an outport driver from the block processing. Every call is serialized with the driver
+// marshaller and queued, then delivered in order on a separate goroutine, retrying until the driver accepts it.
+// The queue is bounded by the maximum lag, in blocks and in bytes, and the configured policy decides what happens
+// when the driver falls behind
+type bufferedDriver struct {
+	driver           outport.Driver
+	name             string
+	marshaller       marshal.Marshalizer
+	maxLagInBlocks   uint64
+	maxLagInBytes    uint64
+	maxLagPolicy     string
+	retrialInterval  time.Duration
+	appStatusHandler core.AppStatusHandler
+
+	mutex        sync.Mutex
+	cond         *sync.Cond
+	queue        *spillQueue
+	lagInBlocks  uint64
+	lagInBytes   uint64
+	numDropped   uint64
+	isStopped    bool
+	chanStop     chan struct{}
+	chanLoopDone chan struct{}
+	stopOnce     sync.Once
+
+	closeDriverOnce sync.Once
+	errCloseDriver  error
+}
+
+// NewBufferedDriver will create a new instance of bufferedDriver and start delivering the queued items
+func NewBufferedDriver(args ArgsBufferedDriver) (*bufferedDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := newSpillQueue(args.Config.SpillDirectory, args.Name, args.Config.MaxItemsInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	bd := &bufferedDriver{
+		driver:           args.Driver,
+		name:             args.Name,
+		marshaller:       args.Driver.GetMarshaller(),
+		maxLagInBlocks:   args.Config.MaxLagInBlocks,
+		maxLagInBytes:    args.Config.MaxLagInMB * bytesInMegabyte,
+		maxLagPolicy:     args.Config.MaxLagPolicy,
+		retrialInterval:  args.RetrialInterval,
+		appStatusHandler: args.AppStatusHandler,
+		queue:            queue,
+		chanStop:         make(chan struct{}),
+		chanLoopDone:     make(chan struct{}),
+	}
+	bd.cond = sync.NewCond(&bd.mutex)
+	bd.updateMetrics()
+
+	go bd.processLoop()
+
+	return bd, nil
+}
+
+func checkArgs(args ArgsBufferedDriver) error {
+	if check.IfNil(args.Driver) {
+		return ErrNilDriver
+	}
+	if check.IfNil(args.Driver.GetMarshaller()) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if len(args.Name) == 0 {
+		return ErrEmptyDriverName
+	}
+	if len(args.Config.SpillDirectory) == 0 {
+		return ErrEmptySpillDirectory
+	}
+	if args.Config.MaxItemsInMemory < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxItemsInMemory, args.Config.MaxItemsInMemory)
+	}
+	if args.RetrialInterval <= 0 {
+		return fmt.Errorf("%w, provided: %v", ErrInvalidRetrialInterval, args.RetrialInterval)
+	}
+
+	switch args.Config.MaxLagPolicy {
+	case StallPolicy, DropPolicy, DisconnectPolicy:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidMaxLagPolicy, args.Config.MaxLagPolicy)
+	}
+}
+
+// SaveBlock queues the block for the wrapped driver
+func (bd *bufferedDriver) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	return bd.enqueue(outportcore.TopicSaveBlock, outportBlock)
+}
+
+// RevertIndexedBlock queues the block revert for the wrapped driver
+func (bd *bufferedDriver) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	return bd.enqueue(outportcore.TopicRevertIndexedBlock, blockData)
+}
+
+// SaveRoundsInfo queues the rounds info for the wrapped driver
+func (bd *bufferedDriver) SaveRoundsInfo(roundsInfos *outportcore.RoundsInfo) error {
+	return bd.enqueue(outportcore.TopicSaveRoundsInfo, roundsInfos)
+}
+
+// SaveValidatorsPubKeys queues the validators public keys for the wrapped driver
+func (bd *bufferedDriver) SaveValidatorsPubKeys(validatorsPubKeys *outportcore.ValidatorsPubKeys) error {
+	return bd.enqueue(outportcore.TopicSaveValidatorsPubKeys, validatorsPubKeys)
+}
+
+// SaveValidatorsRating queues the validators rating for the wrapped driver
+func (bd *bufferedDriver) SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating) error {
+	return bd.enqueue(outportcore.TopicSaveValidatorsRating, validatorsRating)
+}
+
+// SaveValidatorsStatusChanges queues the validators' status changes for the wrapped driver
+func (bd *bufferedDriver) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error {
+	return bd.enqueue(common.TopicSaveValidatorsStatusChanges, statusChanges)
+}
+
+// SaveAccounts queues the accounts for the wrapped driver
+func (bd *bufferedDriver) SaveAccounts(accounts *outportcore.Accounts) error {
+	return bd.enqueue(outportcore.TopicSaveAccounts, accounts)
+}
+
+// FinalizedBlock queues the finalized block for the wrapped driver
+func (bd *bufferedDriver) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error {
+	return bd.enqueue(outportcore.TopicFinalizedBlock, finalizedBlock)
+}
+
+// GetMarshaller returns the marshaller of the wrapped driver
+func (bd *bufferedDriver) GetMarshaller() marshal.Marshalizer {
+	return bd.driver.GetMarshaller()
+}
+
+// SetCurrentSettings sets the settings directly on the wrapped driver, as they are requested by the driver itself
+func (bd *bufferedDriver) SetCurrentSettings(config outportcore.OutportConfig) error {
+	return bd.driver.SetCurrentSettings(config)
+}
+
+// RegisterHandler registers the handler directly on the wrapped driver
+func (bd *bufferedDriver) RegisterHandler(handlerFunction func() error, topic string) error {
+	return bd.driver.RegisterHandler(handlerFunction, topic)
+}
+
+// enqueue serializes the data right away, as the outport reuses the provided structures for the next drivers
+func (bd *bufferedDriver) enqueue(topic string, data interface{}) error {
+	payload, err := bd.marshaller.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling data for topic %s", err, topic)
+	}
+
+	bd.mutex.Lock()
+	defer bd.mutex.Unlock()
+
+	if bd.isStopped {
+		return nil
+	}
+
+	if bd.isLagOverLimits() {
+		switch bd.maxLagPolicy {
+		case DropPolicy:
+			if canBeDropped(topic) {
+				bd.numDropped++
+				bd.updateMetrics()
+				log.Warn("outport driver lag is over the limits, dropping item",
+					"driver", bd.name, "topic", topic, "lag in blocks", bd.lagInBlocks, "lag in bytes", bd.lagInBytes)
+				return nil
+			}
+		case DisconnectPolicy:
+			bd.disconnect()
+			return nil
+		default:
+			bd.waitForLag(topic)
+			if bd.isStopped {
+				return nil
+			}
+		}
+	}
+
+	item := &queueItem{
+		topic:   topic,
+		payload: payload,
+	}
+	err = bd.queue.push(item)
+	if err != nil {
+		return fmt.Errorf("%w while queueing data for topic %s", err, topic)
+	}
+
+	bd.addLag(item)
+	bd.cond.Broadcast()
+
+	return nil
+}
+
+// canBeDropped returns false for the block reverts and the finalized blocks, as they might refer to blocks already
+// queued or delivered, so dropping them would leave the driver with a reverted or a not finalized block
+func canBeDropped(topic string) bool {
+	return topic != outportcore.TopicRevertIndexedBlock && topic != outportcore.TopicFinalizedBlock
+}
+
+func (bd *bufferedDriver) isLagOverLimits() bool {
+	isOverBlocksLimit := bd.maxLagInBlocks > 0 && bd.lagInBlocks >= bd.maxLagInBlocks
+	isOverBytesLimit := bd.maxLagInBytes > 0 && bd.lagInBytes >= bd.maxLagInBytes
+
+	return isOverBlocksLimit || isOverBytesLimit
+}
+
+// waitForLag should be called under mutex protection
+func (bd *bufferedDriver) waitForLag(topic string) {
+	log.Warn("outport driver lag is over the limits, waiting for the driver to catch up",
+		"driver", bd.name, "topic", topic, "lag in blocks", bd.lagInBlocks, "lag in bytes", bd.lagInBytes)
+
+	for bd.isLagOverLimits() && !bd.isStopped {
+		bd.cond.Wait()
+	}
+}
+
+// disconnect should be called under mutex protection
+func (bd *bufferedDriver) disconnect() {
+	log.Error("outport driver lag is over the limits, disconnecting the driver",
+		"driver", bd.name, "lag in blocks", bd.lagInBlocks, "lag in bytes", bd.lagInBytes, "dropped items", bd.queue.len())
+
+	bd.numDropped += uint64(bd.queue.len())
+	err := bd.queue.clear()
+	log.LogIfError(err)
+
+	bd.lagInBlocks = 0
+	bd.lagInBytes = 0
+	bd.stop()
+	bd.updateMetrics()
+
+	go func() {
+		log.LogIfError(bd.closeDriver())
+	}()
+}
+
+// closeDriver closes the wrapped driver only once, after the delivery loop ends, as the loop might still be calling it
+func (bd *bufferedDriver) closeDriver() error {
+	<-bd.chanLoopDone
+
+	bd.closeDriverOnce.Do(func() {
+		bd.errCloseDriver = bd.driver.Close()
+	})
+
+	return bd.errCloseDriver
+}
+
+// stop should be called under mutex protection
+func (bd *bufferedDriver) stop() {
+	bd.isStopped = true
+	bd.stopOnce.Do(func() {
+		close(bd.chanStop)
+	})
+	bd.cond.Broadcast()
+}
+
+func (bd *bufferedDriver) processLoop() {
+	defer close(bd.chanLoopDone)
+
+	for {
+		item, ok := bd.nextItem()
+		if !ok {
+			return
+		}
+
+		bd.deliver(item)
+		bd.markDelivered(item)
+	}
+}
+
+func (bd *bufferedDriver) nextItem() (*queueItem, bool) {
+	bd.mutex.Lock()
+	defer bd.mutex.Unlock()
+
+	for !bd.isStopped {
+		item, err := bd.queue.pop()
+		if err != nil {
+			log.Error("cannot read the next queued outport item, dropping the queue", "driver", bd.name, "error", err)
+			bd.numDropped += uint64(bd.queue.len())
+			log.LogIfError(bd.queue.clear())
+			bd.lagInBlocks = 0
+			bd.lagInBytes = 0
+			bd.updateMetrics()
+			bd.cond.Broadcast()
+			continue
+		}
+		if item != nil {
+			return item, true
+		}
+
+		bd.cond.Wait()
+	}
+
+	return nil, false
+}
+
+func (bd *bufferedDriver) deliver(item *queueItem) {
+	for {
+		err := bd.callDriver(item)
+		if err == nil {
+			return
+		}
+
+		log.Error("error calling the buffered outport driver, will retry",
+			"driver", bd.name,
+			"topic", item.topic,
+			"retrial in", bd.retrialInterval,
+			"error", err)
+
+		select {
+		case <-bd.chanStop:
+			return
+		case <-time.After(bd.retrialInterval):
+		}
+	}
+}
+
+func (bd *bufferedDriver) callDriver(item *queueItem) error {
+	data, err := createEmptyData(item.topic)
+	if err != nil {
+		log.Error("dropping queued outport item", "driver", bd.name, "error", err)
+		return nil
+	}
+
+	err = bd.marshaller.Unmarshal(data, item.payload)
+	if err != nil {
+		log.Error("dropping queued outport item", "driver", bd.name, "topic", item.topic, "error", err)
+		return nil
+	}
+
+	switch item.topic {
+	case outportcore.TopicSaveBlock:
+		return bd.driver.SaveBlock(data.(*outportcore.OutportBlock))
+	case outportcore.TopicRevertIndexedBlock:
+		return bd.driver.RevertIndexedBlock(data.(*outportcore.BlockData))
+	case outportcore.TopicSaveRoundsInfo:
+		return bd.driver.SaveRoundsInfo(data.(*outportcore.RoundsInfo))
+	case outportcore.TopicSaveValidatorsPubKeys:
+		return bd.driver.SaveValidatorsPubKeys(data.(*outportcore.ValidatorsPubKeys))
+	case outportcore.TopicSaveValidatorsRating:
+		return bd.driver.SaveValidatorsRating(data.(*outportcore.ValidatorsRating))
+	case outportcore.TopicSaveAccounts:
+		return bd.driver.SaveAccounts(data.(*outportcore.Accounts))
+	case common.TopicSaveValidatorsStatusChanges:
+		return bd.driver.SaveValidatorsStatusChanges(data.(*common.ValidatorsStatusChanges))
+	default:
+		return bd.driver.FinalizedBlock(data.(*outportcore.FinalizedBlock))
+	}
+}
+
+func createEmptyData(topic string) (interface{}, error) {
+	switch topic {
+	case outportcore.TopicSaveBlock:
+		return &outportcore.OutportBlock{}, nil
+	case outportcore.TopicRevertIndexedBlock:
+		return &outportcore.BlockData{}, nil
+	case outportcore.TopicSaveRoundsInfo:
+		return &outportcore.RoundsInfo{}, nil
+	case outportcore.TopicSaveValidatorsPubKeys:
+		return &outportcore.ValidatorsPubKeys{}, nil
+	case outportcore.TopicSaveValidatorsRating:
+		return &outportcore.ValidatorsRating{}, nil
+	case outportcore.TopicSaveAccounts:
+		return &outportcore.Accounts{}, nil
+	case common.TopicSaveValidatorsStatusChanges:
+		return &common.ValidatorsStatusChanges{}, nil
+	case outportcore.TopicFinalizedBlock:
+		return &outportcore.FinalizedBlock{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownTopic, topic)
+	}
+}
+
+func (bd *bufferedDriver) markDelivered(item *queueItem) {
+	bd.mutex.Lock()
+	defer bd.mutex.Unlock()
+
+	if bd.isStopped {
+		return
+	}
+
+	if item.topic == outportcore.TopicSaveBlock {
+		bd.lagInBlocks--
+	}
+	bd.lagInBytes -= uint64(len(item.payload))
+	bd.updateMetrics()
+	bd.cond.Broadcast()
+}
+
+// addLag should be called under mutex protection
+func (bd *bufferedDriver) addLag(item *queueItem) {
+	if item.topic == outportcore.TopicSaveBlock {
+		bd.lagInBlocks++
+	}
+	bd.lagInBytes += uint64(len(item.payload))
+	bd.updateMetrics()
+}
+
+// updateMetrics should be called under mutex protection
+func (bd *bufferedDriver) updateMetrics() {
+	bd.appStatusHandler.SetUInt64Value(common.MetricOutportDriverLagInBlocksPrefix+bd.name, bd.lagInBlocks)
+	bd.appStatusHandler.SetUInt64Value(common.MetricOutportDriverLagInBytesPrefix+bd.name, bd.lagInBytes)
+	bd.appStatusHandler.SetUInt64Value(common.MetricOutportDriverDroppedPrefix+bd.name, bd.numDropped)
+}
+
+// Close stops the delivery, dropping the items not yet delivered, and closes the wrapped driver once the delivery loop
+// ends
+func (bd *bufferedDriver) Close() error {
+	bd.mutex.Lock()
+	if !bd.isStopped && bd.queue.len() > 0 {
+		log.Warn("closing the buffered outport driver with undelivered items",
+			"driver", bd.name, "items", bd.queue.len(), "lag in blocks", bd.lagInBlocks)
+	}
+	bd.stop()
+	bd.mutex.Unlock()
+
+	err := bd.closeDriver()
+
+	bd.mutex.Lock()
+	errQueue := bd.queue.close()
+	bd.mutex.Unlock()
+	if err == nil {
+		err = errQueue
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bd *bufferedDriver) IsInterfaceNil() bool {
+	return bd == nil
+}
@@ -0,0 +1,421 @@
+package buffered_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/buffered"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const driverName = "test"
+
+func createMockArgsBufferedDriver(t *testing.T) buffered.ArgsBufferedDriver {
+	return buffered.ArgsBufferedDriver{
+		Driver: &mock.DriverStub{},
+		Name:   driverName,
+		Config: config.OutportBufferConfig{
+			Enabled:          true,
+			MaxItemsInMemory: 2,
+			SpillDirectory:   t.TempDir(),
+			MaxLagPolicy:     buffered.StallPolicy,
+		},
+		RetrialInterval:  time.Millisecond,
+		AppStatusHandler: statusHandler.NewAppStatusHandlerMock(),
+	}
+}
+
+func createOutportBlock(nonce uint64) *outportcore.OutportBlock {
+	return &outportcore.OutportBlock{
+		BlockData:              &outportcore.BlockData{HeaderHash: []byte("hash")},
+		HighestFinalBlockNonce: nonce,
+	}
+}
+
+func TestNewBufferedDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.Driver = nil
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.Equal(t, buffered.ErrNilDriver, err)
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.AppStatusHandler = nil
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.Equal(t, buffered.ErrNilAppStatusHandler, err)
+	})
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.Name = ""
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.Equal(t, buffered.ErrEmptyDriverName, err)
+	})
+	t.Run("empty spill directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.Config.SpillDirectory = ""
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.Equal(t, buffered.ErrEmptySpillDirectory, err)
+	})
+	t.Run("invalid max items in memory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.Config.MaxItemsInMemory = 0
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.ErrorIs(t, err, buffered.ErrInvalidMaxItemsInMemory)
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.RetrialInterval = 0
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.ErrorIs(t, err, buffered.ErrInvalidRetrialInterval)
+	})
+	t.Run("invalid max lag policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBufferedDriver(t)
+		args.Config.MaxLagPolicy = "retry"
+		bd, err := buffered.NewBufferedDriver(args)
+		assert.Nil(t, bd)
+		assert.ErrorIs(t, err, buffered.ErrInvalidMaxLagPolicy)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bd, err := buffered.NewBufferedDriver(createMockArgsBufferedDriver(t))
+		require.Nil(t, err)
+		assert.False(t, bd.IsInterfaceNil())
+		assert.Nil(t, bd.Close())
+	})
+}
+
+func TestBufferedDriver_ShouldDeliverInOrderIncludingTheSpilledItems(t *testing.T) {
+	t.Parallel()
+
+	mutDelivered := sync.Mutex{}
+	delivered := make([]string, 0)
+	record := func(topic string) {
+		mutDelivered.Lock()
+		delivered = append(delivered, topic)
+		mutDelivered.Unlock()
+	}
+	chanRelease := make(chan struct{})
+
+	args := createMockArgsBufferedDriver(t)
+	args.Config.MaxItemsInMemory = 1
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			<-chanRelease
+			record(outportcore.TopicSaveBlock)
+			return nil
+		},
+		RevertIndexedBlockCalled: func(blockData *outportcore.BlockData) error {
+			record(outportcore.TopicRevertIndexedBlock)
+			return nil
+		},
+		SaveRoundsInfoCalled: func(roundsInfos *outportcore.RoundsInfo) error {
+			record(outportcore.TopicSaveRoundsInfo)
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error {
+			record(outportcore.TopicSaveValidatorsPubKeys)
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(validatorsRating *outportcore.ValidatorsRating) error {
+			record(outportcore.TopicSaveValidatorsRating)
+			return nil
+		},
+		SaveAccountsCalled: func(accounts *outportcore.Accounts) error {
+			record(outportcore.TopicSaveAccounts)
+			return nil
+		},
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			assert.Equal(t, []byte("hash"), finalizedBlock.HeaderHash)
+			record(outportcore.TopicFinalizedBlock)
+			return nil
+		},
+	}
+	bd, _ := buffered.NewBufferedDriver(args)
+
+	require.Nil(t, bd.SaveBlock(createOutportBlock(1)))
+	require.Nil(t, bd.RevertIndexedBlock(&outportcore.BlockData{}))
+	require.Nil(t, bd.SaveRoundsInfo(&outportcore.RoundsInfo{}))
+	require.Nil(t, bd.SaveValidatorsPubKeys(&outportcore.ValidatorsPubKeys{}))
+	require.Nil(t, bd.SaveValidatorsRating(&outportcore.ValidatorsRating{}))
+	require.Nil(t, bd.SaveAccounts(&outportcore.Accounts{}))
+	require.Nil(t, bd.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("hash")}))
+	close(chanRelease)
+
+	expected := []string{
+		outportcore.TopicSaveBlock,
+		outportcore.TopicRevertIndexedBlock,
+		outportcore.TopicSaveRoundsInfo,
+		outportcore.TopicSaveValidatorsPubKeys,
+		outportcore.TopicSaveValidatorsRating,
+		outportcore.TopicSaveAccounts,
+		outportcore.TopicFinalizedBlock,
+	}
+	assert.Eventually(t, func() bool {
+		mutDelivered.Lock()
+		defer mutDelivered.Unlock()
+
+		return len(delivered) == len(expected)
+	}, time.Second, time.Millisecond)
+	mutDelivered.Lock()
+	assert.Equal(t, expected, delivered)
+	mutDelivered.Unlock()
+
+	assert.Nil(t, bd.Close())
+}
+
+func TestBufferedDriver_SaveBlockShouldNotWaitForAFailingDriver(t *testing.T) {
+	t.Parallel()
+
+	isDriverWorking := atomic.Bool{}
+	numSavedBlocks := atomic.Int32{}
+	args := createMockArgsBufferedDriver(t)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			if !isDriverWorking.Load() {
+				return errors.New("driver error")
+			}
+
+			numSavedBlocks.Add(1)
+			return nil
+		},
+	}
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.AppStatusHandler = appStatusHandler
+	bd, _ := buffered.NewBufferedDriver(args)
+
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		require.Nil(t, bd.SaveBlock(createOutportBlock(nonce)))
+	}
+
+	assert.Equal(t, uint64(5), appStatusHandler.GetUint64(common.MetricOutportDriverLagInBlocksPrefix+driverName))
+	assert.NotZero(t, appStatusHandler.GetUint64(common.MetricOutportDriverLagInBytesPrefix+driverName))
+
+	isDriverWorking.Store(true)
+	assert.Eventually(t, func() bool {
+		return numSavedBlocks.Load() == 5
+	}, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return appStatusHandler.GetUint64(common.MetricOutportDriverLagInBytesPrefix+driverName) == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricOutportDriverLagInBlocksPrefix+driverName))
+
+	assert.Nil(t, bd.Close())
+}
+
+func TestBufferedDriver_MaxLagPolicies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("drop policy should drop the items over the limit", func(t *testing.T) {
+		t.Parallel()
+
+		chanRelease := make(chan struct{})
+		savedNonces := make(chan uint64, 10)
+		args := createMockArgsBufferedDriver(t)
+		args.Config.MaxLagInBlocks = 2
+		args.Config.MaxLagPolicy = buffered.DropPolicy
+		args.Driver = &mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				<-chanRelease
+				savedNonces <- outportBlock.HighestFinalBlockNonce
+				return nil
+			},
+		}
+		appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+		args.AppStatusHandler = appStatusHandler
+		bd, _ := buffered.NewBufferedDriver(args)
+
+		for nonce := uint64(1); nonce <= 4; nonce++ {
+			require.Nil(t, bd.SaveBlock(createOutportBlock(nonce)))
+		}
+		assert.Equal(t, uint64(2), appStatusHandler.GetUint64(common.MetricOutportDriverDroppedPrefix+driverName))
+
+		close(chanRelease)
+		assert.Equal(t, uint64(1), <-savedNonces)
+		assert.Equal(t, uint64(2), <-savedNonces)
+
+		assert.Nil(t, bd.Close())
+		assert.Empty(t, savedNonces)
+	})
+	t.Run("drop policy should not drop the reverts and the finalized blocks", func(t *testing.T) {
+		t.Parallel()
+
+		chanRelease := make(chan struct{})
+		calledTopics := make(chan string, 10)
+		args := createMockArgsBufferedDriver(t)
+		args.Config.MaxLagInBlocks = 1
+		args.Config.MaxLagPolicy = buffered.DropPolicy
+		args.Driver = &mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				<-chanRelease
+				calledTopics <- outportcore.TopicSaveBlock
+				return nil
+			},
+			RevertIndexedBlockCalled: func(blockData *outportcore.BlockData) error {
+				calledTopics <- outportcore.TopicRevertIndexedBlock
+				return nil
+			},
+			FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+				calledTopics <- outportcore.TopicFinalizedBlock
+				return nil
+			},
+		}
+		appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+		args.AppStatusHandler = appStatusHandler
+		bd, _ := buffered.NewBufferedDriver(args)
+
+		require.Nil(t, bd.SaveBlock(createOutportBlock(1)))
+		require.Nil(t, bd.SaveBlock(createOutportBlock(2)))
+		require.Nil(t, bd.RevertIndexedBlock(&outportcore.BlockData{HeaderHash: []byte("hash")}))
+		require.Nil(t, bd.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("hash")}))
+		assert.Equal(t, uint64(1), appStatusHandler.GetUint64(common.MetricOutportDriverDroppedPrefix+driverName))
+
+		close(chanRelease)
+		assert.Equal(t, outportcore.TopicSaveBlock, <-calledTopics)
+		assert.Equal(t, outportcore.TopicRevertIndexedBlock, <-calledTopics)
+		assert.Equal(t, outportcore.TopicFinalizedBlock, <-calledTopics)
+
+		assert.Nil(t, bd.Close())
+		assert.Empty(t, calledTopics)
+	})
+	t.Run("disconnect policy should close the driver", func(t *testing.T) {
+		t.Parallel()
+
+		chanRelease := make(chan struct{})
+		chanSaveCalled := make(chan struct{}, 1)
+		numCloseCalls := atomic.Int32{}
+		numSaveCalls := atomic.Int32{}
+		args := createMockArgsBufferedDriver(t)
+		args.Config.MaxLagInMB = 1
+		args.Config.MaxLagPolicy = buffered.DisconnectPolicy
+		args.Driver = &mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				numSaveCalls.Add(1)
+				chanSaveCalled <- struct{}{}
+				<-chanRelease
+				return nil
+			},
+			CloseCalled: func() error {
+				numCloseCalls.Add(1)
+				return nil
+			},
+		}
+		appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+		args.AppStatusHandler = appStatusHandler
+		bd, _ := buffered.NewBufferedDriver(args)
+
+		largeBlock := createOutportBlock(1)
+		largeBlock.BlockData.HeaderBytes = make([]byte, 1024*1024)
+		require.Nil(t, bd.SaveBlock(largeBlock))
+		<-chanSaveCalled
+		require.Nil(t, bd.SaveBlock(createOutportBlock(2)))
+		require.Nil(t, bd.SaveBlock(createOutportBlock(3)))
+
+		assert.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricOutportDriverLagInBytesPrefix+driverName))
+		assert.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricOutportDriverLagInBlocksPrefix+driverName))
+
+		time.Sleep(time.Millisecond * 50)
+		assert.Equal(t, int32(0), numCloseCalls.Load(), "should not close the driver while it is still called")
+
+		close(chanRelease)
+		assert.Eventually(t, func() bool {
+			return numCloseCalls.Load() == 1
+		}, time.Second, time.Millisecond)
+		assert.Nil(t, bd.Close())
+		assert.Equal(t, int32(1), numCloseCalls.Load())
+		assert.Equal(t, int32(1), numSaveCalls.Load())
+	})
+	t.Run("stall policy should wait for the driver to catch up", func(t *testing.T) {
+		t.Parallel()
+
+		chanRelease := make(chan struct{})
+		args := createMockArgsBufferedDriver(t)
+		args.Config.MaxLagInBlocks = 1
+		args.Config.MaxLagPolicy = buffered.StallPolicy
+		args.Driver = &mock.DriverStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+				<-chanRelease
+				return nil
+			},
+		}
+		bd, _ := buffered.NewBufferedDriver(args)
+
+		require.Nil(t, bd.SaveBlock(createOutportBlock(1)))
+
+		chanSaved := make(chan struct{})
+		go func() {
+			_ = bd.SaveBlock(createOutportBlock(2))
+			close(chanSaved)
+		}()
+
+		select {
+		case <-chanSaved:
+			assert.Fail(t, "should have waited for the driver")
+		case <-time.After(time.Millisecond * 50):
+		}
+
+		close(chanRelease)
+		select {
+		case <-chanSaved:
+		case <-time.After(time.Second):
+			assert.Fail(t, "should have been released")
+		}
+
+		assert.Nil(t, bd.Close())
+	})
+}
+
+func TestBufferedDriver_CloseShouldCloseTheWrappedDriver(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsBufferedDriver(t)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			return expectedErr
+		},
+		CloseCalled: func() error {
+			return expectedErr
+		},
+	}
+	bd, _ := buffered.NewBufferedDriver(args)
+	require.Nil(t, bd.SaveBlock(createOutportBlock(1)))
+
+	err := bd.Close()
+	assert.Equal(t, expectedErr, err)
+
+	// calls after close are ignored
+	assert.Nil(t, bd.SaveBlock(createOutportBlock(2)))
+}
@@ -0,0 +1,29 @@
+package buffered
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrEmptyDriverName signals that an empty driver name has been provided
+var ErrEmptyDriverName = errors.New("empty driver name")
+
+// ErrEmptySpillDirectory signals that an empty spill directory has been provided
+var ErrEmptySpillDirectory = errors.New("empty spill directory")
+
+// ErrInvalidMaxItemsInMemory signals that an invalid maximum number of in-memory items has been provided
+var ErrInvalidMaxItemsInMemory = errors.New("invalid maximum number of items in memory")
+
+// ErrInvalidRetrialInterval signals that an invalid retrial interval has been provided
+var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrInvalidMaxLagPolicy signals that an unknown maximum lag policy has been provided
+var ErrInvalidMaxLagPolicy = errors.New("invalid maximum lag policy")
+
+// ErrCorruptedSpillFile signals that an item read from the spill file failed the integrity checks
+var ErrCorruptedSpillFile = errors.New("corrupted spill file")
+
+var errUnknownTopic = errors.New("unknown topic")
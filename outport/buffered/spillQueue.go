@@ -0,0 +1,171 @@
+package buffered
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+)
+
+// spillRecordHeaderSize is the size of the header prepended to each spilled item:
+// payload length (4 bytes) | topic length (1 byte) | crc32 of the payload (4 bytes)
+const spillRecordHeaderSize = 9
+
+const spillFileExtension = ".spill"
+
+type queueItem struct {
+	topic   string
+	payload []byte
+}
+
+// spillQueue is a FIFO queue holding up to maxItemsInMemory items in memory. Once the memory is full, the items are
+// appended to a spill file and all the following ones go on disk as well, until the file is consumed, so the
+// items on disk are always newer than the ones in memory. The queue is not concurrent safe
+type spillQueue struct {
+	maxItemsInMemory int
+	memory           []*queueItem
+	spillFile        *os.File
+	readOffset       int64
+	writeOffset      int64
+	numItemsOnDisk   int
+}
+
+// newSpillQueue creates the queue and its spill file, dropping the items spilled by a previous run as the ones
+// kept in memory at that time are lost anyway
+func newSpillQueue(directory string, name string, maxItemsInMemory int) (*spillQueue, error) {
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(directory, name+spillFileExtension)
+	fileInfo, err := os.Stat(path)
+	if err == nil && fileInfo.Size() > 0 {
+		log.Warn("dropping the outport items spilled by a previous run", "file", path, "size", fileInfo.Size())
+	}
+
+	spillFile, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spillQueue{
+		maxItemsInMemory: maxItemsInMemory,
+		memory:           make([]*queueItem, 0, maxItemsInMemory),
+		spillFile:        spillFile,
+	}, nil
+}
+
+func (sq *spillQueue) push(item *queueItem) error {
+	if sq.numItemsOnDisk == 0 && len(sq.memory) < sq.maxItemsInMemory {
+		sq.memory = append(sq.memory, item)
+		return nil
+	}
+
+	record := encodeSpillRecord(item)
+	_, err := sq.spillFile.WriteAt(record, sq.writeOffset)
+	if err != nil {
+		return err
+	}
+
+	sq.writeOffset += int64(len(record))
+	sq.numItemsOnDisk++
+
+	return nil
+}
+
+// pop returns the oldest item or nil if the queue is empty
+func (sq *spillQueue) pop() (*queueItem, error) {
+	if len(sq.memory) > 0 {
+		item := sq.memory[0]
+		sq.memory[0] = nil
+		sq.memory = sq.memory[1:]
+
+		return item, nil
+	}
+	if sq.numItemsOnDisk == 0 {
+		return nil, nil
+	}
+
+	item, size, err := sq.readSpillRecord()
+	if err != nil {
+		return nil, err
+	}
+
+	sq.readOffset += size
+	sq.numItemsOnDisk--
+	if sq.numItemsOnDisk == 0 {
+		err = sq.resetSpillFile()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return item, nil
+}
+
+func (sq *spillQueue) len() int {
+	return len(sq.memory) + sq.numItemsOnDisk
+}
+
+func (sq *spillQueue) clear() error {
+	sq.memory = make([]*queueItem, 0, sq.maxItemsInMemory)
+	sq.numItemsOnDisk = 0
+
+	return sq.resetSpillFile()
+}
+
+func (sq *spillQueue) close() error {
+	err := sq.spillFile.Truncate(0)
+	if err != nil {
+		log.Warn("could not truncate the spill file", "file", sq.spillFile.Name(), "error", err)
+	}
+
+	return sq.spillFile.Close()
+}
+
+func (sq *spillQueue) resetSpillFile() error {
+	sq.readOffset = 0
+	sq.writeOffset = 0
+
+	return sq.spillFile.Truncate(0)
+}
+
+func (sq *spillQueue) readSpillRecord() (*queueItem, int64, error) {
+	header := make([]byte, spillRecordHeaderSize)
+	_, err := sq.spillFile.ReadAt(header, sq.readOffset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	payloadLen := int(binary.BigEndian.Uint32(header[0:4]))
+	topicLen := int(header[4])
+	buff := make([]byte, topicLen+payloadLen)
+	_, err = sq.spillFile.ReadAt(buff, sq.readOffset+spillRecordHeaderSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	payload := buff[topicLen:]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[5:9]) {
+		return nil, 0, fmt.Errorf("%w: checksum mismatch at offset %d", ErrCorruptedSpillFile, sq.readOffset)
+	}
+
+	item := &queueItem{
+		topic:   string(buff[:topicLen]),
+		payload: payload,
+	}
+
+	return item, int64(spillRecordHeaderSize + len(buff)), nil
+}
+
+func encodeSpillRecord(item *queueItem) []byte {
+	buff := make([]byte, spillRecordHeaderSize, spillRecordHeaderSize+len(item.topic)+len(item.payload))
+	binary.BigEndian.PutUint32(buff[0:4], uint32(len(item.payload)))
+	buff[4] = byte(len(item.topic))
+	binary.BigEndian.PutUint32(buff[5:9], crc32.ChecksumIEEE(item.payload))
+	buff = append(buff, item.topic...)
+
+	return append(buff, item.payload...)
+}
@@ -0,0 +1,91 @@
+package buffered
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createItem(index int) *queueItem {
+	return &queueItem{
+		topic:   fmt.Sprintf("topic%d", index),
+		payload: []byte(fmt.Sprintf("payload%d", index)),
+	}
+}
+
+func TestSpillQueue_ShouldKeepTheOrderWhileSpilling(t *testing.T) {
+	t.Parallel()
+
+	queue, err := newSpillQueue(t.TempDir(), "test", 2)
+	require.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, queue.push(createItem(i)))
+	}
+	assert.Equal(t, 5, queue.len())
+	assert.Equal(t, 3, queue.numItemsOnDisk)
+
+	// the memory has free slots, but the new items should follow the spilled ones
+	item, err := queue.pop()
+	require.Nil(t, err)
+	assert.Equal(t, createItem(0), item)
+	require.Nil(t, queue.push(createItem(5)))
+	assert.Equal(t, 4, queue.numItemsOnDisk)
+
+	for i := 1; i <= 5; i++ {
+		item, err = queue.pop()
+		require.Nil(t, err)
+		assert.Equal(t, createItem(i), item)
+	}
+
+	item, err = queue.pop()
+	assert.Nil(t, err)
+	assert.Nil(t, item)
+	assert.Equal(t, int64(0), queue.writeOffset)
+
+	// the spill file was consumed, so the memory is used again
+	require.Nil(t, queue.push(createItem(6)))
+	assert.Equal(t, 0, queue.numItemsOnDisk)
+	assert.Nil(t, queue.close())
+}
+
+func TestSpillQueue_CorruptedRecordShouldError(t *testing.T) {
+	t.Parallel()
+
+	queue, _ := newSpillQueue(t.TempDir(), "test", 1)
+	require.Nil(t, queue.push(createItem(0)))
+	require.Nil(t, queue.push(createItem(1)))
+
+	_, err := queue.spillFile.WriteAt([]byte("X"), queue.writeOffset-1)
+	require.Nil(t, err)
+
+	_, _ = queue.pop()
+	item, err := queue.pop()
+	assert.Nil(t, item)
+	assert.ErrorIs(t, err, ErrCorruptedSpillFile)
+
+	assert.Nil(t, queue.clear())
+	assert.Equal(t, 0, queue.len())
+	assert.Nil(t, queue.close())
+}
+
+func TestNewSpillQueue_ShouldDropThePreviousSpillFile(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	path := filepath.Join(directory, "test"+spillFileExtension)
+	require.Nil(t, os.WriteFile(path, encodeSpillRecord(createItem(0)), 0644))
+
+	queue, err := newSpillQueue(directory, "test", 1)
+	require.Nil(t, err)
+	assert.Equal(t, 0, queue.len())
+
+	fileInfo, err := os.Stat(path)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), fileInfo.Size())
+	assert.Nil(t, queue.close())
+}
@@ -2,6 +2,7 @@ package disabled
 
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport"
 )
 
@@ -34,6 +35,10 @@ func (n *disabledOutport) SaveValidatorsPubKeys(_ *outportcore.ValidatorsPubKeys
 func (n *disabledOutport) SaveValidatorsRating(_ *outportcore.ValidatorsRating) {
 }
 
+// SaveValidatorsStatusChanges does nothing
+func (n *disabledOutport) SaveValidatorsStatusChanges(_ *common.ValidatorsStatusChanges) {
+}
+
 // SaveAccounts does nothing
 func (n *disabledOutport) SaveAccounts(_ *outportcore.Accounts) {
 }
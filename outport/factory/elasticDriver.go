@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// elasticDriver adapts the elastic indexer to the outport driver interface, as the indexer does not have an index for
+// the validators' status changes
+type elasticDriver struct {
+	dataindexer.Indexer
+}
+
+// SaveValidatorsStatusChanges does nothing
+func (ed *elasticDriver) SaveValidatorsStatusChanges(_ *common.ValidatorsStatusChanges) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *elasticDriver) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,31 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/file"
+)
+
+const bytesInMegabyte = 1024 * 1024
+
+// ArgsFileDriverFactory holds the arguments needed for creating a file driver
+type ArgsFileDriverFactory struct {
+	Config     config.FileDriverConfig
+	Marshaller marshal.Marshalizer
+}
+
+// CreateFileDriver will create a new instance of outport.Driver that appends the outport data to segment files
+func CreateFileDriver(args ArgsFileDriverFactory) (outport.Driver, error) {
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return file.NewFileDriver(file.ArgsFileDriver{
+		Marshaller:            args.Marshaller,
+		BlockContainer:        blockContainer,
+		Directory:             args.Config.Directory,
+		MaxSegmentSizeInBytes: args.Config.MaxSegmentSizeInMB * bytesInMegabyte,
+	})
+}
@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/file"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsFileDriverFactory{
+			Config: config.FileDriverConfig{
+				MaxSegmentSizeInMB: 1,
+			},
+			Marshaller: &marshallerMock.MarshalizerMock{},
+		}
+
+		driver, err := CreateFileDriver(args)
+		require.Equal(t, file.ErrEmptyDirectory, err)
+		require.Nil(t, driver)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsFileDriverFactory{
+			Config: config.FileDriverConfig{
+				Directory:          t.TempDir(),
+				MaxSegmentSizeInMB: 1,
+			},
+			Marshaller: &marshallerMock.MarshalizerMock{},
+		}
+
+		driver, err := CreateFileDriver(args)
+		require.Nil(t, err)
+		require.Equal(t, "*file.fileDriver", fmt.Sprintf("%T", driver))
+		require.Nil(t, driver.Close())
+	})
+}
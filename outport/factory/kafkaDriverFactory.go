@@ -0,0 +1,36 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/kafka"
+)
+
+// ArgsKafkaDriverFactory holds the arguments needed for creating a Kafka driver
+type ArgsKafkaDriverFactory struct {
+	Config     config.KafkaDriverConfig
+	Marshaller marshal.Marshalizer
+}
+
+// CreateKafkaDriver will create a new instance of outport.Driver that publishes the outport data to a Kafka partition
+func CreateKafkaDriver(args ArgsKafkaDriverFactory) (outport.Driver, error) {
+	producer, err := kafka.NewProducer(kafka.ArgsProducer{
+		BrokerAddress:  args.Config.BrokerAddress,
+		Topic:          args.Config.Topic,
+		Partition:      args.Config.Partition,
+		ClientID:       args.Config.ClientID,
+		RequiredAcks:   args.Config.RequiredAcks,
+		RequestTimeout: time.Duration(args.Config.RequestTimeoutInSec) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return kafka.NewKafkaDriver(kafka.ArgsKafkaDriver{
+		Marshaller: args.Marshaller,
+		Producer:   producer,
+	})
+}
@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/kafka"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateKafkaDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsKafkaDriverFactory{
+			Config: config.KafkaDriverConfig{
+				BrokerAddress:       "127.0.0.1:9092",
+				RequestTimeoutInSec: 1,
+			},
+			Marshaller: &marshallerMock.MarshalizerMock{},
+		}
+
+		driver, err := CreateKafkaDriver(args)
+		require.Equal(t, kafka.ErrEmptyTopic, err)
+		require.Nil(t, driver)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsKafkaDriverFactory{
+			Config: config.KafkaDriverConfig{
+				BrokerAddress:       "127.0.0.1:9092",
+				Topic:               "outport",
+				RequiredAcks:        -1,
+				RequestTimeoutInSec: 1,
+			},
+			Marshaller: &marshallerMock.MarshalizerMock{},
+		}
+
+		driver, err := CreateKafkaDriver(args)
+		require.Nil(t, err)
+		require.Equal(t, "*kafka.kafkaDriver", fmt.Sprintf("%T", driver))
+	})
+}
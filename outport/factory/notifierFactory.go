@@ -5,6 +5,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/notifier"
 )
@@ -18,6 +19,9 @@ type EventNotifierFactoryArgs struct {
 	Password          string
 	RequestTimeoutSec int
 	Marshaller        marshal.Marshalizer
+	AddressConverter  core.PubkeyConverter
+	Subscriptions     config.EventNotifierSubscriptionsConfig
+	BufferConfig      config.OutportBufferConfig
 }
 
 // CreateEventNotifier will create a new event notifier client instance
@@ -43,10 +47,16 @@ func CreateEventNotifier(args *EventNotifierFactoryArgs) (outport.Driver, error)
 		return nil, err
 	}
 
+	subscriptionFilter, err := createSubscriptionFilter(args)
+	if err != nil {
+		return nil, err
+	}
+
 	notifierArgs := notifier.ArgsEventNotifier{
-		HttpClient:     httpClient,
-		Marshaller:     args.Marshaller,
-		BlockContainer: blockContainer,
+		HttpClient:         httpClient,
+		Marshaller:         args.Marshaller,
+		BlockContainer:     blockContainer,
+		SubscriptionFilter: subscriptionFilter,
 	}
 
 	return notifier.NewEventNotifier(notifierArgs)
@@ -60,6 +70,17 @@ func checkInputArgs(args *EventNotifierFactoryArgs) error {
 	return nil
 }
 
+func createSubscriptionFilter(args *EventNotifierFactoryArgs) (notifier.SubscriptionFilter, error) {
+	if !args.Subscriptions.Enabled {
+		return notifier.NewDisabledSubscriptionFilter(), nil
+	}
+
+	return notifier.NewSubscriptionFilter(notifier.ArgsSubscriptionFilter{
+		Config:           args.Subscriptions,
+		AddressConverter: args.AddressConverter,
+	})
+}
+
 func createBlockCreatorsContainer() (notifier.BlockContainerHandler, error) {
 	container := block.NewEmptyBlockCreatorsContainer()
 	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
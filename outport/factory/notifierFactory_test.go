@@ -5,6 +5,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/notifier"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/stretchr/testify/require"
 )
@@ -18,6 +20,7 @@ func createMockNotifierFactoryArgs() *factory.EventNotifierFactoryArgs {
 		Password:          "",
 		RequestTimeoutSec: 1,
 		Marshaller:        &marshallerMock.MarshalizerMock{},
+		AddressConverter:  testscommon.NewPubkeyConverterMock(32),
 	}
 }
 
@@ -42,4 +45,15 @@ func TestCreateEventNotifier(t *testing.T) {
 		require.Nil(t, err)
 		require.NotNil(t, en)
 	})
+	t.Run("enabled subscriptions with nil address converter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.Subscriptions.Enabled = true
+		args.AddressConverter = nil
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, notifier.ErrNilPubKeyConverter, err)
+	})
 }
@@ -4,9 +4,20 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/buffered"
+)
+
+const (
+	elasticDriverName  = "elastic"
+	notifierDriverName = "notifier"
+	hostDriverName     = "host%d"
+	fileDriverName     = "file"
+	kafkaDriverName    = "kafka"
 )
 
 // OutportFactoryArgs holds the factory arguments of different outport drivers
@@ -15,8 +26,19 @@ type OutportFactoryArgs struct {
 	ShardID                   uint32
 	RetrialInterval           time.Duration
 	ElasticIndexerFactoryArgs indexerFactory.ArgsIndexerFactory
+	ElasticBufferConfig       config.OutportBufferConfig
 	EventNotifierFactoryArgs  *EventNotifierFactoryArgs
 	HostDriversArgs           []ArgsHostDriverFactory
+	FileDriverArgs            ArgsFileDriverFactory
+	KafkaDriverArgs           ArgsKafkaDriverFactory
+	AppStatusHandler          core.AppStatusHandler
+}
+
+// driverSubscriber subscribes the drivers to the outport, wrapping the ones configured as non-blocking
+type driverSubscriber struct {
+	outportHandler   outport.OutportHandler
+	retrialInterval  time.Duration
+	appStatusHandler core.AppStatusHandler
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -45,44 +67,75 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	subscriber := &driverSubscriber{
+		outportHandler:   outport,
+		retrialInterval:  args.RetrialInterval,
+		appStatusHandler: args.AppStatusHandler,
+	}
+
+	err := createAndSubscribeElasticDriverIfNeeded(subscriber, args.ElasticIndexerFactoryArgs, args.ElasticBufferConfig)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(subscriber, args.EventNotifierFactoryArgs)
 	if err != nil {
 		return err
 	}
 
 	for idx := 0; idx < len(args.HostDriversArgs); idx++ {
-		err = createAndSubscribeHostDriverIfNeeded(outport, args.HostDriversArgs[idx])
+		err = createAndSubscribeHostDriverIfNeeded(subscriber, args.HostDriversArgs[idx], idx)
 		if err != nil {
 			return fmt.Errorf("%w when calling createAndSubscribeHostDriverIfNeeded, host driver index %d", err, idx)
 		}
 	}
 
-	return nil
+	err = createAndSubscribeFileDriverIfNeeded(subscriber, args.FileDriverArgs)
+	if err != nil {
+		return err
+	}
+
+	return createAndSubscribeKafkaDriverIfNeeded(subscriber, args.KafkaDriverArgs)
+}
+
+func (ds *driverSubscriber) subscribe(driver outport.Driver, name string, bufferConfig config.OutportBufferConfig) error {
+	if !bufferConfig.Enabled {
+		return ds.outportHandler.SubscribeDriver(driver)
+	}
+
+	bufferedDriver, err := buffered.NewBufferedDriver(buffered.ArgsBufferedDriver{
+		Driver:           driver,
+		Name:             name,
+		Config:           bufferConfig,
+		RetrialInterval:  ds.retrialInterval,
+		AppStatusHandler: ds.appStatusHandler,
+	})
+	if err != nil {
+		return fmt.Errorf("%w while creating the non-blocking %s driver", err, name)
+	}
+
+	return ds.outportHandler.SubscribeDriver(bufferedDriver)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args indexerFactory.ArgsIndexerFactory,
+	bufferConfig config.OutportBufferConfig,
 ) error {
 	if !args.Enabled {
 		return nil
 	}
 
-	elasticDriver, err := indexerFactory.NewIndexer(args)
+	indexer, err := indexerFactory.NewIndexer(args)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscriber.subscribe(&elasticDriver{Indexer: indexer}, elasticDriverName, bufferConfig)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *EventNotifierFactoryArgs,
 ) error {
 	if !args.Enabled {
@@ -94,7 +147,7 @@ func createAndSubscribeEventNotifierIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscriber.subscribe(eventNotifier, notifierDriverName, args.BufferConfig)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
@@ -106,8 +159,9 @@ func checkArguments(args *OutportFactoryArgs) error {
 }
 
 func createAndSubscribeHostDriverIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args ArgsHostDriverFactory,
+	index int,
 ) error {
 	if !args.HostConfig.Enabled {
 		return nil
@@ -118,5 +172,37 @@ func createAndSubscribeHostDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(hostDriver)
+	return subscriber.subscribe(hostDriver, fmt.Sprintf(hostDriverName, index), args.HostConfig.Buffer)
+}
+
+func createAndSubscribeFileDriverIfNeeded(
+	subscriber *driverSubscriber,
+	args ArgsFileDriverFactory,
+) error {
+	if !args.Config.Enabled {
+		return nil
+	}
+
+	fileDriver, err := CreateFileDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscriber.subscribe(fileDriver, fileDriverName, args.Config.Buffer)
+}
+
+func createAndSubscribeKafkaDriverIfNeeded(
+	subscriber *driverSubscriber,
+	args ArgsKafkaDriverFactory,
+) error {
+	if !args.Config.Enabled {
+		return nil
+	}
+
+	kafkaDriver, err := CreateKafkaDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscriber.subscribe(kafkaDriver, kafkaDriverName, args.Config.Buffer)
 }
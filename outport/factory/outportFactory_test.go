@@ -9,9 +9,11 @@ import (
 	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/buffered"
 	"github.com/multiversx/mx-chain-go/outport/factory"
 	notifierFactory "github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-storage-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
@@ -188,3 +190,97 @@ func TestCreateAndSubscribeDriversShouldReturnError(t *testing.T) {
 	require.Nil(t, outPort)
 	require.ErrorIs(t, err, data.ErrInvalidWebSocketHostMode)
 }
+
+func TestCreateOutport_SubscribeFileAndKafkaDrivers(t *testing.T) {
+	args := &factory.OutportFactoryArgs{
+		RetrialInterval: time.Second,
+		EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+			Enabled: false,
+		},
+		FileDriverArgs: factory.ArgsFileDriverFactory{
+			Config: config.FileDriverConfig{
+				Enabled:            true,
+				Directory:          t.TempDir(),
+				MaxSegmentSizeInMB: 1,
+			},
+			Marshaller: &testscommon.MarshalizerMock{},
+		},
+		KafkaDriverArgs: factory.ArgsKafkaDriverFactory{
+			Config: config.KafkaDriverConfig{
+				Enabled:             true,
+				BrokerAddress:       "127.0.0.1:9092",
+				Topic:               "outport",
+				RequestTimeoutInSec: 1,
+			},
+			Marshaller: &testscommon.MarshalizerMock{},
+		},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = outPort.Close()
+	}()
+
+	require.True(t, outPort.HasDrivers())
+}
+
+func TestCreateOutport_SubscribeNonBlockingDriver(t *testing.T) {
+	t.Run("invalid buffer config should error", func(t *testing.T) {
+		args := &factory.OutportFactoryArgs{
+			RetrialInterval: time.Second,
+			EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+				Enabled: false,
+			},
+			FileDriverArgs: factory.ArgsFileDriverFactory{
+				Config: config.FileDriverConfig{
+					Enabled:            true,
+					Directory:          t.TempDir(),
+					MaxSegmentSizeInMB: 1,
+					Buffer: config.OutportBufferConfig{
+						Enabled:          true,
+						MaxItemsInMemory: 10,
+						SpillDirectory:   t.TempDir(),
+						MaxLagPolicy:     "retry",
+					},
+				},
+				Marshaller: &testscommon.MarshalizerMock{},
+			},
+			AppStatusHandler: statusHandler.NewAppStatusHandlerMock(),
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.ErrorIs(t, err, buffered.ErrInvalidMaxLagPolicy)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := &factory.OutportFactoryArgs{
+			RetrialInterval: time.Second,
+			EventNotifierFactoryArgs: &notifierFactory.EventNotifierFactoryArgs{
+				Enabled: false,
+			},
+			FileDriverArgs: factory.ArgsFileDriverFactory{
+				Config: config.FileDriverConfig{
+					Enabled:            true,
+					Directory:          t.TempDir(),
+					MaxSegmentSizeInMB: 1,
+					Buffer: config.OutportBufferConfig{
+						Enabled:          true,
+						MaxItemsInMemory: 10,
+						SpillDirectory:   t.TempDir(),
+						MaxLagInBlocks:   100,
+						MaxLagPolicy:     buffered.DropPolicy,
+					},
+				},
+				Marshaller: &testscommon.MarshalizerMock{},
+			},
+			AppStatusHandler: statusHandler.NewAppStatusHandlerMock(),
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, err)
+		require.True(t, outPort.HasDrivers())
+		require.Nil(t, outPort.Close())
+	})
+}
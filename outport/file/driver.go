@@ -0,0 +1,438 @@
+package file
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/file")
+
+// ArgsFileDriver holds the arguments needed for creating a new fileDriver
+type ArgsFileDriver struct {
+	Marshaller            marshal.Marshalizer
+	BlockContainer        BlockContainerHandler
+	Directory             string
+	MaxSegmentSizeInBytes uint64
+}
+
+type savedBlockRecord struct {
+	offset  uint64
+	payload []byte
+}
+
+// fileDriver writes every outport action as a length prefixed record in append-only segment files, in the
+// exact order the actions were received, so reverted and finalized blocks are replayed as they happened.
+// Each saved block is also added to an index file, allowing the consumers to start reading from any nonce
+type fileDriver struct {
+	mutex          sync.Mutex
+	marshaller     marshal.Marshalizer
+	blockContainer BlockContainerHandler
+	directory      string
+	maxSegmentSize uint64
+	segmentFile    *os.File
+	segmentIndex   uint32
+	segmentSize    uint64
+	indexFile      *os.File
+	isClosed       bool
+}
+
+// NewFileDriver will create a new instance of fileDriver, recovering the state left by a previous run
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNilReflect(args.BlockContainer) {
+		return nil, ErrNilBlockContainer
+	}
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.MaxSegmentSizeInBytes == 0 {
+		return nil, ErrInvalidMaxSegmentSize
+	}
+
+	err := os.MkdirAll(args.Directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &fileDriver{
+		marshaller:     args.Marshaller,
+		blockContainer: args.BlockContainer,
+		directory:      args.Directory,
+		maxSegmentSize: args.MaxSegmentSizeInBytes,
+	}
+
+	err = fd.recover()
+	if err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+// recover removes the partially written data left by an unclean shutdown and indexes the blocks
+// that were written in the last segment but did not reach the index file
+func (fd *fileDriver) recover() error {
+	segments, err := listSegments(fd.directory)
+	if err != nil {
+		return err
+	}
+
+	lastSegment := uint32(0)
+	if len(segments) > 0 {
+		lastSegment = segments[len(segments)-1]
+	}
+
+	entries, err := readIndex(fd.directory)
+	if err != nil {
+		return err
+	}
+
+	minOffset := uint64(0)
+	if len(entries) > 0 {
+		lastEntry := entries[len(entries)-1]
+		if lastEntry.segment == lastSegment {
+			minOffset = lastEntry.offset + 1
+		}
+	}
+
+	validSize, savedBlocks, err := scanSegment(segmentPath(fd.directory, lastSegment), minOffset)
+	if err != nil {
+		return err
+	}
+
+	numValidEntries := len(entries)
+	for numValidEntries > 0 && isAfter(entries[numValidEntries-1], lastSegment, validSize) {
+		numValidEntries--
+	}
+	if numValidEntries != len(entries) {
+		log.Warn("fileDriver.recover: removed dangling index entries", "num", len(entries)-numValidEntries)
+	}
+
+	err = truncateIfExists(filepath.Join(fd.directory, indexFileName), uint64(numValidEntries*indexEntrySize))
+	if err != nil {
+		return err
+	}
+
+	fd.segmentIndex = lastSegment
+	fd.segmentSize = validSize
+	fd.segmentFile, err = openForAppend(segmentPath(fd.directory, lastSegment), false)
+	if err != nil {
+		return err
+	}
+
+	fd.indexFile, err = openForAppend(filepath.Join(fd.directory, indexFileName), false)
+	if err != nil {
+		_ = fd.segmentFile.Close()
+		return err
+	}
+
+	for _, savedBlock := range savedBlocks {
+		err = fd.indexSavedBlock(lastSegment, savedBlock)
+		if err != nil {
+			_ = fd.closeFiles()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fd *fileDriver) indexSavedBlock(segment uint32, savedBlock savedBlockRecord) error {
+	outportBlock := &outport.OutportBlock{}
+	err := fd.marshaller.Unmarshal(outportBlock, savedBlock.payload)
+	if err != nil {
+		return err
+	}
+
+	nonce, err := fd.getNonce(outportBlock)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("fileDriver.recover: indexing block", "nonce", nonce, "segment", segment, "offset", savedBlock.offset)
+
+	return fd.writeIndexEntry(indexEntry{
+		nonce:   nonce,
+		segment: segment,
+		offset:  savedBlock.offset,
+	})
+}
+
+// scanSegment returns the size of the valid records prefix of the segment and the saved blocks found at offsets
+// higher or equal to the provided one. The segment is truncated to its valid size
+func scanSegment(path string, minOffset uint64) (uint64, []savedBlockRecord, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	savedBlocks := make([]savedBlockRecord, 0)
+	reader := bufio.NewReader(file)
+	offset := uint64(0)
+	for {
+		record, size, errRead := readRecord(reader)
+		if errors.Is(errRead, io.EOF) {
+			break
+		}
+		if errors.Is(errRead, io.ErrUnexpectedEOF) || errors.Is(errRead, ErrCorruptedRecord) {
+			log.Warn("fileDriver.recover: truncating segment", "path", path, "offset", offset, "reason", errRead)
+			break
+		}
+		if errRead != nil {
+			_ = file.Close()
+			return 0, nil, errRead
+		}
+
+		if record.Topic == outport.TopicSaveBlock && offset >= minOffset {
+			savedBlocks = append(savedBlocks, savedBlockRecord{offset: offset, payload: record.Payload})
+		}
+		offset += size
+	}
+
+	err = file.Close()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return offset, savedBlocks, truncateIfExists(path, offset)
+}
+
+func isAfter(entry indexEntry, segment uint32, size uint64) bool {
+	return entry.segment > segment || (entry.segment == segment && entry.offset >= size)
+}
+
+func truncateIfExists(path string, size uint64) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if uint64(info.Size()) == size {
+		return nil
+	}
+
+	return os.Truncate(path, int64(size))
+}
+
+func openForAppend(path string, truncate bool) (*os.File, error) {
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if truncate {
+		flags |= os.O_TRUNC
+	}
+
+	return os.OpenFile(path, flags, core.FileModeReadWrite)
+}
+
+// SaveBlock will write the block and index it by its nonce
+func (fd *fileDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	nonce, err := fd.getNonce(outportBlock)
+	if err != nil {
+		return err
+	}
+
+	return fd.handleAction(outportBlock, outport.TopicSaveBlock, core.OptionalUint64{Value: nonce, HasValue: true})
+}
+
+// RevertIndexedBlock will write the reverted block
+func (fd *fileDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	return fd.handleAction(blockData, outport.TopicRevertIndexedBlock, core.OptionalUint64{})
+}
+
+// SaveRoundsInfo will write the rounds info
+func (fd *fileDriver) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	return fd.handleAction(roundsInfos, outport.TopicSaveRoundsInfo, core.OptionalUint64{})
+}
+
+// SaveValidatorsPubKeys will write the validators' public keys
+func (fd *fileDriver) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	return fd.handleAction(validatorsPubKeys, outport.TopicSaveValidatorsPubKeys, core.OptionalUint64{})
+}
+
+// SaveValidatorsRating will write the validators' rating
+func (fd *fileDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRating) error {
+	return fd.handleAction(validatorsRating, outport.TopicSaveValidatorsRating, core.OptionalUint64{})
+}
+
+// SaveValidatorsStatusChanges will write the validators' status changes
+func (fd *fileDriver) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error {
+	return fd.handleAction(statusChanges, common.TopicSaveValidatorsStatusChanges, core.OptionalUint64{})
+}
+
+// SaveAccounts will write the accounts
+func (fd *fileDriver) SaveAccounts(accounts *outport.Accounts) error {
+	return fd.handleAction(accounts, outport.TopicSaveAccounts, core.OptionalUint64{})
+}
+
+// FinalizedBlock will write the finalized block
+func (fd *fileDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	return fd.handleAction(finalizedBlock, outport.TopicFinalizedBlock, core.OptionalUint64{})
+}
+
+// GetMarshaller returns the internal marshaller
+func (fd *fileDriver) GetMarshaller() marshal.Marshalizer {
+	return fd.marshaller
+}
+
+// SetCurrentSettings will write the current settings
+func (fd *fileDriver) SetCurrentSettings(config outport.OutportConfig) error {
+	return fd.handleAction(&config, outport.TopicSettings, core.OptionalUint64{})
+}
+
+// RegisterHandler calls the settings handler right away, as there is no consumer connection that could request them later
+func (fd *fileDriver) RegisterHandler(handlerFunction func() error, topic string) error {
+	if topic != outport.TopicSettings || handlerFunction == nil {
+		return nil
+	}
+
+	return handlerFunction()
+}
+
+func (fd *fileDriver) getNonce(outportBlock *outport.OutportBlock) (uint64, error) {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return 0, errNilBlockData
+	}
+
+	creator, err := fd.blockContainer.Get(core.HeaderType(outportBlock.BlockData.HeaderType))
+	if err != nil {
+		return 0, err
+	}
+
+	header, err := block.GetHeaderFromBytes(fd.marshaller, creator, outportBlock.BlockData.HeaderBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return header.GetNonce(), nil
+}
+
+func (fd *fileDriver) handleAction(args interface{}, topic string, nonce core.OptionalUint64) error {
+	payload, err := fd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling data for topic %s", err, topic)
+	}
+
+	record, err := encodeRecord(topic, payload)
+	if err != nil {
+		return err
+	}
+
+	fd.mutex.Lock()
+	defer fd.mutex.Unlock()
+
+	if fd.isClosed {
+		return ErrDriverIsClosed
+	}
+
+	err = fd.rotateIfNeeded()
+	if err != nil {
+		return err
+	}
+
+	offset := fd.segmentSize
+	err = writeAndSync(fd.segmentFile, record)
+	if err != nil {
+		// drop the partially written bytes, if any, so the next record starts at the expected offset
+		_ = fd.segmentFile.Truncate(int64(offset))
+		return fmt.Errorf("%w while writing record for topic %s", err, topic)
+	}
+	fd.segmentSize += uint64(len(record))
+
+	if !nonce.HasValue {
+		return nil
+	}
+
+	return fd.writeIndexEntry(indexEntry{
+		nonce:   nonce.Value,
+		segment: fd.segmentIndex,
+		offset:  offset,
+	})
+}
+
+func (fd *fileDriver) rotateIfNeeded() error {
+	if fd.segmentSize < fd.maxSegmentSize {
+		return nil
+	}
+
+	err := fd.segmentFile.Close()
+	if err != nil {
+		return err
+	}
+
+	nextSegment := fd.segmentIndex + 1
+	fd.segmentFile, err = openForAppend(segmentPath(fd.directory, nextSegment), true)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("fileDriver: rotated segment", "segment", nextSegment)
+	fd.segmentIndex = nextSegment
+	fd.segmentSize = 0
+
+	return nil
+}
+
+func (fd *fileDriver) writeIndexEntry(entry indexEntry) error {
+	err := writeAndSync(fd.indexFile, encodeIndexEntry(entry))
+	if err != nil {
+		return fmt.Errorf("%w while writing index entry for nonce %d", err, entry.nonce)
+	}
+
+	return nil
+}
+
+func writeAndSync(file *os.File, buff []byte) error {
+	_, err := file.Write(buff)
+	if err != nil {
+		return err
+	}
+
+	return file.Sync()
+}
+
+// Close will close the segment and the index files
+func (fd *fileDriver) Close() error {
+	fd.mutex.Lock()
+	defer fd.mutex.Unlock()
+
+	if fd.isClosed {
+		return nil
+	}
+	fd.isClosed = true
+
+	return fd.closeFiles()
+}
+
+func (fd *fileDriver) closeFiles() error {
+	errSegment := fd.segmentFile.Close()
+	errIndex := fd.indexFile.Close()
+	if errSegment != nil {
+		return errSegment
+	}
+
+	return errIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
@@ -0,0 +1,285 @@
+package file_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/outport/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var marshaller = &marshal.GogoProtoMarshalizer{}
+
+func createBlockContainer() file.BlockContainerHandler {
+	container := block.NewEmptyBlockCreatorsContainer()
+	_ = container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+
+	return container
+}
+
+func createArgsFileDriver(directory string) file.ArgsFileDriver {
+	return file.ArgsFileDriver{
+		Marshaller:            marshaller,
+		BlockContainer:        createBlockContainer(),
+		Directory:             directory,
+		MaxSegmentSizeInBytes: 1024 * 1024,
+	}
+}
+
+func createOutportBlock(t *testing.T, nonce uint64) *outport.OutportBlock {
+	headerBytes, headerType, err := outport.GetHeaderBytesAndType(marshaller, &block.Header{Nonce: nonce})
+	require.Nil(t, err)
+
+	return &outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(headerType),
+			HeaderHash:  []byte{byte(nonce)},
+		},
+	}
+}
+
+type readRecord struct {
+	topic string
+	hash  []byte
+}
+
+func readAll(t *testing.T, directory string, nonce uint64) []readRecord {
+	reader, err := file.NewReader(directory)
+	require.Nil(t, err)
+
+	records := make([]readRecord, 0)
+	err = reader.ReadFrom(nonce, func(record *file.Record) error {
+		switch record.Topic {
+		case outport.TopicSaveBlock:
+			outportBlock := &outport.OutportBlock{}
+			require.Nil(t, marshaller.Unmarshal(outportBlock, record.Payload))
+			records = append(records, readRecord{topic: record.Topic, hash: outportBlock.BlockData.HeaderHash})
+		case outport.TopicRevertIndexedBlock:
+			blockData := &outport.BlockData{}
+			require.Nil(t, marshaller.Unmarshal(blockData, record.Payload))
+			records = append(records, readRecord{topic: record.Topic, hash: blockData.HeaderHash})
+		case outport.TopicFinalizedBlock:
+			finalizedBlock := &outport.FinalizedBlock{}
+			require.Nil(t, marshaller.Unmarshal(finalizedBlock, record.Payload))
+			records = append(records, readRecord{topic: record.Topic, hash: finalizedBlock.HeaderHash})
+		default:
+			records = append(records, readRecord{topic: record.Topic})
+		}
+		return nil
+	})
+	require.Nil(t, err)
+
+	return records
+}
+
+func writeBlocksWithRevert(t *testing.T, driver outportDriver) {
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, 1)))
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, 2)))
+	require.Nil(t, driver.RevertIndexedBlock(createOutportBlock(t, 2).BlockData))
+	block2 := createOutportBlock(t, 2)
+	block2.BlockData.HeaderHash = []byte("fork")
+	require.Nil(t, driver.SaveBlock(block2))
+	require.Nil(t, driver.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte{1}}))
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, 3)))
+}
+
+type outportDriver interface {
+	SaveBlock(outportBlock *outport.OutportBlock) error
+	RevertIndexedBlock(blockData *outport.BlockData) error
+	FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFileDriver(t.TempDir())
+		args.Marshaller = nil
+		driver, err := file.NewFileDriver(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("nil block container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFileDriver(t.TempDir())
+		args.BlockContainer = nil
+		driver, err := file.NewFileDriver(args)
+		assert.Equal(t, file.ErrNilBlockContainer, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFileDriver("")
+		driver, err := file.NewFileDriver(args)
+		assert.Equal(t, file.ErrEmptyDirectory, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("invalid max segment size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFileDriver(t.TempDir())
+		args.MaxSegmentSizeInBytes = 0
+		driver, err := file.NewFileDriver(args)
+		assert.Equal(t, file.ErrInvalidMaxSegmentSize, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := file.NewFileDriver(createArgsFileDriver(filepath.Join(t.TempDir(), "outport")))
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(driver))
+		assert.Equal(t, marshaller, driver.GetMarshaller())
+		assert.Nil(t, driver.Close())
+	})
+}
+
+func TestFileDriver_ShouldKeepTheActionsOrder(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	driver, err := file.NewFileDriver(createArgsFileDriver(directory))
+	require.Nil(t, err)
+
+	writeBlocksWithRevert(t, driver)
+	require.Nil(t, driver.Close())
+
+	expected := []readRecord{
+		{topic: outport.TopicSaveBlock, hash: []byte{1}},
+		{topic: outport.TopicSaveBlock, hash: []byte{2}},
+		{topic: outport.TopicRevertIndexedBlock, hash: []byte{2}},
+		{topic: outport.TopicSaveBlock, hash: []byte("fork")},
+		{topic: outport.TopicFinalizedBlock, hash: []byte{1}},
+		{topic: outport.TopicSaveBlock, hash: []byte{3}},
+	}
+	assert.Equal(t, expected, readAll(t, directory, 0))
+	assert.Equal(t, expected[1:], readAll(t, directory, 2))
+	assert.Equal(t, expected[5:], readAll(t, directory, 3))
+	assert.Empty(t, readAll(t, directory, 4))
+}
+
+func TestFileDriver_ShouldRotateSegments(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	args := createArgsFileDriver(directory)
+	args.MaxSegmentSizeInBytes = 1
+	driver, err := file.NewFileDriver(args)
+	require.Nil(t, err)
+
+	writeBlocksWithRevert(t, driver)
+	require.Nil(t, driver.Close())
+
+	segments, _ := filepath.Glob(filepath.Join(directory, "outport-*.dat"))
+	assert.Equal(t, 6, len(segments))
+
+	records := readAll(t, directory, 2)
+	require.Equal(t, 5, len(records))
+	assert.Equal(t, []byte{2}, records[0].hash)
+	assert.Equal(t, []byte{3}, records[4].hash)
+}
+
+func TestFileDriver_ShouldRecoverAfterUncleanShutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("partially written record should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		driver, err := file.NewFileDriver(createArgsFileDriver(directory))
+		require.Nil(t, err)
+		require.Nil(t, driver.SaveBlock(createOutportBlock(t, 1)))
+		require.Nil(t, driver.Close())
+
+		segmentFile, err := os.OpenFile(filepath.Join(directory, "outport-0000000000.dat"), os.O_APPEND|os.O_WRONLY, 0)
+		require.Nil(t, err)
+		_, _ = segmentFile.Write([]byte{0, 0, 1, 0, 1})
+		require.Nil(t, segmentFile.Close())
+
+		driver, err = file.NewFileDriver(createArgsFileDriver(directory))
+		require.Nil(t, err)
+		require.Nil(t, driver.SaveBlock(createOutportBlock(t, 2)))
+		require.Nil(t, driver.Close())
+
+		assert.Equal(t, []readRecord{
+			{topic: outport.TopicSaveBlock, hash: []byte{1}},
+			{topic: outport.TopicSaveBlock, hash: []byte{2}},
+		}, readAll(t, directory, 0))
+	})
+	t.Run("missing index entries should be restored", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		driver, err := file.NewFileDriver(createArgsFileDriver(directory))
+		require.Nil(t, err)
+		require.Nil(t, driver.SaveBlock(createOutportBlock(t, 1)))
+		require.Nil(t, driver.SaveBlock(createOutportBlock(t, 2)))
+		require.Nil(t, driver.Close())
+
+		indexPath := filepath.Join(directory, "index.dat")
+		require.Nil(t, os.Truncate(indexPath, 25))
+
+		driver, err = file.NewFileDriver(createArgsFileDriver(directory))
+		require.Nil(t, err)
+		require.Nil(t, driver.Close())
+
+		info, err := os.Stat(indexPath)
+		require.Nil(t, err)
+		assert.Equal(t, int64(40), info.Size())
+		assert.Equal(t, []readRecord{{topic: outport.TopicSaveBlock, hash: []byte{2}}}, readAll(t, directory, 2))
+	})
+}
+
+func TestFileDriver_RegisterHandlerShouldWriteSettings(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	driver, err := file.NewFileDriver(createArgsFileDriver(directory))
+	require.Nil(t, err)
+
+	err = driver.RegisterHandler(func() error {
+		return driver.SetCurrentSettings(outport.OutportConfig{ShardID: 1})
+	}, outport.TopicSettings)
+	require.Nil(t, err)
+	require.Nil(t, driver.SaveBlock(createOutportBlock(t, 1)))
+	require.Nil(t, driver.Close())
+
+	records := make([]string, 0)
+	reader, _ := file.NewReader(directory)
+	err = reader.ReadFrom(0, func(record *file.Record) error {
+		records = append(records, record.Topic)
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{outport.TopicSaveBlock}, records)
+
+	segment, err := os.ReadFile(filepath.Join(directory, "outport-0000000000.dat"))
+	require.Nil(t, err)
+	assert.Equal(t, byte(8), segment[4])
+}
+
+func TestFileDriver_ClosedDriverShouldError(t *testing.T) {
+	t.Parallel()
+
+	driver, err := file.NewFileDriver(createArgsFileDriver(t.TempDir()))
+	require.Nil(t, err)
+	require.Nil(t, driver.Close())
+	require.Nil(t, driver.Close())
+
+	err = driver.SaveBlock(createOutportBlock(t, 1))
+	assert.Equal(t, file.ErrDriverIsClosed, err)
+	err = driver.FinalizedBlock(&outport.FinalizedBlock{})
+	assert.Equal(t, file.ErrDriverIsClosed, err)
+}
@@ -0,0 +1,23 @@
+package file
+
+import "errors"
+
+// ErrDriverIsClosed signals that the driver was closed while trying to perform actions
+var ErrDriverIsClosed = errors.New("file driver is closed")
+
+// ErrNilBlockContainer signals that a nil block container has been provided
+var ErrNilBlockContainer = errors.New("nil block container")
+
+// ErrEmptyDirectory signals that an empty directory has been provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidMaxSegmentSize signals that an invalid maximum segment size has been provided
+var ErrInvalidMaxSegmentSize = errors.New("invalid maximum segment size")
+
+// ErrCorruptedRecord signals that a stored record failed the integrity checks
+var ErrCorruptedRecord = errors.New("corrupted record")
+
+// ErrUnknownTopic signals that a record was provided for an unknown topic
+var ErrUnknownTopic = errors.New("unknown topic")
+
+var errNilBlockData = errors.New("nil block data")
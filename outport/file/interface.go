@@ -0,0 +1,11 @@
+package file
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+)
+
+// BlockContainerHandler defines what a block container should be able to do
+type BlockContainerHandler interface {
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+}
@@ -0,0 +1,107 @@
+package file
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+)
+
+type reader struct {
+	directory string
+}
+
+// NewReader creates a reader over the files written by the file driver in the provided directory
+func NewReader(directory string) (*reader, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+
+	return &reader{
+		directory: directory,
+	}, nil
+}
+
+// ReadFrom calls the handler for every record written starting with the first saved block having a nonce higher
+// or equal to the provided one. The records are provided in the order they were written, including the reverted
+// and finalized blocks notifications. A partially written last record is ignored
+func (r *reader) ReadFrom(nonce uint64, handler func(record *Record) error) error {
+	entries, err := readIndex(r.directory)
+	if err != nil {
+		return err
+	}
+
+	start, found := findFirstEntry(entries, nonce)
+	if !found {
+		return nil
+	}
+
+	segments, err := listSegments(r.directory)
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range segments {
+		if segment < start.segment {
+			continue
+		}
+
+		offset := uint64(0)
+		if segment == start.segment {
+			offset = start.offset
+		}
+
+		err = readSegment(segmentPath(r.directory, segment), offset, handler)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findFirstEntry(entries []indexEntry, nonce uint64) (indexEntry, bool) {
+	for _, entry := range entries {
+		if entry.nonce >= nonce {
+			return entry, true
+		}
+	}
+
+	return indexEntry{}, false
+}
+
+func readSegment(path string, offset uint64, handler func(record *Record) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = file.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	bufferedReader := bufio.NewReader(file)
+	for {
+		record, _, errRead := readRecord(bufferedReader)
+		if errors.Is(errRead, io.EOF) || errors.Is(errRead, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if errRead != nil {
+			return errRead
+		}
+
+		err = handler(record)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *reader) IsInterfaceNil() bool {
+	return r == nil
+}
@@ -0,0 +1,169 @@
+package file
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	// recordHeaderSize is the size of the header prepended to each payload: length (4 bytes) | type (1 byte) | crc32 (4 bytes)
+	recordHeaderSize = 9
+	// indexEntrySize is the size of an index entry: nonce (8 bytes) | segment (4 bytes) | offset (8 bytes)
+	indexEntrySize = 20
+
+	indexFileName       = "index.dat"
+	segmentFileTemplate = "outport-%010d.dat"
+	segmentFileGlob     = "outport-*.dat"
+)
+
+var topicToRecordType = map[string]byte{
+	outport.TopicSaveBlock:                  1,
+	outport.TopicRevertIndexedBlock:         2,
+	outport.TopicFinalizedBlock:             3,
+	outport.TopicSaveRoundsInfo:             4,
+	outport.TopicSaveValidatorsPubKeys:      5,
+	outport.TopicSaveValidatorsRating:       6,
+	outport.TopicSaveAccounts:               7,
+	outport.TopicSettings:                   8,
+	common.TopicSaveValidatorsStatusChanges: 9,
+}
+
+var recordTypeToTopic = reverseTopics(topicToRecordType)
+
+// Record holds an outport action as it was written by the file driver
+type Record struct {
+	Topic   string
+	Payload []byte
+}
+
+type indexEntry struct {
+	nonce   uint64
+	segment uint32
+	offset  uint64
+}
+
+func reverseTopics(topics map[string]byte) map[byte]string {
+	result := make(map[byte]string, len(topics))
+	for topic, recordType := range topics {
+		result[recordType] = topic
+	}
+
+	return result
+}
+
+func encodeRecord(topic string, payload []byte) ([]byte, error) {
+	recordType, ok := topicToRecordType[topic]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+	}
+
+	buff := make([]byte, recordHeaderSize, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(buff[0:4], uint32(len(payload)))
+	buff[4] = recordType
+	binary.BigEndian.PutUint32(buff[5:9], crc32.ChecksumIEEE(payload))
+
+	return append(buff, payload...), nil
+}
+
+// readRecord reads the next record, returning io.EOF when the stream ends on a record boundary
+// and io.ErrUnexpectedEOF when the last record was only partially written
+func readRecord(reader io.Reader) (*Record, uint64, error) {
+	header := make([]byte, recordHeaderSize)
+	_, err := io.ReadFull(reader, header)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+	_, err = io.ReadFull(reader, payload)
+	if errors.Is(err, io.EOF) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	topic, ok := recordTypeToTopic[header[4]]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: unknown record type %d", ErrCorruptedRecord, header[4])
+	}
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[5:9]) {
+		return nil, 0, fmt.Errorf("%w: checksum mismatch", ErrCorruptedRecord)
+	}
+
+	return &Record{
+		Topic:   topic,
+		Payload: payload,
+	}, uint64(recordHeaderSize + len(payload)), nil
+}
+
+func encodeIndexEntry(entry indexEntry) []byte {
+	buff := make([]byte, indexEntrySize)
+	binary.BigEndian.PutUint64(buff[0:8], entry.nonce)
+	binary.BigEndian.PutUint32(buff[8:12], entry.segment)
+	binary.BigEndian.PutUint64(buff[12:20], entry.offset)
+
+	return buff
+}
+
+func decodeIndexEntry(buff []byte) indexEntry {
+	return indexEntry{
+		nonce:   binary.BigEndian.Uint64(buff[0:8]),
+		segment: binary.BigEndian.Uint32(buff[8:12]),
+		offset:  binary.BigEndian.Uint64(buff[12:20]),
+	}
+}
+
+func segmentPath(directory string, segment uint32) string {
+	return filepath.Join(directory, fmt.Sprintf(segmentFileTemplate, segment))
+}
+
+// listSegments returns the indexes of the segment files found in the provided directory, in ascending order
+func listSegments(directory string) ([]uint32, error) {
+	paths, err := filepath.Glob(filepath.Join(directory, segmentFileGlob))
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]uint32, 0, len(paths))
+	for _, path := range paths {
+		var segment uint32
+		_, errScan := fmt.Sscanf(filepath.Base(path), segmentFileTemplate, &segment)
+		if errScan != nil {
+			continue
+		}
+
+		segments = append(segments, segment)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i] < segments[j]
+	})
+
+	return segments, nil
+}
+
+func readIndex(directory string) ([]indexEntry, error) {
+	buff, err := os.ReadFile(filepath.Join(directory, indexFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]indexEntry, 0, len(buff)/indexEntrySize)
+	for i := 0; i+indexEntrySize <= len(buff); i += indexEntrySize {
+		entries = append(entries, decodeIndexEntry(buff[i:i+indexEntrySize]))
+	}
+
+	return entries, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 // ArgsHostDriver holds the arguments needed for creating a new hostDriver
@@ -81,6 +82,11 @@ func (o *hostDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRa
 	return o.handleAction(validatorsRating, outport.TopicSaveValidatorsRating)
 }
 
+// SaveValidatorsStatusChanges will handle the saving of the validators' status changes
+func (o *hostDriver) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error {
+	return o.handleAction(statusChanges, common.TopicSaveValidatorsStatusChanges)
+}
+
 // SaveAccounts will handle the accounts' saving
 func (o *hostDriver) SaveAccounts(accounts *outport.Accounts) error {
 	return o.handleAction(accounts, outport.TopicSaveAccounts)
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	outportStubs "github.com/multiversx/mx-chain-go/testscommon/outport"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/require"
@@ -289,6 +290,49 @@ func TestWebsocketOutportDriverNodePart_SaveValidatorsRating(t *testing.T) {
 	})
 }
 
+func TestWebsocketOutportDriverNodePart_SaveValidatorsStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SaveValidatorsStatusChanges - should error", func(t *testing.T) {
+		args := getMockArgs()
+		args.SenderHost = &outportStubs.SenderHostStub{
+			SendCalled: func(_ []byte, _ string) error {
+				return cannotSendOnRouteErr
+			},
+		}
+		o, err := NewHostDriver(args)
+		require.NoError(t, err)
+
+		err = o.SaveValidatorsStatusChanges(&common.ValidatorsStatusChanges{})
+		require.True(t, errors.Is(err, cannotSendOnRouteErr))
+	})
+
+	t.Run("SaveValidatorsStatusChanges - should send the status changes with the driver marshaller", func(t *testing.T) {
+		statusChanges := &common.ValidatorsStatusChanges{
+			Epoch: 3,
+			Changes: []*common.ValidatorStatusChange{
+				{BlsKey: "aa", OldList: string(common.EligibleList), NewList: string(common.JailedList), Reason: common.ValidatorJailed, Epoch: 3},
+			},
+		}
+		expectedPayload, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(statusChanges)
+
+		args := getMockArgs()
+		args.Marshaller = &marshal.GogoProtoMarshalizer{}
+		args.SenderHost = &outportStubs.SenderHostStub{
+			SendCalled: func(payload []byte, topic string) error {
+				require.Equal(t, expectedPayload, payload)
+				require.Equal(t, common.TopicSaveValidatorsStatusChanges, topic)
+				return nil
+			},
+		}
+		o, err := NewHostDriver(args)
+		require.NoError(t, err)
+
+		err = o.SaveValidatorsStatusChanges(statusChanges)
+		require.NoError(t, err)
+	})
+}
+
 func TestWebsocketOutportDriverNodePart_SaveBlock_PayloadCheck(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package outport
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/process"
 )
 
@@ -14,6 +15,7 @@ type Driver interface {
 	SaveRoundsInfo(roundsInfos *outportcore.RoundsInfo) error
 	SaveValidatorsPubKeys(validatorsPubKeys *outportcore.ValidatorsPubKeys) error
 	SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating) error
+	SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error
 	SaveAccounts(accounts *outportcore.Accounts) error
 	FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error
 	GetMarshaller() marshal.Marshalizer
@@ -31,6 +33,7 @@ type OutportHandler interface {
 	SaveRoundsInfo(roundsInfos *outportcore.RoundsInfo)
 	SaveValidatorsPubKeys(validatorsPubKeys *outportcore.ValidatorsPubKeys)
 	SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating)
+	SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges)
 	SaveAccounts(accounts *outportcore.Accounts)
 	FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock)
 	SubscribeDriver(driver Driver) error
@@ -0,0 +1,176 @@
+package kafka
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsKafkaDriver holds the arguments needed for creating a new kafkaDriver
+type ArgsKafkaDriver struct {
+	Marshaller marshal.Marshalizer
+	Producer   Producer
+}
+
+// kafkaDriver publishes every outport action as a record keyed by the action topic. The records are produced
+// synchronously on a single partition, so the consumers receive the reverted and finalized blocks
+// notifications in the same order as the saved blocks
+type kafkaDriver struct {
+	marshaller        marshal.Marshalizer
+	producer          Producer
+	isClosed          atomic.Flag
+	mutSettings       sync.Mutex
+	settingsHandler   func() error
+	settingsPublished bool
+}
+
+// NewKafkaDriver will create a new instance of kafkaDriver
+func NewKafkaDriver(args ArgsKafkaDriver) (*kafkaDriver, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Producer) {
+		return nil, ErrNilProducer
+	}
+
+	return &kafkaDriver{
+		marshaller: args.Marshaller,
+		producer:   args.Producer,
+	}, nil
+}
+
+// SaveBlock will publish the block
+func (kd *kafkaDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	return kd.handleAction(outportBlock, outport.TopicSaveBlock)
+}
+
+// RevertIndexedBlock will publish the reverted block
+func (kd *kafkaDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	return kd.handleAction(blockData, outport.TopicRevertIndexedBlock)
+}
+
+// SaveRoundsInfo will publish the rounds info
+func (kd *kafkaDriver) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	return kd.handleAction(roundsInfos, outport.TopicSaveRoundsInfo)
+}
+
+// SaveValidatorsPubKeys will publish the validators' public keys
+func (kd *kafkaDriver) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	return kd.handleAction(validatorsPubKeys, outport.TopicSaveValidatorsPubKeys)
+}
+
+// SaveValidatorsRating will publish the validators' rating
+func (kd *kafkaDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRating) error {
+	return kd.handleAction(validatorsRating, outport.TopicSaveValidatorsRating)
+}
+
+// SaveValidatorsStatusChanges will publish the validators' status changes
+func (kd *kafkaDriver) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error {
+	return kd.handleAction(statusChanges, common.TopicSaveValidatorsStatusChanges)
+}
+
+// SaveAccounts will publish the accounts
+func (kd *kafkaDriver) SaveAccounts(accounts *outport.Accounts) error {
+	return kd.handleAction(accounts, outport.TopicSaveAccounts)
+}
+
+// FinalizedBlock will publish the finalized block
+func (kd *kafkaDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	return kd.handleAction(finalizedBlock, outport.TopicFinalizedBlock)
+}
+
+// GetMarshaller returns the internal marshaller
+func (kd *kafkaDriver) GetMarshaller() marshal.Marshalizer {
+	return kd.marshaller
+}
+
+// SetCurrentSettings will publish the current settings
+func (kd *kafkaDriver) SetCurrentSettings(config outport.OutportConfig) error {
+	err := kd.produce(&config, outport.TopicSettings)
+	if err != nil {
+		return err
+	}
+
+	kd.mutSettings.Lock()
+	kd.settingsPublished = true
+	kd.mutSettings.Unlock()
+
+	return nil
+}
+
+// RegisterHandler keeps the settings handler and calls it right away, as the consumers can not request the settings
+// from the producer. If the broker is not reachable yet, the settings are published before the next record
+func (kd *kafkaDriver) RegisterHandler(handlerFunction func() error, topic string) error {
+	if topic != outport.TopicSettings || handlerFunction == nil {
+		return nil
+	}
+
+	kd.mutSettings.Lock()
+	kd.settingsHandler = handlerFunction
+	kd.settingsPublished = false
+	kd.mutSettings.Unlock()
+
+	err := handlerFunction()
+	if err != nil {
+		log.Warn("kafkaDriver: could not publish the settings, will retry before the next record", "error", err)
+	}
+
+	return nil
+}
+
+func (kd *kafkaDriver) publishSettingsIfNeeded() error {
+	kd.mutSettings.Lock()
+	handler := kd.settingsHandler
+	shouldPublish := handler != nil && !kd.settingsPublished
+	kd.mutSettings.Unlock()
+
+	if !shouldPublish {
+		return nil
+	}
+
+	return handler()
+}
+
+func (kd *kafkaDriver) handleAction(args interface{}, topic string) error {
+	err := kd.publishSettingsIfNeeded()
+	if err != nil {
+		return err
+	}
+
+	return kd.produce(args, topic)
+}
+
+func (kd *kafkaDriver) produce(args interface{}, topic string) error {
+	if kd.isClosed.IsSet() {
+		return ErrDriverIsClosed
+	}
+
+	payload, err := kd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling data for topic %s", err, topic)
+	}
+
+	err = kd.producer.Produce([]byte(topic), payload)
+	if err != nil {
+		return fmt.Errorf("%w while producing record for topic %s", err, topic)
+	}
+
+	return nil
+}
+
+// Close will close the producer
+func (kd *kafkaDriver) Close() error {
+	kd.isClosed.SetValue(true)
+	return kd.producer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kd *kafkaDriver) IsInterfaceNil() bool {
+	return kd == nil
+}
@@ -0,0 +1,184 @@
+package kafka_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/outport/kafka"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsKafkaDriver() kafka.ArgsKafkaDriver {
+	return kafka.ArgsKafkaDriver{
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Producer:   &mock.ProducerStub{},
+	}
+}
+
+func TestNewKafkaDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKafkaDriver()
+		args.Marshaller = nil
+		driver, err := kafka.NewKafkaDriver(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("nil producer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKafkaDriver()
+		args.Producer = nil
+		driver, err := kafka.NewKafkaDriver(args)
+		assert.Equal(t, kafka.ErrNilProducer, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsKafkaDriver()
+		driver, err := kafka.NewKafkaDriver(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(driver))
+		assert.Equal(t, args.Marshaller, driver.GetMarshaller())
+	})
+}
+
+func TestKafkaDriver_ShouldProduceTheActionsInOrder(t *testing.T) {
+	t.Parallel()
+
+	broker := newLocalBroker(t)
+	producer, err := kafka.NewProducer(createArgsProducer(broker.address()))
+	require.Nil(t, err)
+
+	args := createArgsKafkaDriver()
+	args.Producer = producer
+	driver, err := kafka.NewKafkaDriver(args)
+	require.Nil(t, err)
+
+	require.Nil(t, driver.SaveBlock(&outport.OutportBlock{BlockData: &outport.BlockData{HeaderHash: []byte("h1")}}))
+	require.Nil(t, driver.RevertIndexedBlock(&outport.BlockData{HeaderHash: []byte("h1")}))
+	require.Nil(t, driver.SaveBlock(&outport.OutportBlock{BlockData: &outport.BlockData{HeaderHash: []byte("h2")}}))
+	require.Nil(t, driver.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("h2")}))
+	require.Nil(t, driver.Close())
+
+	records := broker.getRecords()
+	require.Equal(t, 4, len(records))
+
+	expectedKeys := []string{outport.TopicSaveBlock, outport.TopicRevertIndexedBlock, outport.TopicSaveBlock, outport.TopicFinalizedBlock}
+	for i, record := range records {
+		assert.Equal(t, expectedKeys[i], string(record.key))
+	}
+
+	savedBlock := &outport.OutportBlock{}
+	require.Nil(t, args.Marshaller.Unmarshal(savedBlock, records[2].value))
+	assert.Equal(t, []byte("h2"), savedBlock.BlockData.HeaderHash)
+
+	finalizedBlock := &outport.FinalizedBlock{}
+	require.Nil(t, args.Marshaller.Unmarshal(finalizedBlock, records[3].value))
+	assert.Equal(t, []byte("h2"), finalizedBlock.HeaderHash)
+}
+
+func TestKafkaDriver_ProducerErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createArgsKafkaDriver()
+	args.Producer = &mock.ProducerStub{
+		ProduceCalled: func(key []byte, value []byte) error {
+			return expectedErr
+		},
+	}
+	driver, _ := kafka.NewKafkaDriver(args)
+
+	err := driver.SaveBlock(&outport.OutportBlock{})
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestKafkaDriver_RegisterHandlerShouldPublishSettings(t *testing.T) {
+	t.Parallel()
+
+	producedKeys := make([]string, 0)
+	args := createArgsKafkaDriver()
+	args.Producer = &mock.ProducerStub{
+		ProduceCalled: func(key []byte, value []byte) error {
+			producedKeys = append(producedKeys, string(key))
+			return nil
+		},
+	}
+	driver, _ := kafka.NewKafkaDriver(args)
+
+	err := driver.RegisterHandler(func() error {
+		return driver.SetCurrentSettings(outport.OutportConfig{})
+	}, outport.TopicSettings)
+	require.Nil(t, err)
+
+	err = driver.RegisterHandler(func() error {
+		return errors.New("should not be called")
+	}, outport.TopicSaveBlock)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{outport.TopicSettings}, producedKeys)
+}
+
+func TestKafkaDriver_ClosedDriverShouldError(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createArgsKafkaDriver()
+	args.Producer = &mock.ProducerStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	driver, _ := kafka.NewKafkaDriver(args)
+
+	require.Nil(t, driver.Close())
+	assert.True(t, closeCalled)
+
+	err := driver.SaveBlock(&outport.OutportBlock{})
+	assert.Equal(t, kafka.ErrDriverIsClosed, err)
+}
+
+func TestKafkaDriver_SettingsShouldBePublishedBeforeTheNextRecordIfTheFirstAttemptFailed(t *testing.T) {
+	t.Parallel()
+
+	isBrokerReachable := false
+	producedKeys := make([]string, 0)
+	args := createArgsKafkaDriver()
+	args.Producer = &mock.ProducerStub{
+		ProduceCalled: func(key []byte, value []byte) error {
+			if !isBrokerReachable {
+				return errors.New("connection refused")
+			}
+
+			producedKeys = append(producedKeys, string(key))
+			return nil
+		},
+	}
+	driver, _ := kafka.NewKafkaDriver(args)
+
+	err := driver.RegisterHandler(func() error {
+		return driver.SetCurrentSettings(outport.OutportConfig{})
+	}, outport.TopicSettings)
+	require.Nil(t, err)
+
+	err = driver.SaveBlock(&outport.OutportBlock{})
+	require.NotNil(t, err)
+
+	isBrokerReachable = true
+	require.Nil(t, driver.SaveBlock(&outport.OutportBlock{}))
+	require.Nil(t, driver.SaveBlock(&outport.OutportBlock{}))
+
+	assert.Equal(t, []string{outport.TopicSettings, outport.TopicSaveBlock, outport.TopicSaveBlock}, producedKeys)
+}
@@ -0,0 +1,27 @@
+package kafka
+
+import "errors"
+
+// ErrNilProducer signals that a nil producer has been provided
+var ErrNilProducer = errors.New("nil producer")
+
+// ErrDriverIsClosed signals that the driver was closed while trying to perform actions
+var ErrDriverIsClosed = errors.New("kafka driver is closed")
+
+// ErrEmptyBrokerAddress signals that an empty broker address has been provided
+var ErrEmptyBrokerAddress = errors.New("empty broker address")
+
+// ErrEmptyTopic signals that an empty topic has been provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrInvalidRequiredAcks signals that an invalid required acks value has been provided
+var ErrInvalidRequiredAcks = errors.New("invalid required acks, should be -1, 0 or 1")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrBrokerResponse signals that the broker rejected the produced records
+var ErrBrokerResponse = errors.New("broker error response")
+
+// ErrInvalidResponse signals that the broker response could not be decoded
+var ErrInvalidResponse = errors.New("invalid broker response")
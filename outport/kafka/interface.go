@@ -0,0 +1,8 @@
+package kafka
+
+// Producer defines the actions that a Kafka producer should do
+type Producer interface {
+	Produce(key []byte, value []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
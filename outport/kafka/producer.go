@@ -0,0 +1,178 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/kafka")
+
+const maxResponseSize = 1024 * 1024
+
+// ArgsProducer holds the arguments needed for creating a new producer
+type ArgsProducer struct {
+	BrokerAddress  string
+	Topic          string
+	Partition      int32
+	ClientID       string
+	RequiredAcks   int16
+	RequestTimeout time.Duration
+}
+
+// producer appends records to a single partition, one request at a time. The configured broker should be the
+// partition leader. The connection is re-established on the next call after any network error, while the
+// caller is responsible for retrying the failed record, which gives at-least-once delivery
+type producer struct {
+	mutex         sync.Mutex
+	args          ArgsProducer
+	conn          net.Conn
+	correlationID int32
+	isClosed      bool
+	getTimeMs     func() int64
+}
+
+// NewProducer will create a new instance of producer. The broker connection is opened on the first produced record
+func NewProducer(args ArgsProducer) (*producer, error) {
+	if len(args.BrokerAddress) == 0 {
+		return nil, ErrEmptyBrokerAddress
+	}
+	if len(args.Topic) == 0 {
+		return nil, ErrEmptyTopic
+	}
+	if args.RequiredAcks < -1 || args.RequiredAcks > 1 {
+		return nil, ErrInvalidRequiredAcks
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &producer{
+		args: args,
+		getTimeMs: func() int64 {
+			return time.Now().UnixMilli()
+		},
+	}, nil
+}
+
+// Produce sends the record to the broker and, if acknowledgements are required, waits for the broker response
+func (p *producer) Produce(key []byte, value []byte) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.isClosed {
+		return ErrDriverIsClosed
+	}
+
+	err := p.connectIfNeeded()
+	if err != nil {
+		return err
+	}
+
+	p.correlationID++
+	request := encodeProduceRequest(produceRequest{
+		correlationID: p.correlationID,
+		clientID:      p.args.ClientID,
+		requiredAcks:  p.args.RequiredAcks,
+		timeoutMs:     int32(p.args.RequestTimeout.Milliseconds()),
+		topic:         p.args.Topic,
+		partition:     p.args.Partition,
+		timestampMs:   p.getTimeMs(),
+		key:           key,
+		value:         value,
+	})
+
+	err = p.conn.SetDeadline(time.Now().Add(p.args.RequestTimeout))
+	if err != nil {
+		p.disconnect()
+		return err
+	}
+
+	_, err = p.conn.Write(request)
+	if err != nil {
+		p.disconnect()
+		return err
+	}
+
+	if p.args.RequiredAcks == 0 {
+		return nil
+	}
+
+	response, err := p.readResponse()
+	if err != nil {
+		p.disconnect()
+		return err
+	}
+
+	return checkProduceResponse(response, p.correlationID)
+}
+
+func (p *producer) connectIfNeeded() error {
+	if p.conn != nil {
+		return nil
+	}
+
+	conn, err := net.DialTimeout("tcp", p.args.BrokerAddress, p.args.RequestTimeout)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("producer: connected to broker", "address", p.args.BrokerAddress)
+	p.conn = conn
+
+	return nil
+}
+
+func (p *producer) readResponse() ([]byte, error) {
+	sizeBuff := make([]byte, 4)
+	_, err := io.ReadFull(p.conn, sizeBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(sizeBuff)
+	if size > maxResponseSize {
+		return nil, fmt.Errorf("%w: response size %d exceeds the maximum of %d", ErrInvalidResponse, size, maxResponseSize)
+	}
+
+	response := make([]byte, size)
+	_, err = io.ReadFull(p.conn, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (p *producer) disconnect() {
+	if p.conn == nil {
+		return
+	}
+
+	err := p.conn.Close()
+	if err != nil {
+		log.Debug("producer: error closing the broker connection", "error", err)
+	}
+	p.conn = nil
+}
+
+// Close will close the broker connection
+func (p *producer) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.isClosed = true
+	p.disconnect()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *producer) IsInterfaceNil() bool {
+	return p == nil
+}
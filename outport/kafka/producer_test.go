@@ -0,0 +1,332 @@
+package kafka_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/outport/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type producedRecord struct {
+	clientID  string
+	acks      int16
+	topic     string
+	partition int32
+	key       []byte
+	value     []byte
+}
+
+// localBroker is a broker stand-in which decodes Produce requests, validates the record batches and
+// answers with the configured error code
+type localBroker struct {
+	t         *testing.T
+	listener  net.Listener
+	mutex     sync.Mutex
+	records   []producedRecord
+	errorCode int16
+}
+
+func newLocalBroker(t *testing.T) *localBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	broker := &localBroker{
+		t:        t,
+		listener: listener,
+	}
+	go broker.acceptConnections()
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return broker
+}
+
+func (lb *localBroker) address() string {
+	return lb.listener.Addr().String()
+}
+
+func (lb *localBroker) setErrorCode(errorCode int16) {
+	lb.mutex.Lock()
+	lb.errorCode = errorCode
+	lb.mutex.Unlock()
+}
+
+func (lb *localBroker) getRecords() []producedRecord {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+
+	return append([]producedRecord{}, lb.records...)
+}
+
+func (lb *localBroker) acceptConnections() {
+	for {
+		conn, err := lb.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go lb.serve(conn)
+	}
+}
+
+func (lb *localBroker) serve(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	for {
+		sizeBuff := make([]byte, 4)
+		_, err := io.ReadFull(conn, sizeBuff)
+		if err != nil {
+			return
+		}
+		request := make([]byte, binary.BigEndian.Uint32(sizeBuff))
+		_, err = io.ReadFull(conn, request)
+		if err != nil {
+			return
+		}
+
+		record, correlationID := lb.decodeProduceRequest(bytes.NewReader(request))
+
+		lb.mutex.Lock()
+		lb.records = append(lb.records, record)
+		errorCode := lb.errorCode
+		lb.mutex.Unlock()
+
+		if record.acks == 0 {
+			continue
+		}
+
+		_, err = conn.Write(encodeProduceResponse(correlationID, record.topic, record.partition, errorCode))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (lb *localBroker) decodeProduceRequest(reader *bytes.Reader) (producedRecord, int32) {
+	t := lb.t
+	assert.Equal(t, int16(0), readInt16(reader))
+	assert.Equal(t, int16(3), readInt16(reader))
+	correlationID := readInt32(reader)
+
+	record := producedRecord{}
+	record.clientID = readString(reader)
+	assert.Equal(t, int16(-1), readInt16(reader))
+	record.acks = readInt16(reader)
+	assert.True(t, readInt32(reader) > 0)
+	assert.Equal(t, int32(1), readInt32(reader))
+	record.topic = readString(reader)
+	assert.Equal(t, int32(1), readInt32(reader))
+	record.partition = readInt32(reader)
+
+	batch := make([]byte, readInt32(reader))
+	_, _ = io.ReadFull(reader, batch)
+	assert.Equal(t, 0, reader.Len())
+
+	assert.Equal(t, uint32(len(batch)-12), binary.BigEndian.Uint32(batch[8:12]))
+	assert.Equal(t, byte(2), batch[16])
+	crc := crc32.Checksum(batch[21:], crc32.MakeTable(crc32.Castagnoli))
+	assert.Equal(t, crc, binary.BigEndian.Uint32(batch[17:21]))
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(batch[57:61]))
+
+	recordReader := bytes.NewReader(batch[61:])
+	length, _ := binary.ReadVarint(recordReader)
+	assert.Equal(t, int64(recordReader.Len()), length)
+	attributes, _ := recordReader.ReadByte()
+	assert.Equal(t, byte(0), attributes)
+	_, _ = binary.ReadVarint(recordReader)
+	_, _ = binary.ReadVarint(recordReader)
+	record.key = readVarintBytes(recordReader)
+	record.value = readVarintBytes(recordReader)
+	numHeaders, _ := binary.ReadVarint(recordReader)
+	assert.Equal(t, int64(0), numHeaders)
+
+	return record, correlationID
+}
+
+func encodeProduceResponse(correlationID int32, topic string, partition int32, errorCode int16) []byte {
+	buff := make([]byte, 4)
+	buff = binary.BigEndian.AppendUint32(buff, uint32(correlationID))
+	buff = binary.BigEndian.AppendUint32(buff, 1)
+	buff = binary.BigEndian.AppendUint16(buff, uint16(len(topic)))
+	buff = append(buff, topic...)
+	buff = binary.BigEndian.AppendUint32(buff, 1)
+	buff = binary.BigEndian.AppendUint32(buff, uint32(partition))
+	buff = binary.BigEndian.AppendUint16(buff, uint16(errorCode))
+	buff = binary.BigEndian.AppendUint64(buff, 0)
+	buff = binary.BigEndian.AppendUint64(buff, 0)
+	buff = binary.BigEndian.AppendUint32(buff, 0)
+	binary.BigEndian.PutUint32(buff[0:4], uint32(len(buff)-4))
+
+	return buff
+}
+
+func readInt16(reader io.Reader) int16 {
+	var value int16
+	_ = binary.Read(reader, binary.BigEndian, &value)
+	return value
+}
+
+func readInt32(reader io.Reader) int32 {
+	var value int32
+	_ = binary.Read(reader, binary.BigEndian, &value)
+	return value
+}
+
+func readString(reader io.Reader) string {
+	buff := make([]byte, readInt16(reader))
+	_, _ = io.ReadFull(reader, buff)
+	return string(buff)
+}
+
+func readVarintBytes(reader *bytes.Reader) []byte {
+	length, _ := binary.ReadVarint(reader)
+	if length < 0 {
+		return nil
+	}
+
+	buff := make([]byte, length)
+	_, _ = io.ReadFull(reader, buff)
+	return buff
+}
+
+func createArgsProducer(address string) kafka.ArgsProducer {
+	return kafka.ArgsProducer{
+		BrokerAddress:  address,
+		Topic:          "outport",
+		Partition:      2,
+		ClientID:       "node",
+		RequiredAcks:   -1,
+		RequestTimeout: time.Second * 2,
+	}
+}
+
+func TestNewProducer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty broker address should error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := kafka.NewProducer(createArgsProducer(""))
+		assert.Equal(t, kafka.ErrEmptyBrokerAddress, err)
+		assert.True(t, check.IfNil(p))
+	})
+	t.Run("empty topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsProducer("127.0.0.1:9092")
+		args.Topic = ""
+		p, err := kafka.NewProducer(args)
+		assert.Equal(t, kafka.ErrEmptyTopic, err)
+		assert.True(t, check.IfNil(p))
+	})
+	t.Run("invalid required acks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsProducer("127.0.0.1:9092")
+		args.RequiredAcks = 2
+		p, err := kafka.NewProducer(args)
+		assert.Equal(t, kafka.ErrInvalidRequiredAcks, err)
+		assert.True(t, check.IfNil(p))
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsProducer("127.0.0.1:9092")
+		args.RequestTimeout = 0
+		p, err := kafka.NewProducer(args)
+		assert.Equal(t, kafka.ErrInvalidRequestTimeout, err)
+		assert.True(t, check.IfNil(p))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := kafka.NewProducer(createArgsProducer("127.0.0.1:9092"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(p))
+	})
+}
+
+func TestProducer_Produce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should deliver the records in order", func(t *testing.T) {
+		t.Parallel()
+
+		broker := newLocalBroker(t)
+		p, _ := kafka.NewProducer(createArgsProducer(broker.address()))
+
+		require.Nil(t, p.Produce([]byte("SaveBlock"), []byte("block 1")))
+		require.Nil(t, p.Produce([]byte("RevertIndexedBlock"), []byte("block 1")))
+		require.Nil(t, p.Produce([]byte("FinalizedBlock"), nil))
+		require.Nil(t, p.Close())
+
+		assert.Equal(t, []producedRecord{
+			{clientID: "node", acks: -1, topic: "outport", partition: 2, key: []byte("SaveBlock"), value: []byte("block 1")},
+			{clientID: "node", acks: -1, topic: "outport", partition: 2, key: []byte("RevertIndexedBlock"), value: []byte("block 1")},
+			{clientID: "node", acks: -1, topic: "outport", partition: 2, key: []byte("FinalizedBlock"), value: nil},
+		}, broker.getRecords())
+	})
+	t.Run("broker error code should error", func(t *testing.T) {
+		t.Parallel()
+
+		broker := newLocalBroker(t)
+		broker.setErrorCode(6)
+		p, _ := kafka.NewProducer(createArgsProducer(broker.address()))
+
+		err := p.Produce([]byte("SaveBlock"), []byte("block 1"))
+		assert.True(t, errors.Is(err, kafka.ErrBrokerResponse))
+
+		broker.setErrorCode(0)
+		assert.Nil(t, p.Produce([]byte("SaveBlock"), []byte("block 1")))
+	})
+	t.Run("without acks should not wait for responses", func(t *testing.T) {
+		t.Parallel()
+
+		broker := newLocalBroker(t)
+		args := createArgsProducer(broker.address())
+		args.RequiredAcks = 0
+		p, _ := kafka.NewProducer(args)
+
+		require.Nil(t, p.Produce([]byte("SaveBlock"), []byte("block 1")))
+		require.Nil(t, p.Produce([]byte("SaveBlock"), []byte("block 2")))
+
+		assert.Eventually(t, func() bool {
+			return len(broker.getRecords()) == 2
+		}, time.Second, time.Millisecond*10)
+	})
+	t.Run("unreachable broker should error", func(t *testing.T) {
+		t.Parallel()
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		address := listener.Addr().String()
+		_ = listener.Close()
+
+		p, _ := kafka.NewProducer(createArgsProducer(address))
+		err = p.Produce([]byte("SaveBlock"), []byte("block 1"))
+		assert.NotNil(t, err)
+	})
+	t.Run("closed producer should error", func(t *testing.T) {
+		t.Parallel()
+
+		broker := newLocalBroker(t)
+		p, _ := kafka.NewProducer(createArgsProducer(broker.address()))
+		require.Nil(t, p.Close())
+
+		err := p.Produce([]byte("SaveBlock"), []byte("block 1"))
+		assert.Equal(t, kafka.ErrDriverIsClosed, err)
+	})
+}
@@ -0,0 +1,197 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+// The producer speaks the subset of the Kafka wire protocol needed to append records to a partition:
+// Produce requests, version 3, carrying a single record batch (magic 2). Version 3 is the oldest Produce
+// version accepted by current brokers and does not use the flexible (tagged fields) encoding.
+const (
+	produceAPIKey     = int16(0)
+	produceAPIVersion = int16(3)
+	recordBatchMagic  = int8(2)
+
+	batchHeaderLength  = 61
+	batchLengthOffset  = 8
+	batchCrcOffset     = 17
+	batchCrcDataOffset = 21
+)
+
+// the sentinel values are variables, as negative constants can not be converted to the unsigned wire types
+var (
+	noProducerID      = int64(-1)
+	noProducerEpoch   = int16(-1)
+	noBaseSequence    = int32(-1)
+	noPartitionLeader = int32(-1)
+	nullStringLength  = int16(-1)
+)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+type produceRequest struct {
+	correlationID int32
+	clientID      string
+	requiredAcks  int16
+	timeoutMs     int32
+	topic         string
+	partition     int32
+	timestampMs   int64
+	key           []byte
+	value         []byte
+}
+
+// encodeProduceRequest returns the size prefixed Produce request containing a single record
+func encodeProduceRequest(request produceRequest) []byte {
+	batch := encodeRecordBatch(request.timestampMs, request.key, request.value)
+
+	buff := make([]byte, 4, 64+len(request.clientID)+len(request.topic)+len(batch))
+	// request header v1
+	buff = binary.BigEndian.AppendUint16(buff, uint16(produceAPIKey))
+	buff = binary.BigEndian.AppendUint16(buff, uint16(produceAPIVersion))
+	buff = binary.BigEndian.AppendUint32(buff, uint32(request.correlationID))
+	buff = appendString(buff, request.clientID)
+	// produce request v3
+	buff = binary.BigEndian.AppendUint16(buff, uint16(nullStringLength))
+	buff = binary.BigEndian.AppendUint16(buff, uint16(request.requiredAcks))
+	buff = binary.BigEndian.AppendUint32(buff, uint32(request.timeoutMs))
+	buff = binary.BigEndian.AppendUint32(buff, 1)
+	buff = appendString(buff, request.topic)
+	buff = binary.BigEndian.AppendUint32(buff, 1)
+	buff = binary.BigEndian.AppendUint32(buff, uint32(request.partition))
+	buff = binary.BigEndian.AppendUint32(buff, uint32(len(batch)))
+	buff = append(buff, batch...)
+
+	binary.BigEndian.PutUint32(buff[0:4], uint32(len(buff)-4))
+
+	return buff
+}
+
+func encodeRecordBatch(timestampMs int64, key []byte, value []byte) []byte {
+	record := encodeRecord(key, value)
+
+	buff := make([]byte, 0, batchHeaderLength+len(record))
+	buff = binary.BigEndian.AppendUint64(buff, 0) // base offset, assigned by the broker
+	buff = binary.BigEndian.AppendUint32(buff, 0) // batch length, filled below
+	buff = binary.BigEndian.AppendUint32(buff, uint32(noPartitionLeader))
+	buff = append(buff, byte(recordBatchMagic))
+	buff = binary.BigEndian.AppendUint32(buff, 0) // crc, filled below
+	buff = binary.BigEndian.AppendUint16(buff, 0) // attributes: no compression, create time
+	buff = binary.BigEndian.AppendUint32(buff, 0) // last offset delta
+	buff = binary.BigEndian.AppendUint64(buff, uint64(timestampMs))
+	buff = binary.BigEndian.AppendUint64(buff, uint64(timestampMs))
+	buff = binary.BigEndian.AppendUint64(buff, uint64(noProducerID))
+	buff = binary.BigEndian.AppendUint16(buff, uint16(noProducerEpoch))
+	buff = binary.BigEndian.AppendUint32(buff, uint32(noBaseSequence))
+	buff = binary.BigEndian.AppendUint32(buff, 1)
+	buff = append(buff, record...)
+
+	binary.BigEndian.PutUint32(buff[batchLengthOffset:], uint32(len(buff)-batchLengthOffset-4))
+	binary.BigEndian.PutUint32(buff[batchCrcOffset:], crc32.Checksum(buff[batchCrcDataOffset:], castagnoliTable))
+
+	return buff
+}
+
+func encodeRecord(key []byte, value []byte) []byte {
+	body := make([]byte, 0, 16+len(key)+len(value))
+	body = append(body, 0)              // attributes
+	body = binary.AppendVarint(body, 0) // timestamp delta
+	body = binary.AppendVarint(body, 0) // offset delta
+	body = appendVarintBytes(body, key)
+	body = appendVarintBytes(body, value)
+	body = binary.AppendVarint(body, 0) // headers count
+
+	record := binary.AppendVarint(make([]byte, 0, len(body)+binary.MaxVarintLen32), int64(len(body)))
+
+	return append(record, body...)
+}
+
+func appendVarintBytes(buff []byte, data []byte) []byte {
+	if data == nil {
+		return binary.AppendVarint(buff, -1)
+	}
+
+	buff = binary.AppendVarint(buff, int64(len(data)))
+	return append(buff, data...)
+}
+
+func appendString(buff []byte, str string) []byte {
+	buff = binary.BigEndian.AppendUint16(buff, uint16(len(str)))
+	return append(buff, str...)
+}
+
+type responseDecoder struct {
+	buff []byte
+	err  error
+}
+
+func (rd *responseDecoder) next(size int) []byte {
+	if rd.err != nil {
+		return nil
+	}
+	if len(rd.buff) < size {
+		rd.err = fmt.Errorf("%w: unexpected end of data", ErrInvalidResponse)
+		return nil
+	}
+
+	data := rd.buff[:size]
+	rd.buff = rd.buff[size:]
+
+	return data
+}
+
+func (rd *responseDecoder) int16() int16 {
+	data := rd.next(2)
+	if data == nil {
+		return 0
+	}
+
+	return int16(binary.BigEndian.Uint16(data))
+}
+
+func (rd *responseDecoder) int32() int32 {
+	data := rd.next(4)
+	if data == nil {
+		return 0
+	}
+
+	return int32(binary.BigEndian.Uint32(data))
+}
+
+func (rd *responseDecoder) string() string {
+	size := rd.int16()
+	if size < 0 {
+		return ""
+	}
+
+	return string(rd.next(int(size)))
+}
+
+// checkProduceResponse decodes a Produce response, version 3, without its size prefix and returns an
+// error if the correlation id does not match or any of the partitions reported an error
+func checkProduceResponse(response []byte, correlationID int32) error {
+	decoder := &responseDecoder{buff: response}
+
+	responseCorrelationID := decoder.int32()
+	if decoder.err == nil && responseCorrelationID != correlationID {
+		return fmt.Errorf("%w: correlation id mismatch, expected %d, got %d", ErrInvalidResponse, correlationID, responseCorrelationID)
+	}
+
+	numTopics := decoder.int32()
+	for i := int32(0); i < numTopics && decoder.err == nil; i++ {
+		topic := decoder.string()
+		numPartitions := decoder.int32()
+		for j := int32(0); j < numPartitions && decoder.err == nil; j++ {
+			partition := decoder.int32()
+			errorCode := decoder.int16()
+			_ = decoder.next(16) // base offset and log append time
+			if decoder.err == nil && errorCode != 0 {
+				return fmt.Errorf("%w: error code %d for topic %s, partition %d", ErrBrokerResponse, errorCode, topic, partition)
+			}
+		}
+	}
+
+	return decoder.err
+}
@@ -3,21 +3,23 @@ package mock
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 )
 
 // DriverStub -
 type DriverStub struct {
-	SaveBlockCalled             func(outportBlock *outportcore.OutportBlock) error
-	RevertIndexedBlockCalled    func(blockData *outportcore.BlockData) error
-	SaveRoundsInfoCalled        func(roundsInfos *outportcore.RoundsInfo) error
-	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error
-	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating) error
-	SaveAccountsCalled          func(accounts *outportcore.Accounts) error
-	FinalizedBlockCalled        func(finalizedBlock *outportcore.FinalizedBlock) error
-	CloseCalled                 func() error
-	RegisterHandlerCalled       func(handlerFunction func() error, topic string) error
-	SetCurrentSettingsCalled    func(config outportcore.OutportConfig) error
+	SaveBlockCalled                   func(outportBlock *outportcore.OutportBlock) error
+	RevertIndexedBlockCalled          func(blockData *outportcore.BlockData) error
+	SaveRoundsInfoCalled              func(roundsInfos *outportcore.RoundsInfo) error
+	SaveValidatorsPubKeysCalled       func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error
+	SaveValidatorsRatingCalled        func(validatorsRating *outportcore.ValidatorsRating) error
+	SaveValidatorsStatusChangesCalled func(statusChanges *common.ValidatorsStatusChanges) error
+	SaveAccountsCalled                func(accounts *outportcore.Accounts) error
+	FinalizedBlockCalled              func(finalizedBlock *outportcore.FinalizedBlock) error
+	CloseCalled                       func() error
+	RegisterHandlerCalled             func(handlerFunction func() error, topic string) error
+	SetCurrentSettingsCalled          func(config outportcore.OutportConfig) error
 }
 
 // SaveBlock -
@@ -65,6 +67,15 @@ func (d *DriverStub) SaveValidatorsRating(validatorsRating *outportcore.Validato
 	return nil
 }
 
+// SaveValidatorsStatusChanges -
+func (d *DriverStub) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error {
+	if d.SaveValidatorsStatusChangesCalled != nil {
+		return d.SaveValidatorsStatusChangesCalled(statusChanges)
+	}
+
+	return nil
+}
+
 // SaveAccounts -
 func (d *DriverStub) SaveAccounts(accounts *outportcore.Accounts) error {
 	if d.SaveAccountsCalled != nil {
@@ -0,0 +1,30 @@
+package mock
+
+// ProducerStub -
+type ProducerStub struct {
+	ProduceCalled func(key []byte, value []byte) error
+	CloseCalled   func() error
+}
+
+// Produce -
+func (ps *ProducerStub) Produce(key []byte, value []byte) error {
+	if ps.ProduceCalled != nil {
+		return ps.ProduceCalled(key, value)
+	}
+
+	return nil
+}
+
+// Close -
+func (ps *ProducerStub) Close() error {
+	if ps.CloseCalled != nil {
+		return ps.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ps *ProducerStub) IsInterfaceNil() bool {
+	return ps == nil
+}
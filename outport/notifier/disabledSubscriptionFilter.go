@@ -0,0 +1,21 @@
+package notifier
+
+import "github.com/multiversx/mx-chain-core-go/data/outport"
+
+type disabledSubscriptionFilter struct {
+}
+
+// NewDisabledSubscriptionFilter creates a subscription filter that lets every block pass unchanged
+func NewDisabledSubscriptionFilter() *disabledSubscriptionFilter {
+	return &disabledSubscriptionFilter{}
+}
+
+// FilterBlock returns the provided block
+func (filter *disabledSubscriptionFilter) FilterBlock(outportBlock *outport.OutportBlock) *outport.OutportBlock {
+	return outportBlock
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (filter *disabledSubscriptionFilter) IsInterfaceNil() bool {
+	return filter == nil
+}
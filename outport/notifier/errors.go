@@ -15,3 +15,9 @@ var ErrNilMarshaller = errors.New("nil marshaller")
 
 // ErrNilBlockContainerHandler signals that a nil block container handler has been provided
 var ErrNilBlockContainerHandler = errors.New("nil bock container handler")
+
+// ErrNilSubscriptionFilter signals that a nil subscription filter has been provided
+var ErrNilSubscriptionFilter = errors.New("nil subscription filter")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
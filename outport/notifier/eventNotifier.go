@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -14,22 +15,25 @@ import (
 var log = logger.GetOrCreate("outport/eventNotifier")
 
 const (
-	pushEventEndpoint       = "/events/push"
-	revertEventsEndpoint    = "/events/revert"
-	finalizedEventsEndpoint = "/events/finalized"
+	pushEventEndpoint        = "/events/push"
+	revertEventsEndpoint     = "/events/revert"
+	finalizedEventsEndpoint  = "/events/finalized"
+	validatorsEventsEndpoint = "/events/validators"
 )
 
 type eventNotifier struct {
-	httpClient     httpClientHandler
-	marshalizer    marshal.Marshalizer
-	blockContainer BlockContainerHandler
+	httpClient         httpClientHandler
+	marshalizer        marshal.Marshalizer
+	blockContainer     BlockContainerHandler
+	subscriptionFilter SubscriptionFilter
 }
 
 // ArgsEventNotifier defines the arguments needed for event notifier creation
 type ArgsEventNotifier struct {
-	HttpClient     httpClientHandler
-	Marshaller     marshal.Marshalizer
-	BlockContainer BlockContainerHandler
+	HttpClient         httpClientHandler
+	Marshaller         marshal.Marshalizer
+	BlockContainer     BlockContainerHandler
+	SubscriptionFilter SubscriptionFilter
 }
 
 // NewEventNotifier creates a new instance of the eventNotifier
@@ -41,9 +45,10 @@ func NewEventNotifier(args ArgsEventNotifier) (*eventNotifier, error) {
 	}
 
 	return &eventNotifier{
-		httpClient:     args.HttpClient,
-		marshalizer:    args.Marshaller,
-		blockContainer: args.BlockContainer,
+		httpClient:         args.HttpClient,
+		marshalizer:        args.Marshaller,
+		blockContainer:     args.BlockContainer,
+		subscriptionFilter: args.SubscriptionFilter,
 	}, nil
 }
 
@@ -57,17 +62,20 @@ func checkEventNotifierArgs(args ArgsEventNotifier) error {
 	if check.IfNilReflect(args.BlockContainer) {
 		return ErrNilBlockContainerHandler
 	}
+	if check.IfNil(args.SubscriptionFilter) {
+		return ErrNilSubscriptionFilter
+	}
 
 	return nil
 }
 
-// SaveBlock converts block data in order to be pushed to subscribers
+// SaveBlock converts block data in order to be pushed to subscribers, keeping only the data matching the subscriptions
 func (en *eventNotifier) SaveBlock(args *outport.OutportBlock) error {
 	if args.BlockData != nil {
 		log.Debug("eventNotifier: SaveBlock called at block", "block hash", args.BlockData.HeaderHash)
 	}
 
-	err := en.httpClient.Post(pushEventEndpoint, args)
+	err := en.httpClient.Post(pushEventEndpoint, en.subscriptionFilter.FilterBlock(args))
 	if err != nil {
 		return fmt.Errorf("%w in eventNotifier.SaveBlock while posting block data", err)
 	}
@@ -105,6 +113,16 @@ func (en *eventNotifier) SaveValidatorsRating(_ *outport.ValidatorsRating) error
 	return nil
 }
 
+// SaveValidatorsStatusChanges pushes the validators' status changes of an epoch start to subscribers
+func (en *eventNotifier) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) error {
+	err := en.httpClient.Post(validatorsEventsEndpoint, statusChanges)
+	if err != nil {
+		return fmt.Errorf("%w in eventNotifier.SaveValidatorsStatusChanges while posting event data", err)
+	}
+
+	return nil
+}
+
 // SaveValidatorsPubKeys returns nil
 func (en *eventNotifier) SaveValidatorsPubKeys(_ *outport.ValidatorsPubKeys) error {
 	return nil
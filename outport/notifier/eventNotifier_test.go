@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/mock"
 	"github.com/multiversx/mx-chain-go/outport/notifier"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
@@ -19,9 +20,10 @@ import (
 
 func createMockEventNotifierArgs() notifier.ArgsEventNotifier {
 	return notifier.ArgsEventNotifier{
-		HttpClient:     &mock.HTTPClientStub{},
-		Marshaller:     &marshallerMock.MarshalizerMock{},
-		BlockContainer: &outportStub.BlockContainerStub{},
+		HttpClient:         &mock.HTTPClientStub{},
+		Marshaller:         &marshallerMock.MarshalizerMock{},
+		BlockContainer:     &outportStub.BlockContainerStub{},
+		SubscriptionFilter: notifier.NewDisabledSubscriptionFilter(),
 	}
 }
 
@@ -61,6 +63,17 @@ func TestNewEventNotifier(t *testing.T) {
 		require.Equal(t, notifier.ErrNilBlockContainerHandler, err)
 	})
 
+	t.Run("nil subscription filter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventNotifierArgs()
+		args.SubscriptionFilter = nil
+
+		en, err := notifier.NewEventNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, notifier.ErrNilSubscriptionFilter, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -254,6 +267,57 @@ func TestFinalizedBlock(t *testing.T) {
 	})
 }
 
+func TestSaveValidatorsStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return err if http request failed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventNotifierArgs()
+
+		expectedErr := errors.New("expected error")
+		args.HttpClient = &mock.HTTPClientStub{
+			PostCalled: func(route string, payload interface{}) error {
+				return expectedErr
+			},
+		}
+
+		en, _ := notifier.NewEventNotifier(args)
+
+		err := en.SaveValidatorsStatusChanges(&common.ValidatorsStatusChanges{})
+		require.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEventNotifierArgs()
+
+		statusChanges := &common.ValidatorsStatusChanges{
+			Epoch: 2,
+			Changes: []*common.ValidatorStatusChange{
+				{BlsKey: "aa", OldList: string(common.EligibleList), NewList: string(common.JailedList), Reason: common.ValidatorJailed, Epoch: 2},
+			},
+		}
+		wasCalled := false
+		args.HttpClient = &mock.HTTPClientStub{
+			PostCalled: func(route string, payload interface{}) error {
+				wasCalled = true
+				require.Equal(t, "/events/validators", route)
+				require.Equal(t, statusChanges, payload)
+				return nil
+			},
+		}
+
+		en, _ := notifier.NewEventNotifier(args)
+
+		err := en.SaveValidatorsStatusChanges(statusChanges)
+		require.Nil(t, err)
+
+		require.True(t, wasCalled)
+	})
+}
+
 func TestMockFunctions(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,227 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+// ArgsSubscriptionFilter defines the arguments needed for subscription filter creation
+type ArgsSubscriptionFilter struct {
+	Config           config.EventNotifierSubscriptionsConfig
+	AddressConverter core.PubkeyConverter
+}
+
+// subscriptionFilter reduces the pushed block to the events matching the subscriptions, together with the
+// transactions and smart contract results that generated them
+type subscriptionFilter struct {
+	addresses              map[string]struct{}
+	encodedAddresses       map[string]struct{}
+	identifiers            map[string]struct{}
+	topics                 map[string]struct{}
+	includeAlteredAccounts bool
+}
+
+// NewSubscriptionFilter creates a new instance of subscriptionFilter
+func NewSubscriptionFilter(args ArgsSubscriptionFilter) (*subscriptionFilter, error) {
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	sf := &subscriptionFilter{
+		addresses:              make(map[string]struct{}, len(args.Config.Addresses)),
+		encodedAddresses:       make(map[string]struct{}, len(args.Config.Addresses)),
+		identifiers:            make(map[string]struct{}, len(args.Config.Identifiers)),
+		topics:                 make(map[string]struct{}, len(args.Config.Topics)),
+		includeAlteredAccounts: args.Config.IncludeAlteredAccounts,
+	}
+
+	for _, address := range args.Config.Addresses {
+		addressBytes, err := args.AddressConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for subscription address %s", err, address)
+		}
+
+		sf.addresses[string(addressBytes)] = struct{}{}
+		sf.encodedAddresses[address] = struct{}{}
+	}
+	for _, identifier := range args.Config.Identifiers {
+		sf.identifiers[identifier] = struct{}{}
+	}
+	for _, topic := range args.Config.Topics {
+		topicBytes, err := hex.DecodeString(topic)
+		if err != nil {
+			return nil, fmt.Errorf("%w for subscription topic %s", err, topic)
+		}
+
+		sf.topics[string(topicBytes)] = struct{}{}
+	}
+
+	return sf, nil
+}
+
+// FilterBlock returns a copy of the provided block holding only the matching logs and their context. The provided
+// block is not altered, as it is shared with the other outport drivers
+func (sf *subscriptionFilter) FilterBlock(outportBlock *outport.OutportBlock) *outport.OutportBlock {
+	if outportBlock == nil {
+		return nil
+	}
+
+	filtered := &outport.OutportBlock{
+		ShardID:                outportBlock.ShardID,
+		BlockData:              outportBlock.BlockData,
+		HeaderGasConsumption:   outportBlock.HeaderGasConsumption,
+		AlteredAccounts:        sf.filterAlteredAccounts(outportBlock.AlteredAccounts),
+		NotarizedHeadersHashes: outportBlock.NotarizedHeadersHashes,
+		NumberOfShards:         outportBlock.NumberOfShards,
+		SignersIndexes:         outportBlock.SignersIndexes,
+		HighestFinalBlockNonce: outportBlock.HighestFinalBlockNonce,
+		HighestFinalBlockHash:  outportBlock.HighestFinalBlockHash,
+	}
+	if outportBlock.TransactionPool != nil {
+		filtered.TransactionPool = sf.filterTransactionPool(outportBlock.TransactionPool)
+	}
+
+	return filtered
+}
+
+func (sf *subscriptionFilter) filterTransactionPool(pool *outport.TransactionPool) *outport.TransactionPool {
+	filteredPool := &outport.TransactionPool{
+		Transactions:         make(map[string]*outport.TxInfo),
+		SmartContractResults: make(map[string]*outport.SCRInfo),
+		Logs:                 make([]*outport.LogData, 0),
+	}
+
+	for _, logData := range pool.Logs {
+		filteredLog := sf.filterLog(logData)
+		if filteredLog == nil {
+			continue
+		}
+
+		filteredPool.Logs = append(filteredPool.Logs, filteredLog)
+		addLogContext(filteredPool, pool, logData.TxHash)
+	}
+
+	return filteredPool
+}
+
+func (sf *subscriptionFilter) filterLog(logData *outport.LogData) *outport.LogData {
+	if logData == nil || logData.Log == nil {
+		return nil
+	}
+
+	events := make([]*transaction.Event, 0)
+	for _, event := range logData.Log.Events {
+		if sf.isEventMatching(logData.Log, event) {
+			events = append(events, event)
+		}
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	return &outport.LogData{
+		TxHash: logData.TxHash,
+		Log: &transaction.Log{
+			Address: logData.Log.Address,
+			Events:  events,
+		},
+	}
+}
+
+func (sf *subscriptionFilter) isEventMatching(txLog *transaction.Log, event *transaction.Event) bool {
+	if event == nil {
+		return false
+	}
+
+	return sf.isAddressMatching(txLog, event) && isInSet(sf.identifiers, event.Identifier) && sf.isTopicMatching(event)
+}
+
+func (sf *subscriptionFilter) isAddressMatching(txLog *transaction.Log, event *transaction.Event) bool {
+	if len(sf.addresses) == 0 {
+		return true
+	}
+
+	_, isEventAddressWatched := sf.addresses[string(event.Address)]
+	_, isLogAddressWatched := sf.addresses[string(txLog.Address)]
+
+	return isEventAddressWatched || isLogAddressWatched
+}
+
+func (sf *subscriptionFilter) isTopicMatching(event *transaction.Event) bool {
+	if len(sf.topics) == 0 {
+		return true
+	}
+
+	for _, topic := range event.Topics {
+		_, found := sf.topics[string(topic)]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isInSet(set map[string]struct{}, value []byte) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[string(value)]
+
+	return found
+}
+
+// addLogContext copies the transaction or the smart contract result that generated the log. For smart contract
+// results, the original transaction is copied as well
+func addLogContext(filteredPool *outport.TransactionPool, pool *outport.TransactionPool, txHash string) {
+	txInfo, found := pool.Transactions[txHash]
+	if found {
+		filteredPool.Transactions[txHash] = txInfo
+		return
+	}
+
+	scrInfo, found := pool.SmartContractResults[txHash]
+	if !found {
+		return
+	}
+
+	filteredPool.SmartContractResults[txHash] = scrInfo
+	if scrInfo.SmartContractResult == nil {
+		return
+	}
+
+	originalTxHash := hex.EncodeToString(scrInfo.SmartContractResult.OriginalTxHash)
+	originalTxInfo, found := pool.Transactions[originalTxHash]
+	if found {
+		filteredPool.Transactions[originalTxHash] = originalTxInfo
+	}
+}
+
+func (sf *subscriptionFilter) filterAlteredAccounts(accounts map[string]*alteredAccount.AlteredAccount) map[string]*alteredAccount.AlteredAccount {
+	filteredAccounts := make(map[string]*alteredAccount.AlteredAccount)
+	if !sf.includeAlteredAccounts {
+		return filteredAccounts
+	}
+
+	for address, account := range accounts {
+		_, isWatched := sf.encodedAddresses[address]
+		if isWatched || len(sf.encodedAddresses) == 0 {
+			filteredAccounts[address] = account
+		}
+	}
+
+	return filteredAccounts
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sf *subscriptionFilter) IsInterfaceNil() bool {
+	return sf == nil
+}
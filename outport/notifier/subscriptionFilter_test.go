@@ -0,0 +1,191 @@
+package notifier_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/notifier"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	watchedAddress = []byte("watched-address")
+	otherAddress   = []byte("other-address")
+)
+
+func createMockSubscriptionFilterArgs() notifier.ArgsSubscriptionFilter {
+	return notifier.ArgsSubscriptionFilter{
+		Config: config.EventNotifierSubscriptionsConfig{
+			Enabled:     true,
+			Addresses:   []string{hex.EncodeToString(watchedAddress)},
+			Identifiers: []string{"swap"},
+			Topics:      []string{hex.EncodeToString([]byte("topic"))},
+		},
+		AddressConverter: testscommon.NewPubkeyConverterMock(len(watchedAddress)),
+	}
+}
+
+func createOutportBlockWithLogs() *outport.OutportBlock {
+	originalTxHash := []byte("original-tx")
+
+	return &outport.OutportBlock{
+		TransactionPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				hex.EncodeToString(originalTxHash): {Transaction: &transaction.Transaction{Nonce: 1}},
+				"other-tx":                         {Transaction: &transaction.Transaction{Nonce: 2}},
+			},
+			SmartContractResults: map[string]*outport.SCRInfo{
+				"scr": {SmartContractResult: &smartContractResult.SmartContractResult{OriginalTxHash: originalTxHash}},
+			},
+			Logs: []*outport.LogData{
+				{
+					TxHash: "scr",
+					Log: &transaction.Log{
+						Address: otherAddress,
+						Events: []*transaction.Event{
+							{Address: watchedAddress, Identifier: []byte("swap"), Topics: [][]byte{[]byte("a"), []byte("topic")}},
+							{Address: watchedAddress, Identifier: []byte("deposit"), Topics: [][]byte{[]byte("topic")}},
+							{Address: otherAddress, Identifier: []byte("swap"), Topics: [][]byte{[]byte("topic")}},
+						},
+					},
+				},
+				{
+					TxHash: "other-tx",
+					Log: &transaction.Log{
+						Address: otherAddress,
+						Events: []*transaction.Event{
+							{Address: watchedAddress, Identifier: []byte("swap"), Topics: [][]byte{[]byte("b")}},
+						},
+					},
+				},
+			},
+		},
+		AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+			hex.EncodeToString(watchedAddress): {Nonce: 1},
+			hex.EncodeToString(otherAddress):   {Nonce: 2},
+		},
+	}
+}
+
+func TestNewSubscriptionFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address converter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionFilterArgs()
+		args.AddressConverter = nil
+
+		sf, err := notifier.NewSubscriptionFilter(args)
+		require.Nil(t, sf)
+		require.Equal(t, notifier.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionFilterArgs()
+		args.Config.Addresses = []string{"not hex"}
+
+		sf, err := notifier.NewSubscriptionFilter(args)
+		require.Nil(t, sf)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid topic", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionFilterArgs()
+		args.Config.Topics = []string{"not hex"}
+
+		sf, err := notifier.NewSubscriptionFilter(args)
+		require.Nil(t, sf)
+		require.Error(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sf, err := notifier.NewSubscriptionFilter(createMockSubscriptionFilterArgs())
+		require.Nil(t, err)
+		require.False(t, sf.IsInterfaceNil())
+	})
+}
+
+func TestSubscriptionFilter_FilterBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should keep only the matching events and their context", func(t *testing.T) {
+		t.Parallel()
+
+		sf, _ := notifier.NewSubscriptionFilter(createMockSubscriptionFilterArgs())
+		outportBlock := createOutportBlockWithLogs()
+
+		filtered := sf.FilterBlock(outportBlock)
+
+		require.Len(t, filtered.TransactionPool.Logs, 1)
+		filteredLog := filtered.TransactionPool.Logs[0]
+		assert.Equal(t, "scr", filteredLog.TxHash)
+		require.Len(t, filteredLog.Log.Events, 1)
+		assert.Equal(t, outportBlock.TransactionPool.Logs[0].Log.Events[0], filteredLog.Log.Events[0])
+
+		require.Len(t, filtered.TransactionPool.SmartContractResults, 1)
+		assert.NotNil(t, filtered.TransactionPool.SmartContractResults["scr"])
+		require.Len(t, filtered.TransactionPool.Transactions, 1)
+		assert.NotNil(t, filtered.TransactionPool.Transactions[hex.EncodeToString([]byte("original-tx"))])
+		assert.Empty(t, filtered.AlteredAccounts)
+
+		// the provided block is shared with the other drivers, so it should remain unchanged
+		assert.Equal(t, createOutportBlockWithLogs(), outportBlock)
+	})
+
+	t.Run("log address should match the watched addresses", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionFilterArgs()
+		args.Config.Addresses = []string{hex.EncodeToString(otherAddress)}
+		args.Config.Identifiers = nil
+		args.Config.Topics = nil
+		sf, _ := notifier.NewSubscriptionFilter(args)
+
+		filtered := sf.FilterBlock(createOutportBlockWithLogs())
+
+		require.Len(t, filtered.TransactionPool.Logs, 2)
+		assert.Len(t, filtered.TransactionPool.Logs[0].Log.Events, 3)
+		assert.Len(t, filtered.TransactionPool.Logs[1].Log.Events, 1)
+		assert.Len(t, filtered.TransactionPool.Transactions, 2)
+	})
+
+	t.Run("should include the altered accounts of the watched addresses", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionFilterArgs()
+		args.Config.IncludeAlteredAccounts = true
+		sf, _ := notifier.NewSubscriptionFilter(args)
+
+		filtered := sf.FilterBlock(createOutportBlockWithLogs())
+
+		require.Len(t, filtered.AlteredAccounts, 1)
+		assert.NotNil(t, filtered.AlteredAccounts[hex.EncodeToString(watchedAddress)])
+
+		args.Config.Addresses = nil
+		sf, _ = notifier.NewSubscriptionFilter(args)
+
+		filtered = sf.FilterBlock(createOutportBlockWithLogs())
+		assert.Len(t, filtered.AlteredAccounts, 2)
+	})
+
+	t.Run("nil block", func(t *testing.T) {
+		t.Parallel()
+
+		sf, _ := notifier.NewSubscriptionFilter(createMockSubscriptionFilterArgs())
+		assert.Nil(t, sf.FilterBlock(nil))
+	})
+}
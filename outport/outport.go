@@ -8,6 +8,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -279,6 +280,37 @@ func (o *outport) saveValidatorsRatingBlocking(validatorsRating *outportcore.Val
 	}
 }
 
+// SaveValidatorsStatusChanges will save the validators' status changes for every driver
+func (o *outport) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.drivers {
+		o.saveValidatorsStatusChangesBlocking(statusChanges, driver)
+	}
+}
+
+func (o *outport) saveValidatorsStatusChangesBlocking(statusChanges *common.ValidatorsStatusChanges, driver Driver) {
+	ch := o.monitorCompletionOnDriver("saveValidatorsStatusChangesBlocking", driver)
+	defer close(ch)
+
+	for {
+		err := driver.SaveValidatorsStatusChanges(statusChanges)
+		if err == nil {
+			return
+		}
+
+		log.Error("error calling SaveValidatorsStatusChanges, will retry",
+			"driver", driverString(driver),
+			"retrial in", o.retrialInterval,
+			"error", err)
+
+		if o.shouldTerminate() {
+			return
+		}
+	}
+}
+
 // SaveAccounts will save accounts  for every driver
 func (o *outport) SaveAccounts(accounts *outportcore.Accounts) {
 	o.mutex.RLock()
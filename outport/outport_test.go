@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/mock"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
@@ -279,6 +280,53 @@ func TestOutport_SaveValidatorsRating(t *testing.T) {
 	assert.Equal(t, uint32(4), atomicGo.LoadUint32(&numLogDebugCalled))
 }
 
+func TestOutport_SaveValidatorsStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	numCalled1 := 0
+	numCalled2 := 0
+	driver1 := &mock.DriverStub{
+		SaveValidatorsStatusChangesCalled: func(statusChanges *common.ValidatorsStatusChanges) error {
+			numCalled1++
+			if numCalled1 < 10 {
+				return expectedError
+			}
+
+			return nil
+		},
+	}
+	driver2 := &mock.DriverStub{
+		SaveValidatorsStatusChangesCalled: func(statusChanges *common.ValidatorsStatusChanges) error {
+			numCalled2++
+			return nil
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+	numLogDebugCalled := uint32(0)
+	outportHandler.logHandler = func(logLevel logger.LogLevel, message string, args ...interface{}) {
+		if logLevel == logger.LogError {
+			assert.Fail(t, "should have not called log error")
+		}
+		if logLevel == logger.LogDebug {
+			atomicGo.AddUint32(&numLogDebugCalled, 1)
+		}
+	}
+
+	outportHandler.SaveValidatorsStatusChanges(&common.ValidatorsStatusChanges{})
+	time.Sleep(time.Second)
+
+	_ = outportHandler.SubscribeDriver(driver1)
+	_ = outportHandler.SubscribeDriver(driver2)
+
+	outportHandler.SaveValidatorsStatusChanges(&common.ValidatorsStatusChanges{})
+	time.Sleep(time.Second)
+
+	assert.Equal(t, 10, numCalled1)
+	assert.Equal(t, 1, numCalled2)
+	assert.Equal(t, uint32(4), atomicGo.LoadUint32(&numLogDebugCalled))
+}
+
 func TestOutport_RevertIndexedBlock(t *testing.T) {
 	t.Parallel()
 
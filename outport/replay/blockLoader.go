@@ -0,0 +1,233 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// storedBlock holds a block loaded from storage together with the transactions and logs it executed
+type storedBlock struct {
+	headerHash      []byte
+	header          data.HeaderHandler
+	body            *block.Body
+	txs             map[block.Type]map[string]data.TransactionHandler
+	logs            []*data.LogData
+	orderedTxHashes [][]byte
+}
+
+// blockLoader rebuilds blocks from the units persisted by the node: the header is found by nonce, the body
+// from the mini blocks unit and the executed transactions from the transactions units. The intra shard mini
+// blocks created while processing (smart contract results, receipts and invalid transactions) are not part
+// of the body, so they are read from the receipts repository
+type blockLoader struct {
+	store              dataRetriever.StorageService
+	marshaller         marshal.Marshalizer
+	uint64Converter    typeConverters.Uint64ByteSliceConverter
+	receiptsRepository ReceiptsRepository
+	shardID            uint32
+}
+
+func (bl *blockLoader) loadHeader(nonce uint64) (data.HeaderHandler, []byte, error) {
+	return process.GetHeaderFromStorageWithNonce(nonce, bl.shardID, bl.store, bl.uint64Converter, bl.marshaller)
+}
+
+func (bl *blockLoader) loadBlock(nonce uint64) (*storedBlock, error) {
+	header, headerHash, err := bl.loadHeader(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the header with nonce %d", err, nonce)
+	}
+
+	blk := &storedBlock{
+		headerHash:      headerHash,
+		header:          header,
+		txs:             make(map[block.Type]map[string]data.TransactionHandler),
+		orderedTxHashes: make([][]byte, 0),
+	}
+
+	blk.body, err = bl.loadBody(header)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the body of the block with nonce %d", err, nonce)
+	}
+
+	mbHeaders := header.GetMiniBlockHeaderHandlers()
+	for i, miniBlock := range blk.body.MiniBlocks {
+		err = bl.loadTransactions(blk, miniBlock.Type, getExecutedTxHashes(mbHeaders[i], miniBlock))
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the transactions of the block with nonce %d", err, nonce)
+		}
+	}
+
+	receiptsHolder, err := bl.receiptsRepository.LoadReceipts(header, headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the receipts of the block with nonce %d", err, nonce)
+	}
+	for _, miniBlock := range receiptsHolder.GetMiniblocks() {
+		err = bl.loadTransactions(blk, miniBlock.Type, miniBlock.TxHashes)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the intra shard transactions of the block with nonce %d", err, nonce)
+		}
+	}
+
+	err = bl.loadLogs(blk)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the logs of the block with nonce %d", err, nonce)
+	}
+
+	return blk, nil
+}
+
+func (bl *blockLoader) loadBody(header data.HeaderHandler) (*block.Body, error) {
+	storer, err := bl.store.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	mbHeaders := header.GetMiniBlockHeaderHandlers()
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(mbHeaders)),
+	}
+	for _, mbHeader := range mbHeaders {
+		buff, errGet := storer.GetFromEpoch(mbHeader.GetHash(), header.GetEpoch())
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = bl.marshaller.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (bl *blockLoader) loadTransactions(blk *storedBlock, blockType block.Type, txHashes [][]byte) error {
+	if blockType == block.PeerBlock || len(txHashes) == 0 {
+		return nil
+	}
+
+	unit, err := getTransactionsUnit(blockType)
+	if err != nil {
+		return fmt.Errorf("%w for mini block type %s", err, blockType.String())
+	}
+
+	storer, err := bl.store.GetStorer(unit)
+	if err != nil {
+		return err
+	}
+
+	txs, ok := blk.txs[blockType]
+	if !ok {
+		txs = make(map[string]data.TransactionHandler, len(txHashes))
+		blk.txs[blockType] = txs
+	}
+
+	for _, txHash := range txHashes {
+		_, alreadyLoaded := txs[string(txHash)]
+		if alreadyLoaded {
+			continue
+		}
+
+		buff, errGet := storer.GetFromEpoch(txHash, blk.header.GetEpoch())
+		if errGet != nil {
+			return errGet
+		}
+
+		tx := createEmptyTransaction(blockType)
+		err = bl.marshaller.Unmarshal(tx, buff)
+		if err != nil {
+			return err
+		}
+
+		txs[string(txHash)] = tx
+		blk.orderedTxHashes = append(blk.orderedTxHashes, txHash)
+	}
+
+	return nil
+}
+
+func (bl *blockLoader) loadLogs(blk *storedBlock) error {
+	storer, err := bl.store.GetStorer(dataRetriever.TxLogsUnit)
+	if err != nil {
+		return err
+	}
+
+	blk.logs = make([]*data.LogData, 0)
+	for _, txHash := range blk.orderedTxHashes {
+		buff, errGet := storer.GetFromEpoch(txHash, blk.header.GetEpoch())
+		if storage.IsNotFoundInStorageErr(errGet) {
+			continue
+		}
+		if errGet != nil {
+			return errGet
+		}
+
+		txLog := &transaction.Log{}
+		err = bl.marshaller.Unmarshal(txLog, buff)
+		if err != nil {
+			return err
+		}
+
+		blk.logs = append(blk.logs, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     string(txHash),
+		})
+	}
+
+	return nil
+}
+
+// getExecutedTxHashes returns the hashes executed by the block, the same way the outport data provider checks them
+func getExecutedTxHashes(mbHeader data.MiniBlockHeaderHandler, miniBlock *block.MiniBlock) [][]byte {
+	if mbHeader.GetProcessingType() == int32(block.Processed) {
+		return nil
+	}
+
+	first := int(mbHeader.GetIndexOfFirstTxProcessed())
+	last := int(mbHeader.GetIndexOfLastTxProcessed())
+	if first < 0 || last >= len(miniBlock.TxHashes) || first > last {
+		return nil
+	}
+
+	return miniBlock.TxHashes[first : last+1]
+}
+
+func getTransactionsUnit(blockType block.Type) (dataRetriever.UnitType, error) {
+	switch blockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, nil
+	case block.SmartContractResultBlock, block.ReceiptBlock:
+		return dataRetriever.UnsignedTransactionUnit, nil
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, nil
+	default:
+		return 0, errUnknownTransactionsUnit
+	}
+}
+
+func createEmptyTransaction(blockType block.Type) data.TransactionHandler {
+	switch blockType {
+	case block.SmartContractResultBlock:
+		return &smartContractResult.SmartContractResult{}
+	case block.ReceiptBlock:
+		return &receipt.Receipt{}
+	case block.RewardsBlock:
+		return &rewardTx.RewardTx{}
+	default:
+		return &transaction.Transaction{}
+	}
+}
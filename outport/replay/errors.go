@@ -0,0 +1,32 @@
+package replay
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrNilReceiptsRepository signals that a nil receipts repository has been provided
+var ErrNilReceiptsRepository = errors.New("nil receipts repository")
+
+// ErrInvalidNonceRange signals that the provided nonce range is invalid
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrReplayInterrupted signals that the replay has been interrupted before reaching the last nonce
+var ErrReplayInterrupted = errors.New("replay interrupted")
+
+var errUnknownTransactionsUnit = errors.New("unknown transactions unit")
@@ -0,0 +1,26 @@
+package replay
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ReceiptsRepository defines what a receipts repository should be able to do
+type ReceiptsRepository interface {
+	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
+	IsInterfaceNil() bool
+}
+
+// EpochNotifier defines what an epoch notifier should be able to do
+type EpochNotifier interface {
+	CheckEpoch(header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
+
+// OutportReplayer defines what an outport replayer should be able to do
+type OutportReplayer interface {
+	Replay(ctx context.Context, fromNonce uint64, toNonce uint64) error
+	IsInterfaceNil() bool
+}
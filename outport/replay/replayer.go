@@ -0,0 +1,262 @@
+package replay
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport"
+	outportProcess "github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/outport/process/factory"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+const progressLogInterval = 1000
+
+// ArgsOutportReplayer holds the arguments needed for creating a new outportReplayer
+type ArgsOutportReplayer struct {
+	Store                  dataRetriever.StorageService
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	Uint64Converter        typeConverters.Uint64ByteSliceConverter
+	AddressConverter       core.PubkeyConverter
+	ShardCoordinator       sharding.Coordinator
+	AccountsDB             state.AccountsAdapter
+	EsdtDataStorageHandler vmcommon.ESDTNFTStorageHandler
+	EconomicsData          outportProcess.EconomicsDataHandler
+	EnableEpochsHandler    common.EnableEpochsHandler
+	EpochNotifier          EpochNotifier
+	NodesCoordinator       nodesCoordinator.NodesCoordinator
+	ReceiptsRepository     ReceiptsRepository
+	Outport                outport.OutportHandler
+}
+
+// outportReplayer re-feeds the outport drivers with blocks already committed in the node database. Each block
+// goes through the same outport data provider the block processors use, fed with the data loaded from storage
+// instead of the one held by the processing components
+type outportReplayer struct {
+	shardID        uint32
+	loader         *blockLoader
+	txCoordinator  *storedBlockTxCoordinator
+	executionOrder *storedBlockExecutionOrder
+	dataProvider   outport.DataProviderOutport
+	accountsDB     state.AccountsAdapter
+	epochNotifier  EpochNotifier
+	outportHandler outport.OutportHandler
+}
+
+// NewOutportReplayer will create a new instance of outportReplayer
+func NewOutportReplayer(args ArgsOutportReplayer) (*outportReplayer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsStorer, err := args.Store.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+	miniBlocksStorer, err := args.Store.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	txCoordinator := &storedBlockTxCoordinator{}
+	executionOrder := newStoredBlockExecutionOrder()
+	dataProvider, err := factory.CreateOutportDataProvider(factory.ArgOutportDataProviderFactory{
+		HasDrivers:             true,
+		AddressConverter:       args.AddressConverter,
+		AccountsDB:             args.AccountsDB,
+		Marshaller:             args.Marshaller,
+		EsdtDataStorageHandler: args.EsdtDataStorageHandler,
+		TransactionsStorer:     transactionsStorer,
+		ShardCoordinator:       args.ShardCoordinator,
+		TxCoordinator:          txCoordinator,
+		NodesCoordinator:       args.NodesCoordinator,
+		GasConsumedProvider:    &storedBlockGasConsumption{},
+		EconomicsData:          args.EconomicsData,
+		Hasher:                 args.Hasher,
+		MbsStorer:              miniBlocksStorer,
+		EnableEpochsHandler:    args.EnableEpochsHandler,
+		ExecutionOrderGetter:   executionOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &outportReplayer{
+		shardID: args.ShardCoordinator.SelfId(),
+		loader: &blockLoader{
+			store:              args.Store,
+			marshaller:         args.Marshaller,
+			uint64Converter:    args.Uint64Converter,
+			receiptsRepository: args.ReceiptsRepository,
+			shardID:            args.ShardCoordinator.SelfId(),
+		},
+		txCoordinator:  txCoordinator,
+		executionOrder: executionOrder,
+		dataProvider:   dataProvider,
+		accountsDB:     args.AccountsDB,
+		epochNotifier:  args.EpochNotifier,
+		outportHandler: args.Outport,
+	}, nil
+}
+
+func checkArgs(args ArgsOutportReplayer) error {
+	if check.IfNil(args.Store) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AccountsDB) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return ErrNilEpochNotifier
+	}
+	if check.IfNil(args.ReceiptsRepository) {
+		return ErrNilReceiptsRepository
+	}
+	if check.IfNil(args.Outport) {
+		return ErrNilOutportHandler
+	}
+
+	return nil
+}
+
+// Replay feeds the outport with the blocks in the [fromNonce, toNonce] interval, in order. Every block is also
+// reported as finalized right after being saved, as all the stored blocks are final
+func (or *outportReplayer) Replay(ctx context.Context, fromNonce uint64, toNonce uint64) error {
+	if fromNonce > toNonce {
+		return fmt.Errorf("%w, from nonce %d is greater than to nonce %d", ErrInvalidNonceRange, fromNonce, toNonce)
+	}
+
+	var previousHeader data.HeaderHandler
+	if fromNonce > 0 {
+		previousHeader, _, _ = or.loader.loadHeader(fromNonce - 1)
+	}
+
+	log.Info("replaying the stored blocks", "shard", or.shardID, "from nonce", fromNonce, "to nonce", toNonce)
+	for nonce := fromNonce; ; nonce++ {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w at nonce %d", ErrReplayInterrupted, nonce)
+		default:
+		}
+
+		header, err := or.replayBlock(nonce, previousHeader)
+		if err != nil {
+			return err
+		}
+		previousHeader = header
+
+		replayedBlocks := nonce - fromNonce + 1
+		if replayedBlocks%progressLogInterval == 0 {
+			log.Info("replay progress", "nonce", nonce, "replayed blocks", replayedBlocks, "remaining blocks", toNonce-nonce)
+		}
+
+		if nonce == toNonce {
+			break
+		}
+	}
+
+	log.Info("replay finished", "shard", or.shardID, "replayed blocks", toNonce-fromNonce+1)
+
+	return nil
+}
+
+func (or *outportReplayer) replayBlock(nonce uint64, previousHeader data.HeaderHandler) (data.HeaderHandler, error) {
+	blk, err := or.loader.loadBlock(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	or.epochNotifier.CheckEpoch(blk.header)
+
+	err = or.accountsDB.RecreateTrie(blk.header.GetRootHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w while recreating the state of the block with nonce %d, the state might have been pruned", err, nonce)
+	}
+
+	or.txCoordinator.setBlock(blk)
+	or.executionOrder.setBlock(blk)
+
+	outportBlock, err := or.dataProvider.PrepareOutportSaveBlockData(outportProcess.ArgPrepareOutportSaveBlockData{
+		HeaderHash:             blk.headerHash,
+		Header:                 blk.header,
+		Body:                   blk.body,
+		PreviousHeader:         previousHeader,
+		RewardsTxs:             or.getRewardsTxs(blk),
+		NotarizedHeadersHashes: getNotarizedHeadersHashes(blk.header),
+		HighestFinalBlockNonce: nonce,
+		HighestFinalBlockHash:  blk.headerHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while preparing the outport data of the block with nonce %d", err, nonce)
+	}
+
+	err = or.outportHandler.SaveBlock(outportBlock)
+	if err != nil {
+		return nil, fmt.Errorf("%w while saving the block with nonce %d", err, nonce)
+	}
+
+	or.outportHandler.FinalizedBlock(&outportcore.FinalizedBlock{
+		ShardID:    or.shardID,
+		HeaderHash: blk.headerHash,
+	})
+
+	log.Debug("replayed block", "nonce", nonce, "hash", blk.headerHash)
+
+	return blk.header, nil
+}
+
+// getRewardsTxs returns the rewards created by a metachain block, which the metachain processor passes separately
+func (or *outportReplayer) getRewardsTxs(blk *storedBlock) map[string]data.TransactionHandler {
+	if or.shardID != core.MetachainShardId {
+		return nil
+	}
+
+	return blk.txs[block.RewardsBlock]
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (or *outportReplayer) IsInterfaceNil() bool {
+	return or == nil
+}
@@ -0,0 +1,437 @@
+package replay_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
+	"github.com/multiversx/mx-chain-go/outport/replay"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsOutportReplayer() replay.ArgsOutportReplayer {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+
+	return replay.ArgsOutportReplayer{
+		Store:                  genericMocks.NewChainStorerMock(0),
+		Marshaller:             &marshallerMock.MarshalizerMock{},
+		Hasher:                 &testscommon.KeccakMock{},
+		Uint64Converter:        uint64ByteSlice.NewBigEndianConverter(),
+		AddressConverter:       testscommon.NewPubkeyConverterMock(32),
+		ShardCoordinator:       shardCoordinator,
+		AccountsDB:             &state.AccountsStub{RecreateTrieCalled: func(_ []byte) error { return nil }},
+		EsdtDataStorageHandler: &testscommon.EsdtStorageHandlerStub{},
+		EconomicsData:          &economicsmocks.EconomicsHandlerMock{},
+		EnableEpochsHandler:    &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		EpochNotifier:          &epochNotifier.EpochNotifierStub{},
+		NodesCoordinator:       &shardingMocks.NodesCoordinatorStub{},
+		ReceiptsRepository:     &testscommon.ReceiptsRepositoryStub{},
+		Outport:                &outport.OutportStub{},
+	}
+}
+
+type storedBlockBuilder struct {
+	t          *testing.T
+	store      *genericMocks.ChainStorerMock
+	args       replay.ArgsOutportReplayer
+	headerHash map[uint64][]byte
+}
+
+func newStoredBlockBuilder(t *testing.T, args replay.ArgsOutportReplayer) *storedBlockBuilder {
+	return &storedBlockBuilder{
+		t:          t,
+		store:      args.Store.(*genericMocks.ChainStorerMock),
+		args:       args,
+		headerHash: make(map[uint64][]byte),
+	}
+}
+
+func (sbb *storedBlockBuilder) put(unit dataRetriever.UnitType, key []byte, value interface{}) {
+	buff, err := sbb.args.Marshaller.Marshal(value)
+	require.Nil(sbb.t, err)
+	require.Nil(sbb.t, sbb.store.Put(unit, key, buff))
+}
+
+func (sbb *storedBlockBuilder) saveMiniBlock(miniBlock *block.MiniBlock) block.MiniBlockHeader {
+	mbHash := []byte(fmt.Sprintf("mb-%s", miniBlock.TxHashes[0]))
+	sbb.put(dataRetriever.MiniBlockUnit, mbHash, miniBlock)
+
+	return block.MiniBlockHeader{
+		Hash:    mbHash,
+		Type:    miniBlock.Type,
+		TxCount: uint32(len(miniBlock.TxHashes)),
+	}
+}
+
+func (sbb *storedBlockBuilder) saveTx(unit dataRetriever.UnitType, txHash string, tx data.TransactionHandler) []byte {
+	sbb.put(unit, []byte(txHash), tx)
+	return []byte(txHash)
+}
+
+func (sbb *storedBlockBuilder) saveShardBlock(nonce uint64, txHashes ...string) []byte {
+	txs := make([][]byte, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		txs = append(txs, sbb.saveTx(dataRetriever.TransactionUnit, txHash, &transaction.Transaction{
+			Nonce:    nonce,
+			SndAddr:  []byte("sender-address-0000000000000000"),
+			RcvAddr:  []byte("receiver-address-00000000000000"),
+			Value:    big.NewInt(10),
+			GasLimit: 50000,
+			GasPrice: 1000000000,
+		}))
+	}
+
+	mbHeader := sbb.saveMiniBlock(&block.MiniBlock{TxHashes: txs, Type: block.TxBlock})
+	header := &block.Header{
+		Nonce:            nonce,
+		Round:            nonce,
+		RootHash:         []byte(fmt.Sprintf("root hash %d", nonce)),
+		MiniBlockHeaders: []block.MiniBlockHeader{mbHeader},
+	}
+
+	return sbb.saveHeader(nonce, dataRetriever.BlockHeaderUnit, dataRetriever.ShardHdrNonceHashDataUnit, header)
+}
+
+func (sbb *storedBlockBuilder) saveHeader(nonce uint64, headerUnit dataRetriever.UnitType, nonceUnit dataRetriever.UnitType, header data.HeaderHandler) []byte {
+	headerHash := []byte(fmt.Sprintf("header hash %d", nonce))
+	sbb.put(headerUnit, headerHash, header)
+	require.Nil(sbb.t, sbb.store.Put(nonceUnit, sbb.args.Uint64Converter.ToByteSlice(nonce), headerHash))
+	sbb.headerHash[nonce] = headerHash
+
+	return headerHash
+}
+
+func TestNewOutportReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.Store = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilStorageService, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.Marshaller = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.Uint64Converter = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilUint64Converter, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.ShardCoordinator = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.AccountsDB = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil epoch notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.EpochNotifier = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilEpochNotifier, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil receipts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.ReceiptsRepository = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilReceiptsRepository, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("nil outport should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.Outport = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, replay.ErrNilOutportHandler, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("invalid data provider arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.AddressConverter = nil
+		replayer, err := replay.NewOutportReplayer(args)
+		assert.Equal(t, alteredaccounts.ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(replayer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := replay.NewOutportReplayer(createMockArgsOutportReplayer())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(replayer))
+	})
+}
+
+func TestOutportReplayer_Replay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := replay.NewOutportReplayer(createMockArgsOutportReplayer())
+		err := replayer.Replay(context.Background(), 5, 4)
+		assert.True(t, errors.Is(err, replay.ErrInvalidNonceRange))
+	})
+	t.Run("should feed the stored blocks in order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		builder := newStoredBlockBuilder(t, args)
+		builder.saveShardBlock(1, "tx1")
+		builder.saveShardBlock(2, "tx2", "tx3")
+		builder.saveShardBlock(3, "tx4")
+
+		scrHash := builder.saveTx(dataRetriever.UnsignedTransactionUnit, "scr1", &smartContractResult.SmartContractResult{
+			SndAddr:        []byte("receiver-address-00000000000000"),
+			RcvAddr:        []byte("sender-address-0000000000000000"),
+			Value:          big.NewInt(1),
+			OriginalTxHash: []byte("tx2"),
+		})
+		builder.put(dataRetriever.TxLogsUnit, []byte("tx3"), &transaction.Log{
+			Address: []byte("receiver-address-00000000000000"),
+			Events:  []*transaction.Event{{Identifier: []byte("transfer")}},
+		})
+		args.ReceiptsRepository = &testscommon.ReceiptsRepositoryStub{
+			LoadReceiptsCalled: func(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error) {
+				if header.GetNonce() != 2 {
+					return holders.NewReceiptsHolder(nil), nil
+				}
+
+				return holders.NewReceiptsHolder([]*block.MiniBlock{{TxHashes: [][]byte{scrHash}, Type: block.SmartContractResultBlock}}), nil
+			},
+		}
+
+		recreatedRootHashes := make([]string, 0)
+		args.AccountsDB = &state.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				recreatedRootHashes = append(recreatedRootHashes, string(rootHash))
+				return nil
+			},
+		}
+		checkedEpochs := 0
+		args.EpochNotifier = &epochNotifier.EpochNotifierStub{
+			CheckEpochCalled: func(header data.HeaderHandler) {
+				checkedEpochs++
+			},
+		}
+
+		savedBlocks := make([]*outportcore.OutportBlockWithHeaderAndBody, 0)
+		finalizedHashes := make([]string, 0)
+		args.Outport = &outport.OutportStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlockWithHeaderAndBody) error {
+				savedBlocks = append(savedBlocks, outportBlock)
+				return nil
+			},
+			FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) {
+				finalizedHashes = append(finalizedHashes, string(finalizedBlock.HeaderHash))
+			},
+		}
+
+		replayer, err := replay.NewOutportReplayer(args)
+		require.Nil(t, err)
+
+		err = replayer.Replay(context.Background(), 2, 3)
+		require.Nil(t, err)
+
+		require.Equal(t, 2, len(savedBlocks))
+		assert.Equal(t, []string{"root hash 2", "root hash 3"}, recreatedRootHashes)
+		assert.Equal(t, 2, checkedEpochs)
+		assert.Equal(t, []string{"header hash 2", "header hash 3"}, finalizedHashes)
+
+		secondBlock := savedBlocks[0]
+		assert.Equal(t, []byte("header hash 2"), secondBlock.HeaderDataWithBody.HeaderHash)
+		assert.Equal(t, uint64(2), secondBlock.HeaderDataWithBody.Header.GetNonce())
+		assert.Equal(t, uint64(2), secondBlock.HighestFinalBlockNonce)
+		pool := secondBlock.TransactionPool
+		require.Equal(t, 2, len(pool.Transactions))
+		assert.Equal(t, uint32(0), pool.Transactions[hex.EncodeToString([]byte("tx2"))].ExecutionOrder)
+		assert.Equal(t, uint32(1), pool.Transactions[hex.EncodeToString([]byte("tx3"))].ExecutionOrder)
+		require.Equal(t, 1, len(pool.SmartContractResults))
+		assert.Equal(t, uint32(2), pool.SmartContractResults[hex.EncodeToString(scrHash)].ExecutionOrder)
+		require.Equal(t, 1, len(pool.Logs))
+		assert.Equal(t, hex.EncodeToString([]byte("tx3")), pool.Logs[0].TxHash)
+
+		thirdBlock := savedBlocks[1]
+		assert.Equal(t, []byte("header hash 3"), thirdBlock.HeaderDataWithBody.HeaderHash)
+		require.Equal(t, 1, len(thirdBlock.TransactionPool.Transactions))
+		assert.Equal(t, 0, len(thirdBlock.TransactionPool.SmartContractResults))
+	})
+	t.Run("should feed the rewards and the notarized headers of metachain blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			NoShards:     1,
+			CurrentShard: core.MetachainShardId,
+		}
+		builder := newStoredBlockBuilder(t, args)
+		rewardHash := builder.saveTx(dataRetriever.RewardTransactionUnit, "reward1", &rewardTx.RewardTx{
+			Round:   7,
+			Value:   big.NewInt(100),
+			RcvAddr: []byte("receiver-address-00000000000000"),
+		})
+		mbHeader := builder.saveMiniBlock(&block.MiniBlock{
+			TxHashes:        [][]byte{rewardHash},
+			Type:            block.RewardsBlock,
+			SenderShardID:   core.MetachainShardId,
+			ReceiverShardID: 0,
+		})
+		builder.saveHeader(7, dataRetriever.MetaBlockUnit, dataRetriever.MetaHdrNonceHashDataUnit, &block.MetaBlock{
+			Nonce:            7,
+			Round:            7,
+			RootHash:         []byte("meta root hash"),
+			MiniBlockHeaders: []block.MiniBlockHeader{mbHeader},
+			ShardInfo:        []block.ShardData{{HeaderHash: []byte("shard header")}},
+		})
+
+		var savedBlock *outportcore.OutportBlockWithHeaderAndBody
+		args.Outport = &outport.OutportStub{
+			SaveBlockCalled: func(outportBlock *outportcore.OutportBlockWithHeaderAndBody) error {
+				savedBlock = outportBlock
+				return nil
+			},
+		}
+
+		replayer, _ := replay.NewOutportReplayer(args)
+		err := replayer.Replay(context.Background(), 7, 7)
+		require.Nil(t, err)
+
+		require.NotNil(t, savedBlock)
+		assert.Equal(t, []string{hex.EncodeToString([]byte("shard header"))}, savedBlock.NotarizedHeadersHashes)
+		require.Equal(t, 1, len(savedBlock.TransactionPool.Rewards))
+		assert.NotNil(t, savedBlock.TransactionPool.Rewards[hex.EncodeToString(rewardHash)])
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		builder := newStoredBlockBuilder(t, args)
+		builder.saveShardBlock(1, "tx1")
+
+		numSavedBlocks := 0
+		args.Outport = &outport.OutportStub{
+			SaveBlockCalled: func(_ *outportcore.OutportBlockWithHeaderAndBody) error {
+				numSavedBlocks++
+				return nil
+			},
+		}
+
+		replayer, _ := replay.NewOutportReplayer(args)
+		err := replayer.Replay(context.Background(), 1, 2)
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, numSavedBlocks)
+	})
+	t.Run("pruned state should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		builder := newStoredBlockBuilder(t, args)
+		builder.saveShardBlock(1, "tx1")
+		args.AccountsDB = &state.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return expectedErr
+			},
+		}
+
+		replayer, _ := replay.NewOutportReplayer(args)
+		err := replayer.Replay(context.Background(), 1, 1)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("outport error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		builder := newStoredBlockBuilder(t, args)
+		builder.saveShardBlock(1, "tx1")
+		args.Outport = &outport.OutportStub{
+			SaveBlockCalled: func(_ *outportcore.OutportBlockWithHeaderAndBody) error {
+				return expectedErr
+			},
+		}
+
+		replayer, _ := replay.NewOutportReplayer(args)
+		err := replayer.Replay(context.Background(), 1, 1)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("cancelled context should stop the replay", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer()
+		builder := newStoredBlockBuilder(t, args)
+		builder.saveShardBlock(1, "tx1")
+		builder.saveShardBlock(2, "tx2")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		args.Outport = &outport.OutportStub{
+			SaveBlockCalled: func(_ *outportcore.OutportBlockWithHeaderAndBody) error {
+				cancel()
+				return nil
+			},
+		}
+
+		replayer, _ := replay.NewOutportReplayer(args)
+		err := replayer.Replay(ctx, 1, 2)
+		assert.True(t, errors.Is(err, replay.ErrReplayInterrupted))
+	})
+}
@@ -0,0 +1,108 @@
+package replay
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/ordering"
+	"github.com/multiversx/mx-chain-go/factory/disabled"
+)
+
+// storedBlockTxCoordinator provides the transactions and logs of the stored block being replayed, in place of
+// the ones the transaction coordinator holds after processing a block
+type storedBlockTxCoordinator struct {
+	disabled.TxCoordinator
+	currentBlock *storedBlock
+}
+
+func (sbtc *storedBlockTxCoordinator) setBlock(blk *storedBlock) {
+	sbtc.currentBlock = blk
+}
+
+// GetAllCurrentUsedTxs returns the transactions of the given type executed by the current block
+func (sbtc *storedBlockTxCoordinator) GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler {
+	if sbtc.currentBlock == nil {
+		return make(map[string]data.TransactionHandler)
+	}
+
+	txs, ok := sbtc.currentBlock.txs[blockType]
+	if !ok {
+		return make(map[string]data.TransactionHandler)
+	}
+
+	return txs
+}
+
+// GetAllCurrentLogs returns the logs generated by the current block
+func (sbtc *storedBlockTxCoordinator) GetAllCurrentLogs() []*data.LogData {
+	if sbtc.currentBlock == nil {
+		return make([]*data.LogData, 0)
+	}
+
+	return sbtc.currentBlock.logs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbtc *storedBlockTxCoordinator) IsInterfaceNil() bool {
+	return sbtc == nil
+}
+
+type orderedCollectionHandler interface {
+	common.ExecutionOrderGetter
+	Add(item []byte)
+}
+
+// storedBlockExecutionOrder provides the execution order of the current block. The order is not persisted, so the
+// transactions are ordered as they appear in the block body, followed by the ones from the intra shard mini blocks
+type storedBlockExecutionOrder struct {
+	orderedCollectionHandler
+}
+
+func newStoredBlockExecutionOrder() *storedBlockExecutionOrder {
+	return &storedBlockExecutionOrder{
+		orderedCollectionHandler: ordering.NewOrderedCollection(),
+	}
+}
+
+func (sbeo *storedBlockExecutionOrder) setBlock(blk *storedBlock) {
+	collection := ordering.NewOrderedCollection()
+	for _, txHash := range blk.orderedTxHashes {
+		collection.Add(txHash)
+	}
+
+	sbeo.orderedCollectionHandler = collection
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbeo *storedBlockExecutionOrder) IsInterfaceNil() bool {
+	return sbeo == nil
+}
+
+// storedBlockGasConsumption is used instead of the gas handler, as the gas consumed by a block is not persisted
+type storedBlockGasConsumption struct {
+}
+
+// TotalGasProvided returns 0
+func (sbgc *storedBlockGasConsumption) TotalGasProvided() uint64 {
+	return 0
+}
+
+// TotalGasProvidedWithScheduled returns 0
+func (sbgc *storedBlockGasConsumption) TotalGasProvidedWithScheduled() uint64 {
+	return 0
+}
+
+// TotalGasRefunded returns 0
+func (sbgc *storedBlockGasConsumption) TotalGasRefunded() uint64 {
+	return 0
+}
+
+// TotalGasPenalized returns 0
+func (sbgc *storedBlockGasConsumption) TotalGasPenalized() uint64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbgc *storedBlockGasConsumption) IsInterfaceNil() bool {
+	return sbgc == nil
+}
@@ -106,12 +106,14 @@ type ArgShardProcessor struct {
 // new instances of meta processor
 type ArgMetaProcessor struct {
 	ArgBaseProcessor
-	PendingMiniBlocksHandler     process.PendingMiniBlocksHandler
-	SCToProtocol                 process.SmartContractToProtocolHandler
-	EpochStartDataCreator        process.EpochStartDataCreator
-	EpochEconomics               process.EndOfEpochEconomics
-	EpochRewardsCreator          process.RewardsCreator
-	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
-	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
-	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	PendingMiniBlocksHandler         process.PendingMiniBlocksHandler
+	SCToProtocol                     process.SmartContractToProtocolHandler
+	EpochStartDataCreator            process.EpochStartDataCreator
+	EpochEconomics                   process.EndOfEpochEconomics
+	EpochRewardsCreator              process.RewardsCreator
+	EpochValidatorInfoCreator        process.EpochStartValidatorInfoCreator
+	EpochSystemSCProcessor           process.EpochStartSystemSCProcessor
+	ValidatorStatisticsProcessor     process.ValidatorStatisticsProcessor
+	ScheduledCallsProcessor          process.ScheduledCallsProcessor
+	ValidatorsStatusChangesCollector process.ValidatorsStatusChangesCollector
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/headerVersionData"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	processOutport "github.com/multiversx/mx-chain-go/outport/process"
 	"github.com/multiversx/mx-chain-go/process"
@@ -32,6 +33,8 @@ var _ process.BlockProcessor = (*metaProcessor)(nil)
 type metaProcessor struct {
 	*baseProcessor
 	scToProtocol                 process.SmartContractToProtocolHandler
+	scheduledCallsProcessor      process.ScheduledCallsProcessor
+	statusChangesCollector       process.ValidatorsStatusChangesCollector
 	epochStartDataCreator        process.EpochStartDataCreator
 	epochEconomics               process.EndOfEpochEconomics
 	epochRewardsCreator          process.RewardsCreator
@@ -60,6 +63,12 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.SCToProtocol) {
 		return nil, process.ErrNilSCToProtocol
 	}
+	if check.IfNil(arguments.ScheduledCallsProcessor) {
+		return nil, process.ErrNilScheduledCallsProcessor
+	}
+	if check.IfNil(arguments.ValidatorsStatusChangesCollector) {
+		return nil, process.ErrNilValidatorsStatusChangesCollector
+	}
 	if check.IfNil(arguments.PendingMiniBlocksHandler) {
 		return nil, process.ErrNilPendingMiniBlocksHandler
 	}
@@ -144,6 +153,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		baseProcessor:                base,
 		headersCounter:               NewHeaderCounter(),
 		scToProtocol:                 arguments.SCToProtocol,
+		scheduledCallsProcessor:      arguments.ScheduledCallsProcessor,
+		statusChangesCollector:       arguments.ValidatorsStatusChangesCollector,
 		pendingMiniBlocksHandler:     arguments.PendingMiniBlocksHandler,
 		epochStartDataCreator:        arguments.EpochStartDataCreator,
 		epochEconomics:               arguments.EpochEconomics,
@@ -363,6 +374,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.scheduledCallsProcessor.ProcessDueCalls(header)
+	if err != nil {
+		return err
+	}
+
 	mbIndex := mp.getIndexOfFirstMiniBlockToBeExecuted(header)
 	miniBlocks := body.MiniBlocks[mbIndex:]
 
@@ -427,6 +443,7 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 	if err != nil {
 		return err
 	}
+	mp.statusChangesCollector.SetPreviousStatus(allValidatorsInfo, header.Epoch)
 
 	err = mp.validatorStatisticsProcessor.ProcessRatingsEndOfEpoch(allValidatorsInfo, header.Epoch)
 	if err != nil {
@@ -465,6 +482,13 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.verifyParameterChangesData(header)
+	if err != nil {
+		return err
+	}
+
+	mp.statusChangesCollector.ComputeStatusChanges(allValidatorsInfo, header.Epoch)
+
 	err = mp.validatorInfoCreator.VerifyValidatorInfoMiniBlocks(body.MiniBlocks, allValidatorsInfo)
 	if err != nil {
 		return err
@@ -654,6 +678,7 @@ func (mp *metaProcessor) indexBlock(
 	}
 
 	indexValidatorsRating(mp.outportHandler, mp.validatorStatisticsProcessor, metaBlock)
+	indexValidatorsStatusChanges(mp.outportHandler, mp.statusChangesCollector, metaBlock)
 }
 
 // RestoreBlockIntoPools restores the block into associated pools
@@ -863,6 +888,7 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 	if err != nil {
 		return nil, err
 	}
+	mp.statusChangesCollector.SetPreviousStatus(allValidatorsInfo, metaBlock.Epoch)
 
 	err = mp.validatorStatisticsProcessor.ProcessRatingsEndOfEpoch(allValidatorsInfo, metaBlock.Epoch)
 	if err != nil {
@@ -899,6 +925,13 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		return nil, err
 	}
 
+	metaBlock.Reserved, err = mp.createParameterChangesData()
+	if err != nil {
+		return nil, err
+	}
+
+	mp.statusChangesCollector.ComputeStatusChanges(allValidatorsInfo, metaBlock.Epoch)
+
 	validatorMiniBlocks, err := mp.validatorInfoCreator.CreateValidatorInfoMiniBlocks(allValidatorsInfo)
 	if err != nil {
 		return nil, err
@@ -916,6 +949,32 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 	return &block.Body{MiniBlocks: finalMiniBlocks}, nil
 }
 
+// createParameterChangesData returns the marshalled parameter changes of the passed governance proposals, carried by
+// the start of epoch metablock to all the shards. Nothing is returned while there are no such changes
+func (mp *metaProcessor) createParameterChangesData() ([]byte, error) {
+	changes, err := mp.epochSystemSCProcessor.ParameterChanges()
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	return mp.marshalizer.Marshal(&governance.GovernanceParameterChanges{Changes: changes})
+}
+
+func (mp *metaProcessor) verifyParameterChangesData(header *block.MetaBlock) error {
+	parameterChangesData, err := mp.createParameterChangesData()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(parameterChangesData, header.Reserved) {
+		return process.ErrParameterChangesMismatch
+	}
+
+	return nil
+}
+
 // createBlockBody creates block body of metachain
 func (mp *metaProcessor) createBlockBody(metaBlock data.HeaderHandler, haveTime func() bool) (data.BodyHandler, error) {
 	err := mp.createBlockStarted()
@@ -925,6 +984,11 @@ func (mp *metaProcessor) createBlockBody(metaBlock data.HeaderHandler, haveTime
 
 	mp.blockSizeThrottler.ComputeCurrentMaxSize()
 
+	err = mp.scheduledCallsProcessor.ProcessDueCalls(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("started creating meta block body",
 		"epoch", metaBlock.GetEpoch(),
 		"round", metaBlock.GetRound(),
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dataRetriever/blockchain"
 	"github.com/multiversx/mx-chain-go/process"
@@ -152,14 +153,16 @@ func createMockMetaArguments(
 			ManagedPeersHolder:           &testscommon.ManagedPeersHolderStub{},
 			SentSignaturesTracker:        &testscommon.SentSignatureTrackerStub{},
 		},
-		SCToProtocol:                 &mock.SCToProtocolStub{},
-		PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
-		EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
-		EpochEconomics:               &mock.EpochEconomicsStub{},
-		EpochRewardsCreator:          &testscommon.RewardsCreatorStub{},
-		EpochValidatorInfoCreator:    &testscommon.EpochValidatorInfoCreatorStub{},
-		ValidatorStatisticsProcessor: &testscommon.ValidatorStatisticsProcessorStub{},
-		EpochSystemSCProcessor:       &testscommon.EpochStartSystemSCStub{},
+		SCToProtocol:                     &mock.SCToProtocolStub{},
+		ScheduledCallsProcessor:          &testscommon.ScheduledCallsProcessorStub{},
+		ValidatorsStatusChangesCollector: &testscommon.ValidatorsStatusChangesCollectorStub{},
+		PendingMiniBlocksHandler:         &mock.PendingMiniBlocksHandlerStub{},
+		EpochStartDataCreator:            &mock.EpochStartDataCreatorStub{},
+		EpochEconomics:                   &mock.EpochEconomicsStub{},
+		EpochRewardsCreator:              &testscommon.RewardsCreatorStub{},
+		EpochValidatorInfoCreator:        &testscommon.EpochValidatorInfoCreatorStub{},
+		ValidatorStatisticsProcessor:     &testscommon.ValidatorStatisticsProcessorStub{},
+		EpochSystemSCProcessor:           &testscommon.EpochStartSystemSCStub{},
 	}
 	return arguments
 }
@@ -306,6 +309,30 @@ func TestNewMetaProcessor_NilHeadersDataPoolShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilScheduledCallsProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createMockComponentHolders()
+	arguments := createMockMetaArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.ScheduledCallsProcessor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilScheduledCallsProcessor, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilValidatorsStatusChangesCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createMockComponentHolders()
+	arguments := createMockMetaArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.ValidatorsStatusChangesCollector = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilValidatorsStatusChangesCollector, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_NilSCToProtocolShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -2501,6 +2528,27 @@ func TestMetaProcessor_CreateMiniBlocksNoTimeShouldReturnEmptyBody(t *testing.T)
 	assert.Equal(t, &block.Body{}, bodyHandler)
 }
 
+func TestMetaProcessor_CreateBlockBodyShouldProcessDueScheduledCalls(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arguments := createMockMetaArguments(createMockComponentHolders())
+	var processedHeader data.HeaderHandler
+	arguments.ScheduledCallsProcessor = &testscommon.ScheduledCallsProcessorStub{
+		ProcessDueCallsCalled: func(header data.HeaderHandler) error {
+			processedHeader = header
+			return expectedErr
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	metaHdr := &block.MetaBlock{Round: 10}
+
+	bodyHandler, err := mp.CreateBlockBody(metaHdr, func() bool { return true })
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, bodyHandler)
+	assert.Equal(t, metaHdr, processedHeader)
+}
+
 func TestMetaProcessor_CreateMiniBlocksDestMe(t *testing.T) {
 	t.Parallel()
 
@@ -3235,6 +3283,33 @@ func TestMetaProcessor_ProcessEpochStartMetaBlock(t *testing.T) {
 		err := mp.ProcessEpochStartMetaBlock(headerMeta, &block.Body{})
 		assert.Nil(t, err)
 	})
+
+	t.Run("parameter changes mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreC, dataC, bootstrapC, statusC := createMockComponentHolders()
+		arguments := createMockMetaArguments(coreC, dataC, bootstrapC, statusC)
+		arguments.EpochSystemSCProcessor = &testscommon.EpochStartSystemSCStub{
+			ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+				return []*governance.ParameterChange{
+					{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "1000", ActivationEpoch: 2},
+				}, nil
+			},
+		}
+
+		mp, _ := blproc.NewMetaProcessor(arguments)
+
+		header := &block.MetaBlock{
+			Nonce:           1,
+			Round:           1,
+			PrevHash:        []byte("hash1"),
+			AccumulatedFees: big.NewInt(0),
+			DeveloperFees:   big.NewInt(0),
+			Reserved:        []byte("other parameter changes"),
+		}
+		err := mp.ProcessEpochStartMetaBlock(header, &block.Body{})
+		assert.Equal(t, process.ErrParameterChangesMismatch, err)
+	})
 }
 
 func TestMetaProcessor_UpdateEpochStartHeader(t *testing.T) {
@@ -242,6 +242,26 @@ func indexValidatorsRating(
 	}
 }
 
+func indexValidatorsStatusChanges(
+	outportHandler outport.OutportHandler,
+	statusChangesCollector process.ValidatorsStatusChangesCollector,
+	metaBlock data.HeaderHandler,
+) {
+	if !metaBlock.IsStartOfEpochBlock() {
+		return
+	}
+
+	changes := statusChangesCollector.GetStatusChanges(metaBlock.GetEpoch())
+	if len(changes) == 0 {
+		return
+	}
+
+	outportHandler.SaveValidatorsStatusChanges(&common.ValidatorsStatusChanges{
+		Epoch:   metaBlock.GetEpoch(),
+		Changes: changes,
+	})
+}
+
 func calculateRoundDuration(
 	lastBlockTimestamp uint64,
 	currentBlockTimestamp uint64,
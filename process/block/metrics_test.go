@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -78,3 +80,60 @@ func TestMetrics_IncrementMetricCountConsensusAcceptedBlocks(t *testing.T) {
 		assert.Equal(t, 2, cntIncrement) // main key + managed key
 	})
 }
+
+func TestMetrics_IndexValidatorsStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := []*common.ValidatorStatusChange{
+		{BlsKey: "aa", OldList: string(common.EligibleList), NewList: string(common.JailedList), Reason: common.ValidatorJailed, Epoch: 4},
+	}
+	statusChangesCollector := &testscommon.ValidatorsStatusChangesCollectorStub{
+		GetStatusChangesCalled: func(epoch uint32) []*common.ValidatorStatusChange {
+			if epoch == 4 {
+				return changes
+			}
+			return nil
+		},
+	}
+
+	t.Run("not a start of epoch block should not save", func(t *testing.T) {
+		t.Parallel()
+
+		outportHandler := &outportStub.OutportStub{
+			SaveValidatorsStatusChangesCalled: func(statusChanges *common.ValidatorsStatusChanges) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		indexValidatorsStatusChanges(outportHandler, statusChangesCollector, &block.MetaBlock{Epoch: 4})
+	})
+	t.Run("no changes should not save", func(t *testing.T) {
+		t.Parallel()
+
+		outportHandler := &outportStub.OutportStub{
+			SaveValidatorsStatusChangesCalled: func(statusChanges *common.ValidatorsStatusChanges) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		metaBlock := &block.MetaBlock{
+			Epoch:      5,
+			EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		}
+		indexValidatorsStatusChanges(outportHandler, statusChangesCollector, metaBlock)
+	})
+	t.Run("should save the changes of the epoch", func(t *testing.T) {
+		t.Parallel()
+
+		var saved *common.ValidatorsStatusChanges
+		outportHandler := &outportStub.OutportStub{
+			SaveValidatorsStatusChangesCalled: func(statusChanges *common.ValidatorsStatusChanges) {
+				saved = statusChanges
+			},
+		}
+		metaBlock := &block.MetaBlock{
+			Epoch:      4,
+			EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		}
+		indexValidatorsStatusChanges(outportHandler, statusChangesCollector, metaBlock)
+		assert.Equal(t, &common.ValidatorsStatusChanges{Epoch: 4, Changes: changes}, saved)
+	})
+}
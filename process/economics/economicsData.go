@@ -11,6 +11,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/statusHandler"
@@ -27,14 +29,15 @@ var log = logger.GetOrCreate("process/economics")
 type economicsData struct {
 	*gasConfigHandler
 	*rewardsConfigHandler
-	gasPriceModifier    float64
-	minInflation        float64
-	yearSettings        map[uint32]*config.YearSetting
-	mutYearSettings     sync.RWMutex
-	statusHandler       core.AppStatusHandler
-	enableEpochsHandler common.EnableEpochsHandler
-	txVersionHandler    process.TxVersionCheckerHandler
-	mut                 sync.RWMutex
+	gasPriceModifier         float64
+	minInflation             float64
+	yearSettings             map[uint32]*config.YearSetting
+	mutYearSettings          sync.RWMutex
+	statusHandler            core.AppStatusHandler
+	enableEpochsHandler      common.EnableEpochsHandler
+	txVersionHandler         process.TxVersionCheckerHandler
+	parameterChangesProvider governance.ParameterChangesProvider
+	mut                      sync.RWMutex
 }
 
 // ArgsNewEconomicsData defines the arguments needed for new economics economicsData
@@ -70,11 +73,12 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 	}
 
 	ed := &economicsData{
-		minInflation:        args.Economics.GlobalSettings.MinimumInflation,
-		gasPriceModifier:    args.Economics.FeeSettings.GasPriceModifier,
-		statusHandler:       statusHandler.NewNilStatusHandler(),
-		enableEpochsHandler: args.EnableEpochsHandler,
-		txVersionHandler:    args.TxVersionChecker,
+		minInflation:             args.Economics.GlobalSettings.MinimumInflation,
+		gasPriceModifier:         args.Economics.FeeSettings.GasPriceModifier,
+		statusHandler:            statusHandler.NewNilStatusHandler(),
+		enableEpochsHandler:      args.EnableEpochsHandler,
+		txVersionHandler:         args.TxVersionChecker,
+		parameterChangesProvider: disabled.NewParameterChangesProvider(),
 	}
 
 	ed.yearSettings = make(map[uint32]*config.YearSetting)
@@ -137,6 +141,46 @@ func (ed *economicsData) SetStatusHandler(statusHandler core.AppStatusHandler) e
 	return ed.rewardsConfigHandler.setStatusHandler(statusHandler)
 }
 
+// SetParameterChangesProvider will set the provider of the parameter changes voted through governance, will apply
+// the economics changes it holds and will apply again the ones it receives later on
+func (ed *economicsData) SetParameterChangesProvider(provider governance.ParameterChangesProvider) error {
+	if check.IfNil(provider) {
+		return governance.ErrNilParameterChangesProvider
+	}
+	ed.mut.Lock()
+	ed.parameterChangesProvider = provider
+	ed.mut.Unlock()
+
+	err := ed.applyParameterChanges()
+	if err != nil {
+		return err
+	}
+
+	provider.RegisterHandler(ed)
+
+	return nil
+}
+
+// ParameterChangesUpdated applies the economics changes received by the parameter changes provider
+func (ed *economicsData) ParameterChangesUpdated() error {
+	return ed.applyParameterChanges()
+}
+
+func (ed *economicsData) applyParameterChanges() error {
+	ed.mut.RLock()
+	provider := ed.parameterChangesProvider
+	ed.mut.RUnlock()
+
+	changes, err := provider.ParameterChanges()
+	if err != nil {
+		return err
+	}
+
+	ed.gasConfigHandler.applyParameterChanges(changes)
+
+	return nil
+}
+
 // LeaderPercentage returns leader reward percentage
 func (ed *economicsData) LeaderPercentage() float64 {
 	currentEpoch := ed.enableEpochsHandler.GetCurrentEpoch()
@@ -427,6 +471,12 @@ func (ed *economicsData) MaxGasLimitPerTxInEpoch(epoch uint32) uint64 {
 	return ed.getMaxGasLimitPerTx(epoch)
 }
 
+// ConfiguredGasLimitsInEpoch returns the gas limits configured for a specific epoch, without the changes voted through
+// governance
+func (ed *economicsData) ConfiguredGasLimitsInEpoch(epoch uint32) governance.GasLimits {
+	return ed.getConfiguredGasLimits(epoch)
+}
+
 // DeveloperPercentage returns the developer percentage value
 func (ed *economicsData) DeveloperPercentage() float64 {
 	currentEpoch := ed.enableEpochsHandler.GetCurrentEpoch()
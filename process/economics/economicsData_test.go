@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/enablers"
 	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/economics"
@@ -1621,3 +1622,122 @@ func TestEconomicsData_RewardsTopUpFactor(t *testing.T) {
 	value := economicsData.RewardsTopUpFactor()
 	assert.Equal(t, topUpFactor, value)
 }
+
+func TestEconomicsData_SetParameterChangesProvider(t *testing.T) {
+	t.Parallel()
+
+	gls := []config.GasLimitSetting{
+		{
+			EnableEpoch:                 0,
+			MaxGasLimitPerBlock:         "1500000000",
+			MaxGasLimitPerMiniBlock:     "1500000000",
+			MaxGasLimitPerMetaBlock:     "15000000000",
+			MaxGasLimitPerMetaMiniBlock: "15000000000",
+			MaxGasLimitPerTx:            "1500000000",
+			MinGasLimit:                 "50000",
+			ExtraGasLimitGuardedTx:      "50000",
+		},
+		{
+			EnableEpoch:                 10,
+			MaxGasLimitPerBlock:         "1500000000",
+			MaxGasLimitPerMiniBlock:     "500000000",
+			MaxGasLimitPerMetaBlock:     "15000000000",
+			MaxGasLimitPerMetaMiniBlock: "5000000000",
+			MaxGasLimitPerTx:            "500000000",
+			MinGasLimit:                 "50000",
+			ExtraGasLimitGuardedTx:      "50000",
+		},
+	}
+
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		economicsData, _ := economics.NewEconomicsData(createArgsForEconomicsData(1))
+		err := economicsData.SetParameterChangesProvider(nil)
+		require.Equal(t, governance.ErrNilParameterChangesProvider, err)
+	})
+	t.Run("provider error should error and keep the configured settings", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsForEconomicsData(1)
+		args.Economics.FeeSettings.GasLimitSettings = gls
+		economicsData, _ := economics.NewEconomicsData(args)
+
+		expectedErr := errors.New("expected error")
+		err := economicsData.SetParameterChangesProvider(&testscommon.ParameterChangesProviderStub{
+			ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+				return nil, expectedErr
+			},
+			RegisterHandlerCalled: func(handler governance.ParameterChangesHandler) {
+				require.Fail(t, "should have not registered")
+			},
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, gls[0], *economicsData.GetGasLimitSetting(5))
+		require.Equal(t, gls[1], *economicsData.GetGasLimitSetting(10))
+	})
+	t.Run("invalid resulting settings should skip only the offending proposal", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsForEconomicsData(1)
+		args.Economics.FeeSettings.GasLimitSettings = gls
+		economicsData, _ := economics.NewEconomicsData(args)
+
+		err := economicsData.SetParameterChangesProvider(&testscommon.ParameterChangesProviderStub{
+			ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+				return []*governance.ParameterChange{
+					{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "100", ActivationEpoch: 5},
+					{ProposalNonce: 1, Name: governance.MinGasLimit, Value: "60000", ActivationEpoch: 5},
+					{ProposalNonce: 2, Name: governance.MaxGasLimitPerTx, Value: "1000000000", ActivationEpoch: 6},
+				}, nil
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, gls[0], *economicsData.GetGasLimitSetting(5))
+		require.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerTxInEpoch(5))
+		require.Equal(t, uint64(50000), economicsData.MinGasLimitInEpoch(5))
+		require.Equal(t, uint64(1000000000), economicsData.MaxGasLimitPerTxInEpoch(6))
+
+		configuredGasLimits := economicsData.ConfiguredGasLimitsInEpoch(6)
+		require.Equal(t, uint64(1500000000), configuredGasLimits.MaxGasLimitPerTx)
+		require.Equal(t, uint64(50000), configuredGasLimits.MinGasLimit)
+	})
+	t.Run("should apply the changes starting with their activation epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsForEconomicsData(1)
+		args.Economics.FeeSettings.GasLimitSettings = gls
+		economicsData, _ := economics.NewEconomicsData(args)
+
+		changes := make([]*governance.ParameterChange, 0)
+		var registeredHandler governance.ParameterChangesHandler
+		err := economicsData.SetParameterChangesProvider(&testscommon.ParameterChangesProviderStub{
+			ParameterChangesCalled: func() ([]*governance.ParameterChange, error) {
+				return changes, nil
+			},
+			RegisterHandlerCalled: func(handler governance.ParameterChangesHandler) {
+				registeredHandler = handler
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, economicsData, registeredHandler)
+		require.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerTxInEpoch(5))
+
+		changes = []*governance.ParameterChange{
+			{ProposalNonce: 2, Name: governance.MaxGasLimitPerTx, Value: "700000000", ActivationEpoch: 12},
+			{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "1000000000", ActivationEpoch: 5},
+			{ProposalNonce: 1, Name: governance.MinGasLimit, Value: "60000", ActivationEpoch: 5},
+		}
+		err = registeredHandler.ParameterChangesUpdated()
+		require.Nil(t, err)
+
+		require.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerTxInEpoch(4))
+		require.Equal(t, uint64(50000), economicsData.MinGasLimitInEpoch(4))
+		require.Equal(t, uint64(1000000000), economicsData.MaxGasLimitPerTxInEpoch(5))
+		require.Equal(t, uint64(60000), economicsData.MinGasLimitInEpoch(5))
+		require.Equal(t, uint64(1000000000), economicsData.MaxGasLimitPerTxInEpoch(10))
+		require.Equal(t, uint64(500000000), economicsData.MaxGasLimitPerMiniBlockInEpoch(0, 10))
+		require.Equal(t, uint64(60000), economicsData.MinGasLimitInEpoch(10))
+		require.Equal(t, uint64(700000000), economicsData.MaxGasLimitPerTxInEpoch(12))
+	})
+}
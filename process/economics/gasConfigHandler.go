@@ -2,6 +2,7 @@ package economics
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/statusHandler"
@@ -30,6 +32,7 @@ type gasConfig struct {
 
 type gasConfigHandler struct {
 	statusHandler          core.AppStatusHandler
+	baseGasLimitSettings   []*gasConfig
 	gasLimitSettings       []*gasConfig
 	minGasPrice            uint64
 	gasPerDataByte         uint64
@@ -56,6 +59,7 @@ func newGasConfigHandler(economics *config.EconomicsConfig) (*gasConfigHandler,
 
 	return &gasConfigHandler{
 		statusHandler:          statusHandler.NewNilStatusHandler(),
+		baseGasLimitSettings:   gasConfigSlice,
 		gasLimitSettings:       gasConfigSlice,
 		minGasPrice:            minGasPrice,
 		gasPerDataByte:         gasPerDataByte,
@@ -150,16 +154,158 @@ func (handler *gasConfigHandler) updateGasConfigMetrics(epoch uint32) {
 }
 
 func (handler *gasConfigHandler) getGasConfigForEpoch(epoch uint32) *gasConfig {
-	gasConfigSetting := handler.gasLimitSettings[0]
-	for i := 1; i < len(handler.gasLimitSettings); i++ {
-		if epoch >= handler.gasLimitSettings[i].gasLimitSettingEpoch {
-			gasConfigSetting = handler.gasLimitSettings[i]
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	return getGasConfigForEpoch(handler.gasLimitSettings, epoch)
+}
+
+func getGasConfigForEpoch(gasLimitSettings []*gasConfig, epoch uint32) *gasConfig {
+	gasConfigSetting := gasLimitSettings[0]
+	for i := 1; i < len(gasLimitSettings); i++ {
+		if epoch >= gasLimitSettings[i].gasLimitSettingEpoch {
+			gasConfigSetting = gasLimitSettings[i]
 		}
 	}
 
 	return gasConfigSetting
 }
 
+// applyParameterChanges rebuilds the gas limit settings from the configured ones, overriding the values changed
+// through governance starting with their activation epochs. The changes of a proposal that would leave the settings in
+// an invalid state are skipped, the other ones being applied
+func (handler *gasConfigHandler) applyParameterChanges(changes []*governance.ParameterChange) {
+	gasLimitSettings := copyGasLimitSettings(handler.baseGasLimitSettings)
+	for _, proposalChanges := range groupByProposal(governance.ActiveChanges(changes, governance.EconomicsPrefix, math.MaxUint32)) {
+		newGasLimitSettings, err := applyProposalChanges(gasLimitSettings, proposalChanges)
+		if err != nil {
+			log.Warn("gasConfigHandler.applyParameterChanges: skipping the economics changes of a governance proposal",
+				"proposal nonce", proposalChanges[0].ProposalNonce,
+				"activation epoch", proposalChanges[0].ActivationEpoch,
+				"error", err)
+			continue
+		}
+
+		gasLimitSettings = newGasLimitSettings
+	}
+
+	handler.mut.Lock()
+	handler.gasLimitSettings = gasLimitSettings
+	handler.mut.Unlock()
+}
+
+// groupByProposal splits the provided changes, sorted by activation epoch and proposal nonce, in the changes of each
+// proposal
+func groupByProposal(changes []*governance.ParameterChange) [][]*governance.ParameterChange {
+	groups := make([][]*governance.ParameterChange, 0)
+	for i, change := range changes {
+		isSameProposal := i > 0 && changes[i-1].ProposalNonce == change.ProposalNonce &&
+			changes[i-1].ActivationEpoch == change.ActivationEpoch
+		if isSameProposal {
+			groups[len(groups)-1] = append(groups[len(groups)-1], change)
+			continue
+		}
+
+		groups = append(groups, []*governance.ParameterChange{change})
+	}
+
+	return groups
+}
+
+func applyProposalChanges(gasLimitSettings []*gasConfig, changes []*governance.ParameterChange) ([]*gasConfig, error) {
+	gasLimitSettings = copyGasLimitSettings(gasLimitSettings)
+	for _, change := range changes {
+		value, err := governance.ParseUint64Value(change.Name, change.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		gasLimitSettings = addGasConfigForEpoch(gasLimitSettings, change.ActivationEpoch)
+		for _, gc := range gasLimitSettings {
+			if gc.gasLimitSettingEpoch < change.ActivationEpoch {
+				continue
+			}
+
+			err = setGasConfigValue(gc, change.Name, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, gc := range gasLimitSettings {
+		err := checkGasConfigLimits(gc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gasLimitSettings, nil
+}
+
+func copyGasLimitSettings(gasLimitSettings []*gasConfig) []*gasConfig {
+	result := make([]*gasConfig, 0, len(gasLimitSettings))
+	for _, gc := range gasLimitSettings {
+		gcCopy := *gc
+		result = append(result, &gcCopy)
+	}
+
+	return result
+}
+
+func (handler *gasConfigHandler) getConfiguredGasLimits(epoch uint32) governance.GasLimits {
+	gc := getGasConfigForEpoch(handler.baseGasLimitSettings, epoch)
+
+	return governance.GasLimits{
+		MinGasLimit:                 gc.minGasLimit,
+		MaxGasLimitPerBlock:         gc.maxGasLimitPerBlock,
+		MaxGasLimitPerMiniBlock:     gc.maxGasLimitPerMiniBlock,
+		MaxGasLimitPerMetaBlock:     gc.maxGasLimitPerMetaBlock,
+		MaxGasLimitPerMetaMiniBlock: gc.maxGasLimitPerMetaMiniBlock,
+		MaxGasLimitPerTx:            gc.maxGasLimitPerTx,
+	}
+}
+
+func addGasConfigForEpoch(gasLimitSettings []*gasConfig, epoch uint32) []*gasConfig {
+	for _, gc := range gasLimitSettings {
+		if gc.gasLimitSettingEpoch == epoch {
+			return gasLimitSettings
+		}
+	}
+
+	newGasConfig := *getGasConfigForEpoch(gasLimitSettings, epoch)
+	newGasConfig.gasLimitSettingEpoch = epoch
+	gasLimitSettings = append(gasLimitSettings, &newGasConfig)
+	sort.Slice(gasLimitSettings, func(i, j int) bool {
+		return gasLimitSettings[i].gasLimitSettingEpoch < gasLimitSettings[j].gasLimitSettingEpoch
+	})
+
+	return gasLimitSettings
+}
+
+func setGasConfigValue(gc *gasConfig, name string, value uint64) error {
+	switch name {
+	case governance.MinGasLimit:
+		gc.minGasLimit = value
+	case governance.ExtraGasLimitGuardedTx:
+		gc.extraGasLimitGuardedTx = value
+	case governance.MaxGasLimitPerBlock:
+		gc.maxGasLimitPerBlock = value
+	case governance.MaxGasLimitPerMiniBlock:
+		gc.maxGasLimitPerMiniBlock = value
+	case governance.MaxGasLimitPerMetaBlock:
+		gc.maxGasLimitPerMetaBlock = value
+	case governance.MaxGasLimitPerMetaMiniBlock:
+		gc.maxGasLimitPerMetaMiniBlock = value
+	case governance.MaxGasLimitPerTx:
+		gc.maxGasLimitPerTx = value
+	default:
+		return fmt.Errorf("%w: %s", governance.ErrUnknownParameter, name)
+	}
+
+	return nil
+}
+
 func checkAndParseFeeSettings(feeSettings config.FeeSettings) ([]*gasConfig, error) {
 	if feeSettings.GasPriceModifier > 1.0 || feeSettings.GasPriceModifier < epsilon {
 		return nil, process.ErrInvalidGasModifier
@@ -225,23 +371,32 @@ func checkAndParseGasLimitSettings(gasLimitSetting config.GasLimitSetting) (*gas
 		return nil, fmt.Errorf("%w for epoch %d", process.ErrInvalidExtraGasLimitGuardedTx, gasLimitSetting.EnableEpoch)
 	}
 
+	err = checkGasConfigLimits(gc)
+	if err != nil {
+		return nil, err
+	}
+
+	return gc, nil
+}
+
+func checkGasConfigLimits(gc *gasConfig) error {
 	if gc.maxGasLimitPerBlock < gc.minGasLimit {
-		return nil, fmt.Errorf("%w: maxGasLimitPerBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerBlock, gc.maxGasLimitPerBlock, gc.minGasLimit, gasLimitSetting.EnableEpoch)
+		return fmt.Errorf("%w: maxGasLimitPerBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerBlock, gc.maxGasLimitPerBlock, gc.minGasLimit, gc.gasLimitSettingEpoch)
 	}
 	if gc.maxGasLimitPerMiniBlock < gc.minGasLimit {
-		return nil, fmt.Errorf("%w: maxGasLimitPerMiniBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerMiniBlock, gc.maxGasLimitPerMiniBlock, gc.minGasLimit, gasLimitSetting.EnableEpoch)
+		return fmt.Errorf("%w: maxGasLimitPerMiniBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerMiniBlock, gc.maxGasLimitPerMiniBlock, gc.minGasLimit, gc.gasLimitSettingEpoch)
 	}
 	if gc.maxGasLimitPerMetaBlock < gc.minGasLimit {
-		return nil, fmt.Errorf("%w: maxGasLimitPerMetaBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerMetaBlock, gc.maxGasLimitPerMetaBlock, gc.minGasLimit, gasLimitSetting.EnableEpoch)
+		return fmt.Errorf("%w: maxGasLimitPerMetaBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerMetaBlock, gc.maxGasLimitPerMetaBlock, gc.minGasLimit, gc.gasLimitSettingEpoch)
 	}
 	if gc.maxGasLimitPerMetaMiniBlock < gc.minGasLimit {
-		return nil, fmt.Errorf("%w: maxGasLimitPerMetaMiniBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerMetaMiniBlock, gc.maxGasLimitPerMetaMiniBlock, gc.minGasLimit, gasLimitSetting.EnableEpoch)
+		return fmt.Errorf("%w: maxGasLimitPerMetaMiniBlock = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerMetaMiniBlock, gc.maxGasLimitPerMetaMiniBlock, gc.minGasLimit, gc.gasLimitSettingEpoch)
 	}
 	if gc.maxGasLimitPerTx < gc.minGasLimit {
-		return nil, fmt.Errorf("%w: maxGasLimitPerTx = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerTx, gc.maxGasLimitPerTx, gc.minGasLimit, gasLimitSetting.EnableEpoch)
+		return fmt.Errorf("%w: maxGasLimitPerTx = %d minGasLimit = %d in epoch %d", process.ErrInvalidMaxGasLimitPerTx, gc.maxGasLimitPerTx, gc.minGasLimit, gc.gasLimitSettingEpoch)
 	}
 
-	return gc, nil
+	return nil
 }
 
 func convertGenericValues(economics *config.EconomicsConfig) (uint64, uint64, *big.Int, uint64, error) {
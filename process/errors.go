@@ -1226,3 +1226,85 @@ var ErrInvalidAsyncArguments = errors.New("invalid arguments to process async/ca
 
 // ErrNilSentSignatureTracker defines the error for setting a nil SentSignatureTracker
 var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
+
+// ErrNilPeerHonestyScorer signals that a nil peer honesty scorer has been provided
+var ErrNilPeerHonestyScorer = errors.New("nil peer honesty scorer")
+
+// ErrNilPeerHonestyScoresHandler signals that a nil peer honesty scores handler has been provided
+var ErrNilPeerHonestyScoresHandler = errors.New("nil peer honesty scores handler")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNilPeerAddressesProvider signals that a nil peer addresses provider has been provided
+var ErrNilPeerAddressesProvider = errors.New("nil peer addresses provider")
+
+// ErrInvalidIPRange signals that an invalid IP range has been provided
+var ErrInvalidIPRange = errors.New("invalid IP range")
+
+// ErrPeerIsPinned signals that a pinned peer can not be banned
+var ErrPeerIsPinned = errors.New("peer is pinned")
+
+// ErrEmptyPublicKey signals that an empty public key has been provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrNilPeerDenialEvaluator signals that a nil peer denial evaluator has been provided
+var ErrNilPeerDenialEvaluator = errors.New("nil peer denial evaluator")
+
+// ErrNilInterceptedDataScheduler signals that a nil intercepted data scheduler has been provided
+var ErrNilInterceptedDataScheduler = errors.New("nil intercepted data scheduler")
+
+// ErrNilInterceptorPriorityLanes signals that a nil interceptor priority lanes handler has been provided
+var ErrNilInterceptorPriorityLanes = errors.New("nil interceptor priority lanes handler")
+
+// ErrInvalidPriorityLaneConfig signals that an invalid priority lane configuration has been provided
+var ErrInvalidPriorityLaneConfig = errors.New("invalid priority lane config")
+
+// ErrPriorityLanesClosed signals that the priority lanes were closed
+var ErrPriorityLanesClosed = errors.New("priority lanes closed")
+
+// ErrNilAuctionSimulationArgs signals that nil auction simulation arguments have been provided
+var ErrNilAuctionSimulationArgs = errors.New("nil auction simulation arguments")
+
+// ErrTooManySimulatedAuctionNodes signals that too many additional nodes were requested for an auction simulation
+var ErrTooManySimulatedAuctionNodes = errors.New("too many simulated auction nodes")
+
+// ErrTooManyScorecardEpochs signals that too many epochs were requested for a validator scorecard
+var ErrTooManyScorecardEpochs = errors.New("too many scorecard epochs")
+
+// ErrBuiltInFunctionCalledWithValue signals that a built-in function which does not accept value was called with value
+var ErrBuiltInFunctionCalledWithValue = errors.New("built-in function called with value")
+
+// ErrCallerIsNotESDTSystemSC signals that a built-in function reserved to the ESDT system SC was called by another address
+var ErrCallerIsNotESDTSystemSC = errors.New("caller is not the ESDT system SC")
+
+// ErrOnlySystemAccountAccepted signals that a built-in function can be executed only on the system account
+var ErrOnlySystemAccountAccepted = errors.New("only the system account is accepted")
+
+// ErrAddressNotInESDTAllowList signals that an ESDT transfer involves an address missing from the token allow list
+var ErrAddressNotInESDTAllowList = errors.New("address is not in the token allow list")
+
+// ErrAddressInESDTDenyList signals that an ESDT transfer involves an address from the token deny list
+var ErrAddressInESDTDenyList = errors.New("address is in the token deny list")
+
+// ErrESDTTransferLimitExceeded signals that an ESDT transfer exceeds the per epoch transfer limit of the sender
+var ErrESDTTransferLimitExceeded = errors.New("token transfer limit for the current epoch exceeded")
+
+// ErrNilESDTComplianceChecker signals that a nil ESDT compliance checker has been provided
+var ErrNilESDTComplianceChecker = errors.New("nil ESDT compliance checker")
+
+// ErrNilSystemVM signals that a nil system VM has been provided
+var ErrNilSystemVM = errors.New("nil system VM")
+
+// ErrNilScheduledCallsProcessor signals that a nil scheduled calls processor has been provided
+var ErrNilScheduledCallsProcessor = errors.New("nil scheduled calls processor")
+
+// ErrNilValidatorsStatusChangesCollector signals that a nil validators status changes collector has been provided
+var ErrNilValidatorsStatusChangesCollector = errors.New("nil validators status changes collector")
+
+// ErrScheduledCallsExecutionFailed signals that the due scheduled calls could not be executed
+var ErrScheduledCallsExecutionFailed = errors.New("scheduled calls execution failed")
+
+// ErrParameterChangesMismatch signals that the governance parameter changes carried by a start of epoch metablock do not
+// match the ones recorded by the governance system smart contract
+var ErrParameterChangesMismatch = errors.New("governance parameter changes mismatch")
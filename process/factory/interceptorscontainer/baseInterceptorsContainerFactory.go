@@ -23,7 +23,6 @@ import (
 )
 
 const (
-	numGoRoutines                   = 100
 	chunksProcessorRequestInterval  = time.Millisecond * 400
 	minTimespanDurationInSec        = int64(1)
 	errorOnMainNetworkString        = "on main network"
@@ -42,7 +41,7 @@ type baseInterceptorsContainerFactory struct {
 	nodesCoordinator           nodesCoordinator.NodesCoordinator
 	blockBlackList             process.TimeCacher
 	argInterceptorFactory      *interceptorFactory.ArgInterceptedDataFactory
-	globalThrottler            process.InterceptorThrottler
+	priorityLanes              process.InterceptorPriorityLanesHandler
 	maxTxNonceDeltaAllowed     int
 	antifloodHandler           process.P2PAntifloodHandler
 	whiteListHandler           process.WhiteListHandler
@@ -292,7 +291,8 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 			Marshalizer:          internalMarshaller,
 			DataFactory:          txFactory,
 			Processor:            txProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(topic),
+			Scheduler:            bicf.priorityLanes.Scheduler(topic),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -335,7 +335,8 @@ func (bicf *baseInterceptorsContainerFactory) createOneUnsignedTxInterceptor(top
 			Marshalizer:          internalMarshaller,
 			DataFactory:          txFactory,
 			Processor:            txProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(topic),
+			Scheduler:            bicf.priorityLanes.Scheduler(topic),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -378,7 +379,8 @@ func (bicf *baseInterceptorsContainerFactory) createOneRewardTxInterceptor(topic
 			Marshalizer:          internalMarshaller,
 			DataFactory:          txFactory,
 			Processor:            txProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(topic),
+			Scheduler:            bicf.priorityLanes.Scheduler(topic),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -420,7 +422,8 @@ func (bicf *baseInterceptorsContainerFactory) generateHeaderInterceptors() error
 			Topic:                identifierHdr,
 			DataFactory:          hdrFactory,
 			Processor:            hdrProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(identifierHdr),
+			Scheduler:            bicf.priorityLanes.Scheduler(identifierHdr),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -508,7 +511,8 @@ func (bicf *baseInterceptorsContainerFactory) createOneMiniBlocksInterceptor(top
 			Marshalizer:          internalMarshaller,
 			DataFactory:          miniblockFactory,
 			Processor:            miniblockProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(topic),
+			Scheduler:            bicf.priorityLanes.Scheduler(topic),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -547,7 +551,8 @@ func (bicf *baseInterceptorsContainerFactory) generateMetachainHeaderInterceptor
 			Topic:                identifierHdr,
 			DataFactory:          hdrFactory,
 			Processor:            hdrProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(identifierHdr),
+			Scheduler:            bicf.priorityLanes.Scheduler(identifierHdr),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -584,7 +589,8 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 			Marshalizer:          internalMarshaller,
 			DataFactory:          trieNodesFactory,
 			Processor:            trieNodesProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(topic),
+			Scheduler:            bicf.priorityLanes.Scheduler(topic),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -675,7 +681,8 @@ func (bicf *baseInterceptorsContainerFactory) generatePeerAuthenticationIntercep
 			Marshalizer:          internalMarshaller,
 			DataFactory:          peerAuthenticationFactory,
 			Processor:            peerAuthenticationProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(identifierPeerAuthentication),
+			Scheduler:            bicf.priorityLanes.Scheduler(identifierPeerAuthentication),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			PreferredPeersHolder: bicf.preferredPeersHolder,
@@ -733,7 +740,8 @@ func (bicf *baseInterceptorsContainerFactory) createHeartbeatV2Interceptor(
 			Topic:                identifier,
 			DataFactory:          heartbeatFactory,
 			Processor:            heartbeatProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(identifier),
+			Scheduler:            bicf.priorityLanes.Scheduler(identifier),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			PreferredPeersHolder: bicf.preferredPeersHolder,
@@ -782,7 +790,8 @@ func (bicf *baseInterceptorsContainerFactory) createPeerShardInterceptor(
 			Topic:                identifier,
 			DataFactory:          interceptedPeerShardFactory,
 			Processor:            psiProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(identifier),
+			Scheduler:            bicf.priorityLanes.Scheduler(identifier),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.mainMessenger.ID(),
@@ -820,7 +829,8 @@ func (bicf *baseInterceptorsContainerFactory) generateValidatorInfoInterceptor()
 			Marshalizer:          internalMarshaller,
 			DataFactory:          interceptedValidatorInfoFactory,
 			Processor:            validatorInfoProcessor,
-			Throttler:            bicf.globalThrottler,
+			Throttler:            bicf.priorityLanes.Throttler(identifier),
+			Scheduler:            bicf.priorityLanes.Scheduler(identifier),
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
 			PreferredPeersHolder: bicf.preferredPeersHolder,
@@ -3,7 +3,6 @@ package interceptorscontainer
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
@@ -79,6 +78,9 @@ func NewMetaInterceptorsContainerFactory(
 	if check.IfNil(args.PeerSignatureHandler) {
 		return nil, process.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(args.PriorityLanes) {
+		return nil, process.ErrNilInterceptorPriorityLanes
+	}
 	if args.HeartbeatExpiryTimespanInSec < minTimespanDurationInSec {
 		return nil, process.ErrInvalidExpiryTimespan
 	}
@@ -124,17 +126,13 @@ func NewMetaInterceptorsContainerFactory(
 		fullArchivePeerShardMapper: args.FullArchivePeerShardMapper,
 		hardforkTrigger:            args.HardforkTrigger,
 		nodeOperationMode:          args.NodeOperationMode,
+		priorityLanes:              args.PriorityLanes,
 	}
 
 	icf := &metaInterceptorsContainerFactory{
 		baseInterceptorsContainerFactory: base,
 	}
 
-	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
-	if err != nil {
-		return nil, err
-	}
-
 	return icf, nil
 }
 
@@ -266,7 +264,8 @@ func (micf *metaInterceptorsContainerFactory) createOneShardHeaderInterceptor(to
 			Topic:                topic,
 			DataFactory:          hdrFactory,
 			Processor:            hdrProcessor,
-			Throttler:            micf.globalThrottler,
+			Throttler:            micf.priorityLanes.Throttler(topic),
+			Scheduler:            micf.priorityLanes.Scheduler(topic),
 			AntifloodHandler:     micf.antifloodHandler,
 			WhiteListRequest:     micf.whiteListHandler,
 			CurrentPeerId:        micf.mainMessenger.ID(),
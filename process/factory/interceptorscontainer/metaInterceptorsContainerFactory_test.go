@@ -397,6 +397,18 @@ func TestNewMetaInterceptorsContainerFactory_NilPeerSignatureHandler(t *testing.
 	assert.Equal(t, process.ErrNilPeerSignatureHandler, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilPriorityLanesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsMeta(coreComp, cryptoComp)
+	args.PriorityLanes = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptorPriorityLanes, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_InvalidExpiryTimespan(t *testing.T) {
 	t.Parallel()
 
@@ -706,6 +718,7 @@ func getArgumentsMeta(
 		MainPeerShardMapper:          &p2pmocks.NetworkShardingCollectorStub{},
 		FullArchivePeerShardMapper:   &p2pmocks.NetworkShardingCollectorStub{},
 		HardforkTrigger:              &testscommon.HardforkTriggerStub{},
+		PriorityLanes:                createMockPriorityLanes(),
 		NodeOperationMode:            common.NormalOperation,
 	}
 }
@@ -3,7 +3,6 @@ package interceptorscontainer
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
@@ -78,6 +77,9 @@ func NewShardInterceptorsContainerFactory(
 	if check.IfNil(args.PeerSignatureHandler) {
 		return nil, process.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(args.PriorityLanes) {
+		return nil, process.ErrNilInterceptorPriorityLanes
+	}
 	if args.HeartbeatExpiryTimespanInSec < minTimespanDurationInSec {
 		return nil, process.ErrInvalidExpiryTimespan
 	}
@@ -123,17 +125,13 @@ func NewShardInterceptorsContainerFactory(
 		fullArchivePeerShardMapper: args.FullArchivePeerShardMapper,
 		hardforkTrigger:            args.HardforkTrigger,
 		nodeOperationMode:          args.NodeOperationMode,
+		priorityLanes:              args.PriorityLanes,
 	}
 
 	icf := &shardInterceptorsContainerFactory{
 		baseInterceptorsContainerFactory: base,
 	}
 
-	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
-	if err != nil {
-		return nil, err
-	}
-
 	return icf, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/factory/interceptorscontainer"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -532,6 +533,18 @@ func TestShardInterceptorsContainerFactory_NilPeerSignatureHandler(t *testing.T)
 	assert.Equal(t, process.ErrNilPeerSignatureHandler, err)
 }
 
+func TestShardInterceptorsContainerFactory_NilPriorityLanesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsShard(coreComp, cryptoComp)
+	args.PriorityLanes = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptorPriorityLanes, err)
+}
+
 func TestShardInterceptorsContainerFactory_InvalidExpiryTimespan(t *testing.T) {
 	t.Parallel()
 
@@ -698,6 +711,12 @@ func createMockComponentHolders() (*mock.CoreComponentsMock, *mock.CryptoCompone
 	return coreComponents, cryptoComponents
 }
 
+func createMockPriorityLanes() process.InterceptorPriorityLanesHandler {
+	priorityLanes, _ := lanes.NewNoPriorityLanes()
+
+	return priorityLanes
+}
+
 func getArgumentsShard(
 	coreComp *mock.CoreComponentsMock,
 	cryptoComp *mock.CryptoComponentsMock,
@@ -732,5 +751,6 @@ func getArgumentsShard(
 		MainPeerShardMapper:          &p2pmocks.NetworkShardingCollectorStub{},
 		FullArchivePeerShardMapper:   &p2pmocks.NetworkShardingCollectorStub{},
 		HardforkTrigger:              &testscommon.HardforkTriggerStub{},
+		PriorityLanes:                createMockPriorityLanes(),
 	}
 }
@@ -44,6 +44,7 @@ type vmContainerFactory struct {
 	wasmVMChangeLocker  common.Locker
 	esdtTransferParser  vmcommon.ESDTTransferParser
 	hasher              hashing.Hasher
+	vmHooksGasTracer    process.VMHooksGasTracer
 }
 
 // ArgVMContainerFactory defines the arguments needed to the new VM factory
@@ -58,6 +59,8 @@ type ArgVMContainerFactory struct {
 	BuiltInFunctions    vmcommon.BuiltInFunctionContainer
 	BlockChainHook      process.BlockChainHookWithAccountsAdapter
 	Hasher              hashing.Hasher
+	// VMHooksGasTracer is optional and should only be set for the VMs used in simulations
+	VMHooksGasTracer process.VMHooksGasTracer
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -102,6 +105,7 @@ func NewVMContainerFactory(args ArgVMContainerFactory) (*vmContainerFactory, err
 		wasmVMChangeLocker:  args.WasmVMChangeLocker,
 		esdtTransferParser:  args.ESDTTransferParser,
 		hasher:              args.Hasher,
+		vmHooksGasTracer:    args.VMHooksGasTracer,
 	}
 
 	vmf.wasmVMVersions = args.Config.WasmVMVersions
@@ -352,8 +356,19 @@ func (vmf *vmContainerFactory) createInProcessWasmVMV15() (vmcommon.VMExecutionH
 		EnableEpochsHandler:                 vmf.enableEpochsHandler,
 		Hasher:                              vmf.hasher,
 	}
+	if check.IfNil(vmf.vmHooksGasTracer) {
+		return wasmVMHost15.NewVMHost(vmf.blockChainHook, hostParameters)
+	}
+
+	hostParameters.OverrideVMExecutor = vmf.vmHooksGasTracer
+	vmHost, err := wasmVMHost15.NewVMHost(vmf.blockChainHook, hostParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	vmf.vmHooksGasTracer.SetVMHost(vmHost)
 
-	return wasmVMHost15.NewVMHost(vmf.blockChainHook, hostParameters)
+	return vmHost, nil
 }
 
 func (vmf *vmContainerFactory) closePreviousVM(vm vmcommon.VMExecutionHandler) {
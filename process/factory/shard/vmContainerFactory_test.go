@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator/gasTracer"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
@@ -177,6 +178,31 @@ func TestVmContainerFactory_Create(t *testing.T) {
 	assert.NotNil(t, acc)
 }
 
+func TestVmContainerFactory_CreateWithVMHooksGasTracer(t *testing.T) {
+	if runtime.GOARCH == "arm64" {
+		t.Skip("skipping test on arm64")
+	}
+
+	vmHooksGasTracer := gasTracer.NewVMHooksGasTracer()
+	args := createMockVMAccountsArguments()
+	args.VMHooksGasTracer = vmHooksGasTracer
+	args.Config.WasmVMVersions = []config.WasmVMVersionByEpoch{{StartEpoch: 0, Version: "v1.5"}}
+	vmf, _ := NewVMContainerFactory(args)
+	require.NotNil(t, vmf)
+	require.Nil(t, vmHooksGasTracer.GetGasTrace())
+
+	container, err := vmf.Create()
+	require.Nil(t, err)
+	defer func() {
+		_ = container.Close()
+	}()
+
+	vm, err := container.Get(factory.WasmVirtualMachine)
+	require.Nil(t, err)
+	require.NotNil(t, vm)
+	require.NotNil(t, vmHooksGasTracer.GetGasTrace())
+}
+
 func TestVmContainerFactory_ResolveWasmVMVersion(t *testing.T) {
 	if runtime.GOARCH == "arm64" {
 		t.Skip("skipping test on arm64")
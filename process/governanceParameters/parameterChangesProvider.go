@@ -0,0 +1,176 @@
+package governanceParameters
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/governanceParameters")
+
+// ArgsParameterChangesProvider defines the arguments needed to create a new parameter changes provider
+type ArgsParameterChangesProvider struct {
+	Marshaller         marshal.Marshalizer
+	MetaBlockStorer    storage.Storer
+	EpochStartNotifier process.EpochStartEventNotifier
+	CurrentEpoch       uint32
+}
+
+type parameterChangesProvider struct {
+	marshaller  marshal.Marshalizer
+	mutChanges  sync.RWMutex
+	changes     []*governance.ParameterChange
+	mutHandlers sync.RWMutex
+	handlers    []governance.ParameterChangesHandler
+}
+
+// NewParameterChangesProvider creates a provider of the parameter changes of the passed governance proposals, as
+// carried by the start of epoch metablocks. It starts with the changes of the start of epoch metablock of the current
+// epoch and it is updated on each start of epoch event, the same way on all the shards
+func NewParameterChangesProvider(args ArgsParameterChangesProvider) (*parameterChangesProvider, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.EpochStartNotifier) {
+		return nil, process.ErrNilEpochStartNotifier
+	}
+
+	provider := &parameterChangesProvider{
+		marshaller: args.Marshaller,
+		changes:    make([]*governance.ParameterChange, 0),
+		handlers:   make([]governance.ParameterChangesHandler, 0),
+	}
+
+	err := provider.loadFromStorage(args.MetaBlockStorer, args.CurrentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	args.EpochStartNotifier.RegisterHandler(provider)
+
+	return provider, nil
+}
+
+func (provider *parameterChangesProvider) loadFromStorage(storer storage.Storer, epoch uint32) error {
+	metaBlockBytes, err := storer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		// the genesis metablock is only saved when the genesis is processed and it carries no parameter changes
+		log.Debug("parameterChangesProvider: no start of epoch metablock stored", "epoch", epoch)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = provider.marshaller.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return err
+	}
+	if !metaBlock.IsStartOfEpochBlock() {
+		// the genesis metablock is saved under the same key, without carrying any parameter changes
+		log.Debug("parameterChangesProvider: genesis metablock stored", "epoch", epoch)
+		return nil
+	}
+
+	return provider.setChangesFromMetaBlock(metaBlock)
+}
+
+func (provider *parameterChangesProvider) setChangesFromMetaBlock(metaHdr data.HeaderHandler) error {
+	if check.IfNil(metaHdr) {
+		return process.ErrNilHeaderHandler
+	}
+	if metaHdr.GetShardID() != core.MetachainShardId || !metaHdr.IsStartOfEpochBlock() {
+		return process.ErrNotEpochStartBlock
+	}
+
+	parameterChanges := &governance.GovernanceParameterChanges{}
+	err := provider.marshaller.Unmarshal(parameterChanges, metaHdr.GetReserved())
+	if err != nil {
+		return err
+	}
+
+	changes := parameterChanges.Changes
+	if changes == nil {
+		changes = make([]*governance.ParameterChange, 0)
+	}
+
+	provider.mutChanges.Lock()
+	provider.changes = changes
+	provider.mutChanges.Unlock()
+
+	return nil
+}
+
+// ParameterChanges returns the parameter changes of all the passed governance proposals, together with their
+// activation epochs
+func (provider *parameterChangesProvider) ParameterChanges() ([]*governance.ParameterChange, error) {
+	provider.mutChanges.RLock()
+	defer provider.mutChanges.RUnlock()
+
+	changes := make([]*governance.ParameterChange, len(provider.changes))
+	copy(changes, provider.changes)
+
+	return changes, nil
+}
+
+// RegisterHandler registers a handler to be notified whenever new parameter changes are received
+func (provider *parameterChangesProvider) RegisterHandler(handler governance.ParameterChangesHandler) {
+	if check.IfNil(handler) {
+		return
+	}
+
+	provider.mutHandlers.Lock()
+	provider.handlers = append(provider.handlers, handler)
+	provider.mutHandlers.Unlock()
+}
+
+// EpochStartPrepare reads the parameter changes carried by the start of epoch metablock and notifies the registered
+// handlers
+func (provider *parameterChangesProvider) EpochStartPrepare(metaHdr data.HeaderHandler, _ data.BodyHandler) {
+	err := provider.setChangesFromMetaBlock(metaHdr)
+	if err != nil {
+		log.Error("parameterChangesProvider.EpochStartPrepare: could not read the governance parameter changes", "error", err)
+		return
+	}
+
+	provider.mutHandlers.RLock()
+	handlers := make([]governance.ParameterChangesHandler, len(provider.handlers))
+	copy(handlers, provider.handlers)
+	provider.mutHandlers.RUnlock()
+
+	for _, handler := range handlers {
+		err = handler.ParameterChangesUpdated()
+		if err != nil {
+			log.Error("parameterChangesProvider.EpochStartPrepare: could not apply the governance parameter changes",
+				"epoch", metaHdr.GetEpoch(), "error", err)
+		}
+	}
+}
+
+// EpochStartAction does nothing, the parameter changes are read when preparing for the start of epoch
+func (provider *parameterChangesProvider) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (provider *parameterChangesProvider) NotifyOrder() uint32 {
+	return common.ParameterChangesOrder
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *parameterChangesProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
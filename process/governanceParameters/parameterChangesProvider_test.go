@@ -0,0 +1,224 @@
+package governanceParameters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/governance"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+type parameterChangesHandlerStub struct {
+	parameterChangesUpdatedCalled func() error
+}
+
+func (stub *parameterChangesHandlerStub) ParameterChangesUpdated() error {
+	return stub.parameterChangesUpdatedCalled()
+}
+
+func (stub *parameterChangesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsParameterChangesProvider() ArgsParameterChangesProvider {
+	return ArgsParameterChangesProvider{
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		MetaBlockStorer:    testscommon.CreateMemUnit(),
+		EpochStartNotifier: &mock.EpochStartNotifierStub{},
+		CurrentEpoch:       5,
+	}
+}
+
+func createStartOfEpochMetaBlock(t *testing.T, epoch uint32, changes []*governance.ParameterChange) *block.MetaBlock {
+	metaBlock := &block.MetaBlock{
+		Epoch: epoch,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+		},
+	}
+	if len(changes) == 0 {
+		return metaBlock
+	}
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	reserved, err := marshaller.Marshal(&governance.GovernanceParameterChanges{Changes: changes})
+	require.Nil(t, err)
+	metaBlock.Reserved = reserved
+
+	return metaBlock
+}
+
+func TestNewParameterChangesProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsParameterChangesProvider()
+		args.Marshaller = nil
+		provider, err := NewParameterChangesProvider(args)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(provider))
+	})
+	t.Run("nil meta block storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsParameterChangesProvider()
+		args.MetaBlockStorer = nil
+		provider, err := NewParameterChangesProvider(args)
+		require.Equal(t, process.ErrNilStorage, err)
+		require.True(t, check.IfNil(provider))
+	})
+	t.Run("nil epoch start notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsParameterChangesProvider()
+		args.EpochStartNotifier = nil
+		provider, err := NewParameterChangesProvider(args)
+		require.Equal(t, process.ErrNilEpochStartNotifier, err)
+		require.True(t, check.IfNil(provider))
+	})
+	t.Run("storer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsParameterChangesProvider()
+		args.MetaBlockStorer = &storage.StorerStub{
+			SearchFirstCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		provider, err := NewParameterChangesProvider(args)
+		require.Equal(t, expectedErr, err)
+		require.True(t, check.IfNil(provider))
+	})
+	t.Run("missing start of epoch metablock should start without changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsParameterChangesProvider()
+		var registeredHandler epochStart.ActionHandler
+		args.EpochStartNotifier = &mock.EpochStartNotifierStub{
+			RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+				registeredHandler = handler
+			},
+		}
+		provider, err := NewParameterChangesProvider(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(provider))
+		require.Equal(t, provider, registeredHandler)
+
+		changes, err := provider.ParameterChanges()
+		require.Nil(t, err)
+		require.Empty(t, changes)
+	})
+	t.Run("stored genesis metablock should start without changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsParameterChangesProvider()
+		metaBlockBytes, _ := args.Marshaller.Marshal(&block.MetaBlock{Epoch: args.CurrentEpoch})
+		_ = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(args.CurrentEpoch)), metaBlockBytes)
+
+		provider, err := NewParameterChangesProvider(args)
+		require.Nil(t, err)
+
+		changes, err := provider.ParameterChanges()
+		require.Nil(t, err)
+		require.Empty(t, changes)
+	})
+	t.Run("should start with the changes of the stored start of epoch metablock", func(t *testing.T) {
+		t.Parallel()
+
+		expectedChanges := []*governance.ParameterChange{
+			{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "1000", ActivationEpoch: 5},
+		}
+		args := createMockArgsParameterChangesProvider()
+		metaBlockBytes, _ := args.Marshaller.Marshal(createStartOfEpochMetaBlock(t, args.CurrentEpoch, expectedChanges))
+		_ = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(args.CurrentEpoch)), metaBlockBytes)
+
+		provider, err := NewParameterChangesProvider(args)
+		require.Nil(t, err)
+
+		changes, err := provider.ParameterChanges()
+		require.Nil(t, err)
+		require.Equal(t, expectedChanges, changes)
+	})
+}
+
+func TestParameterChangesProvider_EpochStartPrepare(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a start of epoch metablock should not update the changes", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := NewParameterChangesProvider(createMockArgsParameterChangesProvider())
+		provider.RegisterHandler(&parameterChangesHandlerStub{
+			parameterChangesUpdatedCalled: func() error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		})
+
+		provider.EpochStartPrepare(&block.MetaBlock{Epoch: 6}, nil)
+		provider.EpochStartPrepare(&block.Header{Epoch: 6, EpochStartMetaHash: []byte("hash")}, nil)
+		provider.EpochStartPrepare(nil, nil)
+	})
+	t.Run("invalid parameter changes should not update the changes", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := NewParameterChangesProvider(createMockArgsParameterChangesProvider())
+		provider.RegisterHandler(&parameterChangesHandlerStub{
+			parameterChangesUpdatedCalled: func() error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		})
+
+		metaBlock := createStartOfEpochMetaBlock(t, 6, nil)
+		metaBlock.Reserved = []byte("invalid")
+		provider.EpochStartPrepare(metaBlock, nil)
+
+		changes, _ := provider.ParameterChanges()
+		require.Empty(t, changes)
+	})
+	t.Run("should update the changes and notify all the handlers", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := NewParameterChangesProvider(createMockArgsParameterChangesProvider())
+		numCalls := 0
+		handler := &parameterChangesHandlerStub{
+			parameterChangesUpdatedCalled: func() error {
+				numCalls++
+				return errors.New("expected error")
+			},
+		}
+		provider.RegisterHandler(handler)
+		provider.RegisterHandler(nil)
+		provider.RegisterHandler(handler)
+
+		expectedChanges := []*governance.ParameterChange{
+			{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "1000", ActivationEpoch: 6},
+			{ProposalNonce: 2, Name: governance.MinGasLimit, Value: "60000", ActivationEpoch: 7},
+		}
+		provider.EpochStartPrepare(createStartOfEpochMetaBlock(t, 6, expectedChanges), nil)
+
+		changes, err := provider.ParameterChanges()
+		require.Nil(t, err)
+		require.Equal(t, expectedChanges, changes)
+		require.Equal(t, 2, numCalls)
+
+		provider.EpochStartPrepare(createStartOfEpochMetaBlock(t, 7, nil), nil)
+		changes, err = provider.ParameterChanges()
+		require.Nil(t, err)
+		require.Empty(t, changes)
+		require.Equal(t, 4, numCalls)
+	})
+}
@@ -5,21 +5,25 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/cmd/node/factory"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
 type headerIntegrityVerifier struct {
 	referenceChainID     []byte
 	headerVersionHandler factory.HeaderVersionHandler
+	enableEpochsHandler  common.EnableEpochsHandler
 }
 
 // NewHeaderIntegrityVerifier returns a new instance of a structure capable of verifying the integrity of a provided header
 func NewHeaderIntegrityVerifier(
 	referenceChainID []byte,
 	headerVersionHandler factory.HeaderVersionHandler,
+	enableEpochsHandler common.EnableEpochsHandler,
 ) (*headerIntegrityVerifier, error) {
 
 	if len(referenceChainID) == 0 {
@@ -28,10 +32,20 @@ func NewHeaderIntegrityVerifier(
 	if check.IfNil(headerVersionHandler) {
 		return nil, fmt.Errorf("%w, in NewHeaderVersioningHandler", ErrNilHeaderVersionHandler)
 	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, process.ErrNilEnableEpochsHandler
+	}
+	err := core.CheckHandlerCompatibility(enableEpochsHandler, []core.EnableEpochFlag{
+		common.GovernanceParameterChangesFlag,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	hdrIntVer := &headerIntegrityVerifier{
 		referenceChainID:     referenceChainID,
 		headerVersionHandler: headerVersionHandler,
+		enableEpochsHandler:  enableEpochsHandler,
 	}
 
 	return hdrIntVer, nil
@@ -44,7 +58,7 @@ func (hdrIntVer *headerIntegrityVerifier) GetVersion(epoch uint32) string {
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
+	if len(hdr.GetReserved()) > 0 && !hdrIntVer.canCarryParameterChanges(hdr) {
 		return process.ErrReservedFieldInvalid
 	}
 
@@ -56,6 +70,16 @@ func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
 	return hdrIntVer.checkChainID(hdr)
 }
 
+// canCarryParameterChanges returns true for the start of epoch metablocks created after the governance parameter
+// changes activation, whose reserved field carries the governance parameter changes
+func (hdrIntVer *headerIntegrityVerifier) canCarryParameterChanges(hdr data.HeaderHandler) bool {
+	if hdr.GetShardID() != core.MetachainShardId || !hdr.IsStartOfEpochBlock() {
+		return false
+	}
+
+	return hdrIntVer.enableEpochsHandler.IsFlagEnabledInEpoch(common.GovernanceParameterChangesFlag, hdr.GetEpoch())
+}
+
 // checkChainID returns nil if the header's chain ID matches the one provided
 // otherwise, it will error
 func (hdrIntVer *headerIntegrityVerifier) checkChainID(hdr data.HeaderHandler) error {
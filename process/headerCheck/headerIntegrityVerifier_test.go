@@ -4,14 +4,25 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func createMockEnableEpochsHandler() common.EnableEpochsHandler {
+	return &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+		IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+			return flag == common.GovernanceParameterChangesFlag
+		},
+	}
+}
+
 func TestNewHeaderIntegrityVerifier_InvalidReferenceChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +30,7 @@ func TestNewHeaderIntegrityVerifier_InvalidReferenceChainIDShouldErr(t *testing.
 	hdrIntVer, err := NewHeaderIntegrityVerifier(
 		nil,
 		hvh,
+		createMockEnableEpochsHandler(),
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.Equal(t, ErrInvalidReferenceChainID, err)
@@ -30,11 +42,36 @@ func TestNewHeaderIntegrityVerifierr_NilVersionHandlerShouldErr(t *testing.T) {
 	hdrIntVer, err := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
 		nil,
+		createMockEnableEpochsHandler(),
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrNilHeaderVersionHandler))
 }
 
+func TestNewHeaderIntegrityVerifier_NilEnableEpochsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdrIntVer, err := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		&testscommon.HeaderVersionHandlerStub{},
+		nil,
+	)
+	require.True(t, check.IfNil(hdrIntVer))
+	require.Equal(t, process.ErrNilEnableEpochsHandler, err)
+}
+
+func TestNewHeaderIntegrityVerifier_InvalidEnableEpochsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdrIntVer, err := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		&testscommon.HeaderVersionHandlerStub{},
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStubWithNoFlagsDefined(),
+	)
+	require.True(t, check.IfNil(hdrIntVer))
+	require.True(t, errors.Is(err, core.ErrInvalidEnableEpochsHandler))
+}
+
 func TestNewHeaderIntegrityVerifier_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +79,7 @@ func TestNewHeaderIntegrityVerifier_ShouldWork(t *testing.T) {
 	hdrIntVer, err := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
 		hvh,
+		createMockEnableEpochsHandler(),
 	)
 	require.False(t, check.IfNil(hdrIntVer))
 	require.NoError(t, err)
@@ -57,11 +95,70 @@ func TestHeaderIntegrityVerifier_PopulatedReservedShouldErr(t *testing.T) {
 	hdrIntVer, _ := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
 		hvh,
+		createMockEnableEpochsHandler(),
+	)
+	err := hdrIntVer.Verify(hdr)
+	require.Equal(t, process.ErrReservedFieldInvalid, err)
+}
+
+func TestHeaderIntegrityVerifier_PopulatedReservedOnStartOfEpochMetaBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.MetaBlock{
+		ChainID:  []byte("chainID"),
+		Reserved: []byte("parameter changes"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+		},
+	}
+	hvh := &testscommon.HeaderVersionHandlerStub{}
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		hvh,
+		createMockEnableEpochsHandler(),
 	)
 	err := hdrIntVer.Verify(hdr)
+	require.Nil(t, err)
+
+	shardHdr := &block.Header{
+		ChainID:            []byte("chainID"),
+		Reserved:           []byte("r"),
+		EpochStartMetaHash: []byte("epoch start meta hash"),
+	}
+	err = hdrIntVer.Verify(shardHdr)
 	require.Equal(t, process.ErrReservedFieldInvalid, err)
 }
 
+func TestHeaderIntegrityVerifier_PopulatedReservedOnStartOfEpochMetaBlockBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.MetaBlock{
+		Epoch:    2,
+		ChainID:  []byte("chainID"),
+		Reserved: []byte("parameter changes"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+		},
+	}
+	hvh := &testscommon.HeaderVersionHandlerStub{}
+	enableEpochsHandler := &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+		IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+			return flag == common.GovernanceParameterChangesFlag && epoch >= 3
+		},
+	}
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		hvh,
+		enableEpochsHandler,
+	)
+	err := hdrIntVer.Verify(hdr)
+	require.Equal(t, process.ErrReservedFieldInvalid, err)
+
+	hdr.Epoch = 3
+	err = hdrIntVer.Verify(hdr)
+	require.Nil(t, err)
+}
+
 func TestHeaderIntegrityVerifier_VerifyHdrChainIDAndReferenceChainIDMismatchShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -69,6 +166,7 @@ func TestHeaderIntegrityVerifier_VerifyHdrChainIDAndReferenceChainIDMismatchShou
 	hdrIntVer, _ := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
 		hvh,
+		createMockEnableEpochsHandler(),
 	)
 	mb := &block.MetaBlock{
 		SoftwareVersion: []byte("software"),
@@ -86,6 +184,7 @@ func TestHeaderIntegrityVerifier_VerifyShouldWork(t *testing.T) {
 	hdrIntVer, _ := NewHeaderIntegrityVerifier(
 		expectedChainID,
 		hvh,
+		createMockEnableEpochsHandler(),
 	)
 	mb := &block.MetaBlock{
 		SoftwareVersion: []byte("software"),
@@ -106,6 +205,7 @@ func TestHeaderIntegrityVerifier_GetVersionShouldWork(t *testing.T) {
 	hdrIntVer, _ := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
 		hvh,
+		createMockEnableEpochsHandler(),
 	)
 
 	assert.Equal(t, "v1", hdrIntVer.GetVersion(1))
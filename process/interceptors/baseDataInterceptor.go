@@ -3,6 +3,7 @@ package interceptors
 import (
 	"bytes"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -12,6 +13,7 @@ import (
 
 type baseDataInterceptor struct {
 	throttler            process.InterceptorThrottler
+	scheduler            process.InterceptedDataScheduler
 	antifloodHandler     process.P2PAntifloodHandler
 	topic                string
 	currentPeerId        core.PeerID
@@ -40,6 +42,7 @@ func (bdi *baseDataInterceptor) preProcessMesage(message p2p.MessageP2P, fromCon
 		}
 
 		if !bdi.throttler.CanProcess() {
+			bdi.antifloodHandler.ThrottledOnTopic(bdi.topic)
 			return process.ErrSystemBusy
 		}
 	}
@@ -63,6 +66,12 @@ func (bdi *baseDataInterceptor) isMessageFromSelfToSelf(fromConnectedPeer core.P
 }
 
 func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedData, msg p2p.MessageP2P) {
+	startTime := time.Now()
+	bdi.antifloodHandler.StartProcessingOnTopic(bdi.topic)
+	defer func() {
+		bdi.antifloodHandler.EndProcessingOnTopic(bdi.topic, time.Since(startTime))
+	}()
+
 	err := bdi.processor.Validate(data, msg.Peer())
 	if err != nil {
 		log.Trace("intercepted data is not valid",
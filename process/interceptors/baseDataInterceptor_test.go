@@ -3,6 +3,7 @@ package interceptors
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/p2p"
@@ -31,9 +32,10 @@ func newBaseDataInterceptorForPreProcess(
 
 func newBaseDataInterceptorForProcess(processor process.InterceptorProcessor, debugHandler process.InterceptedDebugger, topic string) *baseDataInterceptor {
 	return &baseDataInterceptor{
-		topic:        topic,
-		processor:    processor,
-		debugHandler: debugHandler,
+		topic:            topic,
+		processor:        processor,
+		debugHandler:     debugHandler,
+		antifloodHandler: &mock.P2PAntifloodHandlerStub{},
 	}
 }
 
@@ -276,6 +278,34 @@ func TestProcessInterceptedData_ProcessErrorShouldCallDone(t *testing.T) {
 	assert.True(t, processCalled)
 }
 
+func TestProcessInterceptedData_ShouldReportTopicLoad(t *testing.T) {
+	t.Parallel()
+
+	startedTopic := ""
+	endedTopic := ""
+	processor := &mock.InterceptorProcessorStub{
+		ValidateCalled: func(data process.InterceptedData) error {
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			return nil
+		},
+	}
+	bdi := newBaseDataInterceptorForProcess(processor, &mock.InterceptedDebugHandlerStub{}, "topic")
+	bdi.antifloodHandler = &mock.P2PAntifloodHandlerStub{
+		StartProcessingOnTopicCalled: func(topic string) {
+			startedTopic = topic
+		},
+		EndProcessingOnTopicCalled: func(topic string, processingTime time.Duration) {
+			endedTopic = topic
+		},
+	}
+	bdi.processInterceptedData(&testscommon.InterceptedDataStub{}, &p2pmocks.P2PMessageMock{})
+
+	assert.Equal(t, "topic", startedTopic)
+	assert.Equal(t, "topic", endedTopic)
+}
+
 //------- debug
 
 func TestProcessDebugInterceptedData_ShouldWork(t *testing.T) {
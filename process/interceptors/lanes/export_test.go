@@ -0,0 +1,6 @@
+package lanes
+
+// SaveMetrics -
+func (pl *priorityLanes) SaveMetrics() {
+	pl.saveMetrics()
+}
@@ -0,0 +1,21 @@
+package lanes
+
+type goRoutineScheduler struct {
+}
+
+// NewGoRoutineScheduler creates a scheduler that processes each intercepted data on its own go routine
+func NewGoRoutineScheduler() *goRoutineScheduler {
+	return &goRoutineScheduler{}
+}
+
+// Schedule starts the provided handler on a new go routine
+func (grs *goRoutineScheduler) Schedule(handler func()) error {
+	go handler()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (grs *goRoutineScheduler) IsInterfaceNil() bool {
+	return grs == nil
+}
@@ -0,0 +1,76 @@
+package lanes
+
+import (
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+// lane holds the intercepted data of a topic class waiting to be processed. A lane acts both as the throttler and as
+// the scheduler of the interceptors registered on the topics of its class
+type lane struct {
+	parent     *priorityLanes
+	class      TopicClass
+	weight     int
+	queueSize  int
+	maxWorkers int
+
+	numAdmitted  int32
+	numDropped   uint64
+	numProcessed uint64
+
+	// the following fields are protected by the parent's mutex
+	queue         []func()
+	numRunning    int
+	currentWeight int
+}
+
+func newLane(parent *priorityLanes, class TopicClass, laneConfig config.InterceptorsPriorityLaneConfig) *lane {
+	return &lane{
+		parent:     parent,
+		class:      class,
+		weight:     int(laneConfig.Weight),
+		queueSize:  int(laneConfig.QueueSize),
+		maxWorkers: int(laneConfig.MaxWorkers),
+		queue:      make([]func(), 0, laneConfig.QueueSize),
+	}
+}
+
+func (l *lane) popNoLock() func() {
+	handler := l.queue[0]
+	l.queue[0] = nil
+	l.queue = l.queue[1:]
+	l.numRunning++
+
+	return handler
+}
+
+// CanProcess returns true if the lane can accept one more intercepted data. A refused data is counted as dropped
+func (l *lane) CanProcess() bool {
+	if atomic.LoadInt32(&l.numAdmitted) < int32(l.queueSize) {
+		return true
+	}
+
+	atomic.AddUint64(&l.numDropped, 1)
+	return false
+}
+
+// StartProcessing marks the admission of a new intercepted data on this lane
+func (l *lane) StartProcessing() {
+	atomic.AddInt32(&l.numAdmitted, 1)
+}
+
+// EndProcessing marks the end of processing of an intercepted data admitted on this lane
+func (l *lane) EndProcessing() {
+	atomic.AddInt32(&l.numAdmitted, -1)
+}
+
+// Schedule queues the provided handler on this lane. Errors if the lane queue is full or the lanes were closed
+func (l *lane) Schedule(handler func()) error {
+	return l.parent.schedule(l, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (l *lane) IsInterfaceNil() bool {
+	return l == nil
+}
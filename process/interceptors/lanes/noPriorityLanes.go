@@ -0,0 +1,47 @@
+package lanes
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/throttler"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const numGoRoutines = 100
+
+type noPriorityLanes struct {
+	globalThrottler process.InterceptorThrottler
+	scheduler       process.InterceptedDataScheduler
+}
+
+// NewNoPriorityLanes creates an instance in which all the topics share the same go routines throttler and each
+// intercepted data is processed on its own go routine
+func NewNoPriorityLanes() (*noPriorityLanes, error) {
+	globalThrottler, err := throttler.NewNumGoRoutinesThrottler(numGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+
+	return &noPriorityLanes{
+		globalThrottler: globalThrottler,
+		scheduler:       NewGoRoutineScheduler(),
+	}, nil
+}
+
+// Throttler returns the global throttler, regardless of the topic
+func (npl *noPriorityLanes) Throttler(_ string) process.InterceptorThrottler {
+	return npl.globalThrottler
+}
+
+// Scheduler returns the go routine scheduler, regardless of the topic
+func (npl *noPriorityLanes) Scheduler(_ string) process.InterceptedDataScheduler {
+	return npl.scheduler
+}
+
+// Close returns nil
+func (npl *noPriorityLanes) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (npl *noPriorityLanes) IsInterfaceNil() bool {
+	return npl == nil
+}
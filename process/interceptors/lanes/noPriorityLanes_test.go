@@ -0,0 +1,35 @@
+package lanes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoPriorityLanes(t *testing.T) {
+	t.Parallel()
+
+	npl, err := lanes.NewNoPriorityLanes()
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(npl))
+
+	// all the topics share the same throttler and scheduler
+	assert.True(t, npl.Throttler(headersTopic) == npl.Throttler(transactionsTopic))
+	assert.True(t, npl.Scheduler(headersTopic) == npl.Scheduler(transactionsTopic))
+
+	chDone := make(chan struct{})
+	err = npl.Scheduler(transactionsTopic).Schedule(func() {
+		close(chDone)
+	})
+	assert.Nil(t, err)
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout waiting for handler")
+	}
+	assert.Nil(t, npl.Close())
+}
@@ -0,0 +1,297 @@
+package lanes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/interceptors/lanes")
+
+const minMetricsUpdateInterval = time.Second
+
+// ArgsPriorityLanes is the argument DTO used to create a new priority lanes instance
+type ArgsPriorityLanes struct {
+	Config           config.InterceptorsPriorityLanesConfig
+	AppStatusHandler core.AppStatusHandler
+}
+
+type priorityLanes struct {
+	mut                   sync.Mutex
+	cond                  *sync.Cond
+	consensusCond         *sync.Cond
+	lanes                 map[TopicClass]*lane
+	consensusLane         *lane
+	orderedLanes          []*lane
+	isClosed              bool
+	appStatusHandler      core.AppStatusHandler
+	metricsUpdateInterval time.Duration
+	cancelFunc            func()
+}
+
+// NewPriorityLanes creates a new priority lanes instance. The intercepted data of each topic class is queued on the
+// lane of that class and a shared, bounded pool of workers serves the lanes according to their weights. The consensus
+// lane is served by its own workers, so the other lanes can not starve it
+func NewPriorityLanes(args ArgsPriorityLanes) (*priorityLanes, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pl := &priorityLanes{
+		lanes:                 make(map[TopicClass]*lane),
+		appStatusHandler:      args.AppStatusHandler,
+		metricsUpdateInterval: time.Duration(args.Config.MetricsUpdateIntervalInSeconds) * time.Second,
+	}
+	pl.cond = sync.NewCond(&pl.mut)
+	pl.consensusCond = sync.NewCond(&pl.mut)
+	if pl.metricsUpdateInterval < minMetricsUpdateInterval {
+		pl.metricsUpdateInterval = minMetricsUpdateInterval
+	}
+
+	for _, laneConfig := range args.Config.Lanes {
+		class := TopicClass(laneConfig.Class)
+		pl.lanes[class] = newLane(pl, class, laneConfig)
+	}
+	for _, class := range orderedClasses {
+		if class == ConsensusClass {
+			continue
+		}
+		pl.orderedLanes = append(pl.orderedLanes, pl.lanes[class])
+	}
+	pl.consensusLane = pl.lanes[ConsensusClass]
+
+	var ctx context.Context
+	ctx, pl.cancelFunc = context.WithCancel(context.Background())
+	for i := uint32(0); i < args.Config.NumWorkers; i++ {
+		go pl.work(pl.waitNextHandler)
+	}
+	for i := 0; i < pl.consensusLane.maxWorkers; i++ {
+		go pl.work(pl.waitNextConsensusHandler)
+	}
+	go pl.saveMetricsLoop(ctx)
+
+	return pl, nil
+}
+
+func checkArgs(args ArgsPriorityLanes) error {
+	if check.IfNil(args.AppStatusHandler) {
+		return process.ErrNilAppStatusHandler
+	}
+	if args.Config.NumWorkers == 0 {
+		return fmt.Errorf("%w, NumWorkers should be greater than 0", process.ErrInvalidPriorityLaneConfig)
+	}
+
+	configuredClasses := make(map[TopicClass]struct{})
+	for _, laneConfig := range args.Config.Lanes {
+		class := TopicClass(laneConfig.Class)
+		if !isKnownClass(class) {
+			return fmt.Errorf("%w, unknown class %s", process.ErrInvalidPriorityLaneConfig, laneConfig.Class)
+		}
+		_, isDuplicated := configuredClasses[class]
+		if isDuplicated {
+			return fmt.Errorf("%w, duplicated class %s", process.ErrInvalidPriorityLaneConfig, laneConfig.Class)
+		}
+		if laneConfig.QueueSize == 0 || laneConfig.MaxWorkers == 0 {
+			return fmt.Errorf("%w, QueueSize and MaxWorkers should be greater than 0 for class %s",
+				process.ErrInvalidPriorityLaneConfig, laneConfig.Class)
+		}
+		// the consensus lane is served by its own workers, so its weight is not used
+		if laneConfig.Weight == 0 && class != ConsensusClass {
+			return fmt.Errorf("%w, Weight should be greater than 0 for class %s",
+				process.ErrInvalidPriorityLaneConfig, laneConfig.Class)
+		}
+
+		configuredClasses[class] = struct{}{}
+	}
+
+	for _, class := range orderedClasses {
+		_, isConfigured := configuredClasses[class]
+		if !isConfigured {
+			return fmt.Errorf("%w, missing class %s", process.ErrInvalidPriorityLaneConfig, class)
+		}
+	}
+
+	return nil
+}
+
+func isKnownClass(class TopicClass) bool {
+	for _, knownClass := range orderedClasses {
+		if knownClass == class {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Throttler returns the throttler of the lane the provided topic belongs to
+func (pl *priorityLanes) Throttler(topic string) process.InterceptorThrottler {
+	return pl.lanes[ClassForTopic(topic)]
+}
+
+// Scheduler returns the scheduler of the lane the provided topic belongs to
+func (pl *priorityLanes) Scheduler(topic string) process.InterceptedDataScheduler {
+	return pl.lanes[ClassForTopic(topic)]
+}
+
+func (pl *priorityLanes) schedule(l *lane, handler func()) error {
+	pl.mut.Lock()
+	defer pl.mut.Unlock()
+
+	if pl.isClosed {
+		return process.ErrPriorityLanesClosed
+	}
+	if len(l.queue) >= l.queueSize {
+		atomic.AddUint64(&l.numDropped, 1)
+		return process.ErrSystemBusy
+	}
+
+	l.queue = append(l.queue, handler)
+	pl.condForLane(l).Signal()
+
+	return nil
+}
+
+func (pl *priorityLanes) condForLane(l *lane) *sync.Cond {
+	if l == pl.consensusLane {
+		return pl.consensusCond
+	}
+
+	return pl.cond
+}
+
+func (pl *priorityLanes) work(waitNextHandler func() (*lane, func(), bool)) {
+	for {
+		l, handler, ok := waitNextHandler()
+		if !ok {
+			return
+		}
+
+		handler()
+
+		pl.mut.Lock()
+		l.numRunning--
+		atomic.AddUint64(&l.numProcessed, 1)
+		if len(l.queue) > 0 {
+			// the lane might have been skipped by the other workers because it reached its maximum number of workers
+			pl.condForLane(l).Signal()
+		}
+		pl.mut.Unlock()
+	}
+}
+
+func (pl *priorityLanes) waitNextHandler() (*lane, func(), bool) {
+	pl.mut.Lock()
+	defer pl.mut.Unlock()
+
+	for {
+		if pl.isClosed {
+			return nil, nil, false
+		}
+
+		l := pl.selectLaneNoLock()
+		if l != nil {
+			return l, l.popNoLock(), true
+		}
+
+		pl.cond.Wait()
+	}
+}
+
+func (pl *priorityLanes) waitNextConsensusHandler() (*lane, func(), bool) {
+	pl.mut.Lock()
+	defer pl.mut.Unlock()
+
+	for {
+		if pl.isClosed {
+			return nil, nil, false
+		}
+
+		if len(pl.consensusLane.queue) > 0 {
+			return pl.consensusLane, pl.consensusLane.popNoLock(), true
+		}
+
+		pl.consensusCond.Wait()
+	}
+}
+
+// selectLaneNoLock applies a smooth weighted round-robin on the lanes served by the shared workers that have pending
+// handlers and still have available workers. On equal weights, the lane with the higher priority wins
+func (pl *priorityLanes) selectLaneNoLock() *lane {
+	var selected *lane
+	totalWeight := 0
+	for _, l := range pl.orderedLanes {
+		if len(l.queue) == 0 || l.numRunning >= l.maxWorkers {
+			continue
+		}
+
+		l.currentWeight += l.weight
+		totalWeight += l.weight
+		if selected == nil || l.currentWeight > selected.currentWeight {
+			selected = l
+		}
+	}
+
+	if selected != nil {
+		selected.currentWeight -= totalWeight
+	}
+
+	return selected
+}
+
+func (pl *priorityLanes) saveMetricsLoop(ctx context.Context) {
+	timer := time.NewTimer(pl.metricsUpdateInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("priorityLanes.saveMetricsLoop go routine is stopping...")
+			return
+		case <-timer.C:
+			pl.saveMetrics()
+			timer.Reset(pl.metricsUpdateInterval)
+		}
+	}
+}
+
+func (pl *priorityLanes) saveMetrics() {
+	pl.mut.Lock()
+	defer pl.mut.Unlock()
+
+	for _, l := range pl.lanes {
+		class := string(l.class)
+		pl.appStatusHandler.SetUInt64Value(common.MetricInterceptorsLaneQueueDepthPrefix+class, uint64(len(l.queue)))
+		pl.appStatusHandler.SetUInt64Value(common.MetricInterceptorsLaneRunningPrefix+class, uint64(l.numRunning))
+		pl.appStatusHandler.SetUInt64Value(common.MetricInterceptorsLaneDroppedPrefix+class, atomic.LoadUint64(&l.numDropped))
+		pl.appStatusHandler.SetUInt64Value(common.MetricInterceptorsLaneProcessedPrefix+class, atomic.LoadUint64(&l.numProcessed))
+	}
+}
+
+// Close stops the workers and the metrics go routine. The handlers still queued are discarded
+func (pl *priorityLanes) Close() error {
+	pl.mut.Lock()
+	pl.isClosed = true
+	pl.mut.Unlock()
+
+	pl.cond.Broadcast()
+	pl.consensusCond.Broadcast()
+	pl.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pl *priorityLanes) IsInterfaceNil() bool {
+	return pl == nil
+}
@@ -0,0 +1,374 @@
+package lanes_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second * 5
+
+var consensusTopic = common.ConsensusTopic + "_0"
+var headersTopic = factory.ShardBlocksTopic + "_0_META"
+var transactionsTopic = factory.TransactionTopic + "_0"
+
+func createMockArgsPriorityLanes() lanes.ArgsPriorityLanes {
+	return lanes.ArgsPriorityLanes{
+		Config: config.InterceptorsPriorityLanesConfig{
+			Enabled:                        true,
+			NumWorkers:                     1,
+			MetricsUpdateIntervalInSeconds: 1,
+			Lanes: []config.InterceptorsPriorityLaneConfig{
+				{Class: "consensus", QueueSize: 10, MaxWorkers: 1},
+				{Class: "headers", Weight: 3, QueueSize: 10, MaxWorkers: 10},
+				{Class: "miniblocks", Weight: 2, QueueSize: 10, MaxWorkers: 10},
+				{Class: "trienodes", Weight: 1, QueueSize: 10, MaxWorkers: 10},
+				{Class: "other", Weight: 1, QueueSize: 10, MaxWorkers: 10},
+				{Class: "transactions", Weight: 1, QueueSize: 10, MaxWorkers: 10},
+			},
+		},
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+	}
+}
+
+type metricsRecorder struct {
+	mut     sync.Mutex
+	metrics map[string]uint64
+}
+
+func newMetricsRecorder() *metricsRecorder {
+	return &metricsRecorder{
+		metrics: make(map[string]uint64),
+	}
+}
+
+func (mr *metricsRecorder) appStatusHandler() *statusHandler.AppStatusHandlerStub {
+	return &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mr.mut.Lock()
+			mr.metrics[key] = value
+			mr.mut.Unlock()
+		},
+	}
+}
+
+func (mr *metricsRecorder) get(key string) uint64 {
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	return mr.metrics[key]
+}
+
+func waitChannel(t *testing.T, ch chan struct{}) {
+	select {
+	case <-ch:
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout waiting for handler")
+	}
+}
+
+func TestNewPriorityLanes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.AppStatusHandler = nil
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.Equal(t, process.ErrNilAppStatusHandler, err)
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("zero workers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.Config.NumWorkers = 0
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidPriorityLaneConfig))
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("unknown class should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.Config.Lanes[0].Class = "unknown"
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidPriorityLaneConfig))
+		assert.Contains(t, err.Error(), "unknown class")
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("duplicated class should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.Config.Lanes[2].Class = "headers"
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidPriorityLaneConfig))
+		assert.Contains(t, err.Error(), "duplicated class")
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("missing class should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.Config.Lanes = args.Config.Lanes[1:]
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidPriorityLaneConfig))
+		assert.Contains(t, err.Error(), "missing class")
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("zero values in lane config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.Config.Lanes[3].MaxWorkers = 0
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidPriorityLaneConfig))
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("zero weight should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriorityLanes()
+		args.Config.Lanes[1].Weight = 0
+		pl, err := lanes.NewPriorityLanes(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidPriorityLaneConfig))
+		assert.Contains(t, err.Error(), "Weight")
+		assert.True(t, check.IfNil(pl))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pl, err := lanes.NewPriorityLanes(createMockArgsPriorityLanes())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pl))
+		assert.Nil(t, pl.Close())
+	})
+}
+
+func TestPriorityLanes_ThrottlerShouldCountDrops(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPriorityLanes()
+	args.Config.Lanes[5].QueueSize = 2
+	recorder := newMetricsRecorder()
+	args.AppStatusHandler = recorder.appStatusHandler()
+	pl, _ := lanes.NewPriorityLanes(args)
+	defer func() {
+		_ = pl.Close()
+	}()
+
+	throttler := pl.Throttler(transactionsTopic)
+	assert.True(t, throttler.CanProcess())
+	throttler.StartProcessing()
+	throttler.StartProcessing()
+	assert.False(t, throttler.CanProcess())
+
+	// the other lanes are not affected
+	assert.True(t, pl.Throttler(headersTopic).CanProcess())
+
+	throttler.EndProcessing()
+	assert.True(t, throttler.CanProcess())
+
+	pl.SaveMetrics()
+	assert.Equal(t, uint64(1), recorder.get(common.MetricInterceptorsLaneDroppedPrefix+"transactions"))
+	assert.Equal(t, uint64(0), recorder.get(common.MetricInterceptorsLaneDroppedPrefix+"headers"))
+}
+
+func TestPriorityLanes_ScheduleShouldProcessByWeights(t *testing.T) {
+	t.Parallel()
+
+	pl, _ := lanes.NewPriorityLanes(createMockArgsPriorityLanes())
+	defer func() {
+		_ = pl.Close()
+	}()
+
+	// block the only worker until all the handlers are queued
+	chStarted := make(chan struct{})
+	chRelease := make(chan struct{})
+	err := pl.Scheduler(transactionsTopic).Schedule(func() {
+		close(chStarted)
+		<-chRelease
+	})
+	require.Nil(t, err)
+	waitChannel(t, chStarted)
+
+	mutOrder := sync.Mutex{}
+	order := make([]string, 0)
+	chDone := make(chan struct{})
+	numHandlers := int32(8)
+	createHandler := func(name string) func() {
+		return func() {
+			mutOrder.Lock()
+			order = append(order, name)
+			mutOrder.Unlock()
+
+			if atomic.AddInt32(&numHandlers, -1) == 0 {
+				close(chDone)
+			}
+		}
+	}
+	for i := 0; i < 4; i++ {
+		require.Nil(t, pl.Scheduler(transactionsTopic).Schedule(createHandler("t")))
+		require.Nil(t, pl.Scheduler(headersTopic).Schedule(createHandler("h")))
+	}
+
+	close(chRelease)
+	waitChannel(t, chDone)
+
+	mutOrder.Lock()
+	defer mutOrder.Unlock()
+	assert.Equal(t, []string{"h", "h", "t", "h", "h", "t", "t", "t"}, order)
+}
+
+func TestPriorityLanes_ScheduleShouldRespectMaxWorkers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPriorityLanes()
+	args.Config.NumWorkers = 3
+	args.Config.Lanes[1].MaxWorkers = 1
+	pl, _ := lanes.NewPriorityLanes(args)
+	defer func() {
+		_ = pl.Close()
+	}()
+
+	numRunning := int32(0)
+	maxRunning := int32(0)
+	chRelease := make(chan struct{})
+	chDone := make(chan struct{}, 3)
+	for i := 0; i < 3; i++ {
+		err := pl.Scheduler(headersTopic).Schedule(func() {
+			running := atomic.AddInt32(&numRunning, 1)
+			if running > atomic.LoadInt32(&maxRunning) {
+				atomic.StoreInt32(&maxRunning, running)
+			}
+			<-chRelease
+			atomic.AddInt32(&numRunning, -1)
+			chDone <- struct{}{}
+		})
+		require.Nil(t, err)
+	}
+
+	// a transaction can still be processed by one of the idle workers
+	chTxProcessed := make(chan struct{})
+	err := pl.Scheduler(transactionsTopic).Schedule(func() {
+		close(chTxProcessed)
+	})
+	require.Nil(t, err)
+	waitChannel(t, chTxProcessed)
+
+	close(chRelease)
+	for i := 0; i < 3; i++ {
+		<-chDone
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+}
+
+func TestPriorityLanes_ScheduleShouldServeConsensusUnderTransactionsFlood(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPriorityLanes()
+	args.Config.NumWorkers = 2
+	args.Config.Lanes[5].QueueSize = 100
+	pl, _ := lanes.NewPriorityLanes(args)
+	defer func() {
+		_ = pl.Close()
+	}()
+
+	// all the shared workers are busy with transactions and more transactions are queued
+	chStarted := make(chan struct{}, 2)
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+	for i := 0; i < 100; i++ {
+		err := pl.Scheduler(transactionsTopic).Schedule(func() {
+			chStarted <- struct{}{}
+			<-chRelease
+		})
+		require.Nil(t, err)
+	}
+	for i := 0; i < 2; i++ {
+		waitChannel(t, chStarted)
+	}
+
+	// the headers wait for a shared worker
+	headersProcessed := int32(0)
+	err := pl.Scheduler(headersTopic).Schedule(func() {
+		atomic.StoreInt32(&headersProcessed, 1)
+	})
+	require.Nil(t, err)
+
+	// the consensus messages are still served, by the consensus lane workers
+	numConsensusMessages := 10
+	numPending := int32(numConsensusMessages)
+	chConsensusDone := make(chan struct{})
+	for i := 0; i < numConsensusMessages; i++ {
+		err = pl.Scheduler(consensusTopic).Schedule(func() {
+			if atomic.AddInt32(&numPending, -1) == 0 {
+				close(chConsensusDone)
+			}
+		})
+		require.Nil(t, err)
+	}
+	waitChannel(t, chConsensusDone)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&headersProcessed))
+}
+
+func TestPriorityLanes_ScheduleOnFullQueueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPriorityLanes()
+	args.Config.Lanes[5].QueueSize = 1
+	recorder := newMetricsRecorder()
+	args.AppStatusHandler = recorder.appStatusHandler()
+	pl, _ := lanes.NewPriorityLanes(args)
+	defer func() {
+		_ = pl.Close()
+	}()
+
+	chStarted := make(chan struct{})
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+	err := pl.Scheduler(transactionsTopic).Schedule(func() {
+		close(chStarted)
+		<-chRelease
+	})
+	require.Nil(t, err)
+	waitChannel(t, chStarted)
+
+	err = pl.Scheduler(transactionsTopic).Schedule(func() {})
+	assert.Nil(t, err)
+	err = pl.Scheduler(transactionsTopic).Schedule(func() {})
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	pl.SaveMetrics()
+	assert.Equal(t, uint64(1), recorder.get(common.MetricInterceptorsLaneQueueDepthPrefix+"transactions"))
+	assert.Equal(t, uint64(1), recorder.get(common.MetricInterceptorsLaneRunningPrefix+"transactions"))
+	assert.Equal(t, uint64(1), recorder.get(common.MetricInterceptorsLaneDroppedPrefix+"transactions"))
+	assert.Equal(t, uint64(0), recorder.get(common.MetricInterceptorsLaneProcessedPrefix+"transactions"))
+}
+
+func TestPriorityLanes_ScheduleAfterCloseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pl, _ := lanes.NewPriorityLanes(createMockArgsPriorityLanes())
+	err := pl.Close()
+	assert.Nil(t, err)
+
+	err = pl.Scheduler(headersTopic).Schedule(func() {
+		assert.Fail(t, "should have not been called")
+	})
+	assert.Equal(t, process.ErrPriorityLanesClosed, err)
+}
@@ -0,0 +1,65 @@
+package lanes
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process/factory"
+)
+
+// TopicClass defines the class of a topic, used to decide the processing priority of the intercepted data
+type TopicClass string
+
+const (
+	// ConsensusClass is the class of the consensus topics, served by the consensus worker
+	ConsensusClass TopicClass = "consensus"
+	// HeadersClass is the class of the shard and metachain headers topics
+	HeadersClass TopicClass = "headers"
+	// MiniBlocksClass is the class of the block bodies topics
+	MiniBlocksClass TopicClass = "miniblocks"
+	// TrieNodesClass is the class of the trie nodes topics
+	TrieNodesClass TopicClass = "trienodes"
+	// OtherClass is the class of all the topics not covered by the other classes (heartbeat, validator info etc.)
+	OtherClass TopicClass = "other"
+	// TransactionsClass is the class of the transactions topics
+	TransactionsClass TopicClass = "transactions"
+)
+
+// orderedClasses holds all the topic classes, from the highest priority to the lowest one
+var orderedClasses = []TopicClass{
+	ConsensusClass,
+	HeadersClass,
+	MiniBlocksClass,
+	TrieNodesClass,
+	OtherClass,
+	TransactionsClass,
+}
+
+type topicPrefixClass struct {
+	prefix string
+	class  TopicClass
+}
+
+var topicPrefixes = []topicPrefixClass{
+	{prefix: common.ConsensusTopic, class: ConsensusClass},
+	{prefix: factory.ShardBlocksTopic, class: HeadersClass},
+	{prefix: factory.MetachainBlocksTopic, class: HeadersClass},
+	{prefix: factory.MiniBlocksTopic, class: MiniBlocksClass},
+	{prefix: factory.PeerChBodyTopic, class: MiniBlocksClass},
+	{prefix: factory.AccountTrieNodesTopic, class: TrieNodesClass},
+	{prefix: factory.ValidatorTrieNodesTopic, class: TrieNodesClass},
+	{prefix: factory.TransactionTopic, class: TransactionsClass},
+	{prefix: factory.UnsignedTransactionTopic, class: TransactionsClass},
+	{prefix: factory.RewardsTransactionTopic, class: TransactionsClass},
+}
+
+// ClassForTopic returns the class of the provided topic
+func ClassForTopic(topic string) TopicClass {
+	for _, tpc := range topicPrefixes {
+		if strings.HasPrefix(topic, tpc.prefix) {
+			return tpc.class
+		}
+	}
+
+	return OtherClass
+}
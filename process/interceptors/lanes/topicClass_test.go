@@ -0,0 +1,29 @@
+package lanes_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassForTopic(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, lanes.ConsensusClass, lanes.ClassForTopic(common.ConsensusTopic+"_0"))
+	assert.Equal(t, lanes.HeadersClass, lanes.ClassForTopic(factory.ShardBlocksTopic+"_0_META"))
+	assert.Equal(t, lanes.HeadersClass, lanes.ClassForTopic(factory.MetachainBlocksTopic))
+	assert.Equal(t, lanes.MiniBlocksClass, lanes.ClassForTopic(factory.MiniBlocksTopic+"_0_1"))
+	assert.Equal(t, lanes.MiniBlocksClass, lanes.ClassForTopic(factory.PeerChBodyTopic+"_0_META"))
+	assert.Equal(t, lanes.TrieNodesClass, lanes.ClassForTopic(factory.AccountTrieNodesTopic+"_0_META"))
+	assert.Equal(t, lanes.TrieNodesClass, lanes.ClassForTopic(factory.ValidatorTrieNodesTopic+"_META"))
+	assert.Equal(t, lanes.TransactionsClass, lanes.ClassForTopic(factory.TransactionTopic+"_0_1"))
+	assert.Equal(t, lanes.TransactionsClass, lanes.ClassForTopic(factory.UnsignedTransactionTopic+"_0_META"))
+	assert.Equal(t, lanes.TransactionsClass, lanes.ClassForTopic(factory.RewardsTransactionTopic+"_META_0"))
+	assert.Equal(t, lanes.OtherClass, lanes.ClassForTopic(common.PeerAuthenticationTopic))
+	assert.Equal(t, lanes.OtherClass, lanes.ClassForTopic(common.HeartbeatV2Topic+"_0"))
+	assert.Equal(t, lanes.OtherClass, lanes.ClassForTopic(common.ValidatorInfoTopic+"_0_META"))
+	assert.Equal(t, lanes.OtherClass, lanes.ClassForTopic("unknown topic"))
+}
@@ -24,6 +24,7 @@ type ArgMultiDataInterceptor struct {
 	DataFactory          process.InterceptedDataFactory
 	Processor            process.InterceptorProcessor
 	Throttler            process.InterceptorThrottler
+	Scheduler            process.InterceptedDataScheduler
 	AntifloodHandler     process.P2PAntifloodHandler
 	WhiteListRequest     process.WhiteListHandler
 	PreferredPeersHolder process.PreferredPeersHolderHandler
@@ -57,6 +58,9 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 	if check.IfNil(arg.Throttler) {
 		return nil, process.ErrNilInterceptorThrottler
 	}
+	if check.IfNil(arg.Scheduler) {
+		return nil, process.ErrNilInterceptedDataScheduler
+	}
 	if check.IfNil(arg.AntifloodHandler) {
 		return nil, process.ErrNilAntifloodHandler
 	}
@@ -73,6 +77,7 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 	multiDataIntercept := &MultiDataInterceptor{
 		baseDataInterceptor: &baseDataInterceptor{
 			throttler:            arg.Throttler,
+			scheduler:            arg.Scheduler,
 			antifloodHandler:     arg.AntifloodHandler,
 			topic:                arg.Topic,
 			currentPeerId:        arg.CurrentPeerId,
@@ -184,12 +189,16 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		}
 	}
 
-	go func() {
+	err = mdi.scheduler.Schedule(func() {
 		for _, interceptedData := range listInterceptedData {
 			mdi.processInterceptedData(interceptedData, message)
 		}
 		mdi.throttler.EndProcessing()
-	}()
+	})
+	if err != nil {
+		mdi.throttler.EndProcessing()
+		return err
+	}
 
 	return nil
 }
@@ -28,6 +28,7 @@ func createMockArgMultiDataInterceptor() interceptors.ArgMultiDataInterceptor {
 		DataFactory:          &mock.InterceptedDataFactoryStub{},
 		Processor:            &mock.InterceptorProcessorStub{},
 		Throttler:            createMockThrottler(),
+		Scheduler:            &mock.InterceptedDataSchedulerStub{},
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		WhiteListRequest:     &testscommon.WhiteListHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
@@ -90,6 +91,17 @@ func TestNewMultiDataInterceptor_NilInterceptorThrottlerShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilInterceptorThrottler, err)
 }
 
+func TestNewMultiDataInterceptor_NilInterceptedDataSchedulerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	arg.Scheduler = nil
+	interceptor, err := interceptors.NewMultiDataInterceptor(arg)
+
+	assert.Nil(t, interceptor)
+	assert.Equal(t, process.ErrNilInterceptedDataScheduler, err)
+}
+
 func TestNewMultiDataInterceptor_NilAntifloodHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -371,6 +383,44 @@ func testProcessReceiveMessageMultiData(t *testing.T, isForCurrentShard bool, ex
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
 }
 
+func TestMultiDataInterceptor_ProcessReceivedMessageSchedulerErrorsShouldEndProcessing(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	processCalledNum := int32(0)
+	throttler := createMockThrottler()
+	expectedErr := errors.New("expected error")
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Processor = createMockInterceptorStub(nil, &processCalledNum)
+	arg.Throttler = throttler
+	arg.Scheduler = &mock.InterceptedDataSchedulerStub{
+		ScheduleCalled: func(handler func()) error {
+			return expectedErr
+		},
+	}
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("buff1")}})
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: dataField,
+	}
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&processCalledNum))
+	assert.Equal(t, int32(1), throttler.StartProcessingCount())
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
+
 func TestMultiDataInterceptor_ProcessReceivedMessageCheckBatchErrors(t *testing.T) {
 	buffData := [][]byte{[]byte("buff1"), []byte("buff2")}
 
@@ -15,6 +15,7 @@ type ArgSingleDataInterceptor struct {
 	DataFactory          process.InterceptedDataFactory
 	Processor            process.InterceptorProcessor
 	Throttler            process.InterceptorThrottler
+	Scheduler            process.InterceptedDataScheduler
 	AntifloodHandler     process.P2PAntifloodHandler
 	WhiteListRequest     process.WhiteListHandler
 	PreferredPeersHolder process.PreferredPeersHolderHandler
@@ -42,6 +43,9 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 	if check.IfNil(arg.Throttler) {
 		return nil, process.ErrNilInterceptorThrottler
 	}
+	if check.IfNil(arg.Scheduler) {
+		return nil, process.ErrNilInterceptedDataScheduler
+	}
 	if check.IfNil(arg.AntifloodHandler) {
 		return nil, process.ErrNilAntifloodHandler
 	}
@@ -58,6 +62,7 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 	singleDataIntercept := &SingleDataInterceptor{
 		baseDataInterceptor: &baseDataInterceptor{
 			throttler:            arg.Throttler,
+			scheduler:            arg.Scheduler,
 			antifloodHandler:     arg.AntifloodHandler,
 			topic:                arg.Topic,
 			currentPeerId:        arg.CurrentPeerId,
@@ -136,10 +141,14 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		return nil
 	}
 
-	go func() {
+	err = sdi.scheduler.Schedule(func() {
 		sdi.processInterceptedData(interceptedData, message)
 		sdi.throttler.EndProcessing()
-	}()
+	})
+	if err != nil {
+		sdi.throttler.EndProcessing()
+		return err
+	}
 
 	return nil
 }
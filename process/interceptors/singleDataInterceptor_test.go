@@ -8,9 +8,11 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +25,7 @@ func createMockArgSingleDataInterceptor() interceptors.ArgSingleDataInterceptor
 		DataFactory:          &mock.InterceptedDataFactoryStub{},
 		Processor:            &mock.InterceptorProcessorStub{},
 		Throttler:            createMockThrottler(),
+		Scheduler:            &mock.InterceptedDataSchedulerStub{},
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		WhiteListRequest:     &testscommon.WhiteListHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
@@ -101,6 +104,17 @@ func TestNewSingleDataInterceptor_NilInterceptorThrottlerShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilInterceptorThrottler, err)
 }
 
+func TestNewSingleDataInterceptor_NilInterceptedDataSchedulerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	arg.Scheduler = nil
+	interceptor, err := interceptors.NewSingleDataInterceptor(arg)
+
+	assert.Nil(t, interceptor)
+	assert.Equal(t, process.ErrNilInterceptedDataScheduler, err)
+}
+
 func TestNewSingleDataInterceptor_NilP2PAntifloodHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -261,6 +275,110 @@ func testProcessReceiveMessage(t *testing.T, isForCurrentShard bool, validityErr
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
 }
 
+func TestSingleDataInterceptor_ProcessReceivedMessageSchedulerErrorsShouldEndProcessing(t *testing.T) {
+	t.Parallel()
+
+	processCalledNum := int32(0)
+	throttler := createMockThrottler()
+	expectedErr := errors.New("expected error")
+	arg := createMockArgSingleDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Processor = createMockInterceptorStub(nil, &processCalledNum)
+	arg.Throttler = throttler
+	arg.Scheduler = &mock.InterceptedDataSchedulerStub{
+		ScheduleCalled: func(handler func()) error {
+			return expectedErr
+		},
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&processCalledNum))
+	assert.Equal(t, int32(1), throttler.StartProcessingCount())
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageThrottledShouldOverloadAdaptiveTopic(t *testing.T) {
+	t.Parallel()
+
+	numGoRoutines := int32(100)
+	maxQueueDepth := uint32(50)
+	adaptivePreventer, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(floodPreventers.ArgAdaptiveTopicFloodPreventer{
+		DefaultMaxMessagesPerPeer: 100,
+		TargetProcessingLatency:   time.Second,
+		MaxQueueDepth:             maxQueueDepth,
+		MinQuotaPercent:           10,
+		DecreaseStepPercent:       50,
+		RecoveryStepPercent:       10,
+		LowHonestyScoreThreshold:  -40,
+	})
+	_ = adaptivePreventer.SetPeerValidatorMapper(&mock.PeerShardMapperStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return core.P2PPeerInfo{PeerType: core.ObserverPeer}
+		},
+	})
+	goRoutinesThrottler, _ := throttler.NewNumGoRoutinesThrottler(numGoRoutines)
+
+	arg := createMockArgSingleDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Throttler = goRoutinesThrottler
+	// the scheduled handlers are never started, simulating a processing that can not keep up
+	arg.Scheduler = &mock.InterceptedDataSchedulerStub{
+		ScheduleCalled: func(handler func()) error {
+			return nil
+		},
+	}
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		StartProcessingOnTopicCalled: adaptivePreventer.StartProcessingOnTopic,
+		EndProcessingOnTopicCalled:   adaptivePreventer.EndProcessingOnTopic,
+		ThrottledOnTopicCalled:       adaptivePreventer.ThrottledOnTopic,
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	numBusy := uint32(0)
+	for i := 0; i < int(numGoRoutines)+int(maxQueueDepth)+1; i++ {
+		err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+		if err == process.ErrSystemBusy {
+			numBusy++
+		}
+	}
+	assert.Equal(t, maxQueueDepth+1, numBusy)
+
+	adaptivePreventer.UpdateQuotas()
+
+	numAccepted := 0
+	for i := 0; i < 100; i++ {
+		if adaptivePreventer.IncreaseLoad("observer", arg.Topic, 1) == nil {
+			numAccepted++
+		}
+	}
+	assert.Equal(t, 50, numAccepted)
+}
+
 func TestSingleDataInterceptor_ProcessReceivedMessageWhitelistedShouldWork(t *testing.T) {
 	t.Parallel()
 
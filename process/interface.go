@@ -2,6 +2,7 @@ package process
 
 import (
 	"math/big"
+	"net"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -22,6 +23,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
@@ -32,6 +34,8 @@ import (
 	"github.com/multiversx/mx-chain-go/storage"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
 )
 
 // TransactionProcessor is the main interface for transaction execution engine
@@ -145,6 +149,20 @@ type InterceptorThrottler interface {
 	IsInterfaceNil() bool
 }
 
+// InterceptedDataScheduler is able to schedule the processing of the intercepted data
+type InterceptedDataScheduler interface {
+	Schedule(handler func()) error
+	IsInterfaceNil() bool
+}
+
+// InterceptorPriorityLanesHandler provides the throttler and the scheduler used by the interceptors of a topic
+type InterceptorPriorityLanesHandler interface {
+	Throttler(topic string) InterceptorThrottler
+	Scheduler(topic string) InterceptedDataScheduler
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // TransactionCoordinator is an interface to coordinate transaction processing using multiple processors
 type TransactionCoordinator interface {
 	RequestMiniBlocksAndTransactions(header data.HeaderHandler)
@@ -319,6 +337,8 @@ type TransactionLogProcessorDatabase interface {
 type ValidatorsProvider interface {
 	GetLatestValidators() map[string]*validator.ValidatorStatistics
 	GetAuctionList() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuction(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	GetValidatorScorecard(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
 	ForceUpdate() error
 	IsInterfaceNil() bool
 	Close() error
@@ -461,6 +481,12 @@ type VirtualMachinesContainerFactory interface {
 	IsInterfaceNil() bool
 }
 
+// VMHooksGasTracer defines the executor factory of a wasm VM which records the gas consumed by each VM hook
+type VMHooksGasTracer interface {
+	executor.ExecutorAbstractFactory
+	SetVMHost(vmHost vmhost.VMHost)
+}
+
 // EpochStartTriggerHandler defines that actions which are needed by processor for start of epoch
 type EpochStartTriggerHandler interface {
 	Update(round uint64, nonce uint64)
@@ -724,6 +750,8 @@ type EconomicsDataHandler interface {
 	rewardsHandler
 	feeHandler
 	SetStatusHandler(statusHandler core.AppStatusHandler) error
+	SetParameterChangesProvider(provider governance.ParameterChangesProvider) error
+	ConfiguredGasLimitsInEpoch(epoch uint32) governance.GasLimits
 	IsInterfaceNil() bool
 }
 
@@ -733,6 +761,21 @@ type SmartContractToProtocolHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ScheduledCallsProcessor dispatches, on every metachain block, the calls which became due in the scheduled calls
+// system smart contract
+type ScheduledCallsProcessor interface {
+	ProcessDueCalls(header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// ValidatorsStatusChangesCollector detects the validators' list changes produced between two epoch starts
+type ValidatorsStatusChangesCollector interface {
+	SetPreviousStatus(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32)
+	ComputeStatusChanges(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32)
+	GetStatusChanges(epoch uint32) []*common.ValidatorStatusChange
+	IsInterfaceNil() bool
+}
+
 // PeerChangesHandler will create the peer changes data for current block and will verify them
 type PeerChangesHandler interface {
 	PeerChanges() []block.PeerData
@@ -907,6 +950,48 @@ type TopicFloodPreventer interface {
 	ResetForTopic(topic string)
 	ResetForNotRegisteredTopics()
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	StartProcessingOnTopic(topic string)
+	ThrottledOnTopic(topic string)
+	EndProcessingOnTopic(topic string, processingTime time.Duration)
+	IsInterfaceNil() bool
+}
+
+// AdaptiveTopicFloodPreventer defines a topic flood preventer that adjusts its quotas based on the observed load
+// and on the peers' trust level
+type AdaptiveTopicFloodPreventer interface {
+	TopicFloodPreventer
+	SetPeerValidatorMapper(validatorMapper PeerValidatorMapper) error
+	SetPeerHonestyScorer(scorer PeerHonestyScorer) error
+	SetDebugger(debugger AntifloodDebugger) error
+}
+
+// PeerHonestyScorer is able to provide the current honesty score of a public key
+type PeerHonestyScorer interface {
+	GetScore(pk string) float64
+	IsInterfaceNil() bool
+}
+
+// PeerHonestyScoresHandler is able to export and import the honesty scores of all known public keys
+type PeerHonestyScoresHandler interface {
+	GetAllScores() map[string]map[string]float64
+	SetScores(pk string, scoresByTopic map[string]float64)
+	IsInterfaceNil() bool
+}
+
+// PeerReputationHandler defines the behavior of a component able to keep the peers' reputation (bans, pins and
+// honesty scores) across node restarts
+type PeerReputationHandler interface {
+	GetState() common.PeerReputationState
+	BanPeer(pid core.PeerID, duration time.Duration) error
+	UnbanPeer(pid core.PeerID) error
+	BanIPRange(ipRange string, duration time.Duration) error
+	UnbanIPRange(ipRange string) error
+	PinPeer(pid core.PeerID) error
+	UnpinPeer(pid core.PeerID) error
+	IsPinned(pid core.PeerID) bool
+	IsIPBanned(ip net.IP) bool
+	SetPeerHonestyHandler(handler PeerHonestyScoresHandler) error
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -919,6 +1004,9 @@ type P2PAntifloodHandler interface {
 	SetDebugger(debugger AntifloodDebugger) error
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error
+	StartProcessingOnTopic(topic string)
+	ThrottledOnTopic(topic string)
+	EndProcessingOnTopic(topic string, processingTime time.Duration)
 	IsInterfaceNil() bool
 	Close() error
 }
@@ -986,6 +1074,7 @@ type EpochStartSystemSCProcessor interface {
 		rewardTxs epochStart.TransactionCacher,
 	) error
 	ToggleUnStakeUnBond(value bool) error
+	ParameterChanges() ([]*governance.ParameterChange, error)
 	IsInterfaceNil() bool
 }
 
@@ -1087,6 +1176,7 @@ type PreferredPeersHolderHandler interface {
 // AntifloodDebugger defines an interface for debugging the antiflood behavior
 type AntifloodDebugger interface {
 	AddData(pid core.PeerID, topic string, numRejected uint32, sizeRejected uint64, sequence []byte, isBlacklisted bool)
+	AddQuotaDecision(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string)
 	Close() error
 	IsInterfaceNil() bool
 }
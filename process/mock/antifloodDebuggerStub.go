@@ -4,8 +4,9 @@ import "github.com/multiversx/mx-chain-core-go/core"
 
 // AntifloodDebuggerStub -
 type AntifloodDebuggerStub struct {
-	AddDataCalled func(pid core.PeerID, topic string, numRejected uint32, sizeRejected uint64, sequence []byte, isBlacklisted bool)
-	CloseCalled   func() error
+	AddDataCalled          func(pid core.PeerID, topic string, numRejected uint32, sizeRejected uint64, sequence []byte, isBlacklisted bool)
+	AddQuotaDecisionCalled func(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string)
+	CloseCalled            func() error
 }
 
 // AddData -
@@ -15,6 +16,13 @@ func (ads *AntifloodDebuggerStub) AddData(pid core.PeerID, topic string, numReje
 	}
 }
 
+// AddQuotaDecision -
+func (ads *AntifloodDebuggerStub) AddQuotaDecision(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string) {
+	if ads.AddQuotaDecisionCalled != nil {
+		ads.AddQuotaDecisionCalled(topic, oldQuotaPercent, newQuotaPercent, reason)
+	}
+}
+
 // Close -
 func (ads *AntifloodDebuggerStub) Close() error {
 	if ads.CloseCalled != nil {
@@ -0,0 +1,22 @@
+package mock
+
+// InterceptedDataSchedulerStub -
+type InterceptedDataSchedulerStub struct {
+	ScheduleCalled func(handler func()) error
+}
+
+// Schedule -
+func (stub *InterceptedDataSchedulerStub) Schedule(handler func()) error {
+	if stub.ScheduleCalled != nil {
+		return stub.ScheduleCalled(handler)
+	}
+
+	go handler()
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *InterceptedDataSchedulerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
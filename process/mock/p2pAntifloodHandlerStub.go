@@ -16,6 +16,9 @@ type P2PAntifloodHandlerStub struct {
 	SetDebuggerCalled                  func(debugger process.AntifloodDebugger) error
 	BlacklistPeerCalled                func(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopicCalled func(pid core.PeerID, topic string) error
+	StartProcessingOnTopicCalled       func(topic string)
+	ThrottledOnTopicCalled             func(topic string)
+	EndProcessingOnTopicCalled         func(topic string, processingTime time.Duration)
 }
 
 // CanProcessMessage -
@@ -65,6 +68,27 @@ func (p2pahs *P2PAntifloodHandlerStub) BlacklistPeer(peer core.PeerID, reason st
 	}
 }
 
+// StartProcessingOnTopic -
+func (p2pahs *P2PAntifloodHandlerStub) StartProcessingOnTopic(topic string) {
+	if p2pahs.StartProcessingOnTopicCalled != nil {
+		p2pahs.StartProcessingOnTopicCalled(topic)
+	}
+}
+
+// ThrottledOnTopic -
+func (p2pahs *P2PAntifloodHandlerStub) ThrottledOnTopic(topic string) {
+	if p2pahs.ThrottledOnTopicCalled != nil {
+		p2pahs.ThrottledOnTopicCalled(topic)
+	}
+}
+
+// EndProcessingOnTopic -
+func (p2pahs *P2PAntifloodHandlerStub) EndProcessingOnTopic(topic string, processingTime time.Duration) {
+	if p2pahs.EndProcessingOnTopicCalled != nil {
+		p2pahs.EndProcessingOnTopicCalled(topic, processingTime)
+	}
+}
+
 // Close -
 func (p2pahs *P2PAntifloodHandlerStub) Close() error {
 	return nil
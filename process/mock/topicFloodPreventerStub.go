@@ -1,12 +1,19 @@
 package mock
 
-import "github.com/multiversx/mx-chain-core-go/core"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
 	IncreaseLoadCalled           func(pid core.PeerID, topic string, numMessages uint32) error
 	ResetForTopicCalled          func(topic string)
 	SetMaxMessagesForTopicCalled func(topic string, num uint32)
+	StartProcessingOnTopicCalled func(topic string)
+	ThrottledOnTopicCalled       func(topic string)
+	EndProcessingOnTopicCalled   func(topic string, processingTime time.Duration)
 }
 
 // IncreaseLoad -
@@ -36,6 +43,27 @@ func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(topic string, num uint32) {
 	}
 }
 
+// StartProcessingOnTopic -
+func (t *TopicAntiFloodStub) StartProcessingOnTopic(topic string) {
+	if t.StartProcessingOnTopicCalled != nil {
+		t.StartProcessingOnTopicCalled(topic)
+	}
+}
+
+// ThrottledOnTopic -
+func (t *TopicAntiFloodStub) ThrottledOnTopic(topic string) {
+	if t.ThrottledOnTopicCalled != nil {
+		t.ThrottledOnTopicCalled(topic)
+	}
+}
+
+// EndProcessingOnTopic -
+func (t *TopicAntiFloodStub) EndProcessingOnTopic(topic string, processingTime time.Duration) {
+	if t.EndProcessingOnTopicCalled != nil {
+		t.EndProcessingOnTopicCalled(topic, processingTime)
+	}
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
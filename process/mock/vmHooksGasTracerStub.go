@@ -0,0 +1,32 @@
+package mock
+
+import (
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+)
+
+// VMHooksGasTracerStub -
+type VMHooksGasTracerStub struct {
+	ResetCalled       func()
+	GetGasTraceCalled func() map[string]*txSimData.APIFunctionGas
+}
+
+// Reset -
+func (stub *VMHooksGasTracerStub) Reset() {
+	if stub.ResetCalled != nil {
+		stub.ResetCalled()
+	}
+}
+
+// GetGasTrace -
+func (stub *VMHooksGasTracerStub) GetGasTrace() map[string]*txSimData.APIFunctionGas {
+	if stub.GetGasTraceCalled != nil {
+		return stub.GetGasTraceCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *VMHooksGasTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
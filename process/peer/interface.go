@@ -1,6 +1,8 @@
 package peer
 
 import (
+	"math/big"
+
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/state"
@@ -17,6 +19,7 @@ type StakingDataProviderAPI interface {
 	ComputeUnQualifiedNodes(validatorInfos state.ShardValidatorsInfoMapHandler) ([][]byte, map[string][][]byte, error)
 	FillValidatorInfo(validator state.ValidatorInfoHandler) error
 	GetOwnersData() map[string]*epochStart.OwnerData
+	SimulateOwnerAuctionChanges(owner []byte, additionalTopUp *big.Int, additionalNodes []state.ValidatorInfoHandler) error
 	Clean()
 	IsInterfaceNil() bool
 }
@@ -10,12 +10,14 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 var _ process.ValidatorsProvider = (*validatorsProvider)(nil)
@@ -38,6 +40,8 @@ type validatorsProvider struct {
 	addressPubKeyConverter       core.PubkeyConverter
 	stakingDataProvider          StakingDataProviderAPI
 	auctionListSelector          epochStart.AuctionListSelector
+	validatorPerformanceStorer   storage.Storer
+	marshaller                   marshal.Marshalizer
 
 	maxRating    uint32
 	currentEpoch uint32
@@ -53,6 +57,8 @@ type ArgValidatorsProvider struct {
 	AddressPubKeyConverter            core.PubkeyConverter
 	StakingDataProvider               StakingDataProviderAPI
 	AuctionListSelector               epochStart.AuctionListSelector
+	ValidatorPerformanceStorer        storage.Storer
+	Marshalizer                       marshal.Marshalizer
 	StartEpoch                        uint32
 	MaxRating                         uint32
 }
@@ -83,6 +89,12 @@ func NewValidatorsProvider(
 	if check.IfNil(args.AuctionListSelector) {
 		return nil, epochStart.ErrNilAuctionListSelector
 	}
+	if check.IfNil(args.ValidatorPerformanceStorer) {
+		return nil, fmt.Errorf("%w for validator performance", process.ErrNilStorage)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
 	if args.MaxRating == 0 {
 		return nil, process.ErrMaxRatingZero
 	}
@@ -109,6 +121,8 @@ func NewValidatorsProvider(
 		addressPubKeyConverter:       args.AddressPubKeyConverter,
 		currentEpoch:                 args.StartEpoch,
 		auctionListSelector:          args.AuctionListSelector,
+		validatorPerformanceStorer:   args.ValidatorPerformanceStorer,
+		marshaller:                   args.Marshalizer,
 	}
 
 	go valProvider.startRefreshProcess(currentContext)
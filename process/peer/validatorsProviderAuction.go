@@ -72,7 +72,7 @@ func (vp *validatorsProvider) createValidatorsAuctionCache(validatorsMap state.S
 		return nil, err
 	}
 
-	selectedNodes, err := vp.getSelectedNodesFromAuction(validatorsMap)
+	selectedNodes, err := vp.getSelectedNodesFromAuction(validatorsMap, vp.cachedRandomness)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +83,16 @@ func (vp *validatorsProvider) createValidatorsAuctionCache(validatorsMap state.S
 }
 
 func (vp *validatorsProvider) fillAllValidatorsInfo(validatorsMap state.ShardValidatorsInfoMapHandler) error {
+	err := vp.fillValidatorsInfo(validatorsMap)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = vp.stakingDataProvider.ComputeUnQualifiedNodes(validatorsMap)
+	return err
+}
+
+func (vp *validatorsProvider) fillValidatorsInfo(validatorsMap state.ShardValidatorsInfoMapHandler) error {
 	for _, validator := range validatorsMap.GetAllValidatorsInfo() {
 		err := vp.stakingDataProvider.FillValidatorInfo(validator)
 		if err != nil {
@@ -90,14 +100,14 @@ func (vp *validatorsProvider) fillAllValidatorsInfo(validatorsMap state.ShardVal
 		}
 	}
 
-	_, _, err := vp.stakingDataProvider.ComputeUnQualifiedNodes(validatorsMap)
-	return err
+	return nil
 }
 
 // this func should be called under mutex protection
-func (vp *validatorsProvider) getSelectedNodesFromAuction(validatorsMap state.ShardValidatorsInfoMapHandler) ([]state.ValidatorInfoHandler, error) {
-	randomness := vp.cachedRandomness
-
+func (vp *validatorsProvider) getSelectedNodesFromAuction(
+	validatorsMap state.ShardValidatorsInfoMapHandler,
+	randomness []byte,
+) ([]state.ValidatorInfoHandler, error) {
 	err := vp.auctionListSelector.SelectNodesFromAuctionList(validatorsMap, randomness)
 	if err != nil {
 		return nil, err
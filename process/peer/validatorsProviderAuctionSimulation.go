@@ -0,0 +1,174 @@
+package peer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const maxSimulatedAuctionNodes = 100
+const simulatedNodeKeyPrefix = "simulated"
+
+// SimulateAuction re-runs the auction list selection over the latest finalized staking data, after applying the
+// hypothetical changes of an owner: an additional top-up and/or additional staked nodes. The cached auction list is not
+// affected by the simulation
+func (vp *validatorsProvider) SimulateAuction(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	if args == nil {
+		return nil, process.ErrNilAuctionSimulationArgs
+	}
+	owner, err := vp.addressPubKeyConverter.Decode(args.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("%w for owner %s", err, args.Owner)
+	}
+	additionalTopUp, err := parseAdditionalTopUp(args.AdditionalTopUp)
+	if err != nil {
+		return nil, err
+	}
+	if args.AdditionalNodes > maxSimulatedAuctionNodes {
+		return nil, fmt.Errorf("%w, provided: %d, maximum: %d",
+			process.ErrTooManySimulatedAuctionNodes, args.AdditionalNodes, maxSimulatedAuctionNodes)
+	}
+
+	// the simulation uses the randomness of the cached auction list, so it selects the nodes as the auction list does
+	err = vp.updateAuctionListCacheIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	vp.auctionMutex.Lock()
+	defer vp.auctionMutex.Unlock()
+
+	rootHash := vp.validatorStatistics.LastFinalizedRootHash()
+	if len(rootHash) == 0 {
+		return nil, state.ErrNilRootHash
+	}
+
+	validatorsMap, err := vp.validatorStatistics.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	simulatedNodes := vp.createSimulatedAuctionNodes(args.AdditionalNodes)
+	auctionList, err := vp.createSimulatedAuctionList(validatorsMap, vp.cachedRandomness, owner, additionalTopUp, simulatedNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedOwner := vp.addressPubKeyConverter.SilentEncode(owner, log)
+	return vp.createAuctionSimulationResponse(auctionList, encodedOwner, simulatedNodes), nil
+}
+
+func parseAdditionalTopUp(topUp string) (*big.Int, error) {
+	if len(topUp) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	additionalTopUp, ok := big.NewInt(0).SetString(topUp, 10)
+	if !ok || additionalTopUp.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s", epochStart.ErrInvalidAdditionalTopUp, topUp)
+	}
+
+	return additionalTopUp, nil
+}
+
+func (vp *validatorsProvider) createSimulatedAuctionNodes(numNodes uint32) []state.ValidatorInfoHandler {
+	keyLength := vp.validatorPubKeyConverter.Len()
+	simulatedNodes := make([]state.ValidatorInfoHandler, 0, numNodes)
+	for i := uint32(0); i < numNodes; i++ {
+		blsKey := make([]byte, keyLength)
+		copy(blsKey, fmt.Sprintf("%s%d", simulatedNodeKeyPrefix, i))
+
+		simulatedNodes = append(simulatedNodes, &state.ValidatorInfo{
+			PublicKey: blsKey,
+			List:      string(common.AuctionList),
+		})
+	}
+
+	return simulatedNodes
+}
+
+// this func should be called under mutex protection
+func (vp *validatorsProvider) createSimulatedAuctionList(
+	validatorsMap state.ShardValidatorsInfoMapHandler,
+	randomness []byte,
+	owner []byte,
+	additionalTopUp *big.Int,
+	simulatedNodes []state.ValidatorInfoHandler,
+) ([]*common.AuctionListValidatorAPIResponse, error) {
+	defer vp.stakingDataProvider.Clean()
+
+	err := vp.fillValidatorsInfo(validatorsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vp.stakingDataProvider.SimulateOwnerAuctionChanges(owner, additionalTopUp, simulatedNodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, simulatedNode := range simulatedNodes {
+		err = validatorsMap.Add(simulatedNode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, _, err = vp.stakingDataProvider.ComputeUnQualifiedNodes(validatorsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedNodes, err := vp.getSelectedNodesFromAuction(validatorsMap, randomness)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionListValidators, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(selectedNodes)
+	sortList(auctionListValidators, qualifiedOwners)
+
+	return auctionListValidators, nil
+}
+
+func (vp *validatorsProvider) createAuctionSimulationResponse(
+	auctionList []*common.AuctionListValidatorAPIResponse,
+	encodedOwner string,
+	simulatedNodes []state.ValidatorInfoHandler,
+) *common.AuctionSimulationAPIResponse {
+	simulatedKeys := make(map[string]struct{}, len(simulatedNodes))
+	for _, simulatedNode := range simulatedNodes {
+		encodedKey := vp.validatorPubKeyConverter.SilentEncode(simulatedNode.GetPublicKey(), log)
+		simulatedKeys[encodedKey] = struct{}{}
+	}
+
+	response := &common.AuctionSimulationAPIResponse{
+		AuctionList:    auctionList,
+		ThresholdTopUp: "0",
+	}
+
+	// the auction list is sorted descending by the qualified top-up, so the last qualified node is the marginal one
+	for _, ownerData := range auctionList {
+		for _, node := range ownerData.Nodes {
+			_, node.Simulated = simulatedKeys[node.BlsKey]
+			if !node.Qualified {
+				continue
+			}
+
+			response.ThresholdTopUp = ownerData.QualifiedTopUp
+			response.MarginalNode = &common.AuctionMarginalNode{
+				Owner:          ownerData.Owner,
+				BlsKey:         node.BlsKey,
+				QualifiedTopUp: ownerData.QualifiedTopUp,
+			}
+		}
+
+		if ownerData.Owner == encodedOwner {
+			response.Owner = ownerData
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,121 @@
+package peer
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const defaultNumScorecardEpochs = 10
+const maxNumScorecardEpochs = 100
+
+// GetValidatorScorecard returns the performance history of the provided validator for the last numEpochs ended
+// epochs, most recent epoch first. The history is only recorded by the metachain nodes, at every epoch start, so
+// epochs without a record (node not yet registered, history not available on the current node) are skipped
+func (vp *validatorsProvider) GetValidatorScorecard(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+	pubKey, err := vp.validatorPubKeyConverter.Decode(blsKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for bls key %s", err, blsKey)
+	}
+	if numEpochs == 0 {
+		numEpochs = defaultNumScorecardEpochs
+	}
+	if numEpochs > maxNumScorecardEpochs {
+		return nil, fmt.Errorf("%w, provided: %d, maximum: %d", process.ErrTooManyScorecardEpochs, numEpochs, maxNumScorecardEpochs)
+	}
+
+	vp.lock.RLock()
+	currentEpoch := vp.currentEpoch
+	vp.lock.RUnlock()
+
+	performances := vp.getValidatorPerformances(pubKey, currentEpoch, numEpochs)
+
+	return &common.ValidatorScorecard{
+		BlsKey:       blsKey,
+		Epochs:       vp.createEpochScorecards(performances),
+		JailEvents:   getJailEvents(performances),
+		ShardChanges: getShardChanges(performances),
+	}, nil
+}
+
+// getValidatorPerformances returns the stored records, most recent epoch first. The record of an epoch is saved by the
+// start of epoch block of the next epoch, so it is fetched from the storer of the next epoch
+func (vp *validatorsProvider) getValidatorPerformances(pubKey []byte, currentEpoch uint32, numEpochs uint32) []*common.ValidatorEpochPerformance {
+	performances := make([]*common.ValidatorEpochPerformance, 0, numEpochs)
+	for i := uint32(1); i <= numEpochs && i <= currentEpoch; i++ {
+		epoch := currentEpoch - i
+		buff, err := vp.validatorPerformanceStorer.GetFromEpoch(common.ValidatorPerformanceKey(pubKey, epoch), epoch+1)
+		if err != nil {
+			continue
+		}
+
+		performance := &common.ValidatorEpochPerformance{}
+		err = vp.marshaller.Unmarshal(performance, buff)
+		if err != nil {
+			log.Debug("validatorsProvider.getValidatorPerformances", "epoch", epoch, "error", err)
+			continue
+		}
+
+		performances = append(performances, performance)
+	}
+
+	return performances
+}
+
+func (vp *validatorsProvider) createEpochScorecards(performances []*common.ValidatorEpochPerformance) []*common.ValidatorEpochScorecard {
+	scorecards := make([]*common.ValidatorEpochScorecard, 0, len(performances))
+	for _, performance := range performances {
+		scorecards = append(scorecards, &common.ValidatorEpochScorecard{
+			Epoch:             performance.Epoch,
+			ShardID:           performance.ShardID,
+			List:              performance.List,
+			ProposedBlocks:    performance.LeaderSuccess,
+			MissedBlocks:      performance.LeaderFailure,
+			SignedBlocks:      performance.ValidatorSuccess,
+			MissedSignatures:  performance.ValidatorFailure,
+			IgnoredSignatures: performance.ValidatorIgnoredSignatures,
+			StartRating:       float32(performance.StartRating) * 100 / float32(vp.maxRating),
+			EndRating:         float32(performance.EndRating) * 100 / float32(vp.maxRating),
+		})
+	}
+
+	return scorecards
+}
+
+// getJailEvents returns the epochs in which the validator has been jailed, most recent first
+func getJailEvents(performances []*common.ValidatorEpochPerformance) []uint32 {
+	jailEvents := make([]uint32, 0)
+	for i, performance := range performances {
+		if performance.List != string(common.JailedList) {
+			continue
+		}
+
+		isLastRecord := i == len(performances)-1
+		if isLastRecord || performances[i+1].List != string(common.JailedList) {
+			jailEvents = append(jailEvents, performance.Epoch)
+		}
+	}
+
+	return jailEvents
+}
+
+// getShardChanges returns the shuffling history of the validator, most recent first
+func getShardChanges(performances []*common.ValidatorEpochPerformance) []*common.ValidatorShardChange {
+	shardChanges := make([]*common.ValidatorShardChange, 0)
+	for i := 0; i < len(performances)-1; i++ {
+		current := performances[i]
+		previous := performances[i+1]
+		if current.ShardID == previous.ShardID {
+			continue
+		}
+
+		shardChanges = append(shardChanges, &common.ValidatorShardChange{
+			Epoch:     current.Epoch,
+			FromShard: previous.ShardID,
+			ToShard:   current.ShardID,
+		})
+	}
+
+	return shardChanges
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
@@ -111,6 +112,24 @@ func TestNewValidatorsProvider_WithNilAuctionListSelectorShouldErr(t *testing.T)
 	require.Equal(t, epochStart.ErrNilAuctionListSelector, err)
 }
 
+func TestNewValidatorsProvider_WithNilValidatorPerformanceStorerShouldErr(t *testing.T) {
+	arg := createDefaultValidatorsProviderArg()
+	arg.ValidatorPerformanceStorer = nil
+	vp, err := NewValidatorsProvider(arg)
+
+	require.Nil(t, vp)
+	require.ErrorIs(t, err, process.ErrNilStorage)
+}
+
+func TestNewValidatorsProvider_WithNilMarshalizerShouldErr(t *testing.T) {
+	arg := createDefaultValidatorsProviderArg()
+	arg.Marshalizer = nil
+	vp, err := NewValidatorsProvider(arg)
+
+	require.Nil(t, vp)
+	require.Equal(t, process.ErrNilMarshalizer, err)
+}
+
 func TestValidatorsProvider_GetLatestValidatorsSecondHashDoesNotExist(t *testing.T) {
 	mut := sync.Mutex{}
 	root := []byte("rootHash")
@@ -1163,6 +1182,208 @@ func createMockShardValidatorInfo() *state.ShardValidatorInfo {
 	return initialInfo
 }
 
+func TestValidatorsProvider_SimulateAuction(t *testing.T) {
+	t.Parallel()
+
+	owner1 := []byte("owner1")
+	owner2 := []byte("owner2")
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+
+		simulation, err := vp.SimulateAuction(nil)
+		require.Nil(t, simulation)
+		require.Equal(t, process.ErrNilAuctionSimulationArgs, err)
+
+		simulation, err = vp.SimulateAuction(&common.AuctionSimulationArgs{Owner: "not hex"})
+		require.Nil(t, simulation)
+		require.NotNil(t, err)
+
+		simulation, err = vp.SimulateAuction(&common.AuctionSimulationArgs{Owner: hex.EncodeToString(owner1), AdditionalTopUp: "-5"})
+		require.Nil(t, simulation)
+		require.ErrorIs(t, err, epochStart.ErrInvalidAdditionalTopUp)
+
+		simulation, err = vp.SimulateAuction(&common.AuctionSimulationArgs{Owner: hex.EncodeToString(owner1), AdditionalNodes: maxSimulatedAuctionNodes + 1})
+		require.Nil(t, simulation)
+		require.ErrorIs(t, err, process.ErrTooManySimulatedAuctionNodes)
+	})
+	t.Run("error simulating owner changes, staking data provider cache should be cleaned", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+		cleanCalled := &coreAtomic.Flag{}
+		expectedErr := errors.New("local error")
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return state.NewShardValidatorsInfoMap(), nil
+			},
+		}
+		args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+			SimulateOwnerAuctionChangesCalled: func(owner []byte, additionalTopUp *big.Int, additionalNodes []state.ValidatorInfoHandler) error {
+				return expectedErr
+			},
+			CleanCalled: func() {
+				cleanCalled.SetValue(true)
+			},
+		}
+		args.CacheRefreshIntervalDurationInSec = time.Hour
+		vp, _ := NewValidatorsProvider(args)
+		setCachedAuctionList(vp, []byte("root hash"))
+
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationArgs{Owner: hex.EncodeToString(owner1)})
+		require.Nil(t, simulation)
+		require.Equal(t, expectedErr, err)
+		require.True(t, cleanCalled.IsSet())
+	})
+	t.Run("should use the randomness of the cached auction list", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+		args.CacheRefreshIntervalDurationInSec = time.Hour
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return state.NewShardValidatorsInfoMap(), nil
+			},
+		}
+		var usedRandomness []byte
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SelectNodesFromAuctionListCalled: func(validatorsInfoMap state.ShardValidatorsInfoMapHandler, randomness []byte) error {
+				usedRandomness = randomness
+				return nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		setCachedAuctionList(vp, []byte("cached randomness"))
+
+		_, err := vp.SimulateAuction(&common.AuctionSimulationArgs{Owner: hex.EncodeToString(owner1)})
+		require.Nil(t, err)
+		require.Equal(t, []byte("cached randomness"), usedRandomness)
+	})
+	t.Run("should select the simulated nodes and compute the threshold", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+		v1 := &state.ValidatorInfo{PublicKey: []byte("pk1"), List: string(common.AuctionList)}
+		v2 := &state.ValidatorInfo{PublicKey: []byte("pk2"), List: string(common.AuctionList)}
+		ownersData := map[string]*epochStart.OwnerData{
+			string(owner1): {
+				NumStakedNodes: 1,
+				TotalTopUp:     big.NewInt(1000),
+				TopUpPerNode:   big.NewInt(1000),
+				AuctionList:    []state.ValidatorInfoHandler{v1},
+				Qualified:      true,
+			},
+			string(owner2): {
+				NumStakedNodes: 1,
+				TotalTopUp:     big.NewInt(500),
+				TopUpPerNode:   big.NewInt(500),
+				AuctionList:    []state.ValidatorInfoHandler{v2},
+				Qualified:      true,
+			},
+		}
+
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				validatorsMap := state.NewShardValidatorsInfoMap()
+				_ = validatorsMap.Add(v1)
+				_ = validatorsMap.Add(v2)
+				return validatorsMap, nil
+			},
+		}
+		args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+			SimulateOwnerAuctionChangesCalled: func(owner []byte, additionalTopUp *big.Int, additionalNodes []state.ValidatorInfoHandler) error {
+				require.Equal(t, owner1, owner)
+				require.Equal(t, big.NewInt(2000), additionalTopUp)
+				require.Equal(t, 1, len(additionalNodes))
+
+				ownerData := ownersData[string(owner1)]
+				ownerData.NumStakedNodes = 2
+				ownerData.TotalTopUp = big.NewInt(3000)
+				ownerData.TopUpPerNode = big.NewInt(1500)
+				ownerData.AuctionList = append(ownerData.AuctionList, additionalNodes...)
+				return nil
+			},
+			GetOwnersDataCalled: func() map[string]*epochStart.OwnerData {
+				return ownersData
+			},
+		}
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SelectNodesFromAuctionListCalled: func(validatorsInfoMap state.ShardValidatorsInfoMapHandler, randomness []byte) error {
+				require.Equal(t, 3, len(validatorsInfoMap.GetAllValidatorsInfo()))
+				for _, node := range ownersData[string(owner1)].AuctionList {
+					selectedNode := node.ShallowClone()
+					selectedNode.SetList(string(common.SelectedFromAuctionList))
+					_ = validatorsInfoMap.Replace(node, selectedNode)
+				}
+				return nil
+			},
+		}
+		args.CacheRefreshIntervalDurationInSec = time.Hour
+		vp, _ := NewValidatorsProvider(args)
+		setCachedAuctionList(vp, []byte("root hash"))
+
+		simulation, err := vp.SimulateAuction(&common.AuctionSimulationArgs{
+			Owner:           hex.EncodeToString(owner1),
+			AdditionalTopUp: "2000",
+			AdditionalNodes: 1,
+		})
+		require.Nil(t, err)
+
+		simulatedKey := make([]byte, args.ValidatorPubKeyConverter.Len())
+		copy(simulatedKey, simulatedNodeKeyPrefix+"0")
+		expectedOwner1 := &common.AuctionListValidatorAPIResponse{
+			Owner:          hex.EncodeToString(owner1),
+			NumStakedNodes: 2,
+			TotalTopUp:     "3000",
+			TopUpPerNode:   "1500",
+			QualifiedTopUp: "1500",
+			Nodes: []*common.AuctionNode{
+				{BlsKey: hex.EncodeToString(v1.PublicKey), Qualified: true},
+				{BlsKey: hex.EncodeToString(simulatedKey), Qualified: true, Simulated: true},
+			},
+		}
+		expectedOwner2 := &common.AuctionListValidatorAPIResponse{
+			Owner:          hex.EncodeToString(owner2),
+			NumStakedNodes: 1,
+			TotalTopUp:     "500",
+			TopUpPerNode:   "500",
+			QualifiedTopUp: "500",
+			Nodes: []*common.AuctionNode{
+				{BlsKey: hex.EncodeToString(v2.PublicKey), Qualified: false},
+			},
+		}
+		expectedSimulation := &common.AuctionSimulationAPIResponse{
+			AuctionList:    []*common.AuctionListValidatorAPIResponse{expectedOwner1, expectedOwner2},
+			Owner:          expectedOwner1,
+			ThresholdTopUp: "1500",
+			MarginalNode: &common.AuctionMarginalNode{
+				Owner:          hex.EncodeToString(owner1),
+				BlsKey:         hex.EncodeToString(simulatedKey),
+				QualifiedTopUp: "1500",
+			},
+		}
+		require.Equal(t, expectedSimulation, simulation)
+	})
+}
+
+func setCachedAuctionList(vp *validatorsProvider, randomness []byte) {
+	vp.auctionMutex.Lock()
+	vp.cachedRandomness = randomness
+	vp.lastAuctionCacheUpdate = time.Now()
+	vp.auctionMutex.Unlock()
+}
+
 func createDefaultValidatorsProviderArg() ArgValidatorsProvider {
 	return ArgValidatorsProvider{
 		NodesCoordinator:                  &shardingMocks.NodesCoordinatorMock{},
@@ -1175,9 +1396,101 @@ func createDefaultValidatorsProviderArg() ArgValidatorsProvider {
 				return []byte("rootHash")
 			},
 		},
-		MaxRating:                100,
-		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
-		AddressPubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
-		AuctionListSelector:      &stakingcommon.AuctionListSelectorStub{},
+		MaxRating:                  100,
+		ValidatorPubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		AddressPubKeyConverter:     testscommon.NewPubkeyConverterMock(32),
+		AuctionListSelector:        &stakingcommon.AuctionListSelectorStub{},
+		ValidatorPerformanceStorer: testscommon.CreateMemUnit(),
+		Marshalizer:                &marshal.GogoProtoMarshalizer{},
 	}
 }
+
+func TestValidatorsProvider_GetValidatorScorecard(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("bls")
+	savePerformance := func(args ArgValidatorsProvider, performance *common.ValidatorEpochPerformance) {
+		buff, _ := args.Marshalizer.Marshal(performance)
+		_ = args.ValidatorPerformanceStorer.Put(common.ValidatorPerformanceKey(blsKey, performance.Epoch), buff)
+	}
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+
+		scorecard, err := vp.GetValidatorScorecard("not hex", 1)
+		require.Nil(t, scorecard)
+		require.NotNil(t, err)
+
+		scorecard, err = vp.GetValidatorScorecard(hex.EncodeToString(blsKey), maxNumScorecardEpochs+1)
+		require.Nil(t, scorecard)
+		require.ErrorIs(t, err, process.ErrTooManyScorecardEpochs)
+	})
+	t.Run("no history should return an empty scorecard", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createDefaultValidatorsProviderArg())
+
+		scorecard, err := vp.GetValidatorScorecard(hex.EncodeToString(blsKey), 0)
+		require.Nil(t, err)
+		require.Equal(t, &common.ValidatorScorecard{
+			BlsKey:       hex.EncodeToString(blsKey),
+			Epochs:       make([]*common.ValidatorEpochScorecard, 0),
+			JailEvents:   make([]uint32, 0),
+			ShardChanges: make([]*common.ValidatorShardChange, 0),
+		}, scorecard)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultValidatorsProviderArg()
+		args.StartEpoch = 10
+		savePerformance(args, &common.ValidatorEpochPerformance{Epoch: 9, ShardID: 1, List: string(common.JailedList), StartRating: 20, EndRating: 10})
+		savePerformance(args, &common.ValidatorEpochPerformance{Epoch: 8, ShardID: 1, List: string(common.JailedList), StartRating: 30, EndRating: 20})
+		savePerformance(args, &common.ValidatorEpochPerformance{
+			Epoch:                      7,
+			ShardID:                    1,
+			List:                       string(common.EligibleList),
+			LeaderSuccess:              1,
+			LeaderFailure:              2,
+			ValidatorSuccess:           3,
+			ValidatorFailure:           4,
+			ValidatorIgnoredSignatures: 5,
+			StartRating:                50,
+			EndRating:                  30,
+		})
+		savePerformance(args, &common.ValidatorEpochPerformance{Epoch: 6, ShardID: 0, List: string(common.EligibleList), StartRating: 40, EndRating: 50})
+		savePerformance(args, &common.ValidatorEpochPerformance{Epoch: 4, ShardID: 2, List: string(common.JailedList), StartRating: 40, EndRating: 40})
+		vp, _ := NewValidatorsProvider(args)
+
+		scorecard, err := vp.GetValidatorScorecard(hex.EncodeToString(blsKey), 5)
+		require.Nil(t, err)
+		require.Equal(t, []*common.ValidatorEpochScorecard{
+			{Epoch: 9, ShardID: 1, List: string(common.JailedList), StartRating: 20, EndRating: 10},
+			{Epoch: 8, ShardID: 1, List: string(common.JailedList), StartRating: 30, EndRating: 20},
+			{
+				Epoch:             7,
+				ShardID:           1,
+				List:              string(common.EligibleList),
+				ProposedBlocks:    1,
+				MissedBlocks:      2,
+				SignedBlocks:      3,
+				MissedSignatures:  4,
+				IgnoredSignatures: 5,
+				StartRating:       50,
+				EndRating:         30,
+			},
+			{Epoch: 6, ShardID: 0, List: string(common.EligibleList), StartRating: 40, EndRating: 50},
+		}, scorecard.Epochs)
+		require.Equal(t, []uint32{8}, scorecard.JailEvents)
+		require.Equal(t, []*common.ValidatorShardChange{{Epoch: 7, FromShard: 0, ToShard: 1}}, scorecard.ShardChanges)
+
+		scorecard, _ = vp.GetValidatorScorecard(hex.EncodeToString(blsKey), 6)
+		require.Equal(t, []uint32{8, 4}, scorecard.JailEvents)
+		require.Equal(t, []*common.ValidatorShardChange{
+			{Epoch: 7, FromShard: 0, ToShard: 1},
+			{Epoch: 6, FromShard: 2, ToShard: 0},
+		}, scorecard.ShardChanges)
+	})
+}
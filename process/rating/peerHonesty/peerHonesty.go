@@ -196,6 +196,76 @@ func (pph *p2pPeerHonesty) ChangeScore(pk string, topic string, units int) {
 	pph.checkBlacklistNoLock(ps)
 }
 
+// GetScore returns the lowest score of a public key across all topics. Unknown public keys have a neutral (0) score
+func (pph *p2pPeerHonesty) GetScore(pk string) float64 {
+	pph.mut.RLock()
+	defer pph.mut.RUnlock()
+
+	psObj, ok := pph.cache.Peek([]byte(pk))
+	if !ok {
+		return 0
+	}
+
+	ps, ok := psObj.(*peerScore)
+	if !ok {
+		return 0
+	}
+
+	lowestScore := float64(0)
+	for _, score := range ps.scoresByTopic {
+		if score < lowestScore {
+			lowestScore = score
+		}
+	}
+
+	return lowestScore
+}
+
+// GetAllScores returns a copy of all the known scores, indexed by public key and topic
+func (pph *p2pPeerHonesty) GetAllScores() map[string]map[string]float64 {
+	pph.mut.RLock()
+	defer pph.mut.RUnlock()
+
+	allScores := make(map[string]map[string]float64)
+	for _, key := range pph.cache.Keys() {
+		psObj, ok := pph.cache.Peek(key)
+		if !ok {
+			continue
+		}
+
+		ps, ok := psObj.(*peerScore)
+		if !ok || len(ps.scoresByTopic) == 0 {
+			continue
+		}
+
+		scoresByTopic := make(map[string]float64, len(ps.scoresByTopic))
+		for topic, score := range ps.scoresByTopic {
+			scoresByTopic[topic] = score
+		}
+		allScores[ps.pk] = scoresByTopic
+	}
+
+	return allScores
+}
+
+// SetScores will overwrite the scores of the provided public key. Values outside the [MinScore, MaxScore] interval are capped
+func (pph *p2pPeerHonesty) SetScores(pk string, scoresByTopic map[string]float64) {
+	pph.mut.Lock()
+	defer pph.mut.Unlock()
+
+	ps := pph.getValidPeerScoreNoLock(pk)
+	for topic, score := range scoresByTopic {
+		if score > pph.maxScore {
+			score = pph.maxScore
+		}
+		if score < pph.minScore {
+			score = pph.minScore
+		}
+
+		ps.scoresByTopic[topic] = score
+	}
+}
+
 func (pph *p2pPeerHonesty) getValidPeerScoreNoLock(pk string) *peerScore {
 	key := []byte(pk)
 
@@ -224,6 +224,60 @@ func TestP2pPeerHonesty_DoubleChangeScoreShouldWork(t *testing.T) {
 	assert.Equal(t, float64(units+units)*cfg.UnitValue, ps.scoresByTopic[topic])
 }
 
+func TestP2pPeerHonesty_GetScore(t *testing.T) {
+	t.Parallel()
+
+	pph, _ := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+	)
+
+	pk := "pk"
+	assert.Equal(t, float64(0), pph.GetScore(pk))
+
+	pph.ChangeScore(pk, "topic1", 10)
+	assert.Equal(t, float64(0), pph.GetScore(pk))
+
+	pph.ChangeScore(pk, "topic2", -5)
+	assert.Equal(t, float64(-5), pph.GetScore(pk))
+}
+
+func TestP2pPeerHonesty_GetAllScoresAndSetScores(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+	)
+
+	assert.Equal(t, 0, len(pph.GetAllScores()))
+
+	pph.ChangeScore("pk1", "topic1", 10)
+	pph.SetScores("pk2", map[string]float64{
+		"topic1": 5,
+		"topic2": cfg.MaxScore + 1,
+		"topic3": cfg.MinScore - 1,
+	})
+
+	expectedScores := map[string]map[string]float64{
+		"pk1": {"topic1": 10 * cfg.UnitValue},
+		"pk2": {
+			"topic1": 5,
+			"topic2": cfg.MaxScore,
+			"topic3": cfg.MinScore,
+		},
+	}
+	allScores := pph.GetAllScores()
+	assert.Equal(t, expectedScores, allScores)
+
+	// the returned map is a copy
+	allScores["pk1"]["topic1"] = 0
+	assert.Equal(t, expectedScores, pph.GetAllScores())
+}
+
 func TestP2pPeerHonesty_CheckBlacklistNotBlacklisted(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,67 @@
+package peerReputation
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// peerBlackListCacher exposes the banned peer IDs of the store as a process.PeerBlackListCacher
+type peerBlackListCacher struct {
+	store *peerReputationStore
+}
+
+// Upsert bans the provided peer ID for the given span, unless the peer is pinned
+func (pblc *peerBlackListCacher) Upsert(pid core.PeerID, span time.Duration) error {
+	return pblc.store.upsertPeer(pid, span)
+}
+
+// Has returns true if the provided peer ID is banned
+func (pblc *peerBlackListCacher) Has(pid core.PeerID) bool {
+	return pblc.store.hasPeer(pid)
+}
+
+// Sweep removes all the expired bans
+func (pblc *peerBlackListCacher) Sweep() {
+	pblc.store.Sweep()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pblc *peerBlackListCacher) IsInterfaceNil() bool {
+	return pblc == nil
+}
+
+// publicKeysCacher exposes the banned public keys of the store as a process.TimeCacher
+type publicKeysCacher struct {
+	store *peerReputationStore
+}
+
+// Add bans the provided public key for the default span
+func (pkc *publicKeysCacher) Add(key string) error {
+	return pkc.store.upsertPublicKey(key, pkc.store.defaultSpan)
+}
+
+// Upsert bans the provided public key for the given span
+func (pkc *publicKeysCacher) Upsert(key string, span time.Duration) error {
+	return pkc.store.upsertPublicKey(key, span)
+}
+
+// Has returns true if the provided public key is banned
+func (pkc *publicKeysCacher) Has(key string) bool {
+	return pkc.store.hasPublicKey(key)
+}
+
+// Sweep removes all the expired bans
+func (pkc *publicKeysCacher) Sweep() {
+	pkc.store.Sweep()
+}
+
+// Len returns the number of banned public keys, including the ones not swept yet
+func (pkc *publicKeysCacher) Len() int {
+	return pkc.store.numPublicKeys()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pkc *publicKeysCacher) IsInterfaceNil() bool {
+	return pkc == nil
+}
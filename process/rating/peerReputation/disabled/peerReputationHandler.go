@@ -0,0 +1,84 @@
+package disabled
+
+import (
+	"net"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+type peerReputationHandler struct {
+}
+
+// NewPeerReputationHandler returns a disabled implementation of the peer reputation handler
+func NewPeerReputationHandler() *peerReputationHandler {
+	return &peerReputationHandler{}
+}
+
+// GetState returns an empty state
+func (handler *peerReputationHandler) GetState() common.PeerReputationState {
+	return common.PeerReputationState{
+		BannedPeers:      make([]common.PeerReputationBan, 0),
+		BannedPublicKeys: make([]common.PeerReputationBan, 0),
+		BannedIPRanges:   make([]common.PeerReputationBan, 0),
+		PinnedPeers:      make([]string, 0),
+		HonestyScores:    make(map[string]map[string]float64),
+	}
+}
+
+// BanPeer returns nil
+func (handler *peerReputationHandler) BanPeer(_ core.PeerID, _ time.Duration) error {
+	return nil
+}
+
+// UnbanPeer returns nil
+func (handler *peerReputationHandler) UnbanPeer(_ core.PeerID) error {
+	return nil
+}
+
+// BanIPRange returns nil
+func (handler *peerReputationHandler) BanIPRange(_ string, _ time.Duration) error {
+	return nil
+}
+
+// UnbanIPRange returns nil
+func (handler *peerReputationHandler) UnbanIPRange(_ string) error {
+	return nil
+}
+
+// PinPeer returns nil
+func (handler *peerReputationHandler) PinPeer(_ core.PeerID) error {
+	return nil
+}
+
+// UnpinPeer returns nil
+func (handler *peerReputationHandler) UnpinPeer(_ core.PeerID) error {
+	return nil
+}
+
+// IsPinned returns false
+func (handler *peerReputationHandler) IsPinned(_ core.PeerID) bool {
+	return false
+}
+
+// IsIPBanned returns false
+func (handler *peerReputationHandler) IsIPBanned(_ net.IP) bool {
+	return false
+}
+
+// SetPeerHonestyHandler returns nil
+func (handler *peerReputationHandler) SetPeerHonestyHandler(_ process.PeerHonestyScoresHandler) error {
+	return nil
+}
+
+// Close returns nil
+func (handler *peerReputationHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *peerReputationHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,23 @@
+package peerReputation
+
+import (
+	"net"
+	"time"
+)
+
+// SetTimeHandler -
+func (prs *peerReputationStore) SetTimeHandler(handler func() time.Time) {
+	prs.mut.Lock()
+	prs.getTimeHandler = handler
+	prs.mut.Unlock()
+}
+
+// Persist -
+func (prs *peerReputationStore) Persist() {
+	prs.persist()
+}
+
+// ExtractIP -
+func ExtractIP(address string) net.IP {
+	return extractIP(address)
+}
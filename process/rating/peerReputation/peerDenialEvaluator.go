@@ -0,0 +1,91 @@
+package peerReputation
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// PeerAddressesProvider is able to return the known addresses (multiaddr format) of a peer
+type PeerAddressesProvider interface {
+	PeerAddresses(pid core.PeerID) []string
+	IsInterfaceNil() bool
+}
+
+// ArgsPeerDenialEvaluator is the argument DTO used to create a new peer denial evaluator
+type ArgsPeerDenialEvaluator struct {
+	DenialEvaluator   p2p.PeerDenialEvaluator
+	ReputationHandler process.PeerReputationHandler
+	AddressesProvider PeerAddressesProvider
+}
+
+type peerDenialEvaluator struct {
+	denialEvaluator   p2p.PeerDenialEvaluator
+	reputationHandler process.PeerReputationHandler
+	addressesProvider PeerAddressesProvider
+}
+
+// NewPeerDenialEvaluator creates a peer denial evaluator that decorates the provided one with the pinned peers and
+// the banned IP ranges of the reputation handler
+func NewPeerDenialEvaluator(args ArgsPeerDenialEvaluator) (*peerDenialEvaluator, error) {
+	if check.IfNil(args.DenialEvaluator) {
+		return nil, process.ErrNilPeerDenialEvaluator
+	}
+	if check.IfNil(args.ReputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(args.AddressesProvider) {
+		return nil, process.ErrNilPeerAddressesProvider
+	}
+
+	return &peerDenialEvaluator{
+		denialEvaluator:   args.DenialEvaluator,
+		reputationHandler: args.ReputationHandler,
+		addressesProvider: args.AddressesProvider,
+	}, nil
+}
+
+// IsDenied returns true if the provided peer id is denied to access the network. Pinned peers are never denied
+func (pde *peerDenialEvaluator) IsDenied(pid core.PeerID) bool {
+	if pde.reputationHandler.IsPinned(pid) {
+		return false
+	}
+	if pde.denialEvaluator.IsDenied(pid) {
+		return true
+	}
+
+	for _, address := range pde.addressesProvider.PeerAddresses(pid) {
+		if pde.reputationHandler.IsIPBanned(extractIP(address)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpsertPeerID will update or insert the provided peer id in the wrapped denial evaluator
+func (pde *peerDenialEvaluator) UpsertPeerID(pid core.PeerID, duration time.Duration) error {
+	return pde.denialEvaluator.UpsertPeerID(pid, duration)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pde *peerDenialEvaluator) IsInterfaceNil() bool {
+	return pde == nil
+}
+
+// extractIP returns the IP contained in a multiaddr such as /ip4/127.0.0.1/tcp/37373 or nil if none is found
+func extractIP(address string) net.IP {
+	components := strings.Split(address, "/")
+	for i := 0; i < len(components)-1; i++ {
+		if components[i] == "ip4" || components[i] == "ip6" {
+			return net.ParseIP(components[i+1])
+		}
+	}
+
+	return nil
+}
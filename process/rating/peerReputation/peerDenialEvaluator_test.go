@@ -0,0 +1,125 @@
+package peerReputation_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsPeerDenialEvaluator() peerReputation.ArgsPeerDenialEvaluator {
+	return peerReputation.ArgsPeerDenialEvaluator{
+		DenialEvaluator:   &mock.PeerDenialEvaluatorStub{},
+		ReputationHandler: &testscommon.PeerReputationHandlerStub{},
+		AddressesProvider: &p2pmocks.MessengerStub{},
+	}
+}
+
+func TestNewPeerDenialEvaluator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil denial evaluator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerDenialEvaluator()
+		args.DenialEvaluator = nil
+		pde, err := peerReputation.NewPeerDenialEvaluator(args)
+		assert.True(t, check.IfNil(pde))
+		assert.Equal(t, process.ErrNilPeerDenialEvaluator, err)
+	})
+	t.Run("nil reputation handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerDenialEvaluator()
+		args.ReputationHandler = nil
+		pde, err := peerReputation.NewPeerDenialEvaluator(args)
+		assert.True(t, check.IfNil(pde))
+		assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+	})
+	t.Run("nil addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerDenialEvaluator()
+		args.AddressesProvider = nil
+		pde, err := peerReputation.NewPeerDenialEvaluator(args)
+		assert.True(t, check.IfNil(pde))
+		assert.Equal(t, process.ErrNilPeerAddressesProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pde, err := peerReputation.NewPeerDenialEvaluator(createMockArgsPeerDenialEvaluator())
+		assert.False(t, check.IfNil(pde))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPeerDenialEvaluator_IsDenied(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPeerDenialEvaluator()
+	args.DenialEvaluator = &mock.PeerDenialEvaluatorStub{
+		IsDeniedCalled: func(pid core.PeerID) bool {
+			return pid == "blacklisted" || pid == "pinned"
+		},
+	}
+	args.ReputationHandler = &testscommon.PeerReputationHandlerStub{
+		IsPinnedCalled: func(pid core.PeerID) bool {
+			return pid == "pinned"
+		},
+		IsIPBannedCalled: func(ip net.IP) bool {
+			return ip.Equal(net.ParseIP("10.0.0.1"))
+		},
+	}
+	args.AddressesProvider = &p2pmocks.MessengerStub{
+		PeerAddressesCalled: func(pid core.PeerID) []string {
+			if pid == "banned ip" {
+				return []string{"/ip4/127.0.0.1/tcp/37373", "/ip4/10.0.0.1/tcp/37373"}
+			}
+
+			return []string{"/ip4/127.0.0.1/tcp/37373"}
+		},
+	}
+	pde, _ := peerReputation.NewPeerDenialEvaluator(args)
+
+	assert.True(t, pde.IsDenied("blacklisted"))
+	assert.True(t, pde.IsDenied("banned ip"))
+	assert.False(t, pde.IsDenied("pinned"))
+	assert.False(t, pde.IsDenied("other"))
+}
+
+func TestPeerDenialEvaluator_UpsertPeerID(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	args := createMockArgsPeerDenialEvaluator()
+	args.DenialEvaluator = &mock.PeerDenialEvaluatorStub{
+		UpsertPeerIDCalled: func(pid core.PeerID, duration time.Duration) error {
+			assert.Equal(t, core.PeerID("pid"), pid)
+			assert.Equal(t, time.Minute, duration)
+			wasCalled = true
+			return nil
+		},
+	}
+	pde, _ := peerReputation.NewPeerDenialEvaluator(args)
+
+	assert.Nil(t, pde.UpsertPeerID("pid", time.Minute))
+	assert.True(t, wasCalled)
+}
+
+func TestExtractIP(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, net.ParseIP("10.0.0.1").Equal(peerReputation.ExtractIP("/ip4/10.0.0.1/tcp/37373/p2p/16Uiu2")))
+	assert.True(t, net.ParseIP("::1").Equal(peerReputation.ExtractIP("/ip6/::1/tcp/37373")))
+	assert.Nil(t, peerReputation.ExtractIP("/dns4/example.com/tcp/37373"))
+	assert.Nil(t, peerReputation.ExtractIP("/ip4"))
+}
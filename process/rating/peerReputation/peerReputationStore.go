@@ -0,0 +1,534 @@
+package peerReputation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/rating/peerreputation")
+
+var stateKey = []byte("peerReputationState")
+
+const approximateZero = 0.00001
+
+// ArgsPeerReputationStore is the argument DTO used to create a new peer reputation store
+type ArgsPeerReputationStore struct {
+	Storer            storage.Storer
+	Marshaller        marshal.Marshalizer
+	PeerHonestyConfig config.PeerHonestyConfig
+	DefaultSpan       time.Duration
+	PersistInterval   time.Duration
+}
+
+type banRecord struct {
+	expiresAt time.Time
+	isManual  bool
+}
+
+type ipRangeBan struct {
+	ipNet *net.IPNet
+	banRecord
+}
+
+type peerReputationStore struct {
+	storer           storage.Storer
+	marshaller       marshal.Marshalizer
+	decayCoefficient float64
+	decayInterval    time.Duration
+	defaultSpan      time.Duration
+	getTimeHandler   func() time.Time
+
+	mut              sync.RWMutex
+	bannedPeers      map[core.PeerID]*banRecord
+	bannedPublicKeys map[string]*banRecord
+	bannedIPRanges   map[string]*ipRangeBan
+	pinnedPeers      map[core.PeerID]struct{}
+	honestyHandler   process.PeerHonestyScoresHandler
+	loadedScores     map[string]map[string]float64
+
+	cancelFunc func()
+}
+
+// NewPeerReputationStore creates a new peer reputation store, loading the state saved by a previous run
+func NewPeerReputationStore(args ArgsPeerReputationStore) (*peerReputationStore, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	prs := &peerReputationStore{
+		storer:           args.Storer,
+		marshaller:       args.Marshaller,
+		decayCoefficient: args.PeerHonestyConfig.DecayCoefficient,
+		decayInterval:    time.Duration(args.PeerHonestyConfig.DecayUpdateIntervalInSeconds) * time.Second,
+		defaultSpan:      args.DefaultSpan,
+		getTimeHandler:   time.Now,
+		bannedPeers:      make(map[core.PeerID]*banRecord),
+		bannedPublicKeys: make(map[string]*banRecord),
+		bannedIPRanges:   make(map[string]*ipRangeBan),
+		pinnedPeers:      make(map[core.PeerID]struct{}),
+		loadedScores:     make(map[string]map[string]float64),
+	}
+
+	prs.loadState()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	prs.cancelFunc = cancelFunc
+	go prs.persistContinuously(ctx, args.PersistInterval)
+
+	return prs, nil
+}
+
+func checkArgs(args ArgsPeerReputationStore) error {
+	if check.IfNil(args.Storer) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if args.PeerHonestyConfig.DecayCoefficient <= 0 || args.PeerHonestyConfig.DecayCoefficient >= 1 {
+		return fmt.Errorf("%w for the decay coefficient", process.ErrInvalidDecayCoefficient)
+	}
+	if args.PeerHonestyConfig.DecayUpdateIntervalInSeconds == 0 {
+		return fmt.Errorf("%w for the decay interval", process.ErrInvalidDecayIntervalInSeconds)
+	}
+	if args.DefaultSpan <= 0 {
+		return fmt.Errorf("%w for the default span", process.ErrInvalidValue)
+	}
+	if args.PersistInterval <= 0 {
+		return fmt.Errorf("%w for the persist interval", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func (prs *peerReputationStore) persistContinuously(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			prs.persist()
+		case <-ctx.Done():
+			log.Debug("closing peerReputationStore.persistContinuously go routine")
+			return
+		}
+	}
+}
+
+// PeerBlackListCacher returns the peer IDs blacklist backed by this store
+func (prs *peerReputationStore) PeerBlackListCacher() process.PeerBlackListCacher {
+	return &peerBlackListCacher{store: prs}
+}
+
+// PublicKeysCacher returns the public keys blacklist backed by this store
+func (prs *peerReputationStore) PublicKeysCacher() process.TimeCacher {
+	return &publicKeysCacher{store: prs}
+}
+
+// SetPeerHonestyHandler sets the honesty handler and restores in it the (decayed) scores loaded from the storage
+func (prs *peerReputationStore) SetPeerHonestyHandler(handler process.PeerHonestyScoresHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilPeerHonestyScoresHandler
+	}
+
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	prs.honestyHandler = handler
+	for pk, scoresByTopic := range prs.loadedScores {
+		handler.SetScores(pk, scoresByTopic)
+	}
+	prs.loadedScores = make(map[string]map[string]float64)
+
+	return nil
+}
+
+// BanPeer bans the provided peer ID. A zero duration means a permanent ban
+func (prs *peerReputationStore) BanPeer(pid core.PeerID, duration time.Duration) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	_, isPinned := prs.pinnedPeers[pid]
+	if isPinned {
+		return fmt.Errorf("%w: %s", process.ErrPeerIsPinned, pid.Pretty())
+	}
+
+	prs.bannedPeers[pid] = &banRecord{
+		expiresAt: prs.computeExpiry(duration),
+		isManual:  true,
+	}
+
+	log.Debug("peerReputationStore.BanPeer", "pid", pid.Pretty(), "duration", duration)
+
+	return nil
+}
+
+// UnbanPeer removes the ban of the provided peer ID
+func (prs *peerReputationStore) UnbanPeer(pid core.PeerID) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	prs.mut.Lock()
+	delete(prs.bannedPeers, pid)
+	prs.mut.Unlock()
+
+	log.Debug("peerReputationStore.UnbanPeer", "pid", pid.Pretty())
+
+	return nil
+}
+
+// BanIPRange bans the provided IP range, written in CIDR notation or as a single IP. A zero duration means a permanent ban
+func (prs *peerReputationStore) BanIPRange(ipRange string, duration time.Duration) error {
+	ipNet, err := parseIPRange(ipRange)
+	if err != nil {
+		return err
+	}
+
+	prs.mut.Lock()
+	prs.bannedIPRanges[ipNet.String()] = &ipRangeBan{
+		ipNet: ipNet,
+		banRecord: banRecord{
+			expiresAt: prs.computeExpiry(duration),
+			isManual:  true,
+		},
+	}
+	prs.mut.Unlock()
+
+	log.Debug("peerReputationStore.BanIPRange", "range", ipNet.String(), "duration", duration)
+
+	return nil
+}
+
+// UnbanIPRange removes the ban of the provided IP range
+func (prs *peerReputationStore) UnbanIPRange(ipRange string) error {
+	ipNet, err := parseIPRange(ipRange)
+	if err != nil {
+		return err
+	}
+
+	prs.mut.Lock()
+	delete(prs.bannedIPRanges, ipNet.String())
+	prs.mut.Unlock()
+
+	log.Debug("peerReputationStore.UnbanIPRange", "range", ipNet.String())
+
+	return nil
+}
+
+// PinPeer marks the provided peer ID as trusted: it will never be denied and any existing ban is lifted
+func (prs *peerReputationStore) PinPeer(pid core.PeerID) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	prs.mut.Lock()
+	prs.pinnedPeers[pid] = struct{}{}
+	delete(prs.bannedPeers, pid)
+	prs.mut.Unlock()
+
+	log.Debug("peerReputationStore.PinPeer", "pid", pid.Pretty())
+
+	return nil
+}
+
+// UnpinPeer removes the provided peer ID from the pinned peers
+func (prs *peerReputationStore) UnpinPeer(pid core.PeerID) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	prs.mut.Lock()
+	delete(prs.pinnedPeers, pid)
+	prs.mut.Unlock()
+
+	log.Debug("peerReputationStore.UnpinPeer", "pid", pid.Pretty())
+
+	return nil
+}
+
+// IsPinned returns true if the provided peer ID is pinned
+func (prs *peerReputationStore) IsPinned(pid core.PeerID) bool {
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	_, isPinned := prs.pinnedPeers[pid]
+
+	return isPinned
+}
+
+// IsIPBanned returns true if the provided IP belongs to a banned IP range
+func (prs *peerReputationStore) IsIPBanned(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	now := prs.getTimeHandler()
+	for _, ban := range prs.bannedIPRanges {
+		if ban.isExpired(now) {
+			continue
+		}
+		if ban.ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetState returns the current reputation state, sorted by identifiers
+func (prs *peerReputationStore) GetState() common.PeerReputationState {
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	now := prs.getTimeHandler()
+	state := common.PeerReputationState{
+		BannedPeers:      make([]common.PeerReputationBan, 0, len(prs.bannedPeers)),
+		BannedPublicKeys: make([]common.PeerReputationBan, 0, len(prs.bannedPublicKeys)),
+		BannedIPRanges:   make([]common.PeerReputationBan, 0, len(prs.bannedIPRanges)),
+		PinnedPeers:      make([]string, 0, len(prs.pinnedPeers)),
+		HonestyScores:    make(map[string]map[string]float64),
+	}
+
+	for pid, ban := range prs.bannedPeers {
+		if !ban.isExpired(now) {
+			state.BannedPeers = append(state.BannedPeers, ban.toDTO(pid.Pretty()))
+		}
+	}
+	for pk, ban := range prs.bannedPublicKeys {
+		if !ban.isExpired(now) {
+			state.BannedPublicKeys = append(state.BannedPublicKeys, ban.toDTO(hex.EncodeToString([]byte(pk))))
+		}
+	}
+	for ipRange, ban := range prs.bannedIPRanges {
+		if !ban.isExpired(now) {
+			state.BannedIPRanges = append(state.BannedIPRanges, ban.toDTO(ipRange))
+		}
+	}
+	for pid := range prs.pinnedPeers {
+		state.PinnedPeers = append(state.PinnedPeers, pid.Pretty())
+	}
+	for pk, scoresByTopic := range prs.getScoresNoLock() {
+		state.HonestyScores[hex.EncodeToString([]byte(pk))] = scoresByTopic
+	}
+
+	sortBans(state.BannedPeers)
+	sortBans(state.BannedPublicKeys)
+	sortBans(state.BannedIPRanges)
+	sort.Strings(state.PinnedPeers)
+
+	return state
+}
+
+// Sweep removes all the expired bans
+func (prs *peerReputationStore) Sweep() {
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	now := prs.getTimeHandler()
+	for pid, ban := range prs.bannedPeers {
+		if ban.isExpired(now) {
+			delete(prs.bannedPeers, pid)
+		}
+	}
+	for pk, ban := range prs.bannedPublicKeys {
+		if ban.isExpired(now) {
+			delete(prs.bannedPublicKeys, pk)
+		}
+	}
+	for ipRange, ban := range prs.bannedIPRanges {
+		if ban.isExpired(now) {
+			delete(prs.bannedIPRanges, ipRange)
+		}
+	}
+}
+
+func (prs *peerReputationStore) upsertPeer(pid core.PeerID, span time.Duration) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	_, isPinned := prs.pinnedPeers[pid]
+	if isPinned {
+		log.Trace("peerReputationStore: ignored blacklisting of a pinned peer", "pid", pid.Pretty())
+		return nil
+	}
+
+	prs.bannedPeers[pid] = prs.extendBanNoLock(prs.bannedPeers[pid], span)
+
+	return nil
+}
+
+func (prs *peerReputationStore) hasPeer(pid core.PeerID) bool {
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	_, isPinned := prs.pinnedPeers[pid]
+	if isPinned {
+		return false
+	}
+
+	ban, found := prs.bannedPeers[pid]
+
+	return found && !ban.isExpired(prs.getTimeHandler())
+}
+
+func (prs *peerReputationStore) upsertPublicKey(pk string, span time.Duration) error {
+	if len(pk) == 0 {
+		return process.ErrEmptyPublicKey
+	}
+
+	prs.mut.Lock()
+	prs.bannedPublicKeys[pk] = prs.extendBanNoLock(prs.bannedPublicKeys[pk], span)
+	prs.mut.Unlock()
+
+	return nil
+}
+
+func (prs *peerReputationStore) hasPublicKey(pk string) bool {
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	ban, found := prs.bannedPublicKeys[pk]
+
+	return found && !ban.isExpired(prs.getTimeHandler())
+}
+
+func (prs *peerReputationStore) numPublicKeys() int {
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	return len(prs.bannedPublicKeys)
+}
+
+// extendBanNoLock returns the ban record that lasts the longest between the existing one and a new automatic one
+func (prs *peerReputationStore) extendBanNoLock(existing *banRecord, span time.Duration) *banRecord {
+	newExpiry := prs.getTimeHandler().Add(span)
+	if existing == nil || existing.isExpired(prs.getTimeHandler()) {
+		return &banRecord{expiresAt: newExpiry}
+	}
+	if existing.isPermanent() || existing.expiresAt.After(newExpiry) {
+		return existing
+	}
+
+	return &banRecord{
+		expiresAt: newExpiry,
+		isManual:  existing.isManual,
+	}
+}
+
+func (prs *peerReputationStore) computeExpiry(duration time.Duration) time.Time {
+	if duration == 0 {
+		return time.Time{}
+	}
+
+	return prs.getTimeHandler().Add(duration)
+}
+
+func (prs *peerReputationStore) getScoresNoLock() map[string]map[string]float64 {
+	if check.IfNil(prs.honestyHandler) {
+		return prs.loadedScores
+	}
+
+	return prs.honestyHandler.GetAllScores()
+}
+
+// Close saves the current state and stops the persisting go routine
+func (prs *peerReputationStore) Close() error {
+	prs.cancelFunc()
+	prs.persist()
+
+	return prs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prs *peerReputationStore) IsInterfaceNil() bool {
+	return prs == nil
+}
+
+func (br *banRecord) isPermanent() bool {
+	return br.expiresAt.IsZero()
+}
+
+func (br *banRecord) isExpired(now time.Time) bool {
+	return !br.isPermanent() && !now.Before(br.expiresAt)
+}
+
+func (br *banRecord) expiresAtUnix() int64 {
+	if br.isPermanent() {
+		return 0
+	}
+
+	return br.expiresAt.Unix()
+}
+
+func (br *banRecord) toDTO(identifier string) common.PeerReputationBan {
+	return common.PeerReputationBan{
+		Identifier: identifier,
+		ExpiresAt:  br.expiresAtUnix(),
+		IsManual:   br.isManual,
+	}
+}
+
+func parseIPRange(ipRange string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(ipRange)
+	if err == nil {
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(ipRange)
+	if ip == nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidIPRange, ipRange)
+	}
+
+	numBits := 8 * net.IPv6len
+	ipv4 := ip.To4()
+	if ipv4 != nil {
+		ip = ipv4
+		numBits = 8 * net.IPv4len
+	}
+
+	return &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(numBits, numBits),
+	}, nil
+}
+
+func sortBans(bans []common.PeerReputationBan) {
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Identifier < bans[j].Identifier
+	})
+}
+
+func decayScore(score float64, coefficient float64, numIntervals float64) float64 {
+	score = score * math.Pow(coefficient, numIntervals)
+	if check.IsZeroFloat64(score, approximateZero) {
+		return 0
+	}
+
+	return score
+}
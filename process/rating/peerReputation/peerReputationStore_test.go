@@ -0,0 +1,361 @@
+package peerReputation_test
+
+import (
+	"errors"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPeerReputationStore() peerReputation.ArgsPeerReputationStore {
+	return peerReputation.ArgsPeerReputationStore{
+		Storer:     testscommon.CreateMemUnit(),
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		PeerHonestyConfig: config.PeerHonestyConfig{
+			DecayCoefficient:             0.5,
+			DecayUpdateIntervalInSeconds: 10,
+		},
+		DefaultSpan:     time.Minute,
+		PersistInterval: time.Hour,
+	}
+}
+
+func TestNewPeerReputationStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Storer = nil
+		prs, err := peerReputation.NewPeerReputationStore(args)
+		assert.True(t, check.IfNil(prs))
+		assert.Equal(t, process.ErrNilStorage, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Marshaller = nil
+		prs, err := peerReputation.NewPeerReputationStore(args)
+		assert.True(t, check.IfNil(prs))
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("invalid decay coefficient should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.PeerHonestyConfig.DecayCoefficient = 1
+		prs, err := peerReputation.NewPeerReputationStore(args)
+		assert.True(t, check.IfNil(prs))
+		assert.True(t, errors.Is(err, process.ErrInvalidDecayCoefficient))
+	})
+	t.Run("invalid decay interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.PeerHonestyConfig.DecayUpdateIntervalInSeconds = 0
+		prs, err := peerReputation.NewPeerReputationStore(args)
+		assert.True(t, check.IfNil(prs))
+		assert.True(t, errors.Is(err, process.ErrInvalidDecayIntervalInSeconds))
+	})
+	t.Run("invalid default span should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.DefaultSpan = 0
+		prs, err := peerReputation.NewPeerReputationStore(args)
+		assert.True(t, check.IfNil(prs))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid persist interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.PersistInterval = 0
+		prs, err := peerReputation.NewPeerReputationStore(args)
+		assert.True(t, check.IfNil(prs))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		prs, err := peerReputation.NewPeerReputationStore(createMockArgsPeerReputationStore())
+		assert.False(t, check.IfNil(prs))
+		assert.Nil(t, err)
+		assert.Nil(t, prs.Close())
+	})
+}
+
+func TestPeerReputationStore_BanAndUnbanPeer(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := peerReputation.NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	now := time.Now()
+	prs.SetTimeHandler(func() time.Time {
+		return now
+	})
+	blackList := prs.PeerBlackListCacher()
+
+	assert.Equal(t, process.ErrEmptyPeerID, prs.BanPeer("", time.Minute))
+	assert.Equal(t, process.ErrEmptyPeerID, prs.UnbanPeer(""))
+
+	require.Nil(t, prs.BanPeer("pid1", 0))
+	require.Nil(t, prs.BanPeer("pid2", time.Minute))
+	assert.True(t, blackList.Has("pid1"))
+	assert.True(t, blackList.Has("pid2"))
+
+	expectedBans := []common.PeerReputationBan{
+		{Identifier: core.PeerID("pid1").Pretty(), ExpiresAt: 0, IsManual: true},
+		{Identifier: core.PeerID("pid2").Pretty(), ExpiresAt: now.Add(time.Minute).Unix(), IsManual: true},
+	}
+	assert.ElementsMatch(t, expectedBans, prs.GetState().BannedPeers)
+
+	require.Nil(t, prs.UnbanPeer("pid1"))
+	assert.False(t, blackList.Has("pid1"))
+
+	prs.SetTimeHandler(func() time.Time {
+		return now.Add(time.Minute)
+	})
+	assert.False(t, blackList.Has("pid2"))
+	assert.Equal(t, 0, len(prs.GetState().BannedPeers))
+}
+
+func TestPeerReputationStore_PinnedPeers(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := peerReputation.NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	blackList := prs.PeerBlackListCacher()
+	require.Nil(t, blackList.Upsert("pid", time.Minute))
+	assert.True(t, blackList.Has("pid"))
+
+	assert.Equal(t, process.ErrEmptyPeerID, prs.PinPeer(""))
+	require.Nil(t, prs.PinPeer("pid"))
+	assert.True(t, prs.IsPinned("pid"))
+	assert.False(t, blackList.Has("pid"))
+
+	require.Nil(t, blackList.Upsert("pid", time.Minute))
+	assert.False(t, blackList.Has("pid"))
+	assert.True(t, errors.Is(prs.BanPeer("pid", time.Minute), process.ErrPeerIsPinned))
+	assert.Equal(t, []string{core.PeerID("pid").Pretty()}, prs.GetState().PinnedPeers)
+
+	require.Nil(t, prs.UnpinPeer("pid"))
+	assert.False(t, prs.IsPinned("pid"))
+	require.Nil(t, blackList.Upsert("pid", time.Minute))
+	assert.True(t, blackList.Has("pid"))
+}
+
+func TestPeerReputationStore_IPRanges(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := peerReputation.NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	assert.True(t, errors.Is(prs.BanIPRange("not an IP", 0), process.ErrInvalidIPRange))
+	assert.True(t, errors.Is(prs.UnbanIPRange("10.0.0.0/33"), process.ErrInvalidIPRange))
+
+	require.Nil(t, prs.BanIPRange("10.0.0.0/8", 0))
+	require.Nil(t, prs.BanIPRange("192.168.1.1", time.Minute))
+	require.Nil(t, prs.BanIPRange("2001:db8::/32", 0))
+
+	assert.True(t, prs.IsIPBanned(net.ParseIP("10.1.2.3")))
+	assert.True(t, prs.IsIPBanned(net.ParseIP("192.168.1.1")))
+	assert.True(t, prs.IsIPBanned(net.ParseIP("2001:db8::1")))
+	assert.False(t, prs.IsIPBanned(net.ParseIP("192.168.1.2")))
+	assert.False(t, prs.IsIPBanned(net.ParseIP("11.0.0.1")))
+	assert.False(t, prs.IsIPBanned(nil))
+
+	bannedRanges := make([]string, 0)
+	for _, ban := range prs.GetState().BannedIPRanges {
+		bannedRanges = append(bannedRanges, ban.Identifier)
+	}
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.1/32", "2001:db8::/32"}, bannedRanges)
+
+	require.Nil(t, prs.UnbanIPRange("10.0.0.0/8"))
+	assert.False(t, prs.IsIPBanned(net.ParseIP("10.1.2.3")))
+
+	prs.SetTimeHandler(func() time.Time {
+		return time.Now().Add(time.Hour)
+	})
+	assert.False(t, prs.IsIPBanned(net.ParseIP("192.168.1.1")))
+	assert.True(t, prs.IsIPBanned(net.ParseIP("2001:db8::1")))
+}
+
+func TestPeerReputationStore_BlackListCachers(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := peerReputation.NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	now := time.Now()
+	prs.SetTimeHandler(func() time.Time {
+		return now
+	})
+
+	t.Run("automatic bans should not shorten existing bans", func(t *testing.T) {
+		blackList := prs.PeerBlackListCacher()
+		require.Nil(t, prs.BanPeer("manual pid", 0))
+		require.Nil(t, blackList.Upsert("manual pid", time.Second))
+		require.Nil(t, blackList.Upsert("pid", time.Hour))
+		require.Nil(t, blackList.Upsert("pid", time.Second))
+		assert.Equal(t, process.ErrEmptyPeerID, blackList.Upsert("", time.Second))
+
+		expectedBans := []common.PeerReputationBan{
+			{Identifier: core.PeerID("manual pid").Pretty(), ExpiresAt: 0, IsManual: true},
+			{Identifier: core.PeerID("pid").Pretty(), ExpiresAt: now.Add(time.Hour).Unix(), IsManual: false},
+		}
+		assert.ElementsMatch(t, expectedBans, prs.GetState().BannedPeers)
+	})
+	t.Run("public keys cacher", func(t *testing.T) {
+		pkCacher := prs.PublicKeysCacher()
+		require.Nil(t, pkCacher.Add("pk1"))
+		require.Nil(t, pkCacher.Upsert("pk2", time.Hour))
+		assert.Equal(t, process.ErrEmptyPublicKey, pkCacher.Add(""))
+		assert.True(t, pkCacher.Has("pk1"))
+		assert.True(t, pkCacher.Has("pk2"))
+		assert.False(t, pkCacher.Has("pk3"))
+		assert.Equal(t, 2, pkCacher.Len())
+
+		prs.SetTimeHandler(func() time.Time {
+			return now.Add(time.Minute)
+		})
+		assert.False(t, pkCacher.Has("pk1"))
+		assert.Equal(t, 2, pkCacher.Len())
+
+		pkCacher.Sweep()
+		assert.Equal(t, 1, pkCacher.Len())
+		assert.True(t, pkCacher.Has("pk2"))
+	})
+}
+
+func TestPeerReputationStore_SetPeerHonestyHandler(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := peerReputation.NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	assert.Equal(t, process.ErrNilPeerHonestyScoresHandler, prs.SetPeerHonestyHandler(nil))
+
+	scores := map[string]map[string]float64{
+		"pk": {"topic": -10},
+	}
+	err := prs.SetPeerHonestyHandler(&testscommon.PeerHonestyScoresHandlerStub{
+		GetAllScoresCalled: func() map[string]map[string]float64 {
+			return scores
+		},
+	})
+	require.Nil(t, err)
+
+	expectedScores := map[string]map[string]float64{
+		"706b": {"topic": -10},
+	}
+	assert.Equal(t, expectedScores, prs.GetState().HonestyScores)
+}
+
+func TestPeerReputationStore_PersistAndLoad(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPeerReputationStore()
+	args.PeerHonestyConfig.DecayUpdateIntervalInSeconds = 100
+	decayInterval := time.Duration(args.PeerHonestyConfig.DecayUpdateIntervalInSeconds) * time.Second
+	savedAt := time.Now().Add(-2 * decayInterval)
+
+	prs, _ := peerReputation.NewPeerReputationStore(args)
+	prs.SetTimeHandler(func() time.Time {
+		return savedAt
+	})
+	_ = prs.SetPeerHonestyHandler(&testscommon.PeerHonestyScoresHandlerStub{
+		GetAllScoresCalled: func() map[string]map[string]float64 {
+			return map[string]map[string]float64{
+				"pk": {"topic1": -40, "topic2": 8},
+			}
+		},
+	})
+
+	require.Nil(t, prs.BanPeer("permanent pid", 0))
+	require.Nil(t, prs.BanPeer("expired pid", decayInterval))
+	require.Nil(t, prs.PeerBlackListCacher().Upsert("pid", time.Hour))
+	require.Nil(t, prs.PublicKeysCacher().Upsert("pk", time.Hour))
+	require.Nil(t, prs.BanIPRange("10.0.0.0/8", 0))
+	require.Nil(t, prs.PinPeer("pinned pid"))
+	prs.Persist()
+	prs.SetTimeHandler(time.Now)
+
+	loadedScores := make(map[string]map[string]float64)
+	loadedPrs, err := peerReputation.NewPeerReputationStore(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = loadedPrs.Close()
+	}()
+	_ = loadedPrs.SetPeerHonestyHandler(&testscommon.PeerHonestyScoresHandlerStub{
+		SetScoresCalled: func(pk string, scoresByTopic map[string]float64) {
+			loadedScores[pk] = scoresByTopic
+		},
+	})
+
+	blackList := loadedPrs.PeerBlackListCacher()
+	assert.True(t, blackList.Has("permanent pid"))
+	assert.True(t, blackList.Has("pid"))
+	assert.False(t, blackList.Has("expired pid"))
+	assert.True(t, loadedPrs.PublicKeysCacher().Has("pk"))
+	assert.True(t, loadedPrs.IsIPBanned(net.ParseIP("10.0.0.1")))
+	assert.True(t, loadedPrs.IsPinned("pinned pid"))
+
+	// 2 decay intervals elapsed, with a 0.5 coefficient
+	require.Equal(t, 1, len(loadedScores))
+	assert.True(t, math.Abs(loadedScores["pk"]["topic1"]-(-10)) < 0.5)
+	assert.True(t, math.Abs(loadedScores["pk"]["topic2"]-2) < 0.5)
+}
+
+func TestPeerReputationStore_CloseShouldPersist(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPeerReputationStore()
+	wasPutCalled := false
+	wasCloseCalled := false
+	args.Storer = &storage.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, errors.New("not found")
+		},
+		PutCalled: func(key, data []byte) error {
+			wasPutCalled = true
+			return nil
+		},
+		CloseCalled: func() error {
+			wasCloseCalled = true
+			return nil
+		},
+	}
+
+	prs, _ := peerReputation.NewPeerReputationStore(args)
+	assert.Nil(t, prs.Close())
+	assert.True(t, wasPutCalled)
+	assert.True(t, wasCloseCalled)
+}
@@ -0,0 +1,182 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. persistence.proto
+
+package peerReputation
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+func (prs *peerReputationStore) persist() {
+	prs.mut.RLock()
+	state := prs.createPersistedStateNoLock()
+	prs.mut.RUnlock()
+
+	buff, err := prs.marshaller.Marshal(state)
+	if err != nil {
+		log.Warn("peerReputationStore.persist: can not marshal state", "error", err)
+		return
+	}
+
+	err = prs.storer.Put(stateKey, buff)
+	if err != nil {
+		log.Warn("peerReputationStore.persist: can not save state", "error", err)
+		return
+	}
+
+	log.Trace("peerReputationStore.persist",
+		"banned peers", len(state.BannedPeers),
+		"banned public keys", len(state.BannedPublicKeys),
+		"banned IP ranges", len(state.BannedIPRanges),
+		"pinned peers", len(state.PinnedPeers),
+		"honesty scores", len(state.HonestyScores),
+	)
+}
+
+func (prs *peerReputationStore) createPersistedStateNoLock() *PersistedState {
+	now := prs.getTimeHandler()
+	state := &PersistedState{
+		SavedAt:          now.Unix(),
+		BannedPeers:      make([]*PersistedBan, 0, len(prs.bannedPeers)),
+		BannedPublicKeys: make([]*PersistedBan, 0, len(prs.bannedPublicKeys)),
+		BannedIPRanges:   make([]*PersistedBan, 0, len(prs.bannedIPRanges)),
+		PinnedPeers:      make([]string, 0, len(prs.pinnedPeers)),
+		HonestyScores:    make([]*PersistedScore, 0),
+	}
+
+	for pid, ban := range prs.bannedPeers {
+		if !ban.isExpired(now) {
+			state.BannedPeers = append(state.BannedPeers, ban.toPersisted(hex.EncodeToString(pid.Bytes())))
+		}
+	}
+	for pk, ban := range prs.bannedPublicKeys {
+		if !ban.isExpired(now) {
+			state.BannedPublicKeys = append(state.BannedPublicKeys, ban.toPersisted(hex.EncodeToString([]byte(pk))))
+		}
+	}
+	for ipRange, ban := range prs.bannedIPRanges {
+		if !ban.isExpired(now) {
+			state.BannedIPRanges = append(state.BannedIPRanges, ban.toPersisted(ipRange))
+		}
+	}
+	for pid := range prs.pinnedPeers {
+		state.PinnedPeers = append(state.PinnedPeers, hex.EncodeToString(pid.Bytes()))
+	}
+	for pk, scoresByTopic := range prs.getScoresNoLock() {
+		for topic, score := range scoresByTopic {
+			state.HonestyScores = append(state.HonestyScores, &PersistedScore{
+				PublicKey: hex.EncodeToString([]byte(pk)),
+				Topic:     topic,
+				Score:     score,
+			})
+		}
+	}
+
+	return state
+}
+
+// loadState restores the state saved by a previous run. Expired bans are dropped and the honesty scores are decayed
+// as if the node would have been running in the meantime
+func (prs *peerReputationStore) loadState() {
+	buff, err := prs.storer.Get(stateKey)
+	if err != nil {
+		log.Debug("peerReputationStore: no previous state found, starting with an empty one")
+		return
+	}
+
+	state := &PersistedState{}
+	err = prs.marshaller.Unmarshal(state, buff)
+	if err != nil {
+		log.Warn("peerReputationStore: can not unmarshal the previous state, starting with an empty one", "error", err)
+		return
+	}
+
+	now := prs.getTimeHandler()
+	for _, ban := range state.BannedPeers {
+		pidBytes, errDecode := hex.DecodeString(ban.Key)
+		record := ban.toRecord()
+		if errDecode != nil || record.isExpired(now) {
+			continue
+		}
+
+		prs.bannedPeers[core.PeerID(pidBytes)] = record
+	}
+	for _, ban := range state.BannedPublicKeys {
+		pkBytes, errDecode := hex.DecodeString(ban.Key)
+		record := ban.toRecord()
+		if errDecode != nil || record.isExpired(now) {
+			continue
+		}
+
+		prs.bannedPublicKeys[string(pkBytes)] = record
+	}
+	for _, ban := range state.BannedIPRanges {
+		ipNet, errParse := parseIPRange(ban.Key)
+		record := ban.toRecord()
+		if errParse != nil || record.isExpired(now) {
+			continue
+		}
+
+		prs.bannedIPRanges[ipNet.String()] = &ipRangeBan{
+			ipNet:     ipNet,
+			banRecord: *record,
+		}
+	}
+	for _, pidHex := range state.PinnedPeers {
+		pidBytes, errDecode := hex.DecodeString(pidHex)
+		if errDecode != nil {
+			continue
+		}
+
+		prs.pinnedPeers[core.PeerID(pidBytes)] = struct{}{}
+	}
+
+	elapsed := now.Sub(time.Unix(state.SavedAt, 0))
+	numIntervals := float64(0)
+	if elapsed > 0 {
+		numIntervals = float64(elapsed) / float64(prs.decayInterval)
+	}
+	for _, score := range state.HonestyScores {
+		pkBytes, errDecode := hex.DecodeString(score.PublicKey)
+		if errDecode != nil {
+			continue
+		}
+
+		decayedScores, found := prs.loadedScores[string(pkBytes)]
+		if !found {
+			decayedScores = make(map[string]float64)
+			prs.loadedScores[string(pkBytes)] = decayedScores
+		}
+		decayedScores[score.Topic] = decayScore(score.Score, prs.decayCoefficient, numIntervals)
+	}
+
+	log.Debug("peerReputationStore: loaded previous state",
+		"saved at", time.Unix(state.SavedAt, 0),
+		"banned peers", len(prs.bannedPeers),
+		"banned public keys", len(prs.bannedPublicKeys),
+		"banned IP ranges", len(prs.bannedIPRanges),
+		"pinned peers", len(prs.pinnedPeers),
+		"honesty scores", len(prs.loadedScores),
+	)
+}
+
+func (br *banRecord) toPersisted(key string) *PersistedBan {
+	return &PersistedBan{
+		Key:       key,
+		ExpiresAt: br.expiresAtUnix(),
+		IsManual:  br.isManual,
+	}
+}
+
+func (pb *PersistedBan) toRecord() *banRecord {
+	record := &banRecord{
+		isManual: pb.IsManual,
+	}
+	if pb.ExpiresAt != 0 {
+		record.expiresAt = time.Unix(pb.ExpiresAt, 0)
+	}
+
+	return record
+}
@@ -0,0 +1,310 @@
+package scheduledCalls
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var _ process.ScheduledCallsProcessor = (*scheduledCallsProcessor)(nil)
+
+var log = logger.GetOrCreate("process/scheduledCalls")
+
+var dispatchedCallGasLockedArgument = []byte("@" + core.ConvertToEvenHex(0))
+
+type dispatchedTransfer struct {
+	destination []byte
+	transfer    vmcommon.OutputTransfer
+}
+
+// ArgsScheduledCallsProcessor is the struct that contains all components needed to create a new scheduledCallsProcessor
+type ArgsScheduledCallsProcessor struct {
+	SystemVM            vmcommon.VMExecutionHandler
+	Accounts            state.AccountsAdapter
+	ShardCoordinator    sharding.Coordinator
+	TxCoordinator       process.TransactionCoordinator
+	FeeHandler          process.TransactionFeeHandler
+	Hasher              hashing.Hasher
+	Marshalizer         marshal.Marshalizer
+	EnableEpochsHandler common.EnableEpochsHandler
+}
+
+// scheduledCallsProcessor executes, on every metachain block, the due calls of the scheduled calls system smart contract
+// and adds the resulting smart contract results, towards the destination shards, to the block
+type scheduledCallsProcessor struct {
+	systemVM            vmcommon.VMExecutionHandler
+	accounts            state.AccountsAdapter
+	shardCoordinator    sharding.Coordinator
+	txCoordinator       process.TransactionCoordinator
+	feeHandler          process.TransactionFeeHandler
+	hasher              hashing.Hasher
+	marshalizer         marshal.Marshalizer
+	enableEpochsHandler common.EnableEpochsHandler
+}
+
+// NewScheduledCallsProcessor creates the component which dispatches the due scheduled calls
+func NewScheduledCallsProcessor(args ArgsScheduledCallsProcessor) (*scheduledCallsProcessor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduledCallsProcessor{
+		systemVM:            args.SystemVM,
+		accounts:            args.Accounts,
+		shardCoordinator:    args.ShardCoordinator,
+		txCoordinator:       args.TxCoordinator,
+		feeHandler:          args.FeeHandler,
+		hasher:              args.Hasher,
+		marshalizer:         args.Marshalizer,
+		enableEpochsHandler: args.EnableEpochsHandler,
+	}, nil
+}
+
+func checkArgs(args ArgsScheduledCallsProcessor) error {
+	if check.IfNil(args.SystemVM) {
+		return process.ErrNilSystemVM
+	}
+	if check.IfNil(args.Accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.TxCoordinator) {
+		return process.ErrNilTransactionCoordinator
+	}
+	if check.IfNil(args.FeeHandler) {
+		return process.ErrNilUnsignedTxHandler
+	}
+	if check.IfNil(args.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return process.ErrNilEnableEpochsHandler
+	}
+	return core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
+		common.ScheduledCallsFlag,
+	})
+}
+
+// ProcessDueCalls executes the due scheduled calls. The metachain state of the scheduled calls contract is updated and
+// one smart contract result is created for each dispatched call. The gas deposit of the dispatched calls is accounted
+// as metachain fees, as the destination shards execute the calls with a zero gas price.
+func (scp *scheduledCallsProcessor) ProcessDueCalls(header data.HeaderHandler) error {
+	if !scp.enableEpochsHandler.IsFlagEnabled(common.ScheduledCallsFlag) {
+		return nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vm.ScheduledCallsSCAddress,
+			CallValue:   big.NewInt(0),
+			GasProvided: math.MaxInt64,
+		},
+		RecipientAddr: vm.ScheduledCallsSCAddress,
+		Function:      systemSmartContracts.ExecuteDueCallsFunction,
+	}
+	vmOutput, err := scp.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, return code: %s, message: %s", process.ErrScheduledCallsExecutionFailed, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	scrs, totalFees, err := scp.createSmartContractResults(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	err = scp.processOutputAccounts(vmOutput, totalFees)
+	if err != nil {
+		return err
+	}
+
+	logLeftOverCalls(vmOutput)
+
+	if len(scrs) == 0 {
+		return nil
+	}
+
+	log.Debug("scheduledCallsProcessor.ProcessDueCalls", "round", header.GetRound(), "num dispatched calls", len(scrs))
+
+	return scp.txCoordinator.AddIntermediateTransactions(map[block.Type][]data.TransactionHandler{
+		block.SmartContractResultBlock: scrs,
+	})
+}
+
+func (scp *scheduledCallsProcessor) createSmartContractResults(vmOutput *vmcommon.VMOutput) ([]data.TransactionHandler, *big.Int, error) {
+	transfers := make([]*dispatchedTransfer, 0)
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if scp.isSelfShardAddress(outAcc.Address) {
+			continue
+		}
+		for _, transfer := range outAcc.OutputTransfers {
+			transfers = append(transfers, &dispatchedTransfer{
+				destination: outAcc.Address,
+				transfer:    transfer,
+			})
+		}
+	}
+	sort.Slice(transfers, func(i, j int) bool {
+		return transfers[i].transfer.Index < transfers[j].transfer.Index
+	})
+
+	if len(transfers)*systemSmartContracts.NumReturnValuesPerDispatchedCall != len(vmOutput.ReturnData) {
+		return nil, nil, fmt.Errorf("%w, num transfers %d does not match num return values %d",
+			process.ErrScheduledCallsExecutionFailed, len(transfers), len(vmOutput.ReturnData))
+	}
+
+	totalFees := big.NewInt(0)
+	scrs := make([]data.TransactionHandler, 0, len(transfers))
+	for i, dispatched := range transfers {
+		transfer := dispatched.transfer
+		returnData := vmOutput.ReturnData[i*systemSmartContracts.NumReturnValuesPerDispatchedCall:]
+		registrationTxHash := returnData[0]
+		fee := big.NewInt(0).SetBytes(returnData[3])
+		scrData, callType := createDispatchedCallData(dispatched)
+
+		scr := &smartContractResult.SmartContractResult{
+			Nonce:          big.NewInt(0).SetBytes(returnData[2]).Uint64(),
+			Value:          big.NewInt(0).Set(transfer.Value),
+			RcvAddr:        dispatched.destination,
+			SndAddr:        vm.ScheduledCallsSCAddress,
+			Data:           scrData,
+			PrevTxHash:     registrationTxHash,
+			OriginalTxHash: registrationTxHash,
+			GasLimit:       transfer.GasLimit,
+			CallType:       callType,
+			OriginalSender: returnData[1],
+		}
+		scrHash, err := core.CalculateHash(scp.marshalizer, scp.hasher, scr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		scp.feeHandler.ProcessTransactionFee(fee, big.NewInt(0), scrHash)
+		totalFees.Add(totalFees, fee)
+		scrs = append(scrs, scr)
+	}
+
+	return scrs, totalFees, nil
+}
+
+// createDispatchedCallData returns the data and the call type of the smart contract result dispatching a call. The calls
+// towards smart contracts are dispatched as legacy asynchronous calls, so that the destination shards return their
+// outcome to the scheduled calls contract with a callback. The last argument of such a call is the gas locked for the
+// callback, which is zero as the metachain executes it free of charge.
+func createDispatchedCallData(dispatched *dispatchedTransfer) ([]byte, vmData.CallType) {
+	if !core.IsSmartContractAddress(dispatched.destination) || len(dispatched.transfer.Data) == 0 {
+		return dispatched.transfer.Data, vmData.DirectCall
+	}
+
+	scrData := make([]byte, 0, len(dispatched.transfer.Data)+len(dispatchedCallGasLockedArgument))
+	scrData = append(scrData, dispatched.transfer.Data...)
+	scrData = append(scrData, dispatchedCallGasLockedArgument...)
+
+	return scrData, vmData.AsynchronousCall
+}
+
+func logLeftOverCalls(vmOutput *vmcommon.VMOutput) {
+	for _, logEntry := range vmOutput.Logs {
+		if string(logEntry.Identifier) != systemSmartContracts.DueCallsLeftOverIdentifier || len(logEntry.Topics) != 2 {
+			continue
+		}
+
+		log.Debug("scheduledCallsProcessor: due calls left over for the next blocks",
+			"resume round", big.NewInt(0).SetBytes(logEntry.Topics[0]).Uint64(),
+			"current round", big.NewInt(0).SetBytes(logEntry.Topics[1]).Uint64(),
+		)
+	}
+}
+
+func (scp *scheduledCallsProcessor) processOutputAccounts(vmOutput *vmcommon.VMOutput, totalFees *big.Int) error {
+	outputAccounts := process.SortVMOutputInsideData(vmOutput)
+	for _, outAcc := range outputAccounts {
+		if !scp.isSelfShardAddress(outAcc.Address) {
+			continue
+		}
+
+		account, err := scp.getUserAccount(outAcc.Address)
+		if err != nil {
+			return err
+		}
+
+		storageUpdates := process.GetSortedStorageUpdates(outAcc)
+		for _, storeUpdate := range storageUpdates {
+			err = account.SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		balanceDelta := big.NewInt(0)
+		if outAcc.BalanceDelta != nil {
+			balanceDelta.Set(outAcc.BalanceDelta)
+		}
+		if bytes.Equal(outAcc.Address, vm.ScheduledCallsSCAddress) {
+			balanceDelta.Sub(balanceDelta, totalFees)
+		}
+		if balanceDelta.Sign() != 0 {
+			err = account.AddToBalance(balanceDelta)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = scp.accounts.SaveAccount(account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (scp *scheduledCallsProcessor) isSelfShardAddress(address []byte) bool {
+	return scp.shardCoordinator.ComputeId(address) == scp.shardCoordinator.SelfId()
+}
+
+func (scp *scheduledCallsProcessor) getUserAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := scp.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scp *scheduledCallsProcessor) IsInterfaceNil() bool {
+	return scp == nil
+}
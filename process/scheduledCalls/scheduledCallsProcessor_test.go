@@ -0,0 +1,305 @@
+package scheduledCalls
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/trackableDataTrie"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsScheduledCallsProcessor() ArgsScheduledCallsProcessor {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(3, core.MetachainShardId)
+
+	return ArgsScheduledCallsProcessor{
+		SystemVM:            &mock.VMExecutionHandlerStub{},
+		Accounts:            &stateMock.AccountsStub{},
+		ShardCoordinator:    shardCoordinator,
+		TxCoordinator:       &testscommon.TransactionCoordinatorMock{},
+		FeeHandler:          &mock.FeeAccumulatorStub{},
+		Hasher:              &hashingMocks.HasherMock{},
+		Marshalizer:         &marshallerMock.MarshalizerMock{},
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.ScheduledCallsFlag),
+	}
+}
+
+func createScheduledCallsAccount(balance int64) state.UserAccountHandler {
+	dtt, _ := trackableDataTrie.NewTrackableDataTrie(vm.ScheduledCallsSCAddress, &hashingMocks.HasherMock{}, &marshallerMock.MarshalizerMock{}, enableEpochsHandlerMock.NewEnableEpochsHandlerStub())
+	userAcc, _ := accounts.NewUserAccount(vm.ScheduledCallsSCAddress, dtt, &trie.TrieLeafParserStub{})
+	_ = userAcc.AddToBalance(big.NewInt(balance))
+
+	return userAcc
+}
+
+func TestNewScheduledCallsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil system VM should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.SystemVM = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilSystemVM, err)
+	})
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.Accounts = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.ShardCoordinator = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil tx coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.TxCoordinator = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilTransactionCoordinator, err)
+	})
+	t.Run("nil fee handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.FeeHandler = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilUnsignedTxHandler, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.Hasher = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilHasher, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.Marshalizer = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.EnableEpochsHandler = nil
+		scp, err := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp)
+		require.Equal(t, process.ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		scp, err := NewScheduledCallsProcessor(createMockArgsScheduledCallsProcessor())
+		require.Nil(t, err)
+		require.False(t, scp.IsInterfaceNil())
+	})
+}
+
+func TestScheduledCallsProcessor_ProcessDueCalls(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should not call the system VM", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		scp, _ := NewScheduledCallsProcessor(args)
+		require.Nil(t, scp.ProcessDueCalls(&block.MetaBlock{}))
+	})
+	t.Run("execution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledCallsProcessor()
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			},
+		}
+		scp, _ := NewScheduledCallsProcessor(args)
+		err := scp.ProcessDueCalls(&block.MetaBlock{})
+		require.True(t, errors.Is(err, process.ErrScheduledCallsExecutionFailed))
+	})
+	t.Run("should update the contract and add one smart contract result for each dispatched call", func(t *testing.T) {
+		t.Parallel()
+
+		firstDestination := bytes.Repeat([]byte{1}, 32)
+		secondDestination := bytes.Repeat([]byte{2}, 32)
+		owner := bytes.Repeat([]byte{3}, 32)
+		args := createMockArgsScheduledCallsProcessor()
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Equal(t, vm.ScheduledCallsSCAddress, input.CallerAddr)
+				require.Equal(t, vm.ScheduledCallsSCAddress, input.RecipientAddr)
+
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{
+						[]byte("txHash1"), owner, {}, big.NewInt(100).Bytes(),
+						[]byte("txHash2"), owner, {2}, big.NewInt(200).Bytes(),
+					},
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						string(vm.ScheduledCallsSCAddress): {
+							Address:        vm.ScheduledCallsSCAddress,
+							BalanceDelta:   big.NewInt(-5),
+							StorageUpdates: map[string]*vmcommon.StorageUpdate{"key": {Offset: []byte("key"), Data: []byte("value")}},
+						},
+						string(secondDestination): {
+							Address:         secondDestination,
+							BalanceDelta:    big.NewInt(0),
+							OutputTransfers: []vmcommon.OutputTransfer{{Index: 2, Value: big.NewInt(0), Data: []byte("claim"), GasLimit: 20}},
+						},
+						string(firstDestination): {
+							Address:         firstDestination,
+							BalanceDelta:    big.NewInt(5),
+							OutputTransfers: []vmcommon.OutputTransfer{{Index: 1, Value: big.NewInt(5), GasLimit: 10}},
+						},
+					},
+				}, nil
+			},
+		}
+		scAccount := createScheduledCallsAccount(1000)
+		args.Accounts = &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				require.Equal(t, vm.ScheduledCallsSCAddress, address)
+				return scAccount, nil
+			},
+		}
+		totalFees := big.NewInt(0)
+		args.FeeHandler = &mock.FeeAccumulatorStub{
+			ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+				totalFees.Add(totalFees, cost)
+			},
+		}
+		var addedSCRs []data.TransactionHandler
+		args.TxCoordinator = &testscommon.TransactionCoordinatorMock{
+			AddIntermediateTransactionsCalled: func(mapSCRs map[block.Type][]data.TransactionHandler) error {
+				addedSCRs = mapSCRs[block.SmartContractResultBlock]
+				return nil
+			},
+		}
+		scp, _ := NewScheduledCallsProcessor(args)
+
+		err := scp.ProcessDueCalls(&block.MetaBlock{Round: 10})
+		require.Nil(t, err)
+		require.Equal(t, big.NewInt(300), totalFees)
+		require.Equal(t, big.NewInt(695), scAccount.GetBalance())
+		value, _, _ := scAccount.RetrieveValue([]byte("key"))
+		require.Equal(t, []byte("value"), value)
+
+		require.Equal(t, []data.TransactionHandler{
+			&smartContractResult.SmartContractResult{
+				Value:          big.NewInt(5),
+				RcvAddr:        firstDestination,
+				SndAddr:        vm.ScheduledCallsSCAddress,
+				PrevTxHash:     []byte("txHash1"),
+				OriginalTxHash: []byte("txHash1"),
+				GasLimit:       10,
+				OriginalSender: owner,
+			},
+			&smartContractResult.SmartContractResult{
+				Nonce:          2,
+				Value:          big.NewInt(0),
+				RcvAddr:        secondDestination,
+				SndAddr:        vm.ScheduledCallsSCAddress,
+				Data:           []byte("claim"),
+				PrevTxHash:     []byte("txHash2"),
+				OriginalTxHash: []byte("txHash2"),
+				GasLimit:       20,
+				OriginalSender: owner,
+			},
+		}, addedSCRs)
+	})
+	t.Run("calls towards smart contracts should be dispatched as asynchronous calls", func(t *testing.T) {
+		t.Parallel()
+
+		scDestination := append(make([]byte, 10), bytes.Repeat([]byte{1}, 22)...)
+		owner := bytes.Repeat([]byte{3}, 32)
+		args := createMockArgsScheduledCallsProcessor()
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{[]byte("txHash1"), owner, {}, big.NewInt(100).Bytes()},
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						string(scDestination): {
+							Address:         scDestination,
+							BalanceDelta:    big.NewInt(0),
+							OutputTransfers: []vmcommon.OutputTransfer{{Index: 1, Value: big.NewInt(0), Data: []byte("claim@01"), GasLimit: 10}},
+						},
+					},
+				}, nil
+			},
+		}
+		var addedSCRs []data.TransactionHandler
+		args.TxCoordinator = &testscommon.TransactionCoordinatorMock{
+			AddIntermediateTransactionsCalled: func(mapSCRs map[block.Type][]data.TransactionHandler) error {
+				addedSCRs = mapSCRs[block.SmartContractResultBlock]
+				return nil
+			},
+		}
+		scp, _ := NewScheduledCallsProcessor(args)
+
+		err := scp.ProcessDueCalls(&block.MetaBlock{Round: 10})
+		require.Nil(t, err)
+		require.Equal(t, []data.TransactionHandler{
+			&smartContractResult.SmartContractResult{
+				Value:          big.NewInt(0),
+				RcvAddr:        scDestination,
+				SndAddr:        vm.ScheduledCallsSCAddress,
+				Data:           []byte("claim@01@00"),
+				PrevTxHash:     []byte("txHash1"),
+				OriginalTxHash: []byte("txHash1"),
+				GasLimit:       10,
+				CallType:       vmData.AsynchronousCall,
+				OriginalSender: owner,
+			},
+		}, addedSCRs)
+	})
+}
@@ -0,0 +1,265 @@
+package builtInFunctions
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const epochLengthInUsage = 4
+
+type esdtTransferAmount struct {
+	tokenID []byte
+	value   *big.Int
+}
+
+type esdtKeyValue struct {
+	key   []byte
+	value []byte
+}
+
+// esdtComplianceChecker verifies the ESDT transfers against the allow list, deny list and transfer limits
+// propagated by the ESDT system SC on the system account of the shard
+type esdtComplianceChecker struct {
+	accounts            vmcommon.AccountsAdapter
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+	mutEpoch            sync.RWMutex
+	currentEpoch        uint32
+}
+
+func newESDTComplianceChecker(
+	accounts vmcommon.AccountsAdapter,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	epochNotifier vmcommon.EpochNotifier,
+) (*esdtComplianceChecker, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, process.ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	ecc := &esdtComplianceChecker{
+		accounts:            accounts,
+		enableEpochsHandler: enableEpochsHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(ecc)
+
+	return ecc, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (ecc *esdtComplianceChecker) EpochConfirmed(epoch uint32, _ uint64) {
+	ecc.mutEpoch.Lock()
+	ecc.currentEpoch = epoch
+	ecc.mutEpoch.Unlock()
+}
+
+func (ecc *esdtComplianceChecker) isActive() bool {
+	return ecc.enableEpochsHandler.IsFlagEnabled(common.ESDTComplianceFlag)
+}
+
+func (ecc *esdtComplianceChecker) getCurrentEpoch() uint32 {
+	ecc.mutEpoch.RLock()
+	defer ecc.mutEpoch.RUnlock()
+
+	return ecc.currentEpoch
+}
+
+// checkTransfers returns an error if any of the transfers breaks the restrictions of its token. On success, it returns
+// the updated amounts transferred in the current epoch, to be saved by the caller after the transfer is executed
+func (ecc *esdtComplianceChecker) checkTransfers(
+	acntSnd vmcommon.UserAccountHandler,
+	destination []byte,
+	transfers []*esdtTransferAmount,
+) ([]*esdtKeyValue, error) {
+	systemAccount, err := ecc.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*esdtKeyValue, 0)
+	for _, transfer := range aggregateTransfersByToken(transfers) {
+		configBuff, errRetrieve := retrieveValue(systemAccount, common.ESDTComplianceConfigKey(transfer.tokenID))
+		if errRetrieve != nil {
+			return nil, errRetrieve
+		}
+
+		config := common.NewESDTComplianceConfigFromBytes(configBuff)
+		if !config.HasRestrictions() {
+			continue
+		}
+
+		err = ecc.checkLists(systemAccount, config, transfer.tokenID, acntSnd.AddressBytes(), destination)
+		if err != nil {
+			return nil, err
+		}
+		if !config.TransferLimit {
+			continue
+		}
+
+		update, errLimit := ecc.checkTransferLimit(systemAccount, acntSnd, config, transfer)
+		if errLimit != nil {
+			return nil, errLimit
+		}
+		if update != nil {
+			updates = append(updates, update)
+		}
+	}
+
+	return updates, nil
+}
+
+func (ecc *esdtComplianceChecker) checkLists(
+	systemAccount vmcommon.UserAccountHandler,
+	config *common.ESDTComplianceConfig,
+	tokenID []byte,
+	addresses ...[]byte,
+) error {
+	for _, address := range addresses {
+		if config.AllowList {
+			isListed, err := isAddressListed(systemAccount, common.ESDTComplianceAllowList, tokenID, address)
+			if err != nil {
+				return err
+			}
+			if !isListed {
+				return fmt.Errorf("%w, token %s, address %s", process.ErrAddressNotInESDTAllowList, tokenID, hex.EncodeToString(address))
+			}
+		}
+		if config.DenyList {
+			isListed, err := isAddressListed(systemAccount, common.ESDTComplianceDenyList, tokenID, address)
+			if err != nil {
+				return err
+			}
+			if isListed {
+				return fmt.Errorf("%w, token %s, address %s", process.ErrAddressInESDTDenyList, tokenID, hex.EncodeToString(address))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ecc *esdtComplianceChecker) checkTransferLimit(
+	systemAccount vmcommon.UserAccountHandler,
+	acntSnd vmcommon.UserAccountHandler,
+	config *common.ESDTComplianceConfig,
+	transfer *esdtTransferAmount,
+) (*esdtKeyValue, error) {
+	limitBuff, err := retrieveValue(systemAccount, common.ESDTComplianceEntryKey(common.ESDTComplianceTransferLimit, transfer.tokenID, acntSnd.AddressBytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	limit := config.DefaultTransferLimit
+	if len(limitBuff) > 0 {
+		limit = big.NewInt(0).SetBytes(limitBuff)
+	}
+	if limit.Sign() == 0 {
+		return nil, nil
+	}
+
+	usageKey := common.ESDTComplianceUsageKey(transfer.tokenID)
+	usageBuff, err := retrieveValue(acntSnd, usageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := ecc.getCurrentEpoch()
+	transferred := big.NewInt(0)
+	if len(usageBuff) >= epochLengthInUsage && binary.BigEndian.Uint32(usageBuff[:epochLengthInUsage]) == currentEpoch {
+		transferred.SetBytes(usageBuff[epochLengthInUsage:])
+	}
+
+	transferred.Add(transferred, transfer.value)
+	if transferred.Cmp(limit) > 0 {
+		return nil, fmt.Errorf("%w, token %s, limit %s", process.ErrESDTTransferLimitExceeded, transfer.tokenID, limit.String())
+	}
+
+	newUsage := make([]byte, epochLengthInUsage)
+	binary.BigEndian.PutUint32(newUsage, currentEpoch)
+
+	return &esdtKeyValue{
+		key:   usageKey,
+		value: append(newUsage, transferred.Bytes()...),
+	}, nil
+}
+
+func (ecc *esdtComplianceChecker) saveUsages(acntSnd vmcommon.UserAccountHandler, updates []*esdtKeyValue) error {
+	for _, update := range updates {
+		err := acntSnd.AccountDataHandler().SaveKeyValue(update.key, update.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ecc *esdtComplianceChecker) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemAccount, err := ecc.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := systemAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ecc *esdtComplianceChecker) IsInterfaceNil() bool {
+	return ecc == nil
+}
+
+func isAddressListed(systemAccount vmcommon.UserAccountHandler, listType string, tokenID []byte, address []byte) (bool, error) {
+	value, err := retrieveValue(systemAccount, common.ESDTComplianceEntryKey(listType, tokenID, address))
+	if err != nil {
+		return false, err
+	}
+
+	return len(value) > 0, nil
+}
+
+func retrieveValue(account vmcommon.UserAccountHandler, key []byte) ([]byte, error) {
+	value, _, err := account.AccountDataHandler().RetrieveValue(key)
+	if core.IsGetNodeFromDBError(err) {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func aggregateTransfersByToken(transfers []*esdtTransferAmount) []*esdtTransferAmount {
+	aggregated := make([]*esdtTransferAmount, 0, len(transfers))
+	indexes := make(map[string]int)
+	for _, transfer := range transfers {
+		index, found := indexes[string(transfer.tokenID)]
+		if found {
+			aggregated[index].value.Add(aggregated[index].value, transfer.value)
+			continue
+		}
+
+		indexes[string(transfer.tokenID)] = len(aggregated)
+		aggregated = append(aggregated, &esdtTransferAmount{
+			tokenID: transfer.tokenID,
+			value:   big.NewInt(0).Set(transfer.value),
+		})
+	}
+
+	return aggregated
+}
@@ -0,0 +1,191 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	complianceTestToken    = []byte("TKN-abcdef")
+	complianceTestSender   = bytes.Repeat([]byte("s"), 32)
+	complianceTestReceiver = bytes.Repeat([]byte("r"), 32)
+)
+
+func createAccountWithStorage(address []byte, storage map[string][]byte) vmcommon.UserAccountHandler {
+	dtt := &trieMock.DataTrieTrackerStub{
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return storage[string(key)], 0, nil
+		},
+		SaveKeyValueCalled: func(key []byte, value []byte) error {
+			storage[string(key)] = value
+			return nil
+		},
+	}
+	account, _ := accounts.NewUserAccount(address, dtt, &trieMock.TrieLeafParserStub{})
+
+	return account
+}
+
+func createAccountsWithSystemAccount(systemAccountStorage map[string][]byte) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return createAccountWithStorage(address, systemAccountStorage), nil
+		},
+	}
+}
+
+func createComplianceChecker(systemAccountStorage map[string][]byte, epoch uint32) *esdtComplianceChecker {
+	checker, _ := newESDTComplianceChecker(
+		createAccountsWithSystemAccount(systemAccountStorage),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.ESDTComplianceFlag),
+		&epochNotifier.EpochNotifierStub{},
+	)
+	checker.EpochConfirmed(epoch, 0)
+
+	return checker
+}
+
+func TestNewESDTComplianceChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := newESDTComplianceChecker(nil, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, &epochNotifier.EpochNotifierStub{})
+		require.Nil(t, checker)
+		require.Equal(t, process.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := newESDTComplianceChecker(&stateMock.AccountsStub{}, nil, &epochNotifier.EpochNotifierStub{})
+		require.Nil(t, checker)
+		require.Equal(t, process.ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("nil epoch notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := newESDTComplianceChecker(&stateMock.AccountsStub{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, nil)
+		require.Nil(t, checker)
+		require.Equal(t, process.ErrNilEpochNotifier, err)
+	})
+	t.Run("should work and register for epoch changes", func(t *testing.T) {
+		t.Parallel()
+
+		registered := false
+		notifier := &epochNotifier.EpochNotifierStub{
+			RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
+				registered = true
+			},
+		}
+		checker, err := newESDTComplianceChecker(&stateMock.AccountsStub{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, notifier)
+		require.Nil(t, err)
+		require.False(t, checker.IsInterfaceNil())
+		require.True(t, registered)
+	})
+}
+
+func TestEsdtComplianceChecker_CheckTransfers(t *testing.T) {
+	t.Parallel()
+
+	transfers := []*esdtTransferAmount{{tokenID: complianceTestToken, value: big.NewInt(10)}}
+
+	t.Run("token without restrictions should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker := createComplianceChecker(make(map[string][]byte), 0)
+		sender := createAccountWithStorage(complianceTestSender, make(map[string][]byte))
+		updates, err := checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.Nil(t, err)
+		require.Empty(t, updates)
+	})
+	t.Run("allow list should reject the addresses not listed", func(t *testing.T) {
+		t.Parallel()
+
+		config := &common.ESDTComplianceConfig{AllowList: true}
+		systemAccountStorage := map[string][]byte{
+			string(common.ESDTComplianceConfigKey(complianceTestToken)):                                                      config.ToBytes(),
+			string(common.ESDTComplianceEntryKey(common.ESDTComplianceAllowList, complianceTestToken, complianceTestSender)): {1},
+		}
+		checker := createComplianceChecker(systemAccountStorage, 0)
+		sender := createAccountWithStorage(complianceTestSender, make(map[string][]byte))
+
+		_, err := checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.True(t, errors.Is(err, process.ErrAddressNotInESDTAllowList))
+
+		systemAccountStorage[string(common.ESDTComplianceEntryKey(common.ESDTComplianceAllowList, complianceTestToken, complianceTestReceiver))] = []byte{1}
+		_, err = checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.Nil(t, err)
+	})
+	t.Run("deny list should reject the listed addresses", func(t *testing.T) {
+		t.Parallel()
+
+		config := &common.ESDTComplianceConfig{DenyList: true}
+		systemAccountStorage := map[string][]byte{
+			string(common.ESDTComplianceConfigKey(complianceTestToken)): config.ToBytes(),
+		}
+		checker := createComplianceChecker(systemAccountStorage, 0)
+		sender := createAccountWithStorage(complianceTestSender, make(map[string][]byte))
+
+		_, err := checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.Nil(t, err)
+
+		systemAccountStorage[string(common.ESDTComplianceEntryKey(common.ESDTComplianceDenyList, complianceTestToken, complianceTestReceiver))] = []byte{1}
+		_, err = checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.True(t, errors.Is(err, process.ErrAddressInESDTDenyList))
+	})
+	t.Run("transfer limit should count the transfers of the current epoch", func(t *testing.T) {
+		t.Parallel()
+
+		config := &common.ESDTComplianceConfig{TransferLimit: true, DefaultTransferLimit: big.NewInt(25)}
+		systemAccountStorage := map[string][]byte{
+			string(common.ESDTComplianceConfigKey(complianceTestToken)): config.ToBytes(),
+		}
+		checker := createComplianceChecker(systemAccountStorage, 5)
+		sender := createAccountWithStorage(complianceTestSender, make(map[string][]byte))
+
+		for i := 0; i < 2; i++ {
+			updates, err := checker.checkTransfers(sender, complianceTestReceiver, transfers)
+			require.Nil(t, err)
+			require.Len(t, updates, 1)
+			require.Nil(t, checker.saveUsages(sender, updates))
+		}
+
+		_, err := checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.True(t, errors.Is(err, process.ErrESDTTransferLimitExceeded))
+
+		checker.EpochConfirmed(6, 0)
+		_, err = checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.Nil(t, err)
+	})
+	t.Run("account transfer limit should override the default one", func(t *testing.T) {
+		t.Parallel()
+
+		config := &common.ESDTComplianceConfig{TransferLimit: true, DefaultTransferLimit: big.NewInt(5)}
+		systemAccountStorage := map[string][]byte{
+			string(common.ESDTComplianceConfigKey(complianceTestToken)):                                                          config.ToBytes(),
+			string(common.ESDTComplianceEntryKey(common.ESDTComplianceTransferLimit, complianceTestToken, complianceTestSender)): big.NewInt(10).Bytes(),
+		}
+		checker := createComplianceChecker(systemAccountStorage, 0)
+		sender := createAccountWithStorage(complianceTestSender, make(map[string][]byte))
+
+		_, err := checker.checkTransfers(sender, complianceTestReceiver, transfers)
+		require.Nil(t, err)
+
+		multipleTransfers := append(transfers, &esdtTransferAmount{tokenID: complianceTestToken, value: big.NewInt(1)})
+		_, err = checker.checkTransfers(sender, complianceTestReceiver, multipleTransfers)
+		require.True(t, errors.Is(err, process.ErrESDTTransferLimitExceeded))
+	})
+}
@@ -0,0 +1,151 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	numArgsComplianceConfig     = 2
+	minNumArgsComplianceEntries = 4
+)
+
+var zero = big.NewInt(0)
+
+// esdtComplianceSettings saves on the system account of the shard the compliance data sent by the ESDT system SC
+type esdtComplianceSettings struct {
+	accounts            vmcommon.AccountsAdapter
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+	function            string
+}
+
+func newESDTComplianceSettings(
+	accounts vmcommon.AccountsAdapter,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	function string,
+) (*esdtComplianceSettings, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, process.ErrNilEnableEpochsHandler
+	}
+	if function != common.BuiltInFunctionESDTSetComplianceConfig && function != common.BuiltInFunctionESDTSetComplianceEntries {
+		return nil, process.ErrInvalidArguments
+	}
+
+	return &esdtComplianceSettings{
+		accounts:            accounts,
+		enableEpochsHandler: enableEpochsHandler,
+		function:            function,
+	}, nil
+}
+
+// ProcessBuiltinFunction saves the token compliance configuration or entries on the system account
+func (ecs *esdtComplianceSettings) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue != nil && vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, core.ESDTSCAddress) {
+		return nil, process.ErrCallerIsNotESDTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, process.ErrOnlySystemAccountAccepted
+	}
+
+	keyValues, err := ecs.createKeyValues(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ecs.saveOnSystemAccount(keyValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// format: ESDTSetComplianceConfig@tokenID@config
+// format: ESDTSetComplianceEntries@tokenID@entryType@value@address1@address2...
+func (ecs *esdtComplianceSettings) createKeyValues(args [][]byte) ([]*esdtKeyValue, error) {
+	if ecs.function == common.BuiltInFunctionESDTSetComplianceConfig {
+		if len(args) != numArgsComplianceConfig {
+			return nil, process.ErrInvalidArguments
+		}
+
+		return []*esdtKeyValue{{key: common.ESDTComplianceConfigKey(args[0]), value: args[1]}}, nil
+	}
+
+	if len(args) < minNumArgsComplianceEntries {
+		return nil, process.ErrInvalidArguments
+	}
+	entryType := string(args[1])
+	if !common.IsValidESDTComplianceEntryType(entryType) {
+		return nil, process.ErrInvalidArguments
+	}
+
+	// a zero value removes the entry
+	var value []byte
+	entryValue := big.NewInt(0).SetBytes(args[2])
+	if entryValue.Cmp(zero) > 0 {
+		value = entryValue.Bytes()
+	}
+
+	keyValues := make([]*esdtKeyValue, 0, len(args)-3)
+	for _, address := range args[3:] {
+		keyValues = append(keyValues, &esdtKeyValue{
+			key:   common.ESDTComplianceEntryKey(entryType, args[0], address),
+			value: value,
+		})
+	}
+
+	return keyValues, nil
+}
+
+func (ecs *esdtComplianceSettings) saveOnSystemAccount(keyValues []*esdtKeyValue) error {
+	account, err := ecs.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return err
+	}
+
+	systemAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	for _, keyValue := range keyValues {
+		err = systemAccount.AccountDataHandler().SaveKeyValue(keyValue.key, keyValue.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ecs.accounts.SaveAccount(systemAccount)
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (ecs *esdtComplianceSettings) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// IsActive returns true if the ESDT compliance flag is enabled
+func (ecs *esdtComplianceSettings) IsActive() bool {
+	return ecs.enableEpochsHandler.IsFlagEnabled(common.ESDTComplianceFlag)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ecs *esdtComplianceSettings) IsInterfaceNil() bool {
+	return ecs == nil
+}
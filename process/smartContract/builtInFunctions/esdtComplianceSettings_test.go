@@ -0,0 +1,122 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createComplianceSettingsInput(args ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: core.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  args,
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+}
+
+func TestNewESDTComplianceSettings(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.ESDTComplianceFlag)
+	settings, err := newESDTComplianceSettings(nil, enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceConfig)
+	require.Nil(t, settings)
+	require.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	settings, err = newESDTComplianceSettings(createAccountsWithSystemAccount(nil), nil, common.BuiltInFunctionESDTSetComplianceConfig)
+	require.Nil(t, settings)
+	require.Equal(t, process.ErrNilEnableEpochsHandler, err)
+
+	settings, err = newESDTComplianceSettings(createAccountsWithSystemAccount(nil), enableEpochsHandler, core.BuiltInFunctionESDTPause)
+	require.Nil(t, settings)
+	require.Equal(t, process.ErrInvalidArguments, err)
+
+	settings, err = newESDTComplianceSettings(createAccountsWithSystemAccount(nil), enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceConfig)
+	require.Nil(t, err)
+	require.True(t, settings.IsActive())
+	require.False(t, settings.IsInterfaceNil())
+}
+
+func TestEsdtComplianceSettings_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.ESDTComplianceFlag)
+
+	t.Run("invalid caller should error", func(t *testing.T) {
+		t.Parallel()
+
+		settings, _ := newESDTComplianceSettings(createAccountsWithSystemAccount(nil), enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceConfig)
+		vmInput := createComplianceSettingsInput(complianceTestToken, []byte{1})
+		vmInput.CallerAddr = complianceTestSender
+
+		_, err := settings.ProcessBuiltinFunction(nil, nil, vmInput)
+		require.Equal(t, process.ErrCallerIsNotESDTSystemSC, err)
+	})
+	t.Run("invalid recipient should error", func(t *testing.T) {
+		t.Parallel()
+
+		settings, _ := newESDTComplianceSettings(createAccountsWithSystemAccount(nil), enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceConfig)
+		vmInput := createComplianceSettingsInput(complianceTestToken, []byte{1})
+		vmInput.RecipientAddr = complianceTestReceiver
+
+		_, err := settings.ProcessBuiltinFunction(nil, nil, vmInput)
+		require.Equal(t, process.ErrOnlySystemAccountAccepted, err)
+	})
+	t.Run("call value should error", func(t *testing.T) {
+		t.Parallel()
+
+		settings, _ := newESDTComplianceSettings(createAccountsWithSystemAccount(nil), enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceConfig)
+		vmInput := createComplianceSettingsInput(complianceTestToken, []byte{1})
+		vmInput.CallValue = big.NewInt(1)
+
+		_, err := settings.ProcessBuiltinFunction(nil, nil, vmInput)
+		require.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+	})
+	t.Run("config should be saved on the system account", func(t *testing.T) {
+		t.Parallel()
+
+		storage := make(map[string][]byte)
+		settings, _ := newESDTComplianceSettings(createAccountsWithSystemAccount(storage), enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceConfig)
+		config := &common.ESDTComplianceConfig{AllowList: true, DefaultTransferLimit: big.NewInt(0)}
+
+		_, err := settings.ProcessBuiltinFunction(nil, nil, createComplianceSettingsInput(complianceTestToken))
+		require.Equal(t, process.ErrInvalidArguments, err)
+
+		vmOutput, err := settings.ProcessBuiltinFunction(nil, nil, createComplianceSettingsInput(complianceTestToken, config.ToBytes()))
+		require.Nil(t, err)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+		require.Equal(t, config, common.NewESDTComplianceConfigFromBytes(storage[string(common.ESDTComplianceConfigKey(complianceTestToken))]))
+	})
+	t.Run("entries should be added and removed", func(t *testing.T) {
+		t.Parallel()
+
+		storage := make(map[string][]byte)
+		settings, _ := newESDTComplianceSettings(createAccountsWithSystemAccount(storage), enableEpochsHandler, common.BuiltInFunctionESDTSetComplianceEntries)
+		senderKey := string(common.ESDTComplianceEntryKey(common.ESDTComplianceAllowList, complianceTestToken, complianceTestSender))
+		receiverKey := string(common.ESDTComplianceEntryKey(common.ESDTComplianceAllowList, complianceTestToken, complianceTestReceiver))
+
+		vmInput := createComplianceSettingsInput(complianceTestToken, []byte("unknown"), []byte{1}, complianceTestSender)
+		_, err := settings.ProcessBuiltinFunction(nil, nil, vmInput)
+		require.Equal(t, process.ErrInvalidArguments, err)
+
+		vmInput = createComplianceSettingsInput(complianceTestToken, []byte(common.ESDTComplianceAllowList), []byte{1}, complianceTestSender, complianceTestReceiver)
+		_, err = settings.ProcessBuiltinFunction(nil, nil, vmInput)
+		require.Nil(t, err)
+		require.Equal(t, []byte{1}, storage[senderKey])
+		require.Equal(t, []byte{1}, storage[receiverKey])
+
+		vmInput = createComplianceSettingsInput(complianceTestToken, []byte(common.ESDTComplianceAllowList), []byte{0}, complianceTestReceiver)
+		_, err = settings.ProcessBuiltinFunction(nil, nil, vmInput)
+		require.Nil(t, err)
+		require.Equal(t, []byte{1}, storage[senderKey])
+		require.Empty(t, storage[receiverKey])
+	})
+}
@@ -0,0 +1,148 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	minArgsESDTTransfer      = 2
+	minArgsESDTNFTTransfer   = 4
+	minArgsMultiESDTTransfer = 2
+	argsPerMultiESDTTransfer = 3
+)
+
+// esdtComplianceTransfer wraps an ESDT transfer built-in function, rejecting the transfers that break the compliance
+// restrictions of the transferred tokens. The checks are done only on the sender shard, so a cross-shard transfer
+// already accepted is never rejected on the destination shard
+type esdtComplianceTransfer struct {
+	builtInFunction vmcommon.BuiltinFunction
+	function        string
+	checker         *esdtComplianceChecker
+}
+
+func newESDTComplianceTransfer(
+	builtInFunction vmcommon.BuiltinFunction,
+	function string,
+	checker *esdtComplianceChecker,
+) (*esdtComplianceTransfer, error) {
+	if check.IfNil(builtInFunction) {
+		return nil, process.ErrNilBuiltInFunction
+	}
+	if check.IfNil(checker) {
+		return nil, process.ErrNilESDTComplianceChecker
+	}
+
+	return &esdtComplianceTransfer{
+		builtInFunction: builtInFunction,
+		function:        function,
+		checker:         checker,
+	}, nil
+}
+
+// ProcessBuiltinFunction checks the compliance restrictions of the transferred tokens before executing the transfer
+func (ect *esdtComplianceTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	shouldCheck := vmInput != nil && !check.IfNil(acntSnd) && !vmInput.ReturnCallAfterError && ect.checker.isActive()
+	if !shouldCheck {
+		return ect.builtInFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	}
+
+	destination, transfers, ok := ect.parseTransfers(vmInput)
+	if !ok {
+		// the wrapped function will return the proper error
+		return ect.builtInFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	}
+
+	updates, err := ect.checker.checkTransfers(acntSnd, destination, transfers)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, err := ect.builtInFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ect.checker.saveUsages(acntSnd, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (ect *esdtComplianceTransfer) parseTransfers(vmInput *vmcommon.ContractCallInput) ([]byte, []*esdtTransferAmount, bool) {
+	args := vmInput.Arguments
+	switch ect.function {
+	case core.BuiltInFunctionESDTTransfer:
+		if len(args) < minArgsESDTTransfer {
+			return nil, nil, false
+		}
+
+		return vmInput.RecipientAddr, []*esdtTransferAmount{newESDTTransferAmount(args[0], args[1])}, true
+	case core.BuiltInFunctionESDTNFTTransfer:
+		if len(args) < minArgsESDTNFTTransfer {
+			return nil, nil, false
+		}
+
+		return args[3], []*esdtTransferAmount{newESDTTransferAmount(args[0], args[2])}, true
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		if len(args) < minArgsMultiESDTTransfer {
+			return nil, nil, false
+		}
+
+		numTransfers := big.NewInt(0).SetBytes(args[1]).Uint64()
+		if numTransfers == 0 || numTransfers > uint64(len(args)-minArgsMultiESDTTransfer)/argsPerMultiESDTTransfer {
+			return nil, nil, false
+		}
+
+		transfers := make([]*esdtTransferAmount, 0, numTransfers)
+		for i := uint64(0); i < numTransfers; i++ {
+			startIndex := minArgsMultiESDTTransfer + i*argsPerMultiESDTTransfer
+			transfers = append(transfers, newESDTTransferAmount(args[startIndex], args[startIndex+2]))
+		}
+
+		return args[0], transfers, true
+	default:
+		return nil, nil, false
+	}
+}
+
+func newESDTTransferAmount(tokenID []byte, value []byte) *esdtTransferAmount {
+	return &esdtTransferAmount{
+		tokenID: tokenID,
+		value:   big.NewInt(0).SetBytes(value),
+	}
+}
+
+// SetPayableChecker sets the payable checker on the wrapped transfer function
+func (ect *esdtComplianceTransfer) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
+	acceptPayableChecker, ok := ect.builtInFunction.(vmcommon.AcceptPayableChecker)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	return acceptPayableChecker.SetPayableChecker(payableHandler)
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (ect *esdtComplianceTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	ect.builtInFunction.SetNewGasConfig(gasCost)
+}
+
+// IsActive returns true if the wrapped function is active
+func (ect *esdtComplianceTransfer) IsActive() bool {
+	return ect.builtInFunction.IsActive()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ect *esdtComplianceTransfer) IsInterfaceNil() bool {
+	return ect == nil
+}
@@ -0,0 +1,138 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createDenyListSystemAccountStorage() map[string][]byte {
+	config := &common.ESDTComplianceConfig{DenyList: true}
+	return map[string][]byte{
+		string(common.ESDTComplianceConfigKey(complianceTestToken)):                                                       config.ToBytes(),
+		string(common.ESDTComplianceEntryKey(common.ESDTComplianceDenyList, complianceTestToken, complianceTestReceiver)): {1},
+	}
+}
+
+func TestNewESDTComplianceTransfer(t *testing.T) {
+	t.Parallel()
+
+	transfer, err := newESDTComplianceTransfer(nil, core.BuiltInFunctionESDTTransfer, createComplianceChecker(nil, 0))
+	require.Nil(t, transfer)
+	require.Equal(t, process.ErrNilBuiltInFunction, err)
+
+	transfer, err = newESDTComplianceTransfer(&mock.BuiltInFunctionStub{}, core.BuiltInFunctionESDTTransfer, nil)
+	require.Nil(t, transfer)
+	require.Equal(t, process.ErrNilESDTComplianceChecker, err)
+
+	transfer, err = newESDTComplianceTransfer(&mock.BuiltInFunctionStub{}, core.BuiltInFunctionESDTTransfer, createComplianceChecker(nil, 0))
+	require.Nil(t, err)
+	require.False(t, transfer.IsInterfaceNil())
+}
+
+func TestEsdtComplianceTransfer_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	value := big.NewInt(10).Bytes()
+	tests := []struct {
+		name    string
+		fn      string
+		vmInput *vmcommon.ContractCallInput
+	}{
+		{
+			name: core.BuiltInFunctionESDTTransfer,
+			fn:   core.BuiltInFunctionESDTTransfer,
+			vmInput: &vmcommon.ContractCallInput{
+				VMInput:       vmcommon.VMInput{CallerAddr: complianceTestSender, Arguments: [][]byte{complianceTestToken, value}},
+				RecipientAddr: complianceTestReceiver,
+			},
+		},
+		{
+			name: core.BuiltInFunctionESDTNFTTransfer,
+			fn:   core.BuiltInFunctionESDTNFTTransfer,
+			vmInput: &vmcommon.ContractCallInput{
+				VMInput:       vmcommon.VMInput{CallerAddr: complianceTestSender, Arguments: [][]byte{complianceTestToken, {1}, value, complianceTestReceiver}},
+				RecipientAddr: complianceTestSender,
+			},
+		},
+		{
+			name: core.BuiltInFunctionMultiESDTNFTTransfer,
+			fn:   core.BuiltInFunctionMultiESDTNFTTransfer,
+			vmInput: &vmcommon.ContractCallInput{
+				VMInput:       vmcommon.VMInput{CallerAddr: complianceTestSender, Arguments: [][]byte{complianceTestReceiver, {1}, complianceTestToken, {}, value}},
+				RecipientAddr: complianceTestSender,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" to a denied address should error", func(t *testing.T) {
+			called := false
+			inner := &mock.BuiltInFunctionStub{
+				ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					called = true
+					return &vmcommon.VMOutput{}, nil
+				},
+			}
+			transfer, _ := newESDTComplianceTransfer(inner, tt.fn, createComplianceChecker(createDenyListSystemAccountStorage(), 0))
+			sender := createAccountWithStorage(complianceTestSender, make(map[string][]byte))
+
+			_, err := transfer.ProcessBuiltinFunction(sender, nil, tt.vmInput)
+			require.True(t, errors.Is(err, process.ErrAddressInESDTDenyList))
+			require.False(t, called)
+		})
+	}
+
+	t.Run("destination shard should not check", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		inner := &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				called = true
+				return &vmcommon.VMOutput{}, nil
+			},
+		}
+		transfer, _ := newESDTComplianceTransfer(inner, tests[0].fn, createComplianceChecker(createDenyListSystemAccountStorage(), 0))
+		receiver := createAccountWithStorage(complianceTestReceiver, make(map[string][]byte))
+
+		_, err := transfer.ProcessBuiltinFunction(nil, receiver, tests[0].vmInput)
+		require.Nil(t, err)
+		require.True(t, called)
+	})
+	t.Run("transfer limit usage should be saved only after a successful transfer", func(t *testing.T) {
+		t.Parallel()
+
+		config := &common.ESDTComplianceConfig{TransferLimit: true, DefaultTransferLimit: big.NewInt(100)}
+		systemAccountStorage := map[string][]byte{
+			string(common.ESDTComplianceConfigKey(complianceTestToken)): config.ToBytes(),
+		}
+		expectedErr := errors.New("expected error")
+		var innerErr error
+		inner := &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{}, innerErr
+			},
+		}
+		transfer, _ := newESDTComplianceTransfer(inner, tests[0].fn, createComplianceChecker(systemAccountStorage, 0))
+		senderStorage := make(map[string][]byte)
+		sender := createAccountWithStorage(complianceTestSender, senderStorage)
+
+		innerErr = expectedErr
+		_, err := transfer.ProcessBuiltinFunction(sender, nil, tests[0].vmInput)
+		require.Equal(t, expectedErr, err)
+		require.Empty(t, senderStorage)
+
+		innerErr = nil
+		_, err = transfer.ProcessBuiltinFunction(sender, nil, tests[0].vmInput)
+		require.Nil(t, err)
+		require.Equal(t, []byte{0, 0, 0, 0, 10}, senderStorage[string(common.ESDTComplianceUsageKey(complianceTestToken))])
+	})
+}
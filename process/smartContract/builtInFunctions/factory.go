@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -100,11 +101,69 @@ func CreateBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (vmc
 		return nil, err
 	}
 
+	err = addESDTComplianceFunctions(bContainerFactory.BuiltInFunctionContainer(), vmcommonAccounts, args)
+	if err != nil {
+		return nil, err
+	}
+
 	args.GasSchedule.RegisterNotifyHandler(bContainerFactory)
 
 	return bContainerFactory, nil
 }
 
+// addESDTComplianceFunctions adds the functions propagating the ESDT transfer restrictions and wraps the ESDT
+// transfer functions so the restrictions are enforced
+func addESDTComplianceFunctions(
+	container vmcommon.BuiltInFunctionContainer,
+	accounts vmcommon.AccountsAdapter,
+	args ArgsCreateBuiltInFunctionContainer,
+) error {
+	complianceFunctions := []string{
+		common.BuiltInFunctionESDTSetComplianceConfig,
+		common.BuiltInFunctionESDTSetComplianceEntries,
+	}
+	for _, function := range complianceFunctions {
+		complianceSettings, err := newESDTComplianceSettings(accounts, args.EnableEpochsHandler, function)
+		if err != nil {
+			return err
+		}
+
+		err = container.Add(function, complianceSettings)
+		if err != nil {
+			return err
+		}
+	}
+
+	checker, err := newESDTComplianceChecker(accounts, args.EnableEpochsHandler, args.EpochNotifier)
+	if err != nil {
+		return err
+	}
+
+	transferFunctions := []string{
+		core.BuiltInFunctionESDTTransfer,
+		core.BuiltInFunctionESDTNFTTransfer,
+		core.BuiltInFunctionMultiESDTNFTTransfer,
+	}
+	for _, function := range transferFunctions {
+		transferFunction, errGet := container.Get(function)
+		if errGet != nil {
+			return errGet
+		}
+
+		complianceTransfer, errCreate := newESDTComplianceTransfer(transferFunction, function, checker)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		err = container.Replace(function, complianceTransfer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAllowedAddress returns the allowed crawler address on the current shard
 func GetAllowedAddress(coordinator sharding.Coordinator, addresses [][]byte) ([]byte, error) {
 	if check.IfNil(coordinator) {
@@ -60,6 +60,23 @@ func (af *AntiFlood) SetDebugger(_ process.AntifloodDebugger) error {
 func (af *AntiFlood) BlacklistPeer(_ core.PeerID, _ string, _ time.Duration) {
 }
 
+// StartProcessingOnTopic does nothing
+func (af *AntiFlood) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic does nothing
+func (af *AntiFlood) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic does nothing
+func (af *AntiFlood) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
+// SetPeerHonestyScorer does nothing and returns nil
+func (af *AntiFlood) SetPeerHonestyScorer(_ process.PeerHonestyScorer) error {
+	return nil
+}
+
 // Close does nothing
 func (af *AntiFlood) Close() error {
 	return nil
@@ -10,6 +10,10 @@ type AntifloodDebugger struct {
 func (ad *AntifloodDebugger) AddData(_ core.PeerID, _ string, _ uint32, _ uint64, _ []byte, _ bool) {
 }
 
+// AddQuotaDecision does nothing
+func (ad *AntifloodDebugger) AddQuotaDecision(_ string, _ uint32, _ uint32, _ string) {
+}
+
 // Close returns nil
 func (ad *AntifloodDebugger) Close() error {
 	return nil
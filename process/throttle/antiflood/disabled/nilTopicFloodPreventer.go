@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/process"
 )
@@ -34,6 +36,18 @@ func (ntfp *nilTopicFloodPreventer) ResetForNotRegisteredTopics() {
 func (ntfp *nilTopicFloodPreventer) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// StartProcessingOnTopic does nothing
+func (ntfp *nilTopicFloodPreventer) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic does nothing
+func (ntfp *nilTopicFloodPreventer) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic does nothing
+func (ntfp *nilTopicFloodPreventer) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ntfp *nilTopicFloodPreventer) IsInterfaceNil() bool {
 	return ntfp == nil
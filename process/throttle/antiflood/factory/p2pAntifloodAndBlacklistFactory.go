@@ -24,7 +24,9 @@ import (
 
 var log = logger.GetOrCreate("p2p/antiflood/factory")
 
-const defaultSpan = 300 * time.Second
+// DefaultSpan is the default time span used by the peer IDs and public keys blacklist caches
+const DefaultSpan = 300 * time.Second
+
 const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
@@ -43,11 +45,38 @@ type AntiFloodComponents struct {
 
 // NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
 func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, statusHandler core.AppStatusHandler, currentPid core.PeerID) (*AntiFloodComponents, error) {
+	timeCache := cache.NewTimeCache(DefaultSpan)
+	p2pPeerBlackList, err := cache.NewPeerTimeCache(timeCache)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeysCache := cache.NewTimeCache(DefaultSpan)
+
+	return NewP2PAntiFloodComponentsWithCachers(ctx, config, statusHandler, currentPid, p2pPeerBlackList, publicKeysCache)
+}
+
+// NewP2PAntiFloodComponentsWithCachers will return instances of antiflood and blacklist, based on the config, using the
+// provided peer IDs and public keys blacklist caches
+func NewP2PAntiFloodComponentsWithCachers(
+	ctx context.Context,
+	config config.Config,
+	statusHandler core.AppStatusHandler,
+	currentPid core.PeerID,
+	p2pPeerBlackList process.PeerBlackListCacher,
+	publicKeysCache process.TimeCacher,
+) (*AntiFloodComponents, error) {
 	if check.IfNil(statusHandler) {
 		return nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(p2pPeerBlackList) {
+		return nil, fmt.Errorf("%w for peer IDs cacher", process.ErrNilBlackListCacher)
+	}
+	if check.IfNil(publicKeysCache) {
+		return nil, fmt.Errorf("%w for public keys cacher", process.ErrNilBlackListCacher)
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid)
+		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid, p2pPeerBlackList, publicKeysCache)
 	}
 
 	return &AntiFloodComponents{
@@ -64,15 +93,9 @@ func initP2PAntiFloodComponents(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	p2pPeerBlackList process.PeerBlackListCacher,
+	publicKeysCache process.TimeCacher,
 ) (*AntiFloodComponents, error) {
-	timeCache := cache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := cache.NewPeerTimeCache(timeCache)
-	if err != nil {
-		return nil, err
-	}
-
-	publicKeysCache := cache.NewTimeCache(defaultSpan)
-
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		ctx,
 		mainConfig.Antiflood.FastReacting,
@@ -112,7 +135,7 @@ func initP2PAntiFloodComponents(
 		return nil, fmt.Errorf("%w when creating out of specs flood preventer", err)
 	}
 
-	topicFloodPreventer, err := floodPreventers.NewTopicFloodPreventer(mainConfig.Antiflood.Topic.DefaultMaxMessagesPerSec)
+	topicFloodPreventer, err := createTopicFloodPreventer(ctx, mainConfig.Antiflood)
 	if err != nil {
 		return nil, err
 	}
@@ -159,6 +182,58 @@ func initP2PAntiFloodComponents(
 	}, nil
 }
 
+func createTopicFloodPreventer(ctx context.Context, antifloodConfig config.AntifloodConfig) (process.TopicFloodPreventer, error) {
+	if !antifloodConfig.Adaptive.Enabled {
+		return floodPreventers.NewTopicFloodPreventer(antifloodConfig.Topic.DefaultMaxMessagesPerSec)
+	}
+
+	adaptiveConfig := antifloodConfig.Adaptive
+	if adaptiveConfig.UpdateIntervalInSeconds == 0 {
+		return nil, fmt.Errorf("%w for Antiflood.Adaptive.UpdateIntervalInSeconds", process.ErrInvalidValue)
+	}
+
+	arg := floodPreventers.ArgAdaptiveTopicFloodPreventer{
+		DefaultMaxMessagesPerPeer: antifloodConfig.Topic.DefaultMaxMessagesPerSec,
+		TargetProcessingLatency:   time.Duration(adaptiveConfig.TargetProcessingLatencyInMilliseconds) * time.Millisecond,
+		MaxQueueDepth:             adaptiveConfig.MaxQueueDepth,
+		MinQuotaPercent:           adaptiveConfig.MinQuotaPercent,
+		DecreaseStepPercent:       adaptiveConfig.DecreaseStepPercent,
+		RecoveryStepPercent:       adaptiveConfig.RecoveryStepPercent,
+		LowHonestyScoreThreshold:  adaptiveConfig.LowHonestyScoreThreshold,
+	}
+	adaptiveTopicFloodPreventer, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%w when creating adaptive topic flood preventer", err)
+	}
+
+	log.Debug("started adaptive topic flood preventer",
+		"update interval in seconds", adaptiveConfig.UpdateIntervalInSeconds,
+		"target processing latency", arg.TargetProcessingLatency,
+		"max queue depth", arg.MaxQueueDepth,
+		"min quota percent", arg.MinQuotaPercent,
+		"decrease step percent", arg.DecreaseStepPercent,
+		"recovery step percent", arg.RecoveryStepPercent,
+		"low honesty score threshold", arg.LowHonestyScoreThreshold,
+	)
+
+	go func() {
+		wait := time.Duration(adaptiveConfig.UpdateIntervalInSeconds) * time.Second
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("adaptiveTopicFloodPreventer.UpdateQuotas go routine is stopping...")
+				return
+			case <-time.After(wait):
+			}
+
+			adaptiveTopicFloodPreventer.UpdateQuotas()
+		}
+	}()
+
+	return adaptiveTopicFloodPreventer, nil
+}
+
 func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMessages []config.TopicMaxMessagesConfig) {
 	for _, topicMaxMsg := range topicMaxMessages {
 		topicFloodPreventer.SetMaxMessagesForTopic(topicMaxMsg.Topic, topicMaxMsg.NumMessagesPerSec)
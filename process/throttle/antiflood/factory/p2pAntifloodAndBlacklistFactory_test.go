@@ -2,13 +2,17 @@ package factory
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,6 +29,38 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodComponentsWithCachers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil peer IDs cacher should error", func(t *testing.T) {
+		t.Parallel()
+
+		components, err := NewP2PAntiFloodComponentsWithCachers(context.Background(), createEnabledAntifloodConfig(), statusHandler.NewAppStatusHandlerMock(), currentPid, nil, &testscommon.TimeCacheStub{})
+		assert.Nil(t, components)
+		assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
+	})
+	t.Run("nil public keys cacher should error", func(t *testing.T) {
+		t.Parallel()
+
+		components, err := NewP2PAntiFloodComponentsWithCachers(context.Background(), createEnabledAntifloodConfig(), statusHandler.NewAppStatusHandlerMock(), currentPid, &mock.PeerBlackListHandlerStub{}, nil)
+		assert.Nil(t, components)
+		assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
+	})
+	t.Run("should use the provided cachers", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		peerBlackList := &mock.PeerBlackListHandlerStub{}
+		publicKeysCache := &testscommon.TimeCacheStub{}
+		components, err := NewP2PAntiFloodComponentsWithCachers(ctx, createEnabledAntifloodConfig(), statusHandler.NewAppStatusHandlerMock(), currentPid, peerBlackList, publicKeysCache)
+		assert.Nil(t, err)
+		assert.True(t, components.BlacklistHandler == peerBlackList)
+		assert.True(t, components.PubKeysCacher == publicKeysCache)
+	})
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -80,6 +116,70 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	time.Sleep(time.Second * 2)
 }
 
+func TestNewP2PAntiFloodAndBlackList_AdaptiveTopicPreventer(t *testing.T) {
+	t.Parallel()
+
+	createConfig := func() config.Config {
+		return config.Config{
+			Antiflood: config.AntifloodConfig{
+				Enabled: true,
+				Cache: config.CacheConfig{
+					Type:     "LRU",
+					Capacity: 10,
+					Shards:   2,
+				},
+				FastReacting: createFloodPreventerConfig(),
+				SlowReacting: createFloodPreventerConfig(),
+				OutOfSpecs:   createFloodPreventerConfig(),
+				Topic: config.TopicAntifloodConfig{
+					DefaultMaxMessagesPerSec: 10,
+				},
+				Adaptive: config.AdaptiveAntifloodConfig{
+					Enabled:                               true,
+					UpdateIntervalInSeconds:               1,
+					TargetProcessingLatencyInMilliseconds: 100,
+					MaxQueueDepth:                         10,
+					MinQuotaPercent:                       10,
+					DecreaseStepPercent:                   20,
+					RecoveryStepPercent:                   10,
+					LowHonestyScoreThreshold:              -40,
+				},
+			},
+		}
+	}
+
+	t.Run("invalid update interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig()
+		cfg.Antiflood.Adaptive.UpdateIntervalInSeconds = 0
+		components, err := NewP2PAntiFloodComponents(context.Background(), cfg, statusHandler.NewAppStatusHandlerMock(), currentPid)
+		assert.Nil(t, components)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid adaptive config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig()
+		cfg.Antiflood.Adaptive.MaxQueueDepth = 0
+		components, err := NewP2PAntiFloodComponents(context.Background(), cfg, statusHandler.NewAppStatusHandlerMock(), currentPid)
+		assert.Nil(t, components)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		components, err := NewP2PAntiFloodComponents(ctx, createConfig(), statusHandler.NewAppStatusHandlerMock(), currentPid)
+		assert.Nil(t, err)
+
+		_, isAdaptive := components.TopicPreventer.(process.AdaptiveTopicFloodPreventer)
+		assert.True(t, isAdaptive)
+	})
+}
+
 func createFloodPreventerConfig() config.FloodPreventerConfig {
 	return config.FloodPreventerConfig{
 		IntervalInSeconds: 1,
@@ -95,3 +195,22 @@ func createFloodPreventerConfig() config.FloodPreventerConfig {
 		},
 	}
 }
+
+func createEnabledAntifloodConfig() config.Config {
+	return config.Config{
+		Antiflood: config.AntifloodConfig{
+			Enabled: true,
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+				Shards:   2,
+			},
+			FastReacting: createFloodPreventerConfig(),
+			SlowReacting: createFloodPreventerConfig(),
+			OutOfSpecs:   createFloodPreventerConfig(),
+			Topic: config.TopicAntifloodConfig{
+				DefaultMaxMessagesPerSec: 10,
+			},
+		},
+	}
+}
@@ -0,0 +1,329 @@
+package floodPreventers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+)
+
+var _ process.AdaptiveTopicFloodPreventer = (*adaptiveTopicFloodPreventer)(nil)
+
+const maxQuotaPercent = uint32(100)
+const minQuotaPercent = uint32(1)
+
+// ArgAdaptiveTopicFloodPreventer defines the arguments needed to create an adaptive topic flood preventer
+type ArgAdaptiveTopicFloodPreventer struct {
+	DefaultMaxMessagesPerPeer uint32
+	TargetProcessingLatency   time.Duration
+	MaxQueueDepth             uint32
+	MinQuotaPercent           uint32
+	DecreaseStepPercent       uint32
+	RecoveryStepPercent       uint32
+	LowHonestyScoreThreshold  float64
+}
+
+type topicLoad struct {
+	numInFlight         uint32
+	numThrottled        uint64
+	numProcessed        uint64
+	totalProcessingTime time.Duration
+	quotaPercent        uint32
+}
+
+// adaptiveTopicFloodPreventer is a topic flood preventer that shrinks the per-peer quotas of the topics whose
+// processing falls behind (high latency or too many pending messages) and restores them once the load goes down. The
+// pending messages of a topic are the ones being processed plus the ones the interceptors could not admit for
+// processing since the last update, as the node was busy. Validators with a good honesty score are always granted the full configured quota, while peers with a low
+// honesty score are limited to the minimum quota.
+type adaptiveTopicFloodPreventer struct {
+	*topicFloodPreventer
+	targetProcessingLatency  time.Duration
+	maxQueueDepth            uint32
+	minQuotaPercent          uint32
+	decreaseStepPercent      uint32
+	recoveryStepPercent      uint32
+	lowHonestyScoreThreshold float64
+
+	mutLoads sync.RWMutex
+	loads    map[string]*topicLoad
+
+	mutHandlers         sync.RWMutex
+	peerValidatorMapper process.PeerValidatorMapper
+	peerHonestyScorer   process.PeerHonestyScorer
+	debugger            process.AntifloodDebugger
+}
+
+// NewAdaptiveTopicFloodPreventer creates a new adaptive flood preventer based on topic
+func NewAdaptiveTopicFloodPreventer(arg ArgAdaptiveTopicFloodPreventer) (*adaptiveTopicFloodPreventer, error) {
+	err := checkArgAdaptiveTopicFloodPreventer(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	tfp, err := NewTopicFloodPreventer(arg.DefaultMaxMessagesPerPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adaptiveTopicFloodPreventer{
+		topicFloodPreventer:      tfp,
+		targetProcessingLatency:  arg.TargetProcessingLatency,
+		maxQueueDepth:            arg.MaxQueueDepth,
+		minQuotaPercent:          arg.MinQuotaPercent,
+		decreaseStepPercent:      arg.DecreaseStepPercent,
+		recoveryStepPercent:      arg.RecoveryStepPercent,
+		lowHonestyScoreThreshold: arg.LowHonestyScoreThreshold,
+		loads:                    make(map[string]*topicLoad),
+		peerValidatorMapper:      &disabled.PeerValidatorMapper{},
+		debugger:                 &disabled.AntifloodDebugger{},
+	}, nil
+}
+
+func checkArgAdaptiveTopicFloodPreventer(arg ArgAdaptiveTopicFloodPreventer) error {
+	if arg.TargetProcessingLatency <= 0 {
+		return fmt.Errorf("%w raised in NewAdaptiveTopicFloodPreventer, TargetProcessingLatency: provided %v",
+			process.ErrInvalidValue, arg.TargetProcessingLatency)
+	}
+	if arg.MaxQueueDepth == 0 {
+		return fmt.Errorf("%w raised in NewAdaptiveTopicFloodPreventer, MaxQueueDepth should be greater than 0",
+			process.ErrInvalidValue)
+	}
+	if arg.MinQuotaPercent < minQuotaPercent || arg.MinQuotaPercent > maxQuotaPercent {
+		return fmt.Errorf("%w raised in NewAdaptiveTopicFloodPreventer, MinQuotaPercent: provided %d, interval [%d, %d]",
+			process.ErrInvalidValue, arg.MinQuotaPercent, minQuotaPercent, maxQuotaPercent)
+	}
+	if arg.DecreaseStepPercent < minQuotaPercent || arg.DecreaseStepPercent > maxQuotaPercent {
+		return fmt.Errorf("%w raised in NewAdaptiveTopicFloodPreventer, DecreaseStepPercent: provided %d, interval [%d, %d]",
+			process.ErrInvalidValue, arg.DecreaseStepPercent, minQuotaPercent, maxQuotaPercent)
+	}
+	if arg.RecoveryStepPercent < minQuotaPercent || arg.RecoveryStepPercent > maxQuotaPercent {
+		return fmt.Errorf("%w raised in NewAdaptiveTopicFloodPreventer, RecoveryStepPercent: provided %d, interval [%d, %d]",
+			process.ErrInvalidValue, arg.RecoveryStepPercent, minQuotaPercent, maxQuotaPercent)
+	}
+
+	return nil
+}
+
+// IncreaseLoad tries to increment the counter values held at "identifier" position for the given topic
+// It returns nil if the counter is still within the quota currently granted to the peer on that topic
+func (atfp *adaptiveTopicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numMessages uint32) error {
+	quotaPercent := atfp.quotaPercentForPeer(pid, topic)
+
+	atfp.mutTopicMaxMessages.Lock()
+	defer atfp.mutTopicMaxMessages.Unlock()
+
+	counter := atfp.increaseCounterNoLock(pid, topic, numMessages)
+	limit := computeAdaptedLimit(atfp.maxMessagesForTopic(topic), quotaPercent)
+	if counter > limit {
+		return process.ErrSystemBusy
+	}
+
+	return nil
+}
+
+func (atfp *adaptiveTopicFloodPreventer) quotaPercentForPeer(pid core.PeerID, topic string) uint32 {
+	atfp.mutHandlers.RLock()
+	peerInfo := atfp.peerValidatorMapper.GetPeerInfo(pid)
+	isLowHonestyPeer := false
+	if len(peerInfo.PkBytes) > 0 && !check.IfNil(atfp.peerHonestyScorer) {
+		isLowHonestyPeer = atfp.peerHonestyScorer.GetScore(string(peerInfo.PkBytes)) < atfp.lowHonestyScoreThreshold
+	}
+	atfp.mutHandlers.RUnlock()
+
+	if isLowHonestyPeer {
+		return atfp.minQuotaPercent
+	}
+	if peerInfo.PeerType == core.ValidatorPeer {
+		return maxQuotaPercent
+	}
+
+	return atfp.topicQuotaPercent(topic)
+}
+
+func (atfp *adaptiveTopicFloodPreventer) topicQuotaPercent(topic string) uint32 {
+	atfp.mutLoads.RLock()
+	defer atfp.mutLoads.RUnlock()
+
+	load, ok := atfp.loads[topic]
+	if !ok {
+		return maxQuotaPercent
+	}
+
+	return load.quotaPercent
+}
+
+func computeAdaptedLimit(maxMessages uint32, quotaPercent uint32) uint32 {
+	limit := uint64(maxMessages) * uint64(quotaPercent) / uint64(maxQuotaPercent)
+	if limit < topicMinMessages {
+		return topicMinMessages
+	}
+
+	return uint32(limit)
+}
+
+// StartProcessingOnTopic signals that a message received on the provided topic started to be processed
+func (atfp *adaptiveTopicFloodPreventer) StartProcessingOnTopic(topic string) {
+	atfp.mutLoads.Lock()
+	defer atfp.mutLoads.Unlock()
+
+	atfp.getOrCreateLoadNoLock(topic).numInFlight++
+}
+
+// ThrottledOnTopic signals that a message received on the provided topic could not be admitted for processing because
+// the node was busy
+func (atfp *adaptiveTopicFloodPreventer) ThrottledOnTopic(topic string) {
+	atfp.mutLoads.Lock()
+	defer atfp.mutLoads.Unlock()
+
+	atfp.getOrCreateLoadNoLock(topic).numThrottled++
+}
+
+// EndProcessingOnTopic signals that the processing of a message received on the provided topic ended
+func (atfp *adaptiveTopicFloodPreventer) EndProcessingOnTopic(topic string, processingTime time.Duration) {
+	atfp.mutLoads.Lock()
+	defer atfp.mutLoads.Unlock()
+
+	load := atfp.getOrCreateLoadNoLock(topic)
+	if load.numInFlight > 0 {
+		load.numInFlight--
+	}
+	load.numProcessed++
+	load.totalProcessingTime += processingTime
+}
+
+func (atfp *adaptiveTopicFloodPreventer) getOrCreateLoadNoLock(topic string) *topicLoad {
+	load, ok := atfp.loads[topic]
+	if !ok {
+		load = &topicLoad{
+			quotaPercent: maxQuotaPercent,
+		}
+		atfp.loads[topic] = load
+	}
+
+	return load
+}
+
+// UpdateQuotas recomputes the quota of each observed topic based on the load measured since the last call. The quota of
+// an overloaded topic is decreased by one step (down to the minimum quota) while the quota of a topic that keeps up is
+// recovered by one step (up to the full quota)
+func (atfp *adaptiveTopicFloodPreventer) UpdateQuotas() {
+	atfp.mutLoads.Lock()
+	defer atfp.mutLoads.Unlock()
+
+	for topic, load := range atfp.loads {
+		oldQuotaPercent := load.quotaPercent
+		reason := atfp.computeOverloadReason(load)
+		if len(reason) > 0 {
+			load.quotaPercent = atfp.decreasedQuotaPercent(oldQuotaPercent)
+		} else {
+			load.quotaPercent = atfp.recoveredQuotaPercent(oldQuotaPercent)
+			reason = "load is within limits"
+		}
+
+		load.numThrottled = 0
+		load.numProcessed = 0
+		load.totalProcessingTime = 0
+
+		if oldQuotaPercent == load.quotaPercent {
+			continue
+		}
+
+		log.Debug("adaptive topic quota changed",
+			"topic", topic,
+			"old quota percent", oldQuotaPercent,
+			"new quota percent", load.quotaPercent,
+			"reason", reason,
+		)
+		atfp.recordQuotaDecision(topic, oldQuotaPercent, load.quotaPercent, reason)
+	}
+}
+
+func (atfp *adaptiveTopicFloodPreventer) computeOverloadReason(load *topicLoad) string {
+	numPending := uint64(load.numInFlight) + load.numThrottled
+	if numPending > uint64(atfp.maxQueueDepth) {
+		return fmt.Sprintf("queue depth %d (%d in flight, %d throttled) exceeds maximum %d",
+			numPending, load.numInFlight, load.numThrottled, atfp.maxQueueDepth)
+	}
+	if load.numProcessed == 0 {
+		return ""
+	}
+
+	averageLatency := load.totalProcessingTime / time.Duration(load.numProcessed)
+	if averageLatency > atfp.targetProcessingLatency {
+		return fmt.Sprintf("average processing latency %v exceeds target %v", averageLatency, atfp.targetProcessingLatency)
+	}
+
+	return ""
+}
+
+func (atfp *adaptiveTopicFloodPreventer) decreasedQuotaPercent(quotaPercent uint32) uint32 {
+	if quotaPercent < atfp.minQuotaPercent+atfp.decreaseStepPercent {
+		return atfp.minQuotaPercent
+	}
+
+	return quotaPercent - atfp.decreaseStepPercent
+}
+
+func (atfp *adaptiveTopicFloodPreventer) recoveredQuotaPercent(quotaPercent uint32) uint32 {
+	if quotaPercent+atfp.recoveryStepPercent > maxQuotaPercent {
+		return maxQuotaPercent
+	}
+
+	return quotaPercent + atfp.recoveryStepPercent
+}
+
+func (atfp *adaptiveTopicFloodPreventer) recordQuotaDecision(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string) {
+	atfp.mutHandlers.RLock()
+	defer atfp.mutHandlers.RUnlock()
+
+	atfp.debugger.AddQuotaDecision(topic, oldQuotaPercent, newQuotaPercent, reason)
+}
+
+// SetPeerValidatorMapper sets the peer validator mapper used to identify the validators
+func (atfp *adaptiveTopicFloodPreventer) SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error {
+	if check.IfNil(validatorMapper) {
+		return process.ErrNilPeerValidatorMapper
+	}
+
+	atfp.mutHandlers.Lock()
+	atfp.peerValidatorMapper = validatorMapper
+	atfp.mutHandlers.Unlock()
+
+	return nil
+}
+
+// SetPeerHonestyScorer sets the component able to provide the honesty score of a peer
+func (atfp *adaptiveTopicFloodPreventer) SetPeerHonestyScorer(scorer process.PeerHonestyScorer) error {
+	if check.IfNil(scorer) {
+		return process.ErrNilPeerHonestyScorer
+	}
+
+	atfp.mutHandlers.Lock()
+	atfp.peerHonestyScorer = scorer
+	atfp.mutHandlers.Unlock()
+
+	return nil
+}
+
+// SetDebugger sets the antiflood debugger used to record the quota decisions
+func (atfp *adaptiveTopicFloodPreventer) SetDebugger(debugger process.AntifloodDebugger) error {
+	if check.IfNil(debugger) {
+		return process.ErrNilDebugger
+	}
+
+	atfp.mutHandlers.Lock()
+	atfp.debugger = debugger
+	atfp.mutHandlers.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (atfp *adaptiveTopicFloodPreventer) IsInterfaceNil() bool {
+	return atfp == nil
+}
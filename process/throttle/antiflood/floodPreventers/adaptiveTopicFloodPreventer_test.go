@@ -0,0 +1,265 @@
+package floodPreventers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adaptiveTestTopic = "topic"
+
+func createMockArgAdaptiveTopicFloodPreventer() floodPreventers.ArgAdaptiveTopicFloodPreventer {
+	return floodPreventers.ArgAdaptiveTopicFloodPreventer{
+		DefaultMaxMessagesPerPeer: 10,
+		TargetProcessingLatency:   time.Millisecond * 100,
+		MaxQueueDepth:             5,
+		MinQuotaPercent:           10,
+		DecreaseStepPercent:       50,
+		RecoveryStepPercent:       20,
+		LowHonestyScoreThreshold:  -40,
+	}
+}
+
+func createObserverPeerMapper() *mock.PeerShardMapperStub {
+	return &mock.PeerShardMapperStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			if pid == "validator" {
+				return core.P2PPeerInfo{PeerType: core.ValidatorPeer, PkBytes: []byte("validator pk")}
+			}
+
+			return core.P2PPeerInfo{PeerType: core.ObserverPeer, PkBytes: []byte(pid + " pk")}
+		},
+	}
+}
+
+func increaseLoadNumTimes(atfp process.TopicFloodPreventer, pid core.PeerID, numTimes int) int {
+	numAccepted := 0
+	for i := 0; i < numTimes; i++ {
+		if atfp.IncreaseLoad(pid, adaptiveTestTopic, 1) == nil {
+			numAccepted++
+		}
+	}
+
+	return numAccepted
+}
+
+func overloadTopic(atfp process.TopicFloodPreventer) {
+	atfp.StartProcessingOnTopic(adaptiveTestTopic)
+	atfp.EndProcessingOnTopic(adaptiveTestTopic, time.Second)
+}
+
+func TestNewAdaptiveTopicFloodPreventer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid target latency should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgAdaptiveTopicFloodPreventer()
+		arg.TargetProcessingLatency = 0
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid max queue depth should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgAdaptiveTopicFloodPreventer()
+		arg.MaxQueueDepth = 0
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid min quota percent should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgAdaptiveTopicFloodPreventer()
+		arg.MinQuotaPercent = 0
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+		arg.MinQuotaPercent = 101
+		atfp, err = floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid decrease step should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgAdaptiveTopicFloodPreventer()
+		arg.DecreaseStepPercent = 0
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid recovery step should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgAdaptiveTopicFloodPreventer()
+		arg.RecoveryStepPercent = 0
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid default max messages should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgAdaptiveTopicFloodPreventer()
+		arg.DefaultMaxMessagesPerPeer = 0
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+		assert.True(t, check.IfNil(atfp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atfp, err := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+		assert.False(t, check.IfNil(atfp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAdaptiveTopicFloodPreventer_IncreaseLoadWithoutPressureShouldUseFullQuota(t *testing.T) {
+	t.Parallel()
+
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+	_ = atfp.SetPeerValidatorMapper(createObserverPeerMapper())
+
+	atfp.StartProcessingOnTopic(adaptiveTestTopic)
+	atfp.EndProcessingOnTopic(adaptiveTestTopic, time.Millisecond)
+	atfp.UpdateQuotas()
+
+	assert.Equal(t, 10, increaseLoadNumTimes(atfp, "observer", 12))
+}
+
+func TestAdaptiveTopicFloodPreventer_HighLatencyShouldDecreaseQuota(t *testing.T) {
+	t.Parallel()
+
+	var decisions []uint32
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+	_ = atfp.SetPeerValidatorMapper(createObserverPeerMapper())
+	_ = atfp.SetDebugger(&mock.AntifloodDebuggerStub{
+		AddQuotaDecisionCalled: func(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string) {
+			assert.Equal(t, adaptiveTestTopic, topic)
+			assert.Contains(t, reason, "latency")
+			decisions = append(decisions, newQuotaPercent)
+		},
+	})
+
+	overloadTopic(atfp)
+	atfp.UpdateQuotas()
+	assert.Equal(t, 5, increaseLoadNumTimes(atfp, "observer", 12))
+
+	overloadTopic(atfp)
+	atfp.UpdateQuotas()
+	assert.Equal(t, 1, increaseLoadNumTimes(atfp, "other observer", 12))
+
+	// already at minimum, no new decision
+	overloadTopic(atfp)
+	atfp.UpdateQuotas()
+
+	assert.Equal(t, []uint32{50, 10}, decisions)
+}
+
+func TestAdaptiveTopicFloodPreventer_QueueDepthShouldDecreaseQuota(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAdaptiveTopicFloodPreventer()
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(arg)
+	_ = atfp.SetPeerValidatorMapper(createObserverPeerMapper())
+
+	reasonRecorded := ""
+	_ = atfp.SetDebugger(&mock.AntifloodDebuggerStub{
+		AddQuotaDecisionCalled: func(topic string, oldQuotaPercent uint32, newQuotaPercent uint32, reason string) {
+			reasonRecorded = reason
+		},
+	})
+
+	for i := uint32(0); i < arg.MaxQueueDepth; i++ {
+		atfp.StartProcessingOnTopic(adaptiveTestTopic)
+	}
+	atfp.UpdateQuotas()
+	assert.Empty(t, reasonRecorded)
+
+	atfp.ThrottledOnTopic(adaptiveTestTopic)
+	atfp.UpdateQuotas()
+
+	assert.Contains(t, reasonRecorded, "queue depth")
+	assert.Equal(t, 5, increaseLoadNumTimes(atfp, "observer", 12))
+}
+
+func TestAdaptiveTopicFloodPreventer_ShouldRecoverWhenLoadDecreases(t *testing.T) {
+	t.Parallel()
+
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+	_ = atfp.SetPeerValidatorMapper(createObserverPeerMapper())
+
+	overloadTopic(atfp)
+	atfp.UpdateQuotas()
+	overloadTopic(atfp)
+	atfp.UpdateQuotas()
+
+	atfp.UpdateQuotas()
+	assert.Equal(t, 3, increaseLoadNumTimes(atfp, "observer 1", 12))
+
+	for i := 0; i < 10; i++ {
+		atfp.UpdateQuotas()
+	}
+	assert.Equal(t, 10, increaseLoadNumTimes(atfp, "observer 2", 12))
+}
+
+func TestAdaptiveTopicFloodPreventer_TrustedValidatorsShouldKeepFullQuota(t *testing.T) {
+	t.Parallel()
+
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+	_ = atfp.SetPeerValidatorMapper(createObserverPeerMapper())
+
+	overloadTopic(atfp)
+	atfp.UpdateQuotas()
+
+	assert.Equal(t, 10, increaseLoadNumTimes(atfp, "validator", 12))
+	assert.Equal(t, 5, increaseLoadNumTimes(atfp, "observer", 12))
+}
+
+func TestAdaptiveTopicFloodPreventer_LowHonestyPeersShouldGetMinimumQuota(t *testing.T) {
+	t.Parallel()
+
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+	_ = atfp.SetPeerValidatorMapper(createObserverPeerMapper())
+	_ = atfp.SetPeerHonestyScorer(&testscommon.PeerHonestyHandlerStub{
+		GetScoreCalled: func(pk string) float64 {
+			if pk == "validator pk" || pk == "dishonest pk" {
+				return -50
+			}
+
+			return 0
+		},
+	})
+
+	assert.Equal(t, 1, increaseLoadNumTimes(atfp, "validator", 12))
+	assert.Equal(t, 1, increaseLoadNumTimes(atfp, "dishonest", 12))
+	assert.Equal(t, 10, increaseLoadNumTimes(atfp, "honest", 12))
+}
+
+func TestAdaptiveTopicFloodPreventer_Setters(t *testing.T) {
+	t.Parallel()
+
+	atfp, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(createMockArgAdaptiveTopicFloodPreventer())
+
+	assert.Equal(t, process.ErrNilPeerValidatorMapper, atfp.SetPeerValidatorMapper(nil))
+	assert.Equal(t, process.ErrNilPeerHonestyScorer, atfp.SetPeerHonestyScorer(nil))
+	assert.Equal(t, process.ErrNilDebugger, atfp.SetDebugger(nil))
+
+	require.Nil(t, atfp.SetPeerValidatorMapper(&mock.PeerShardMapperStub{}))
+	require.Nil(t, atfp.SetPeerHonestyScorer(&testscommon.PeerHonestyHandlerStub{}))
+	require.Nil(t, atfp.SetDebugger(&mock.AntifloodDebuggerStub{}))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/process"
@@ -55,6 +56,16 @@ func (tfp *topicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numM
 	tfp.mutTopicMaxMessages.Lock()
 	defer tfp.mutTopicMaxMessages.Unlock()
 
+	counter := tfp.increaseCounterNoLock(pid, topic, numMessages)
+	limitExceeded := counter > tfp.maxMessagesForTopic(topic)
+	if limitExceeded {
+		return process.ErrSystemBusy
+	}
+
+	return nil
+}
+
+func (tfp *topicFloodPreventer) increaseCounterNoLock(pid core.PeerID, topic string, numMessages uint32) uint32 {
 	_, ok := tfp.counterMap[topic]
 	if !ok {
 		tfp.counterMap[topic] = make(map[core.PeerID]uint32)
@@ -62,12 +73,7 @@ func (tfp *topicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numM
 
 	tfp.counterMap[topic][pid] += numMessages
 
-	limitExceeded := tfp.counterMap[topic][pid] > tfp.maxMessagesForTopic(topic)
-	if limitExceeded {
-		return process.ErrSystemBusy
-	}
-
-	return nil
+	return tfp.counterMap[topic][pid]
 }
 
 // SetMaxMessagesForTopic will update the maximum number of messages that can be received from a peer in a topic
@@ -158,6 +164,18 @@ func (tfp *topicFloodPreventer) maxMessagesForTopicWildcard(topic string) uint32
 	return tfp.defaultMaxMessagesPerPeer
 }
 
+// StartProcessingOnTopic does nothing as the static quotas do not depend on the processing load
+func (tfp *topicFloodPreventer) StartProcessingOnTopic(_ string) {
+}
+
+// ThrottledOnTopic does nothing as the static quotas do not depend on the processing load
+func (tfp *topicFloodPreventer) ThrottledOnTopic(_ string) {
+}
+
+// EndProcessingOnTopic does nothing as the static quotas do not depend on the processing load
+func (tfp *topicFloodPreventer) EndProcessingOnTopic(_ string, _ time.Duration) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tfp *topicFloodPreventer) IsInterfaceNil() bool {
 	return tfp == nil
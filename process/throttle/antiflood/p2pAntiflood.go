@@ -127,6 +127,14 @@ func (af *p2pAntiflood) SetPeerValidatorMapper(validatorMapper process.PeerValid
 		return process.ErrNilPeerValidatorMapper
 	}
 
+	adaptivePreventer, isAdaptive := af.topicPreventer.(process.AdaptiveTopicFloodPreventer)
+	if isAdaptive {
+		err := adaptivePreventer.SetPeerValidatorMapper(validatorMapper)
+		if err != nil {
+			return err
+		}
+	}
+
 	af.mutTopicCheck.Lock()
 	defer af.mutTopicCheck.Unlock()
 
@@ -134,6 +142,20 @@ func (af *p2pAntiflood) SetPeerValidatorMapper(validatorMapper process.PeerValid
 	return nil
 }
 
+// SetPeerHonestyScorer sets the peer honesty scorer used by the adaptive topic flood preventer, if enabled
+func (af *p2pAntiflood) SetPeerHonestyScorer(scorer process.PeerHonestyScorer) error {
+	if check.IfNil(scorer) {
+		return process.ErrNilPeerHonestyScorer
+	}
+
+	adaptivePreventer, isAdaptive := af.topicPreventer.(process.AdaptiveTopicFloodPreventer)
+	if !isAdaptive {
+		return nil
+	}
+
+	return adaptivePreventer.SetPeerHonestyScorer(scorer)
+}
+
 func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topic string, numRejected uint32, sizeRejected uint64, sequence []byte, isBlacklisted bool) {
 	if len(topic) == 0 {
 		topic = unidentifiedTopic
@@ -223,6 +245,14 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 		return process.ErrNilDebugger
 	}
 
+	adaptivePreventer, isAdaptive := af.topicPreventer.(process.AdaptiveTopicFloodPreventer)
+	if isAdaptive {
+		err := adaptivePreventer.SetDebugger(debugger)
+		if err != nil {
+			return err
+		}
+	}
+
 	af.mutDebugger.Lock()
 	log.LogIfError(af.debugger.Close())
 	af.debugger = debugger
@@ -231,6 +261,22 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 	return nil
 }
 
+// StartProcessingOnTopic signals the topic flood preventer that a message started to be processed on the provided topic
+func (af *p2pAntiflood) StartProcessingOnTopic(topic string) {
+	af.topicPreventer.StartProcessingOnTopic(topic)
+}
+
+// ThrottledOnTopic signals the topic flood preventer that a message received on the provided topic could not be admitted
+// for processing because the node was busy
+func (af *p2pAntiflood) ThrottledOnTopic(topic string) {
+	af.topicPreventer.ThrottledOnTopic(topic)
+}
+
+// EndProcessingOnTopic signals the topic flood preventer that a message finished processing on the provided topic
+func (af *p2pAntiflood) EndProcessingOnTopic(topic string, processingTime time.Duration) {
+	af.topicPreventer.EndProcessingOnTopic(topic, processingTime)
+}
+
 // BlacklistPeer will add a peer to the black list
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
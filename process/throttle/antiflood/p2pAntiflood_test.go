@@ -13,6 +13,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -464,3 +466,96 @@ func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	err = afm.IsOriginatorEligibleForTopic(core.PeerID(validatorPID), "topic")
 	assert.Nil(t, err)
 }
+
+func TestP2pAntiflood_ProcessingOnTopicShouldForwardToTopicPreventer(t *testing.T) {
+	t.Parallel()
+
+	startedTopic := ""
+	endedTopic := ""
+	endedDuration := time.Duration(0)
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			StartProcessingOnTopicCalled: func(topic string) {
+				startedTopic = topic
+			},
+			EndProcessingOnTopicCalled: func(topic string, processingTime time.Duration) {
+				endedTopic = topic
+				endedDuration = processingTime
+			},
+		},
+		&mock.FloodPreventerStub{},
+	)
+
+	afm.StartProcessingOnTopic("topic")
+	afm.EndProcessingOnTopic("topic", time.Second)
+
+	assert.Equal(t, "topic", startedTopic)
+	assert.Equal(t, "topic", endedTopic)
+	assert.Equal(t, time.Second, endedDuration)
+}
+
+func TestP2pAntiflood_SetPeerHonestyScorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil scorer should error", func(t *testing.T) {
+		t.Parallel()
+
+		afm, _ := antiflood.NewP2PAntiflood(
+			&mock.PeerBlackListHandlerStub{},
+			&mock.TopicAntiFloodStub{},
+			&mock.FloodPreventerStub{},
+		)
+
+		err := afm.SetPeerHonestyScorer(nil)
+		assert.Equal(t, process.ErrNilPeerHonestyScorer, err)
+	})
+	t.Run("not adaptive topic preventer should ignore", func(t *testing.T) {
+		t.Parallel()
+
+		afm, _ := antiflood.NewP2PAntiflood(
+			&mock.PeerBlackListHandlerStub{},
+			&mock.TopicAntiFloodStub{},
+			&mock.FloodPreventerStub{},
+		)
+
+		err := afm.SetPeerHonestyScorer(&testscommon.PeerHonestyHandlerStub{})
+		assert.Nil(t, err)
+	})
+	t.Run("adaptive topic preventer should work", func(t *testing.T) {
+		t.Parallel()
+
+		topicPreventer, _ := floodPreventers.NewAdaptiveTopicFloodPreventer(floodPreventers.ArgAdaptiveTopicFloodPreventer{
+			DefaultMaxMessagesPerPeer: 10,
+			TargetProcessingLatency:   time.Second,
+			MaxQueueDepth:             10,
+			MinQuotaPercent:           10,
+			DecreaseStepPercent:       10,
+			RecoveryStepPercent:       10,
+			LowHonestyScoreThreshold:  -40,
+		})
+		afm, _ := antiflood.NewP2PAntiflood(
+			&mock.PeerBlackListHandlerStub{},
+			topicPreventer,
+			&mock.FloodPreventerStub{},
+		)
+
+		err := afm.SetPeerHonestyScorer(&testscommon.PeerHonestyHandlerStub{
+			GetScoreCalled: func(pk string) float64 {
+				return -50
+			},
+		})
+		assert.Nil(t, err)
+
+		err = afm.SetPeerValidatorMapper(&mock.PeerShardMapperStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				return core.P2PPeerInfo{PeerType: core.ValidatorPeer, PkBytes: []byte("pk")}
+			},
+		})
+		assert.Nil(t, err)
+
+		// low honesty score limits the peer to 10% of the 10 messages quota
+		assert.Nil(t, afm.CanProcessMessagesOnTopic("pid", "topic", 1, 1, nil))
+		assert.NotNil(t, afm.CanProcessMessagesOnTopic("pid", "topic", 1, 1, nil))
+	})
+}
@@ -8,5 +8,35 @@ import (
 // SimulationResultsWithVMOutput is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
-	VMOutput *vmcommon.VMOutput `json:"-"`
+	VMOutput *vmcommon.VMOutput         `json:"-"`
+	GasTrace map[string]*APIFunctionGas `json:"-"`
+}
+
+// CostResponseWithGasBreakdown is the data transfer object which holds the cost of a transaction together with the
+// breakdown of the consumed gas
+type CostResponseWithGasBreakdown struct {
+	*transaction.CostResponse
+	GasBreakdown *GasBreakdown `json:"gasBreakdown,omitempty"`
+}
+
+// GasBreakdown holds where the gas of a transaction goes. The smart contract execution is split by the API functions
+// called by the contracts, when the simulation VM traces them, and the rest of the execution. The wasm executor only
+// meters the total gas of the opcodes, so the rest of the execution is never split by opcode, as signaled by
+// OpcodesBreakdownAvailable and OpcodesBreakdownMessage
+type GasBreakdown struct {
+	BaseCost                   uint64                     `json:"baseCost"`
+	BuiltInFunctions           map[string]uint64          `json:"builtInFunctions,omitempty"`
+	SmartContractExecution     uint64                     `json:"smartContractExecution"`
+	APIFunctions               map[string]*APIFunctionGas `json:"apiFunctions,omitempty"`
+	ExecutionNotInAPIFunctions uint64                     `json:"executionNotInApiFunctions,omitempty"`
+	AsyncCallbackReserve       uint64                     `json:"asyncCallbackReserve"`
+	GasTraceAvailable          bool                       `json:"gasTraceAvailable"`
+	OpcodesBreakdownAvailable  bool                       `json:"opcodesBreakdownAvailable"`
+	OpcodesBreakdownMessage    string                     `json:"opcodesBreakdownMessage,omitempty"`
+}
+
+// APIFunctionGas holds the gas consumed by all the calls of a VM API function
+type APIFunctionGas struct {
+	GasUsed  uint64 `json:"gasUsed"`
+	NumCalls uint64 `json:"numCalls"`
 }
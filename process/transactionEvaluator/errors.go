@@ -26,5 +26,8 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
 
+// ErrNilVMHooksGasTracer signals that a nil VM hooks gas tracer has been provided
+var ErrNilVMHooksGasTracer = errors.New("nil VM hooks gas tracer")
+
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
@@ -0,0 +1,179 @@
+package gasTracer
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+)
+
+type vmHookCall struct {
+	name         string
+	pointsBefore uint64
+}
+
+type vmHooksGasTracer struct {
+	mutTrace        sync.Mutex
+	vmHost          vmhost.VMHost
+	executorFactory executor.ExecutorAbstractFactory
+	vmHookCalls     []*vmHookCall
+	gasByVMHook     map[string]*txSimData.APIFunctionGas
+}
+
+// NewVMHooksGasTracer creates the executor factory of a wasm VM that records the gas consumed by each VM hook called
+// by the executed smart contracts. It should only be set on the VM used for simulations, as each VM hook call goes
+// through the tracer
+func NewVMHooksGasTracer() *vmHooksGasTracer {
+	return &vmHooksGasTracer{
+		executorFactory: wasmer2.ExecutorFactory(),
+		vmHookCalls:     make([]*vmHookCall, 0),
+		gasByVMHook:     make(map[string]*txSimData.APIFunctionGas),
+	}
+}
+
+// CreateExecutor creates the default wasm executor, with all its VM hooks reporting to the tracer
+func (tracer *vmHooksGasTracer) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	// only the VM hooks of the wrapper are kept, since its executor holds all the created instances, as it is meant
+	// for testing
+	innerFactory := &executorCapturer{
+		wrappedFactory: tracer.executorFactory,
+	}
+	_, err := executorwrapper.NewWrappedExecutorFactory(tracer, innerFactory).CreateExecutor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return innerFactory.createdExecutor, nil
+}
+
+// SetVMHost sets the VM host that uses the created executor, needed to read the gas consumed by the VM hooks
+func (tracer *vmHooksGasTracer) SetVMHost(vmHost vmhost.VMHost) {
+	tracer.mutTrace.Lock()
+	tracer.vmHost = vmHost
+	tracer.vmHookCalls = make([]*vmHookCall, 0)
+	tracer.mutTrace.Unlock()
+}
+
+// LogExecutorEvent does nothing, as the executor events are not traced
+func (tracer *vmHooksGasTracer) LogExecutorEvent(_ string) {
+}
+
+// LogVMHookCallBefore records the gas used before the call of a VM hook
+func (tracer *vmHooksGasTracer) LogVMHookCallBefore(callInfo string) {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	if check.IfNilReflect(tracer.vmHost) {
+		return
+	}
+
+	tracer.vmHookCalls = append(tracer.vmHookCalls, &vmHookCall{
+		name:         getVMHookName(callInfo),
+		pointsBefore: tracer.vmHost.Runtime().GetPointsUsed(),
+	})
+}
+
+// LogVMHookCallAfter accounts the gas consumed by a VM hook. The VM hooks called by the contracts executed from a VM
+// hook are already accounted in the gas of their caller
+func (tracer *vmHooksGasTracer) LogVMHookCallAfter(_ string) {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	numVMHookCalls := len(tracer.vmHookCalls)
+	if numVMHookCalls == 0 || check.IfNilReflect(tracer.vmHost) {
+		return
+	}
+
+	call := tracer.vmHookCalls[numVMHookCalls-1]
+	tracer.vmHookCalls = tracer.vmHookCalls[:numVMHookCalls-1]
+	if numVMHookCalls > 1 {
+		return
+	}
+
+	vmHookGas, found := tracer.gasByVMHook[call.name]
+	if !found {
+		vmHookGas = &txSimData.APIFunctionGas{}
+		tracer.gasByVMHook[call.name] = vmHookGas
+	}
+
+	vmHookGas.NumCalls++
+	pointsAfter := tracer.vmHost.Runtime().GetPointsUsed()
+	if pointsAfter > call.pointsBefore {
+		vmHookGas.GasUsed += pointsAfter - call.pointsBefore
+	}
+}
+
+// Reset clears the recorded gas, before a new simulation
+func (tracer *vmHooksGasTracer) Reset() {
+	tracer.mutTrace.Lock()
+	tracer.vmHookCalls = make([]*vmHookCall, 0)
+	tracer.gasByVMHook = make(map[string]*txSimData.APIFunctionGas)
+	tracer.mutTrace.Unlock()
+}
+
+// GetGasTrace returns the gas consumed by each VM hook since the last reset, or nil if no VM uses the tracer
+func (tracer *vmHooksGasTracer) GetGasTrace() map[string]*txSimData.APIFunctionGas {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	if check.IfNilReflect(tracer.vmHost) {
+		return nil
+	}
+
+	gasTrace := make(map[string]*txSimData.APIFunctionGas, len(tracer.gasByVMHook))
+	for name, vmHookGas := range tracer.gasByVMHook {
+		gasTrace[name] = &txSimData.APIFunctionGas{
+			GasUsed:  vmHookGas.GasUsed,
+			NumCalls: vmHookGas.NumCalls,
+		}
+	}
+
+	return gasTrace
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *vmHooksGasTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
+
+// getVMHookName returns the name of the VM hook as imported by the smart contracts, out of the call description of
+// the executor wrapper, which starts with the name of the Go method
+func getVMHookName(callInfo string) string {
+	name := callInfo
+	position := strings.Index(callInfo, "(")
+	if position >= 0 {
+		name = callInfo[:position]
+	}
+	if len(name) == 0 {
+		return name
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// executorCapturer keeps the executor created with the wrapped VM hooks
+type executorCapturer struct {
+	wrappedFactory  executor.ExecutorAbstractFactory
+	createdExecutor executor.Executor
+}
+
+// CreateExecutor creates and keeps the executor
+func (capturer *executorCapturer) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	createdExecutor, err := capturer.wrappedFactory.CreateExecutor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	capturer.createdExecutor = createdExecutor
+	return createdExecutor, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (capturer *executorCapturer) IsInterfaceNil() bool {
+	return capturer == nil
+}
@@ -0,0 +1,157 @@
+package gasTracer
+
+import (
+	"testing"
+
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/stretchr/testify/require"
+)
+
+type executorFactoryStub struct {
+	vmHooks          executor.VMHooks
+	createdExecutor  executor.Executor
+	createdExecutors int
+}
+
+// CreateExecutor -
+func (stub *executorFactoryStub) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	stub.vmHooks = args.VMHooks
+	stub.createdExecutors++
+	return stub.createdExecutor, nil
+}
+
+// IsInterfaceNil -
+func (stub *executorFactoryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type vmHooksStub struct {
+	executor.VMHooks
+	runtime *contextmock.RuntimeContextMock
+}
+
+// GetGasLeft -
+func (stub *vmHooksStub) GetGasLeft() int64 {
+	stub.runtime.PointsUsed += 5
+	return 0
+}
+
+func createTracerWithVMHost() (*vmHooksGasTracer, *contextmock.RuntimeContextMock) {
+	runtime := &contextmock.RuntimeContextMock{}
+	tracer := NewVMHooksGasTracer()
+	tracer.SetVMHost(&contextmock.VMHostMock{RuntimeContext: runtime})
+
+	return tracer, runtime
+}
+
+func TestNewVMHooksGasTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewVMHooksGasTracer()
+	require.False(t, tracer.IsInterfaceNil())
+	require.Nil(t, tracer.GetGasTrace())
+}
+
+func TestVMHooksGasTracer_CreateExecutor(t *testing.T) {
+	t.Parallel()
+
+	tracer, runtime := createTracerWithVMHost()
+	expectedExecutor := contextmock.NewExecutorMock(nil)
+	factory := &executorFactoryStub{
+		createdExecutor: expectedExecutor,
+	}
+	tracer.executorFactory = factory
+
+	createdExecutor, err := tracer.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks: &vmHooksStub{runtime: runtime},
+	})
+	require.Nil(t, err)
+	require.True(t, createdExecutor == expectedExecutor)
+	require.Equal(t, 1, factory.createdExecutors)
+
+	factory.vmHooks.GetGasLeft()
+	factory.vmHooks.GetGasLeft()
+	require.Equal(t, map[string]*txSimData.APIFunctionGas{
+		"getGasLeft": {GasUsed: 10, NumCalls: 2},
+	}, tracer.GetGasTrace())
+}
+
+func TestVMHooksGasTracer_TraceVMHooks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should account the gas of each VM hook", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, runtime := createTracerWithVMHost()
+
+		runtime.PointsUsed = 100
+		tracer.LogVMHookCallBefore("BigIntAdd(1, 2, 3)")
+		runtime.PointsUsed = 130
+		tracer.LogVMHookCallAfter("BigIntAdd(1, 2, 3)")
+
+		runtime.PointsUsed = 200
+		tracer.LogVMHookCallBefore("GetCaller(10)")
+		runtime.PointsUsed = 210
+		tracer.LogVMHookCallAfter("GetCaller(10)")
+
+		tracer.LogVMHookCallBefore("BigIntAdd(1, 2, 3)")
+		runtime.PointsUsed = 230
+		tracer.LogVMHookCallAfter("BigIntAdd(1, 2, 3)")
+
+		require.Equal(t, map[string]*txSimData.APIFunctionGas{
+			"bigIntAdd": {GasUsed: 50, NumCalls: 2},
+			"getCaller": {GasUsed: 10, NumCalls: 1},
+		}, tracer.GetGasTrace())
+	})
+	t.Run("should account the nested VM hooks in their caller", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, runtime := createTracerWithVMHost()
+
+		runtime.PointsUsed = 100
+		tracer.LogVMHookCallBefore("ExecuteOnDestContext(1, 2, 3)")
+		runtime.PointsUsed = 0
+		tracer.LogVMHookCallBefore("GetCaller(10)")
+		runtime.PointsUsed = 10
+		tracer.LogVMHookCallAfter("GetCaller(10)")
+		runtime.PointsUsed = 400
+		tracer.LogVMHookCallAfter("ExecuteOnDestContext(1, 2, 3)")
+
+		require.Equal(t, map[string]*txSimData.APIFunctionGas{
+			"executeOnDestContext": {GasUsed: 300, NumCalls: 1},
+		}, tracer.GetGasTrace())
+	})
+	t.Run("reset should clear the traced gas", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, runtime := createTracerWithVMHost()
+
+		tracer.LogVMHookCallBefore("GetCaller(10)")
+		runtime.PointsUsed = 10
+		tracer.LogVMHookCallAfter("GetCaller(10)")
+		tracer.LogVMHookCallBefore("GetCaller(10)")
+
+		tracer.Reset()
+		tracer.LogVMHookCallAfter("GetCaller(10)")
+		require.Equal(t, map[string]*txSimData.APIFunctionGas{}, tracer.GetGasTrace())
+	})
+	t.Run("without VM host should not trace", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := NewVMHooksGasTracer()
+		tracer.LogVMHookCallBefore("GetCaller(10)")
+		tracer.LogVMHookCallAfter("GetCaller(10)")
+		require.Nil(t, tracer.GetGasTrace())
+	})
+}
+
+func TestGetVMHookName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "bigIntAdd", getVMHookName("BigIntAdd(1, 2, 3)"))
+	require.Equal(t, "mBufferNew", getVMHookName("MBufferNew()"))
+	require.Equal(t, "getGasLeft", getVMHookName("GetGasLeft"))
+	require.Equal(t, "", getVMHookName(""))
+}
@@ -2,6 +2,7 @@ package transactionEvaluator
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
@@ -13,6 +14,13 @@ type TransactionProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// VMHooksGasTracer defines what a component that records the gas consumed by each VM hook should be able to do
+type VMHooksGasTracer interface {
+	Reset()
+	GetGasTrace() map[string]*txSimData.APIFunctionGas
+	IsInterfaceNil() bool
+}
+
 // DataFieldParser defines what a data field parser should be able to do
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
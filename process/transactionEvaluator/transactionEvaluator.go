@@ -19,11 +19,13 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
 )
 
 const dummySignature = "01010101"
 const gasRemainedSplitString = "gas remained = "
 const gasUsedSlitString = "gas used = "
+const opcodesBreakdownUnavailableMessage = "the wasm executor only meters the total gas of the opcodes, so the smart contract execution is not split by opcode"
 
 // ArgsApiTransactionEvaluator holds the arguments required for creating a new transaction evaluator
 type ArgsApiTransactionEvaluator struct {
@@ -34,6 +36,7 @@ type ArgsApiTransactionEvaluator struct {
 	ShardCoordinator    sharding.Coordinator
 	EnableEpochsHandler common.EnableEpochsHandler
 	BlockChain          data.ChainHandler
+	GasScheduleNotifier core.GasScheduleNotifier
 }
 
 type apiTransactionEvaluator struct {
@@ -44,6 +47,8 @@ type apiTransactionEvaluator struct {
 	txSimulator         facade.TransactionSimulatorProcessor
 	enableEpochsHandler common.EnableEpochsHandler
 	blockChain          data.ChainHandler
+	gasScheduleNotifier core.GasScheduleNotifier
+	callArgsParser      process.CallArgumentsParser
 	mutExecution        sync.RWMutex
 }
 
@@ -70,6 +75,9 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.GasScheduleNotifier) {
+		return nil, process.ErrNilGasSchedule
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.CleanUpInformativeSCRsFlag,
 	})
@@ -85,6 +93,8 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 		shardCoordinator:    args.ShardCoordinator,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		blockChain:          args.BlockChain,
+		gasScheduleNotifier: args.GasScheduleNotifier,
+		callArgsParser:      parsers.NewCallArgsParser(),
 	}
 
 	return tce, nil
@@ -111,9 +121,39 @@ func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.T
 		ate.mutExecution.Unlock()
 	}()
 
+	costResponse, _, err := ate.computeTransactionCost(tx)
+
+	return costResponse, err
+}
+
+// ComputeTransactionCostWithGasBreakdown will calculate how many gas units a transaction will consume, together with
+// the breakdown of the consumed gas
+func (ate *apiTransactionEvaluator) ComputeTransactionCostWithGasBreakdown(tx *transaction.Transaction) (*txSimData.CostResponseWithGasBreakdown, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	costResponse, simulationResults, err := ate.computeTransactionCost(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &txSimData.CostResponseWithGasBreakdown{
+		CostResponse: costResponse,
+	}
+	if costResponse.GasUnits > 0 {
+		response.GasBreakdown = ate.computeGasBreakdown(tx, costResponse.GasUnits, simulationResults)
+	}
+
+	return response, nil
+}
+
+func (ate *apiTransactionEvaluator) computeTransactionCost(tx *transaction.Transaction) (*transaction.CostResponse, *txSimData.SimulationResultsWithVMOutput, error) {
 	txTypeOnSender, txTypeOnDestination := ate.txTypeHandler.ComputeTransactionType(tx)
 	if txTypeOnSender == process.MoveBalance && txTypeOnDestination == process.MoveBalance {
-		return ate.computeMoveBalanceCost(tx), nil
+		return ate.computeMoveBalanceCost(tx), nil, nil
 	}
 
 	switch txTypeOnSender {
@@ -124,12 +164,12 @@ func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.T
 		return &transaction.CostResponse{
 			GasUnits:      0,
 			ReturnMessage: "cannot compute cost of the relayed transaction",
-		}, nil
+		}, nil, nil
 	default:
 		return &transaction.CostResponse{
 			GasUnits:      0,
 			ReturnMessage: process.ErrWrongTransaction.Error(),
-		}, nil
+		}, nil, nil
 	}
 }
 
@@ -142,10 +182,10 @@ func (ate *apiTransactionEvaluator) computeMoveBalanceCost(tx *transaction.Trans
 	}
 }
 
-func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
+func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, *txSimData.SimulationResultsWithVMOutput, error) {
 	err := ate.addMissingFieldsIfNeeded(tx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	costResponse := &transaction.CostResponse{}
@@ -153,13 +193,13 @@ func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Tran
 	res, err := ate.txSimulator.ProcessTx(tx, currentHeader)
 	if err != nil {
 		costResponse.ReturnMessage = err.Error()
-		return costResponse, nil
+		return costResponse, nil, nil
 	}
 
 	isMoveBalanceOk := txType == process.MoveBalance && res.FailReason == ""
 	if isMoveBalanceOk {
 		costResponse.GasUnits = ate.feeHandler.ComputeGasLimit(tx)
-		return costResponse, nil
+		return costResponse, res, nil
 
 	}
 
@@ -170,23 +210,100 @@ func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Tran
 
 	if res.FailReason != "" {
 		costResponse.ReturnMessage = fmt.Sprintf("%s: %s", res.FailReason, returnMessageFromVMOutput)
-		return costResponse, nil
+		return costResponse, res, nil
 	}
 
 	if res.VMOutput == nil {
 		costResponse.ReturnMessage = process.ErrNilVMOutput.Error()
-		return costResponse, nil
+		return costResponse, res, nil
 	}
 
 	costResponse.SmartContractResults = res.ScResults
 	costResponse.Logs = res.Logs
 	if res.VMOutput.ReturnCode == vmcommon.Ok {
 		costResponse.GasUnits = ate.computeGasUnitsBasedOnVMOutput(tx, res.VMOutput)
-		return costResponse, nil
+		return costResponse, res, nil
 	}
 
 	costResponse.ReturnMessage = fmt.Sprintf("%s: %s", res.VMOutput.ReturnCode.String(), returnMessageFromVMOutput)
-	return costResponse, nil
+	return costResponse, res, nil
+}
+
+// computeGasBreakdown splits the consumed gas units in the base cost, the built-in function cost, the gas reserved for
+// the async callbacks and the smart contract execution. If the simulation VM traced the API functions, the smart
+// contract execution is further split in the gas consumed by each API function and the rest of the execution
+func (ate *apiTransactionEvaluator) computeGasBreakdown(
+	tx *transaction.Transaction,
+	gasUnits uint64,
+	simulationResults *txSimData.SimulationResultsWithVMOutput,
+) *txSimData.GasBreakdown {
+	gasBreakdown := &txSimData.GasBreakdown{
+		BaseCost:                core.MinUint64(ate.feeHandler.ComputeGasLimit(tx), gasUnits),
+		OpcodesBreakdownMessage: opcodesBreakdownUnavailableMessage,
+	}
+	remainingGas := gasUnits - gasBreakdown.BaseCost
+
+	builtInFunction, builtInFunctionCost, isBuiltInFunction := ate.getBuiltInFunctionCost(tx)
+	if isBuiltInFunction {
+		builtInFunctionCost = core.MinUint64(builtInFunctionCost, remainingGas)
+		gasBreakdown.BuiltInFunctions = map[string]uint64{builtInFunction: builtInFunctionCost}
+		remainingGas -= builtInFunctionCost
+	}
+
+	if simulationResults == nil {
+		return gasBreakdown
+	}
+
+	gasBreakdown.AsyncCallbackReserve = core.MinUint64(computeAsyncCallbackReserve(simulationResults.VMOutput), remainingGas)
+	gasBreakdown.SmartContractExecution = remainingGas - gasBreakdown.AsyncCallbackReserve
+
+	if simulationResults.GasTrace == nil {
+		return gasBreakdown
+	}
+
+	gasBreakdown.GasTraceAvailable = true
+	gasBreakdown.APIFunctions = simulationResults.GasTrace
+	gasUsedByAPIFunctions := uint64(0)
+	for _, apiFunctionGas := range simulationResults.GasTrace {
+		gasUsedByAPIFunctions += apiFunctionGas.GasUsed
+	}
+
+	if gasUsedByAPIFunctions < gasBreakdown.SmartContractExecution {
+		gasBreakdown.ExecutionNotInAPIFunctions = gasBreakdown.SmartContractExecution - gasUsedByAPIFunctions
+	}
+
+	return gasBreakdown
+}
+
+func (ate *apiTransactionEvaluator) getBuiltInFunctionCost(tx *transaction.Transaction) (string, uint64, bool) {
+	txTypeOnSender, _ := ate.txTypeHandler.ComputeTransactionType(tx)
+	if txTypeOnSender != process.BuiltInFunctionCall {
+		return "", 0, false
+	}
+
+	function, _, err := ate.callArgsParser.ParseData(string(tx.Data))
+	if err != nil {
+		return "", 0, false
+	}
+
+	builtInCost := ate.gasScheduleNotifier.LatestGasSchedule()[common.BuiltInCost]
+
+	return function, builtInCost[function], true
+}
+
+func computeAsyncCallbackReserve(vmOutput *vmcommon.VMOutput) uint64 {
+	if vmOutput == nil {
+		return 0
+	}
+
+	asyncCallbackReserve := uint64(0)
+	for _, outAcc := range vmOutput.OutputAccounts {
+		for _, outTransfer := range outAcc.OutputTransfers {
+			asyncCallbackReserve += outTransfer.GasLocked
+		}
+	}
+
+	return asyncCallbackReserve
 }
 
 func (ate *apiTransactionEvaluator) computeGasUnitsBasedOnVMOutput(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) uint64 {
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
@@ -32,6 +33,7 @@ func createArgs() ArgsApiTransactionEvaluator {
 		ShardCoordinator:    &mock.ShardCoordinatorStub{},
 		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 		BlockChain:          &testscommon.ChainHandlerMock{},
+		GasScheduleNotifier: testscommon.NewGasScheduleNotifierMock(make(map[string]map[string]uint64)),
 	}
 }
 
@@ -55,6 +57,17 @@ func TestTransactionEvaluator_NilBlockChain(t *testing.T) {
 	require.Equal(t, process.ErrNilBlockChain, err)
 }
 
+func TestTransactionEvaluator_NilGasScheduleNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.GasScheduleNotifier = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilGasSchedule, err)
+}
+
 func TestTransactionEvaluator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -427,3 +440,136 @@ func TestApiTransactionEvaluator_GetCurrentHeader(t *testing.T) {
 	currentHeader = tce.getCurrentBlockHeader()
 	require.Equal(t, expectedNonce, currentHeader.GetNonce())
 }
+
+func TestComputeTransactionCostWithGasBreakdown(t *testing.T) {
+	t.Parallel()
+
+	baseCost := uint64(1000)
+	consumedGasUnits := uint64(10000)
+	createBuiltInFunctionArgs := func(simulationResults *txSimData.SimulationResultsWithVMOutput) ArgsApiTransactionEvaluator {
+		args := createArgs()
+		args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.BuiltInFunctionCall, process.SCInvoking
+			},
+		}
+		args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return math.MaxUint64
+			},
+			ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+				return baseCost
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return simulationResults, nil
+			},
+		}
+		args.Accounts = &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
+		}
+		args.GasScheduleNotifier = testscommon.NewGasScheduleNotifierMock(map[string]map[string]uint64{
+			common.BuiltInCost: {"ESDTTransfer": 200},
+		})
+
+		return args
+	}
+	createVMOutput := func() *vmcommon.VMOutput {
+		return &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			GasRemaining: math.MaxUint64 - 1 - consumedGasUnits,
+			OutputAccounts: map[string]*vmcommon.OutputAccount{
+				"destination": {
+					OutputTransfers: []vmcommon.OutputTransfer{{GasLimit: 500, GasLocked: 3000}},
+				},
+			},
+		}
+	}
+
+	t.Run("move balance should only have the base cost", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.MoveBalance, process.MoveBalance
+			},
+		}
+		args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+			ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+				return baseCost
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		cost, err := tce.ComputeTransactionCostWithGasBreakdown(&transaction.Transaction{})
+		require.Nil(t, err)
+		require.Equal(t, baseCost, cost.GasUnits)
+		require.Equal(t, &txSimData.GasBreakdown{
+			BaseCost:                baseCost,
+			OpcodesBreakdownMessage: opcodesBreakdownUnavailableMessage,
+		}, cost.GasBreakdown)
+	})
+	t.Run("failed simulation should not have a breakdown", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := createVMOutput()
+		vmOutput.ReturnCode = vmcommon.UserError
+		args := createBuiltInFunctionArgs(&txSimData.SimulationResultsWithVMOutput{VMOutput: vmOutput})
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		cost, err := tce.ComputeTransactionCostWithGasBreakdown(&transaction.Transaction{Data: []byte("ESDTTransfer@01@02")})
+		require.Nil(t, err)
+		require.Zero(t, cost.GasUnits)
+		require.Nil(t, cost.GasBreakdown)
+	})
+	t.Run("without gas trace should not split the smart contract execution", func(t *testing.T) {
+		t.Parallel()
+
+		args := createBuiltInFunctionArgs(&txSimData.SimulationResultsWithVMOutput{VMOutput: createVMOutput()})
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		cost, err := tce.ComputeTransactionCostWithGasBreakdown(&transaction.Transaction{Data: []byte("ESDTTransfer@01@02")})
+		require.Nil(t, err)
+		require.Equal(t, consumedGasUnits, cost.GasUnits)
+		require.Equal(t, &txSimData.GasBreakdown{
+			BaseCost:                baseCost,
+			BuiltInFunctions:        map[string]uint64{"ESDTTransfer": 200},
+			SmartContractExecution:  5800,
+			AsyncCallbackReserve:    3000,
+			OpcodesBreakdownMessage: opcodesBreakdownUnavailableMessage,
+		}, cost.GasBreakdown)
+	})
+	t.Run("with gas trace should split the smart contract execution", func(t *testing.T) {
+		t.Parallel()
+
+		args := createBuiltInFunctionArgs(&txSimData.SimulationResultsWithVMOutput{
+			VMOutput: createVMOutput(),
+			GasTrace: map[string]*txSimData.APIFunctionGas{
+				"bigIntAdd": {GasUsed: 35, NumCalls: 3},
+				"getCaller": {GasUsed: 5, NumCalls: 1},
+			},
+		})
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		cost, err := tce.ComputeTransactionCostWithGasBreakdown(&transaction.Transaction{Data: []byte("ESDTTransfer@01@02")})
+		require.Nil(t, err)
+		require.Equal(t, consumedGasUnits, cost.GasUnits)
+		require.Equal(t, &txSimData.GasBreakdown{
+			BaseCost:               baseCost,
+			BuiltInFunctions:       map[string]uint64{"ESDTTransfer": 200},
+			SmartContractExecution: 5800,
+			APIFunctions: map[string]*txSimData.APIFunctionGas{
+				"bigIntAdd": {GasUsed: 35, NumCalls: 3},
+				"getCaller": {GasUsed: 5, NumCalls: 1},
+			},
+			ExecutionNotInAPIFunctions: 5760,
+			AsyncCallbackReserve:       3000,
+			GasTraceAvailable:          true,
+			OpcodesBreakdownMessage:    opcodesBreakdownUnavailableMessage,
+		}, cost.GasBreakdown)
+	})
+}
@@ -35,6 +35,7 @@ type ArgsTxSimulator struct {
 	Marshalizer               marshal.Marshalizer
 	DataFieldParser           DataFieldParser
 	BlockChainHook            process.BlockChainHookHandler
+	VMHooksGasTracer          VMHooksGasTracer
 }
 
 type refundHandler interface {
@@ -53,6 +54,7 @@ type transactionSimulator struct {
 	refundDetector         refundHandler
 	dataFieldParser        DataFieldParser
 	blockChainHook         process.BlockChainHookHandler
+	vmHooksGasTracer       VMHooksGasTracer
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -84,6 +86,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.BlockChainHook) {
 		return nil, process.ErrNilBlockChainHook
 	}
+	if check.IfNil(args.VMHooksGasTracer) {
+		return nil, ErrNilVMHooksGasTracer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -96,6 +101,7 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		refundDetector:         transactionAPI.NewRefundDetector(),
 		dataFieldParser:        args.DataFieldParser,
 		blockChainHook:         args.BlockChainHook,
+		vmHooksGasTracer:       args.VMHooksGasTracer,
 	}, nil
 }
 
@@ -108,6 +114,7 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 	failReason := ""
 
 	ts.blockChainHook.SetCurrentHeader(currentHeader)
+	ts.vmHooksGasTracer.Reset()
 
 	retCode, err := ts.txProcessor.ProcessTransaction(tx)
 	if err != nil {
@@ -138,6 +145,8 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 
 	ts.addLogsFromVmOutput(results, vmOutput)
 
+	results.GasTrace = ts.vmHooksGasTracer.GetGasTrace()
+
 	return results, nil
 }
 
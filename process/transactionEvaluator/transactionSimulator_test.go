@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -103,6 +104,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilVMHooksGasTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.VMHooksGasTracer = nil
+				return args
+			},
+			exError: ErrNilVMHooksGasTracer,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -139,6 +149,35 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
 
+func TestTransactionSimulator_ProcessTxShouldIncludeTheGasTrace(t *testing.T) {
+	t.Parallel()
+
+	gasTrace := map[string]*txSimData.APIFunctionGas{
+		"bigIntAdd": {GasUsed: 30, NumCalls: 2},
+	}
+	isReset := false
+	args := getTxSimulatorArgs()
+	args.VMHooksGasTracer = &mock.VMHooksGasTracerStub{
+		ResetCalled: func() {
+			isReset = true
+		},
+		GetGasTraceCalled: func() map[string]*txSimData.APIFunctionGas {
+			return gasTrace
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.True(t, isReset)
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, &block.Header{})
+	require.NoError(t, err)
+	require.Equal(t, gasTrace, results.GasTrace)
+}
+
 func TestTransactionSimulator_getVMOutputComputeHashFails(t *testing.T) {
 	t.Parallel()
 
@@ -246,6 +285,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		Hasher:                    &hashingMocks.HasherMock{},
 		DataFieldParser:           dataFieldParser,
 		BlockChainHook:            &testscommon.BlockChainHookStub{},
+		VMHooksGasTracer:          &mock.VMHooksGasTracerStub{},
 	}
 }
 
@@ -0,0 +1,24 @@
+package builder
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHistoryRepository signals that a nil history repository has been provided
+var ErrNilHistoryRepository = errors.New("nil history repository")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrProofsNotAvailable signals that the proofs cannot be built because the database lookup extensions are disabled
+var ErrProofsNotAvailable = errors.New("proofs are available only when the database lookup extensions are enabled")
+
+// ErrProofsNotSupportedOnMetachain signals that the proofs can only be built by the shard nodes
+var ErrProofsNotSupportedOnMetachain = errors.New("proofs are not supported on metachain")
+
+// ErrNotNotarized signals that the block containing the transaction was not notarized by the metachain yet
+var ErrNotNotarized = errors.New("block not notarized by the metachain yet")
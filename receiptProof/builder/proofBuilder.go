@@ -0,0 +1,190 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/receiptProof"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// ArgsProofBuilder holds the components needed to create a new proof builder
+type ArgsProofBuilder struct {
+	Marshaller        marshal.Marshalizer
+	HistoryRepository dblookupext.HistoryRepository
+	StorageService    dataRetriever.StorageService
+	ShardCoordinator  sharding.Coordinator
+}
+
+type proofBuilder struct {
+	marshaller        marshal.Marshalizer
+	historyRepository dblookupext.HistoryRepository
+	storageService    dataRetriever.StorageService
+	shardCoordinator  sharding.Coordinator
+}
+
+// NewProofBuilder creates a new proof builder, able to prove the inclusion of transactions in the blocks of the current shard
+func NewProofBuilder(args ArgsProofBuilder) (*proofBuilder, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.HistoryRepository) {
+		return nil, ErrNilHistoryRepository
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &proofBuilder{
+		marshaller:        args.Marshaller,
+		historyRepository: args.HistoryRepository,
+		storageService:    args.StorageService,
+		shardCoordinator:  args.ShardCoordinator,
+	}, nil
+}
+
+// BuildReceiptProof builds the proof of the given transaction, together with the proofs of its smart contract results
+func (pb *proofBuilder) BuildReceiptProof(txHash []byte) (*receiptProof.ReceiptProof, error) {
+	if !pb.historyRepository.IsEnabled() {
+		return nil, ErrProofsNotAvailable
+	}
+	if pb.shardCoordinator.SelfId() == core.MetachainShardId {
+		return nil, ErrProofsNotSupportedOnMetachain
+	}
+
+	miniblockMetadata, err := pb.historyRepository.GetMiniblockMetadataByTxHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txProof, err := pb.buildTransactionProof(txHash, miniblockMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := pb.buildResultsProofs(txHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &receiptProof.ReceiptProof{
+		Transaction: txProof,
+		Results:     results,
+	}, nil
+}
+
+func (pb *proofBuilder) buildResultsProofs(txHash []byte, epoch uint32) ([]*receiptProof.TransactionProof, error) {
+	resultsHashes, err := pb.historyRepository.GetResultsHashesByTxHash(txHash, epoch)
+	if err != nil {
+		if errors.Is(err, dblookupext.ErrNotFoundInStorage) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	results := make([]*receiptProof.TransactionProof, 0)
+	for _, scrHashesEpoch := range resultsHashes.ScResultsHashesAndEpoch {
+		for _, scrHash := range scrHashesEpoch.ScResultsHashes {
+			miniblockMetadata, errGet := pb.historyRepository.GetMiniblockMetadataByTxHash(scrHash)
+			if errGet != nil {
+				return nil, errGet
+			}
+
+			result, errBuild := pb.buildTransactionProof(scrHash, miniblockMetadata)
+			if errBuild != nil {
+				return nil, errBuild
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+func (pb *proofBuilder) buildTransactionProof(txHash []byte, miniblockMetadata *dblookupext.MiniblockMetadata) (*receiptProof.TransactionProof, error) {
+	metaHash := miniblockMetadata.NotarizedAtSourceInMetaHash
+	if miniblockMetadata.DestinationShardID == pb.shardCoordinator.SelfId() {
+		metaHash = miniblockMetadata.NotarizedAtDestinationInMetaHash
+	}
+	if len(metaHash) == 0 {
+		return nil, ErrNotNotarized
+	}
+
+	txBytes, err := pb.getFromStorage(getTransactionsUnit(block.Type(miniblockMetadata.Type)), txHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the transaction", err)
+	}
+
+	miniblockBytes, err := pb.getFromStorage(dataRetriever.MiniBlockUnit, miniblockMetadata.MiniblockHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the miniblock", err)
+	}
+
+	headerBytes, err := pb.getFromStorage(dataRetriever.BlockHeaderUnit, miniblockMetadata.HeaderHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the header", err)
+	}
+	header, err := process.UnmarshalShardHeader(pb.marshaller, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlockBytes, err := pb.searchInStorage(dataRetriever.MetaBlockUnit, metaHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the metablock", err)
+	}
+
+	return &receiptProof.TransactionProof{
+		TxHash:      txHash,
+		Transaction: txBytes,
+		ShardID:     header.GetShardID(),
+		Miniblock:   miniblockBytes,
+		Header:      headerBytes,
+		MetaBlock:   metaBlockBytes,
+	}, nil
+}
+
+func getTransactionsUnit(miniblockType block.Type) dataRetriever.UnitType {
+	switch miniblockType {
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit
+	default:
+		return dataRetriever.TransactionUnit
+	}
+}
+
+func (pb *proofBuilder) getFromStorage(unitType dataRetriever.UnitType, key []byte, epoch uint32) ([]byte, error) {
+	storer, err := pb.storageService.GetStorer(unitType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storer.GetFromEpoch(key, epoch)
+}
+
+func (pb *proofBuilder) searchInStorage(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	storer, err := pb.storageService.GetStorer(unitType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storer.SearchFirst(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pb *proofBuilder) IsInterfaceNil() bool {
+	return pb == nil
+}
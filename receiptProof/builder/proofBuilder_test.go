@@ -0,0 +1,233 @@
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/receiptProof/builder"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dblookupextMock "github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	txHash        = []byte("tx")
+	scrHash       = []byte("scr")
+	miniblockHash = []byte("miniblock")
+	headerHash    = []byte("header")
+	metaHash      = []byte("meta")
+)
+
+func createMockArgsProofBuilder() builder.ArgsProofBuilder {
+	return builder.ArgsProofBuilder{
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		HistoryRepository: &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		},
+		StorageService:   genericMocks.NewChainStorerMock(0),
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(2),
+	}
+}
+
+func prepareStorage(t *testing.T, args builder.ArgsProofBuilder) {
+	chainStorer := args.StorageService.(*genericMocks.ChainStorerMock)
+	require.Nil(t, chainStorer.Transactions.Put(txHash, []byte("tx bytes")))
+	require.Nil(t, chainStorer.Unsigned.Put(scrHash, []byte("scr bytes")))
+	require.Nil(t, chainStorer.Miniblocks.Put(miniblockHash, []byte("miniblock bytes")))
+	require.Nil(t, chainStorer.BlockHeaders.PutWithMarshalizer(headerHash, &block.Header{Nonce: 5, Round: 6, PrevRandSeed: []byte("seed")}, args.Marshaller))
+	require.Nil(t, chainStorer.Metablocks.PutWithMarshalizer(metaHash, &block.MetaBlock{Nonce: 7, Round: 8}, args.Marshaller))
+}
+
+func TestNewProofBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.Marshaller = nil
+
+		pb, err := builder.NewProofBuilder(args)
+		require.Nil(t, pb)
+		require.Equal(t, builder.ErrNilMarshaller, err)
+	})
+
+	t.Run("nil history repository", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.HistoryRepository = nil
+
+		pb, err := builder.NewProofBuilder(args)
+		require.Nil(t, pb)
+		require.Equal(t, builder.ErrNilHistoryRepository, err)
+	})
+
+	t.Run("nil storage service", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.StorageService = nil
+
+		pb, err := builder.NewProofBuilder(args)
+		require.Nil(t, pb)
+		require.Equal(t, builder.ErrNilStorageService, err)
+	})
+
+	t.Run("nil shard coordinator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.ShardCoordinator = nil
+
+		pb, err := builder.NewProofBuilder(args)
+		require.Nil(t, pb)
+		require.Equal(t, builder.ErrNilShardCoordinator, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pb, err := builder.NewProofBuilder(createMockArgsProofBuilder())
+		require.Nil(t, err)
+		require.False(t, pb.IsInterfaceNil())
+	})
+}
+
+func TestProofBuilder_BuildReceiptProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("history repository disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		pb, _ := builder.NewProofBuilder(args)
+
+		proof, err := pb.BuildReceiptProof(txHash)
+		require.Nil(t, proof)
+		require.Equal(t, builder.ErrProofsNotAvailable, err)
+	})
+
+	t.Run("metachain node should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+		shardCoordinator.CurrentShard = core.MetachainShardId
+		args.ShardCoordinator = shardCoordinator
+		pb, _ := builder.NewProofBuilder(args)
+
+		proof, err := pb.BuildReceiptProof(txHash)
+		require.Nil(t, proof)
+		require.Equal(t, builder.ErrProofsNotSupportedOnMetachain, err)
+	})
+
+	t.Run("not notarized should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return &dblookupext.MiniblockMetadata{
+					SourceShardID:               0,
+					DestinationShardID:          1,
+					NotarizedAtSourceInMetaHash: nil,
+				}, nil
+			},
+		}
+		pb, _ := builder.NewProofBuilder(args)
+
+		proof, err := pb.BuildReceiptProof(txHash)
+		require.Nil(t, proof)
+		require.Equal(t, builder.ErrNotNotarized, err)
+	})
+
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return &dblookupext.MiniblockMetadata{
+					MiniblockHash:                    miniblockHash,
+					HeaderHash:                       []byte("missing"),
+					NotarizedAtDestinationInMetaHash: metaHash,
+				}, nil
+			},
+		}
+		prepareStorage(t, args)
+		pb, _ := builder.NewProofBuilder(args)
+
+		proof, err := pb.BuildReceiptProof(txHash)
+		require.Nil(t, proof)
+		require.Error(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofBuilder()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				metadata := &dblookupext.MiniblockMetadata{
+					Type:                             int32(block.TxBlock),
+					SourceShardID:                    1,
+					DestinationShardID:               0,
+					MiniblockHash:                    miniblockHash,
+					HeaderHash:                       headerHash,
+					NotarizedAtSourceInMetaHash:      []byte("source meta"),
+					NotarizedAtDestinationInMetaHash: metaHash,
+				}
+				if string(hash) == string(scrHash) {
+					metadata.Type = int32(block.SmartContractResultBlock)
+				}
+
+				return metadata, nil
+			},
+			GetEventsHashesByTxHashCalled: func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+				return &dblookupext.ResultsHashesByTxHash{
+					ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+						{ScResultsHashes: [][]byte{scrHash}},
+					},
+				}, nil
+			},
+		}
+		prepareStorage(t, args)
+		pb, _ := builder.NewProofBuilder(args)
+
+		proof, err := pb.BuildReceiptProof(txHash)
+		require.Nil(t, err)
+
+		txProof := proof.Transaction
+		assert.Equal(t, txHash, txProof.TxHash)
+		assert.Equal(t, []byte("tx bytes"), txProof.Transaction)
+		assert.Equal(t, []byte("miniblock bytes"), txProof.Miniblock)
+		assert.NotEmpty(t, txProof.Header)
+		assert.NotEmpty(t, txProof.MetaBlock)
+
+		require.Len(t, proof.Results, 1)
+		assert.Equal(t, scrHash, proof.Results[0].TxHash)
+		assert.Equal(t, []byte("scr bytes"), proof.Results[0].Transaction)
+	})
+}
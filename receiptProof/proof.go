@@ -0,0 +1,24 @@
+package receiptProof
+
+// TransactionProof holds the data needed to prove that a transaction (or a smart contract result) was included in
+// a shard block notarized by the metachain. The transaction, the miniblock, the header and the metablock are kept in
+// their marshalled form, so their hashes can be recomputed by the verifier. The signature data of the blocks is part
+// of the marshalled headers, the consensus groups being recomputed by the verifier from the validator sets.
+// The protocol does not build a merkle tree over the transactions of a miniblock, its hash being the hash of the whole
+// marshalled miniblock. For this reason the proof carries the whole miniblock instead of a merkle path: the verifier
+// checks that it holds the transaction hash and that its hash is listed in the header. The miniblock only holds the
+// transaction hashes, so this costs about 32 bytes per transaction of the miniblock, instead of the log2 sized path
+type TransactionProof struct {
+	TxHash      []byte `json:"txHash"`
+	Transaction []byte `json:"transaction"`
+	ShardID     uint32 `json:"shardID"`
+	Miniblock   []byte `json:"miniblock"`
+	Header      []byte `json:"header"`
+	MetaBlock   []byte `json:"metaBlock"`
+}
+
+// ReceiptProof holds the proof of a transaction together with the proofs of the smart contract results it generated
+type ReceiptProof struct {
+	Transaction *TransactionProof   `json:"transaction"`
+	Results     []*TransactionProof `json:"results,omitempty"`
+}
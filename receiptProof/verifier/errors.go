@@ -0,0 +1,48 @@
+package verifier
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMultiSigVerifier signals that a nil multi signature verifier has been provided
+var ErrNilMultiSigVerifier = errors.New("nil multi signature verifier")
+
+// ErrNilProof signals that a nil proof has been provided
+var ErrNilProof = errors.New("nil proof")
+
+// ErrTransactionHashMismatch signals that the transaction does not match the proven hash
+var ErrTransactionHashMismatch = errors.New("transaction hash mismatch")
+
+// ErrTransactionNotInMiniblock signals that the transaction is not part of the provided miniblock
+var ErrTransactionNotInMiniblock = errors.New("transaction not found in miniblock")
+
+// ErrMiniblockNotInHeader signals that the miniblock is not part of the provided header
+var ErrMiniblockNotInHeader = errors.New("miniblock not found in header")
+
+// ErrShardIDMismatch signals that the header does not belong to the proven shard
+var ErrShardIDMismatch = errors.New("shard ID mismatch")
+
+// ErrHeaderNotNotarized signals that the header is not notarized by the provided metablock
+var ErrHeaderNotNotarized = errors.New("header not notarized by the metablock")
+
+// ErrMissingValidatorSet signals that the validator set of a shard was not provided
+var ErrMissingValidatorSet = errors.New("missing validator set")
+
+// ErrValidatorSetEpochMismatch signals that the validator set does not belong to the epoch of the consensus group
+var ErrValidatorSetEpochMismatch = errors.New("validator set epoch mismatch")
+
+// ErrWrongSizeBitmap signals that the size of the signers bitmap does not match the consensus size
+var ErrWrongSizeBitmap = errors.New("wrong size bitmap")
+
+// ErrLeaderSignatureMissing signals that the block proposer is not part of the signers
+var ErrLeaderSignatureMissing = errors.New("block proposer signature missing")
+
+// ErrNotEnoughSignatures signals that the block was not signed by enough consensus members
+var ErrNotEnoughSignatures = errors.New("not enough signatures")
+
+// ErrResultNotLinkedToTransaction signals that a smart contract result was not generated by the proven transaction
+var ErrResultNotLinkedToTransaction = errors.New("smart contract result not generated by the transaction")
@@ -0,0 +1,326 @@
+package verifier
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/receiptProof"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+)
+
+// ValidatorSet holds the eligible validators of a shard for an epoch, in the order kept by the nodes coordinator and
+// with the chances used as selection weights, together with the size of its consensus group
+type ValidatorSet struct {
+	Epoch         uint32
+	Validators    []nodesCoordinator.Validator
+	ConsensusSize int
+}
+
+// VerifiedTransaction holds the outcome of a verified transaction proof. IncludedAtDestination is set if the
+// transaction was included in a valid miniblock of a block from its destination shard. It does not prove that the
+// execution succeeded: the logs, holding the signalError events, are not committed in the block headers and the
+// proof can not show that no failed smart contract result was left out
+type VerifiedTransaction struct {
+	TxHash                []byte
+	ShardID               uint32
+	HeaderNonce           uint64
+	MetaBlockNonce        uint64
+	IncludedAtDestination bool
+}
+
+// VerifiedReceipt holds the outcome of a verified receipt proof
+type VerifiedReceipt struct {
+	Transaction *VerifiedTransaction
+	Results     []*VerifiedTransaction
+}
+
+// ArgsProofVerifier holds the components needed to create a new proof verifier
+type ArgsProofVerifier struct {
+	Marshaller       marshal.Marshalizer
+	Hasher           hashing.Hasher
+	MultiSigVerifier crypto.MultiSigVerifier
+}
+
+type proofVerifier struct {
+	marshaller       marshal.Marshalizer
+	hasher           hashing.Hasher
+	multiSigVerifier crypto.MultiSigVerifier
+}
+
+// NewProofVerifier creates a new proof verifier. It only depends on the chain's data structures, so it can be used
+// by light clients that track the validator sets without running a node
+func NewProofVerifier(args ArgsProofVerifier) (*proofVerifier, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.MultiSigVerifier) {
+		return nil, ErrNilMultiSigVerifier
+	}
+
+	return &proofVerifier{
+		marshaller:       args.Marshaller,
+		hasher:           args.Hasher,
+		multiSigVerifier: args.MultiSigVerifier,
+	}, nil
+}
+
+// VerifyReceiptProof verifies the proof of a transaction and the proofs of its smart contract results, which should
+// all have been generated by the proven transaction
+func (pv *proofVerifier) VerifyReceiptProof(proof *receiptProof.ReceiptProof, validators map[uint32]*ValidatorSet) (*VerifiedReceipt, error) {
+	if proof == nil {
+		return nil, ErrNilProof
+	}
+
+	verifiedTx, err := pv.VerifyTransactionProof(proof.Transaction, validators)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*VerifiedTransaction, 0, len(proof.Results))
+	for _, resultProof := range proof.Results {
+		verifiedResult, errVerify := pv.VerifyTransactionProof(resultProof, validators)
+		if errVerify != nil {
+			return nil, fmt.Errorf("%w for result %x", errVerify, resultProof.TxHash)
+		}
+
+		scr := &smartContractResult.SmartContractResult{}
+		err = pv.marshaller.Unmarshal(scr, resultProof.Transaction)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(scr.OriginalTxHash, verifiedTx.TxHash) {
+			return nil, fmt.Errorf("%w, result %x", ErrResultNotLinkedToTransaction, resultProof.TxHash)
+		}
+
+		results = append(results, verifiedResult)
+	}
+
+	return &VerifiedReceipt{
+		Transaction: verifiedTx,
+		Results:     results,
+	}, nil
+}
+
+// VerifyTransactionProof checks that the transaction is part of the miniblock, that the miniblock is part of the
+// header signed by the shard's consensus and that the header was notarized by a metablock signed by the metachain's
+// consensus. The consensus groups are recomputed out of the provided validator sets, indexed by shard ID
+func (pv *proofVerifier) VerifyTransactionProof(proof *receiptProof.TransactionProof, validators map[uint32]*ValidatorSet) (*VerifiedTransaction, error) {
+	if proof == nil {
+		return nil, ErrNilProof
+	}
+	if !bytes.Equal(pv.hasher.Compute(string(proof.Transaction)), proof.TxHash) {
+		return nil, ErrTransactionHashMismatch
+	}
+
+	miniblock := &block.MiniBlock{}
+	err := pv.marshaller.Unmarshal(miniblock, proof.Miniblock)
+	if err != nil {
+		return nil, err
+	}
+	if !containsHash(miniblock.TxHashes, proof.TxHash) {
+		return nil, ErrTransactionNotInMiniblock
+	}
+
+	header, err := pv.unmarshalShardHeader(proof.Header)
+	if err != nil {
+		return nil, err
+	}
+	if header.GetShardID() != proof.ShardID {
+		return nil, ErrShardIDMismatch
+	}
+	miniblockHash := pv.hasher.Compute(string(proof.Miniblock))
+	if !isMiniblockInHeader(miniblockHash, header) {
+		return nil, ErrMiniblockNotInHeader
+	}
+	err = pv.verifyHeaderSignature(header, validators[header.GetShardID()])
+	if err != nil {
+		return nil, fmt.Errorf("%w while verifying the header signature", err)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = pv.marshaller.Unmarshal(metaBlock, proof.MetaBlock)
+	if err != nil {
+		return nil, err
+	}
+	headerHash := pv.hasher.Compute(string(proof.Header))
+	if !isHeaderNotarized(headerHash, header.GetShardID(), metaBlock) {
+		return nil, ErrHeaderNotNotarized
+	}
+	err = pv.verifyHeaderSignature(metaBlock, validators[core.MetachainShardId])
+	if err != nil {
+		return nil, fmt.Errorf("%w while verifying the metablock signature", err)
+	}
+
+	return &VerifiedTransaction{
+		TxHash:                proof.TxHash,
+		ShardID:               header.GetShardID(),
+		HeaderNonce:           header.GetNonce(),
+		MetaBlockNonce:        metaBlock.GetNonce(),
+		IncludedAtDestination: miniblock.ReceiverShardID == header.GetShardID() && miniblock.Type != block.InvalidBlock,
+	}, nil
+}
+
+func (pv *proofVerifier) unmarshalShardHeader(headerBytes []byte) (data.ShardHeaderHandler, error) {
+	headerV2 := &block.HeaderV2{}
+	err := pv.marshaller.Unmarshal(headerV2, headerBytes)
+	if err == nil && !check.IfNil(headerV2.Header) {
+		return headerV2, nil
+	}
+
+	header := &block.Header{}
+	err = pv.marshaller.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (pv *proofVerifier) verifyHeaderSignature(header data.HeaderHandler, validatorSet *ValidatorSet) error {
+	if validatorSet == nil {
+		return fmt.Errorf("%w for shard %d", ErrMissingValidatorSet, header.GetShardID())
+	}
+	consensus, err := pv.computeConsensusGroup(header, validatorSet)
+	if err != nil {
+		return err
+	}
+
+	signers, err := getSigners(consensus, header.GetPubKeysBitmap())
+	if err != nil {
+		return err
+	}
+
+	headerCopy := header.ShallowClone()
+	err = headerCopy.SetSignature(nil)
+	if err != nil {
+		return err
+	}
+	err = headerCopy.SetPubKeysBitmap(nil)
+	if err != nil {
+		return err
+	}
+	err = headerCopy.SetLeaderSignature(nil)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(pv.marshaller, pv.hasher, headerCopy)
+	if err != nil {
+		return err
+	}
+
+	return pv.multiSigVerifier.VerifyAggregatedSig(signers, hash, header.GetSignature())
+}
+
+// computeConsensusGroup selects the consensus group of the header out of the validator set, from the header's
+// randomness, round and epoch, in the same way the nodes coordinator does
+func (pv *proofVerifier) computeConsensusGroup(header data.HeaderHandler, validatorSet *ValidatorSet) ([][]byte, error) {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+	if validatorSet.Epoch != epoch {
+		return nil, fmt.Errorf("%w, validator set epoch %d, consensus epoch %d", ErrValidatorSetEpochMismatch, validatorSet.Epoch, epoch)
+	}
+
+	weights := make([]uint32, 0, len(validatorSet.Validators))
+	for _, validator := range validatorSet.Validators {
+		weights = append(weights, validator.Chances())
+	}
+	selector, err := nodesCoordinator.NewSelectorExpandedList(weights, pv.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusGroup, err := nodesCoordinator.SelectConsensusGroup(
+		selector,
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		validatorSet.ConsensusSize,
+		validatorSet.Validators,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consensus := make([][]byte, 0, len(consensusGroup))
+	for _, validator := range consensusGroup {
+		consensus = append(consensus, validator.PubKey())
+	}
+
+	return consensus, nil
+}
+
+func getSigners(consensus [][]byte, bitmap []byte) ([][]byte, error) {
+	expectedBitmapSize := (len(consensus) + 7) / 8
+	if len(bitmap) != expectedBitmapSize || len(bitmap) == 0 {
+		return nil, ErrWrongSizeBitmap
+	}
+	if bitmap[0]&1 == 0 {
+		return nil, ErrLeaderSignatureMissing
+	}
+
+	numSigners := 0
+	for _, b := range bitmap {
+		numSigners += bits.OnesCount8(b)
+	}
+	if numSigners < core.GetPBFTThreshold(len(consensus)) {
+		return nil, ErrNotEnoughSignatures
+	}
+
+	signers := make([][]byte, 0, numSigners)
+	for i, pubKey := range consensus {
+		if bitmap[i/8]&(1<<uint8(i%8)) != 0 {
+			signers = append(signers, pubKey)
+		}
+	}
+
+	return signers, nil
+}
+
+func containsHash(hashes [][]byte, hash []byte) bool {
+	for _, h := range hashes {
+		if bytes.Equal(h, hash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMiniblockInHeader(miniblockHash []byte, header data.ShardHeaderHandler) bool {
+	for _, miniblockHeader := range header.GetMiniBlockHeaderHandlers() {
+		if bytes.Equal(miniblockHeader.GetHash(), miniblockHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isHeaderNotarized(headerHash []byte, shardID uint32, metaBlock *block.MetaBlock) bool {
+	for _, shardData := range metaBlock.ShardInfo {
+		if shardData.ShardID == shardID && bytes.Equal(shardData.HeaderHash, headerHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pv *proofVerifier) IsInterfaceNil() bool {
+	return pv == nil
+}
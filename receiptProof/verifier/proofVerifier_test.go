@@ -0,0 +1,452 @@
+package verifier_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/receiptProof"
+	"github.com/multiversx/mx-chain-go/receiptProof/verifier"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = sha256.NewSha256()
+)
+
+type proofComponents struct {
+	tx        []byte
+	miniblock *block.MiniBlock
+	header    *block.Header
+	metaBlock *block.MetaBlock
+}
+
+func createProofComponents(tx []byte) *proofComponents {
+	return &proofComponents{
+		tx: tx,
+		miniblock: &block.MiniBlock{
+			TxHashes:        [][]byte{[]byte("other tx")},
+			SenderShardID:   0,
+			ReceiverShardID: 0,
+			Type:            block.TxBlock,
+		},
+		header: &block.Header{
+			ShardID:       0,
+			Nonce:         5,
+			Round:         6,
+			Epoch:         2,
+			PrevRandSeed:  []byte("prev rand seed"),
+			PubKeysBitmap: []byte{0x07},
+			Signature:     []byte("shard signature"),
+		},
+		metaBlock: &block.MetaBlock{
+			Nonce:         7,
+			Round:         8,
+			Epoch:         2,
+			PrevRandSeed:  []byte("prev rand seed"),
+			PubKeysBitmap: []byte{0x07},
+			Signature:     []byte("meta signature"),
+		},
+	}
+}
+
+func (pc *proofComponents) toProof(t *testing.T) *receiptProof.TransactionProof {
+	txHash := testHasher.Compute(string(pc.tx))
+	pc.miniblock.TxHashes = append(pc.miniblock.TxHashes, txHash)
+	miniblockBytes, err := testMarshaller.Marshal(pc.miniblock)
+	require.Nil(t, err)
+
+	pc.header.MiniBlockHeaders = []block.MiniBlockHeader{{Hash: testHasher.Compute(string(miniblockBytes))}}
+	headerBytes, err := testMarshaller.Marshal(pc.header)
+	require.Nil(t, err)
+
+	pc.metaBlock.ShardInfo = []block.ShardData{{ShardID: pc.header.ShardID, HeaderHash: testHasher.Compute(string(headerBytes))}}
+	metaBlockBytes, err := testMarshaller.Marshal(pc.metaBlock)
+	require.Nil(t, err)
+
+	return &receiptProof.TransactionProof{
+		TxHash:      txHash,
+		Transaction: pc.tx,
+		ShardID:     pc.header.ShardID,
+		Miniblock:   miniblockBytes,
+		Header:      headerBytes,
+		MetaBlock:   metaBlockBytes,
+	}
+}
+
+func createValidatorSet(prefix string, numValidators int) *verifier.ValidatorSet {
+	validators := make([]nodesCoordinator.Validator, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		validator, _ := nodesCoordinator.NewValidator([]byte(fmt.Sprintf("%s-%d", prefix, i)), 1, uint32(i))
+		validators = append(validators, validator)
+	}
+
+	return &verifier.ValidatorSet{
+		Epoch:         2,
+		Validators:    validators,
+		ConsensusSize: 3,
+	}
+}
+
+func createValidators() map[uint32]*verifier.ValidatorSet {
+	return map[uint32]*verifier.ValidatorSet{
+		0:                     createValidatorSet("shard", 4),
+		core.MetachainShardId: createValidatorSet("meta", 3),
+	}
+}
+
+func createMockArgsProofVerifier() verifier.ArgsProofVerifier {
+	return verifier.ArgsProofVerifier{
+		Marshaller:       testMarshaller,
+		Hasher:           testHasher,
+		MultiSigVerifier: cryptoMocks.NewMultiSigner(),
+	}
+}
+
+func createTxBytes(t *testing.T) []byte {
+	txBytes, err := testMarshaller.Marshal(&transaction.Transaction{Nonce: 1, Value: big.NewInt(10)})
+	require.Nil(t, err)
+
+	return txBytes
+}
+
+func TestNewProofVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofVerifier()
+		args.Marshaller = nil
+
+		pv, err := verifier.NewProofVerifier(args)
+		require.Nil(t, pv)
+		require.Equal(t, verifier.ErrNilMarshaller, err)
+	})
+
+	t.Run("nil hasher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofVerifier()
+		args.Hasher = nil
+
+		pv, err := verifier.NewProofVerifier(args)
+		require.Nil(t, pv)
+		require.Equal(t, verifier.ErrNilHasher, err)
+	})
+
+	t.Run("nil multi signature verifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProofVerifier()
+		args.MultiSigVerifier = nil
+
+		pv, err := verifier.NewProofVerifier(args)
+		require.Nil(t, pv)
+		require.Equal(t, verifier.ErrNilMultiSigVerifier, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pv, err := verifier.NewProofVerifier(createMockArgsProofVerifier())
+		require.Nil(t, err)
+		require.False(t, pv.IsInterfaceNil())
+	})
+}
+
+func TestProofVerifier_VerifyTransactionProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proof", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+
+		verified, err := pv.VerifyTransactionProof(nil, createValidators())
+		require.Nil(t, verified)
+		require.Equal(t, verifier.ErrNilProof, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		components := createProofComponents(createTxBytes(t))
+		args := createMockArgsProofVerifier()
+		verifiedMessages := make(map[string][][]byte)
+		args.MultiSigVerifier = &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				verifiedMessages[string(aggSig)] = pubKeysSigners
+				return nil
+			},
+		}
+		pv, _ := verifier.NewProofVerifier(args)
+		proof := components.toProof(t)
+
+		verified, err := pv.VerifyTransactionProof(proof, createValidators())
+		require.Nil(t, err)
+		assert.Equal(t, &verifier.VerifiedTransaction{
+			TxHash:                proof.TxHash,
+			ShardID:               0,
+			HeaderNonce:           5,
+			MetaBlockNonce:        7,
+			IncludedAtDestination: true,
+		}, verified)
+		assert.Equal(t, [][]byte{[]byte("shard-2"), []byte("shard-0"), []byte("shard-3")}, verifiedMessages["shard signature"])
+		assert.Equal(t, [][]byte{[]byte("meta-2"), []byte("meta-0"), []byte("meta-1")}, verifiedMessages["meta signature"])
+	})
+
+	t.Run("start of epoch block should be signed by the consensus of the previous epoch", func(t *testing.T) {
+		t.Parallel()
+
+		components := createProofComponents(createTxBytes(t))
+		components.header.Epoch = 3
+		components.header.EpochStartMetaHash = []byte("epoch start meta hash")
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+
+		verified, err := pv.VerifyTransactionProof(components.toProof(t), createValidators())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(5), verified.HeaderNonce)
+	})
+
+	t.Run("header signed by another group of eligible validators should error", func(t *testing.T) {
+		t.Parallel()
+
+		// all the signers are eligible validators, but they are not the consensus group selected for the round
+		signers := [][]byte{[]byte("shard-0"), []byte("shard-1"), []byte("shard-2")}
+		errInvalidSignature := errors.New("invalid signature")
+		args := createMockArgsProofVerifier()
+		args.MultiSigVerifier = &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				if string(aggSig) == "shard signature" && !assert.ObjectsAreEqual(signers, pubKeysSigners) {
+					return errInvalidSignature
+				}
+				return nil
+			},
+		}
+		pv, _ := verifier.NewProofVerifier(args)
+
+		verified, err := pv.VerifyTransactionProof(createProofComponents(createTxBytes(t)).toProof(t), createValidators())
+		require.Nil(t, verified)
+		require.True(t, errors.Is(err, errInvalidSignature))
+	})
+
+	t.Run("cross shard transaction at source should not be marked as executed", func(t *testing.T) {
+		t.Parallel()
+
+		components := createProofComponents(createTxBytes(t))
+		components.miniblock.ReceiverShardID = 1
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+
+		verified, err := pv.VerifyTransactionProof(components.toProof(t), createValidators())
+		require.Nil(t, err)
+		assert.False(t, verified.IncludedAtDestination)
+	})
+
+	t.Run("transaction hash mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+		proof := createProofComponents(createTxBytes(t)).toProof(t)
+		proof.Transaction = []byte("other transaction")
+
+		verified, err := pv.VerifyTransactionProof(proof, createValidators())
+		require.Nil(t, verified)
+		require.Equal(t, verifier.ErrTransactionHashMismatch, err)
+	})
+
+	t.Run("transaction not in miniblock", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+		components := createProofComponents(createTxBytes(t))
+		proof := components.toProof(t)
+		proof.Miniblock, _ = testMarshaller.Marshal(&block.MiniBlock{TxHashes: [][]byte{[]byte("other tx")}})
+
+		verified, err := pv.VerifyTransactionProof(proof, createValidators())
+		require.Nil(t, verified)
+		require.Equal(t, verifier.ErrTransactionNotInMiniblock, err)
+	})
+
+	t.Run("miniblock not in header", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+		components := createProofComponents(createTxBytes(t))
+		proof := components.toProof(t)
+		components.miniblock.Type = block.InvalidBlock
+		proof.Miniblock, _ = testMarshaller.Marshal(components.miniblock)
+
+		verified, err := pv.VerifyTransactionProof(proof, createValidators())
+		require.Nil(t, verified)
+		require.Equal(t, verifier.ErrMiniblockNotInHeader, err)
+	})
+
+	t.Run("header not notarized", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+		components := createProofComponents(createTxBytes(t))
+		proof := components.toProof(t)
+		components.metaBlock.ShardInfo = nil
+		proof.MetaBlock, _ = testMarshaller.Marshal(components.metaBlock)
+
+		verified, err := pv.VerifyTransactionProof(proof, createValidators())
+		require.Nil(t, verified)
+		require.Equal(t, verifier.ErrHeaderNotNotarized, err)
+	})
+
+	t.Run("invalid signature data", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		testCases := []struct {
+			name        string
+			setup       func(components *proofComponents, validators map[uint32]*verifier.ValidatorSet)
+			expectedErr error
+		}{
+			{
+				name: "missing validator set",
+				setup: func(_ *proofComponents, validators map[uint32]*verifier.ValidatorSet) {
+					delete(validators, core.MetachainShardId)
+				},
+				expectedErr: verifier.ErrMissingValidatorSet,
+			},
+			{
+				name: "validator set of another epoch",
+				setup: func(_ *proofComponents, validators map[uint32]*verifier.ValidatorSet) {
+					validators[0].Epoch = 3
+				},
+				expectedErr: verifier.ErrValidatorSetEpochMismatch,
+			},
+			{
+				name: "consensus size over the validator set size",
+				setup: func(_ *proofComponents, validators map[uint32]*verifier.ValidatorSet) {
+					validators[core.MetachainShardId].ConsensusSize = 4
+				},
+				expectedErr: nodesCoordinator.ErrInvalidSampleSize,
+			},
+			{
+				name: "missing randomness",
+				setup: func(components *proofComponents, _ map[uint32]*verifier.ValidatorSet) {
+					components.header.PrevRandSeed = nil
+				},
+				expectedErr: nodesCoordinator.ErrNilRandomness,
+			},
+			{
+				name: "wrong size bitmap",
+				setup: func(components *proofComponents, _ map[uint32]*verifier.ValidatorSet) {
+					components.header.PubKeysBitmap = []byte{0x07, 0x00}
+				},
+				expectedErr: verifier.ErrWrongSizeBitmap,
+			},
+			{
+				name: "leader signature missing",
+				setup: func(components *proofComponents, _ map[uint32]*verifier.ValidatorSet) {
+					components.metaBlock.PubKeysBitmap = []byte{0x06}
+				},
+				expectedErr: verifier.ErrLeaderSignatureMissing,
+			},
+			{
+				name: "not enough signatures",
+				setup: func(components *proofComponents, _ map[uint32]*verifier.ValidatorSet) {
+					components.header.PubKeysBitmap = []byte{0x03}
+				},
+				expectedErr: verifier.ErrNotEnoughSignatures,
+			},
+		}
+
+		for _, tc := range testCases {
+			components := createProofComponents(createTxBytes(t))
+			validators := createValidators()
+			tc.setup(components, validators)
+			pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+
+			verified, err := pv.VerifyTransactionProof(components.toProof(t), validators)
+			assert.Nil(t, verified, tc.name)
+			assert.True(t, errors.Is(err, tc.expectedErr), tc.name)
+		}
+
+		args := createMockArgsProofVerifier()
+		args.MultiSigVerifier = &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				return expectedErr
+			},
+		}
+		pv, _ := verifier.NewProofVerifier(args)
+
+		verified, err := pv.VerifyTransactionProof(createProofComponents(createTxBytes(t)).toProof(t), createValidators())
+		assert.Nil(t, verified)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+}
+
+func TestProofVerifier_VerifyReceiptProof(t *testing.T) {
+	t.Parallel()
+
+	txProof := createProofComponents(createTxBytes(t)).toProof(t)
+	createResultProof := func(originalTxHash []byte) *receiptProof.TransactionProof {
+		scrBytes, err := testMarshaller.Marshal(&smartContractResult.SmartContractResult{
+			Value:          big.NewInt(1),
+			OriginalTxHash: originalTxHash,
+		})
+		require.Nil(t, err)
+
+		components := createProofComponents(scrBytes)
+		components.miniblock.Type = block.SmartContractResultBlock
+		components.header.Nonce = 6
+
+		return components.toProof(t)
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+		resultProof := createResultProof(txProof.TxHash)
+
+		verified, err := pv.VerifyReceiptProof(&receiptProof.ReceiptProof{
+			Transaction: txProof,
+			Results:     []*receiptProof.TransactionProof{resultProof},
+		}, createValidators())
+		require.Nil(t, err)
+		assert.Equal(t, txProof.TxHash, verified.Transaction.TxHash)
+		require.Len(t, verified.Results, 1)
+		assert.Equal(t, resultProof.TxHash, verified.Results[0].TxHash)
+		assert.Equal(t, uint64(6), verified.Results[0].HeaderNonce)
+	})
+
+	t.Run("result of another transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+
+		verified, err := pv.VerifyReceiptProof(&receiptProof.ReceiptProof{
+			Transaction: txProof,
+			Results:     []*receiptProof.TransactionProof{createResultProof([]byte("other tx"))},
+		}, createValidators())
+		require.Nil(t, verified)
+		require.True(t, errors.Is(err, verifier.ErrResultNotLinkedToTransaction))
+	})
+
+	t.Run("nil proof", func(t *testing.T) {
+		t.Parallel()
+
+		pv, _ := verifier.NewProofVerifier(createMockArgsProofVerifier())
+
+		verified, err := pv.VerifyReceiptProof(nil, createValidators())
+		require.Nil(t, verified)
+		require.Equal(t, verifier.ErrNilProof, err)
+	})
+}
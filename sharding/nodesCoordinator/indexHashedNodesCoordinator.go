@@ -382,7 +382,6 @@ func (ihnc *indexHashedNodesCoordinator) ComputeConsensusGroup(
 	}
 
 	consensusSize := ihnc.ConsensusGroupSize(shardID)
-	randomness = []byte(fmt.Sprintf("%d-%s", round, randomness))
 
 	log.Debug("computeValidatorsGroup",
 		"randomness", randomness,
@@ -392,7 +391,7 @@ func (ihnc *indexHashedNodesCoordinator) ComputeConsensusGroup(
 		"round", round,
 		"shardID", shardID)
 
-	tempList, err := selectValidators(selector, randomness, uint32(consensusSize), eligibleList)
+	tempList, err := SelectConsensusGroup(selector, randomness, round, consensusSize, eligibleList)
 	if err != nil {
 		return nil, err
 	}
@@ -1242,6 +1241,24 @@ func computeActuallyLeaving(
 	}
 }
 
+// SelectConsensusGroup selects the consensus group of the given round out of the eligible list of a shard, using the
+// selector created from the weights of the eligible validators. The group is ordered as expected by the signers bitmaps
+func SelectConsensusGroup(
+	selector RandomSelector,
+	randomness []byte,
+	round uint64,
+	consensusSize int,
+	eligibleList []Validator,
+) ([]Validator, error) {
+	if len(randomness) == 0 {
+		return nil, ErrNilRandomness
+	}
+
+	randomnessForRound := []byte(fmt.Sprintf("%d-%s", round, randomness))
+
+	return selectValidators(selector, randomnessForRound, uint32(consensusSize), eligibleList)
+}
+
 func selectValidators(
 	selector RandomSelector,
 	randomness []byte,
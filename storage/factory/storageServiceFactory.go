@@ -596,9 +596,117 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	err = psf.setUpAddressTransactionsStorer(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
+	err = psf.setUpEventsIndexStorer(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
+	err = psf.setUpBalanceHistoryStorer(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
+func (psf *StorageServiceFactory) setUpAddressTransactionsStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.AddressTransactionsIndexEnabled {
+		return nil
+	}
+
+	// Create the addressTransactions (STATIC) storer
+	addressTransactionsConfig := psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig
+	addressTransactionsDbConfig := GetDBFromConfig(addressTransactionsConfig.DB)
+	addressTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardIDStr, addressTransactionsConfig.DB.FilePath)
+	addressTransactionsCacherConfig := GetCacherFromConfig(addressTransactionsConfig.Cache)
+
+	dbConfigHandlerInstance := NewDBConfigHandler(addressTransactionsConfig.DB)
+	addressTransactionsPersisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return err
+	}
+
+	addressTransactionsUnit, err := storageunit.NewStorageUnitFromConf(
+		addressTransactionsCacherConfig,
+		addressTransactionsDbConfig,
+		addressTransactionsPersisterCreator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AddressTransactionsStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) setUpEventsIndexStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.EventsIndexEnabled {
+		return nil
+	}
+
+	// Create the eventsIndex (STATIC) storer
+	eventsIndexConfig := psf.generalConfig.DbLookupExtensions.EventsIndexStorageConfig
+	eventsIndexDbConfig := GetDBFromConfig(eventsIndexConfig.DB)
+	eventsIndexDbConfig.FilePath = psf.pathManager.PathForStatic(shardIDStr, eventsIndexConfig.DB.FilePath)
+	eventsIndexCacherConfig := GetCacherFromConfig(eventsIndexConfig.Cache)
+
+	dbConfigHandlerInstance := NewDBConfigHandler(eventsIndexConfig.DB)
+	eventsIndexPersisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return err
+	}
+
+	eventsIndexUnit, err := storageunit.NewStorageUnitFromConf(
+		eventsIndexCacherConfig,
+		eventsIndexDbConfig,
+		eventsIndexPersisterCreator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.EventsIndexStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.EventsIndexUnit, eventsIndexUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) setUpBalanceHistoryStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.BalanceHistoryIndexEnabled {
+		return nil
+	}
+
+	// Create the balanceHistory (STATIC) storer
+	balanceHistoryConfig := psf.generalConfig.DbLookupExtensions.BalanceHistoryStorageConfig
+	balanceHistoryDbConfig := GetDBFromConfig(balanceHistoryConfig.DB)
+	balanceHistoryDbConfig.FilePath = psf.pathManager.PathForStatic(shardIDStr, balanceHistoryConfig.DB.FilePath)
+	balanceHistoryCacherConfig := GetCacherFromConfig(balanceHistoryConfig.Cache)
+
+	dbConfigHandlerInstance := NewDBConfigHandler(balanceHistoryConfig.DB)
+	balanceHistoryPersisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return err
+	}
+
+	balanceHistoryUnit, err := storageunit.NewStorageUnitFromConf(
+		balanceHistoryCacherConfig,
+		balanceHistoryDbConfig,
+		balanceHistoryPersisterCreator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.BalanceHistoryStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.BalanceHistoryUnit, balanceHistoryUnit)
+
+	return nil
+}
+
 func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
 	esdtSuppliesUnit, err := psf.createEsdtSuppliesUnit(shardIDStr)
 	if err != nil {
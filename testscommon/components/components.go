@@ -854,6 +854,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["ValidatorToDelegation"] = value
 	gasMap["GetActiveFund"] = value
 	gasMap["FixWaitingListSize"] = value
+	gasMap["ScheduledCallsOps"] = value
 
 	return gasMap
 }
@@ -90,6 +90,7 @@ func GetDefaultNetworkComponents() *mock.NetworkComponentsMock {
 		InputAntiFlood:  &mock.P2PAntifloodHandlerStub{},
 		OutputAntiFlood: &mock.P2PAntifloodHandlerStub{},
 		PeerBlackList:   &mock.PeerBlackListHandlerStub{},
+		PeerReputation:  &testscommon.PeerReputationHandlerStub{},
 	}
 }
 
@@ -156,6 +157,7 @@ func GetDefaultProcessComponents(shardCoordinator sharding.Coordinator) *mock.Pr
 				return &mock.PrivateKeyStub{}
 			},
 		},
-		HardforkTriggerField: &testscommon.HardforkTriggerStub{},
+		HardforkTriggerField:              &testscommon.HardforkTriggerStub{},
+		InterceptorsPriorityLanesInternal: &testscommon.PriorityLanesStub{},
 	}
 }
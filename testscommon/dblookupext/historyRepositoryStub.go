@@ -5,18 +5,24 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, rewardsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTHoldersCalled               func(token string) (*esdtSupply.HoldersESDT, error)
+	GetESDTVolumeCalled                func(token string, epoch uint32) (*esdtSupply.VolumeESDT, error)
+	GetAddressTransactionsCalled       func(address []byte, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error)
+	QueryEventsCalled                  func(query common.EventsQuery) ([]*common.IndexedEvent, error)
+	GetBalanceHistoryCalled            func(address []byte, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -25,13 +31,15 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
+	rewardsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	createdIntraMiniBlocks []*block.MiniBlock,
 	logs []*data.LogData,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, createdIntraMiniBlocks, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, rewardsPool, receipts, createdIntraMiniBlocks, logs)
 	}
 	return nil
 }
@@ -89,6 +97,51 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetESDTHolders -
+func (hp *HistoryRepositoryStub) GetESDTHolders(token string) (*esdtSupply.HoldersESDT, error) {
+	if hp.GetESDTHoldersCalled != nil {
+		return hp.GetESDTHoldersCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetESDTVolume -
+func (hp *HistoryRepositoryStub) GetESDTVolume(token string, epoch uint32) (*esdtSupply.VolumeESDT, error) {
+	if hp.GetESDTVolumeCalled != nil {
+		return hp.GetESDTVolumeCalled(token, epoch)
+	}
+
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (hp *HistoryRepositoryStub) GetAddressTransactions(address []byte, options common.AddressTransactionsQueryOptions) ([]*common.AddressTransaction, error) {
+	if hp.GetAddressTransactionsCalled != nil {
+		return hp.GetAddressTransactionsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// QueryEvents -
+func (hp *HistoryRepositoryStub) QueryEvents(query common.EventsQuery) ([]*common.IndexedEvent, error) {
+	if hp.QueryEventsCalled != nil {
+		return hp.QueryEventsCalled(query)
+	}
+
+	return nil, nil
+}
+
+// GetBalanceHistory -
+func (hp *HistoryRepositoryStub) GetBalanceHistory(address []byte, options common.BalanceHistoryQueryOptions) ([]*common.BalanceChange, error) {
+	if hp.GetBalanceHistoryCalled != nil {
+		return hp.GetBalanceHistoryCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
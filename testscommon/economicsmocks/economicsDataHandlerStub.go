@@ -5,6 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common/governance"
 )
 
 // EconomicsHandlerStub -
@@ -42,6 +43,8 @@ type EconomicsHandlerStub struct {
 	ComputeTxFeeBasedOnGasUsedCalled                    func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int
 	ComputeGasLimitBasedOnBalanceCalled                 func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
 	SetStatusHandlerCalled                              func(statusHandler core.AppStatusHandler) error
+	SetParameterChangesProviderCalled                   func(provider governance.ParameterChangesProvider) error
+	ConfiguredGasLimitsInEpochCalled                    func(epoch uint32) governance.GasLimits
 	ComputeTxFeeInEpochCalled                           func(tx data.TransactionWithFeeHandler, epoch uint32) *big.Int
 	ComputeGasLimitInEpochCalled                        func(tx data.TransactionWithFeeHandler, epoch uint32) uint64
 	ComputeGasUsedAndFeeBasedOnRefundValueInEpochCalled func(tx data.TransactionWithFeeHandler, refundValue *big.Int, epoch uint32) (uint64, *big.Int)
@@ -324,6 +327,29 @@ func (e *EconomicsHandlerStub) SetStatusHandler(statusHandler core.AppStatusHand
 	return nil
 }
 
+// SetParameterChangesProvider -
+func (e *EconomicsHandlerStub) SetParameterChangesProvider(provider governance.ParameterChangesProvider) error {
+	if e.SetParameterChangesProviderCalled != nil {
+		return e.SetParameterChangesProviderCalled(provider)
+	}
+	return nil
+}
+
+// ConfiguredGasLimitsInEpoch -
+func (e *EconomicsHandlerStub) ConfiguredGasLimitsInEpoch(epoch uint32) governance.GasLimits {
+	if e.ConfiguredGasLimitsInEpochCalled != nil {
+		return e.ConfiguredGasLimitsInEpochCalled(epoch)
+	}
+	return governance.GasLimits{
+		MinGasLimit:                 e.MinGasLimit(),
+		MaxGasLimitPerBlock:         e.MaxGasLimitPerBlock(0),
+		MaxGasLimitPerMiniBlock:     e.MaxGasLimitPerMiniBlock(0),
+		MaxGasLimitPerMetaBlock:     e.MaxGasLimitPerBlock(core.MetachainShardId),
+		MaxGasLimitPerMetaMiniBlock: e.MaxGasLimitPerMiniBlock(core.MetachainShardId),
+		MaxGasLimitPerTx:            e.MaxGasLimitPerTx(),
+	}
+}
+
 // ComputeTxFeeInEpoch -
 func (e *EconomicsHandlerStub) ComputeTxFeeInEpoch(tx data.TransactionWithFeeHandler, epoch uint32) *big.Int {
 	if e.ComputeTxFeeInEpochCalled != nil {
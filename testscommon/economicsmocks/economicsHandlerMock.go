@@ -5,6 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common/governance"
 )
 
 // EconomicsHandlerMock -
@@ -42,6 +43,8 @@ type EconomicsHandlerMock struct {
 	ComputeTxFeeBasedOnGasUsedCalled                    func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int
 	ComputeGasLimitBasedOnBalanceCalled                 func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
 	SetStatusHandlerCalled                              func(statusHandler core.AppStatusHandler) error
+	SetParameterChangesProviderCalled                   func(provider governance.ParameterChangesProvider) error
+	ConfiguredGasLimitsInEpochCalled                    func(epoch uint32) governance.GasLimits
 	ComputeTxFeeInEpochCalled                           func(tx data.TransactionWithFeeHandler, epoch uint32) *big.Int
 	ComputeGasLimitInEpochCalled                        func(tx data.TransactionWithFeeHandler, epoch uint32) uint64
 	ComputeGasUsedAndFeeBasedOnRefundValueInEpochCalled func(tx data.TransactionWithFeeHandler, refundValue *big.Int, epoch uint32) (uint64, *big.Int)
@@ -303,6 +306,29 @@ func (ehm *EconomicsHandlerMock) SetStatusHandler(statusHandler core.AppStatusHa
 	return nil
 }
 
+// SetParameterChangesProvider -
+func (ehm *EconomicsHandlerMock) SetParameterChangesProvider(provider governance.ParameterChangesProvider) error {
+	if ehm.SetParameterChangesProviderCalled != nil {
+		return ehm.SetParameterChangesProviderCalled(provider)
+	}
+	return nil
+}
+
+// ConfiguredGasLimitsInEpoch -
+func (ehm *EconomicsHandlerMock) ConfiguredGasLimitsInEpoch(epoch uint32) governance.GasLimits {
+	if ehm.ConfiguredGasLimitsInEpochCalled != nil {
+		return ehm.ConfiguredGasLimitsInEpochCalled(epoch)
+	}
+	return governance.GasLimits{
+		MinGasLimit:                 ehm.MinGasLimit(),
+		MaxGasLimitPerBlock:         ehm.MaxGasLimitPerBlock(0),
+		MaxGasLimitPerMiniBlock:     ehm.MaxGasLimitPerMiniBlock(0),
+		MaxGasLimitPerMetaBlock:     ehm.MaxGasLimitPerBlock(core.MetachainShardId),
+		MaxGasLimitPerMetaMiniBlock: ehm.MaxGasLimitPerMiniBlock(core.MetachainShardId),
+		MaxGasLimitPerTx:            ehm.MaxGasLimitPerTx(),
+	}
+}
+
 // ComputeTxFeeInEpoch -
 func (ehm *EconomicsHandlerMock) ComputeTxFeeInEpoch(tx data.TransactionWithFeeHandler, epoch uint32) *big.Int {
 	if ehm.ComputeTxFeeInEpochCalled != nil {
@@ -3,6 +3,7 @@ package testscommon
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -12,6 +13,7 @@ type EpochStartSystemSCStub struct {
 	ProcessSystemSmartContractCalled func(validatorsInfo state.ShardValidatorsInfoMapHandler, header data.HeaderHandler) error
 	ProcessDelegationRewardsCalled   func(miniBlocks block.MiniBlockSlice, txCache epochStart.TransactionCacher) error
 	ToggleUnStakeUnBondCalled        func(value bool) error
+	ParameterChangesCalled           func() ([]*governance.ParameterChange, error)
 }
 
 // ToggleUnStakeUnBond -
@@ -44,6 +46,14 @@ func (e *EpochStartSystemSCStub) ProcessDelegationRewards(
 	return nil
 }
 
+// ParameterChanges -
+func (e *EpochStartSystemSCStub) ParameterChanges() ([]*governance.ParameterChange, error) {
+	if e.ParameterChangesCalled != nil {
+		return e.ParameterChangesCalled()
+	}
+	return make([]*governance.ParameterChange, 0), nil
+}
+
 // IsInterfaceNil -
 func (e *EpochStartSystemSCStub) IsInterfaceNil() bool {
 	return e == nil
@@ -72,6 +72,10 @@ func (mock *keysHandlerSingleSignerMock) GetRedundancyStepInReason() string {
 	return ""
 }
 
+// SetConsensusGroup -
+func (mock *keysHandlerSingleSignerMock) SetConsensusGroup(_ []string) {
+}
+
 // IsInterfaceNil -
 func (mock *keysHandlerSingleSignerMock) IsInterfaceNil() bool {
 	return mock == nil
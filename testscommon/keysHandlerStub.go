@@ -16,6 +16,8 @@ type KeysHandlerStub struct {
 	IsOriginalPublicKeyOfTheNodeCalled           func(pkBytes []byte) bool
 	ResetRoundsWithoutReceivedMessagesCalled     func(pkBytes []byte, pid core.PeerID)
 	GetRedundancyStepInReasonCalled              func() string
+	SetConsensusGroupCalled                      func(consensusGroup []string)
+	IsKeyInCurrentConsensusCalled                func(pkBytes []byte) bool
 }
 
 // GetHandledPrivateKey -
@@ -86,6 +88,22 @@ func (stub *KeysHandlerStub) GetRedundancyStepInReason() string {
 	return ""
 }
 
+// SetConsensusGroup -
+func (stub *KeysHandlerStub) SetConsensusGroup(consensusGroup []string) {
+	if stub.SetConsensusGroupCalled != nil {
+		stub.SetConsensusGroupCalled(consensusGroup)
+	}
+}
+
+// IsKeyInCurrentConsensus -
+func (stub *KeysHandlerStub) IsKeyInCurrentConsensus(pkBytes []byte) bool {
+	if stub.IsKeyInCurrentConsensusCalled != nil {
+		return stub.IsKeyInCurrentConsensusCalled(pkBytes)
+	}
+
+	return false
+}
+
 // IsInterfaceNil -
 func (stub *KeysHandlerStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,52 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// ManagedKeysReloaderStub -
+type ManagedKeysReloaderStub struct {
+	AddManagedKeyCalled    func(privateKeyBytes []byte) (string, error)
+	RemoveManagedKeyCalled func(pkBytes []byte) error
+	ReloadFromFileCalled   func() (*common.ManagedKeysReloadResult, error)
+	CloseCalled            func() error
+}
+
+// AddManagedKey -
+func (stub *ManagedKeysReloaderStub) AddManagedKey(privateKeyBytes []byte) (string, error) {
+	if stub.AddManagedKeyCalled != nil {
+		return stub.AddManagedKeyCalled(privateKeyBytes)
+	}
+
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (stub *ManagedKeysReloaderStub) RemoveManagedKey(pkBytes []byte) error {
+	if stub.RemoveManagedKeyCalled != nil {
+		return stub.RemoveManagedKeyCalled(pkBytes)
+	}
+
+	return nil
+}
+
+// ReloadFromFile -
+func (stub *ManagedKeysReloaderStub) ReloadFromFile() (*common.ManagedKeysReloadResult, error) {
+	if stub.ReloadFromFileCalled != nil {
+		return stub.ReloadFromFileCalled()
+	}
+
+	return &common.ManagedKeysReloadResult{}, nil
+}
+
+// Close -
+func (stub *ManagedKeysReloaderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ManagedKeysReloaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
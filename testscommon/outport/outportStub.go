@@ -2,15 +2,18 @@ package outport
 
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport"
 )
 
 // OutportStub is a mock implementation fot the OutportHandler interface
 type OutportStub struct {
-	SaveBlockCalled             func(args *outportcore.OutportBlockWithHeaderAndBody) error
-	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating)
-	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
-	HasDriversCalled            func() bool
+	SaveBlockCalled                   func(args *outportcore.OutportBlockWithHeaderAndBody) error
+	SaveValidatorsRatingCalled        func(validatorsRating *outportcore.ValidatorsRating)
+	SaveValidatorsPubKeysCalled       func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
+	SaveValidatorsStatusChangesCalled func(statusChanges *common.ValidatorsStatusChanges)
+	HasDriversCalled                  func() bool
+	FinalizedBlockCalled              func(finalizedBlock *outportcore.FinalizedBlock)
 }
 
 // SaveBlock -
@@ -36,6 +39,13 @@ func (as *OutportStub) SaveValidatorsPubKeys(validatorsPubKeys *outportcore.Vali
 	}
 }
 
+// SaveValidatorsStatusChanges -
+func (as *OutportStub) SaveValidatorsStatusChanges(statusChanges *common.ValidatorsStatusChanges) {
+	if as.SaveValidatorsStatusChangesCalled != nil {
+		as.SaveValidatorsStatusChangesCalled(statusChanges)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (as *OutportStub) IsInterfaceNil() bool {
 	return as == nil
@@ -75,5 +85,8 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 }
 
 // FinalizedBlock -
-func (as *OutportStub) FinalizedBlock(_ *outportcore.FinalizedBlock) {
+func (as *OutportStub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) {
+	if as.FinalizedBlockCalled != nil {
+		as.FinalizedBlockCalled(finalizedBlock)
+	}
 }
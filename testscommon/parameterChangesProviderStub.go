@@ -0,0 +1,30 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common/governance"
+
+// ParameterChangesProviderStub -
+type ParameterChangesProviderStub struct {
+	ParameterChangesCalled func() ([]*governance.ParameterChange, error)
+	RegisterHandlerCalled  func(handler governance.ParameterChangesHandler)
+}
+
+// ParameterChanges -
+func (stub *ParameterChangesProviderStub) ParameterChanges() ([]*governance.ParameterChange, error) {
+	if stub.ParameterChangesCalled != nil {
+		return stub.ParameterChangesCalled()
+	}
+
+	return make([]*governance.ParameterChange, 0), nil
+}
+
+// RegisterHandler -
+func (stub *ParameterChangesProviderStub) RegisterHandler(handler governance.ParameterChangesHandler) {
+	if stub.RegisterHandlerCalled != nil {
+		stub.RegisterHandlerCalled(handler)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ParameterChangesProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,28 @@
+package testscommon
+
+// PeerHonestyScoresHandlerStub -
+type PeerHonestyScoresHandlerStub struct {
+	GetAllScoresCalled func() map[string]map[string]float64
+	SetScoresCalled    func(pk string, scoresByTopic map[string]float64)
+}
+
+// GetAllScores -
+func (stub *PeerHonestyScoresHandlerStub) GetAllScores() map[string]map[string]float64 {
+	if stub.GetAllScoresCalled != nil {
+		return stub.GetAllScoresCalled()
+	}
+
+	return make(map[string]map[string]float64)
+}
+
+// SetScores -
+func (stub *PeerHonestyScoresHandlerStub) SetScores(pk string, scoresByTopic map[string]float64) {
+	if stub.SetScoresCalled != nil {
+		stub.SetScoresCalled(pk, scoresByTopic)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PeerHonestyScoresHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
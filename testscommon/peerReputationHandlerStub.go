@@ -0,0 +1,129 @@
+package testscommon
+
+import (
+	"net"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	GetStateCalled              func() common.PeerReputationState
+	BanPeerCalled               func(pid core.PeerID, duration time.Duration) error
+	UnbanPeerCalled             func(pid core.PeerID) error
+	BanIPRangeCalled            func(ipRange string, duration time.Duration) error
+	UnbanIPRangeCalled          func(ipRange string) error
+	PinPeerCalled               func(pid core.PeerID) error
+	UnpinPeerCalled             func(pid core.PeerID) error
+	IsPinnedCalled              func(pid core.PeerID) bool
+	IsIPBannedCalled            func(ip net.IP) bool
+	SetPeerHonestyHandlerCalled func(handler process.PeerHonestyScoresHandler) error
+	CloseCalled                 func() error
+}
+
+// GetState -
+func (stub *PeerReputationHandlerStub) GetState() common.PeerReputationState {
+	if stub.GetStateCalled != nil {
+		return stub.GetStateCalled()
+	}
+
+	return common.PeerReputationState{}
+}
+
+// BanPeer -
+func (stub *PeerReputationHandlerStub) BanPeer(pid core.PeerID, duration time.Duration) error {
+	if stub.BanPeerCalled != nil {
+		return stub.BanPeerCalled(pid, duration)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (stub *PeerReputationHandlerStub) UnbanPeer(pid core.PeerID) error {
+	if stub.UnbanPeerCalled != nil {
+		return stub.UnbanPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// BanIPRange -
+func (stub *PeerReputationHandlerStub) BanIPRange(ipRange string, duration time.Duration) error {
+	if stub.BanIPRangeCalled != nil {
+		return stub.BanIPRangeCalled(ipRange, duration)
+	}
+
+	return nil
+}
+
+// UnbanIPRange -
+func (stub *PeerReputationHandlerStub) UnbanIPRange(ipRange string) error {
+	if stub.UnbanIPRangeCalled != nil {
+		return stub.UnbanIPRangeCalled(ipRange)
+	}
+
+	return nil
+}
+
+// PinPeer -
+func (stub *PeerReputationHandlerStub) PinPeer(pid core.PeerID) error {
+	if stub.PinPeerCalled != nil {
+		return stub.PinPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// UnpinPeer -
+func (stub *PeerReputationHandlerStub) UnpinPeer(pid core.PeerID) error {
+	if stub.UnpinPeerCalled != nil {
+		return stub.UnpinPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// IsPinned -
+func (stub *PeerReputationHandlerStub) IsPinned(pid core.PeerID) bool {
+	if stub.IsPinnedCalled != nil {
+		return stub.IsPinnedCalled(pid)
+	}
+
+	return false
+}
+
+// IsIPBanned -
+func (stub *PeerReputationHandlerStub) IsIPBanned(ip net.IP) bool {
+	if stub.IsIPBannedCalled != nil {
+		return stub.IsIPBannedCalled(ip)
+	}
+
+	return false
+}
+
+// SetPeerHonestyHandler -
+func (stub *PeerReputationHandlerStub) SetPeerHonestyHandler(handler process.PeerHonestyScoresHandler) error {
+	if stub.SetPeerHonestyHandlerCalled != nil {
+		return stub.SetPeerHonestyHandlerCalled(handler)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *PeerReputationHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,45 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
+)
+
+// PriorityLanesStub -
+type PriorityLanesStub struct {
+	ThrottlerCalled func(topic string) process.InterceptorThrottler
+	SchedulerCalled func(topic string) process.InterceptedDataScheduler
+	CloseCalled     func() error
+}
+
+// Throttler -
+func (stub *PriorityLanesStub) Throttler(topic string) process.InterceptorThrottler {
+	if stub.ThrottlerCalled != nil {
+		return stub.ThrottlerCalled(topic)
+	}
+
+	return nil
+}
+
+// Scheduler -
+func (stub *PriorityLanesStub) Scheduler(topic string) process.InterceptedDataScheduler {
+	if stub.SchedulerCalled != nil {
+		return stub.SchedulerCalled(topic)
+	}
+
+	return lanes.NewGoRoutineScheduler()
+}
+
+// Close -
+func (stub *PriorityLanesStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PriorityLanesStub) IsInterfaceNil() bool {
+	return stub == nil
+}
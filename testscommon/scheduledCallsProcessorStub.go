@@ -0,0 +1,21 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-core-go/data"
+
+// ScheduledCallsProcessorStub -
+type ScheduledCallsProcessorStub struct {
+	ProcessDueCallsCalled func(header data.HeaderHandler) error
+}
+
+// ProcessDueCalls -
+func (stub *ScheduledCallsProcessorStub) ProcessDueCalls(header data.HeaderHandler) error {
+	if stub.ProcessDueCallsCalled != nil {
+		return stub.ProcessDueCallsCalled(header)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ScheduledCallsProcessorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
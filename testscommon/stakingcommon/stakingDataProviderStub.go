@@ -18,6 +18,7 @@ type StakingDataProviderStub struct {
 	ComputeUnQualifiedNodesCalled         func(validatorInfos state.ShardValidatorsInfoMapHandler) ([][]byte, map[string][][]byte, error)
 	GetBlsKeyOwnerCalled                  func(blsKey []byte) (string, error)
 	GetOwnersDataCalled                   func() map[string]*epochStart.OwnerData
+	SimulateOwnerAuctionChangesCalled     func(owner []byte, additionalTopUp *big.Int, additionalNodes []state.ValidatorInfoHandler) error
 }
 
 // FillValidatorInfo -
@@ -105,6 +106,14 @@ func (sdps *StakingDataProviderStub) GetOwnersData() map[string]*epochStart.Owne
 	return nil
 }
 
+// SimulateOwnerAuctionChanges -
+func (sdps *StakingDataProviderStub) SimulateOwnerAuctionChanges(owner []byte, additionalTopUp *big.Int, additionalNodes []state.ValidatorInfoHandler) error {
+	if sdps.SimulateOwnerAuctionChangesCalled != nil {
+		return sdps.SimulateOwnerAuctionChangesCalled(owner, additionalTopUp, additionalNodes)
+	}
+	return nil
+}
+
 // EpochConfirmed -
 func (sdps *StakingDataProviderStub) EpochConfirmed(uint32, uint64) {
 }
@@ -7,9 +7,11 @@ import (
 
 // ValidatorsProviderStub -
 type ValidatorsProviderStub struct {
-	GetLatestValidatorsCalled func() map[string]*validator.ValidatorStatistics
-	GetAuctionListCalled      func() ([]*common.AuctionListValidatorAPIResponse, error)
-	ForceUpdateCalled         func() error
+	GetLatestValidatorsCalled   func() map[string]*validator.ValidatorStatistics
+	GetAuctionListCalled        func() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionCalled       func(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error)
+	GetValidatorScorecardCalled func(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error)
+	ForceUpdateCalled           func() error
 }
 
 // GetLatestValidators -
@@ -30,6 +32,24 @@ func (vp *ValidatorsProviderStub) GetAuctionList() ([]*common.AuctionListValidat
 	return nil, nil
 }
 
+// SimulateAuction -
+func (vp *ValidatorsProviderStub) SimulateAuction(args *common.AuctionSimulationArgs) (*common.AuctionSimulationAPIResponse, error) {
+	if vp.SimulateAuctionCalled != nil {
+		return vp.SimulateAuctionCalled(args)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorScorecard -
+func (vp *ValidatorsProviderStub) GetValidatorScorecard(blsKey string, numEpochs uint32) (*common.ValidatorScorecard, error) {
+	if vp.GetValidatorScorecardCalled != nil {
+		return vp.GetValidatorScorecardCalled(blsKey, numEpochs)
+	}
+
+	return nil, nil
+}
+
 // ForceUpdate -
 func (vp *ValidatorsProviderStub) ForceUpdate() error {
 	if vp.ForceUpdateCalled != nil {
@@ -0,0 +1,40 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// ValidatorsStatusChangesCollectorStub -
+type ValidatorsStatusChangesCollectorStub struct {
+	SetPreviousStatusCalled    func(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32)
+	ComputeStatusChangesCalled func(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32)
+	GetStatusChangesCalled     func(epoch uint32) []*common.ValidatorStatusChange
+}
+
+// SetPreviousStatus -
+func (stub *ValidatorsStatusChangesCollectorStub) SetPreviousStatus(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32) {
+	if stub.SetPreviousStatusCalled != nil {
+		stub.SetPreviousStatusCalled(validatorsInfo, epoch)
+	}
+}
+
+// ComputeStatusChanges -
+func (stub *ValidatorsStatusChangesCollectorStub) ComputeStatusChanges(validatorsInfo state.ShardValidatorsInfoMapHandler, epoch uint32) {
+	if stub.ComputeStatusChangesCalled != nil {
+		stub.ComputeStatusChangesCalled(validatorsInfo, epoch)
+	}
+}
+
+// GetStatusChanges -
+func (stub *ValidatorsStatusChangesCollectorStub) GetStatusChanges(epoch uint32) []*common.ValidatorStatusChange {
+	if stub.GetStatusChangesCalled != nil {
+		return stub.GetStatusChangesCalled(epoch)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ValidatorsStatusChangesCollectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	interceptorFactory "github.com/multiversx/mx-chain-go/process/interceptors/factory"
+	"github.com/multiversx/mx-chain-go/process/interceptors/lanes"
 	"github.com/multiversx/mx-chain-go/process/interceptors/processor"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/sharding"
@@ -41,6 +42,7 @@ type fullSyncInterceptorsContainerFactory struct {
 	blockBlackList         process.TimeCacher
 	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
 	globalThrottler        process.InterceptorThrottler
+	scheduler              process.InterceptedDataScheduler
 	maxTxNonceDeltaAllowed int
 	addressPubkeyConv      core.PubkeyConverter
 	whiteListHandler       update.WhiteListHandler
@@ -166,6 +168,7 @@ func NewFullSyncInterceptorsContainerFactory(
 		//TODO: inject the real peers holder once we have the peers mapping before epoch bootstrap finishes
 		preferredPeersHolder: disabled.NewPreferredPeersHolder(),
 		nodeOperationMode:    args.NodeOperationMode,
+		scheduler:            lanes.NewGoRoutineScheduler(),
 	}
 
 	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
@@ -355,6 +358,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneShardHeaderIntercepto
 			DataFactory:      hdrFactory,
 			Processor:        hdrProcessor,
 			Throttler:        ficf.globalThrottler,
+			Scheduler:        ficf.scheduler,
 			AntifloodHandler: ficf.antifloodHandler,
 			WhiteListRequest: ficf.whiteListHandler,
 			CurrentPeerId:    ficf.mainMessenger.ID(),
@@ -558,6 +562,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTxInterceptor(topic s
 			DataFactory:          txFactory,
 			Processor:            txProcessor,
 			Throttler:            ficf.globalThrottler,
+			Scheduler:            ficf.scheduler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.mainMessenger.ID(),
@@ -593,6 +598,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneUnsignedTxInterceptor
 			DataFactory:          txFactory,
 			Processor:            txProcessor,
 			Throttler:            ficf.globalThrottler,
+			Scheduler:            ficf.scheduler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.mainMessenger.ID(),
@@ -628,6 +634,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneRewardTxInterceptor(t
 			DataFactory:          txFactory,
 			Processor:            txProcessor,
 			Throttler:            ficf.globalThrottler,
+			Scheduler:            ficf.scheduler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.mainMessenger.ID(),
@@ -700,6 +707,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneMiniBlocksInterceptor
 			DataFactory:          txFactory,
 			Processor:            txBlockBodyProcessor,
 			Throttler:            ficf.globalThrottler,
+			Scheduler:            ficf.scheduler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.mainMessenger.ID(),
@@ -740,6 +748,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateMetachainHeaderInterce
 			DataFactory:          hdrFactory,
 			Processor:            hdrProcessor,
 			Throttler:            ficf.globalThrottler,
+			Scheduler:            ficf.scheduler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.mainMessenger.ID(),
@@ -776,6 +785,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTrieNodesInterceptor(
 			DataFactory:          trieNodesFactory,
 			Processor:            trieNodesProcessor,
 			Throttler:            ficf.globalThrottler,
+			Scheduler:            ficf.scheduler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.mainMessenger.ID(),
@@ -277,5 +277,8 @@ var ErrInvalidNodeLimitPercentage = errors.New("invalid node limit percentage")
 // ErrNilNodesCoordinator signals that nil nodes coordinator was provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
 
+// ErrDuplicatedParameterChange signals that a proposal changes the same parameter more than once
+var ErrDuplicatedParameterChange = errors.New("duplicated parameter change")
+
 // ErrWaitingListDisabled signals that waiting list has been disabled, since staking v4 is active
 var ErrWaitingListDisabled = errors.New("waiting list is disabled since staking v4 activation")
@@ -243,6 +243,7 @@ func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract,
 		EnableEpochsHandler:    scf.enableEpochsHandler,
 		UnBondPeriodInEpochs:   scf.systemSCConfig.StakingSystemSCConfig.UnBondPeriodInEpochs,
 		OwnerAddress:           ownerAddress,
+		Economics:              scf.economics,
 	}
 	governance, err := systemSmartContracts.NewGovernanceContract(argsGovernance)
 	return governance, err
@@ -267,6 +268,7 @@ func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract,
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		AddTokensAddress:       addTokensAddress,
+		ESDTSCAddress:          vm.ESDTSCAddress,
 		EnableEpochsHandler:    scf.enableEpochsHandler,
 	}
 	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
@@ -295,6 +297,18 @@ func (scf *systemSCFactory) createDelegationManagerContract() (vm.SystemSmartCon
 	return delegationManager, err
 }
 
+func (scf *systemSCFactory) createScheduledCallsContract() (vm.SystemSmartContract, error) {
+	argsScheduledCalls := systemSmartContracts.ArgsNewScheduledCallsContract{
+		Eei:                     scf.systemEI,
+		GasCost:                 scf.gasCost,
+		ScheduledCallsSCAddress: vm.ScheduledCallsSCAddress,
+		Marshalizer:             scf.marshalizer,
+		EnableEpochsHandler:     scf.enableEpochsHandler,
+	}
+	scheduledCalls, err := systemSmartContracts.NewScheduledCallsContract(argsScheduledCalls)
+	return scheduledCalls, err
+}
+
 // CreateForGenesis instantiates all the system smart contracts and returns a container containing them to be used in the genesis process
 func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
 	staking, err := scf.createStakingContract()
@@ -372,6 +386,16 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	scheduledCalls, err := scf.createScheduledCallsContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.ScheduledCallsSCAddress, scheduledCalls)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
 	if err != nil {
 		return nil, err
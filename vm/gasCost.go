@@ -36,6 +36,7 @@ type MetaChainSystemSCsCost struct {
 	GetAllNodeStates      uint64
 	GetActiveFund         uint64
 	FixWaitingListSize    uint64
+	ScheduledCallsOps     uint64
 }
 
 // BuiltInCost defines cost for built-in methods
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -68,6 +69,7 @@ type SystemEI interface {
 // EconomicsHandler defines the methods to get data from the economics component
 type EconomicsHandler interface {
 	GenesisTotalSupply() *big.Int
+	ConfiguredGasLimitsInEpoch(epoch uint32) governance.GasLimits
 	IsInterfaceNil() bool
 }
 
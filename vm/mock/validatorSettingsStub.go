@@ -1,10 +1,15 @@
 package mock
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/common/governance"
+)
 
 // EconomicsHandlerStub -
 type EconomicsHandlerStub struct {
-	TotalSupplyCalled func() *big.Int
+	TotalSupplyCalled                func() *big.Int
+	ConfiguredGasLimitsInEpochCalled func(epoch uint32) governance.GasLimits
 }
 
 // GenesisTotalSupply -
@@ -15,6 +20,21 @@ func (v *EconomicsHandlerStub) GenesisTotalSupply() *big.Int {
 	return big.NewInt(100000000000)
 }
 
+// ConfiguredGasLimitsInEpoch -
+func (v *EconomicsHandlerStub) ConfiguredGasLimitsInEpoch(epoch uint32) governance.GasLimits {
+	if v.ConfiguredGasLimitsInEpochCalled != nil {
+		return v.ConfiguredGasLimitsInEpochCalled(epoch)
+	}
+	return governance.GasLimits{
+		MinGasLimit:                 50000,
+		MaxGasLimitPerBlock:         1500000000,
+		MaxGasLimitPerMiniBlock:     1500000000,
+		MaxGasLimitPerMetaBlock:     15000000000,
+		MaxGasLimitPerMetaMiniBlock: 15000000000,
+		MaxGasLimitPerTx:            1500000000,
+	}
+}
+
 // IsInterfaceNil -
 func (v *EconomicsHandlerStub) IsInterfaceNil() bool {
 	return v == nil
@@ -53,6 +53,7 @@ type delegation struct {
 	endOfEpochAddr         []byte
 	governanceSCAddr       []byte
 	addTokensAddr          []byte
+	esdtSCAddr             []byte
 	gasCost                vm.GasCost
 	marshalizer            marshal.Marshalizer
 	minServiceFee          uint64
@@ -77,6 +78,7 @@ type ArgsNewDelegation struct {
 	EndOfEpochAddress      []byte
 	GovernanceSCAddress    []byte
 	AddTokensAddress       []byte
+	ESDTSCAddress          []byte
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
 	EnableEpochsHandler    common.EnableEpochsHandler
@@ -114,6 +116,9 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 	if len(args.AddTokensAddress) < 1 {
 		return nil, fmt.Errorf("%w for add tokens address", vm.ErrInvalidAddress)
 	}
+	if len(args.ESDTSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for esdt sc address", vm.ErrInvalidAddress)
+	}
 	if check.IfNil(args.EnableEpochsHandler) {
 		return nil, vm.ErrNilEnableEpochsHandler
 	}
@@ -128,6 +133,7 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		common.StakingV2FlagAfterEpoch,
 		common.FixDelegationChangeOwnerOnAccountFlag,
 		common.MultiClaimOnDelegationFlag,
+		common.DelegationReceiptTokensFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -147,6 +153,7 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		endOfEpochAddr:         args.EndOfEpochAddress,
 		governanceSCAddr:       args.GovernanceSCAddress,
 		addTokensAddr:          args.AddTokensAddress,
+		esdtSCAddr:             args.ESDTSCAddress,
 		enableEpochsHandler:    args.EnableEpochsHandler,
 	}
 
@@ -187,7 +194,10 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return vmcommon.UserError
 	}
 
-	if len(args.ESDTTransfers) > 0 {
+	if d.isReceiptTokenTransfer(args) {
+		args = receiptTokenTransferToCall(args)
+	}
+	if len(args.ESDTTransfers) > 0 && !d.isUnDelegateWithReceipt(args) {
 		d.eei.AddReturnMessage("cannot transfer ESDT to system SCs")
 		return vmcommon.UserError
 	}
@@ -285,6 +295,10 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.changeOwner(args)
 	case "synchronizeOwner":
 		return d.synchronizeOwner(args)
+	case enableReceiptTokens:
+		return d.enableReceiptTokens(args)
+	case "getReceiptTokenData":
+		return d.getReceiptTokenData(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + " is an unknown function")
@@ -1654,6 +1668,10 @@ func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return vmcommon.UserError
 	}
 
+	if d.isReceiptTokenEnabled() && !d.isOwner(args.CallerAddr) {
+		return d.delegateWithReceipt(args, dStatus)
+	}
+
 	return d.delegateUser(args, args.CallValue, args.CallValue, args.CallerAddr, dStatus)
 }
 
@@ -1719,6 +1737,10 @@ func (d *delegation) checkOwnerCanUnDelegate(address []byte, activeFund *Fund, v
 }
 
 func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.ESDTTransfers) > 0 {
+		return d.unDelegateWithReceipt(args)
+	}
+
 	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
@@ -0,0 +1,468 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const enableReceiptTokens = "enableReceiptTokens"
+const delegateWithReceipt = "delegateWithReceipt"
+const unDelegateWithReceipt = "unDelegateWithReceipt"
+const receiptTokenKey = "receiptToken"
+const receiptTokenSupplyKey = "receiptTokenSupply"
+const receiptTokenType = "FNG"
+
+// The receipt tokens mode keeps the stake of all receipt holders in a single pooled position, saved as a regular delegator
+// under the address of the delegation contract itself. The rewards of the pool are re-delegated on every receipt operation,
+// so they accrue to the exchange rate between the receipt token and the pooled stake.
+
+func (d *delegation) isReceiptTokenEnabled() bool {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationReceiptTokensFlag) {
+		return false
+	}
+
+	return len(d.eei.GetStorage([]byte(receiptTokenKey))) > 0
+}
+
+func (d *delegation) isUnDelegateWithReceipt(args *vmcommon.ContractCallInput) bool {
+	return d.enableEpochsHandler.IsFlagEnabled(common.DelegationReceiptTokensFlag) && args.Function == "unDelegate"
+}
+
+// the metachain executes the ESDT transfers towards the system smart contracts as regular calls of the built in function,
+// so the receipt tokens reach the contract as ESDTTransfer@token@value@function without being credited to its account
+func (d *delegation) isReceiptTokenTransfer(args *vmcommon.ContractCallInput) bool {
+	return d.enableEpochsHandler.IsFlagEnabled(common.DelegationReceiptTokensFlag) &&
+		args.Function == core.BuiltInFunctionESDTTransfer &&
+		len(args.Arguments) > core.MinLenArgumentsESDTTransfer
+}
+
+func receiptTokenTransferToCall(args *vmcommon.ContractCallInput) *vmcommon.ContractCallInput {
+	callInput := *args
+	callInput.Function = string(args.Arguments[core.MinLenArgumentsESDTTransfer])
+	callInput.Arguments = args.Arguments[core.MinLenArgumentsESDTTransfer+1:]
+	callInput.ESDTTransfers = []*vmcommon.ESDTTransfer{
+		{
+			ESDTTokenName: args.Arguments[0],
+			ESDTValue:     big.NewInt(0).SetBytes(args.Arguments[1]),
+			ESDTTokenType: uint32(core.Fungible),
+		},
+	}
+
+	return &callInput
+}
+
+// format: enableReceiptTokens@tokenName@tokenTicker@numOfDecimals, the call value must be equal with the ESDT issue cost
+func (d *delegation) enableReceiptTokens(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationReceiptTokensFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if !d.isOwner(args.CallerAddr) {
+		d.eei.AddReturnMessage("only owner can call this method")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(d.eei.GetStorage([]byte(receiptTokenKey))) > 0 {
+		d.eei.AddReturnMessage("receipt tokens are already enabled")
+		return vmcommon.UserError
+	}
+
+	registerCall := "registerAndSetAllRoles@" + hex.EncodeToString(args.Arguments[0]) +
+		"@" + hex.EncodeToString(args.Arguments[1]) +
+		"@" + hex.EncodeToString([]byte(receiptTokenType)) +
+		"@" + hex.EncodeToString(args.Arguments[2])
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.esdtSCAddr, args.RecipientAddr, args.CallValue, []byte(registerCall))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+	if len(vmOutput.ReturnData) == 0 {
+		d.eei.AddReturnMessage("esdt system SC did not return the receipt token identifier")
+		return vmcommon.UserError
+	}
+
+	tokenIdentifier := vmOutput.ReturnData[len(vmOutput.ReturnData)-1]
+	d.eei.SetStorage([]byte(receiptTokenKey), tokenIdentifier)
+
+	d.createAndAddLogEntry(args, tokenIdentifier)
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) delegateWithReceipt(args *vmcommon.ContractCallInput, dStatus *DelegationContractStatus) vmcommon.ReturnCode {
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolAddress := args.RecipientAddr
+	isNew, pool, err := d.getOrCreateDelegatorData(poolAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		pool.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+	} else {
+		returnCode := d.compoundReceiptPoolRewards(poolAddress, pool, dConfig, dStatus, globalFund)
+		if returnCode != vmcommon.Ok {
+			return returnCode
+		}
+	}
+
+	poolStake, err := d.getReceiptPoolStake(pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	supply := d.getReceiptTokenSupply()
+
+	// receipt tokens without any pooled stake behind them have no exchange rate, minting 1:1 would dilute their holders
+	if supply.Cmp(zero) > 0 && poolStake.Cmp(zero) == 0 {
+		d.eei.AddReturnMessage("receipt tokens supply is not backed by any pooled stake")
+		return vmcommon.UserError
+	}
+
+	receiptValue := big.NewInt(0).Set(args.CallValue)
+	if supply.Cmp(zero) > 0 {
+		receiptValue.Mul(receiptValue, supply)
+		receiptValue.Div(receiptValue, poolStake)
+	}
+	if receiptValue.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("delegate value is too small for the receipt token exchange rate")
+		return vmcommon.UserError
+	}
+
+	returnCode := d.finishDelegateUser(globalFund, pool, dConfig, dStatus, poolAddress,
+		args.RecipientAddr, args.CallValue, args.CallValue, isNew, true)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	tokenIdentifier := d.eei.GetStorage([]byte(receiptTokenKey))
+	err = d.mintReceiptTokens(args.RecipientAddr, args.CallerAddr, tokenIdentifier, receiptValue)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	supply.Add(supply, receiptValue)
+	d.eei.SetStorage([]byte(receiptTokenSupplyKey), supply.Bytes())
+
+	poolStake.Add(poolStake, args.CallValue)
+	d.createAndAddLogEntryCustom(delegateWithReceipt, args.CallerAddr, args.CallValue.Bytes(), receiptValue.Bytes(), poolStake.Bytes(), supply.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) unDelegateWithReceipt(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 0 {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if !d.isReceiptTokenEnabled() {
+		d.eei.AddReturnMessage("receipt tokens are not enabled")
+		return vmcommon.UserError
+	}
+
+	tokenIdentifier := d.eei.GetStorage([]byte(receiptTokenKey))
+	isValidTransfer := len(args.ESDTTransfers) == 1 &&
+		bytes.Equal(args.ESDTTransfers[0].ESDTTokenName, tokenIdentifier) &&
+		args.ESDTTransfers[0].ESDTTokenNonce == 0 &&
+		args.ESDTTransfers[0].ESDTValue.Cmp(zero) > 0
+	if !isValidTransfer {
+		d.eei.AddReturnMessage("invalid receipt token transfer")
+		return vmcommon.UserError
+	}
+	receiptValue := args.ESDTTransfers[0].ESDTValue
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolAddress := args.RecipientAddr
+	isNew, pool, err := d.getOrCreateDelegatorData(poolAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew || len(pool.ActiveFund) == 0 {
+		d.eei.AddReturnMessage("no stake delegated with receipt tokens")
+		return vmcommon.UserError
+	}
+
+	returnCode := d.compoundReceiptPoolRewards(poolAddress, pool, dConfig, dStatus, globalFund)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	poolFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	supply := d.getReceiptTokenSupply()
+	if supply.Cmp(receiptValue) < 0 {
+		d.eei.AddReturnMessage("receipt token value exceeds the supply")
+		return vmcommon.UserError
+	}
+
+	valueToUnDelegate := big.NewInt(0).Mul(receiptValue, poolFund.Value)
+	valueToUnDelegate.Div(valueToUnDelegate, supply)
+	if valueToUnDelegate.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("receipt token value is too small to undelegate")
+		return vmcommon.UserError
+	}
+
+	delegationManagement, err := getDelegationManagement(d.eei, d.marshalizer, d.delegationMgrSCAddress)
+	if err != nil {
+		d.eei.AddReturnMessage("error getting minimum delegation amount " + err.Error())
+		return vmcommon.UserError
+	}
+	remainedFund := big.NewInt(0).Sub(poolFund.Value, valueToUnDelegate)
+	if remainedFund.Cmp(zero) > 0 && remainedFund.Cmp(delegationManagement.MinDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("invalid value to undelegate - the pooled stake must be undelegated entirely - do not leave dust behind")
+		return vmcommon.UserError
+	}
+
+	isNewDelegator, delegator, err := d.getOrCreateDelegatorData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNewDelegator {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+		dStatus.NumUsers++
+	}
+
+	returnData, returnCode := d.executeOnValidatorSCWithValueInArgs(args.RecipientAddr, "unStakeTokens", valueToUnDelegate)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	actualUserUnStake, err := d.resolveUnStakedUnBondResponse(returnData, valueToUnDelegate)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolFund.Value.Sub(poolFund.Value, actualUserUnStake)
+	err = d.saveFund(pool.ActiveFund, poolFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if poolFund.Value.Cmp(zero) == 0 {
+		pool.ActiveFund = nil
+	}
+
+	err = d.addNewUnStakedFund(args.CallerAddr, delegator, actualUserUnStake)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(delegator.UnStakedFunds) > maxNumOfUnStakedFunds {
+		d.eei.AddReturnMessage("number of unDelegate limit reached, withDraw required")
+		return vmcommon.UserError
+	}
+
+	globalFund.TotalActive.Sub(globalFund.TotalActive, actualUserUnStake)
+	globalFund.TotalUnStaked.Add(globalFund.TotalUnStaked, actualUserUnStake)
+
+	returnCode = d.saveReceiptUnDelegateData(globalFund, dStatus, poolAddress, pool, args.CallerAddr, delegator)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	// the transferred receipt tokens are never credited on the metachain, they leave the circulating supply here, while
+	// a failed call returns them to the sender
+	supply.Sub(supply, receiptValue)
+	d.eei.SetStorage([]byte(receiptTokenSupplyKey), supply.Bytes())
+
+	unDelegateFundKey := delegator.UnStakedFunds[len(delegator.UnStakedFunds)-1]
+	d.createAndAddLogEntryCustom(unDelegateWithReceipt, args.CallerAddr, receiptValue.Bytes(), actualUserUnStake.Bytes(),
+		poolFund.Value.Bytes(), supply.Bytes(), unDelegateFundKey)
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) saveReceiptUnDelegateData(
+	globalFund *GlobalFundData,
+	dStatus *DelegationContractStatus,
+	poolAddress []byte,
+	pool *DelegatorData,
+	delegatorAddress []byte,
+	delegator *DelegatorData,
+) vmcommon.ReturnCode {
+	err := d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.saveDelegationStatus(dStatus)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.saveDelegatorData(poolAddress, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.saveDelegatorData(delegatorAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// compoundReceiptPoolRewards re-delegates the rewards of the receipt tokens pool. The rewards are kept as unclaimed if
+// re-delegating them would leave the pool below the minimum delegation amount or would exceed the delegation cap
+func (d *delegation) compoundReceiptPoolRewards(
+	poolAddress []byte,
+	pool *DelegatorData,
+	dConfig *DelegationConfig,
+	dStatus *DelegationContractStatus,
+	globalFund *GlobalFundData,
+) vmcommon.ReturnCode {
+	err := d.computeAndUpdateRewards(poolAddress, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(pool.ActiveFund) == 0 || pool.UnClaimedRewards.Cmp(zero) <= 0 {
+		return vmcommon.Ok
+	}
+
+	poolFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	delegationManagement, err := getDelegationManagement(d.eei, d.marshalizer, d.delegationMgrSCAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolStakeWithRewards := big.NewInt(0).Add(poolFund.Value, pool.UnClaimedRewards)
+	if poolStakeWithRewards.Cmp(delegationManagement.MinDelegationAmount) < 0 {
+		return vmcommon.Ok
+	}
+	totalActiveWithRewards := big.NewInt(0).Add(globalFund.TotalActive, pool.UnClaimedRewards)
+	withDelegationCap := dConfig.MaxDelegationCap.Cmp(zero) != 0
+	if withDelegationCap && dConfig.CheckCapOnReDelegateRewards && totalActiveWithRewards.Cmp(dConfig.MaxDelegationCap) > 0 {
+		return vmcommon.Ok
+	}
+
+	rewards := big.NewInt(0).Set(pool.UnClaimedRewards)
+	pool.TotalCumulatedRewards.Add(pool.TotalCumulatedRewards, rewards)
+	pool.UnClaimedRewards.SetUint64(0)
+
+	return d.finishDelegateUser(globalFund, pool, dConfig, dStatus, poolAddress, poolAddress, rewards, rewards, false, false)
+}
+
+func (d *delegation) getReceiptPoolStake(pool *DelegatorData) (*big.Int, error) {
+	if len(pool.ActiveFund) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	poolFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).Set(poolFund.Value), nil
+}
+
+func (d *delegation) getReceiptTokenSupply() *big.Int {
+	return big.NewInt(0).SetBytes(d.eei.GetStorage([]byte(receiptTokenSupplyKey)))
+}
+
+func (d *delegation) mintReceiptTokens(scAddress []byte, destination []byte, tokenIdentifier []byte, value *big.Int) error {
+	_, err := d.eei.ProcessBuiltInFunction(scAddress, scAddress, core.BuiltInFunctionESDTLocalMint, [][]byte{tokenIdentifier, value.Bytes()})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.eei.ProcessBuiltInFunction(scAddress, destination, core.BuiltInFunctionESDTTransfer, [][]byte{tokenIdentifier, value.Bytes()})
+
+	return err
+}
+
+func (d *delegation) getReceiptTokenData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationReceiptTokensFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	returnCode := d.checkArgumentsForGeneralViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !d.isReceiptTokenEnabled() {
+		d.eei.AddReturnMessage("receipt tokens are not enabled")
+		return vmcommon.UserError
+	}
+
+	_, pool, err := d.getOrCreateDelegatorData(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	poolStake, err := d.getReceiptPoolStake(pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(d.eei.GetStorage([]byte(receiptTokenKey)))
+	d.eei.Finish(d.getReceiptTokenSupply().Bytes())
+	d.eei.Finish(poolStake.Bytes())
+
+	return vmcommon.Ok
+}
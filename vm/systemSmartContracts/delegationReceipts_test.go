@@ -0,0 +1,400 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var receiptTokenID = []byte("RCPT-abcdef")
+
+type builtInFunctionCall struct {
+	sender      []byte
+	destination []byte
+	function    string
+	arguments   [][]byte
+}
+
+func createDelegationWithReceiptTokens(calls *[]builtInFunctionCall) (*delegation, *vmContext) {
+	args := createMockArgumentsForDelegation()
+	enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.AddActiveFlags(common.DelegationReceiptTokensFlag)
+
+	eei := createDefaultEei()
+	eei.blockChainHook = &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 2
+		},
+		ProcessBuiltInFunctionCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			*calls = append(*calls, builtInFunctionCall{
+				sender:      input.CallerAddr,
+				destination: input.RecipientAddr,
+				function:    input.Function,
+				arguments:   input.Arguments,
+			})
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	d, _ := NewDelegationSystemSC(args)
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+	eei.SetStorage([]byte(receiptTokenKey), receiptTokenID)
+
+	return d, eei
+}
+
+func TestDelegationSystemSC_EnableReceiptTokens(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForDelegation()
+		eei := createDefaultEei()
+		args.Eei = eei
+		d, _ := NewDelegationSystemSC(args)
+
+		vmInput := getDefaultVmInputForFunc(enableReceiptTokens, [][]byte{[]byte("name"), []byte("RCPT"), {18}})
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, enableReceiptTokens+" is an unknown function", eei.returnMessage)
+	})
+	t.Run("not owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+
+		vmInput := getDefaultVmInputForFunc(enableReceiptTokens, [][]byte{[]byte("name"), []byte("RCPT"), {18}})
+		vmInput.CallerAddr = []byte("not owner")
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "only owner can call this method", eei.returnMessage)
+	})
+	t.Run("invalid number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+
+		vmInput := getDefaultVmInputForFunc(enableReceiptTokens, [][]byte{[]byte("name")})
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+		assert.Equal(t, "invalid number of arguments", eei.returnMessage)
+	})
+	t.Run("already enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+
+		vmInput := getDefaultVmInputForFunc(enableReceiptTokens, [][]byte{[]byte("name"), []byte("RCPT"), {18}})
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "receipt tokens are already enabled", eei.returnMessage)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForDelegation()
+		enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+		enableEpochsHandler.AddActiveFlags(common.DelegationReceiptTokensFlag)
+		eei := createDefaultEei()
+		args.Eei = eei
+
+		expectedCall := "registerAndSetAllRoles@" + hex.EncodeToString([]byte("name")) + "@" + hex.EncodeToString([]byte("RCPT")) +
+			"@" + hex.EncodeToString([]byte(receiptTokenType)) + "@12"
+		esdtCalled := false
+		_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			return &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+				esdtCalled = true
+				assert.Equal(t, vm.ESDTSCAddress, key)
+				assert.Equal(t, big.NewInt(50), input.CallValue)
+				assert.Equal(t, []byte("addr"), input.CallerAddr)
+
+				callData := input.Function
+				for _, arg := range input.Arguments {
+					callData += "@" + hex.EncodeToString(arg)
+				}
+				assert.Equal(t, expectedCall, callData)
+
+				eei.Finish(receiptTokenID)
+				return vmcommon.Ok
+			}}, nil
+		}})
+
+		d, _ := NewDelegationSystemSC(args)
+		eei.SetStorage([]byte(ownerKey), []byte("owner"))
+
+		vmInput := getDefaultVmInputForFunc(enableReceiptTokens, [][]byte{[]byte("name"), []byte("RCPT"), {18}})
+		vmInput.CallValue = big.NewInt(50)
+		output := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output)
+		assert.True(t, esdtCalled)
+		assert.Equal(t, receiptTokenID, eei.GetStorage([]byte(receiptTokenKey)))
+		assert.True(t, d.isReceiptTokenEnabled())
+	})
+}
+
+func TestDelegationSystemSC_DelegateWithReceipt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("owner should use the classic delegation", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+
+		eei.SetStorage([]byte(ownerKey), []byte("ownerAddr"))
+		vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+		vmInput.CallerAddr = []byte("ownerAddr")
+		vmInput.CallValue = big.NewInt(20)
+		output := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output, eei.returnMessage)
+		assert.Equal(t, 0, len(calls))
+
+		_, ownerData, _ := d.getOrCreateDelegatorData([]byte("ownerAddr"))
+		assert.NotEqual(t, 0, len(ownerData.ActiveFund))
+		assert.Equal(t, big.NewInt(0), d.getReceiptTokenSupply())
+	})
+	t.Run("should mint receipts proportional with the pool stake", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+
+		vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+		vmInput.CallerAddr = []byte("delegator1")
+		vmInput.CallValue = big.NewInt(100)
+		output := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output)
+
+		require.Equal(t, 2, len(calls))
+		assert.Equal(t, core.BuiltInFunctionESDTLocalMint, calls[0].function)
+		assert.Equal(t, [][]byte{receiptTokenID, big.NewInt(100).Bytes()}, calls[0].arguments)
+		assert.Equal(t, []byte("addr"), calls[0].destination)
+		assert.Equal(t, core.BuiltInFunctionESDTTransfer, calls[1].function)
+		assert.Equal(t, []byte("delegator1"), calls[1].destination)
+		assert.Equal(t, [][]byte{receiptTokenID, big.NewInt(100).Bytes()}, calls[1].arguments)
+
+		_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+		poolFund, _ := d.getFund(pool.ActiveFund)
+		assert.Equal(t, big.NewInt(100), poolFund.Value)
+		assert.Equal(t, big.NewInt(100), d.getReceiptTokenSupply())
+
+		_, delegator, _ := d.getOrCreateDelegatorData([]byte("delegator1"))
+		assert.Equal(t, 0, len(delegator.ActiveFund))
+
+		// the pool stake grows, so one receipt token is worth two staked units
+		poolFund.Value.SetUint64(200)
+		_ = d.saveFund(pool.ActiveFund, poolFund)
+
+		calls = calls[:0]
+		vmInput.CallerAddr = []byte("delegator2")
+		vmInput.CallValue = big.NewInt(50)
+		output = d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output, eei.returnMessage)
+		require.Equal(t, 2, len(calls))
+		assert.Equal(t, [][]byte{receiptTokenID, big.NewInt(25).Bytes()}, calls[1].arguments)
+		assert.Equal(t, big.NewInt(125), d.getReceiptTokenSupply())
+
+		poolFund, _ = d.getFund(pool.ActiveFund)
+		assert.Equal(t, big.NewInt(250), poolFund.Value)
+	})
+	t.Run("supply without pooled stake should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		eei.SetStorage([]byte(receiptTokenSupplyKey), big.NewInt(100).Bytes())
+
+		vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+		vmInput.CallerAddr = []byte("delegator1")
+		vmInput.CallValue = big.NewInt(100)
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "receipt tokens supply is not backed by any pooled stake", eei.returnMessage)
+		assert.Equal(t, 0, len(calls))
+		assert.Equal(t, big.NewInt(100), d.getReceiptTokenSupply())
+	})
+}
+
+func TestDelegationSystemSC_UnDelegateWithReceipt(t *testing.T) {
+	t.Parallel()
+
+	delegateWithReceipts := func(t *testing.T, d *delegation, value int64) {
+		vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+		vmInput.CallerAddr = []byte("delegator1")
+		vmInput.CallValue = big.NewInt(value)
+		output := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output)
+	}
+	createUnDelegateInput := func(token []byte, value int64) *vmcommon.ContractCallInput {
+		vmInput := getDefaultVmInputForFunc("unDelegate", [][]byte{})
+		vmInput.CallerAddr = []byte("delegator1")
+		vmInput.ESDTTransfers = []*vmcommon.ESDTTransfer{
+			{ESDTTokenName: token, ESDTValue: big.NewInt(value)},
+		}
+		return vmInput
+	}
+
+	t.Run("flag not active should reject ESDT transfers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForDelegation()
+		eei := createDefaultEei()
+		args.Eei = eei
+		d, _ := NewDelegationSystemSC(args)
+
+		output := d.Execute(createUnDelegateInput(receiptTokenID, 10))
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "cannot transfer ESDT to system SCs", eei.returnMessage)
+	})
+	t.Run("wrong token should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		delegateWithReceipts(t, d, 100)
+
+		output := d.Execute(createUnDelegateInput([]byte("OTHER-123456"), 10))
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "invalid receipt token transfer", eei.returnMessage)
+	})
+	t.Run("value above the supply should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		delegateWithReceipts(t, d, 100)
+
+		output := d.Execute(createUnDelegateInput(receiptTokenID, 101))
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "receipt token value exceeds the supply", eei.returnMessage)
+	})
+	t.Run("ESDT transfer call without a function should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		delegateWithReceipts(t, d, 100)
+
+		vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTTransfer, [][]byte{receiptTokenID, big.NewInt(10).Bytes()})
+		vmInput.CallerAddr = []byte("delegator1")
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, core.BuiltInFunctionESDTTransfer+" is an unknown function", eei.returnMessage)
+		assert.Equal(t, big.NewInt(100), d.getReceiptTokenSupply())
+	})
+	t.Run("ESDT transfer call of unDelegate should work", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		delegateWithReceipts(t, d, 100)
+
+		vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTTransfer, [][]byte{receiptTokenID, big.NewInt(100).Bytes(), []byte("unDelegate")})
+		vmInput.CallerAddr = []byte("delegator1")
+		output := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output, eei.returnMessage)
+		assert.Equal(t, big.NewInt(0), d.getReceiptTokenSupply())
+
+		_, delegator, _ := d.getOrCreateDelegatorData([]byte("delegator1"))
+		require.Equal(t, 1, len(delegator.UnStakedFunds))
+		unStakedFund, _ := d.getFund(delegator.UnStakedFunds[0])
+		assert.Equal(t, big.NewInt(100), unStakedFund.Value)
+	})
+	t.Run("leaving dust in the pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		delegateWithReceipts(t, d, 100)
+
+		calls = calls[:0]
+		output := d.Execute(createUnDelegateInput(receiptTokenID, 95))
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.Equal(t, "invalid value to undelegate - the pooled stake must be undelegated entirely - do not leave dust behind", eei.returnMessage)
+		assert.Equal(t, 0, len(calls))
+		assert.Equal(t, big.NewInt(100), d.getReceiptTokenSupply())
+	})
+	t.Run("should retire receipts and unStake the proportional value", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []builtInFunctionCall
+		d, eei := createDelegationWithReceiptTokens(&calls)
+		delegateWithReceipts(t, d, 100)
+
+		_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+		poolFund, _ := d.getFund(pool.ActiveFund)
+		poolFund.Value.SetUint64(200)
+		_ = d.saveFund(pool.ActiveFund, poolFund)
+		globalFund, _ := d.getGlobalFundData()
+		globalFund.TotalActive.SetUint64(200)
+		_ = d.saveGlobalFundData(globalFund)
+
+		calls = calls[:0]
+		output := d.Execute(createUnDelegateInput(receiptTokenID, 40))
+		require.Equal(t, vmcommon.Ok, output, eei.returnMessage)
+
+		assert.Equal(t, 0, len(calls))
+		assert.Equal(t, big.NewInt(60), d.getReceiptTokenSupply())
+
+		poolFund, _ = d.getFund(pool.ActiveFund)
+		assert.Equal(t, big.NewInt(120), poolFund.Value)
+
+		_, delegator, _ := d.getOrCreateDelegatorData([]byte("delegator1"))
+		require.Equal(t, 1, len(delegator.UnStakedFunds))
+		unStakedFund, _ := d.getFund(delegator.UnStakedFunds[0])
+		assert.Equal(t, big.NewInt(80), unStakedFund.Value)
+		assert.True(t, bytes.Equal([]byte("delegator1"), unStakedFund.Address))
+
+		globalFund, _ = d.getGlobalFundData()
+		assert.Equal(t, big.NewInt(120), globalFund.TotalActive)
+		assert.Equal(t, big.NewInt(80), globalFund.TotalUnStaked)
+	})
+}
+
+func TestDelegationSystemSC_GetReceiptTokenData(t *testing.T) {
+	t.Parallel()
+
+	var calls []builtInFunctionCall
+	d, eei := createDelegationWithReceiptTokens(&calls)
+
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallerAddr = []byte("delegator1")
+	vmInput.CallValue = big.NewInt(100)
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getReceiptTokenData", [][]byte{})
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 3, len(eei.output))
+	assert.Equal(t, receiptTokenID, eei.output[0])
+	assert.Equal(t, big.NewInt(100).Bytes(), eei.output[1])
+	assert.Equal(t, big.NewInt(100).Bytes(), eei.output[2])
+}
@@ -47,6 +47,7 @@ func createMockArgumentsForDelegation() ArgsNewDelegation {
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		AddTokensAddress:       bytes.Repeat([]byte{1}, 32),
+		ESDTSCAddress:          vm.ESDTSCAddress,
 		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(
 			common.DelegationSmartContractFlag,
 			common.StakingV2FlagAfterEpoch,
@@ -624,7 +624,11 @@ func (host *vmContext) ProcessBuiltInFunction(
 				}
 				host.outputAccounts[address] = leftAccount
 			}
-			leftAccount.OutputTransfers = append(leftAccount.OutputTransfers, outAcc.OutputTransfers...)
+			// the gas left of the system smart contract call is not forwarded with the transfers created by the built in function
+			for _, outTransfer := range outAcc.OutputTransfers {
+				outTransfer.GasLimit = 0
+				leftAccount.OutputTransfers = append(leftAccount.OutputTransfers, outTransfer)
+			}
 		}
 	}
 
@@ -424,3 +424,58 @@ func TestVmContext_UpdateCodeDeployerAddress(t *testing.T) {
 		assert.Equal(t, ownerAddress, vmCtx.outputAccounts[scAddress].CodeDeployerAddress)
 	})
 }
+
+func TestVmContext_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender-address-0")
+	destination := []byte("destination-addr")
+
+	t.Run("built in function error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createDefaultEeiArgs()
+		args.BlockChainHook = &mock.BlockChainHookStub{
+			ProcessBuiltInFunctionCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		vmCtx, _ := NewVMContext(args)
+
+		vmOutput, err := vmCtx.ProcessBuiltInFunction(sender, destination, core.BuiltInFunctionESDTTransfer, nil)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should merge the output transfers without forwarding the gas", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultEeiArgs()
+		args.BlockChainHook = &mock.BlockChainHookStub{
+			ProcessBuiltInFunctionCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				assert.Equal(t, uint64(1000), input.GasProvided)
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						string(destination): {
+							Address: destination,
+							OutputTransfers: []vmcommon.OutputTransfer{
+								{Data: []byte("transfer"), GasLimit: input.GasProvided},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+		vmCtx, _ := NewVMContext(args)
+		vmCtx.SetGasProvided(1000)
+
+		_, err := vmCtx.ProcessBuiltInFunction(sender, destination, core.BuiltInFunctionESDTTransfer, nil)
+		assert.Nil(t, err)
+
+		outAcc := vmCtx.outputAccounts[string(destination)]
+		assert.Equal(t, 1, len(outAcc.OutputTransfers))
+		assert.Equal(t, []byte("transfer"), outAcc.OutputTransfers[0].Data)
+		assert.Equal(t, uint64(0), outAcc.OutputTransfers[0].GasLimit)
+	})
+}
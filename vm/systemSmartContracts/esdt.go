@@ -46,6 +46,8 @@ const upgradeProperties = "upgradeProperties"
 const conversionBase = 10
 const metaESDT = "MetaESDT"
 
+var metachainIdentifier = []byte{255}
+
 type esdt struct {
 	eei                    vm.SystemEI
 	gasCost                vm.GasCost
@@ -101,6 +103,8 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		common.MetaESDTSetFlag,
 		common.ESDTNFTCreateOnMultiShardFlag,
 		common.NFTStopCreateFlag,
+		common.ESDTComplianceFlag,
+		common.DelegationReceiptTokensFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -215,6 +219,18 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.unsetBurnRoleGlobally(args)
 	case "sendAllTransferRoleAddresses":
 		return e.sendAllTransferRoleAddresses(args)
+	case "addToAllowList":
+		return e.changeComplianceList(args, common.ESDTComplianceAllowList, true)
+	case "removeFromAllowList":
+		return e.changeComplianceList(args, common.ESDTComplianceAllowList, false)
+	case "addToDenyList":
+		return e.changeComplianceList(args, common.ESDTComplianceDenyList, true)
+	case "removeFromDenyList":
+		return e.changeComplianceList(args, common.ESDTComplianceDenyList, false)
+	case "setTransferLimit":
+		return e.setTransferLimit(args)
+	case "getComplianceConfig":
+		return e.getComplianceConfigView(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -638,6 +654,10 @@ func (e *esdt) createNewToken(
 		Upgradable:         true,
 		CanAddSpecialRoles: true,
 	}
+	complianceConfig, properties, err := e.extractComplianceProperties(properties)
+	if err != nil {
+		return nil, nil, err
+	}
 	err = e.upgradeProperties(tokenIdentifier, newESDTToken, properties, true, owner)
 	if err != nil {
 		return nil, nil, err
@@ -648,6 +668,9 @@ func (e *esdt) createNewToken(
 	if err != nil {
 		return nil, nil, err
 	}
+	if complianceConfig.HasRestrictions() {
+		e.saveAndSendComplianceConfig(tokenIdentifier, complianceConfig, owner)
+	}
 
 	return tokenIdentifier, newESDTToken, nil
 }
@@ -1716,7 +1739,15 @@ func (e *esdt) prepareAndSendRoleChangeData(
 	if properties.isMultiShardNFTCreateSet {
 		allRoles = append(allRoles, []byte(core.ESDTRoleNFTCreateMultiShard))
 	}
-	e.sendRoleChangeData(tokenID, address, allRoles, core.BuiltInFunctionSetESDTRole)
+	if e.isRoleChangeOnMetachain(address) {
+		err := e.setRolesOnMetachain(tokenID, address, allRoles)
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	} else {
+		e.sendRoleChangeData(tokenID, address, allRoles, core.BuiltInFunctionSetESDTRole)
+	}
 
 	isTransferRoleDefinedInArgs := isDefinedRoleInArgs(roles, []byte(core.ESDTRoleTransfer))
 	firstTransferRoleSet := !properties.transferRoleExists && isDefinedRoleInArgs(roles, []byte(core.ESDTRoleTransfer))
@@ -2046,6 +2077,23 @@ func (e *esdt) stopNFTCreateForever(args *vmcommon.ContractCallInput) vmcommon.R
 	return vmcommon.Ok
 }
 
+// the roles of the system smart contracts are set in the same call, as the built in functions carried by smart contract
+// results towards the metachain are executed as system smart contract calls and would never reach the account
+func (e *esdt) isRoleChangeOnMetachain(address []byte) bool {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.DelegationReceiptTokensFlag) {
+		return false
+	}
+
+	return core.IsSmartContractOnMetachain(metachainIdentifier, address)
+}
+
+func (e *esdt) setRolesOnMetachain(tokenID []byte, address []byte, roles [][]byte) error {
+	arguments := append([][]byte{tokenID}, roles...)
+	_, err := e.eei.ProcessBuiltInFunction(e.esdtSCAddress, address, core.BuiltInFunctionSetESDTRole, arguments)
+
+	return err
+}
+
 func (e *esdt) sendRoleChangeData(tokenID []byte, destination []byte, roles [][]byte, builtInFunc string) {
 	esdtSetRoleData := builtInFunc + "@" + hex.EncodeToString(tokenID)
 	for _, arg := range roles {
@@ -0,0 +1,230 @@
+package systemSmartContracts
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const complianceConfigKeyPrefix = "complianceConfig"
+const hasAllowList = "hasAllowList"
+const hasDenyList = "hasDenyList"
+const hasTransferLimit = "hasTransferLimit"
+const maxNumOfComplianceAddresses = 100
+
+// the token identifier is hex encoded, so the key does not contain the ticker separator and is not listed as a token
+// when the contract storage is iterated
+func complianceConfigKey(tokenID []byte) []byte {
+	return []byte(complianceConfigKeyPrefix + hex.EncodeToString(tokenID))
+}
+
+// extractComplianceProperties removes the transfer restrictions from the list of properties provided at issue time
+func (e *esdt) extractComplianceProperties(properties [][]byte) (*common.ESDTComplianceConfig, [][]byte, error) {
+	config := &common.ESDTComplianceConfig{
+		DefaultTransferLimit: big.NewInt(0),
+	}
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTComplianceFlag) || len(properties)%2 != 0 {
+		return config, properties, nil
+	}
+
+	remainingProperties := make([][]byte, 0, len(properties))
+	for i := 0; i < len(properties); i += 2 {
+		property := string(properties[i])
+		if property != hasAllowList && property != hasDenyList && property != hasTransferLimit {
+			remainingProperties = append(remainingProperties, properties[i], properties[i+1])
+			continue
+		}
+
+		val, err := checkAndGetSetting(string(properties[i+1]))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch property {
+		case hasAllowList:
+			config.AllowList = val
+		case hasDenyList:
+			config.DenyList = val
+		case hasTransferLimit:
+			config.TransferLimit = val
+		}
+	}
+
+	return config, remainingProperties, nil
+}
+
+func (e *esdt) saveAndSendComplianceConfig(tokenID []byte, config *common.ESDTComplianceConfig, callerAddr []byte) {
+	configBytes := config.ToBytes()
+	e.eei.SetStorage(complianceConfigKey(tokenID), configBytes)
+
+	esdtTransferData := common.BuiltInFunctionESDTSetComplianceConfig + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(configBytes)
+	e.eei.SendGlobalSettingToAll(e.esdtSCAddress, []byte(esdtTransferData))
+
+	e.createAndAddComplianceConfigLogEntry(callerAddr, tokenID, config)
+}
+
+func (e *esdt) getComplianceConfig(tokenID []byte) *common.ESDTComplianceConfig {
+	return common.NewESDTComplianceConfigFromBytes(e.eei.GetStorage(complianceConfigKey(tokenID)))
+}
+
+func (e *esdt) sendComplianceEntries(tokenID []byte, entryType string, value *big.Int, addresses [][]byte) {
+	// the zero value, which removes the entries, is sent as one byte so the argument is never empty
+	valueBytes := value.Bytes()
+	if len(valueBytes) == 0 {
+		valueBytes = []byte{0}
+	}
+
+	esdtTransferData := common.BuiltInFunctionESDTSetComplianceEntries + "@" + hex.EncodeToString(tokenID) +
+		"@" + hex.EncodeToString([]byte(entryType)) + "@" + hex.EncodeToString(valueBytes)
+	for _, address := range addresses {
+		esdtTransferData += "@" + hex.EncodeToString(address)
+	}
+
+	e.eei.SendGlobalSettingToAll(e.esdtSCAddress, []byte(esdtTransferData))
+}
+
+func (e *esdt) checkComplianceArguments(args *vmcommon.ContractCallInput, minNumArgs int) (*common.ESDTComplianceConfig, vmcommon.ReturnCode) {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTComplianceFlag) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return nil, vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < minNumArgs {
+		e.eei.AddReturnMessage("not enough arguments")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	_, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+
+	return e.getComplianceConfig(args.Arguments[0]), vmcommon.Ok
+}
+
+func (e *esdt) checkComplianceAddresses(addresses [][]byte) vmcommon.ReturnCode {
+	if len(addresses) > maxNumOfComplianceAddresses {
+		e.eei.AddReturnMessage(fmt.Sprintf("too many addresses, maximum %d", maxNumOfComplianceAddresses))
+		return vmcommon.UserError
+	}
+	err := checkDuplicates(addresses)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	for _, address := range addresses {
+		if !e.isAddressValid(address) {
+			e.eei.AddReturnMessage("invalid address")
+			return vmcommon.UserError
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+// format: addToAllowList@tokenID@address1@address2...
+// format: removeFromAllowList@tokenID@address1@address2...
+// format: addToDenyList@tokenID@address1@address2...
+// format: removeFromDenyList@tokenID@address1@address2...
+func (e *esdt) changeComplianceList(args *vmcommon.ContractCallInput, listType string, isAdd bool) vmcommon.ReturnCode {
+	config, returnCode := e.checkComplianceArguments(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if listType == common.ESDTComplianceAllowList && !config.AllowList {
+		e.eei.AddReturnMessage("token was not issued with an allow list")
+		return vmcommon.UserError
+	}
+	if listType == common.ESDTComplianceDenyList && !config.DenyList {
+		e.eei.AddReturnMessage("token was not issued with a deny list")
+		return vmcommon.UserError
+	}
+
+	addresses := args.Arguments[1:]
+	returnCode = e.checkComplianceAddresses(addresses)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	value := big.NewInt(0)
+	if isAdd {
+		value.SetUint64(1)
+	}
+	e.sendComplianceEntries(args.Arguments[0], listType, value, addresses)
+	e.createAndAddComplianceEntriesLogEntry(args.Function, args.CallerAddr, args.Arguments[0], nil, addresses)
+
+	return vmcommon.Ok
+}
+
+// format: setTransferLimit@tokenID@limit@optional-list-of-addresses
+// without addresses, the default limit of all accounts is changed. A zero limit removes the limit of the addresses,
+// so the default one applies again, while a zero default limit means the token transfers are not limited
+func (e *esdt) setTransferLimit(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	config, returnCode := e.checkComplianceArguments(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !config.TransferLimit {
+		e.eei.AddReturnMessage("token was not issued with transfer limits")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[1]) > core.MaxLenForESDTIssueMint {
+		e.eei.AddReturnMessage(fmt.Sprintf("max length for the transfer limit is %d", core.MaxLenForESDTIssueMint))
+		return vmcommon.UserError
+	}
+
+	tokenID := args.Arguments[0]
+	limit := big.NewInt(0).SetBytes(args.Arguments[1])
+	addresses := args.Arguments[2:]
+	if len(addresses) == 0 {
+		config.DefaultTransferLimit = limit
+		e.saveAndSendComplianceConfig(tokenID, config, args.CallerAddr)
+		return vmcommon.Ok
+	}
+
+	returnCode = e.checkComplianceAddresses(addresses)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	e.sendComplianceEntries(tokenID, common.ESDTComplianceTransferLimit, limit, addresses)
+	e.createAndAddComplianceEntriesLogEntry(args.Function, args.CallerAddr, tokenID, limit.Bytes(), addresses)
+
+	return vmcommon.Ok
+}
+
+// format: getComplianceConfig@tokenID
+func (e *esdt) getComplianceConfigView(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTComplianceFlag) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 1")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	_, err = e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	config := e.getComplianceConfig(args.Arguments[0])
+	e.eei.Finish([]byte("HasAllowList-" + getStringFromBool(config.AllowList)))
+	e.eei.Finish([]byte("HasDenyList-" + getStringFromBool(config.DenyList)))
+	e.eei.Finish([]byte("HasTransferLimit-" + getStringFromBool(config.TransferLimit)))
+	e.eei.Finish([]byte("DefaultTransferLimit-" + config.DefaultTransferLimit.String()))
+
+	return vmcommon.Ok
+}
@@ -0,0 +1,219 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var complianceTestTokenID = []byte("TICKER-75fd57")
+
+func createIssueWithComplianceInput(args ArgsNewESDTSmartContract, properties ...[]byte) *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForFunc("issue", [][]byte{[]byte("name"), []byte("TICKER"), big.NewInt(100).Bytes(), big.NewInt(10).Bytes()})
+	vmInput.Arguments = append(vmInput.Arguments, properties...)
+	vmInput.CallerAddr = []byte("addr")
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+
+	return vmInput
+}
+
+func createESDTWithCompliantToken(t *testing.T, properties ...[]byte) (*esdt, *vmContext) {
+	args := createMockArgumentsForESDT()
+	enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.AddActiveFlags(common.ESDTComplianceFlag)
+	eei := createDefaultEei()
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := createIssueWithComplianceInput(args, properties...)
+	eei.gasRemaining = vmInput.GasProvided
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	eei.output = make([][]byte, 0)
+	eei.logs = make([]*vmcommon.LogEntry, 0)
+
+	return e, eei
+}
+
+func getGlobalSettingsSent(eei *vmContext) []string {
+	systemAddress := make([]byte, len(core.SystemAccountAddress))
+	copy(systemAddress, core.SystemAccountAddress)
+	systemAddress[len(systemAddress)-1] = 0
+
+	sent := make([]string, 0)
+	outAcc, ok := eei.outputAccounts[string(systemAddress)]
+	if !ok {
+		return sent
+	}
+	for _, outputTransfer := range outAcc.OutputTransfers {
+		sent = append(sent, string(outputTransfer.Data))
+	}
+
+	return sent
+}
+
+func createComplianceCallInput(function string, args ...[]byte) *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForFunc(function, append([][]byte{complianceTestTokenID}, args...))
+	vmInput.CallerAddr = []byte("addr")
+
+	return vmInput
+}
+
+func TestEsdt_IssueWithComplianceProperties(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForESDT()
+		eei := createDefaultEei()
+		args.Eei = eei
+		e, _ := NewESDTSmartContract(args)
+
+		vmInput := createIssueWithComplianceInput(args, []byte(hasAllowList), boolToSlice(true))
+		eei.gasRemaining = vmInput.GasProvided
+		require.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+	})
+	t.Run("should save and send the compliance config", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForESDT()
+		enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+		enableEpochsHandler.AddActiveFlags(common.ESDTComplianceFlag)
+		eei := createDefaultEei()
+		args.Eei = eei
+		e, _ := NewESDTSmartContract(args)
+
+		vmInput := createIssueWithComplianceInput(args, []byte(hasAllowList), boolToSlice(true), []byte(canFreeze), boolToSlice(true), []byte(hasTransferLimit), boolToSlice(true))
+		eei.gasRemaining = vmInput.GasProvided
+		require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+		expectedConfig := &common.ESDTComplianceConfig{AllowList: true, TransferLimit: true, DefaultTransferLimit: big.NewInt(0)}
+		require.Equal(t, expectedConfig, e.getComplianceConfig(complianceTestTokenID))
+		expectedSent := common.BuiltInFunctionESDTSetComplianceConfig + "@" + hex.EncodeToString(complianceTestTokenID) + "@" + hex.EncodeToString(expectedConfig.ToBytes())
+		require.Contains(t, getGlobalSettingsSent(eei), expectedSent)
+
+		token, _ := e.getExistingToken(complianceTestTokenID)
+		require.True(t, token.CanFreeze)
+
+		found := false
+		for _, entry := range eei.logs {
+			if bytes.Equal(entry.Identifier, []byte(common.BuiltInFunctionESDTSetComplianceConfig)) {
+				found = true
+				require.Equal(t, [][]byte{complianceTestTokenID, boolToSlice(true), boolToSlice(false), boolToSlice(true), {}}, entry.Topics)
+			}
+		}
+		require.True(t, found)
+	})
+}
+
+func TestEsdt_ChangeComplianceList(t *testing.T) {
+	t.Parallel()
+
+	address := bytes.Repeat([]byte("a"), 32)
+
+	t.Run("not the owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasAllowList), boolToSlice(true))
+		vmInput := createComplianceCallInput("addToAllowList", address)
+		vmInput.CallerAddr = []byte("other")
+		require.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		require.Equal(t, "can be called by owner only", eei.returnMessage)
+	})
+	t.Run("list not enabled at issue should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasAllowList), boolToSlice(true))
+		require.Equal(t, vmcommon.UserError, e.Execute(createComplianceCallInput("addToDenyList", address)))
+		require.Equal(t, "token was not issued with a deny list", eei.returnMessage)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasAllowList), boolToSlice(true))
+		require.Equal(t, vmcommon.UserError, e.Execute(createComplianceCallInput("addToAllowList", []byte("short"))))
+		require.Equal(t, "invalid address", eei.returnMessage)
+	})
+	t.Run("should send the entries to all shards", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasDenyList), boolToSlice(true))
+		require.Equal(t, vmcommon.Ok, e.Execute(createComplianceCallInput("addToDenyList", address)))
+		require.Equal(t, vmcommon.Ok, e.Execute(createComplianceCallInput("removeFromDenyList", address)))
+
+		prefix := common.BuiltInFunctionESDTSetComplianceEntries + "@" + hex.EncodeToString(complianceTestTokenID) + "@" + hex.EncodeToString([]byte(common.ESDTComplianceDenyList))
+		require.Equal(t, []string{
+			prefix + "@01@" + hex.EncodeToString(address),
+			prefix + "@00@" + hex.EncodeToString(address),
+		}, getGlobalSettingsSent(eei))
+		require.Equal(t, &vmcommon.LogEntry{
+			Identifier: []byte("removeFromDenyList"),
+			Address:    []byte("addr"),
+			Topics:     [][]byte{complianceTestTokenID, address},
+		}, eei.logs[len(eei.logs)-1])
+	})
+}
+
+func TestEsdt_SetTransferLimit(t *testing.T) {
+	t.Parallel()
+
+	address := bytes.Repeat([]byte("a"), 32)
+	limit := big.NewInt(1000)
+
+	t.Run("transfer limits not enabled at issue should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasAllowList), boolToSlice(true))
+		require.Equal(t, vmcommon.UserError, e.Execute(createComplianceCallInput("setTransferLimit", limit.Bytes())))
+		require.Equal(t, "token was not issued with transfer limits", eei.returnMessage)
+	})
+	t.Run("default limit should update the config", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasTransferLimit), boolToSlice(true))
+		require.Equal(t, vmcommon.Ok, e.Execute(createComplianceCallInput("setTransferLimit", limit.Bytes())))
+
+		expectedConfig := &common.ESDTComplianceConfig{TransferLimit: true, DefaultTransferLimit: limit}
+		require.Equal(t, expectedConfig, e.getComplianceConfig(complianceTestTokenID))
+		require.Equal(t, []string{
+			common.BuiltInFunctionESDTSetComplianceConfig + "@" + hex.EncodeToString(complianceTestTokenID) + "@" + hex.EncodeToString(expectedConfig.ToBytes()),
+		}, getGlobalSettingsSent(eei))
+	})
+	t.Run("account limit should send the entries to all shards", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei := createESDTWithCompliantToken(t, []byte(hasTransferLimit), boolToSlice(true))
+		require.Equal(t, vmcommon.Ok, e.Execute(createComplianceCallInput("setTransferLimit", limit.Bytes(), address)))
+		require.Equal(t, []string{
+			common.BuiltInFunctionESDTSetComplianceEntries + "@" + hex.EncodeToString(complianceTestTokenID) + "@" +
+				hex.EncodeToString([]byte(common.ESDTComplianceTransferLimit)) + "@" + hex.EncodeToString(limit.Bytes()) + "@" + hex.EncodeToString(address),
+		}, getGlobalSettingsSent(eei))
+		require.Equal(t, big.NewInt(0), e.getComplianceConfig(complianceTestTokenID).DefaultTransferLimit)
+	})
+}
+
+func TestEsdt_GetComplianceConfig(t *testing.T) {
+	t.Parallel()
+
+	e, eei := createESDTWithCompliantToken(t, []byte(hasDenyList), boolToSlice(true))
+	require.Equal(t, vmcommon.Ok, e.Execute(createComplianceCallInput("getComplianceConfig")))
+	require.Equal(t, [][]byte{
+		[]byte("HasAllowList-false"),
+		[]byte("HasDenyList-true"),
+		[]byte("HasTransferLimit-false"),
+		[]byte("DefaultTransferLimit-0"),
+	}, eei.output)
+
+	enableEpochsHandler, _ := e.enableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.RemoveActiveFlags(common.ESDTComplianceFlag)
+	require.Equal(t, vmcommon.FunctionNotFound, e.Execute(createComplianceCallInput("getComplianceConfig")))
+}
@@ -4204,6 +4204,64 @@ func TestEsdt_ExecuteRegisterAndSetFungible(t *testing.T) {
 	assert.Equal(t, token.TokenType, []byte(core.FungibleESDT))
 }
 
+func TestEsdt_ExecuteRegisterAndSetFungibleForMetachainContract(t *testing.T) {
+	t.Parallel()
+
+	metachainSCAddress := vm.FirstDelegationSCAddress
+	registerForMetachainContract := func(flagActive bool) (*vmContext, [][]byte) {
+		args := createMockArgumentsForESDT()
+		enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+		if flagActive {
+			enableEpochsHandler.AddActiveFlags(common.DelegationReceiptTokensFlag)
+		}
+		var setRoleArguments [][]byte
+		eei := createDefaultEei()
+		eei.blockChainHook = &mock.BlockChainHookStub{
+			ProcessBuiltInFunctionCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				assert.Equal(t, args.ESDTSCAddress, input.CallerAddr)
+				assert.Equal(t, metachainSCAddress, input.RecipientAddr)
+				assert.Equal(t, core.BuiltInFunctionSetESDTRole, input.Function)
+				setRoleArguments = input.Arguments
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			},
+		}
+		args.Eei = eei
+		e, _ := NewESDTSmartContract(args)
+
+		vmInput := getDefaultVmInputForFunc("registerAndSetAllRoles", nil)
+		vmInput.CallerAddr = metachainSCAddress
+		vmInput.CallValue = big.NewInt(0).Set(e.baseIssuingCost)
+		vmInput.Arguments = [][]byte{[]byte("tokenName"), []byte("TICKER"), []byte("FNG"), big.NewInt(10).Bytes()}
+		eei.gasRemaining = 9999
+		output := e.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output)
+
+		return eei, setRoleArguments
+	}
+
+	t.Run("flag not active should send the roles", func(t *testing.T) {
+		t.Parallel()
+
+		eei, setRoleArguments := registerForMetachainContract(false)
+		assert.Nil(t, setRoleArguments)
+
+		outAcc := eei.outputAccounts[string(metachainSCAddress)]
+		require.NotNil(t, outAcc)
+		require.Equal(t, 1, len(outAcc.OutputTransfers))
+		assert.True(t, strings.HasPrefix(string(outAcc.OutputTransfers[0].Data), core.BuiltInFunctionSetESDTRole))
+	})
+	t.Run("should set the roles in the same call", func(t *testing.T) {
+		t.Parallel()
+
+		eei, setRoleArguments := registerForMetachainContract(true)
+		expectedArguments := [][]byte{eei.output[0], []byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)}
+		assert.Equal(t, expectedArguments, setRoleArguments)
+
+		_, exists := eei.outputAccounts[string(metachainSCAddress)]
+		assert.False(t, exists)
+	})
+}
+
 func TestEsdt_ExecuteRegisterAndSetNonFungible(t *testing.T) {
 	t.Parallel()
 
@@ -4,7 +4,10 @@ package systemSmartContracts
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"math/big"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -12,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/vm"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -27,6 +31,9 @@ const vetoString = "veto"
 const abstainString = "abstain"
 const commitHashLength = 40
 const maxPercentage = float64(10000.0)
+const pendingParameterChangesKey = "pendingParameterChanges"
+const parameterChangesKey = "parameterChanges"
+const rejectedParameterChangesKey = "rejectedParameterChanges"
 
 // ArgsNewGovernanceContract defines the arguments needed for the on-chain governance contract
 type ArgsNewGovernanceContract struct {
@@ -41,6 +48,7 @@ type ArgsNewGovernanceContract struct {
 	OwnerAddress           []byte
 	UnBondPeriodInEpochs   uint32
 	EnableEpochsHandler    common.EnableEpochsHandler
+	Economics              vm.EconomicsHandler
 }
 
 type governanceContract struct {
@@ -56,6 +64,7 @@ type governanceContract struct {
 	governanceConfig       config.GovernanceSystemSCConfig
 	unBondPeriodInEpochs   uint32
 	enableEpochsHandler    common.EnableEpochsHandler
+	economics              vm.EconomicsHandler
 	mutExecution           sync.RWMutex
 }
 
@@ -73,8 +82,12 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	if check.IfNil(args.EnableEpochsHandler) {
 		return nil, vm.ErrNilEnableEpochsHandler
 	}
+	if check.IfNil(args.Economics) {
+		return nil, vm.ErrNilEconomicsData
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.GovernanceFlag,
+		common.GovernanceParameterChangesFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -110,6 +123,7 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		hasher:                 args.Hasher,
 		governanceConfig:       args.GovernanceConfig,
 		enableEpochsHandler:    args.EnableEpochsHandler,
+		economics:              args.Economics,
 		unBondPeriodInEpochs:   args.UnBondPeriodInEpochs,
 	}
 
@@ -143,6 +157,8 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.initV2(args)
 	case "proposal":
 		return g.proposal(args)
+	case "parameterChangeProposal":
+		return g.parameterChangeProposal(args)
 	case "vote":
 		return g.vote(args)
 	case "delegateVote":
@@ -161,6 +177,14 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.viewDelegatedVoteInfo(args)
 	case "viewProposal":
 		return g.viewProposal(args)
+	case "viewParameterChanges":
+		return g.viewParameterChanges(args)
+	case "viewAllParameterChanges":
+		return g.viewAllParameterChanges(args)
+	case "viewRejectedParameterChanges":
+		return g.viewRejectedParameterChanges(args)
+	case "processParameterChanges":
+		return g.processParameterChanges(args)
 	case "claimAccumulatedFees":
 		return g.claimAccumulatedFees(args)
 	}
@@ -293,6 +317,184 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		g.eei.AddReturnMessage("invalid number of arguments, expected 3")
 		return vmcommon.FunctionWrongSignature
 	}
+
+	return g.createProposal(args, nil, 0)
+}
+
+// parameterChangeProposal creates a new proposal carrying protocol parameter changes. If the proposal passes, the
+// changes are applied automatically starting with the activation epoch, or with the epoch after the proposal was closed
+// if the activation epoch was already reached
+//
+//	args.Arguments[0] - commit hash
+//	args.Arguments[1] - start vote epoch
+//	args.Arguments[2] - end vote epoch
+//	args.Arguments[3] - activation epoch, greater than the end vote epoch
+//	args.Arguments[4:] - pairs of parameter name and value, as string
+func (g *governanceContract) parameterChangeProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) < 6 || len(args.Arguments)%2 != 0 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 4 followed by pairs of parameter name and value")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	changes, err := parseParameterChanges(args.Arguments[4:])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = checkFeeChanges(changes)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	endVoteEpoch := big.NewInt(0).SetBytes(args.Arguments[2]).Uint64()
+	activationEpoch := big.NewInt(0).SetBytes(args.Arguments[3])
+	if !activationEpoch.IsUint64() || activationEpoch.Uint64() <= endVoteEpoch || activationEpoch.Uint64() > math.MaxUint32 {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid activation epoch, should be greater than the end vote epoch %d", endVoteEpoch))
+		return vmcommon.UserError
+	}
+	err = g.checkGasLimitsChanges(changes, activationEpoch.Uint64())
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return g.createProposal(args, changes, activationEpoch.Uint64())
+}
+
+func parseParameterChanges(arguments [][]byte) ([]*ParameterChange, error) {
+	changes := make([]*ParameterChange, 0, len(arguments)/2)
+	names := make(map[string]struct{})
+	for i := 0; i+1 < len(arguments); i += 2 {
+		name := string(arguments[i])
+		value := string(arguments[i+1])
+		_, found := names[name]
+		if found {
+			return nil, fmt.Errorf("%w: %s", vm.ErrDuplicatedParameterChange, name)
+		}
+
+		err := governance.CheckParameterChange(name, value)
+		if err != nil {
+			return nil, err
+		}
+
+		names[name] = struct{}{}
+		changes = append(changes, &ParameterChange{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	return changes, nil
+}
+
+// checkFeeChanges makes sure the proposal fees are changed together and that the lost proposal fee does not exceed the
+// proposal fee, so the configuration stays consistent whatever the order the passed proposals are activated in
+func checkFeeChanges(changes []*ParameterChange) error {
+	var proposalFee, lostProposalFee *big.Int
+	for _, change := range changes {
+		switch change.Name {
+		case governance.ProposalFee:
+			proposalFee, _ = big.NewInt(0).SetString(change.Value, conversionBase)
+		case governance.LostProposalFee:
+			lostProposalFee, _ = big.NewInt(0).SetString(change.Value, conversionBase)
+		}
+	}
+	if proposalFee == nil && lostProposalFee == nil {
+		return nil
+	}
+	if proposalFee == nil || lostProposalFee == nil {
+		return fmt.Errorf("%w, %s and %s should be changed together", vm.ErrIncorrectConfig, governance.ProposalFee, governance.LostProposalFee)
+	}
+	if proposalFee.Cmp(lostProposalFee) < 0 {
+		return fmt.Errorf("%w proposal fee is smaller than lost proposal fee", vm.ErrIncorrectConfig)
+	}
+
+	return nil
+}
+
+// checkGasLimitsChanges makes sure the gas limits stay consistent once the changes of a new proposal are applied after
+// the changes in effect and the ones of the pending passed proposals activated until the same epoch. The check is done
+// again on activation, as other proposals might pass in the meantime
+func (g *governanceContract) checkGasLimitsChanges(changes []*ParameterChange, activationEpoch uint64) error {
+	inEffect, err := g.getParameterChangesInEffect()
+	if err != nil {
+		return err
+	}
+	pending, err := g.getPendingParameterChanges()
+	if err != nil {
+		return err
+	}
+
+	limits, err := g.gasLimitsInEffect(activationEpoch, append(inEffect.Changes, pending...))
+	if err != nil {
+		return err
+	}
+
+	newChanges := make([]*governance.ParameterChange, 0, len(changes))
+	for _, change := range changes {
+		newChanges = append(newChanges, &governance.ParameterChange{Name: change.Name, Value: change.Value})
+	}
+
+	return governance.CheckGasLimitsChanges(limits, newChanges)
+}
+
+// gasLimitsInEffect returns the gas limits of the provided epoch, resulting from applying the provided economics changes
+// activated until that epoch on the configured gas limits, the same way the economics component does
+func (g *governanceContract) gasLimitsInEffect(epoch uint64, changes []*governance.ParameterChange) (governance.GasLimits, error) {
+	limits := g.economics.ConfiguredGasLimitsInEpoch(uint32(epoch))
+	activeChanges := governance.ActiveChanges(changes, governance.EconomicsPrefix, uint32(epoch))
+
+	return governance.ApplyGasLimitsChanges(limits, activeChanges)
+}
+
+func (g *governanceContract) getPendingParameterChanges() ([]*governance.ParameterChange, error) {
+	pending, err := g.getNoncesList([]byte(pendingParameterChangesKey))
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*governance.ParameterChange, 0)
+	for _, nonce := range pending.Nonces {
+		proposal, errGet := g.getProposalFromNonce(big.NewInt(0).SetUint64(nonce))
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		changes = append(changes, proposalParameterChanges(proposal)...)
+	}
+
+	return changes, nil
+}
+
+func proposalParameterChanges(proposal *GeneralProposal) []*governance.ParameterChange {
+	changes := make([]*governance.ParameterChange, 0, len(proposal.ParameterChanges))
+	for _, change := range proposal.ParameterChanges {
+		changes = append(changes, &governance.ParameterChange{
+			ProposalNonce:   proposal.Nonce,
+			Name:            change.Name,
+			Value:           change.Value,
+			ActivationEpoch: uint32(proposal.ActivationEpoch),
+		})
+	}
+
+	return changes
+}
+
+func (g *governanceContract) createProposal(
+	args *vmcommon.ContractCallInput,
+	changes []*ParameterChange,
+	activationEpoch uint64,
+) vmcommon.ReturnCode {
 	generalConfig, err := g.getConfig()
 	if err != nil {
 		g.eei.AddReturnMessage(err.Error())
@@ -341,6 +543,10 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		ProposalCost:   generalConfig.ProposalFee,
 		Nonce:          nextNonce,
 	}
+	if len(changes) > 0 {
+		generalProposal.ParameterChanges = changes
+		generalProposal.ActivationEpoch = activationEpoch
+	}
 	err = g.saveGeneralProposal(commitHash, generalProposal)
 	if err != nil {
 		g.eei.AddReturnMessage("saveGeneralProposal " + err.Error())
@@ -348,13 +554,16 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 	}
 
 	nonceAsBytes := big.NewInt(0).SetUint64(nextNonce).Bytes()
-	nonceKey := append([]byte(noncePrefix), nonceAsBytes...)
-	g.eei.SetStorage(nonceKey, commitHash)
+	g.eei.SetStorage(proposalNonceKey(nextNonce), commitHash)
 
+	topics := [][]byte{nonceAsBytes, commitHash, args.Arguments[1], args.Arguments[2]}
+	if len(changes) > 0 {
+		topics = append(topics, big.NewInt(0).SetUint64(activationEpoch).Bytes())
+	}
 	logEntry := &vmcommon.LogEntry{
 		Identifier: []byte(args.Function),
 		Address:    args.CallerAddr,
-		Topics:     [][]byte{nonceAsBytes, commitHash, args.Arguments[1], args.Arguments[2]},
+		Topics:     topics,
 	}
 	g.eei.AddLogEntry(logEntry)
 
@@ -636,6 +845,15 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
+	hasParameterChanges := len(generalProposal.ParameterChanges) > 0
+	if generalProposal.Passed && hasParameterChanges {
+		err = g.scheduleParameterChanges(generalProposal, currentEpoch)
+		if err != nil {
+			g.eei.AddReturnMessage("scheduleParameterChanges error " + err.Error())
+			return vmcommon.UserError
+		}
+	}
+
 	err = g.saveGeneralProposal(generalProposal.CommitHash, generalProposal)
 	if err != nil {
 		g.eei.AddReturnMessage("saveGeneralProposal error " + err.Error())
@@ -650,16 +868,270 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 
 	g.eei.Transfer(args.CallerAddr, args.RecipientAddr, tokensToReturn, nil, 0)
 
+	topics := [][]byte{generalProposal.CommitHash, boolToSlice(generalProposal.Passed)}
+	if hasParameterChanges {
+		topics = append(topics, big.NewInt(0).SetUint64(generalProposal.ActivationEpoch).Bytes())
+	}
 	logEntry := &vmcommon.LogEntry{
 		Identifier: []byte(args.Function),
 		Address:    args.CallerAddr,
-		Topics:     [][]byte{generalProposal.CommitHash, boolToSlice(generalProposal.Passed)},
+		Topics:     topics,
 	}
 	g.eei.AddLogEntry(logEntry)
 
 	return vmcommon.Ok
 }
 
+// scheduleParameterChanges makes sure the changes of a passed proposal are not activated before the epoch following its
+// closing and records the proposal between the pending ones
+func (g *governanceContract) scheduleParameterChanges(proposal *GeneralProposal, currentEpoch uint32) error {
+	if proposal.ActivationEpoch <= uint64(currentEpoch) {
+		proposal.ActivationEpoch = uint64(currentEpoch) + 1
+	}
+
+	return g.addToNoncesList([]byte(pendingParameterChangesKey), proposal.Nonce)
+}
+
+// processParameterChanges is called by the metachain at the start of each epoch to activate the parameter changes of
+// the passed proposals that reached their activation epoch
+//
+//	args.Arguments[0] - the starting epoch
+func (g *governanceContract) processParameterChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if !bytes.Equal(args.CallerAddr, g.governanceSCAddress) {
+		g.eei.AddReturnMessage("invalid caller to process the parameter changes")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("processParameterChanges can be called only without callValue")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 1")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	err := g.activateParameterChanges(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64())
+	if err != nil {
+		g.eei.AddReturnMessage("activateParameterChanges error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// activateParameterChanges applies on the configuration of the contract the governance parameters of the pending
+// proposals that reached their activation epoch. For the other parameters, only the latest activated change is kept
+// between the changes in effect, so the changes carried by the start of epoch metablocks stay bounded by the number of
+// parameters and of the pending proposals. The economics changes of a proposal that would leave the gas limits
+// inconsistent are not put in effect, being recorded between the rejected changes instead
+func (g *governanceContract) activateParameterChanges(epoch uint64) error {
+	pending, err := g.getNoncesList([]byte(pendingParameterChangesKey))
+	if err != nil {
+		return err
+	}
+
+	activated := make([]*GeneralProposal, 0)
+	remaining := make([]uint64, 0, len(pending.Nonces))
+	for _, nonce := range pending.Nonces {
+		proposal, errGet := g.getProposalFromNonce(big.NewInt(0).SetUint64(nonce))
+		if errGet != nil {
+			return errGet
+		}
+		if proposal.ActivationEpoch > epoch {
+			remaining = append(remaining, nonce)
+			continue
+		}
+
+		activated = append(activated, proposal)
+	}
+	if len(activated) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(activated, func(i, j int) bool {
+		if activated[i].ActivationEpoch != activated[j].ActivationEpoch {
+			return activated[i].ActivationEpoch < activated[j].ActivationEpoch
+		}
+		return activated[i].Nonce < activated[j].Nonce
+	})
+
+	scConfig, err := g.getConfig()
+	if err != nil {
+		return err
+	}
+	inEffect, err := g.getParameterChangesInEffect()
+	if err != nil {
+		return err
+	}
+	rejected, err := g.getParameterChanges(rejectedParameterChangesKey)
+	if err != nil {
+		return err
+	}
+	numRejected := len(rejected.Changes)
+	limits, err := g.gasLimitsInEffect(epoch, inEffect.Changes)
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range activated {
+		changes := proposalParameterChanges(proposal)
+		errLimits := governance.CheckGasLimitsChanges(limits, changes)
+		if errLimits == nil {
+			limits, _ = governance.ApplyGasLimitsChanges(limits, changes)
+		} else {
+			log.Debug("governanceContract.activateParameterChanges: rejecting the economics changes of a proposal",
+				"proposal nonce", proposal.Nonce, "error", errLimits)
+		}
+
+		for _, change := range changes {
+			if strings.HasPrefix(change.Name, governance.GovernancePrefix) {
+				err = applyConfigChange(scConfig, change)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if errLimits != nil && strings.HasPrefix(change.Name, governance.EconomicsPrefix) {
+				rejected.Changes = append(rejected.Changes, change)
+				continue
+			}
+
+			inEffect.Changes = replaceParameterChange(inEffect.Changes, change)
+		}
+	}
+
+	err = g.saveConfig(scConfig)
+	if err != nil {
+		return err
+	}
+	err = g.saveParameterChangesInEffect(inEffect)
+	if err != nil {
+		return err
+	}
+	if len(rejected.Changes) > numRejected {
+		err = g.saveParameterChanges(rejectedParameterChangesKey, rejected)
+		if err != nil {
+			return err
+		}
+	}
+
+	return g.saveNoncesList([]byte(pendingParameterChangesKey), &ParameterChangesList{Nonces: remaining})
+}
+
+// replaceParameterChange drops the change of the same parameter, superseded by the provided one, which is appended
+func replaceParameterChange(changes []*governance.ParameterChange, newChange *governance.ParameterChange) []*governance.ParameterChange {
+	result := make([]*governance.ParameterChange, 0, len(changes)+1)
+	for _, change := range changes {
+		if change.Name != newChange.Name {
+			result = append(result, change)
+		}
+	}
+
+	return append(result, newChange)
+}
+
+func applyConfigChange(scConfig *GovernanceConfigV2, change *governance.ParameterChange) error {
+	var err error
+	switch change.Name {
+	case governance.MinQuorum:
+		scConfig.MinQuorum, err = convertDecimalToPercentage([]byte(change.Value))
+	case governance.MinPassThreshold:
+		scConfig.MinPassThreshold, err = convertDecimalToPercentage([]byte(change.Value))
+	case governance.MinVetoThreshold:
+		scConfig.MinVetoThreshold, err = convertDecimalToPercentage([]byte(change.Value))
+	case governance.ProposalFee:
+		scConfig.ProposalFee, err = convertToBigInt(change.Value)
+	case governance.LostProposalFee:
+		scConfig.LostProposalFee, err = convertToBigInt(change.Value)
+	}
+	if err != nil {
+		return fmt.Errorf("%w for %s", err, change.Name)
+	}
+
+	return nil
+}
+
+func (g *governanceContract) getParameterChangesInEffect() (*governance.GovernanceParameterChanges, error) {
+	return g.getParameterChanges(parameterChangesKey)
+}
+
+func (g *governanceContract) saveParameterChangesInEffect(inEffect *governance.GovernanceParameterChanges) error {
+	return g.saveParameterChanges(parameterChangesKey, inEffect)
+}
+
+func (g *governanceContract) getParameterChanges(key string) (*governance.GovernanceParameterChanges, error) {
+	changes := &governance.GovernanceParameterChanges{}
+	marshalledData := g.eei.GetStorage([]byte(key))
+	if len(marshalledData) == 0 {
+		return changes, nil
+	}
+
+	err := g.marshalizer.Unmarshal(changes, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (g *governanceContract) saveParameterChanges(key string, changes *governance.GovernanceParameterChanges) error {
+	marshalledData, err := g.marshalizer.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage([]byte(key), marshalledData)
+	return nil
+}
+
+func convertToBigInt(value string) (*big.Int, error) {
+	result, ok := big.NewInt(0).SetString(value, conversionBase)
+	if !ok {
+		return nil, vm.ErrIncorrectConfig
+	}
+
+	return result, nil
+}
+
+func (g *governanceContract) getNoncesList(key []byte) (*ParameterChangesList, error) {
+	list := &ParameterChangesList{}
+	marshalledData := g.eei.GetStorage(key)
+	if len(marshalledData) == 0 {
+		return list, nil
+	}
+
+	err := g.marshalizer.Unmarshal(list, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (g *governanceContract) saveNoncesList(key []byte, list *ParameterChangesList) error {
+	marshalledData, err := g.marshalizer.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(key, marshalledData)
+	return nil
+}
+
+func (g *governanceContract) addToNoncesList(key []byte, nonce uint64) error {
+	list, err := g.getNoncesList(key)
+	if err != nil {
+		return err
+	}
+
+	list.Nonces = append(list.Nonces, nonce)
+	return g.saveNoncesList(key, list)
+}
+
 func (g *governanceContract) getAccumulatedFees() *big.Int {
 	currentData := g.eei.GetStorage([]byte(accumulatedFeeKey))
 	return big.NewInt(0).SetBytes(currentData)
@@ -812,6 +1284,101 @@ func (g *governanceContract) viewProposal(args *vmcommon.ContractCallInput) vmco
 	return vmcommon.Ok
 }
 
+// viewParameterChanges returns the activation epoch of a proposal followed by the pairs of parameter name and value it
+// carries. Nothing is returned for the proposals without parameter changes
+func (g *governanceContract) viewParameterChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.checkViewFuncArguments(args, 1)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	proposal, err := g.getProposalFromNonce(big.NewInt(0).SetBytes(args.Arguments[0]))
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(proposal.ParameterChanges) == 0 {
+		return vmcommon.Ok
+	}
+
+	g.eei.Finish(big.NewInt(0).SetUint64(proposal.ActivationEpoch).Bytes())
+	for _, change := range proposal.ParameterChanges {
+		g.eei.Finish([]byte(change.Name))
+		g.eei.Finish([]byte(change.Value))
+	}
+
+	return vmcommon.Ok
+}
+
+// viewAllParameterChanges returns, for each parameter change in effect followed by each change of the pending passed
+// proposals, the proposal nonce, the activation epoch, the parameter name and its value. Only the latest activated
+// change of each parameter is kept in effect, the governance parameters being applied on the contract configuration
+func (g *governanceContract) viewAllParameterChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.checkViewFuncArguments(args, 0)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	inEffect, err := g.getParameterChangesInEffect()
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	for _, change := range inEffect.Changes {
+		g.finishParameterChange(change.ProposalNonce, uint64(change.ActivationEpoch), change.Name, change.Value)
+	}
+
+	pending, err := g.getNoncesList([]byte(pendingParameterChangesKey))
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	for _, nonce := range pending.Nonces {
+		proposal, errGet := g.getProposalFromNonce(big.NewInt(0).SetUint64(nonce))
+		if errGet != nil {
+			g.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+
+		for _, change := range proposal.ParameterChanges {
+			g.finishParameterChange(proposal.Nonce, proposal.ActivationEpoch, change.Name, change.Value)
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+// viewRejectedParameterChanges returns, for each economics change of the passed proposals that was not put in effect as
+// it would have left the gas limits inconsistent, the proposal nonce, the activation epoch, the parameter name and its
+// value
+func (g *governanceContract) viewRejectedParameterChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.checkViewFuncArguments(args, 0)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	rejected, err := g.getParameterChanges(rejectedParameterChangesKey)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	for _, change := range rejected.Changes {
+		g.finishParameterChange(change.ProposalNonce, uint64(change.ActivationEpoch), change.Name, change.Value)
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) finishParameterChange(proposalNonce uint64, activationEpoch uint64, name string, value string) {
+	g.eei.Finish(big.NewInt(0).SetUint64(proposalNonce).Bytes())
+	g.eei.Finish(big.NewInt(0).SetUint64(activationEpoch).Bytes())
+	g.eei.Finish([]byte(name))
+	g.eei.Finish([]byte(value))
+}
+
 func (g *governanceContract) viewDelegatedVoteInfo(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	err := g.checkViewFuncArguments(args, 2)
 	if err != nil {
@@ -1105,8 +1672,7 @@ func (g *governanceContract) saveGeneralProposal(reference []byte, generalPropos
 	if err != nil {
 		return err
 	}
-	key := append([]byte(proposalPrefix), reference...)
-	g.eei.SetStorage(key, marshaledData)
+	g.eei.SetStorage(proposalKey(reference), marshaledData)
 
 	return nil
 }
@@ -1136,10 +1702,19 @@ func (g *governanceContract) getProposalFromNonce(nonce *big.Int) (*GeneralPropo
 	return g.getGeneralProposal(commitHash)
 }
 
+// proposalNonceKey returns the storage key holding the commit hash of the proposal with the provided nonce
+func proposalNonceKey(nonce uint64) []byte {
+	return append([]byte(noncePrefix), big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+// proposalKey returns the storage key holding the proposal with the provided commit hash
+func proposalKey(commitHash []byte) []byte {
+	return append([]byte(proposalPrefix), commitHash...)
+}
+
 // getGeneralProposal returns a proposal from storage
 func (g *governanceContract) getGeneralProposal(reference []byte) (*GeneralProposal, error) {
-	key := append([]byte(proposalPrefix), reference...)
-	marshaledData := g.eei.GetStorage(key)
+	marshaledData := g.eei.GetStorage(proposalKey(reference))
 
 	if len(marshaledData) == 0 {
 		return nil, vm.ErrProposalNotFound
@@ -58,19 +58,21 @@ func (VoteValueType) EnumDescriptor() ([]byte, []int) {
 }
 
 type GeneralProposal struct {
-	Nonce          uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce"`
-	CommitHash     []byte        `protobuf:"bytes,2,opt,name=CommitHash,proto3" json:"CommitHash"`
-	StartVoteEpoch uint64        `protobuf:"varint,3,opt,name=StartVoteEpoch,proto3" json:"StartVoteEpoch"`
-	EndVoteEpoch   uint64        `protobuf:"varint,4,opt,name=EndVoteEpoch,proto3" json:"EndVoteEpoch"`
-	Yes            *math_big.Int `protobuf:"bytes,5,opt,name=Yes,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Yes"`
-	No             *math_big.Int `protobuf:"bytes,6,opt,name=No,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"No"`
-	Veto           *math_big.Int `protobuf:"bytes,7,opt,name=Veto,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Veto"`
-	Abstain        *math_big.Int `protobuf:"bytes,8,opt,name=Abstain,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Abstain"`
-	QuorumStake    *math_big.Int `protobuf:"bytes,9,opt,name=QuorumStake,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"QuorumStake"`
-	Passed         bool          `protobuf:"varint,10,opt,name=Passed,proto3" json:"Passed"`
-	Closed         bool          `protobuf:"varint,11,opt,name=Closed,proto3" json:"Closed"`
-	IssuerAddress  []byte        `protobuf:"bytes,12,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
-	ProposalCost   *math_big.Int `protobuf:"bytes,13,opt,name=ProposalCost,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"ProposalCost"`
+	Nonce            uint64             `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce"`
+	CommitHash       []byte             `protobuf:"bytes,2,opt,name=CommitHash,proto3" json:"CommitHash"`
+	StartVoteEpoch   uint64             `protobuf:"varint,3,opt,name=StartVoteEpoch,proto3" json:"StartVoteEpoch"`
+	EndVoteEpoch     uint64             `protobuf:"varint,4,opt,name=EndVoteEpoch,proto3" json:"EndVoteEpoch"`
+	Yes              *math_big.Int      `protobuf:"bytes,5,opt,name=Yes,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Yes"`
+	No               *math_big.Int      `protobuf:"bytes,6,opt,name=No,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"No"`
+	Veto             *math_big.Int      `protobuf:"bytes,7,opt,name=Veto,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Veto"`
+	Abstain          *math_big.Int      `protobuf:"bytes,8,opt,name=Abstain,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"Abstain"`
+	QuorumStake      *math_big.Int      `protobuf:"bytes,9,opt,name=QuorumStake,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"QuorumStake"`
+	Passed           bool               `protobuf:"varint,10,opt,name=Passed,proto3" json:"Passed"`
+	Closed           bool               `protobuf:"varint,11,opt,name=Closed,proto3" json:"Closed"`
+	IssuerAddress    []byte             `protobuf:"bytes,12,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
+	ProposalCost     *math_big.Int      `protobuf:"bytes,13,opt,name=ProposalCost,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"ProposalCost"`
+	ParameterChanges []*ParameterChange `protobuf:"bytes,14,rep,name=ParameterChanges,proto3" json:"ParameterChanges"`
+	ActivationEpoch  uint64             `protobuf:"varint,15,opt,name=ActivationEpoch,proto3" json:"ActivationEpoch"`
 }
 
 func (m *GeneralProposal) Reset()      { *m = GeneralProposal{} }
@@ -192,6 +194,67 @@ func (m *GeneralProposal) GetProposalCost() *math_big.Int {
 	return nil
 }
 
+func (m *GeneralProposal) GetParameterChanges() []*ParameterChange {
+	if m != nil {
+		return m.ParameterChanges
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+type ParameterChange struct {
+	Name  string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name"`
+	Value string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value"`
+}
+
+func (m *ParameterChange) Reset()      { *m = ParameterChange{} }
+func (*ParameterChange) ProtoMessage() {}
+func (*ParameterChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{1}
+}
+func (m *ParameterChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterChange.Merge(m, src)
+}
+func (m *ParameterChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterChange proto.InternalMessageInfo
+
+func (m *ParameterChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ParameterChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type GovernanceConfig struct {
 	NumNodes         int64         `protobuf:"varint,1,opt,name=NumNodes,proto3" json:"NumNodes"`
 	MinQuorum        int32         `protobuf:"varint,2,opt,name=MinQuorum,proto3" json:"MinQuorum"`
@@ -203,7 +266,7 @@ type GovernanceConfig struct {
 func (m *GovernanceConfig) Reset()      { *m = GovernanceConfig{} }
 func (*GovernanceConfig) ProtoMessage() {}
 func (*GovernanceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{1}
+	return fileDescriptor_e18a03da5266c714, []int{2}
 }
 func (m *GovernanceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +338,7 @@ type GovernanceConfigV2 struct {
 func (m *GovernanceConfigV2) Reset()      { *m = GovernanceConfigV2{} }
 func (*GovernanceConfigV2) ProtoMessage() {}
 func (*GovernanceConfigV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{2}
+	return fileDescriptor_e18a03da5266c714, []int{3}
 }
 func (m *GovernanceConfigV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +413,7 @@ type OngoingVotedList struct {
 func (m *OngoingVotedList) Reset()      { *m = OngoingVotedList{} }
 func (*OngoingVotedList) ProtoMessage() {}
 func (*OngoingVotedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{3}
+	return fileDescriptor_e18a03da5266c714, []int{4}
 }
 func (m *OngoingVotedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +462,7 @@ type DelegatedSCVoteInfo struct {
 func (m *DelegatedSCVoteInfo) Reset()      { *m = DelegatedSCVoteInfo{} }
 func (*DelegatedSCVoteInfo) ProtoMessage() {}
 func (*DelegatedSCVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{4}
+	return fileDescriptor_e18a03da5266c714, []int{5}
 }
 func (m *DelegatedSCVoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,76 +515,124 @@ func (m *DelegatedSCVoteInfo) GetUsedStake() *math_big.Int {
 	return nil
 }
 
+type ParameterChangesList struct {
+	Nonces []uint64 `protobuf:"varint,1,rep,packed,name=Nonces,proto3" json:"Nonces"`
+}
+
+func (m *ParameterChangesList) Reset()      { *m = ParameterChangesList{} }
+func (*ParameterChangesList) ProtoMessage() {}
+func (*ParameterChangesList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{6}
+}
+func (m *ParameterChangesList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterChangesList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterChangesList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterChangesList.Merge(m, src)
+}
+func (m *ParameterChangesList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterChangesList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterChangesList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterChangesList proto.InternalMessageInfo
+
+func (m *ParameterChangesList) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("proto.VoteValueType", VoteValueType_name, VoteValueType_value)
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
+	proto.RegisterType((*ParameterChange)(nil), "proto.ParameterChange")
 	proto.RegisterType((*GovernanceConfig)(nil), "proto.GovernanceConfig")
 	proto.RegisterType((*GovernanceConfigV2)(nil), "proto.GovernanceConfigV2")
 	proto.RegisterType((*OngoingVotedList)(nil), "proto.OngoingVotedList")
 	proto.RegisterType((*DelegatedSCVoteInfo)(nil), "proto.DelegatedSCVoteInfo")
+	proto.RegisterType((*ParameterChangesList)(nil), "proto.ParameterChangesList")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 902 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x23, 0x35,
-	0x14, 0xcf, 0xe4, 0x5f, 0x5b, 0x37, 0xdd, 0x9d, 0x35, 0x8b, 0x34, 0xe2, 0x30, 0xae, 0x72, 0x8a,
-	0x40, 0x4d, 0x24, 0x40, 0x5a, 0x09, 0x2e, 0xec, 0x64, 0xcb, 0x52, 0xa9, 0x9b, 0xed, 0x4e, 0x4b,
-	0x60, 0x11, 0x12, 0x72, 0x66, 0xdc, 0xc9, 0x88, 0x8c, 0x5d, 0x8d, 0x9d, 0xfd, 0x83, 0x84, 0xc4,
-	0x89, 0x2b, 0x7c, 0x0c, 0xc4, 0x27, 0xe1, 0xd8, 0x63, 0x4f, 0x03, 0x4d, 0x2f, 0x60, 0x71, 0x58,
-	0x89, 0x2f, 0x80, 0xec, 0x49, 0xe6, 0x4f, 0x72, 0xaa, 0x18, 0x71, 0xb2, 0xdf, 0xef, 0xd9, 0xbf,
-	0xdf, 0xbc, 0x37, 0xcf, 0x7e, 0x06, 0x66, 0xc0, 0x5e, 0x90, 0x98, 0x62, 0xea, 0x91, 0xfe, 0x45,
-	0xcc, 0x04, 0x83, 0x2d, 0x3d, 0xbc, 0x73, 0x10, 0x84, 0x62, 0x3a, 0x9f, 0xf4, 0x3d, 0x16, 0x0d,
-	0x02, 0x16, 0xb0, 0x81, 0x86, 0x27, 0xf3, 0x73, 0x6d, 0x69, 0x43, 0xcf, 0xd2, 0x5d, 0xdd, 0xbf,
-	0xb7, 0xc0, 0xdd, 0xc7, 0x84, 0x92, 0x18, 0xcf, 0x4e, 0x62, 0x76, 0xc1, 0x38, 0x9e, 0x41, 0x04,
-	0x5a, 0x23, 0x46, 0x3d, 0x62, 0x19, 0xfb, 0x46, 0xaf, 0xe9, 0xec, 0xc8, 0x04, 0xa5, 0x80, 0x9b,
-	0x0e, 0xb0, 0x0f, 0xc0, 0x90, 0x45, 0x51, 0x28, 0x3e, 0xc3, 0x7c, 0x6a, 0xd5, 0xf7, 0x8d, 0x5e,
-	0xc7, 0xb9, 0x23, 0x13, 0x54, 0x40, 0xdd, 0xc2, 0x1c, 0x7e, 0x04, 0xee, 0x9c, 0x0a, 0x1c, 0x8b,
-	0x31, 0x13, 0xe4, 0xf0, 0x82, 0x79, 0x53, 0xab, 0xa1, 0x99, 0xa1, 0x4c, 0xd0, 0x9a, 0xc7, 0x5d,
-	0xb3, 0xe1, 0x87, 0xa0, 0x73, 0x48, 0xfd, 0x7c, 0x67, 0x53, 0xef, 0x34, 0x65, 0x82, 0x4a, 0xb8,
-	0x5b, 0xb2, 0xe0, 0x04, 0x34, 0x9e, 0x13, 0x6e, 0xb5, 0xf4, 0xa7, 0x9d, 0xc8, 0x04, 0x29, 0xf3,
-	0xd7, 0xdf, 0xd1, 0x61, 0x84, 0xc5, 0x74, 0x30, 0x09, 0x83, 0xfe, 0x11, 0x15, 0x1f, 0x17, 0x52,
-	0x15, 0xcd, 0x67, 0x22, 0x7c, 0x41, 0x62, 0xfe, 0x6a, 0x10, 0xbd, 0x3a, 0xf0, 0xa6, 0x38, 0xa4,
-	0x07, 0x1e, 0x8b, 0xc9, 0x41, 0xc0, 0x06, 0x3e, 0x16, 0xb8, 0xef, 0x84, 0xc1, 0x11, 0x15, 0x43,
-	0xcc, 0x05, 0x89, 0x5d, 0xc5, 0x06, 0xbf, 0x01, 0xf5, 0x11, 0xb3, 0xda, 0x5a, 0xe2, 0xa9, 0x4c,
-	0x50, 0x7d, 0xc4, 0xaa, 0x53, 0xa8, 0x8f, 0x18, 0x24, 0xa0, 0x39, 0x26, 0x82, 0x59, 0x5b, 0x5a,
-	0xe2, 0x99, 0x4c, 0x90, 0xb6, 0xab, 0x13, 0xd1, 0x74, 0x90, 0x82, 0xad, 0x87, 0x13, 0x2e, 0x70,
-	0x48, 0xad, 0x6d, 0xad, 0x74, 0x26, 0x13, 0xb4, 0x82, 0xaa, 0x13, 0x5b, 0x31, 0xc2, 0xef, 0xc0,
-	0xee, 0xb3, 0x39, 0x8b, 0xe7, 0xd1, 0xa9, 0xc0, 0xdf, 0x12, 0x6b, 0x47, 0x6b, 0x7e, 0x29, 0x13,
-	0x54, 0x84, 0xab, 0xd3, 0x2d, 0xb2, 0xc2, 0x2e, 0x68, 0x9f, 0x60, 0xce, 0x89, 0x6f, 0x81, 0x7d,
-	0xa3, 0xb7, 0xed, 0x00, 0x99, 0xa0, 0x25, 0xe2, 0x2e, 0x47, 0xb5, 0x66, 0x38, 0x63, 0x6a, 0xcd,
-	0x6e, 0xbe, 0x26, 0x45, 0xdc, 0xe5, 0x08, 0x1f, 0x80, 0xbd, 0x23, 0xce, 0xe7, 0x24, 0x7e, 0xe8,
-	0xfb, 0x31, 0xe1, 0xdc, 0xea, 0xe8, 0x28, 0xee, 0xc9, 0x04, 0x95, 0x1d, 0x6e, 0xd9, 0x84, 0xdf,
-	0x83, 0xce, 0xea, 0x9c, 0x0d, 0x19, 0x17, 0xd6, 0x9e, 0xde, 0xf7, 0x5c, 0x95, 0x73, 0x11, 0xaf,
-	0x2e, 0xfc, 0x12, 0x6d, 0xf7, 0xaf, 0x3a, 0x30, 0x1f, 0x67, 0x37, 0xc7, 0x90, 0xd1, 0xf3, 0x30,
-	0x80, 0x3d, 0xb0, 0x3d, 0x9a, 0x47, 0x23, 0xe6, 0x13, 0xae, 0x8f, 0x7c, 0xc3, 0xe9, 0xc8, 0x04,
-	0x65, 0x98, 0x9b, 0xcd, 0xe0, 0x7b, 0x60, 0xe7, 0x49, 0x48, 0xd3, 0x84, 0xea, 0x73, 0xdf, 0x72,
-	0xf6, 0x64, 0x82, 0x72, 0xd0, 0xcd, 0xa7, 0xf0, 0x13, 0x60, 0x3e, 0x09, 0xa9, 0x4a, 0xea, 0xd9,
-	0x34, 0x26, 0x7c, 0xca, 0x66, 0xbe, 0x3e, 0xf7, 0x2d, 0xe7, 0xbe, 0x4c, 0xd0, 0x86, 0xcf, 0xdd,
-	0x40, 0x96, 0x0c, 0xaa, 0x48, 0x73, 0x86, 0x66, 0x89, 0xa1, 0xe4, 0x73, 0x37, 0x10, 0x55, 0x6b,
-	0xab, 0xf8, 0x3f, 0x25, 0x64, 0x79, 0x1f, 0xe8, 0x5a, 0x2b, 0xc0, 0x15, 0xd6, 0x5a, 0x81, 0xb5,
-	0xfb, 0x53, 0x13, 0xc0, 0xf5, 0x5c, 0x8f, 0xdf, 0x2f, 0xe7, 0x50, 0xa5, 0xbb, 0x7e, 0xcb, 0x1c,
-	0xd6, 0xf5, 0x9e, 0xff, 0x92, 0xc3, 0x46, 0x89, 0xe1, 0x96, 0x39, 0x6c, 0xfe, 0x8f, 0x39, 0x84,
-	0x3f, 0x1a, 0xe0, 0xee, 0x31, 0xe3, 0x62, 0xf3, 0x27, 0x7e, 0x2d, 0x13, 0xb4, 0xee, 0xaa, 0xee,
-	0x23, 0xd6, 0x99, 0xe1, 0x10, 0xdc, 0x3b, 0xc6, 0x39, 0x94, 0xf6, 0xc7, 0xb6, 0xee, 0x45, 0x6f,
-	0xcb, 0x04, 0x6d, 0x3a, 0xdd, 0x4d, 0xa8, 0xeb, 0x01, 0xf3, 0x29, 0x0d, 0x58, 0x48, 0x03, 0xd5,
-	0xa9, 0xfc, 0xe3, 0x90, 0x0b, 0x75, 0xdb, 0x3c, 0x0a, 0x63, 0xe2, 0x09, 0xcb, 0xd8, 0x6f, 0xf4,
-	0x9a, 0xe9, 0x6d, 0x93, 0x22, 0xee, 0x72, 0x54, 0x25, 0xf3, 0x88, 0xcc, 0x48, 0x80, 0x05, 0x51,
-	0xbf, 0x5f, 0x2d, 0xd3, 0x25, 0x93, 0x81, 0x6e, 0x3e, 0xed, 0xfe, 0xd3, 0x00, 0x6f, 0x65, 0xd6,
-	0xe9, 0x50, 0x29, 0x1d, 0xd1, 0x73, 0x06, 0x5f, 0x02, 0x70, 0xc6, 0x04, 0x9e, 0x9d, 0xb0, 0x97,
-	0x24, 0xd6, 0x85, 0xd7, 0x71, 0xbe, 0x50, 0x4d, 0x3b, 0x47, 0xab, 0xcb, 0x5f, 0x81, 0x14, 0x0a,
-	0xb0, 0xf3, 0x39, 0x27, 0x7e, 0xaa, 0x9b, 0x3e, 0x16, 0xc6, 0xea, 0xeb, 0x33, 0xb0, 0x3a, 0xd9,
-	0x9c, 0x33, 0x0b, 0x37, 0x6d, 0x32, 0x8d, 0xb5, 0x70, 0x2b, 0xee, 0x31, 0x05, 0xd2, 0x55, 0xb8,
-	0xa9, 0x6e, 0xb3, 0x1c, 0x6e, 0xc5, 0xb2, 0x39, 0xe7, 0xbb, 0x0f, 0xc0, 0x9e, 0xfa, 0xd3, 0x63,
-	0x3c, 0x9b, 0x93, 0xb3, 0xd7, 0x17, 0x04, 0x6e, 0xe9, 0x17, 0x90, 0x59, 0x83, 0x6d, 0xf5, 0x4c,
-	0x31, 0x0d, 0xb8, 0x9d, 0xbe, 0x26, 0xcc, 0x3a, 0xdc, 0xcd, 0x1a, 0xbe, 0xd9, 0x70, 0x46, 0x97,
-	0xd7, 0x76, 0xed, 0xea, 0xda, 0xae, 0xbd, 0xb9, 0xb6, 0x8d, 0x1f, 0x16, 0xb6, 0xf1, 0xcb, 0xc2,
-	0x36, 0x7e, 0x5b, 0xd8, 0xc6, 0xe5, 0xc2, 0x36, 0xae, 0x16, 0xb6, 0xf1, 0xc7, 0xc2, 0x36, 0xfe,
-	0x5c, 0xd8, 0xb5, 0x37, 0x0b, 0xdb, 0xf8, 0xf9, 0xc6, 0xae, 0x5d, 0xde, 0xd8, 0xb5, 0xab, 0x1b,
-	0xbb, 0xf6, 0xd5, 0x7d, 0xfe, 0x9a, 0x0b, 0x12, 0x9d, 0x46, 0x38, 0x16, 0x43, 0x46, 0x45, 0x8c,
-	0x3d, 0xc1, 0x27, 0x6d, 0xfd, 0xae, 0xfc, 0xe0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xcd,
-	0x78, 0x44, 0xa1, 0x0a, 0x00, 0x00,
+	// 1011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xfa, 0x4f, 0x12, 0x4f, 0x9c, 0x64, 0x3b, 0x0d, 0x68, 0x85, 0xd0, 0xae, 0xe5, 0x93,
+	0x05, 0x8a, 0x2d, 0x15, 0xa4, 0x4a, 0x45, 0x48, 0x64, 0xdd, 0x50, 0x22, 0xa5, 0xae, 0x3b, 0x09,
+	0xa6, 0x45, 0x48, 0x68, 0xbc, 0x9e, 0xac, 0x57, 0x78, 0x77, 0xa2, 0x9d, 0x71, 0xda, 0x22, 0x21,
+	0x71, 0x81, 0x2b, 0x7c, 0x0c, 0xc4, 0x27, 0xe1, 0x98, 0x63, 0x4e, 0x0b, 0x71, 0x2e, 0xb0, 0xa7,
+	0x4a, 0x7c, 0x01, 0x34, 0x33, 0xb6, 0xf7, 0x8f, 0x4f, 0x15, 0xab, 0x5e, 0x3c, 0xf3, 0x7e, 0x6f,
+	0xe6, 0xf7, 0xf3, 0xbc, 0x7d, 0xef, 0xcd, 0x00, 0xdd, 0xa5, 0x97, 0x24, 0x0c, 0x70, 0xe0, 0x90,
+	0xce, 0x45, 0x48, 0x39, 0x85, 0x35, 0x39, 0xbc, 0x77, 0xe0, 0x7a, 0x7c, 0x32, 0x1b, 0x75, 0x1c,
+	0xea, 0x77, 0x5d, 0xea, 0xd2, 0xae, 0x84, 0x47, 0xb3, 0x73, 0x69, 0x49, 0x43, 0xce, 0xd4, 0xae,
+	0xd6, 0x4f, 0x75, 0xb0, 0xf7, 0x88, 0x04, 0x24, 0xc4, 0xd3, 0x41, 0x48, 0x2f, 0x28, 0xc3, 0x53,
+	0x68, 0x81, 0x5a, 0x9f, 0x06, 0x0e, 0x31, 0xb4, 0xa6, 0xd6, 0xae, 0xda, 0xf5, 0x38, 0xb2, 0x14,
+	0x80, 0xd4, 0x00, 0x3b, 0x00, 0xf4, 0xa8, 0xef, 0x7b, 0xfc, 0x0b, 0xcc, 0x26, 0x46, 0xb9, 0xa9,
+	0xb5, 0x1b, 0xf6, 0x6e, 0x1c, 0x59, 0x29, 0x14, 0xa5, 0xe6, 0xf0, 0x01, 0xd8, 0x3d, 0xe5, 0x38,
+	0xe4, 0x43, 0xca, 0xc9, 0xd1, 0x05, 0x75, 0x26, 0x46, 0x45, 0x32, 0xc3, 0x38, 0xb2, 0x72, 0x1e,
+	0x94, 0xb3, 0xe1, 0xc7, 0xa0, 0x71, 0x14, 0x8c, 0x93, 0x9d, 0x55, 0xb9, 0x53, 0x8f, 0x23, 0x2b,
+	0x83, 0xa3, 0x8c, 0x05, 0x47, 0xa0, 0xf2, 0x9c, 0x30, 0xa3, 0x26, 0xff, 0xda, 0x20, 0x8e, 0x2c,
+	0x61, 0xfe, 0xfe, 0xa7, 0x75, 0xe4, 0x63, 0x3e, 0xe9, 0x8e, 0x3c, 0xb7, 0x73, 0x1c, 0xf0, 0x4f,
+	0x52, 0xa1, 0xf2, 0x67, 0x53, 0xee, 0x5d, 0x92, 0x90, 0xbd, 0xec, 0xfa, 0x2f, 0x0f, 0x9c, 0x09,
+	0xf6, 0x82, 0x03, 0x87, 0x86, 0xe4, 0xc0, 0xa5, 0xdd, 0x31, 0xe6, 0xb8, 0x63, 0x7b, 0xee, 0x71,
+	0xc0, 0x7b, 0x98, 0x71, 0x12, 0x22, 0xc1, 0x06, 0xbf, 0x05, 0xe5, 0x3e, 0x35, 0x36, 0xa4, 0xc4,
+	0x93, 0x38, 0xb2, 0xca, 0x7d, 0x5a, 0x9c, 0x42, 0xb9, 0x4f, 0x21, 0x01, 0xd5, 0x21, 0xe1, 0xd4,
+	0xd8, 0x94, 0x12, 0x4f, 0xe3, 0xc8, 0x92, 0x76, 0x71, 0x22, 0x92, 0x0e, 0x06, 0x60, 0xf3, 0x70,
+	0xc4, 0x38, 0xf6, 0x02, 0x63, 0x4b, 0x2a, 0x9d, 0xc5, 0x91, 0xb5, 0x84, 0x8a, 0x13, 0x5b, 0x32,
+	0xc2, 0xef, 0xc1, 0xf6, 0xd3, 0x19, 0x0d, 0x67, 0xfe, 0x29, 0xc7, 0xdf, 0x11, 0xa3, 0x2e, 0x35,
+	0x9f, 0xc5, 0x91, 0x95, 0x86, 0x8b, 0xd3, 0x4d, 0xb3, 0xc2, 0x16, 0xd8, 0x18, 0x60, 0xc6, 0xc8,
+	0xd8, 0x00, 0x4d, 0xad, 0xbd, 0x65, 0x83, 0x38, 0xb2, 0x16, 0x08, 0x5a, 0x8c, 0x62, 0x4d, 0x6f,
+	0x4a, 0xc5, 0x9a, 0xed, 0x64, 0x8d, 0x42, 0xd0, 0x62, 0x84, 0xf7, 0xc1, 0xce, 0x31, 0x63, 0x33,
+	0x12, 0x1e, 0x8e, 0xc7, 0x21, 0x61, 0xcc, 0x68, 0xc8, 0x53, 0xdc, 0x89, 0x23, 0x2b, 0xeb, 0x40,
+	0x59, 0x13, 0xfe, 0x00, 0x1a, 0xcb, 0x3a, 0xeb, 0x51, 0xc6, 0x8d, 0x1d, 0xb9, 0xef, 0xb9, 0x48,
+	0xe7, 0x34, 0x5e, 0xdc, 0xf1, 0x33, 0xb4, 0xf0, 0x19, 0xd0, 0x07, 0x38, 0xc4, 0x3e, 0xe1, 0x24,
+	0xec, 0x4d, 0x70, 0xe0, 0x12, 0x66, 0xec, 0x36, 0x2b, 0xed, 0xed, 0x7b, 0xef, 0xaa, 0x86, 0xd0,
+	0xc9, 0xb9, 0xed, 0xfd, 0x38, 0xb2, 0xd6, 0xf6, 0xa0, 0x35, 0x04, 0x7e, 0x0a, 0xf6, 0x0e, 0x1d,
+	0xee, 0x5d, 0x62, 0xee, 0xd1, 0x40, 0x95, 0xea, 0x9e, 0x2c, 0xd5, 0xbb, 0x71, 0x64, 0xe5, 0x5d,
+	0x28, 0x0f, 0xb4, 0x06, 0x60, 0x2f, 0x47, 0x09, 0xdf, 0x07, 0xd5, 0x3e, 0xf6, 0x55, 0x17, 0xaa,
+	0xdb, 0x5b, 0x22, 0xfd, 0x85, 0x8d, 0xe4, 0xaf, 0x68, 0x52, 0x43, 0x3c, 0x9d, 0x11, 0xd9, 0x7e,
+	0xea, 0xaa, 0x49, 0x49, 0x00, 0xa9, 0xa1, 0xf5, 0x4f, 0x19, 0xe8, 0x8f, 0x56, 0x4d, 0xb2, 0x47,
+	0x83, 0x73, 0xcf, 0x85, 0x6d, 0xb0, 0xd5, 0x9f, 0xf9, 0x7d, 0x3a, 0x26, 0x4c, 0xf2, 0x56, 0xec,
+	0x46, 0x1c, 0x59, 0x2b, 0x0c, 0xad, 0x66, 0xf0, 0x43, 0x50, 0x7f, 0xec, 0x05, 0x2a, 0x77, 0xa4,
+	0x46, 0xcd, 0xde, 0x89, 0x23, 0x2b, 0x01, 0x51, 0x32, 0x85, 0x9f, 0x01, 0xfd, 0xb1, 0x17, 0x88,
+	0xfc, 0x39, 0x9b, 0x84, 0x84, 0x4d, 0xe8, 0x74, 0x2c, 0x5b, 0x5c, 0x4d, 0x85, 0x2f, 0xef, 0x43,
+	0x6b, 0xc8, 0x82, 0x41, 0xd4, 0x63, 0xc2, 0x50, 0xcd, 0x30, 0x64, 0x7c, 0x68, 0x0d, 0x11, 0x65,
+	0xb5, 0xfc, 0xd4, 0x9f, 0x13, 0x62, 0xd4, 0x92, 0xb2, 0x4a, 0xc1, 0x05, 0x96, 0x55, 0x8a, 0xb5,
+	0xf5, 0x4b, 0x15, 0xc0, 0x7c, 0xac, 0x87, 0xf7, 0xb2, 0x31, 0x14, 0xe1, 0x2e, 0xbf, 0x61, 0x0c,
+	0xcb, 0x72, 0xcf, 0xff, 0x89, 0x61, 0x25, 0xc3, 0xf0, 0x86, 0x31, 0xac, 0xbe, 0xc5, 0x18, 0xc2,
+	0x9f, 0x35, 0xb0, 0x77, 0x42, 0x19, 0x5f, 0xff, 0x88, 0xdf, 0x88, 0x0a, 0xca, 0xb9, 0x8a, 0xfb,
+	0x13, 0x79, 0x66, 0xd8, 0x03, 0x77, 0x4e, 0x70, 0x02, 0xa9, 0xa7, 0xc0, 0x86, 0xac, 0xe5, 0x77,
+	0xe2, 0xc8, 0x5a, 0x77, 0xa2, 0x75, 0xa8, 0xe5, 0x00, 0xfd, 0x49, 0xe0, 0x52, 0x2f, 0x70, 0xc5,
+	0xa5, 0x3c, 0x3e, 0xf1, 0x18, 0x17, 0x8d, 0xf5, 0xa1, 0x17, 0x12, 0x87, 0x1b, 0x5a, 0xb3, 0xd2,
+	0xae, 0xaa, 0xc6, 0xaa, 0x10, 0xb4, 0x18, 0x45, 0xca, 0x3c, 0x24, 0x53, 0xe2, 0x62, 0x4e, 0xc4,
+	0xe7, 0x17, 0xcb, 0x64, 0xca, 0xac, 0x40, 0x94, 0x4c, 0x5b, 0xff, 0x56, 0xc0, 0xdd, 0x95, 0x75,
+	0xda, 0x13, 0x4a, 0xc7, 0xc1, 0x39, 0x85, 0x2f, 0x00, 0x38, 0xa3, 0x1c, 0x4f, 0x07, 0xf4, 0x05,
+	0x09, 0x65, 0xe2, 0x35, 0xec, 0xaf, 0xc4, 0xfb, 0x24, 0x41, 0x8b, 0x8b, 0x5f, 0x8a, 0x14, 0x72,
+	0x50, 0xff, 0x92, 0x91, 0xb1, 0xd2, 0x55, 0xef, 0xa2, 0xa1, 0xf8, 0xf7, 0x2b, 0xb0, 0x38, 0xd9,
+	0x84, 0x73, 0x75, 0x5c, 0x75, 0x9f, 0x56, 0x72, 0xc7, 0x2d, 0xf8, 0x3a, 0x4d, 0x91, 0x2e, 0x8f,
+	0xab, 0x74, 0xab, 0xd9, 0xe3, 0x16, 0x2c, 0x9b, 0x70, 0xb6, 0x1e, 0x80, 0xfd, 0xfc, 0xed, 0xb3,
+	0x4c, 0x2f, 0x99, 0x7b, 0x2c, 0x9d, 0x5e, 0x0a, 0x41, 0x8b, 0xf1, 0x83, 0xfb, 0x60, 0x47, 0x64,
+	0x89, 0xbc, 0x21, 0xce, 0x5e, 0x5d, 0x10, 0xb8, 0x29, 0x1f, 0x8a, 0x7a, 0x09, 0x6e, 0x88, 0xd7,
+	0x9c, 0xae, 0xc1, 0x2d, 0xf5, 0xe8, 0xd2, 0xcb, 0x70, 0x7b, 0xf5, 0x2e, 0xd2, 0x2b, 0x76, 0xff,
+	0xea, 0xc6, 0x2c, 0x5d, 0xdf, 0x98, 0xa5, 0xd7, 0x37, 0xa6, 0xf6, 0xe3, 0xdc, 0xd4, 0x7e, 0x9b,
+	0x9b, 0xda, 0x1f, 0x73, 0x53, 0xbb, 0x9a, 0x9b, 0xda, 0xf5, 0xdc, 0xd4, 0xfe, 0x9a, 0x9b, 0xda,
+	0xdf, 0x73, 0xb3, 0xf4, 0x7a, 0x6e, 0x6a, 0xbf, 0xde, 0x9a, 0xa5, 0xab, 0x5b, 0xb3, 0x74, 0x7d,
+	0x6b, 0x96, 0xbe, 0xde, 0x67, 0xaf, 0x18, 0x27, 0xfe, 0xa9, 0x8f, 0x43, 0xde, 0xa3, 0x01, 0x0f,
+	0xb1, 0xc3, 0xd9, 0x68, 0x43, 0xde, 0xb6, 0x1f, 0xfd, 0x37, 0x00, 0xb9, 0x28, 0xd9, 0xd5, 0xc8,
+	0x0b, 0x00, 0x00,
 }
 
 func (x VoteValueType) String() string {
@@ -607,6 +718,44 @@ func (this *GeneralProposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ParameterChanges) != len(that1.ParameterChanges) {
+		return false
+	}
+	for i := range this.ParameterChanges {
+		if !this.ParameterChanges[i].Equal(that1.ParameterChanges[i]) {
+			return false
+		}
+	}
+	if this.ActivationEpoch != that1.ActivationEpoch {
+		return false
+	}
+	return true
+}
+func (this *ParameterChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParameterChange)
+	if !ok {
+		that2, ok := that.(ParameterChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
 	return true
 }
 func (this *GovernanceConfig) Equal(that interface{}) bool {
@@ -775,11 +924,40 @@ func (this *DelegatedSCVoteInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ParameterChangesList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParameterChangesList)
+	if !ok {
+		that2, ok := that.(ParameterChangesList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nonces) != len(that1.Nonces) {
+		return false
+	}
+	for i := range this.Nonces {
+		if this.Nonces[i] != that1.Nonces[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *GeneralProposal) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&systemSmartContracts.GeneralProposal{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "CommitHash: "+fmt.Sprintf("%#v", this.CommitHash)+",\n")
@@ -794,6 +972,21 @@ func (this *GeneralProposal) GoString() string {
 	s = append(s, "Closed: "+fmt.Sprintf("%#v", this.Closed)+",\n")
 	s = append(s, "IssuerAddress: "+fmt.Sprintf("%#v", this.IssuerAddress)+",\n")
 	s = append(s, "ProposalCost: "+fmt.Sprintf("%#v", this.ProposalCost)+",\n")
+	if this.ParameterChanges != nil {
+		s = append(s, "ParameterChanges: "+fmt.Sprintf("%#v", this.ParameterChanges)+",\n")
+	}
+	s = append(s, "ActivationEpoch: "+fmt.Sprintf("%#v", this.ActivationEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ParameterChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ParameterChange{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -850,6 +1043,16 @@ func (this *DelegatedSCVoteInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ParameterChangesList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.ParameterChangesList{")
+	s = append(s, "Nonces: "+fmt.Sprintf("%#v", this.Nonces)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringGovernance(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -878,6 +1081,25 @@ func (m *GeneralProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ParameterChanges) > 0 {
+		for iNdEx := len(m.ParameterChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParameterChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	{
 		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
 		size := __caster.Size(m.ProposalCost)
@@ -996,6 +1218,43 @@ func (m *GeneralProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GovernanceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1244,6 +1503,47 @@ func (m *DelegatedSCVoteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterChangesList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterChangesList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterChangesList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		dAtA6 := make([]byte, len(m.Nonces)*10)
+		var j5 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGovernance(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernance(v)
 	base := offset
@@ -1314,6 +1614,32 @@ func (m *GeneralProposal) Size() (n int) {
 		l = __caster.Size(m.ProposalCost)
 		n += 1 + l + sovGovernance(uint64(l))
 	}
+	if len(m.ParameterChanges) > 0 {
+		for _, e := range m.ParameterChanges {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovGovernance(uint64(m.ActivationEpoch))
+	}
+	return n
+}
+
+func (m *ParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
 	return n
 }
 
@@ -1426,6 +1752,22 @@ func (m *DelegatedSCVoteInfo) Size() (n int) {
 	return n
 }
 
+func (m *ParameterChangesList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovGovernance(uint64(e))
+		}
+		n += 1 + sovGovernance(uint64(l)) + l
+	}
+	return n
+}
+
 func sovGovernance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1436,6 +1778,11 @@ func (this *GeneralProposal) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForParameterChanges := "[]*ParameterChange{"
+	for _, f := range this.ParameterChanges {
+		repeatedStringForParameterChanges += strings.Replace(f.String(), "ParameterChange", "ParameterChange", 1) + ","
+	}
+	repeatedStringForParameterChanges += "}"
 	s := strings.Join([]string{`&GeneralProposal{`,
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`CommitHash:` + fmt.Sprintf("%v", this.CommitHash) + `,`,
@@ -1450,6 +1797,19 @@ func (this *GeneralProposal) String() string {
 		`Closed:` + fmt.Sprintf("%v", this.Closed) + `,`,
 		`IssuerAddress:` + fmt.Sprintf("%v", this.IssuerAddress) + `,`,
 		`ProposalCost:` + fmt.Sprintf("%v", this.ProposalCost) + `,`,
+		`ParameterChanges:` + repeatedStringForParameterChanges + `,`,
+		`ActivationEpoch:` + fmt.Sprintf("%v", this.ActivationEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterChange{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1507,6 +1867,16 @@ func (this *DelegatedSCVoteInfo) String() string {
 	}, "")
 	return s
 }
+func (this *ParameterChangesList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterChangesList{`,
+		`Nonces:` + fmt.Sprintf("%v", this.Nonces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGovernance(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1937,6 +2307,176 @@ func (m *GeneralProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParameterChanges = append(m.ParameterChanges, &ParameterChange{})
+			if err := m.ParameterChanges[len(m.ParameterChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParameterChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGovernance(dAtA[iNdEx:])
@@ -2719,6 +3259,135 @@ func (m *DelegatedSCVoteInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParameterChangesList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterChangesList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterChangesList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGovernance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGovernance
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGovernance
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGovernance
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGovernance
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovernance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
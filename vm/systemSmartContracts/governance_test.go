@@ -10,6 +10,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/governance"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
@@ -59,6 +60,7 @@ func createArgsWithEEI(eei vm.SystemEI) ArgsNewGovernanceContract {
 		OwnerAddress:           bytes.Repeat([]byte{1}, 32),
 		UnBondPeriodInEpochs:   10,
 		EnableEpochsHandler:    enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag),
+		Economics:              &mock.EconomicsHandlerStub{},
 	}
 }
 
@@ -175,6 +177,17 @@ func TestNewGovernanceContract_NilEnableEpochsHandlerShouldErr(t *testing.T) {
 	require.Equal(t, vm.ErrNilEnableEpochsHandler, err)
 }
 
+func TestNewGovernanceContract_NilEconomicsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.Economics = nil
+
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, gsc)
+	require.Equal(t, vm.ErrNilEconomicsData, err)
+}
+
 func TestNewGovernanceContract_InvalidEnableEpochsHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1968,6 +1981,460 @@ func TestGovernanceContract_ProposeClosePayFee(t *testing.T) {
 	require.Equal(t, big.NewInt(499), eei.GetTotalSentToUser(callInput.CallerAddr))
 }
 
+func createParameterChangeProposalArgs(proposalIdentifier []byte, activationEpoch int64, changes ...string) [][]byte {
+	arguments := [][]byte{
+		proposalIdentifier,
+		big.NewInt(50).Bytes(),
+		big.NewInt(55).Bytes(),
+		big.NewInt(activationEpoch).Bytes(),
+	}
+	for _, change := range changes {
+		arguments = append(arguments, []byte(change))
+	}
+
+	return arguments
+}
+
+func TestGovernanceContract_ParameterChangeProposal(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, _ := createGovernanceBlockChainHookStubContextHandler()
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MinQuorum, "2000")
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+	})
+	t.Run("invalid number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, _ := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MinQuorum)
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+		callInput.Arguments = createParameterChangeProposalArgs(proposalIdentifier, 60)
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+	})
+	t.Run("invalid parameter changes should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, "Economics.Unknown", "2000")
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), governance.ErrUnknownParameter.Error())
+
+		callInput.Arguments = createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MaxGasLimitPerTx, "abc")
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), governance.ErrInvalidParameterValue.Error())
+
+		callInput.Arguments = createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MaxGasLimitPerTx, "10", governance.MaxGasLimitPerTx, "20")
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), vm.ErrDuplicatedParameterChange.Error())
+	})
+	t.Run("inconsistent proposal fees should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.LostProposalFee, "10")
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), "should be changed together")
+
+		callInput.Arguments = createParameterChangeProposalArgs(proposalIdentifier, 60, governance.ProposalFee, "10", governance.LostProposalFee, "11")
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), "proposal fee is smaller than lost proposal fee")
+
+		callInput.Arguments = createParameterChangeProposalArgs(proposalIdentifier, 60, governance.ProposalFee, "10", governance.LostProposalFee, "10")
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	})
+	t.Run("inconsistent gas limits should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MaxGasLimitPerTx, "10000")
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), governance.ErrInconsistentGasLimits.Error())
+
+		callInput.Arguments = createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MaxGasLimitPerTx, "10000", governance.MinGasLimit, "10000")
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	})
+	t.Run("activation epoch not after the end vote epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 55, governance.MinQuorum, "2000")
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, eei.GetReturnMessage(), "invalid activation epoch")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, _ := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MinQuorum, "2000", "GasSchedule.BuiltInCost.ESDTTransfer", "300000")
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		proposal, err := gsc.getProposalFromNonce(big.NewInt(1))
+		require.Nil(t, err)
+		require.Equal(t, uint64(60), proposal.ActivationEpoch)
+		require.Equal(t, []*ParameterChange{
+			{Name: governance.MinQuorum, Value: "2000"},
+			{Name: "GasSchedule.BuiltInCost.ESDTTransfer", Value: "300000"},
+		}, proposal.ParameterChanges)
+
+		logsEntry := gsc.eei.GetLogs()
+		require.Equal(t, 1, len(logsEntry))
+		expectedTopics := [][]byte{{1}, proposalIdentifier, callInputArgs[1], callInputArgs[2], big.NewInt(60).Bytes()}
+		require.Equal(t, expectedTopics, logsEntry[0].Topics)
+	})
+}
+
+func TestGovernanceContract_ParameterChangeProposalPassedShouldApplyGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+
+	gsc, blockchainHook, eei := createGovernanceBlockChainHookStubContextHandler()
+	gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+	callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MinQuorum, "2000", governance.MaxGasLimitPerTx, "1000000")
+	callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	currentEpoch := uint32(52)
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return currentEpoch
+	}
+
+	callInput = createVMInput(big.NewInt(0), "vote", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes(), []byte("yes")})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	currentEpoch = 56
+	callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	inEffect, _ := gsc.getParameterChangesInEffect()
+	require.Empty(t, inEffect.Changes)
+	pendingChanges, _ := gsc.getNoncesList([]byte(pendingParameterChangesKey))
+	require.Equal(t, []uint64{1}, pendingChanges.Nonces)
+
+	scConfig, err := gsc.getConfig()
+	require.Nil(t, err)
+	require.Equal(t, float32(0.5), scConfig.MinQuorum)
+
+	callInput = createVMInput(big.NewInt(0), "viewParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedReturnData := [][]byte{big.NewInt(60).Bytes(), []byte(governance.MinQuorum), []byte("2000"), []byte(governance.MaxGasLimitPerTx), []byte("1000000")}
+	returnData := eei.CreateVMOutput().ReturnData
+	require.Equal(t, expectedReturnData, returnData[len(returnData)-len(expectedReturnData):])
+
+	callInput = createVMInput(big.NewInt(0), "viewAllParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedReturnData = [][]byte{
+		big.NewInt(1).Bytes(), big.NewInt(60).Bytes(), []byte(governance.MinQuorum), []byte("2000"),
+		big.NewInt(1).Bytes(), big.NewInt(60).Bytes(), []byte(governance.MaxGasLimitPerTx), []byte("1000000"),
+	}
+	returnData = eei.CreateVMOutput().ReturnData
+	require.Equal(t, expectedReturnData, returnData[len(returnData)-len(expectedReturnData):])
+
+	currentEpoch = 60
+	scConfig, err = gsc.getConfig()
+	require.Nil(t, err)
+	require.Equal(t, float32(0.5), scConfig.MinQuorum)
+
+	callInput = createVMInput(big.NewInt(0), "processParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(59).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	pendingChanges, _ = gsc.getNoncesList([]byte(pendingParameterChangesKey))
+	require.Equal(t, []uint64{1}, pendingChanges.Nonces)
+
+	callInput.Arguments = [][]byte{big.NewInt(60).Bytes()}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	scConfig, err = gsc.getConfig()
+	require.Nil(t, err)
+	require.Equal(t, float32(0.2), scConfig.MinQuorum)
+
+	pendingChanges, _ = gsc.getNoncesList([]byte(pendingParameterChangesKey))
+	require.Empty(t, pendingChanges.Nonces)
+	inEffect, _ = gsc.getParameterChangesInEffect()
+	require.Equal(t, []*governance.ParameterChange{
+		{ProposalNonce: 1, Name: governance.MaxGasLimitPerTx, Value: "1000000", ActivationEpoch: 60},
+	}, inEffect.Changes)
+
+	callInput = createVMInput(big.NewInt(0), "viewAllParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedReturnData = [][]byte{big.NewInt(1).Bytes(), big.NewInt(60).Bytes(), []byte(governance.MaxGasLimitPerTx), []byte("1000000")}
+	returnData = eei.CreateVMOutput().ReturnData
+	require.Equal(t, expectedReturnData, returnData[len(returnData)-len(expectedReturnData):])
+}
+
+func TestGovernanceContract_ProcessParameterChangesShouldKeepTheLatestChangeOfEachParameter(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+
+	gsc, blockchainHook, _ := createGovernanceBlockChainHookStubContextHandler()
+	gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+	currentEpoch := uint32(0)
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return currentEpoch
+	}
+	proposals := []struct {
+		activationEpoch int64
+		changes         []string
+	}{
+		{activationEpoch: 60, changes: []string{governance.MaxGasLimitPerTx, "1000000", governance.MinGasLimit, "60000"}},
+		{activationEpoch: 61, changes: []string{governance.MaxGasLimitPerTx, "2000000"}},
+		{activationEpoch: 70, changes: []string{governance.MaxGasLimitPerTx, "3000000"}},
+	}
+	for i, proposal := range proposals {
+		currentEpoch = 0
+		proposalIdentifier := bytes.Repeat([]byte{byte('a' + i)}, commitHashLength)
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, proposal.activationEpoch, proposal.changes...)
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		nonce := big.NewInt(int64(i + 1)).Bytes()
+		currentEpoch = 52
+		callInput = createVMInput(big.NewInt(0), "vote", callerAddress, vm.GovernanceSCAddress, [][]byte{nonce, []byte("yes")})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		currentEpoch = 56
+		callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{nonce})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	callInput := createVMInput(big.NewInt(0), "processParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(65).Bytes()})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	inEffect, _ := gsc.getParameterChangesInEffect()
+	require.Equal(t, []*governance.ParameterChange{
+		{ProposalNonce: 1, Name: governance.MinGasLimit, Value: "60000", ActivationEpoch: 60},
+		{ProposalNonce: 2, Name: governance.MaxGasLimitPerTx, Value: "2000000", ActivationEpoch: 61},
+	}, inEffect.Changes)
+	pendingChanges, _ := gsc.getNoncesList([]byte(pendingParameterChangesKey))
+	require.Equal(t, []uint64{3}, pendingChanges.Nonces)
+}
+
+func TestGovernanceContract_ProcessParameterChangesShouldRejectInconsistentGasLimits(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+
+	gsc, blockchainHook, eei := createGovernanceBlockChainHookStubContextHandler()
+	gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+	currentEpoch := uint32(0)
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return currentEpoch
+	}
+	// each proposal is consistent on its own, while the second one conflicts with the first one once it passes
+	proposals := []struct {
+		activationEpoch int64
+		changes         []string
+	}{
+		{activationEpoch: 60, changes: []string{governance.MinGasLimit, "100000"}},
+		{activationEpoch: 61, changes: []string{governance.MaxGasLimitPerTx, "90000", governance.MinQuorum, "2000"}},
+	}
+	for i, proposal := range proposals {
+		currentEpoch = 0
+		proposalIdentifier := bytes.Repeat([]byte{byte('a' + i)}, commitHashLength)
+		callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, proposal.activationEpoch, proposal.changes...)
+		callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+	for i := range proposals {
+		nonce := big.NewInt(int64(i + 1)).Bytes()
+		currentEpoch = 52
+		callInput := createVMInput(big.NewInt(0), "vote", callerAddress, vm.GovernanceSCAddress, [][]byte{nonce, []byte("yes")})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		currentEpoch = 56
+		callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{nonce})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	callInput := createVMInput(big.NewInt(0), "processParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(61).Bytes()})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	inEffect, _ := gsc.getParameterChangesInEffect()
+	require.Equal(t, []*governance.ParameterChange{
+		{ProposalNonce: 1, Name: governance.MinGasLimit, Value: "100000", ActivationEpoch: 60},
+	}, inEffect.Changes)
+	scConfig, err := gsc.getConfig()
+	require.Nil(t, err)
+	require.Equal(t, float32(0.2), scConfig.MinQuorum)
+
+	callInput = createVMInput(big.NewInt(0), "viewRejectedParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedReturnData := [][]byte{big.NewInt(2).Bytes(), big.NewInt(61).Bytes(), []byte(governance.MaxGasLimitPerTx), []byte("90000")}
+	returnData := eei.CreateVMOutput().ReturnData
+	require.Equal(t, expectedReturnData, returnData[len(returnData)-len(expectedReturnData):])
+}
+
+func TestGovernanceContract_ProcessParameterChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, _ := createGovernanceBlockChainHookStubContextHandler()
+		callInput := createVMInput(big.NewInt(0), "processParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(60).Bytes()})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+	})
+	t.Run("invalid caller should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		callInput := createVMInput(big.NewInt(0), "processParameterChanges", bytes.Repeat([]byte{2}, 32), vm.GovernanceSCAddress, [][]byte{big.NewInt(60).Bytes()})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Equal(t, "invalid caller to process the parameter changes", eei.GetReturnMessage())
+	})
+	t.Run("invalid number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, _ := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		callInput := createVMInput(big.NewInt(0), "processParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+	})
+	t.Run("no pending changes should work", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, _ := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+		callInput := createVMInput(big.NewInt(0), "processParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(60).Bytes()})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		inEffect, _ := gsc.getParameterChangesInEffect()
+		require.Empty(t, inEffect.Changes)
+	})
+}
+
+func TestGovernanceContract_ParameterChangeProposalClosedLateShouldPostponeActivation(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+
+	gsc, blockchainHook, _ := createGovernanceBlockChainHookStubContextHandler()
+	gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+	callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MaxGasLimitPerTx, "1000000")
+	callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	currentEpoch := uint32(52)
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return currentEpoch
+	}
+
+	callInput = createVMInput(big.NewInt(0), "vote", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes(), []byte("yes")})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	currentEpoch = 65
+	callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	proposal, _ := gsc.getProposalFromNonce(big.NewInt(1))
+	require.True(t, proposal.Passed)
+	require.Equal(t, uint64(66), proposal.ActivationEpoch)
+
+	logsEntry := gsc.eei.GetLogs()
+	lastLog := logsEntry[len(logsEntry)-1]
+	require.Equal(t, big.NewInt(66).Bytes(), lastLog.Topics[2])
+
+	pendingChanges, _ := gsc.getNoncesList([]byte(pendingParameterChangesKey))
+	require.Equal(t, []uint64{1}, pendingChanges.Nonces)
+}
+
+func TestGovernanceContract_ParameterChangeProposalNotPassedShouldNotScheduleChanges(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+
+	gsc, blockchainHook, _ := createGovernanceBlockChainHookStubContextHandler()
+	gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.GovernanceParameterChangesFlag)
+
+	callInputArgs := createParameterChangeProposalArgs(proposalIdentifier, 60, governance.MinQuorum, "2000")
+	callInput := createVMInput(big.NewInt(500), "parameterChangeProposal", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return 56
+	}
+	callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	inEffect, _ := gsc.getParameterChangesInEffect()
+	require.Empty(t, inEffect.Changes)
+	pendingChanges, _ := gsc.getNoncesList([]byte(pendingParameterChangesKey))
+	require.Empty(t, pendingChanges.Nonces)
+}
+
 func TestGovernanceContract_ClaimAccumulatedFees(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -125,6 +126,38 @@ func (d *delegation) createLogEventsForChangeOwner(
 	d.eei.AddLogEntry(entry)
 }
 
+func (e *esdt) createAndAddComplianceConfigLogEntry(address []byte, tokenID []byte, config *common.ESDTComplianceConfig) {
+	entry := &vmcommon.LogEntry{
+		Identifier: []byte(common.BuiltInFunctionESDTSetComplianceConfig),
+		Address:    address,
+		Topics: [][]byte{
+			tokenID,
+			boolToSlice(config.AllowList),
+			boolToSlice(config.DenyList),
+			boolToSlice(config.TransferLimit),
+			config.DefaultTransferLimit.Bytes(),
+		},
+	}
+
+	e.eei.AddLogEntry(entry)
+}
+
+func (e *esdt) createAndAddComplianceEntriesLogEntry(identifier string, address []byte, tokenID []byte, value []byte, addresses [][]byte) {
+	topics := [][]byte{tokenID}
+	if value != nil {
+		topics = append(topics, value)
+	}
+	topics = append(topics, addresses...)
+
+	entry := &vmcommon.LogEntry{
+		Identifier: []byte(identifier),
+		Address:    address,
+		Topics:     topics,
+	}
+
+	e.eei.AddLogEntry(entry)
+}
+
 func boolToSlice(b bool) []byte {
 	return []byte(strconv.FormatBool(b))
 }
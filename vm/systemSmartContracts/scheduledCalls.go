@@ -0,0 +1,565 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. scheduledCalls.proto
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	// ExecuteDueCallsFunction is the function called by the protocol on every metachain block to dispatch the due calls
+	ExecuteDueCallsFunction = "executeDueCalls"
+	// NumReturnValuesPerDispatchedCall is the number of values returned by ExecuteDueCallsFunction for each dispatched
+	// call: the registration transaction hash, the owner, the execution index and the consumed gas deposit
+	NumReturnValuesPerDispatchedCall = 4
+	// DueCallsLeftOverIdentifier is the identifier of the log entry added by ExecuteDueCallsFunction when the due calls
+	// do not fit in the current block, its topics being the round the next execution resumes from and the current round
+	DueCallsLeftOverIdentifier = "dueCallsLeftOver"
+
+	callBackFunction              = "callBack"
+	scheduledCallFailedIdentifier = "scheduledCallFailed"
+
+	scheduledCallKeyPrefix       = "call"
+	scheduledRoundKeyPrefix      = "round"
+	pendingCallBackKeyPrefix     = "pendingCallBack"
+	lastScheduledCallIDKey       = "lastCallID"
+	lastProcessedRoundKey        = "lastProcessedRound"
+	scheduledCallIDLength        = 8
+	maxScheduledCallExecutions   = 1000
+	maxScheduledCallGasLimit     = 600000000
+	maxDispatchedCallsPerBlock   = 100
+	maxScannedRoundsPerExecution = 1000
+)
+
+// executionCost returns the transferred value and the gas limit at the gas price of the registration transaction, the
+// deposit of the call covering it for every remaining execution
+func (sc *ScheduledCall) executionCost() *big.Int {
+	gasDeposit := big.NewInt(0).SetUint64(sc.GasLimit)
+	gasDeposit.Mul(gasDeposit, big.NewInt(0).SetUint64(sc.GasPrice))
+
+	return gasDeposit.Add(gasDeposit, sc.Value)
+}
+
+func (sc *ScheduledCall) gasDeposit() *big.Int {
+	gasDeposit := big.NewInt(0).SetUint64(sc.GasLimit)
+	return gasDeposit.Mul(gasDeposit, big.NewInt(0).SetUint64(sc.GasPrice))
+}
+
+type scheduledCalls struct {
+	eei                     vm.SystemEI
+	gasCost                 vm.GasCost
+	scheduledCallsSCAddress []byte
+	marshaller              marshal.Marshalizer
+	enableEpochsHandler     common.EnableEpochsHandler
+	mutExecution            sync.RWMutex
+}
+
+// ArgsNewScheduledCallsContract defines the arguments needed to create the scheduled calls system smart contract
+type ArgsNewScheduledCallsContract struct {
+	Eei                     vm.SystemEI
+	GasCost                 vm.GasCost
+	ScheduledCallsSCAddress []byte
+	Marshalizer             marshal.Marshalizer
+	EnableEpochsHandler     common.EnableEpochsHandler
+}
+
+// NewScheduledCallsContract creates the system smart contract which holds the calls registered to be executed at a
+// given round, or repeatedly every given number of rounds. The metachain dispatches the due calls as smart contract
+// results on every block.
+func NewScheduledCallsContract(args ArgsNewScheduledCallsContract) (*scheduledCalls, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(args.ScheduledCallsSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for scheduled calls sc address", vm.ErrInvalidAddress)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, vm.ErrNilEnableEpochsHandler
+	}
+	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
+		common.ScheduledCallsFlag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduledCalls{
+		eei:                     args.Eei,
+		gasCost:                 args.GasCost,
+		scheduledCallsSCAddress: args.ScheduledCallsSCAddress,
+		marshaller:              args.Marshalizer,
+		enableEpochsHandler:     args.EnableEpochsHandler,
+	}, nil
+}
+
+// Execute calls one of the functions from the scheduled calls smart contract and runs the code according to the input
+func (s *scheduledCalls) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	err := CheckIfNil(args)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	if !s.enableEpochsHandler.IsFlagEnabled(common.ScheduledCallsFlag) {
+		s.eei.AddReturnMessage("scheduled calls contract is not enabled")
+		return vmcommon.UserError
+	}
+
+	if len(args.ESDTTransfers) > 0 {
+		s.eei.AddReturnMessage("cannot transfer ESDT to system SCs")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "scheduleCall":
+		return s.scheduleCall(args)
+	case "cancelScheduledCall":
+		return s.cancelScheduledCall(args)
+	case "getScheduledCall":
+		return s.getScheduledCall(args)
+	case ExecuteDueCallsFunction:
+		return s.executeDueCalls(args)
+	case callBackFunction:
+		return s.callBack(args)
+	}
+
+	s.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.UserError
+}
+
+// format: scheduleCall@destination@valuePerExecution@gasLimit@startRound@period@numExecutions[@data]
+// the call value must be equal with numExecutions * (valuePerExecution + gasLimit * gasPrice)
+func (s *scheduledCalls) scheduleCall(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 6 && len(args.Arguments) != 7 {
+		s.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.ScheduledCallsOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	call := &ScheduledCall{
+		Owner:               args.CallerAddr,
+		Destination:         args.Arguments[0],
+		Value:               big.NewInt(0).SetBytes(args.Arguments[1]),
+		GasLimit:            big.NewInt(0).SetBytes(args.Arguments[2]).Uint64(),
+		GasPrice:            args.GasPrice,
+		NextRound:           big.NewInt(0).SetBytes(args.Arguments[3]).Uint64(),
+		Period:              big.NewInt(0).SetBytes(args.Arguments[4]).Uint64(),
+		RemainingExecutions: big.NewInt(0).SetBytes(args.Arguments[5]).Uint64(),
+		RegistrationTxHash:  args.CurrentTxHash,
+	}
+	if len(args.Arguments) == 7 {
+		call.Data = args.Arguments[6]
+	}
+
+	err = s.checkScheduledCall(call, args)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	expectedDeposit := big.NewInt(0).Mul(call.executionCost(), big.NewInt(0).SetUint64(call.RemainingExecutions))
+	if args.CallValue.Cmp(expectedDeposit) != 0 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid call value, expected %s", expectedDeposit.String()))
+		return vmcommon.UserError
+	}
+
+	call.ID = s.getLastCallID() + 1
+	s.eei.SetStorage([]byte(lastScheduledCallIDKey), uint64ToBytes(call.ID))
+
+	err = s.saveScheduledCall(call)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	s.addToRound(call.NextRound, call.ID)
+
+	s.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.CallerAddr,
+		Topics:     [][]byte{uint64ToBytes(call.ID), call.Destination, uint64ToBytes(call.NextRound)},
+	})
+	s.eei.Finish(uint64ToBytes(call.ID))
+
+	return vmcommon.Ok
+}
+
+func (s *scheduledCalls) checkScheduledCall(call *ScheduledCall, args *vmcommon.ContractCallInput) error {
+	if len(call.Destination) != len(args.RecipientAddr) {
+		return vm.ErrInvalidAddress
+	}
+	if s.eei.BlockChainHook().GetShardOfAddress(call.Destination) == core.MetachainShardId {
+		return fmt.Errorf("%w, the destination must be in a shard", vm.ErrInvalidAddress)
+	}
+	if call.Value.Cmp(zero) > 0 && core.IsSmartContractAddress(call.Destination) {
+		return fmt.Errorf("value can be scheduled only towards user accounts")
+	}
+	if call.GasLimit > maxScheduledCallGasLimit {
+		return fmt.Errorf("gas limit must be at most %d", maxScheduledCallGasLimit)
+	}
+	if call.RemainingExecutions == 0 || call.RemainingExecutions > maxScheduledCallExecutions {
+		return fmt.Errorf("number of executions must be between 1 and %d", maxScheduledCallExecutions)
+	}
+	if call.RemainingExecutions > 1 && call.Period == 0 {
+		return fmt.Errorf("period must be positive for recurring calls")
+	}
+	if call.NextRound <= s.eei.BlockChainHook().CurrentRound() {
+		return fmt.Errorf("start round must be in the future")
+	}
+
+	functionName := string(call.Data)
+	separatorIndex := strings.Index(functionName, "@")
+	if separatorIndex >= 0 {
+		functionName = functionName[:separatorIndex]
+	}
+	if s.eei.BlockChainHook().IsBuiltinFunctionName(functionName) {
+		return fmt.Errorf("built in functions cannot be scheduled")
+	}
+
+	return nil
+}
+
+// format: cancelScheduledCall@id, the remaining deposit is returned to the owner
+func (s *scheduledCalls) cancelScheduledCall(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.ScheduledCallsOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	call, err := s.getScheduledCallData(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64())
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(call.Owner, args.CallerAddr) {
+		s.eei.AddReturnMessage("only the owner can cancel the scheduled call")
+		return vmcommon.UserError
+	}
+
+	refund := big.NewInt(0).Mul(call.executionCost(), big.NewInt(0).SetUint64(call.RemainingExecutions))
+	s.removeFromRound(call.NextRound, call.ID)
+	s.eei.SetStorage(scheduledCallKey(call.ID), nil)
+	if refund.Cmp(zero) > 0 {
+		s.eei.Transfer(call.Owner, s.scheduledCallsSCAddress, refund, nil, 0)
+	}
+
+	s.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.CallerAddr,
+		Topics:     [][]byte{uint64ToBytes(call.ID), refund.Bytes()},
+	})
+
+	return vmcommon.Ok
+}
+
+// format: getScheduledCall@id
+func (s *scheduledCalls) getScheduledCall(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.ScheduledCallsOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	call, err := s.getScheduledCallData(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64())
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.Finish(call.Owner)
+	s.eei.Finish(call.Destination)
+	s.eei.Finish(call.Value.Bytes())
+	s.eei.Finish(call.Data)
+	s.eei.Finish(big.NewInt(0).SetUint64(call.GasLimit).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(call.NextRound).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(call.Period).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(call.RemainingExecutions).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(call.ExecutedCount).Bytes())
+
+	return vmcommon.Ok
+}
+
+// executeDueCalls transfers the due calls towards their destinations, resuming from the last processed round. The
+// calls which do not fit in the current block remain in their round and are dispatched with the next block.
+func (s *scheduledCalls) executeDueCalls(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.scheduledCallsSCAddress) {
+		s.eei.AddReturnMessage(vm.ErrInvalidCaller.Error())
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+
+	currentRound := s.eei.BlockChainHook().CurrentRound()
+	lastProcessedRound, found := s.getLastProcessedRound()
+	if !found && currentRound > 0 {
+		lastProcessedRound = currentRound - 1
+	}
+
+	numDispatched := 0
+	round := lastProcessedRound + 1
+	for ; round <= currentRound && round-lastProcessedRound <= maxScannedRoundsPerExecution; round++ {
+		callIDs := s.getCallIDsForRound(round)
+		numToDispatch := core.MinInt(len(callIDs), maxDispatchedCallsPerBlock-numDispatched)
+		for _, callID := range callIDs[:numToDispatch] {
+			err := s.dispatchCall(callID, currentRound)
+			if err != nil {
+				s.eei.AddReturnMessage(err.Error())
+				return vmcommon.UserError
+			}
+		}
+
+		numDispatched += numToDispatch
+		s.saveCallIDsForRound(round, callIDs[numToDispatch:])
+		if numToDispatch < len(callIDs) {
+			break
+		}
+	}
+
+	s.eei.SetStorage([]byte(lastProcessedRoundKey), uint64ToBytes(round-1))
+	if round <= currentRound {
+		s.eei.AddLogEntry(&vmcommon.LogEntry{
+			Identifier: []byte(DueCallsLeftOverIdentifier),
+			Address:    s.scheduledCallsSCAddress,
+			Topics:     [][]byte{uint64ToBytes(round), uint64ToBytes(currentRound)},
+		})
+	}
+
+	return vmcommon.Ok
+}
+
+// callBack receives the outcome of the calls dispatched towards smart contracts, as the destination shards return it
+// with a legacy asynchronous callback. Only the callbacks of the dispatched calls are accepted, matched by the hash of
+// the registration transaction and the destination. The failed calls are logged with the hash of their registration
+// transaction, the return code and the return message, if any.
+func (s *scheduledCalls) callBack(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallType != vmData.AsynchronousCallBack {
+		s.eei.AddReturnMessage("function can be called only as an asynchronous callback")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 1 {
+		s.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	key := pendingCallBackKey(args.OriginalTxHash, args.CallerAddr)
+	numPendingCallBacks := bytesToUint64(s.eei.GetStorage(key))
+	if numPendingCallBacks == 0 {
+		s.eei.AddReturnMessage("callback does not match any dispatched call")
+		return vmcommon.UserError
+	}
+	numPendingCallBacks--
+	if numPendingCallBacks == 0 {
+		s.eei.SetStorage(key, nil)
+	} else {
+		s.eei.SetStorage(key, uint64ToBytes(numPendingCallBacks))
+	}
+
+	returnCode := big.NewInt(0).SetBytes(args.Arguments[0])
+	if returnCode.Cmp(big.NewInt(int64(vmcommon.Ok))) == 0 {
+		return vmcommon.Ok
+	}
+
+	topics := [][]byte{args.OriginalTxHash, args.Arguments[0]}
+	if len(args.Arguments) > 1 {
+		topics = append(topics, args.Arguments[1])
+	}
+	s.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(scheduledCallFailedIdentifier),
+		Address:    args.CallerAddr,
+		Topics:     topics,
+	})
+
+	return vmcommon.Ok
+}
+
+func (s *scheduledCalls) dispatchCall(callID uint64, currentRound uint64) error {
+	call, err := s.getScheduledCallData(callID)
+	if err != nil {
+		return err
+	}
+
+	s.eei.Transfer(call.Destination, s.scheduledCallsSCAddress, call.Value, call.Data, call.GasLimit)
+	if isDispatchedWithCallBack(call) {
+		key := pendingCallBackKey(call.RegistrationTxHash, call.Destination)
+		numPendingCallBacks := bytesToUint64(s.eei.GetStorage(key))
+		s.eei.SetStorage(key, uint64ToBytes(numPendingCallBacks+1))
+	}
+	s.eei.Finish(call.RegistrationTxHash)
+	s.eei.Finish(call.Owner)
+	s.eei.Finish(big.NewInt(0).SetUint64(call.ExecutedCount).Bytes())
+	s.eei.Finish(call.gasDeposit().Bytes())
+
+	call.ExecutedCount++
+	call.RemainingExecutions--
+	if call.RemainingExecutions == 0 {
+		s.eei.SetStorage(scheduledCallKey(call.ID), nil)
+		return nil
+	}
+
+	call.NextRound += call.Period
+	if call.NextRound <= currentRound {
+		call.NextRound = currentRound + 1
+	}
+	s.addToRound(call.NextRound, call.ID)
+
+	return s.saveScheduledCall(call)
+}
+
+// isDispatchedWithCallBack returns true for the calls towards smart contracts, dispatched as legacy asynchronous calls
+func isDispatchedWithCallBack(call *ScheduledCall) bool {
+	return core.IsSmartContractAddress(call.Destination) && len(call.Data) > 0
+}
+
+func (s *scheduledCalls) getLastCallID() uint64 {
+	return bytesToUint64(s.eei.GetStorage([]byte(lastScheduledCallIDKey)))
+}
+
+func (s *scheduledCalls) getLastProcessedRound() (uint64, bool) {
+	marshalledRound := s.eei.GetStorage([]byte(lastProcessedRoundKey))
+	return bytesToUint64(marshalledRound), len(marshalledRound) > 0
+}
+
+func (s *scheduledCalls) getScheduledCallData(callID uint64) (*ScheduledCall, error) {
+	marshalledData := s.eei.GetStorage(scheduledCallKey(callID))
+	if len(marshalledData) == 0 {
+		return nil, fmt.Errorf("scheduled call %d not found", callID)
+	}
+
+	call := &ScheduledCall{}
+	err := s.marshaller.Unmarshal(call, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+func (s *scheduledCalls) saveScheduledCall(call *ScheduledCall) error {
+	marshalledData, err := s.marshaller.Marshal(call)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage(scheduledCallKey(call.ID), marshalledData)
+	return nil
+}
+
+func (s *scheduledCalls) getCallIDsForRound(round uint64) []uint64 {
+	marshalledIDs := s.eei.GetStorage(scheduledRoundKey(round))
+	callIDs := make([]uint64, 0, len(marshalledIDs)/scheduledCallIDLength)
+	for i := 0; i+scheduledCallIDLength <= len(marshalledIDs); i += scheduledCallIDLength {
+		callIDs = append(callIDs, bytesToUint64(marshalledIDs[i:i+scheduledCallIDLength]))
+	}
+
+	return callIDs
+}
+
+func (s *scheduledCalls) saveCallIDsForRound(round uint64, callIDs []uint64) {
+	marshalledIDs := make([]byte, 0, len(callIDs)*scheduledCallIDLength)
+	for _, callID := range callIDs {
+		marshalledIDs = append(marshalledIDs, uint64ToBytes(callID)...)
+	}
+
+	s.eei.SetStorage(scheduledRoundKey(round), marshalledIDs)
+}
+
+func (s *scheduledCalls) addToRound(round uint64, callID uint64) {
+	s.saveCallIDsForRound(round, append(s.getCallIDsForRound(round), callID))
+}
+
+func (s *scheduledCalls) removeFromRound(round uint64, callID uint64) {
+	callIDs := s.getCallIDsForRound(round)
+	for i, id := range callIDs {
+		if id == callID {
+			callIDs = append(callIDs[:i], callIDs[i+1:]...)
+			break
+		}
+	}
+
+	s.saveCallIDsForRound(round, callIDs)
+}
+
+func scheduledCallKey(callID uint64) []byte {
+	return append([]byte(scheduledCallKeyPrefix), uint64ToBytes(callID)...)
+}
+
+func scheduledRoundKey(round uint64) []byte {
+	return append([]byte(scheduledRoundKeyPrefix), uint64ToBytes(round)...)
+}
+
+func pendingCallBackKey(registrationTxHash []byte, destination []byte) []byte {
+	key := append([]byte(pendingCallBackKeyPrefix), registrationTxHash...)
+	return append(key, destination...)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, scheduledCallIDLength)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
+
+func bytesToUint64(buff []byte) uint64 {
+	if len(buff) != scheduledCallIDLength {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(buff)
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (s *scheduledCalls) SetNewGasCost(gasCost vm.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost
+	s.mutExecution.Unlock()
+}
+
+// CanUseContract returns true if contract can be used
+func (s *scheduledCalls) CanUseContract() bool {
+	return s.enableEpochsHandler.IsFlagEnabled(common.ScheduledCallsFlag)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (s *scheduledCalls) IsInterfaceNil() bool {
+	return s == nil
+}
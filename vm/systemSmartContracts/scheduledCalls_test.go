@@ -0,0 +1,486 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	scheduledCallsTestOwner       = bytes.Repeat([]byte("o"), 32)
+	scheduledCallsTestDestination = bytes.Repeat([]byte("d"), 32)
+	scheduledCallsTestSCAddress   = append(make([]byte, 10), bytes.Repeat([]byte("s"), 22)...)
+)
+
+func createMockArgsForScheduledCalls() ArgsNewScheduledCallsContract {
+	return ArgsNewScheduledCallsContract{
+		Eei:                     createDefaultEei(),
+		GasCost:                 vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{ScheduledCallsOps: 10}},
+		ScheduledCallsSCAddress: vm.ScheduledCallsSCAddress,
+		Marshalizer:             &mock.MarshalizerMock{},
+		EnableEpochsHandler:     enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.ScheduledCallsFlag),
+	}
+}
+
+func createScheduledCallsWithRound(currentRound *uint64) (*scheduledCalls, *vmContext) {
+	eeiArgs := createDefaultEeiArgs()
+	eeiArgs.BlockChainHook = &mock.BlockChainHookStub{
+		CurrentRoundCalled: func() uint64 {
+			return *currentRound
+		},
+		IsBuiltinFunctionNameCalled: func(functionName string) bool {
+			return functionName == core.BuiltInFunctionESDTTransfer
+		},
+	}
+	eei, _ := NewVMContext(eeiArgs)
+	eei.SetSCAddress(vm.ScheduledCallsSCAddress)
+	eei.gasRemaining = 1000
+
+	args := createMockArgsForScheduledCalls()
+	args.Eei = eei
+	sc, _ := NewScheduledCallsContract(args)
+
+	return sc, eei
+}
+
+func createScheduleCallInput(value int64, gasLimit uint64, gasPrice uint64, startRound uint64, period uint64, numExecutions uint64, data []byte) *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForFunc("scheduleCall", [][]byte{
+		scheduledCallsTestDestination,
+		big.NewInt(value).Bytes(),
+		big.NewInt(0).SetUint64(gasLimit).Bytes(),
+		big.NewInt(0).SetUint64(startRound).Bytes(),
+		big.NewInt(0).SetUint64(period).Bytes(),
+		big.NewInt(0).SetUint64(numExecutions).Bytes(),
+	})
+	if len(data) > 0 {
+		vmInput.Arguments = append(vmInput.Arguments, data)
+	}
+	vmInput.CallerAddr = scheduledCallsTestOwner
+	vmInput.RecipientAddr = vm.ScheduledCallsSCAddress
+	vmInput.GasPrice = gasPrice
+	vmInput.CurrentTxHash = []byte("txHash")
+	deposit := big.NewInt(0).SetUint64(gasLimit * gasPrice)
+	deposit.Add(deposit, big.NewInt(value))
+	vmInput.CallValue = deposit.Mul(deposit, big.NewInt(0).SetUint64(numExecutions))
+
+	return vmInput
+}
+
+func createExecuteDueCallsInput() *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForFunc(ExecuteDueCallsFunction, nil)
+	vmInput.CallerAddr = vm.ScheduledCallsSCAddress
+	vmInput.RecipientAddr = vm.ScheduledCallsSCAddress
+
+	return vmInput
+}
+
+func getScheduledCallsTransfers(eei *vmContext, destination []byte) []vmcommon.OutputTransfer {
+	outAcc, ok := eei.outputAccounts[string(destination)]
+	if !ok {
+		return nil
+	}
+
+	return outAcc.OutputTransfers
+}
+
+func getScheduledCallsLogs(eei *vmContext, identifier string) []*vmcommon.LogEntry {
+	logs := make([]*vmcommon.LogEntry, 0)
+	for _, logEntry := range eei.GetLogs() {
+		if string(logEntry.Identifier) == identifier {
+			logs = append(logs, logEntry)
+		}
+	}
+
+	return logs
+}
+
+func TestNewScheduledCallsContract(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil eei should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForScheduledCalls()
+		args.Eei = nil
+		sc, err := NewScheduledCallsContract(args)
+		require.Nil(t, sc)
+		require.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForScheduledCalls()
+		args.ScheduledCallsSCAddress = nil
+		sc, err := NewScheduledCallsContract(args)
+		require.Nil(t, sc)
+		require.True(t, errors.Is(err, vm.ErrInvalidAddress))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForScheduledCalls()
+		args.Marshalizer = nil
+		sc, err := NewScheduledCallsContract(args)
+		require.Nil(t, sc)
+		require.Equal(t, vm.ErrNilMarshalizer, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForScheduledCalls()
+		args.EnableEpochsHandler = nil
+		sc, err := NewScheduledCallsContract(args)
+		require.Nil(t, sc)
+		require.Equal(t, vm.ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewScheduledCallsContract(createMockArgsForScheduledCalls())
+		require.Nil(t, err)
+		require.False(t, sc.IsInterfaceNil())
+		require.True(t, sc.CanUseContract())
+	})
+}
+
+func TestScheduledCalls_ExecuteFlagNotActive(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	sc, eei := createScheduledCallsWithRound(&currentRound)
+	enableEpochsHandler, _ := sc.enableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.RemoveActiveFlags(common.ScheduledCallsFlag)
+
+	require.False(t, sc.CanUseContract())
+	require.Equal(t, vmcommon.UserError, sc.Execute(createScheduleCallInput(0, 100, 10, 11, 0, 1, nil)))
+	require.Equal(t, "scheduled calls contract is not enabled", eei.returnMessage)
+}
+
+func TestScheduledCalls_ScheduleCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name            string
+			vmInput         *vmcommon.ContractCallInput
+			expectedMessage string
+		}{
+			{
+				name:            "start round in the past",
+				vmInput:         createScheduleCallInput(0, 100, 10, 10, 0, 1, nil),
+				expectedMessage: "start round must be in the future",
+			},
+			{
+				name:            "no executions",
+				vmInput:         createScheduleCallInput(0, 100, 10, 11, 0, 0, nil),
+				expectedMessage: "number of executions must be between 1 and 1000",
+			},
+			{
+				name:            "recurring without period",
+				vmInput:         createScheduleCallInput(0, 100, 10, 11, 0, 2, nil),
+				expectedMessage: "period must be positive for recurring calls",
+			},
+			{
+				name:            "gas limit too high",
+				vmInput:         createScheduleCallInput(0, maxScheduledCallGasLimit+1, 1, 11, 0, 1, nil),
+				expectedMessage: "gas limit must be at most 600000000",
+			},
+			{
+				name:            "built in function",
+				vmInput:         createScheduleCallInput(0, 100, 10, 11, 0, 1, []byte(core.BuiltInFunctionESDTTransfer+"@01@02")),
+				expectedMessage: "built in functions cannot be scheduled",
+			},
+		}
+
+		for _, tt := range tests {
+			currentRound := uint64(10)
+			sc, eei := createScheduledCallsWithRound(&currentRound)
+			require.Equal(t, vmcommon.UserError, sc.Execute(tt.vmInput), tt.name)
+			require.Equal(t, tt.expectedMessage, eei.returnMessage, tt.name)
+		}
+	})
+	t.Run("value towards a smart contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		vmInput := createScheduleCallInput(5, 100, 10, 11, 0, 1, nil)
+		vmInput.Arguments[0] = make([]byte, 32)
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Equal(t, "value can be scheduled only towards user accounts", eei.returnMessage)
+	})
+	t.Run("wrong deposit should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		vmInput := createScheduleCallInput(5, 100, 10, 11, 1, 2, nil)
+		vmInput.CallValue = big.NewInt(1005)
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Equal(t, "invalid call value, expected 2010", eei.returnMessage)
+	})
+	t.Run("should save the call and return its id", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(5, 100, 10, 12, 3, 2, []byte("claim"))))
+		require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(5, 100, 10, 12, 0, 1, nil)))
+		require.Equal(t, [][]byte{uint64ToBytes(1), uint64ToBytes(2)}, eei.output)
+		require.Equal(t, []uint64{1, 2}, sc.getCallIDsForRound(12))
+
+		call, err := sc.getScheduledCallData(1)
+		require.Nil(t, err)
+		require.Equal(t, &ScheduledCall{
+			ID:                  1,
+			Owner:               scheduledCallsTestOwner,
+			Destination:         scheduledCallsTestDestination,
+			Value:               big.NewInt(5),
+			Data:                []byte("claim"),
+			GasLimit:            100,
+			GasPrice:            10,
+			NextRound:           12,
+			Period:              3,
+			RemainingExecutions: 2,
+			RegistrationTxHash:  []byte("txHash"),
+		}, call)
+	})
+}
+
+func TestScheduledCalls_CancelScheduledCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not the owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(5, 100, 10, 12, 3, 2, nil)))
+
+		vmInput := getDefaultVmInputForFunc("cancelScheduledCall", [][]byte{{1}})
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Equal(t, "only the owner can cancel the scheduled call", eei.returnMessage)
+	})
+	t.Run("should refund the remaining deposit", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(5, 100, 10, 12, 3, 2, nil)))
+
+		vmInput := getDefaultVmInputForFunc("cancelScheduledCall", [][]byte{{1}})
+		vmInput.CallerAddr = scheduledCallsTestOwner
+		require.Equal(t, vmcommon.Ok, sc.Execute(vmInput))
+
+		transfers := getScheduledCallsTransfers(eei, scheduledCallsTestOwner)
+		require.Len(t, transfers, 1)
+		require.Equal(t, big.NewInt(2010), transfers[0].Value)
+		require.Empty(t, sc.getCallIDsForRound(12))
+
+		_, err := sc.getScheduledCallData(1)
+		require.NotNil(t, err)
+	})
+}
+
+func TestScheduledCalls_ExecuteDueCalls(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not called by the protocol should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		vmInput := createExecuteDueCallsInput()
+		vmInput.CallerAddr = scheduledCallsTestOwner
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Equal(t, vm.ErrInvalidCaller.Error(), eei.returnMessage)
+	})
+	t.Run("recurring call should be dispatched until no executions remain", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(5, 100, 10, 12, 3, 2, []byte("claim"))))
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		require.Empty(t, getScheduledCallsTransfers(eei, scheduledCallsTestDestination))
+
+		eei.output = make([][]byte, 0)
+		currentRound = 13
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		transfers := getScheduledCallsTransfers(eei, scheduledCallsTestDestination)
+		require.Len(t, transfers, 1)
+		require.Equal(t, big.NewInt(5), transfers[0].Value)
+		require.Equal(t, []byte("claim"), transfers[0].Data)
+		require.Equal(t, uint64(100), transfers[0].GasLimit)
+		require.Equal(t, [][]byte{[]byte("txHash"), scheduledCallsTestOwner, {}, big.NewInt(1000).Bytes()}, eei.output)
+		require.Equal(t, []uint64{1}, sc.getCallIDsForRound(15))
+
+		eei.output = make([][]byte, 0)
+		currentRound = 20
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		require.Len(t, getScheduledCallsTransfers(eei, scheduledCallsTestDestination), 2)
+		require.Equal(t, [][]byte{[]byte("txHash"), scheduledCallsTestOwner, {1}, big.NewInt(1000).Bytes()}, eei.output)
+
+		_, err := sc.getScheduledCallData(1)
+		require.NotNil(t, err)
+	})
+	t.Run("calls over the block limit should remain for the next block", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		eei.gasRemaining = 10 * (maxDispatchedCallsPerBlock + 1)
+		for i := 0; i < maxDispatchedCallsPerBlock+1; i++ {
+			require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(0, 100, 10, 11, 0, 1, nil)))
+		}
+
+		currentRound = 11
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		require.Len(t, getScheduledCallsTransfers(eei, scheduledCallsTestDestination), maxDispatchedCallsPerBlock)
+		require.Equal(t, []uint64{maxDispatchedCallsPerBlock + 1}, sc.getCallIDsForRound(11))
+		require.Equal(t, []*vmcommon.LogEntry{{
+			Identifier: []byte(DueCallsLeftOverIdentifier),
+			Address:    vm.ScheduledCallsSCAddress,
+			Topics:     [][]byte{uint64ToBytes(11), uint64ToBytes(11)},
+		}}, getScheduledCallsLogs(eei, DueCallsLeftOverIdentifier))
+
+		currentRound = 12
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		require.Len(t, getScheduledCallsTransfers(eei, scheduledCallsTestDestination), maxDispatchedCallsPerBlock+1)
+		require.Empty(t, sc.getCallIDsForRound(11))
+		require.Len(t, getScheduledCallsLogs(eei, DueCallsLeftOverIdentifier), 1)
+	})
+	t.Run("rounds over the scan limit should remain for the next block", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+
+		currentRound = 10 + maxScannedRoundsPerExecution + 5
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		require.Equal(t, []*vmcommon.LogEntry{{
+			Identifier: []byte(DueCallsLeftOverIdentifier),
+			Address:    vm.ScheduledCallsSCAddress,
+			Topics:     [][]byte{uint64ToBytes(10 + maxScannedRoundsPerExecution + 1), uint64ToBytes(currentRound)},
+		}}, getScheduledCallsLogs(eei, DueCallsLeftOverIdentifier))
+
+		lastProcessedRound, _ := sc.getLastProcessedRound()
+		require.Equal(t, uint64(10+maxScannedRoundsPerExecution), lastProcessedRound)
+	})
+}
+
+func TestScheduledCalls_CallBack(t *testing.T) {
+	t.Parallel()
+
+	createCallBackInput := func(arguments ...[]byte) *vmcommon.ContractCallInput {
+		vmInput := getDefaultVmInputForFunc(callBackFunction, arguments)
+		vmInput.CallerAddr = scheduledCallsTestSCAddress
+		vmInput.RecipientAddr = vm.ScheduledCallsSCAddress
+		vmInput.CallType = vmData.AsynchronousCallBack
+		vmInput.OriginalTxHash = []byte("txHash")
+
+		return vmInput
+	}
+	createScheduledCallsWithDispatchedCall := func(t *testing.T) (*scheduledCalls, *vmContext) {
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		vmInput := createScheduleCallInput(0, 100, 10, 11, 0, 1, []byte("claim"))
+		vmInput.Arguments[0] = scheduledCallsTestSCAddress
+		require.Equal(t, vmcommon.Ok, sc.Execute(vmInput))
+
+		currentRound = 11
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+		require.Len(t, getScheduledCallsTransfers(eei, scheduledCallsTestSCAddress), 1)
+
+		return sc, eei
+	}
+
+	t.Run("not called as callback should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		vmInput := createCallBackInput([]byte{byte(vmcommon.UserError)})
+		vmInput.CallType = vmData.DirectCall
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Empty(t, getScheduledCallsLogs(eei, scheduledCallFailedIdentifier))
+	})
+	t.Run("missing return code should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, _ := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.FunctionWrongSignature, sc.Execute(createCallBackInput()))
+	})
+	t.Run("callback of an unrelated call should error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, eei := createScheduledCallsWithDispatchedCall(t)
+		returnCode := []byte{byte(vmcommon.UserError)}
+
+		vmInput := createCallBackInput(returnCode, []byte("forged"))
+		vmInput.OriginalTxHash = []byte("otherTxHash")
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Equal(t, "callback does not match any dispatched call", eei.returnMessage)
+
+		vmInput = createCallBackInput(returnCode, []byte("forged"))
+		vmInput.CallerAddr = append(make([]byte, 10), bytes.Repeat([]byte("x"), 22)...)
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Empty(t, getScheduledCallsLogs(eei, scheduledCallFailedIdentifier))
+	})
+	t.Run("callback should be accepted once per dispatched call", func(t *testing.T) {
+		t.Parallel()
+
+		sc, eei := createScheduledCallsWithDispatchedCall(t)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createCallBackInput([]byte{})))
+		require.Equal(t, vmcommon.UserError, sc.Execute(createCallBackInput([]byte{})))
+		require.Equal(t, "callback does not match any dispatched call", eei.returnMessage)
+	})
+	t.Run("calls towards user accounts should not expect callbacks", func(t *testing.T) {
+		t.Parallel()
+
+		currentRound := uint64(10)
+		sc, eei := createScheduledCallsWithRound(&currentRound)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createScheduleCallInput(5, 100, 10, 11, 0, 1, []byte("claim"))))
+		currentRound = 11
+		require.Equal(t, vmcommon.Ok, sc.Execute(createExecuteDueCallsInput()))
+
+		vmInput := createCallBackInput([]byte{})
+		vmInput.CallerAddr = scheduledCallsTestDestination
+		require.Equal(t, vmcommon.UserError, sc.Execute(vmInput))
+		require.Equal(t, "callback does not match any dispatched call", eei.returnMessage)
+	})
+	t.Run("successful call should not log", func(t *testing.T) {
+		t.Parallel()
+
+		sc, eei := createScheduledCallsWithDispatchedCall(t)
+		require.Equal(t, vmcommon.Ok, sc.Execute(createCallBackInput([]byte{})))
+		require.Empty(t, getScheduledCallsLogs(eei, scheduledCallFailedIdentifier))
+	})
+	t.Run("failed call should log the registration transaction and the error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, eei := createScheduledCallsWithDispatchedCall(t)
+		returnCode := []byte{byte(vmcommon.UserError)}
+		require.Equal(t, vmcommon.Ok, sc.Execute(createCallBackInput(returnCode, []byte("insufficient funds"))))
+		require.Equal(t, []*vmcommon.LogEntry{{
+			Identifier: []byte(scheduledCallFailedIdentifier),
+			Address:    scheduledCallsTestSCAddress,
+			Topics:     [][]byte{[]byte("txHash"), returnCode, []byte("insufficient funds")},
+		}}, getScheduledCallsLogs(eei, scheduledCallFailedIdentifier))
+	})
+}